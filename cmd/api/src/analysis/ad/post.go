@@ -26,22 +26,38 @@ import (
 	"github.com/specterops/bloodhound/graphschema/azure"
 )
 
+// Post deletes and then recomputes the post-processed relationships of Active Directory. Cancellation of the given
+// context is checked between post-processing operations.
 func Post(ctx context.Context, db graph.Database, adcsEnabled, citrixEnabled, ntlmEnabled bool, compositionCounter *analysis.CompositionCounter) (*analysis.AtomicPostProcessingStats, error) {
 	aggregateStats := analysis.NewAtomicPostProcessingStats()
 	if stats, err := analysis.DeleteTransitEdges(ctx, db, graph.Kinds{ad.Entity, azure.Entity}, adAnalysis.PostProcessedRelationships()...); err != nil {
 		return &aggregateStats, err
+	} else if err := context.Cause(ctx); err != nil {
+		return &aggregateStats, err
 	} else if groupExpansions, err := adAnalysis.ExpandAllRDPLocalGroups(ctx, db); err != nil {
 		return &aggregateStats, err
+	} else if err := context.Cause(ctx); err != nil {
+		return &aggregateStats, err
 	} else if dcSyncStats, err := adAnalysis.PostDCSync(ctx, db, groupExpansions); err != nil {
 		return &aggregateStats, err
+	} else if err := context.Cause(ctx); err != nil {
+		return &aggregateStats, err
 	} else if syncLAPSStats, err := adAnalysis.PostSyncLAPSPassword(ctx, db, groupExpansions); err != nil {
 		return &aggregateStats, err
+	} else if err := context.Cause(ctx); err != nil {
+		return &aggregateStats, err
 	} else if localGroupStats, err := adAnalysis.PostLocalGroups(ctx, db, groupExpansions, false, citrixEnabled); err != nil {
 		return &aggregateStats, err
+	} else if err := context.Cause(ctx); err != nil {
+		return &aggregateStats, err
 	} else if adcsStats, adcsCache, err := adAnalysis.PostADCS(ctx, db, groupExpansions, adcsEnabled); err != nil {
 		return &aggregateStats, err
+	} else if err := context.Cause(ctx); err != nil {
+		return &aggregateStats, err
 	} else if ownsStats, err := adAnalysis.PostOwnsAndWriteOwner(ctx, db, groupExpansions); err != nil {
 		return &aggregateStats, err
+	} else if err := context.Cause(ctx); err != nil {
+		return &aggregateStats, err
 	} else if ntlmStats, err := adAnalysis.PostNTLM(ctx, db, groupExpansions, adcsCache, ntlmEnabled, compositionCounter); err != nil {
 		return &aggregateStats, err
 	} else {
@@ -27,16 +27,26 @@ import (
 	"github.com/specterops/bloodhound/graphschema/azure"
 )
 
+// Post deletes and then recomputes the post-processed relationships of Azure. Cancellation of the given context is
+// checked between post-processing operations.
 func Post(ctx context.Context, db graph.Database) (*analysis.AtomicPostProcessingStats, error) {
 	aggregateStats := analysis.NewAtomicPostProcessingStats()
 	if stats, err := analysis.DeleteTransitEdges(ctx, db, graph.Kinds{ad.Entity, azure.Entity}, azureAnalysis.PostProcessedRelationships()...); err != nil {
 		return &aggregateStats, err
+	} else if err := context.Cause(ctx); err != nil {
+		return &aggregateStats, err
 	} else if userRoleStats, err := azureAnalysis.UserRoleAssignments(ctx, db); err != nil {
 		return &aggregateStats, err
+	} else if err := context.Cause(ctx); err != nil {
+		return &aggregateStats, err
 	} else if executeCommandStats, err := azureAnalysis.ExecuteCommand(ctx, db); err != nil {
 		return &aggregateStats, err
+	} else if err := context.Cause(ctx); err != nil {
+		return &aggregateStats, err
 	} else if appRoleAssignmentStats, err := azureAnalysis.AppRoleAssignments(ctx, db); err != nil {
 		return &aggregateStats, err
+	} else if err := context.Cause(ctx); err != nil {
+		return &aggregateStats, err
 	} else if hybridStats, err := hybrid.PostHybrid(ctx, db); err != nil {
 		return &aggregateStats, err
 	} else {
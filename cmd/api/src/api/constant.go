@@ -67,6 +67,7 @@ const (
 	URIPathVariableTenantID                          = "tenant_id"
 	URIPathVariableTokenID                           = "token_id"
 	URIPathVariableUserID                            = "user_id"
+	URIPathVariableWorkspaceID                       = "workspace_id"
 	URIPathVariableSavedQueryID                      = "saved_query_id"
 	URIPathVariableSSOProviderID                     = "sso_provider_id"
 	URIPathVariableSSOProviderSlug                   = "sso_provider_slug"
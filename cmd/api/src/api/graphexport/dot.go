@@ -0,0 +1,60 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package graphexport
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+var dotStringEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\r", "", "\n", `\n`)
+
+func quoteDOT(value string) string {
+	return `"` + dotStringEscaper.Replace(value) + `"`
+}
+
+func formatDOTAttributes(label string, attributes []Attribute) string {
+	formatted := []string{"label=" + quoteDOT(label)}
+
+	for _, attribute := range attributes {
+		formatted = append(formatted, quoteDOT(attribute.Name)+"="+quoteDOT(attribute.String()))
+	}
+
+	return strings.Join(formatted, ", ")
+}
+
+// WriteDOT writes the graph as a Graphviz DOT digraph. Attributes are written as quoted DOT attributes which Graphviz
+// ignores for rendering but keeps when the graph is read by other tooling.
+func WriteDOT(writer io.Writer, exported Graph) error {
+	buffered := bufio.NewWriter(writer)
+
+	fmt.Fprintf(buffered, "digraph %s {\n", quoteDOT(graphName))
+
+	for _, node := range exported.Nodes {
+		fmt.Fprintf(buffered, "\t%s [%s];\n", quoteDOT(node.ID), formatDOTAttributes(node.Label, node.Attributes))
+	}
+
+	for _, edge := range exported.Edges {
+		fmt.Fprintf(buffered, "\t%s -> %s [%s];\n", quoteDOT(edge.Source), quoteDOT(edge.Target), formatDOTAttributes(edge.Label, edge.Attributes))
+	}
+
+	fmt.Fprintln(buffered, "}")
+
+	return buffered.Flush()
+}
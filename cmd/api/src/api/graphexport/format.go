@@ -0,0 +1,144 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package graphexport
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/specterops/bloodhound/headers"
+	"github.com/specterops/bloodhound/mediatypes"
+)
+
+const (
+	graphName        = "BloodHound"
+	edgeTypeDirected = "directed"
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported graph export format")
+)
+
+// Format is a graph file format that query results may be exported to.
+type Format string
+
+const (
+	FormatGraphML Format = "graphml"
+	FormatGEXF    Format = "gexf"
+	FormatDOT     Format = "dot"
+)
+
+var formatMediaTypes = map[Format]mediatypes.MediaType{
+	FormatGraphML: mediatypes.ApplicationGraphmlXml,
+	FormatGEXF:    mediatypes.ApplicationGexfXml,
+	FormatDOT:     mediatypes.TextVndGraphviz,
+}
+
+// MediaType returns the media type that identifies the format in Accept and Content-Type headers.
+func (s Format) MediaType() mediatypes.MediaType {
+	return formatMediaTypes[s]
+}
+
+// FileName returns the name given to exported files of this format.
+func (s Format) FileName() string {
+	return "bloodhound-graph." + string(s)
+}
+
+// Write serializes the graph to the given writer in this format.
+func (s Format) Write(writer io.Writer, exported Graph) error {
+	switch s {
+	case FormatGraphML:
+		return WriteGraphML(writer, exported)
+	case FormatGEXF:
+		return WriteGEXF(writer, exported)
+	case FormatDOT:
+		return WriteDOT(writer, exported)
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedFormat, s)
+	}
+}
+
+// FormatForMediaType returns the export format identified by the given media type, if any.
+func FormatForMediaType(mediaType string) (Format, bool) {
+	for format, formatMediaType := range formatMediaTypes {
+		if strings.EqualFold(mediaType, formatMediaType.String()) {
+			return format, true
+		}
+	}
+
+	return "", false
+}
+
+type acceptedMediaType struct {
+	mediaType string
+	quality   float64
+}
+
+// NegotiateFormat selects an export format from the Accept header of a request. Media ranges are considered in order
+// of preference and an export format is only selected when the client prefers it over JSON and any wildcard range.
+func NegotiateFormat(header http.Header) (Format, bool) {
+	var accepted []acceptedMediaType
+
+	for _, value := range header.Values(headers.Accept.String()) {
+		for _, mediaRange := range strings.Split(value, ",") {
+			if mediaType, params, err := mime.ParseMediaType(mediaRange); err == nil {
+				quality := 1.0
+
+				if qualityParam, hasQuality := params["q"]; hasQuality {
+					if parsedQuality, err := strconv.ParseFloat(qualityParam, 64); err == nil {
+						quality = parsedQuality
+					}
+				}
+
+				if quality > 0 {
+					accepted = append(accepted, acceptedMediaType{
+						mediaType: mediaType,
+						quality:   quality,
+					})
+				}
+			}
+		}
+	}
+
+	// The sort is stable so that media ranges of equal quality keep the order the client listed them in
+	slices.SortStableFunc(accepted, func(a, b acceptedMediaType) int {
+		switch {
+		case a.quality > b.quality:
+			return -1
+		case a.quality < b.quality:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	for _, next := range accepted {
+		if format, isExportFormat := FormatForMediaType(next.mediaType); isExportFormat {
+			return format, true
+		} else if next.mediaType == mediatypes.ApplicationJson.String() || strings.HasSuffix(next.mediaType, "/*") {
+			break
+		}
+	}
+
+	return "", false
+}
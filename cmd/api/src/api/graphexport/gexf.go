@@ -0,0 +1,156 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package graphexport
+
+import (
+	"encoding/xml"
+	"io"
+	"strconv"
+)
+
+const (
+	gexfNamespace  = "http://gexf.net/1.3"
+	gexfVersion    = "1.3"
+	gexfModeStatic = "static"
+)
+
+type gexfDocument struct {
+	XMLName   xml.Name  `xml:"gexf"`
+	Namespace string    `xml:"xmlns,attr"`
+	Version   string    `xml:"version,attr"`
+	Meta      gexfMeta  `xml:"meta"`
+	Graph     gexfGraph `xml:"graph"`
+}
+
+type gexfMeta struct {
+	Creator string `xml:"creator"`
+}
+
+type gexfGraph struct {
+	DefaultEdgeType string           `xml:"defaultedgetype,attr"`
+	Mode            string           `xml:"mode,attr"`
+	Attributes      []gexfAttributes `xml:"attributes"`
+	Nodes           []gexfNode       `xml:"nodes>node"`
+	Edges           []gexfEdge       `xml:"edges>edge"`
+}
+
+type gexfAttributes struct {
+	Class      string          `xml:"class,attr"`
+	Attributes []gexfAttribute `xml:"attribute"`
+}
+
+type gexfAttribute struct {
+	ID    string `xml:"id,attr"`
+	Title string `xml:"title,attr"`
+	Type  string `xml:"type,attr"`
+}
+
+type gexfNode struct {
+	ID        string         `xml:"id,attr"`
+	Label     string         `xml:"label,attr"`
+	AttValues []gexfAttValue `xml:"attvalues>attvalue"`
+}
+
+type gexfEdge struct {
+	ID        string         `xml:"id,attr"`
+	Source    string         `xml:"source,attr"`
+	Target    string         `xml:"target,attr"`
+	Label     string         `xml:"label,attr"`
+	AttValues []gexfAttValue `xml:"attvalues>attvalue"`
+}
+
+type gexfAttValue struct {
+	For   string `xml:"for,attr"`
+	Value string `xml:"value,attr"`
+}
+
+func gexfAttributeClass(class string, keys []AttributeKey) (gexfAttributes, map[string]string) {
+	var (
+		declared = gexfAttributes{
+			Class: class,
+		}
+		attributeIDs = map[string]string{}
+	)
+
+	for idx, key := range keys {
+		attributeID := strconv.Itoa(idx)
+		attributeIDs[key.Name] = attributeID
+
+		declared.Attributes = append(declared.Attributes, gexfAttribute{
+			ID:    attributeID,
+			Title: key.Name,
+			Type:  string(key.Type),
+		})
+	}
+
+	return declared, attributeIDs
+}
+
+func gexfAttValues(attributes []Attribute, attributeIDs map[string]string) []gexfAttValue {
+	values := make([]gexfAttValue, 0, len(attributes))
+
+	for _, attribute := range attributes {
+		values = append(values, gexfAttValue{
+			For:   attributeIDs[attribute.Name],
+			Value: attribute.String(),
+		})
+	}
+
+	return values
+}
+
+// WriteGEXF writes the graph as a GEXF document.
+func WriteGEXF(writer io.Writer, exported Graph) error {
+	var (
+		nodeAttributes, nodeAttributeIDs = gexfAttributeClass("node", exported.NodeAttributeKeys())
+		edgeAttributes, edgeAttributeIDs = gexfAttributeClass("edge", exported.EdgeAttributeKeys())
+		document                         = gexfDocument{
+			Namespace: gexfNamespace,
+			Version:   gexfVersion,
+			Meta: gexfMeta{
+				Creator: graphName,
+			},
+			Graph: gexfGraph{
+				DefaultEdgeType: edgeTypeDirected,
+				Mode:            gexfModeStatic,
+				Attributes:      []gexfAttributes{nodeAttributes, edgeAttributes},
+				Nodes:           make([]gexfNode, 0, len(exported.Nodes)),
+				Edges:           make([]gexfEdge, 0, len(exported.Edges)),
+			},
+		}
+	)
+
+	for _, node := range exported.Nodes {
+		document.Graph.Nodes = append(document.Graph.Nodes, gexfNode{
+			ID:        node.ID,
+			Label:     node.Label,
+			AttValues: gexfAttValues(node.Attributes, nodeAttributeIDs),
+		})
+	}
+
+	for _, edge := range exported.Edges {
+		document.Graph.Edges = append(document.Graph.Edges, gexfEdge{
+			ID:        edge.ID,
+			Source:    edge.Source,
+			Target:    edge.Target,
+			Label:     edge.Label,
+			AttValues: gexfAttValues(edge.Attributes, edgeAttributeIDs),
+		})
+	}
+
+	return writeXML(writer, document)
+}
@@ -0,0 +1,305 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package graphexport serializes graph query results to file formats understood by external graph tooling.
+package graphexport
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/specterops/bloodhound/analysis"
+	"github.com/specterops/bloodhound/dawgs/graph"
+	"github.com/specterops/bloodhound/graphschema/ad"
+	"github.com/specterops/bloodhound/graphschema/common"
+	"github.com/specterops/bloodhound/src/model"
+)
+
+// Attributes derived from the kinds and asset group tags of exported nodes and edges rather than copied from their properties
+const (
+	AttributeKind     = "kind"
+	AttributeKinds    = "kinds"
+	AttributeTierZero = "tier_zero"
+	AttributeOwned    = "owned"
+
+	kindsSeparator = ","
+)
+
+// AttributeType is the value type of a node or edge attribute. The type names are shared by GraphML and GEXF.
+type AttributeType string
+
+const (
+	AttributeTypeString  AttributeType = "string"
+	AttributeTypeBoolean AttributeType = "boolean"
+	AttributeTypeLong    AttributeType = "long"
+	AttributeTypeDouble  AttributeType = "double"
+)
+
+// Attribute is a named and typed value attached to an exported node or edge.
+type Attribute struct {
+	Name  string
+	Type  AttributeType
+	Value any
+}
+
+// String formats the attribute value as text.
+func (s Attribute) String() string {
+	switch typedValue := s.Value.(type) {
+	case bool:
+		return strconv.FormatBool(typedValue)
+	case int64:
+		return strconv.FormatInt(typedValue, 10)
+	case float64:
+		return strconv.FormatFloat(typedValue, 'g', -1, 64)
+	case string:
+		return typedValue
+	default:
+		return fmt.Sprint(typedValue)
+	}
+}
+
+// AttributeKey declares an attribute carried by one or more exported nodes or edges.
+type AttributeKey struct {
+	Name string
+	Type AttributeType
+}
+
+type Node struct {
+	ID         string
+	Label      string
+	Attributes []Attribute
+}
+
+type Edge struct {
+	ID         string
+	Source     string
+	Target     string
+	Label      string
+	Attributes []Attribute
+}
+
+// Graph is a format neutral representation of the nodes and edges being exported. Nodes and edges are ordered by
+// their database ID and their attributes are ordered by name so that exports are stable.
+type Graph struct {
+	Nodes []Node
+	Edges []Edge
+}
+
+// FromPathSet returns the distinct nodes and edges of the given paths.
+func FromPathSet(paths graph.PathSet) Graph {
+	var (
+		nodes = graph.NewNodeSet()
+		edges = graph.NewRelationshipSet()
+	)
+
+	for _, path := range paths {
+		nodes.Add(path.Nodes...)
+		edges.Add(path.Edges...)
+	}
+
+	return newGraph(nodes, edges)
+}
+
+// FromNodeSet returns the given nodes without any edges.
+func FromNodeSet(nodes graph.NodeSet) Graph {
+	return newGraph(nodes, graph.NewRelationshipSet())
+}
+
+func newGraph(nodes graph.NodeSet, edges graph.RelationshipSet) Graph {
+	exported := Graph{
+		Nodes: make([]Node, 0, nodes.Len()),
+		Edges: make([]Edge, 0, edges.Len()),
+	}
+
+	for _, node := range sortedByID(nodes.Slice(), func(node *graph.Node) graph.ID { return node.ID }) {
+		exported.Nodes = append(exported.Nodes, exportNode(node))
+	}
+
+	for _, edge := range sortedByID(edges.Slice(), func(edge *graph.Relationship) graph.ID { return edge.ID }) {
+		exported.Edges = append(exported.Edges, exportEdge(edge))
+	}
+
+	return exported
+}
+
+func sortedByID[T any](values []T, id func(value T) graph.ID) []T {
+	slices.SortFunc(values, func(a, b T) int {
+		return cmp.Compare(id(a), id(b))
+	})
+
+	return values
+}
+
+// IsEmpty returns true if the graph has neither nodes nor edges.
+func (s Graph) IsEmpty() bool {
+	return len(s.Nodes) == 0 && len(s.Edges) == 0
+}
+
+// NodeAttributeKeys returns the attributes carried by the graph's nodes, ordered by name.
+func (s Graph) NodeAttributeKeys() []AttributeKey {
+	var attributes [][]Attribute
+
+	for _, node := range s.Nodes {
+		attributes = append(attributes, node.Attributes)
+	}
+
+	return attributeKeys(attributes)
+}
+
+// EdgeAttributeKeys returns the attributes carried by the graph's edges, ordered by name.
+func (s Graph) EdgeAttributeKeys() []AttributeKey {
+	var attributes [][]Attribute
+
+	for _, edge := range s.Edges {
+		attributes = append(attributes, edge.Attributes)
+	}
+
+	return attributeKeys(attributes)
+}
+
+// attributeKeys declares each distinct attribute name. An attribute whose values do not share a single type across
+// all elements is declared as a string.
+func attributeKeys(elementAttributes [][]Attribute) []AttributeKey {
+	types := map[string]AttributeType{}
+
+	for _, attributes := range elementAttributes {
+		for _, attribute := range attributes {
+			if existingType, seen := types[attribute.Name]; !seen {
+				types[attribute.Name] = attribute.Type
+			} else if existingType != attribute.Type {
+				types[attribute.Name] = AttributeTypeString
+			}
+		}
+	}
+
+	keys := make([]AttributeKey, 0, len(types))
+
+	for name, attributeType := range types {
+		keys = append(keys, AttributeKey{
+			Name: name,
+			Type: attributeType,
+		})
+	}
+
+	slices.SortFunc(keys, func(a, b AttributeKey) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return keys
+}
+
+func exportNode(node *graph.Node) Node {
+	var (
+		objectID, _   = node.Properties.GetOrDefault(common.ObjectID.String(), node.ID.String()).String()
+		label, _      = node.Properties.GetWithFallback(common.Name.String(), objectID, common.DisplayName.String()).String()
+		systemTags, _ = node.Properties.GetOrDefault(common.SystemTags.String(), "").String()
+		userTags, _   = node.Properties.GetOrDefault(common.UserTags.String(), "").String()
+		attributes    = propertyAttributes(node.Properties)
+	)
+
+	// Kinds and asset group tags are always exported so that every node carries the same set of classifying attributes
+	attributes[AttributeKind] = newAttribute(AttributeKind, analysis.GetNodeKind(node).String())
+	attributes[AttributeKinds] = newAttribute(AttributeKinds, strings.Join(node.Kinds.Strings(), kindsSeparator))
+	attributes[common.SystemTags.String()] = newAttribute(common.SystemTags.String(), systemTags)
+	attributes[common.UserTags.String()] = newAttribute(common.UserTags.String(), userTags)
+	attributes[AttributeTierZero] = newAttribute(AttributeTierZero, strings.Contains(systemTags, ad.AdminTierZero))
+	attributes[AttributeOwned] = newAttribute(AttributeOwned, strings.Contains(systemTags, model.OwnedAssetGroupTag))
+
+	return Node{
+		ID:         node.ID.String(),
+		Label:      label,
+		Attributes: sortedAttributes(attributes),
+	}
+}
+
+func exportEdge(edge *graph.Relationship) Edge {
+	attributes := propertyAttributes(edge.Properties)
+	attributes[AttributeKind] = newAttribute(AttributeKind, edge.Kind.String())
+
+	return Edge{
+		ID:         edge.ID.String(),
+		Source:     edge.StartID.String(),
+		Target:     edge.EndID.String(),
+		Label:      edge.Kind.String(),
+		Attributes: sortedAttributes(attributes),
+	}
+}
+
+func propertyAttributes(properties *graph.Properties) map[string]Attribute {
+	attributes := map[string]Attribute{}
+
+	if properties != nil {
+		for name, value := range properties.Map {
+			if value != nil {
+				attributes[name] = newAttribute(name, value)
+			}
+		}
+	}
+
+	return attributes
+}
+
+func sortedAttributes(attributes map[string]Attribute) []Attribute {
+	sorted := make([]Attribute, 0, len(attributes))
+
+	for _, attribute := range attributes {
+		sorted = append(sorted, attribute)
+	}
+
+	slices.SortFunc(sorted, func(a, b Attribute) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return sorted
+}
+
+// newAttribute types the given property value. Values without a scalar representation in the export formats, such as
+// arrays, are exported as their JSON encoding.
+func newAttribute(name string, value any) Attribute {
+	if timeValue, isTime := value.(time.Time); isTime {
+		return Attribute{Name: name, Type: AttributeTypeString, Value: timeValue.Format(time.RFC3339Nano)}
+	}
+
+	switch reflected := reflect.ValueOf(value); reflected.Kind() {
+	case reflect.Bool:
+		return Attribute{Name: name, Type: AttributeTypeBoolean, Value: reflected.Bool()}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return Attribute{Name: name, Type: AttributeTypeLong, Value: reflected.Int()}
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return Attribute{Name: name, Type: AttributeTypeLong, Value: int64(reflected.Uint())}
+
+	case reflect.Float32, reflect.Float64:
+		return Attribute{Name: name, Type: AttributeTypeDouble, Value: reflected.Float()}
+
+	case reflect.String:
+		return Attribute{Name: name, Type: AttributeTypeString, Value: reflected.String()}
+
+	default:
+		if encoded, err := json.Marshal(value); err != nil {
+			return Attribute{Name: name, Type: AttributeTypeString, Value: fmt.Sprint(value)}
+		} else {
+			return Attribute{Name: name, Type: AttributeTypeString, Value: string(encoded)}
+		}
+	}
+}
@@ -0,0 +1,243 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package graphexport_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"net/http"
+	"testing"
+
+	"github.com/specterops/bloodhound/dawgs/graph"
+	"github.com/specterops/bloodhound/graphschema/ad"
+	"github.com/specterops/bloodhound/graphschema/common"
+	"github.com/specterops/bloodhound/headers"
+	"github.com/specterops/bloodhound/src/api/graphexport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testPathSet() graph.PathSet {
+	var (
+		user = graph.NewNode(1, graph.AsProperties(map[string]any{
+			common.ObjectID.String():   "S-1-5-21-1",
+			common.Name.String():       "USER@TESTLAB.LOCAL",
+			common.SystemTags.String(): "owned",
+			"admincount":               true,
+			"logoncount":               12,
+		}), ad.Entity, ad.User)
+		group = graph.NewNode(2, graph.AsProperties(map[string]any{
+			common.ObjectID.String():   "S-1-5-21-512",
+			common.Name.String():       "DOMAIN ADMINS@TESTLAB.LOCAL",
+			common.SystemTags.String(): ad.AdminTierZero,
+			"logoncount":               "never",
+			"serviceprincipalnames":    []string{"a", "b"},
+		}), ad.Entity, ad.Group)
+		memberOf = graph.NewRelationship(3, 1, 2, graph.AsProperties(map[string]any{
+			"isacl": false,
+		}), ad.MemberOf)
+	)
+
+	// The same path twice must not duplicate any node or edge in the export
+	return graph.PathSet{
+		{Nodes: []*graph.Node{user, group}, Edges: []*graph.Relationship{memberOf}},
+		{Nodes: []*graph.Node{user, group}, Edges: []*graph.Relationship{memberOf}},
+	}
+}
+
+func attributeValue(attributes []graphexport.Attribute, name string) (any, bool) {
+	for _, attribute := range attributes {
+		if attribute.Name == name {
+			return attribute.Value, true
+		}
+	}
+
+	return nil, false
+}
+
+func TestFromPathSet(t *testing.T) {
+	exported := graphexport.FromPathSet(testPathSet())
+
+	require.Len(t, exported.Nodes, 2)
+	require.Len(t, exported.Edges, 1)
+
+	user := exported.Nodes[0]
+	assert.Equal(t, "1", user.ID)
+	assert.Equal(t, "USER@TESTLAB.LOCAL", user.Label)
+
+	for name, expected := range map[string]any{
+		graphexport.AttributeKind:     ad.User.String(),
+		graphexport.AttributeKinds:    "Base,User",
+		graphexport.AttributeOwned:    true,
+		graphexport.AttributeTierZero: false,
+		common.UserTags.String():      "",
+		"admincount":                  true,
+		"logoncount":                  int64(12),
+	} {
+		value, found := attributeValue(user.Attributes, name)
+		assert.True(t, found, name)
+		assert.Equal(t, expected, value, name)
+	}
+
+	group := exported.Nodes[1]
+	tierZero, _ := attributeValue(group.Attributes, graphexport.AttributeTierZero)
+	assert.Equal(t, true, tierZero)
+
+	spns, _ := attributeValue(group.Attributes, "serviceprincipalnames")
+	assert.Equal(t, `["a","b"]`, spns)
+
+	edge := exported.Edges[0]
+	assert.Equal(t, "1", edge.Source)
+	assert.Equal(t, "2", edge.Target)
+	assert.Equal(t, ad.MemberOf.String(), edge.Label)
+}
+
+func TestGraph_NodeAttributeKeys(t *testing.T) {
+	keys := map[string]graphexport.AttributeType{}
+
+	for _, key := range graphexport.FromPathSet(testPathSet()).NodeAttributeKeys() {
+		keys[key.Name] = key.Type
+	}
+
+	assert.Equal(t, graphexport.AttributeTypeBoolean, keys["admincount"])
+	assert.Equal(t, graphexport.AttributeTypeBoolean, keys[graphexport.AttributeTierZero])
+
+	// Attributes with values of differing types are declared as strings
+	assert.Equal(t, graphexport.AttributeTypeString, keys["logoncount"])
+}
+
+func TestFromNodeSet(t *testing.T) {
+	exported := graphexport.FromNodeSet(graph.NewNodeSet(graph.NewNode(1, graph.NewProperties(), ad.Entity, ad.Computer)))
+
+	require.Len(t, exported.Nodes, 1)
+	assert.Empty(t, exported.Edges)
+	assert.Equal(t, "1", exported.Nodes[0].Label)
+	assert.False(t, exported.IsEmpty())
+	assert.True(t, graphexport.FromNodeSet(graph.NewNodeSet()).IsEmpty())
+}
+
+func TestWriteGraphML(t *testing.T) {
+	var (
+		buffer   bytes.Buffer
+		document struct {
+			Keys []struct {
+				ID   string `xml:"id,attr"`
+				For  string `xml:"for,attr"`
+				Name string `xml:"attr.name,attr"`
+				Type string `xml:"attr.type,attr"`
+			} `xml:"key"`
+			Graph struct {
+				EdgeDefault string `xml:"edgedefault,attr"`
+				Nodes       []struct {
+					ID string `xml:"id,attr"`
+				} `xml:"node"`
+				Edges []struct {
+					Source string `xml:"source,attr"`
+					Target string `xml:"target,attr"`
+				} `xml:"edge"`
+			} `xml:"graph"`
+		}
+	)
+
+	require.Nil(t, graphexport.FormatGraphML.Write(&buffer, graphexport.FromPathSet(testPathSet())))
+	require.Nil(t, xml.Unmarshal(buffer.Bytes(), &document))
+
+	assert.Equal(t, "directed", document.Graph.EdgeDefault)
+	assert.Len(t, document.Graph.Nodes, 2)
+	require.Len(t, document.Graph.Edges, 1)
+	assert.Equal(t, "1", document.Graph.Edges[0].Source)
+	assert.Equal(t, "2", document.Graph.Edges[0].Target)
+	assert.Contains(t, buffer.String(), `attr.name="system_tags"`)
+	assert.Contains(t, buffer.String(), "DOMAIN ADMINS@TESTLAB.LOCAL")
+}
+
+func TestWriteGEXF(t *testing.T) {
+	var (
+		buffer   bytes.Buffer
+		document struct {
+			Graph struct {
+				Attributes []struct {
+					Class string `xml:"class,attr"`
+				} `xml:"attributes"`
+				Nodes []struct {
+					Label string `xml:"label,attr"`
+				} `xml:"nodes>node"`
+				Edges []struct {
+					Label string `xml:"label,attr"`
+				} `xml:"edges>edge"`
+			} `xml:"graph"`
+		}
+	)
+
+	require.Nil(t, graphexport.FormatGEXF.Write(&buffer, graphexport.FromPathSet(testPathSet())))
+	require.Nil(t, xml.Unmarshal(buffer.Bytes(), &document))
+
+	require.Len(t, document.Graph.Attributes, 2)
+	require.Len(t, document.Graph.Nodes, 2)
+	require.Len(t, document.Graph.Edges, 1)
+	assert.Equal(t, "USER@TESTLAB.LOCAL", document.Graph.Nodes[0].Label)
+	assert.Equal(t, ad.MemberOf.String(), document.Graph.Edges[0].Label)
+}
+
+func TestWriteDOT(t *testing.T) {
+	var (
+		buffer   bytes.Buffer
+		exported = graphexport.FromNodeSet(graph.NewNodeSet(graph.NewNode(1, graph.AsProperties(map[string]any{
+			common.Name.String():        `QUOTED "NAME"`,
+			common.Description.String(): "line one\nline two",
+		}), ad.Entity, ad.User)))
+	)
+
+	require.Nil(t, graphexport.FormatDOT.Write(&buffer, exported))
+
+	output := buffer.String()
+	assert.Contains(t, output, `digraph "BloodHound" {`)
+	assert.Contains(t, output, `label="QUOTED \"NAME\""`)
+	assert.Contains(t, output, `"description"="line one\nline two"`)
+
+	buffer.Reset()
+	require.Nil(t, graphexport.FormatDOT.Write(&buffer, graphexport.FromPathSet(testPathSet())))
+	assert.Contains(t, buffer.String(), `"1" -> "2" [label="MemberOf"`)
+}
+
+func TestNegotiateFormat(t *testing.T) {
+	for _, testCase := range []struct {
+		Accept   string
+		Expected graphexport.Format
+		IsExport bool
+	}{
+		{Accept: "", IsExport: false},
+		{Accept: "application/json", IsExport: false},
+		{Accept: "*/*", IsExport: false},
+		{Accept: "application/graphml+xml", Expected: graphexport.FormatGraphML, IsExport: true},
+		{Accept: "application/gexf+xml", Expected: graphexport.FormatGEXF, IsExport: true},
+		{Accept: "text/vnd.graphviz", Expected: graphexport.FormatDOT, IsExport: true},
+		{Accept: "application/json, application/graphml+xml", IsExport: false},
+		{Accept: "application/json;q=0.5, text/vnd.graphviz", Expected: graphexport.FormatDOT, IsExport: true},
+		{Accept: "application/gexf+xml;q=0", IsExport: false},
+	} {
+		header := http.Header{}
+
+		if testCase.Accept != "" {
+			header.Set(headers.Accept.String(), testCase.Accept)
+		}
+
+		format, isExport := graphexport.NegotiateFormat(header)
+		assert.Equal(t, testCase.IsExport, isExport, testCase.Accept)
+		assert.Equal(t, testCase.Expected, format, testCase.Accept)
+	}
+}
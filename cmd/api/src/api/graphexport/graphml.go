@@ -0,0 +1,162 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package graphexport
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+)
+
+const (
+	graphMLNamespace = "http://graphml.graphdrawing.org/xmlns"
+	graphMLLabelKey  = "label"
+)
+
+type graphMLDocument struct {
+	XMLName   xml.Name     `xml:"graphml"`
+	Namespace string       `xml:"xmlns,attr"`
+	Keys      []graphMLKey `xml:"key"`
+	Graph     graphMLGraph `xml:"graph"`
+}
+
+type graphMLKey struct {
+	ID   string `xml:"id,attr"`
+	For  string `xml:"for,attr"`
+	Name string `xml:"attr.name,attr"`
+	Type string `xml:"attr.type,attr"`
+}
+
+type graphMLGraph struct {
+	ID          string        `xml:"id,attr"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Nodes       []graphMLNode `xml:"node"`
+	Edges       []graphMLEdge `xml:"edge"`
+}
+
+type graphMLNode struct {
+	ID   string        `xml:"id,attr"`
+	Data []graphMLData `xml:"data"`
+}
+
+type graphMLEdge struct {
+	ID     string        `xml:"id,attr"`
+	Source string        `xml:"source,attr"`
+	Target string        `xml:"target,attr"`
+	Data   []graphMLData `xml:"data"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+// graphMLKeys declares a key for the element label followed by a key for each attribute. Key IDs are prefixed with the
+// element type so that node and edge attributes of the same name do not collide.
+func graphMLKeys(elementType string, keys []AttributeKey) ([]graphMLKey, map[string]string) {
+	var (
+		prefix   = elementType[:1]
+		declared = []graphMLKey{{
+			ID:   prefix + "_" + graphMLLabelKey,
+			For:  elementType,
+			Name: graphMLLabelKey,
+			Type: string(AttributeTypeString),
+		}}
+		keyIDs = map[string]string{}
+	)
+
+	for idx, key := range keys {
+		keyID := fmt.Sprintf("%s%d", prefix, idx)
+		keyIDs[key.Name] = keyID
+
+		declared = append(declared, graphMLKey{
+			ID:   keyID,
+			For:  elementType,
+			Name: key.Name,
+			Type: string(key.Type),
+		})
+	}
+
+	return declared, keyIDs
+}
+
+func graphMLElementData(prefix, label string, attributes []Attribute, keyIDs map[string]string) []graphMLData {
+	data := []graphMLData{{
+		Key:   prefix + "_" + graphMLLabelKey,
+		Value: label,
+	}}
+
+	for _, attribute := range attributes {
+		data = append(data, graphMLData{
+			Key:   keyIDs[attribute.Name],
+			Value: attribute.String(),
+		})
+	}
+
+	return data
+}
+
+// WriteGraphML writes the graph as a GraphML document.
+func WriteGraphML(writer io.Writer, exported Graph) error {
+	var (
+		nodeKeys, nodeKeyIDs = graphMLKeys("node", exported.NodeAttributeKeys())
+		edgeKeys, edgeKeyIDs = graphMLKeys("edge", exported.EdgeAttributeKeys())
+		document             = graphMLDocument{
+			Namespace: graphMLNamespace,
+			Keys:      append(nodeKeys, edgeKeys...),
+			Graph: graphMLGraph{
+				ID:          graphName,
+				EdgeDefault: edgeTypeDirected,
+				Nodes:       make([]graphMLNode, 0, len(exported.Nodes)),
+				Edges:       make([]graphMLEdge, 0, len(exported.Edges)),
+			},
+		}
+	)
+
+	for _, node := range exported.Nodes {
+		document.Graph.Nodes = append(document.Graph.Nodes, graphMLNode{
+			ID:   node.ID,
+			Data: graphMLElementData("n", node.Label, node.Attributes, nodeKeyIDs),
+		})
+	}
+
+	for _, edge := range exported.Edges {
+		document.Graph.Edges = append(document.Graph.Edges, graphMLEdge{
+			ID:     edge.ID,
+			Source: edge.Source,
+			Target: edge.Target,
+			Data:   graphMLElementData("e", edge.Label, edge.Attributes, edgeKeyIDs),
+		})
+	}
+
+	return writeXML(writer, document)
+}
+
+func writeXML(writer io.Writer, document any) error {
+	if _, err := io.WriteString(writer, xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(writer)
+	encoder.Indent("", "  ")
+
+	if err := encoder.Encode(document); err != nil {
+		return fmt.Errorf("failed encoding xml document: %w", err)
+	}
+
+	return encoder.Close()
+}
@@ -17,6 +17,7 @@
 package api
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -28,6 +29,7 @@ import (
 
 	"github.com/specterops/bloodhound/headers"
 	"github.com/specterops/bloodhound/mediatypes"
+	"github.com/specterops/bloodhound/src/api/graphexport"
 	"github.com/specterops/bloodhound/src/api/stream"
 	"github.com/specterops/bloodhound/src/model"
 	"github.com/specterops/bloodhound/src/utils"
@@ -183,6 +185,26 @@ func WriteCSVResponse(ctx context.Context, message model.CSVWriter, statusCode i
 	response.WriteHeader(statusCode)
 }
 
+// WriteGraphExportResponse writes the graph as an attachment in the given export format. The export is rendered in full
+// before any of it is written so that a serialization failure can still be reported with an error status.
+func WriteGraphExportResponse(ctx context.Context, exported graphexport.Graph, format graphexport.Format, statusCode int, response http.ResponseWriter) {
+	var buffer bytes.Buffer
+
+	if err := format.Write(&buffer, exported); err != nil {
+		slog.ErrorContext(ctx, fmt.Sprintf("Writing API Error. Failed to write %s graph export for request: %v", format, err))
+		response.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	response.Header().Set(headers.ContentType.String(), format.MediaType().String())
+	response.Header().Set(headers.ContentDisposition.String(), fmt.Sprintf(utils.ContentDispositionAttachmentTemplate, format.FileName()))
+	response.WriteHeader(statusCode)
+
+	if written, err := response.Write(buffer.Bytes()); err != nil {
+		slog.ErrorContext(ctx, fmt.Sprintf("Writing API Error. Failed to write graph export response with %d bytes written and error: %v", written, err))
+	}
+}
+
 func WriteBinaryResponse(ctx context.Context, data []byte, filename string, statusCode int, response http.ResponseWriter) {
 	response.Header().Set(headers.ContentType.String(), mediatypes.ApplicationOctetStream.String())
 	response.Header().Set(headers.ContentDisposition.String(), fmt.Sprintf(utils.ContentDispositionAttachmentTemplate, filename))
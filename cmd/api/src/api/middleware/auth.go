@@ -99,6 +99,22 @@ func AuthMiddleware(authenticator api.Authenticator) mux.MiddlewareFunc {
 	}
 }
 
+// deniesDefaultGraph returns true if the given permissions include a graph permission and the request targets the
+// default graph on behalf of an actor that is scoped to its workspaces.
+func deniesDefaultGraph(bhCtx *ctx.Context, permissions []model.Permission) bool {
+	if bhCtx.Workspace != nil || !bhCtx.WorkspaceScoped {
+		return false
+	}
+
+	for _, permission := range permissions {
+		if permission.Authority == auth.Permissions().GraphDBRead.Authority {
+			return true
+		}
+	}
+
+	return false
+}
+
 // PermissionsCheckAll is a middleware func generator that returns a http.Handler which closes around a list of
 // permissions that an actor must have in the request auth context to access the wrapped http.Handler.
 func PermissionsCheckAll(authorizer auth.Authorizer, permissions ...model.Permission) mux.MiddlewareFunc {
@@ -109,6 +125,9 @@ func PermissionsCheckAll(authorizer auth.Authorizer, permissions ...model.Permis
 			} else if !authorizer.AllowsAllPermissions(bhCtx.AuthCtx, permissions) {
 				authorizer.AuditLogUnauthorizedAccess(request)
 				api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusForbidden, "not authorized", request), response)
+			} else if deniesDefaultGraph(bhCtx, permissions) {
+				authorizer.AuditLogUnauthorizedAccess(request)
+				api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusForbidden, "not authorized for the default graph", request), response)
 			} else {
 				next.ServeHTTP(response, request)
 			}
@@ -126,6 +145,9 @@ func PermissionsCheckAtLeastOne(authorizer auth.Authorizer, permissions ...model
 			} else if !authorizer.AllowsAtLeastOnePermission(bhCtx.AuthCtx, permissions) {
 				authorizer.AuditLogUnauthorizedAccess(request)
 				api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusForbidden, "not authorized", request), response)
+			} else if deniesDefaultGraph(bhCtx, permissions) {
+				authorizer.AuditLogUnauthorizedAccess(request)
+				api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusForbidden, "not authorized for the default graph", request), response)
 			} else {
 				next.ServeHTTP(response, request)
 			}
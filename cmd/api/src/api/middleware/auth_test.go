@@ -156,6 +156,61 @@ func TestPermissionsCheckAll(t *testing.T) {
 		ResponseStatusCode(http.StatusForbidden)
 }
 
+func TestPermissionsCheckAll_WorkspaceScoped(t *testing.T) {
+	var (
+		handlerReturn200 = func(response http.ResponseWriter, request *http.Request) {
+			response.WriteHeader(http.StatusOK)
+		}
+		mockCtrl  = gomock.NewController(t)
+		mockDB    = dbmocks.NewMockDatabase(mockCtrl)
+		scopedCtx = ctx.Context{
+			AuthCtx: auth.Context{
+				PermissionOverrides: auth.PermissionOverrides{},
+				Owner: model.User{
+					PrincipalName: "scoped",
+					Roles: model.Roles{
+						{
+							Name:        "Read-Only",
+							Permissions: model.Permissions{auth.Permissions().GraphDBRead, auth.Permissions().AuthManageSelf},
+						},
+					},
+					Unique: model.Unique{
+						ID: uuid.FromStringOrNil("44444444-4444-4444-4444-444444444444"),
+					},
+				},
+			},
+			WorkspaceScoped: true,
+		}
+	)
+	defer mockCtrl.Finish()
+
+	// Routes that do not touch the graph remain available
+	test.Request(t).
+		WithURL("http://example.com/test").
+		WithContext(&scopedCtx).
+		OnHandler(permissionsCheckAllHandler(mockDB, handlerReturn200, auth.Permissions().AuthManageSelf)).
+		Require().
+		ResponseStatusCode(http.StatusOK)
+
+	// Reading the default graph is denied
+	mockDB.EXPECT().AppendAuditLog(gomock.Any(), gomock.Any()).Return(nil)
+	test.Request(t).
+		WithURL("http://example.com/test").
+		WithContext(&scopedCtx).
+		OnHandler(permissionsCheckAllHandler(mockDB, handlerReturn200, auth.Permissions().GraphDBRead)).
+		Require().
+		ResponseStatusCode(http.StatusForbidden)
+
+	// Reading the graph of a selected workspace is allowed
+	scopedCtx.Workspace = &model.Workspace{Serial: model.Serial{ID: 7}}
+	test.Request(t).
+		WithURL("http://example.com/test").
+		WithContext(&scopedCtx).
+		OnHandler(permissionsCheckAllHandler(mockDB, handlerReturn200, auth.Permissions().GraphDBRead)).
+		Require().
+		ResponseStatusCode(http.StatusOK)
+}
+
 func TestPermissionsCheckAtLeastOne(t *testing.T) {
 	var (
 		handlerReturn200 = func(response http.ResponseWriter, request *http.Request) {
@@ -52,6 +52,13 @@ func (s *GzipResponseWriter) Close() error {
 	return s.gw.Close()
 }
 
+// Flush writes any pending compressed data to the wrapped response writer and flushes it to the client.
+func (s *GzipResponseWriter) Flush() {
+	if err := s.gw.Flush(); err == nil {
+		_ = http.NewResponseController(s.ResponseWriter).Flush()
+	}
+}
+
 func CompressionMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(responseWriter http.ResponseWriter, request *http.Request) {
 		var (
@@ -91,6 +91,11 @@ func (s *responseRecorder) WriteHeader(statusCode int) {
 	s.delegate.WriteHeader(statusCode)
 }
 
+// Unwrap returns the wrapped response writer so that an http.ResponseController can reach it.
+func (s *responseRecorder) Unwrap() http.ResponseWriter {
+	return s.delegate
+}
+
 func getSignedRequestDate(request *http.Request) (string, bool) {
 	requestDateHeader := request.Header.Get(headers.RequestDate.String())
 	return requestDateHeader, requestDateHeader != ""
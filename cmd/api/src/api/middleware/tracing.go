@@ -0,0 +1,80 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/specterops/bloodhound/src/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// routeTemplate returns the path template of the route matched to the request, falling back to the request path.
+func routeTemplate(request *http.Request) string {
+	if route := mux.CurrentRoute(request); route != nil {
+		if template, err := route.GetPathTemplate(); err == nil {
+			return template
+		}
+	}
+
+	return request.URL.Path
+}
+
+// TracingMiddleware is a post-routing middleware func that starts a server span for each request. Spans are named
+// after the matched route's path template and continue any trace context propagated by the client.
+func TracingMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+		var (
+			route      = routeTemplate(request)
+			requestCtx = otel.GetTextMapPropagator().Extract(request.Context(), propagation.HeaderCarrier(request.Header))
+
+			tracedResponse = &responseRecorder{
+				delegate: response,
+			}
+		)
+
+		spanCtx, span := tracing.Start(requestCtx, fmt.Sprintf("%s %s", request.Method, route),
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				attribute.String("http.request.method", request.Method),
+				attribute.String("http.route", route),
+				attribute.String("url.path", request.URL.Path),
+			),
+		)
+		defer span.End()
+
+		next.ServeHTTP(tracedResponse, request.WithContext(spanCtx))
+
+		// Handlers that never write to the response are answered with an implicit 200
+		statusCode := tracedResponse.statusCode
+		if statusCode == 0 {
+			statusCode = http.StatusOK
+		}
+
+		span.SetAttributes(attribute.Int("http.response.status_code", statusCode))
+
+		if statusCode >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(statusCode))
+		}
+	})
+}
@@ -0,0 +1,65 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/specterops/bloodhound/src/api/middleware"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracingMiddleware(t *testing.T) {
+	var (
+		recorder       = tracetest.NewSpanRecorder()
+		provider       = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+		previous       = otel.GetTracerProvider()
+		router         = mux.NewRouter()
+		handlerSpanCtx trace.SpanContext
+	)
+
+	otel.SetTracerProvider(provider)
+	defer otel.SetTracerProvider(previous)
+
+	router.Use(middleware.TracingMiddleware)
+	router.HandleFunc("/api/v2/users/{user_id}", func(response http.ResponseWriter, request *http.Request) {
+		handlerSpanCtx = trace.SpanContextFromContext(request.Context())
+		response.WriteHeader(http.StatusInternalServerError)
+	}).Methods(http.MethodGet)
+
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/v2/users/1234", nil))
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+
+	span := spans[0]
+	require.Equal(t, "GET /api/v2/users/{user_id}", span.Name())
+	require.Equal(t, trace.SpanKindServer, span.SpanKind())
+	require.Equal(t, codes.Error, span.Status().Code)
+	require.Equal(t, span.SpanContext().SpanID(), handlerSpanCtx.SpanID())
+	require.Contains(t, span.Attributes(), attribute.String("http.route", "/api/v2/users/{user_id}"))
+	require.Contains(t, span.Attributes(), attribute.Int("http.response.status_code", http.StatusInternalServerError))
+}
@@ -0,0 +1,145 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/specterops/bloodhound/dawgs/graph"
+	"github.com/specterops/bloodhound/headers"
+	"github.com/specterops/bloodhound/src/api"
+	"github.com/specterops/bloodhound/src/auth"
+	"github.com/specterops/bloodhound/src/ctx"
+	"github.com/specterops/bloodhound/src/database"
+	"github.com/specterops/bloodhound/src/services/workspace"
+)
+
+var workspacePathPattern = regexp.MustCompile(`^/workspaces/([^/]+)(/.*)$`)
+
+// WorkspacePathMiddleware is a middleware func that allows clients to select a workspace by prefixing the request path
+// with /workspaces/{workspace_id}. The prefix is removed before routing and the selection is carried forward in the
+// Workspace header.
+func WorkspacePathMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+		if matches := workspacePathPattern.FindStringSubmatch(request.URL.Path); matches != nil {
+			if headerValue := request.Header.Get(headers.Workspace.String()); headerValue != "" && headerValue != matches[1] {
+				api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "Workspace header does not match the workspace selected by the request path.", request), response)
+				return
+			}
+
+			request.Header.Set(headers.Workspace.String(), matches[1])
+			request.URL.Path = matches[2]
+			request.URL.RawPath = ""
+		}
+
+		next.ServeHTTP(response, request)
+	})
+}
+
+// WorkspaceMiddleware is a middleware func generator that returns a http.Handler which resolves the workspace selected
+// by the Workspace header. Graph operations issued with the request context are directed at the workspace's graph
+// namespace.
+//
+// Actors must either be granted access to the selected workspace or hold the permission "permission://auth/ManageUsers."
+// Requests that do not select a workspace operate on the default graph. Users that have been granted access to any
+// workspace are scoped to their workspaces and are denied the graph permissions of routes on the default graph.
+func WorkspaceMiddleware(db database.WorkspaceData, graphDB graph.Database, authorizer auth.Authorizer) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+			bhCtx := ctx.FromRequest(request)
+
+			headerValue := request.Header.Get(headers.Workspace.String())
+			if headerValue == "" {
+				if !bhCtx.AuthCtx.Authenticated() {
+					next.ServeHTTP(response, request)
+				} else if scoped, err := isWorkspaceScoped(request, db, authorizer); err != nil {
+					api.HandleDatabaseError(request, response, err)
+				} else {
+					bhCtx.WorkspaceScoped = scoped
+					next.ServeHTTP(response, request)
+				}
+
+				return
+			}
+
+			if workspaceID, err := strconv.ParseInt(headerValue, 10, 32); err != nil {
+				api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("Workspace header has an invalid value: %s", headerValue), request), response)
+			} else if !bhCtx.AuthCtx.Authenticated() {
+				api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusUnauthorized, "not authenticated", request), response)
+			} else if !workspace.IsSupported(graphDB) {
+				api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, workspace.ErrUnsupportedGraphDriver.Error(), request), response)
+			} else if authorized, err := hasWorkspaceAccess(request, db, authorizer, int32(workspaceID)); err != nil {
+				api.HandleDatabaseError(request, response, err)
+			} else if !authorized {
+				authorizer.AuditLogUnauthorizedAccess(request)
+				api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusForbidden, "not authorized for the selected workspace", request), response)
+			} else if selectedWorkspace, err := db.GetWorkspace(request.Context(), int32(workspaceID)); err != nil {
+				api.HandleDatabaseError(request, response, err)
+			} else {
+				bhCtx.Workspace = &selectedWorkspace
+				next.ServeHTTP(response, request.WithContext(workspace.WithTarget(request.Context(), selectedWorkspace)))
+			}
+		})
+	}
+}
+
+// RejectWorkspaceMiddleware is a middleware func generator that returns a http.Handler which rejects requests that
+// select a workspace. It guards routes backed by application data that is shared by all graphs and therefore can not be
+// scoped to a workspace.
+func RejectWorkspaceMiddleware() mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+			if request.Header.Get(headers.Workspace.String()) != "" {
+				api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "this endpoint does not support workspace selection", request), response)
+			} else {
+				next.ServeHTTP(response, request)
+			}
+		})
+	}
+}
+
+func hasWorkspaceAccess(request *http.Request, db database.WorkspaceData, authorizer auth.Authorizer, workspaceID int32) (bool, error) {
+	bhCtx := ctx.FromRequest(request)
+
+	if authorizer.AllowsPermission(bhCtx.AuthCtx, auth.Permissions().AuthManageUsers) {
+		return true, nil
+	} else if user, isUser := auth.GetUserFromAuthCtx(bhCtx.AuthCtx); !isUser {
+		return false, nil
+	} else {
+		return db.HasWorkspaceAccess(request.Context(), workspaceID, user.ID)
+	}
+}
+
+// isWorkspaceScoped returns true if the actor of the request is a user that has been granted access to at least one
+// workspace without holding the permission "permission://auth/ManageUsers." Such users may not access the default graph.
+func isWorkspaceScoped(request *http.Request, db database.WorkspaceData, authorizer auth.Authorizer) (bool, error) {
+	bhCtx := ctx.FromRequest(request)
+
+	if authorizer.AllowsPermission(bhCtx.AuthCtx, auth.Permissions().AuthManageUsers) {
+		return false, nil
+	} else if user, isUser := auth.GetUserFromAuthCtx(bhCtx.AuthCtx); !isUser {
+		return false, nil
+	} else if workspaces, err := db.GetWorkspacesForUser(request.Context(), user.ID); err != nil {
+		return false, err
+	} else {
+		return len(workspaces) > 0, nil
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/specterops/bloodhound/dawgs"
+	"github.com/specterops/bloodhound/dawgs/drivers/memory"
+	"github.com/specterops/bloodhound/dawgs/graph"
+	"github.com/specterops/bloodhound/headers"
+	"github.com/specterops/bloodhound/src/auth"
+	"github.com/specterops/bloodhound/src/ctx"
+	dbmocks "github.com/specterops/bloodhound/src/database/mocks"
+	"github.com/specterops/bloodhound/src/model"
+	"github.com/specterops/bloodhound/src/utils/test"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestWorkspacePathMiddleware(t *testing.T) {
+	var selectedPath, selectedWorkspace string
+
+	handler := WorkspacePathMiddleware(http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+		selectedPath = request.URL.Path
+		selectedWorkspace = request.Header.Get(headers.Workspace.String())
+		response.WriteHeader(http.StatusOK)
+	}))
+
+	test.Request(t).
+		WithURL("http://example.com/workspaces/7/api/v2/graph-search").
+		WithContext(&ctx.Context{}).
+		OnHandler(handler).
+		Require().
+		ResponseStatusCode(http.StatusOK)
+
+	require.Equal(t, "/api/v2/graph-search", selectedPath)
+	require.Equal(t, "7", selectedWorkspace)
+
+	test.Request(t).
+		WithURL("http://example.com/workspaces/7/api/v2/graph-search").
+		WithHeader(headers.Workspace.String(), "8").
+		WithContext(&ctx.Context{}).
+		OnHandler(handler).
+		Require().
+		ResponseStatusCode(http.StatusBadRequest)
+}
+
+func TestWorkspaceMiddleware(t *testing.T) {
+	var (
+		mockCtrl    = gomock.NewController(t)
+		mockDB      = dbmocks.NewMockDatabase(mockCtrl)
+		memoryDB, _ = dawgs.Open(context.Background(), memory.DriverName, dawgs.Config{})
+		graphDB     = graph.NewDatabaseSwitch(context.Background(), memoryDB)
+		user        = model.User{
+			Unique: model.Unique{
+				ID: uuid.FromStringOrNil("22222222-2222-2222-2222-222222222222"),
+			},
+		}
+		userCtx = ctx.Context{
+			AuthCtx: auth.Context{
+				PermissionOverrides: auth.PermissionOverrides{},
+				Owner:               user,
+			},
+		}
+		selectedWorkspace = model.Workspace{
+			Name:   "engagement",
+			Serial: model.Serial{ID: 7},
+		}
+
+		targetGraph graph.Graph
+		hasTarget   bool
+		handler     = WorkspaceMiddleware(mockDB, graphDB, auth.NewAuthorizer(mockDB))(http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+			targetGraph, hasTarget = graph.GraphTargetFromContext(request.Context())
+			response.WriteHeader(http.StatusOK)
+		}))
+	)
+	defer mockCtrl.Finish()
+
+	// Requests without a workspace selection target the default graph
+	mockDB.EXPECT().GetWorkspacesForUser(gomock.Any(), user.ID).Return(model.Workspaces{}, nil)
+	test.Request(t).
+		WithURL("http://example.com/test").
+		WithContext(&userCtx).
+		OnHandler(handler).
+		Require().
+		ResponseStatusCode(http.StatusOK)
+
+	require.False(t, hasTarget)
+	require.False(t, userCtx.WorkspaceScoped)
+
+	// Users granted access to a workspace are scoped to their workspaces
+	mockDB.EXPECT().GetWorkspacesForUser(gomock.Any(), user.ID).Return(model.Workspaces{selectedWorkspace}, nil)
+	test.Request(t).
+		WithURL("http://example.com/test").
+		WithContext(&userCtx).
+		OnHandler(handler).
+		Require().
+		ResponseStatusCode(http.StatusOK)
+
+	require.False(t, hasTarget)
+	require.True(t, userCtx.WorkspaceScoped)
+
+	test.Request(t).
+		WithURL("http://example.com/test").
+		WithHeader(headers.Workspace.String(), "engagement").
+		WithContext(&userCtx).
+		OnHandler(handler).
+		Require().
+		ResponseStatusCode(http.StatusBadRequest)
+
+	test.Request(t).
+		WithURL("http://example.com/test").
+		WithHeader(headers.Workspace.String(), "7").
+		WithContext(&ctx.Context{}).
+		OnHandler(handler).
+		Require().
+		ResponseStatusCode(http.StatusUnauthorized)
+
+	mockDB.EXPECT().HasWorkspaceAccess(gomock.Any(), int32(7), user.ID).Return(false, nil)
+	mockDB.EXPECT().AppendAuditLog(gomock.Any(), gomock.Any()).Return(nil)
+	test.Request(t).
+		WithURL("http://example.com/test").
+		WithHeader(headers.Workspace.String(), "7").
+		WithContext(&userCtx).
+		OnHandler(handler).
+		Require().
+		ResponseStatusCode(http.StatusForbidden)
+
+	mockDB.EXPECT().HasWorkspaceAccess(gomock.Any(), int32(7), user.ID).Return(true, nil)
+	mockDB.EXPECT().GetWorkspace(gomock.Any(), int32(7)).Return(selectedWorkspace, nil)
+	test.Request(t).
+		WithURL("http://example.com/test").
+		WithHeader(headers.Workspace.String(), "7").
+		WithContext(&userCtx).
+		OnHandler(handler).
+		Require().
+		ResponseStatusCode(http.StatusOK)
+
+	require.True(t, hasTarget)
+	require.Equal(t, selectedWorkspace.GraphName(), targetGraph.Name)
+	require.Equal(t, selectedWorkspace.ID, userCtx.Workspace.ID)
+}
+
+func TestRejectWorkspaceMiddleware(t *testing.T) {
+	handler := RejectWorkspaceMiddleware()(http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+		response.WriteHeader(http.StatusOK)
+	}))
+
+	test.Request(t).
+		WithURL("http://example.com/api/v2/asset-groups").
+		WithContext(&ctx.Context{}).
+		OnHandler(handler).
+		Require().
+		ResponseStatusCode(http.StatusOK)
+
+	test.Request(t).
+		WithURL("http://example.com/api/v2/asset-groups").
+		WithHeader(headers.Workspace.String(), "7").
+		WithContext(&ctx.Context{}).
+		OnHandler(handler).
+		Require().
+		ResponseStatusCode(http.StatusBadRequest)
+}
@@ -30,13 +30,16 @@ import (
 	"github.com/specterops/bloodhound/src/auth"
 	"github.com/specterops/bloodhound/src/config"
 	"github.com/specterops/bloodhound/src/database"
+	"github.com/specterops/bloodhound/src/events"
 	"github.com/specterops/bloodhound/src/queries"
+	"github.com/specterops/bloodhound/src/services/ingest/storage"
 )
 
 func RegisterFossGlobalMiddleware(routerInst *router.Router, cfg config.Configuration, identityResolver auth.IdentityResolver, authenticator api.Authenticator) {
 	// Set up the middleware stack
 	routerInst.UsePrerouting(middleware.ContextMiddleware)
 	routerInst.UsePrerouting(middleware.CORSMiddleware())
+	routerInst.UsePrerouting(middleware.WorkspacePathMiddleware)
 
 	// Set up logging. This must be done after ContextMiddleware is initialized so the context can be accessed in the log logic
 	if cfg.EnableAPILogging {
@@ -44,6 +47,7 @@ func RegisterFossGlobalMiddleware(routerInst *router.Router, cfg config.Configur
 	}
 
 	routerInst.UsePostrouting(
+		middleware.TracingMiddleware,
 		middleware.PanicHandler,
 		middleware.AuthMiddleware(authenticator),
 		middleware.CompressionMiddleware,
@@ -60,7 +64,12 @@ func RegisterFossRoutes(
 	collectorManifests config.CollectorManifests,
 	authenticator api.Authenticator,
 	authorizer auth.Authorizer,
+	eventBus *events.Bus,
+	ingestStore storage.Store,
 ) {
+	// Resolve the selected workspace once the request has been authenticated
+	routerInst.UsePostrouting(middleware.WorkspaceMiddleware(rdms, graphDB, authorizer))
+
 	router.With(func() mux.MiddlewareFunc {
 		return middleware.DefaultRateLimitMiddleware(rdms)
 	},
@@ -78,6 +87,6 @@ func RegisterFossRoutes(
 		routerInst.PathPrefix("/ui", static.AssetHandler),
 	)
 
-	var resources = v2.NewResources(rdms, graphDB, cfg, apiCache, graphQuery, collectorManifests, authorizer, authenticator)
+	var resources = v2.NewResources(rdms, graphDB, cfg, apiCache, graphQuery, collectorManifests, authorizer, authenticator, eventBus, ingestStore)
 	NewV2API(resources, routerInst)
 }
@@ -90,6 +90,12 @@ func (s *Route) RequireUserId() *Route {
 	return s
 }
 
+// Reject requests that select a workspace, as the route is backed by application data shared by all graphs
+func (s *Route) RejectWorkspace() *Route {
+	s.handler.Use(middleware.RejectWorkspaceMiddleware())
+	return s
+}
+
 func (s *Route) CheckFeatureFlag(db database.Database, flagKey string) *Route {
 	s.handler.Use(middleware.FeatureFlagMiddleware(db, flagKey))
 	return s
@@ -0,0 +1,96 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tools
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/specterops/bloodhound/bhlog/measure"
+	"github.com/specterops/bloodhound/dawgs/dump"
+	"github.com/specterops/bloodhound/dawgs/graph"
+	"github.com/specterops/bloodhound/headers"
+	"github.com/specterops/bloodhound/src/api"
+	"github.com/specterops/bloodhound/src/utils"
+)
+
+// GraphDumpTool exposes streaming dumps and restores of the active graph database.
+type GraphDumpTool struct {
+	graphDB     graph.Database
+	restoreLock *sync.Mutex
+}
+
+func NewGraphDumpTool(graphDB graph.Database) *GraphDumpTool {
+	return &GraphDumpTool{
+		graphDB:     graphDB,
+		restoreLock: &sync.Mutex{},
+	}
+}
+
+// Dump streams a gzipped dump of the graph database as the response body. Once streaming has started the status code
+// can no longer be changed, so errors encountered mid-stream are logged and leave the client with a truncated dump
+// that Restore will reject.
+func (s *GraphDumpTool) Dump(response http.ResponseWriter, request *http.Request) {
+	defer measure.ContextLogAndMeasure(request.Context(), slog.LevelInfo, "Dumping graph database")()
+
+	filename := fmt.Sprintf("bloodhound-graph-%s%s", time.Now().UTC().Format("20060102T150405Z"), dump.FileExtension)
+
+	response.Header().Set(headers.ContentType.String(), dump.MediaType)
+	response.Header().Set(headers.ContentDisposition.String(), fmt.Sprintf(utils.ContentDispositionAttachmentTemplate, filename))
+	response.WriteHeader(http.StatusOK)
+
+	if stats, err := dump.Dump(request.Context(), s.graphDB, response); err != nil {
+		slog.ErrorContext(request.Context(), fmt.Sprintf("Graph dump failed after %d nodes and %d relationships: %v", stats.Nodes, stats.Relationships, err))
+	} else {
+		slog.InfoContext(request.Context(), fmt.Sprintf("Graph dump wrote %d nodes and %d relationships", stats.Nodes, stats.Relationships))
+	}
+}
+
+// Restore reads a gzipped dump from the request body and writes its contents into the graph database.
+func (s *GraphDumpTool) Restore(response http.ResponseWriter, request *http.Request) {
+	defer measure.ContextLogAndMeasure(request.Context(), slog.LevelInfo, "Restoring graph database")()
+
+	if !s.restoreLock.TryLock() {
+		api.WriteJSONResponse(request.Context(), map[string]any{
+			"error": "a graph restore is already in progress",
+		}, http.StatusConflict, response)
+		return
+	}
+
+	defer s.restoreLock.Unlock()
+	defer request.Body.Close()
+
+	if stats, err := dump.Restore(request.Context(), s.graphDB, request.Body); err != nil {
+		statusCode := http.StatusInternalServerError
+
+		if errors.Is(err, dump.ErrInvalidDump) {
+			statusCode = http.StatusBadRequest
+		}
+
+		api.WriteJSONResponse(request.Context(), map[string]any{
+			"error":         err.Error(),
+			"nodes":         stats.Nodes,
+			"relationships": stats.Relationships,
+		}, statusCode, response)
+	} else {
+		api.WriteJSONResponse(request.Context(), stats, http.StatusOK, response)
+	}
+}
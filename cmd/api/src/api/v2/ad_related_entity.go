@@ -26,6 +26,7 @@ import (
 	"github.com/specterops/bloodhound/dawgs/ops"
 	"github.com/specterops/bloodhound/graphschema/ad"
 	"github.com/specterops/bloodhound/src/api"
+	"github.com/specterops/bloodhound/src/api/graphexport"
 	"github.com/specterops/bloodhound/src/model"
 	"github.com/specterops/bloodhound/src/model/appcfg"
 	"github.com/specterops/bloodhound/src/queries"
@@ -36,19 +37,22 @@ import (
 // by the `type` parameter, which can be `list`, `count`, or `graph`.
 // Path delegates are for graphing, list delegates are for listing and counting. Endpoints
 // without a certain delegate do not support that delegate feature.
+//
+// Graph and list results are exported to GraphML, GEXF or DOT instead of JSON when the request's Accept header prefers
+// one of those formats.
 func (s *Resources) handleAdRelatedEntityQuery(response http.ResponseWriter, request *http.Request, queryName string, pathDelegate any, listDelegate any) {
 	if params, err := queries.BuildEntityQueryParams(request, queryName, pathDelegate, listDelegate); err != nil {
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf(api.FmtErrorResponseDetailsBadQueryParameters, err), request), response)
 	} else if entityPanelCachingFlag, err := s.DB.GetFlagByKey(request.Context(), appcfg.FeatureEntityPanelCaching); err != nil {
 		api.HandleDatabaseError(request, response, err)
-	} else if results, count, err := s.GraphQuery.GetADEntityQueryResult(request.Context(), params, entityPanelCachingFlag.Enabled); err != nil {
-		if errors.Is(err, queries.ErrGraphUnsupported) || errors.Is(err, queries.ErrUnsupportedDataType) {
-			api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf(api.FmtErrorResponseDetailsBadQueryParameters, err), request), response)
-		} else if errors.Is(err, ops.ErrGraphQueryMemoryLimit) {
-			api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, "calculating the request results exceeded memory limitations due to the volume of objects involved", request), response)
+	} else if exportFormat, isExport := graphexport.NegotiateFormat(request.Header); isExport {
+		if exported, err := s.GraphQuery.GetADEntityQueryExport(request.Context(), params, entityPanelCachingFlag.Enabled); err != nil {
+			writeEntityQueryError(response, request, err)
 		} else {
-			api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, "an unknown error occurred during the request", request), response)
+			api.WriteGraphExportResponse(request.Context(), exported, exportFormat, http.StatusOK, response)
 		}
+	} else if results, count, err := s.GraphQuery.GetADEntityQueryResult(request.Context(), params, entityPanelCachingFlag.Enabled); err != nil {
+		writeEntityQueryError(response, request, err)
 	} else if params.RequestedType == model.DataTypeGraph {
 		api.WriteJSONResponse(request.Context(), results, http.StatusOK, response)
 	} else {
@@ -56,6 +60,16 @@ func (s *Resources) handleAdRelatedEntityQuery(response http.ResponseWriter, req
 	}
 }
 
+func writeEntityQueryError(response http.ResponseWriter, request *http.Request, err error) {
+	if errors.Is(err, queries.ErrGraphUnsupported) || errors.Is(err, queries.ErrUnsupportedDataType) {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf(api.FmtErrorResponseDetailsBadQueryParameters, err), request), response)
+	} else if errors.Is(err, ops.ErrGraphQueryMemoryLimit) {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, "calculating the request results exceeded memory limitations due to the volume of objects involved", request), response)
+	} else {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, "an unknown error occurred during the request", request), response)
+	}
+}
+
 func (s *Resources) ListADUserSessions(response http.ResponseWriter, request *http.Request) {
 	s.handleAdRelatedEntityQuery(response, request, "ListADUserSessions", adAnalysis.FetchUserSessionPaths, adAnalysis.FetchUserSessions)
 }
@@ -22,6 +22,9 @@ import (
 	"testing"
 
 	"github.com/specterops/bloodhound/dawgs/ops"
+	"github.com/specterops/bloodhound/headers"
+	"github.com/specterops/bloodhound/mediatypes"
+	"github.com/specterops/bloodhound/src/api/graphexport"
 	v2 "github.com/specterops/bloodhound/src/api/v2"
 	"github.com/specterops/bloodhound/src/api/v2/apitest"
 	dbMocks "github.com/specterops/bloodhound/src/database/mocks"
@@ -146,6 +149,27 @@ func setupCases(mockGraph *graphMocks.MockGraph, mockDB *dbMocks.MockDatabase) [
 				apitest.BodyNotContains(output, "count")
 			},
 		},
+		{
+			Name: "GraphDBGetADEntityQueryExportGraphML",
+			Input: func(input *apitest.Input) {
+				apitest.SetURLVar(input, "object_id", "1")
+				apitest.AddQueryParam(input, "type", "graph")
+				apitest.SetHeader(input, headers.Accept.String(), mediatypes.ApplicationGraphmlXml.String())
+			},
+			Setup: func() {
+				mockGraph.EXPECT().
+					GetADEntityQueryExport(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(graphexport.Graph{Nodes: []graphexport.Node{{ID: "1", Label: "USER@TESTLAB.LOCAL"}}}, nil)
+				mockDB.EXPECT().
+					GetFlagByKey(gomock.Any(), "entity_panel_cache").
+					Return(appcfg.FeatureFlag{Enabled: true}, nil)
+			},
+			Test: func(output apitest.Output) {
+				apitest.StatusCode(output, http.StatusOK)
+				apitest.BodyContains(output, "<graphml")
+				apitest.BodyContains(output, "USER@TESTLAB.LOCAL")
+			},
+		},
 		{
 			Name: "Success",
 			Input: func(input *apitest.Input) {
@@ -29,7 +29,10 @@ import (
 	"github.com/specterops/bloodhound/src/model/appcfg"
 )
 
-const ErrAnalysisScheduledMode = "analysis is configured to run on a schedule, unable to run just in time"
+const (
+	ErrAnalysisScheduledMode = "analysis is configured to run on a schedule, unable to run just in time"
+	ErrAnalysisNotRunning    = "analysis is not running, unable to cancel"
+)
 
 func (s Resources) GetAnalysisRequest(response http.ResponseWriter, request *http.Request) {
 	if analRequest, err := s.DB.GetAnalysisRequest(request.Context()); err != nil && !errors.Is(err, sql.ErrNoRows) {
@@ -61,3 +64,19 @@ func (s Resources) RequestAnalysis(response http.ResponseWriter, request *http.R
 
 	response.WriteHeader(http.StatusAccepted)
 }
+
+// CancelAnalysis requests cancellation of the in-flight analysis. The datapipe stops analysis once it observes the
+// request, so the response is returned before analysis has stopped.
+func (s Resources) CancelAnalysis(response http.ResponseWriter, request *http.Request) {
+	defer measure.ContextMeasure(request.Context(), slog.LevelDebug, "Canceling analysis")()
+
+	if user, isUser := auth.GetUserFromAuthCtx(ctx.FromRequest(request).AuthCtx); !isUser {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusUnauthorized, api.ErrorResponseDetailsAuthenticationInvalid, request), response)
+	} else if requested, err := s.DB.RequestAnalysisCancellation(request.Context(), user.ID.String()); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if !requested {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusConflict, ErrAnalysisNotRunning, request), response)
+	} else {
+		response.WriteHeader(http.StatusAccepted)
+	}
+}
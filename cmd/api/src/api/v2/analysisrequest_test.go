@@ -17,12 +17,14 @@
 package v2_test
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
 	"time"
 
 	v2 "github.com/specterops/bloodhound/src/api/v2"
+	"github.com/specterops/bloodhound/src/api/v2/apitest"
 	dbMocks "github.com/specterops/bloodhound/src/database/mocks"
 	"github.com/specterops/bloodhound/src/model"
 	"github.com/specterops/bloodhound/src/utils/test"
@@ -70,3 +72,62 @@ func TestResources_GetAnalysisRequest(t *testing.T) {
 			ResponseStatusCode(http.StatusInternalServerError)
 	})
 }
+
+func TestResources_CancelAnalysis(t *testing.T) {
+	var (
+		mockCtrl  = gomock.NewController(t)
+		mockDB    = dbMocks.NewMockDatabase(mockCtrl)
+		resources = v2.Resources{DB: mockDB}
+		user      = setupUser()
+		userCtx   = setupUserCtx(user)
+	)
+	defer mockCtrl.Finish()
+
+	apitest.
+		NewHarness(t, resources.CancelAnalysis).
+		Run([]apitest.Case{
+			{
+				Name: "Unauthorized",
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusUnauthorized)
+				},
+			},
+			{
+				Name: "DatabaseError",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+				},
+				Setup: func() {
+					mockDB.EXPECT().RequestAnalysisCancellation(gomock.Any(), user.ID.String()).Return(false, errors.New("db error"))
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusInternalServerError)
+				},
+			},
+			{
+				Name: "AnalysisNotRunning",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+				},
+				Setup: func() {
+					mockDB.EXPECT().RequestAnalysisCancellation(gomock.Any(), user.ID.String()).Return(false, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusConflict)
+					apitest.BodyContains(output, v2.ErrAnalysisNotRunning)
+				},
+			},
+			{
+				Name: "Success",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+				},
+				Setup: func() {
+					mockDB.EXPECT().RequestAnalysisCancellation(gomock.Any(), user.ID.String()).Return(true, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusAccepted)
+				},
+			},
+		})
+}
@@ -0,0 +1,64 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/specterops/bloodhound/src/api"
+	"github.com/specterops/bloodhound/src/model"
+)
+
+// ListAnalysisRuns returns a page of the recorded analysis runs, most recent first unless sorted otherwise.
+func (s Resources) ListAnalysisRuns(response http.ResponseWriter, request *http.Request) {
+	var (
+		queryParams   = request.URL.Query()
+		sortByColumns = queryParams[api.QueryParameterSortBy]
+		order         []string
+		analysisRuns  model.AnalysisRuns
+	)
+
+	for _, column := range sortByColumns {
+		var descending bool
+		if strings.HasPrefix(column, "-") {
+			descending = true
+			column = column[1:]
+		}
+
+		if !analysisRuns.IsSortable(column) {
+			api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsNotSortable, request), response)
+			return
+		}
+
+		if descending {
+			order = append(order, column+" desc")
+		} else {
+			order = append(order, column)
+		}
+	}
+
+	if skip, err := ParseSkipQueryParameter(queryParams, 0); err != nil {
+		api.WriteErrorResponse(request.Context(), ErrBadQueryParameter(request, model.PaginationQueryParameterSkip, err), response)
+	} else if limit, err := ParseLimitQueryParameter(queryParams, 100); err != nil {
+		api.WriteErrorResponse(request.Context(), ErrBadQueryParameter(request, model.PaginationQueryParameterLimit, err), response)
+	} else if analysisRuns, count, err := s.DB.GetAnalysisRuns(request.Context(), skip, limit, strings.Join(order, ", ")); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		api.WriteResponseWrapperWithPagination(request.Context(), analysisRuns, limit, skip, count, http.StatusOK, response)
+	}
+}
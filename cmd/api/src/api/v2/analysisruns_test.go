@@ -0,0 +1,84 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	v2 "github.com/specterops/bloodhound/src/api/v2"
+	"github.com/specterops/bloodhound/src/api/v2/apitest"
+	dbMocks "github.com/specterops/bloodhound/src/database/mocks"
+	"github.com/specterops/bloodhound/src/model"
+	"go.uber.org/mock/gomock"
+)
+
+func TestResources_ListAnalysisRuns(t *testing.T) {
+	var (
+		mockCtrl  = gomock.NewController(t)
+		mockDB    = dbMocks.NewMockDatabase(mockCtrl)
+		resources = v2.Resources{DB: mockDB}
+	)
+	defer mockCtrl.Finish()
+
+	apitest.
+		NewHarness(t, resources.ListAnalysisRuns).
+		Run([]apitest.Case{
+			apitest.NewSortingErrorCase(),
+			{
+				Name: "InvalidSkip",
+				Input: func(input *apitest.Input) {
+					apitest.AddQueryParam(input, model.PaginationQueryParameterSkip, "-1")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+				},
+			},
+			{
+				Name: "DatabaseError",
+				Setup: func() {
+					mockDB.EXPECT().GetAnalysisRuns(gomock.Any(), 0, 100, "").Return(nil, 0, errors.New("db error"))
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusInternalServerError)
+				},
+			},
+			{
+				Name: "Success",
+				Input: func(input *apitest.Input) {
+					apitest.AddQueryParam(input, "sort_by", "-completed_at")
+					apitest.AddQueryParam(input, model.PaginationQueryParameterLimit, "10")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAnalysisRuns(gomock.Any(), 0, 10, "completed_at desc").Return(model.AnalysisRuns{{
+						Trigger:      model.AnalysisRunTriggerUserRequest,
+						Status:       model.AnalysisRunStatusComplete,
+						EdgesCreated: model.AnalysisRunEdgeCounts{"ADCSESC1": 2},
+					}}, 1, nil)
+				},
+				Test: func(output apitest.Output) {
+					var runs model.AnalysisRuns
+
+					apitest.StatusCode(output, http.StatusOK)
+					apitest.UnmarshalData(output, &runs)
+					apitest.Equal(output, 1, len(runs))
+					apitest.Equal(output, int64(2), runs[0].EdgesCreated["ADCSESC1"])
+				},
+			},
+		})
+}
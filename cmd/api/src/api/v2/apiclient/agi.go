@@ -200,3 +200,19 @@ func (s Client) CreateAssetGroupSelector(assetGroupID int32, spec model.AssetGro
 		}
 	}
 }
+
+func (s Client) UpdateAssetGroupSelectors(assetGroupID int32, specs []model.AssetGroupSelectorSpec) (model.UpdatedAssetGroupSelectors, error) {
+	var updatedSelectors model.UpdatedAssetGroupSelectors
+
+	if response, err := s.Request(http.MethodPut, fmt.Sprintf("api/v2/asset-groups/%d/selectors", assetGroupID), nil, specs); err != nil {
+		return updatedSelectors, err
+	} else {
+		defer response.Body.Close()
+
+		if api.IsErrorResponse(response) {
+			return updatedSelectors, ReadAPIError(response)
+		}
+
+		return updatedSelectors, api.ReadAPIV2ResponsePayload(&updatedSelectors, response)
+	}
+}
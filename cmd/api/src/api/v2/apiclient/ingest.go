@@ -123,6 +123,27 @@ func (s Client) SendCompressedFileUploadData(jsonFile io.Reader, id int64) error
 	}
 }
 
+// SendFileUploadStream streams a single file of the given content type to an open file upload job. Unlike the other
+// upload helpers the body is not buffered so the request is not retried on failure.
+func (s Client) SendFileUploadStream(id int64, body io.Reader, contentType string) error {
+	request, err := s.NewRequest(http.MethodPost, fmt.Sprintf("api/v2/file-upload/%d", id), nil, io.NopCloser(body), http.Header{headers.ContentType.String(): []string{contentType}})
+	if err != nil {
+		return fmt.Errorf("failed to create file upload request: %w", err)
+	}
+
+	if response, err := s.Raw(request); err != nil {
+		return fmt.Errorf("failed to send file upload request: %w", err)
+	} else {
+		defer response.Body.Close()
+
+		if api.IsErrorResponse(response) {
+			return ReadAPIError(response)
+		}
+
+		return nil
+	}
+}
+
 func (s Client) CompleteFileUpload(id int64) error {
 	if response, err := s.Request(http.MethodPost, fmt.Sprintf("api/v2/file-upload/%d/end", id), nil, nil); err != nil {
 		return err
@@ -144,6 +165,10 @@ func (s Client) GetDatapipeStatus() (model.DatapipeStatusWrapper, error) {
 	} else {
 		defer response.Body.Close()
 
+		if api.IsErrorResponse(response) {
+			return status, ReadAPIError(response)
+		}
+
 		return status, api.ReadAPIV2ResponsePayload(&status, response)
 	}
 }
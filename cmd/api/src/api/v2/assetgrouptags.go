@@ -18,20 +18,27 @@ package v2
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
 	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/gorilla/mux"
+	"github.com/specterops/bloodhound/analysis"
 	"github.com/specterops/bloodhound/bhlog/measure"
+	"github.com/specterops/bloodhound/dawgs/graph"
+	"github.com/specterops/bloodhound/graphschema/common"
 	"github.com/specterops/bloodhound/src/api"
 	"github.com/specterops/bloodhound/src/auth"
 	"github.com/specterops/bloodhound/src/ctx"
+	"github.com/specterops/bloodhound/src/database"
 	"github.com/specterops/bloodhound/src/database/types/null"
 	"github.com/specterops/bloodhound/src/model"
 	"github.com/specterops/bloodhound/src/queries"
+	"github.com/specterops/bloodhound/src/utils"
 	"github.com/specterops/bloodhound/src/utils/validation"
 )
 
@@ -261,3 +268,294 @@ func (s *Resources) GetAssetGroupTagMemberCountsByKind(response http.ResponseWri
 		api.WriteBasicResponse(request.Context(), data, http.StatusOK, response)
 	}
 }
+
+type getAssetGroupTagsResponse struct {
+	Tags model.AssetGroupTags `json:"tags"`
+}
+
+func (s *Resources) GetAssetGroupTags(response http.ResponseWriter, request *http.Request) {
+	var (
+		tag         = model.AssetGroupTag{}
+		queryFilter = make(model.QueryParameterFilterMap)
+	)
+	defer measure.ContextMeasure(request.Context(), slog.LevelDebug, "Asset Group Tag List")()
+
+	if queryFilters, err := model.NewQueryParameterFilterParser().ParseQueryParameterFilters(request); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsBadQueryParameterFilters, request), response)
+		return
+	} else {
+		for name, filters := range queryFilters {
+			validPredicates, err := api.GetValidFilterPredicatesAsStrings(tag, name)
+			if err != nil {
+				api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("%s: %s", api.ErrorResponseDetailsColumnNotFilterable, name), request), response)
+				return
+			}
+
+			for _, filter := range filters {
+				if !slices.Contains(validPredicates, string(filter.Operator)) {
+					api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("%s: %s %s", api.ErrorResponseDetailsFilterPredicateNotSupported, filter.Name, filter.Operator), request), response)
+					return
+				}
+
+				filter.IsStringData = tag.IsStringColumn(filter.Name)
+				queryFilter.AddFilter(filter)
+			}
+		}
+	}
+
+	if sqlFilter, err := queryFilter.BuildSQLFilter(); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "error building SQL for filter", request), response)
+	} else if tags, err := s.DB.GetAssetGroupTags(request.Context(), sqlFilter); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		api.WriteBasicResponse(request.Context(), getAssetGroupTagsResponse{Tags: tags}, http.StatusOK, response)
+	}
+}
+
+type createAssetGroupTagRequest struct {
+	Type           model.AssetGroupTagType `json:"type"`
+	Name           string                  `json:"name"`
+	Description    string                  `json:"description"`
+	Position       null.Int32              `json:"position"`
+	RequireCertify null.Bool               `json:"require_certify"`
+}
+
+func (s *Resources) CreateAssetGroupTag(response http.ResponseWriter, request *http.Request) {
+	var createRequest createAssetGroupTagRequest
+	defer measure.ContextMeasure(request.Context(), slog.LevelDebug, "Asset Group Tag Create")()
+
+	if actor, isUser := auth.GetUserFromAuthCtx(ctx.FromRequest(request).AuthCtx); !isUser {
+		slog.Error("Unable to get user from auth context")
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, "unknown user", request), response)
+	} else if err := json.NewDecoder(request.Body).Decode(&createRequest); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponsePayloadUnmarshalError, request), response)
+	} else if createRequest.Type != model.AssetGroupTagTypeTier && createRequest.Type != model.AssetGroupTagTypeLabel {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("invalid tag type %d", createRequest.Type), request), response)
+	} else if createRequest.Name == "" {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "name is required", request), response)
+	} else if createRequest.Type == model.AssetGroupTagTypeLabel && (createRequest.Position.Valid || createRequest.RequireCertify.Valid) {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "position and require_certify are limited to tiers only", request), response)
+	} else {
+		// Tiers do not require certification unless requested. Their position is assigned by the database, which places
+		// new tiers at the end of the tier order unless a position is given.
+		if createRequest.Type == model.AssetGroupTagTypeTier && !createRequest.RequireCertify.Valid {
+			createRequest.RequireCertify = null.BoolFrom(false)
+		}
+
+		if tag, err := s.DB.CreateAssetGroupTag(request.Context(), createRequest.Type, actor.ID.String(), createRequest.Name, createRequest.Description, createRequest.Position, createRequest.RequireCertify); errors.Is(err, database.ErrDuplicateAGTagName) {
+			api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusConflict, "a tag with this name already exists", request), response)
+		} else if errors.Is(err, database.ErrInvalidAssetGroupTierPosition) {
+			api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
+		} else if err != nil {
+			api.HandleDatabaseError(request, response, err)
+		} else {
+			api.WriteBasicResponse(request.Context(), getAssetGroupTagResponse{Tag: tag}, http.StatusCreated, response)
+		}
+	}
+}
+
+type updateAssetGroupTagRequest struct {
+	Name           string      `json:"name"`
+	Description    null.String `json:"description"`
+	Position       null.Int32  `json:"position"`
+	RequireCertify null.Bool   `json:"require_certify"`
+}
+
+func (s *Resources) UpdateAssetGroupTag(response http.ResponseWriter, request *http.Request) {
+	var updateRequest updateAssetGroupTagRequest
+	defer measure.ContextMeasure(request.Context(), slog.LevelDebug, "Asset Group Tag Update")()
+
+	if actor, isUser := auth.GetUserFromAuthCtx(ctx.FromRequest(request).AuthCtx); !isUser {
+		slog.Error("Unable to get user from auth context")
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, "unknown user", request), response)
+	} else if tagId, err := strconv.Atoi(mux.Vars(request)[api.URIPathVariableAssetGroupTagID]); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusNotFound, api.ErrorResponseDetailsIDMalformed, request), response)
+	} else if tag, err := s.DB.GetAssetGroupTag(request.Context(), tagId); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if err := json.NewDecoder(request.Body).Decode(&updateRequest); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponsePayloadUnmarshalError, request), response)
+	} else if updateRequest.Name != "" && updateRequest.Name != tag.Name {
+		// The name of a tag determines the kind applied to its members in the graph
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "the name of a tag can not be changed", request), response)
+	} else if tag.Type != model.AssetGroupTagTypeTier && (updateRequest.Position.Valid || updateRequest.RequireCertify.Valid) {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "position and require_certify are limited to tiers only", request), response)
+	} else {
+		if updateRequest.Position.Valid && updateRequest.Position != tag.Position && tag.IsTierZero() {
+			api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusForbidden, "the position of tier zero can not be changed", request), response)
+			return
+		}
+
+		// The position is validated against the other tiers when the tag is updated. Tags updated without a position
+		// keep their current one.
+		tag.Position = updateRequest.Position
+
+		if updateRequest.Description.Valid {
+			tag.Description = updateRequest.Description.String
+		}
+
+		if updateRequest.RequireCertify.Valid {
+			tag.RequireCertify = updateRequest.RequireCertify
+		}
+
+		if tag, err := s.DB.UpdateAssetGroupTag(request.Context(), actor.ID.String(), tag); errors.Is(err, database.ErrInvalidAssetGroupTierPosition) {
+			api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
+		} else if err != nil {
+			api.HandleDatabaseError(request, response, err)
+		} else {
+			api.WriteBasicResponse(request.Context(), getAssetGroupTagResponse{Tag: tag}, http.StatusOK, response)
+		}
+	}
+}
+
+func (s *Resources) DeleteAssetGroupTag(response http.ResponseWriter, request *http.Request) {
+	defer measure.ContextMeasure(request.Context(), slog.LevelDebug, "Asset Group Tag Delete")()
+
+	if actor, isUser := auth.GetUserFromAuthCtx(ctx.FromRequest(request).AuthCtx); !isUser {
+		slog.Error("Unable to get user from auth context")
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, "unknown user", request), response)
+	} else if tagId, err := strconv.Atoi(mux.Vars(request)[api.URIPathVariableAssetGroupTagID]); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusNotFound, api.ErrorResponseDetailsIDMalformed, request), response)
+	} else if tag, err := s.DB.GetAssetGroupTag(request.Context(), tagId); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if tag.IsTierZero() {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusForbidden, "tier zero can not be deleted", request), response)
+	} else if err := s.DB.DeleteAssetGroupTag(request.Context(), actor.ID.String(), tag); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if err := s.GraphQuery.RemoveNodeKind(request.Context(), tag.ToKind()); err != nil {
+		// A new tag of the same name reuses the kind of the deleted tag and must not inherit its members
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		response.WriteHeader(http.StatusNoContent)
+	}
+}
+
+type AssetGroupTagMember struct {
+	ObjectID    string                              `json:"object_id"`
+	Name        string                              `json:"name"`
+	PrimaryKind string                              `json:"primary_kind"`
+	Status      model.AssetGroupCertificationStatus `json:"status"`
+	CertifiedBy null.String                         `json:"certified_by"`
+	CertifiedAt null.Time                           `json:"certified_at"`
+	Note        null.String                         `json:"note"`
+}
+
+type getAssetGroupTagMembersResponse struct {
+	Members []AssetGroupTagMember `json:"members"`
+}
+
+// assetGroupTagMembers joins the members of a tag in the graph with their certifications. Members without a
+// certification record are pending. Members are ordered by object ID.
+func assetGroupTagMembers(nodes graph.NodeSet, certifications model.AssetGroupTagCertifications) []AssetGroupTagMember {
+	var (
+		members                = make([]AssetGroupTagMember, 0, nodes.Len())
+		certificationsByObject = certifications.ByObjectID()
+	)
+
+	for _, node := range nodes {
+		objectId, _ := node.Properties.GetOrDefault(common.ObjectID.String(), "").String()
+		name, _ := node.Properties.GetOrDefault(common.Name.String(), "").String()
+
+		member := AssetGroupTagMember{
+			ObjectID:    objectId,
+			Name:        name,
+			PrimaryKind: analysis.GetNodeKindDisplayLabel(node),
+			Status:      model.AssetGroupCertificationStatusPending,
+		}
+
+		if certification, found := certificationsByObject[objectId]; found {
+			member.Status = certification.Status
+			member.CertifiedBy = certification.CertifiedBy
+			member.CertifiedAt = certification.CertifiedAt
+			member.Note = certification.Note
+		}
+
+		members = append(members, member)
+	}
+
+	slices.SortFunc(members, func(a, b AssetGroupTagMember) int {
+		return strings.Compare(a.ObjectID, b.ObjectID)
+	})
+
+	return members
+}
+
+func (s *Resources) GetAssetGroupTagMembers(response http.ResponseWriter, request *http.Request) {
+	var (
+		queryParams = request.URL.Query()
+		status      = model.AssetGroupCertificationStatus(queryParams.Get("status"))
+	)
+	defer measure.ContextMeasure(request.Context(), slog.LevelDebug, "Asset Group Tag Get Members")()
+
+	if tagId, err := strconv.Atoi(mux.Vars(request)[api.URIPathVariableAssetGroupTagID]); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusNotFound, api.ErrorResponseDetailsIDMalformed, request), response)
+	} else if status != "" && !status.IsValid() {
+		api.WriteErrorResponse(request.Context(), ErrBadQueryParameter(request, "status", fmt.Errorf("invalid certification status %s", status)), response)
+	} else if skip, err := ParseSkipQueryParameter(queryParams, 0); err != nil {
+		api.WriteErrorResponse(request.Context(), ErrBadQueryParameter(request, model.PaginationQueryParameterSkip, err), response)
+	} else if limit, err := ParseLimitQueryParameter(queryParams, 100); err != nil {
+		api.WriteErrorResponse(request.Context(), ErrBadQueryParameter(request, model.PaginationQueryParameterLimit, err), response)
+	} else if tag, err := s.DB.GetAssetGroupTag(request.Context(), tagId); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if nodes, err := s.GraphQuery.GetNodesByKind(request.Context(), tag.ToKind()); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if certifications, err := s.DB.GetAssetGroupTagCertifications(request.Context(), tag.ID); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		members := assetGroupTagMembers(nodes, certifications)
+
+		if status != "" {
+			members = slices.DeleteFunc(members, func(member AssetGroupTagMember) bool {
+				return member.Status != status
+			})
+		}
+
+		if skip > len(members) {
+			api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf(utils.ErrorInvalidSkip, "value must be less than total count"), request), response)
+			return
+		}
+
+		endIndex := min(skip+limit, len(members))
+		api.WriteResponseWrapperWithPagination(request.Context(), getAssetGroupTagMembersResponse{Members: members[skip:endIndex]}, limit, skip, len(members), http.StatusOK, response)
+	}
+}
+
+type updateAssetGroupTagCertificationRequest struct {
+	Status model.AssetGroupCertificationStatus `json:"status"`
+	Note   null.String                         `json:"note"`
+}
+
+func (s *Resources) UpdateAssetGroupTagMemberCertification(response http.ResponseWriter, request *http.Request) {
+	var (
+		updateRequest updateAssetGroupTagCertificationRequest
+		objectId      = mux.Vars(request)[api.URIPathVariableObjectID]
+	)
+	defer measure.ContextMeasure(request.Context(), slog.LevelDebug, "Asset Group Tag Member Certification Update")()
+
+	if actor, isUser := auth.GetUserFromAuthCtx(ctx.FromRequest(request).AuthCtx); !isUser {
+		slog.Error("Unable to get user from auth context")
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, "unknown user", request), response)
+	} else if tagId, err := strconv.Atoi(mux.Vars(request)[api.URIPathVariableAssetGroupTagID]); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusNotFound, api.ErrorResponseDetailsIDMalformed, request), response)
+	} else if err := json.NewDecoder(request.Body).Decode(&updateRequest); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponsePayloadUnmarshalError, request), response)
+	} else if !updateRequest.Status.IsValid() {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("invalid certification status %s", updateRequest.Status), request), response)
+	} else if tag, err := s.DB.GetAssetGroupTag(request.Context(), tagId); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if tag.Type != model.AssetGroupTagTypeTier || !tag.RequireCertify.Bool {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "certification is only supported for tiers that require certification", request), response)
+	} else if nodes, err := s.GraphQuery.FetchNodesByObjectIDsAndKinds(request.Context(), graph.Kinds{tag.ToKind()}, objectId); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if nodes.Len() == 0 {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusNotFound, api.ErrorResponseDetailsResourceNotFound, request), response)
+	} else if certification, err := s.DB.UpdateAssetGroupTagCertification(request.Context(), actor.ID.String(), model.AssetGroupTagCertification{
+		AssetGroupTagId: tag.ID,
+		ObjectID:        objectId,
+		Status:          updateRequest.Status,
+		Note:            updateRequest.Note,
+	}); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		api.WriteBasicResponse(request.Context(), certification, http.StatusOK, response)
+	}
+}
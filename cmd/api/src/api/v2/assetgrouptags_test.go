@@ -28,7 +28,9 @@ import (
 
 	uuid2 "github.com/gofrs/uuid"
 	"github.com/gorilla/mux"
+	"github.com/specterops/bloodhound/dawgs/graph"
 	"github.com/specterops/bloodhound/graphschema/ad"
+	"github.com/specterops/bloodhound/graphschema/common"
 	"github.com/specterops/bloodhound/headers"
 	"github.com/specterops/bloodhound/mediatypes"
 	"github.com/specterops/bloodhound/src/api"
@@ -809,3 +811,638 @@ func TestResources_GetAssetGroupTagSelectorsByTagId(t *testing.T) {
 			},
 		})
 }
+
+func TestResources_GetAssetGroupTags(t *testing.T) {
+	var (
+		mockCtrl      = gomock.NewController(t)
+		mockDB        = mocks_db.NewMockDatabase(mockCtrl)
+		resourcesInst = v2.Resources{
+			DB: mockDB,
+		}
+		tags = model.AssetGroupTags{
+			{ID: 1, Type: model.AssetGroupTagTypeTier, Name: "Tier Zero", Position: null.Int32From(1)},
+			{ID: 2, Type: model.AssetGroupTagTypeLabel, Name: "Owned"},
+		}
+	)
+
+	defer mockCtrl.Finish()
+
+	apitest.
+		NewHarness(t, resourcesInst.GetAssetGroupTags).
+		Run([]apitest.Case{
+			{
+				Name: "ColumnNotFilterable",
+				Input: func(input *apitest.Input) {
+					apitest.AddQueryParam(input, "kind_id", "eq:1")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, api.ErrorResponseDetailsColumnNotFilterable)
+				},
+			},
+			{
+				Name: "FilterPredicateNotSupported",
+				Input: func(input *apitest.Input) {
+					apitest.AddQueryParam(input, "require_certify", "gt:true")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, api.ErrorResponseDetailsFilterPredicateNotSupported)
+				},
+			},
+			{
+				Name: "DatabaseError",
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTags(gomock.Any(), model.SQLFilter{}).Return(nil, errors.New("entity not found"))
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusInternalServerError)
+				},
+			},
+			{
+				Name: "Success",
+				Input: func(input *apitest.Input) {
+					apitest.AddQueryParam(input, "name", "~eq:tier")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTags(gomock.Any(), model.SQLFilter{SQLString: "name ILIKE ?", Params: []any{"%tier%"}}).Return(tags, nil)
+				},
+				Test: func(output apitest.Output) {
+					var result struct {
+						Tags model.AssetGroupTags `json:"tags"`
+					}
+
+					apitest.StatusCode(output, http.StatusOK)
+					apitest.UnmarshalData(output, &result)
+					apitest.Equal(output, len(tags), len(result.Tags))
+					apitest.Equal(output, tags[0].Name, result.Tags[0].Name)
+				},
+			},
+		})
+}
+
+func TestResources_CreateAssetGroupTag(t *testing.T) {
+	var (
+		mockCtrl      = gomock.NewController(t)
+		mockDB        = mocks_db.NewMockDatabase(mockCtrl)
+		resourcesInst = v2.Resources{
+			DB: mockDB,
+		}
+		user    = setupUser()
+		userCtx = setupUserCtx(user)
+	)
+
+	defer mockCtrl.Finish()
+
+	apitest.
+		NewHarness(t, resourcesInst.CreateAssetGroupTag).
+		Run([]apitest.Case{
+			{
+				Name: "InvalidType",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.BodyString(input, `{"type": 3, "name": "test"}`)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "invalid tag type")
+				},
+			},
+			{
+				Name: "MissingName",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.BodyString(input, `{"type": 2}`)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "name is required")
+				},
+			},
+			{
+				Name: "LabelWithPosition",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.BodyString(input, `{"type": 2, "name": "test", "position": 2}`)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "limited to tiers only")
+				},
+			},
+			{
+				Name: "TierZeroPosition",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.BodyString(input, `{"type": 1, "name": "test", "position": 1}`)
+				},
+				Setup: func() {
+					mockDB.EXPECT().CreateAssetGroupTag(gomock.Any(), model.AssetGroupTagTypeTier, user.ID.String(), "test", "", null.Int32From(1), null.BoolFrom(false)).
+						Return(model.AssetGroupTag{}, fmt.Errorf("%w: position must be greater than 1", database.ErrInvalidAssetGroupTierPosition))
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "position must be greater than 1")
+				},
+			},
+			{
+				Name: "PositionOutOfRange",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.BodyString(input, `{"type": 1, "name": "test", "position": 5}`)
+				},
+				Setup: func() {
+					mockDB.EXPECT().CreateAssetGroupTag(gomock.Any(), model.AssetGroupTagTypeTier, user.ID.String(), "test", "", null.Int32From(5), null.BoolFrom(false)).
+						Return(model.AssetGroupTag{}, fmt.Errorf("%w: position must not be greater than 3", database.ErrInvalidAssetGroupTierPosition))
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "position must not be greater than 3")
+				},
+			},
+			{
+				Name: "DuplicateName",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.BodyString(input, `{"type": 2, "name": "Owned"}`)
+				},
+				Setup: func() {
+					mockDB.EXPECT().CreateAssetGroupTag(gomock.Any(), model.AssetGroupTagTypeLabel, user.ID.String(), "Owned", "", null.Int32{}, null.Bool{}).
+						Return(model.AssetGroupTag{}, database.ErrDuplicateAGTagName)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusConflict)
+				},
+			},
+			{
+				Name: "TierAppendedToEnd",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.BodyString(input, `{"type": 1, "name": "Tier Two", "description": "second tier"}`)
+				},
+				Setup: func() {
+					mockDB.EXPECT().CreateAssetGroupTag(gomock.Any(), model.AssetGroupTagTypeTier, user.ID.String(), "Tier Two", "second tier", null.Int32{}, null.BoolFrom(false)).
+						Return(model.AssetGroupTag{ID: 3, Type: model.AssetGroupTagTypeTier, Name: "Tier Two", Position: null.Int32From(3), RequireCertify: null.BoolFrom(false)}, nil)
+				},
+				Test: func(output apitest.Output) {
+					var result struct {
+						Tag model.AssetGroupTag `json:"tag"`
+					}
+
+					apitest.StatusCode(output, http.StatusCreated)
+					apitest.UnmarshalData(output, &result)
+					apitest.Equal(output, null.Int32From(3), result.Tag.Position)
+				},
+			},
+			{
+				Name: "TierInserted",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.BodyString(input, `{"type": 1, "name": "Tier Half", "position": 2, "require_certify": true}`)
+				},
+				Setup: func() {
+					mockDB.EXPECT().CreateAssetGroupTag(gomock.Any(), model.AssetGroupTagTypeTier, user.ID.String(), "Tier Half", "", null.Int32From(2), null.BoolFrom(true)).
+						Return(model.AssetGroupTag{ID: 3, Type: model.AssetGroupTagTypeTier, Name: "Tier Half", Position: null.Int32From(2), RequireCertify: null.BoolFrom(true)}, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusCreated)
+				},
+			},
+		})
+}
+
+func TestResources_UpdateAssetGroupTag(t *testing.T) {
+	var (
+		mockCtrl      = gomock.NewController(t)
+		mockDB        = mocks_db.NewMockDatabase(mockCtrl)
+		resourcesInst = v2.Resources{
+			DB: mockDB,
+		}
+		user     = setupUser()
+		userCtx  = setupUserCtx(user)
+		tierZero = model.AssetGroupTag{ID: 1, Type: model.AssetGroupTagTypeTier, Name: "Tier Zero", Position: null.Int32From(1), RequireCertify: null.BoolFrom(false)}
+		tierOne  = model.AssetGroupTag{ID: 2, Type: model.AssetGroupTagTypeTier, Name: "Tier One", Position: null.Int32From(2), RequireCertify: null.BoolFrom(false)}
+		tierTwo  = model.AssetGroupTag{ID: 3, Type: model.AssetGroupTagTypeTier, Name: "Tier Two", Position: null.Int32From(3), RequireCertify: null.BoolFrom(false)}
+		label    = model.AssetGroupTag{ID: 4, Type: model.AssetGroupTagTypeLabel, Name: "Owned"}
+	)
+
+	defer mockCtrl.Finish()
+
+	apitest.
+		NewHarness(t, resourcesInst.UpdateAssetGroupTag).
+		WithCommonRequest(func(input *apitest.Input) {
+			apitest.SetContext(input, userCtx)
+		}).
+		Run([]apitest.Case{
+			{
+				Name: "MalformedID",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "one")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNotFound)
+					apitest.BodyContains(output, api.ErrorResponseDetailsIDMalformed)
+				},
+			},
+			{
+				Name: "NotFound",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1234")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 1234).Return(model.AssetGroupTag{}, database.ErrNotFound)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNotFound)
+				},
+			},
+			{
+				Name: "RenameRejected",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "2")
+					apitest.BodyString(input, `{"name": "Tier Uno"}`)
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 2).Return(tierOne, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "can not be changed")
+				},
+			},
+			{
+				Name: "LabelPosition",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "4")
+					apitest.BodyString(input, `{"require_certify": true}`)
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 4).Return(label, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "limited to tiers only")
+				},
+			},
+			{
+				Name: "MoveTierZero",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+					apitest.BodyString(input, `{"position": 2}`)
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 1).Return(tierZero, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusForbidden)
+				},
+			},
+			{
+				Name: "MoveToTierZeroPosition",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "2")
+					apitest.BodyString(input, `{"position": 1}`)
+				},
+				Setup: func() {
+					moved := tierOne
+					moved.Position = null.Int32From(1)
+
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 2).Return(tierOne, nil)
+					mockDB.EXPECT().UpdateAssetGroupTag(gomock.Any(), user.ID.String(), moved).
+						Return(model.AssetGroupTag{}, fmt.Errorf("%w: position must be greater than 1", database.ErrInvalidAssetGroupTierPosition))
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "position must be greater than 1")
+				},
+			},
+			{
+				Name: "MovePastEnd",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "2")
+					apitest.BodyString(input, `{"position": 4}`)
+				},
+				Setup: func() {
+					moved := tierOne
+					moved.Position = null.Int32From(4)
+
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 2).Return(tierOne, nil)
+					mockDB.EXPECT().UpdateAssetGroupTag(gomock.Any(), user.ID.String(), moved).
+						Return(model.AssetGroupTag{}, fmt.Errorf("%w: position must not be greater than 3", database.ErrInvalidAssetGroupTierPosition))
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "position must not be greater than 3")
+				},
+			},
+			{
+				Name: "KeepsPosition",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "3")
+					apitest.BodyString(input, `{"description": "unmoved"}`)
+				},
+				Setup: func() {
+					expected := tierTwo
+					expected.Description = "unmoved"
+					expected.Position = null.Int32{}
+
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 3).Return(tierTwo, nil)
+					mockDB.EXPECT().UpdateAssetGroupTag(gomock.Any(), user.ID.String(), expected).Return(tierTwo, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusOK)
+				},
+			},
+			{
+				Name: "Success",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "2")
+					apitest.BodyString(input, `{"description": "moved", "position": 3, "require_certify": true}`)
+				},
+				Setup: func() {
+					expected := tierOne
+					expected.Description = "moved"
+					expected.Position = null.Int32From(3)
+					expected.RequireCertify = null.BoolFrom(true)
+
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 2).Return(tierOne, nil)
+					mockDB.EXPECT().UpdateAssetGroupTag(gomock.Any(), user.ID.String(), expected).Return(expected, nil)
+				},
+				Test: func(output apitest.Output) {
+					var result struct {
+						Tag model.AssetGroupTag `json:"tag"`
+					}
+
+					apitest.StatusCode(output, http.StatusOK)
+					apitest.UnmarshalData(output, &result)
+					apitest.Equal(output, null.Int32From(3), result.Tag.Position)
+					apitest.Equal(output, "moved", result.Tag.Description)
+				},
+			},
+		})
+}
+
+func TestResources_DeleteAssetGroupTag(t *testing.T) {
+	var (
+		mockCtrl      = gomock.NewController(t)
+		mockDB        = mocks_db.NewMockDatabase(mockCtrl)
+		mockGraph     = mocks_graph.NewMockGraph(mockCtrl)
+		resourcesInst = v2.Resources{
+			DB:         mockDB,
+			GraphQuery: mockGraph,
+		}
+		user     = setupUser()
+		userCtx  = setupUserCtx(user)
+		tierZero = model.AssetGroupTag{ID: 1, Type: model.AssetGroupTagTypeTier, Name: "Tier Zero", Position: null.Int32From(1)}
+		tierOne  = model.AssetGroupTag{ID: 2, Type: model.AssetGroupTagTypeTier, Name: "Tier One", Position: null.Int32From(2)}
+	)
+
+	defer mockCtrl.Finish()
+
+	apitest.
+		NewHarness(t, resourcesInst.DeleteAssetGroupTag).
+		WithCommonRequest(func(input *apitest.Input) {
+			apitest.SetContext(input, userCtx)
+		}).
+		Run([]apitest.Case{
+			{
+				Name: "TierZero",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 1).Return(tierZero, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusForbidden)
+				},
+			},
+			{
+				Name: "DatabaseError",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "2")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 2).Return(tierOne, nil)
+					mockDB.EXPECT().DeleteAssetGroupTag(gomock.Any(), user.ID.String(), tierOne).Return(errors.New("database error"))
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusInternalServerError)
+				},
+			},
+			{
+				Name: "Success",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "2")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 2).Return(tierOne, nil)
+					mockDB.EXPECT().DeleteAssetGroupTag(gomock.Any(), user.ID.String(), tierOne).Return(nil)
+					mockGraph.EXPECT().RemoveNodeKind(gomock.Any(), tierOne.ToKind()).Return(nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNoContent)
+				},
+			},
+		})
+}
+
+func TestResources_GetAssetGroupTagMembers(t *testing.T) {
+	var (
+		mockCtrl      = gomock.NewController(t)
+		mockDB        = mocks_db.NewMockDatabase(mockCtrl)
+		mockGraphDb   = mocks_graph.NewMockGraph(mockCtrl)
+		resourcesInst = v2.Resources{
+			DB:         mockDB,
+			GraphQuery: mockGraphDb,
+		}
+		tierOne     = model.AssetGroupTag{ID: 2, Type: model.AssetGroupTagTypeTier, Name: "Tier One", Position: null.Int32From(2), RequireCertify: null.BoolFrom(true)}
+		certifiedAt = time.Now().UTC()
+		nodes       = graph.NewNodeSet(
+			graph.NewNode(1, graph.AsProperties(map[string]any{common.ObjectID.String(): "B", common.Name.String(): "USER B"}), ad.Entity, ad.User, tierOne.ToKind()),
+			graph.NewNode(2, graph.AsProperties(map[string]any{common.ObjectID.String(): "A", common.Name.String(): "USER A"}), ad.Entity, ad.User, tierOne.ToKind()),
+		)
+		certifications = model.AssetGroupTagCertifications{
+			{AssetGroupTagId: 2, ObjectID: "B", Status: model.AssetGroupCertificationStatusCertified, CertifiedBy: null.StringFrom("certifier"), CertifiedAt: null.TimeFrom(certifiedAt)},
+		}
+	)
+
+	type membersResponse struct {
+		Count int `json:"count"`
+		Skip  int `json:"skip"`
+		Limit int `json:"limit"`
+		Data  struct {
+			Members []v2.AssetGroupTagMember `json:"members"`
+		} `json:"data"`
+	}
+
+	defer mockCtrl.Finish()
+
+	apitest.
+		NewHarness(t, resourcesInst.GetAssetGroupTagMembers).
+		WithCommonRequest(func(input *apitest.Input) {
+			apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "2")
+		}).
+		Run([]apitest.Case{
+			{
+				Name: "InvalidStatus",
+				Input: func(input *apitest.Input) {
+					apitest.AddQueryParam(input, "status", "approved")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+				},
+			},
+			{
+				Name: "GraphError",
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 2).Return(tierOne, nil)
+					mockGraphDb.EXPECT().GetNodesByKind(gomock.Any(), tierOne.ToKind()).Return(nil, errors.New("graph error"))
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusInternalServerError)
+				},
+			},
+			{
+				Name: "Success",
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 2).Return(tierOne, nil)
+					mockGraphDb.EXPECT().GetNodesByKind(gomock.Any(), tierOne.ToKind()).Return(nodes, nil)
+					mockDB.EXPECT().GetAssetGroupTagCertifications(gomock.Any(), 2).Return(certifications, nil)
+				},
+				Test: func(output apitest.Output) {
+					var result membersResponse
+
+					apitest.StatusCode(output, http.StatusOK)
+					apitest.UnmarshalBody(output, &result)
+					apitest.Equal(output, 2, result.Count)
+					apitest.Equal(output, 2, len(result.Data.Members))
+					apitest.Equal(output, "A", result.Data.Members[0].ObjectID)
+					apitest.Equal(output, "User", result.Data.Members[0].PrimaryKind)
+					apitest.Equal(output, model.AssetGroupCertificationStatusPending, result.Data.Members[0].Status)
+					apitest.Equal(output, "B", result.Data.Members[1].ObjectID)
+					apitest.Equal(output, model.AssetGroupCertificationStatusCertified, result.Data.Members[1].Status)
+					apitest.Equal(output, null.StringFrom("certifier"), result.Data.Members[1].CertifiedBy)
+				},
+			},
+			{
+				Name: "StatusFilterAndPaging",
+				Input: func(input *apitest.Input) {
+					apitest.AddQueryParam(input, "status", "pending")
+					apitest.AddQueryParam(input, "limit", "1")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 2).Return(tierOne, nil)
+					mockGraphDb.EXPECT().GetNodesByKind(gomock.Any(), tierOne.ToKind()).Return(nodes, nil)
+					mockDB.EXPECT().GetAssetGroupTagCertifications(gomock.Any(), 2).Return(certifications, nil)
+				},
+				Test: func(output apitest.Output) {
+					var result membersResponse
+
+					apitest.StatusCode(output, http.StatusOK)
+					apitest.UnmarshalBody(output, &result)
+					apitest.Equal(output, 1, result.Count)
+					apitest.Equal(output, 1, len(result.Data.Members))
+					apitest.Equal(output, "A", result.Data.Members[0].ObjectID)
+				},
+			},
+		})
+}
+
+func TestResources_UpdateAssetGroupTagMemberCertification(t *testing.T) {
+	var (
+		mockCtrl      = gomock.NewController(t)
+		mockDB        = mocks_db.NewMockDatabase(mockCtrl)
+		mockGraphDb   = mocks_graph.NewMockGraph(mockCtrl)
+		resourcesInst = v2.Resources{
+			DB:         mockDB,
+			GraphQuery: mockGraphDb,
+		}
+		user      = setupUser()
+		userCtx   = setupUserCtx(user)
+		certified = model.AssetGroupTag{ID: 2, Type: model.AssetGroupTagTypeTier, Name: "Tier One", Position: null.Int32From(2), RequireCertify: null.BoolFrom(true)}
+		unchecked = model.AssetGroupTag{ID: 3, Type: model.AssetGroupTagTypeTier, Name: "Tier Two", Position: null.Int32From(3), RequireCertify: null.BoolFrom(false)}
+		member    = graph.NewNode(1, graph.AsProperties(map[string]any{common.ObjectID.String(): "A"}), ad.Entity, ad.User, certified.ToKind())
+	)
+
+	defer mockCtrl.Finish()
+
+	apitest.
+		NewHarness(t, resourcesInst.UpdateAssetGroupTagMemberCertification).
+		WithCommonRequest(func(input *apitest.Input) {
+			apitest.SetContext(input, userCtx)
+			apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "2")
+			apitest.SetURLVar(input, api.URIPathVariableObjectID, "A")
+		}).
+		Run([]apitest.Case{
+			{
+				Name: "InvalidStatus",
+				Input: func(input *apitest.Input) {
+					apitest.BodyString(input, `{"status": "approved"}`)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "invalid certification status")
+				},
+			},
+			{
+				Name: "CertificationNotRequired",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "3")
+					apitest.BodyString(input, `{"status": "certified"}`)
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 3).Return(unchecked, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "require certification")
+				},
+			},
+			{
+				Name: "NotAMember",
+				Input: func(input *apitest.Input) {
+					apitest.BodyString(input, `{"status": "certified"}`)
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 2).Return(certified, nil)
+					mockGraphDb.EXPECT().FetchNodesByObjectIDsAndKinds(gomock.Any(), graph.Kinds{certified.ToKind()}, "A").Return(graph.NewNodeSet(), nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNotFound)
+				},
+			},
+			{
+				Name: "Success",
+				Input: func(input *apitest.Input) {
+					apitest.BodyString(input, `{"status": "certified", "note": "reviewed"}`)
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 2).Return(certified, nil)
+					mockGraphDb.EXPECT().FetchNodesByObjectIDsAndKinds(gomock.Any(), graph.Kinds{certified.ToKind()}, "A").Return(graph.NewNodeSet(member), nil)
+					mockDB.EXPECT().UpdateAssetGroupTagCertification(gomock.Any(), user.ID.String(), model.AssetGroupTagCertification{
+						AssetGroupTagId: 2,
+						ObjectID:        "A",
+						Status:          model.AssetGroupCertificationStatusCertified,
+						Note:            null.StringFrom("reviewed"),
+					}).Return(model.AssetGroupTagCertification{
+						ID:              1,
+						AssetGroupTagId: 2,
+						ObjectID:        "A",
+						Status:          model.AssetGroupCertificationStatusCertified,
+						CertifiedBy:     null.StringFrom(user.ID.String()),
+						CertifiedAt:     null.TimeFrom(time.Now()),
+						Note:            null.StringFrom("reviewed"),
+					}, nil)
+				},
+				Test: func(output apitest.Output) {
+					var result model.AssetGroupTagCertification
+
+					apitest.StatusCode(output, http.StatusOK)
+					apitest.UnmarshalData(output, &result)
+					apitest.Equal(output, model.AssetGroupCertificationStatusCertified, result.Status)
+					apitest.Equal(output, null.StringFrom(user.ID.String()), result.CertifiedBy)
+				},
+			},
+		})
+}
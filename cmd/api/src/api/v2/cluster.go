@@ -0,0 +1,51 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/specterops/bloodhound/src/api"
+	"github.com/specterops/bloodhound/src/daemons"
+	"github.com/specterops/bloodhound/src/model"
+)
+
+// ClusterStatus describes the API replicas sharing the application database. NodeID identifies the replica that served
+// the request and Leader is the replica running the datapipe, or nil while no replica holds the leader lock.
+type ClusterStatus struct {
+	NodeID string             `json:"node_id"`
+	Leader *model.ClusterNode `json:"leader"`
+	Nodes  model.ClusterNodes `json:"nodes"`
+}
+
+func (s Resources) GetClusterStatus(response http.ResponseWriter, request *http.Request) {
+	if nodes, err := s.DB.GetClusterNodes(request.Context(), time.Now().UTC().Add(-daemons.ClusterNodeTimeout)); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		status := ClusterStatus{
+			NodeID: s.Config.ClusterNodeID(),
+			Nodes:  nodes,
+		}
+
+		if leader, found := nodes.Leader(); found {
+			status.Leader = &leader
+		}
+
+		api.WriteBasicResponse(request.Context(), status, http.StatusOK, response)
+	}
+}
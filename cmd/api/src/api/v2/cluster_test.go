@@ -0,0 +1,89 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	v2 "github.com/specterops/bloodhound/src/api/v2"
+	"github.com/specterops/bloodhound/src/config"
+	dbMocks "github.com/specterops/bloodhound/src/database/mocks"
+	"github.com/specterops/bloodhound/src/model"
+	"github.com/specterops/bloodhound/src/utils/test"
+	"go.uber.org/mock/gomock"
+)
+
+func TestResources_GetClusterStatus(t *testing.T) {
+	const (
+		url = "api/v2/cluster/status"
+	)
+
+	var (
+		mockCtrl  = gomock.NewController(t)
+		mockDB    = dbMocks.NewMockDatabase(mockCtrl)
+		resources = v2.Resources{DB: mockDB, Config: config.Configuration{Cluster: config.ClusterConfiguration{NodeID: "node-a"}}}
+		now       = time.Now().UTC().Truncate(time.Second)
+		leader    = model.ClusterNode{NodeID: "node-b", Hostname: "host-b", IsLeader: true, StartedAt: now, LastHeartbeatAt: now}
+		follower  = model.ClusterNode{NodeID: "node-a", Hostname: "host-a", StartedAt: now, LastHeartbeatAt: now}
+	)
+	defer mockCtrl.Finish()
+
+	t.Run("success getting cluster status", func(t *testing.T) {
+		mockDB.EXPECT().GetClusterNodes(gomock.Any(), gomock.Any()).Return(model.ClusterNodes{follower, leader}, nil)
+
+		test.Request(t).
+			WithMethod(http.MethodGet).
+			WithURL(url).
+			OnHandlerFunc(resources.GetClusterStatus).
+			Require().
+			ResponseJSONBody(v2.ClusterStatus{
+				NodeID: "node-a",
+				Leader: &leader,
+				Nodes:  model.ClusterNodes{follower, leader},
+			}).
+			ResponseStatusCode(http.StatusOK)
+	})
+
+	t.Run("success getting cluster status without a leader", func(t *testing.T) {
+		mockDB.EXPECT().GetClusterNodes(gomock.Any(), gomock.Any()).Return(model.ClusterNodes{follower}, nil)
+
+		test.Request(t).
+			WithMethod(http.MethodGet).
+			WithURL(url).
+			OnHandlerFunc(resources.GetClusterStatus).
+			Require().
+			ResponseJSONBody(v2.ClusterStatus{
+				NodeID: "node-a",
+				Nodes:  model.ClusterNodes{follower},
+			}).
+			ResponseStatusCode(http.StatusOK)
+	})
+
+	t.Run("error getting cluster nodes", func(t *testing.T) {
+		mockDB.EXPECT().GetClusterNodes(gomock.Any(), gomock.Any()).Return(nil, errors.New("an error"))
+
+		test.Request(t).
+			WithMethod(http.MethodGet).
+			WithURL(url).
+			OnHandlerFunc(resources.GetClusterStatus).
+			Require().
+			ResponseStatusCode(http.StatusInternalServerError)
+	})
+}
@@ -24,6 +24,7 @@ import (
 
 	"github.com/specterops/bloodhound/dawgs/util"
 	"github.com/specterops/bloodhound/src/api"
+	"github.com/specterops/bloodhound/src/api/graphexport"
 	"github.com/specterops/bloodhound/src/auth"
 	"github.com/specterops/bloodhound/src/ctx"
 	"github.com/specterops/bloodhound/src/model"
@@ -57,6 +58,11 @@ func (s Resources) CypherQuery(response http.ResponseWriter, request *http.Reque
 		return
 	}
 
+	if exportFormat, isExport := graphexport.NegotiateFormat(request.Header); isExport {
+		s.cypherQueryExport(response, request, preparedQuery, exportFormat)
+		return
+	}
+
 	if preparedQuery.HasMutation {
 		graphResponse, err = s.cypherMutation(request, preparedQuery, payload.IncludeProperties)
 	} else {
@@ -78,6 +84,24 @@ func (s Resources) CypherQuery(response http.ResponseWriter, request *http.Reque
 	}
 }
 
+// cypherQueryExport writes the paths matched by a read-only cypher query in the given export format. Mutations are not
+// exported as their results describe a change to the graph rather than a view of it.
+func (s Resources) cypherQueryExport(response http.ResponseWriter, request *http.Request, preparedQuery queries.PreparedQuery, format graphexport.Format) {
+	if preparedQuery.HasMutation {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusNotAcceptable, "graph export is not supported for queries that modify the graph", request), response)
+	} else if pathSet, err := s.GraphQuery.RawCypherQueryPaths(request.Context(), preparedQuery); err != nil {
+		if util.IsNeoTimeoutError(err) {
+			api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, "transaction timed out, reduce query complexity or try again later", request), response)
+		} else {
+			api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, err.Error(), request), response)
+		}
+	} else if exported := graphexport.FromPathSet(pathSet); exported.IsEmpty() {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusNotFound, "resource not found", request), response)
+	} else {
+		api.WriteGraphExportResponse(request.Context(), exported, format, http.StatusOK, response)
+	}
+}
+
 func (s Resources) cypherMutation(request *http.Request, preparedQuery queries.PreparedQuery, includeProperties bool) (model.UnifiedGraph, error) {
 	var (
 		auditLogEntry model.AuditEntry
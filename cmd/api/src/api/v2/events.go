@@ -0,0 +1,113 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/specterops/bloodhound/headers"
+	"github.com/specterops/bloodhound/src/api"
+	"github.com/specterops/bloodhound/src/ctx"
+	"github.com/specterops/bloodhound/src/events"
+)
+
+const (
+	mediaTypeEventStream = "text/event-stream"
+
+	// eventsKeepAliveInterval is how often a comment is sent on an idle event stream so that proxies do not close it
+	eventsKeepAliveInterval = 15 * time.Second
+)
+
+// writeEvent writes the event to the response in the server-sent events wire format.
+func writeEvent(response http.ResponseWriter, event events.Event) error {
+	if content, err := json.Marshal(event); err != nil {
+		return err
+	} else {
+		_, err := fmt.Fprintf(response, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, content)
+		return err
+	}
+}
+
+// GetEvents streams datapipe ingest and analysis progress to the client as server-sent events. Clients that reconnect
+// with a Last-Event-ID header are first sent any retained events published after that event. Only events describing
+// the graph of the selected workspace, or the default graph when none is selected, are streamed.
+func (s Resources) GetEvents(response http.ResponseWriter, request *http.Request) {
+	var (
+		lastEventID uint64
+		workspaceID int32
+		controller  = http.NewResponseController(response)
+	)
+
+	if selectedWorkspace := ctx.FromRequest(request).Workspace; selectedWorkspace != nil {
+		workspaceID = selectedWorkspace.ID
+	}
+
+	if rawLastEventID := request.Header.Get(headers.LastEventID.String()); rawLastEventID != "" {
+		if parsedLastEventID, err := strconv.ParseUint(rawLastEventID, 10, 64); err != nil {
+			api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("Malformed %s header: %v", headers.LastEventID, err), request), response)
+			return
+		} else {
+			lastEventID = parsedLastEventID
+		}
+	}
+
+	// Subscribe before responding so that no event published after the client sees the response is missed
+	var (
+		subscription = s.Events.Subscribe(request.Context(), lastEventID)
+		keepAlive    = time.NewTicker(eventsKeepAliveInterval)
+	)
+
+	response.Header().Set(headers.ContentType.String(), mediaTypeEventStream)
+	response.Header().Set(headers.CacheControl.String(), "no-cache")
+	response.WriteHeader(http.StatusOK)
+
+	if err := controller.Flush(); err != nil {
+		slog.ErrorContext(request.Context(), fmt.Sprintf("Event stream does not support flushing: %v", err))
+		return
+	}
+
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case event, ok := <-subscription:
+			if !ok {
+				return
+			} else if !event.InWorkspace(workspaceID) {
+				continue
+			} else if err := writeEvent(response, event); err != nil {
+				slog.DebugContext(request.Context(), fmt.Sprintf("Closing event stream: %v", err))
+				return
+			}
+
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(response, ": keep-alive\n\n"); err != nil {
+				slog.DebugContext(request.Context(), fmt.Sprintf("Closing event stream: %v", err))
+				return
+			}
+		}
+
+		if err := controller.Flush(); err != nil {
+			return
+		}
+	}
+}
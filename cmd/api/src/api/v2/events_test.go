@@ -0,0 +1,149 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/specterops/bloodhound/headers"
+	v2 "github.com/specterops/bloodhound/src/api/v2"
+	bhCtx "github.com/specterops/bloodhound/src/ctx"
+	"github.com/specterops/bloodhound/src/events"
+	"github.com/specterops/bloodhound/src/model"
+	"github.com/stretchr/testify/require"
+)
+
+// readEvent reads the next event from a server-sent events stream, skipping comments.
+func readEvent(t *testing.T, reader *bufio.Reader) (string, events.Event) {
+	var (
+		eventType string
+		event     events.Event
+	)
+
+	for {
+		line, err := reader.ReadString('\n')
+		require.NoError(t, err)
+
+		switch line = strings.TrimSuffix(line, "\n"); {
+		case line == "" && eventType != "":
+			return eventType, event
+
+		case strings.HasPrefix(line, "event: "):
+			eventType = strings.TrimPrefix(line, "event: ")
+
+		case strings.HasPrefix(line, "data: "):
+			require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &event))
+		}
+	}
+}
+
+func TestResources_GetEvents(t *testing.T) {
+	var (
+		bus       = events.NewBus(events.DefaultHistorySize)
+		resources = v2.Resources{Events: bus}
+		server    = httptest.NewServer(http.HandlerFunc(resources.GetEvents))
+	)
+
+	defer server.Close()
+
+	t.Run("Streams Published Events", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		missed := bus.Publish(events.TypeAnalysisStarted, nil)
+		resumed := bus.Publish(events.TypeAnalysisProgress, events.AnalysisProgress{Step: "domain_associations", TotalSteps: 12})
+
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+
+		request.Header.Set(headers.LastEventID.String(), "1")
+		require.Equal(t, uint64(1), missed.ID)
+
+		response, err := http.DefaultClient.Do(request)
+		require.NoError(t, err)
+
+		defer response.Body.Close()
+
+		require.Equal(t, http.StatusOK, response.StatusCode)
+		require.Equal(t, "text/event-stream", response.Header.Get(headers.ContentType.String()))
+
+		reader := bufio.NewReader(response.Body)
+
+		eventType, event := readEvent(t, reader)
+		require.Equal(t, string(events.TypeAnalysisProgress), eventType)
+		require.Equal(t, resumed.ID, event.ID)
+
+		published := bus.Publish(events.TypeIngestProgress, events.IngestProgress{TaskID: 1, ObjectsDecoded: 500})
+
+		eventType, event = readEvent(t, reader)
+		require.Equal(t, string(events.TypeIngestProgress), eventType)
+		require.Equal(t, published.ID, event.ID)
+		require.Equal(t, map[string]any{"task_id": float64(1), "job_id": float64(0), "objects_decoded": float64(500)}, event.Data)
+	})
+
+	t.Run("Streams Events Of The Selected Workspace", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		workspaceServer := httptest.NewServer(http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+			resources.GetEvents(response, bhCtx.SetRequestContext(request, &bhCtx.Context{Workspace: &model.Workspace{Serial: model.Serial{ID: 1}}}))
+		}))
+
+		defer workspaceServer.Close()
+
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, workspaceServer.URL, nil)
+		require.NoError(t, err)
+
+		response, err := http.DefaultClient.Do(request)
+		require.NoError(t, err)
+
+		defer response.Body.Close()
+
+		bus.Publish(events.TypeIngestProgress, events.IngestProgress{TaskID: 1})
+		bus.Publish(events.TypeAnalysisProgress, events.AnalysisProgress{Step: "domain_associations"})
+		ingested := bus.Publish(events.TypeIngestProgress, events.IngestProgress{TaskID: 2, WorkspaceID: 1})
+		completed := bus.Publish(events.TypeAnalysisCompleted, events.AnalysisProgress{WorkspaceIDs: []int32{0, 1}})
+
+		reader := bufio.NewReader(response.Body)
+
+		_, event := readEvent(t, reader)
+		require.Equal(t, ingested.ID, event.ID)
+
+		_, event = readEvent(t, reader)
+		require.Equal(t, completed.ID, event.ID)
+	})
+
+	t.Run("Malformed Last Event ID", func(t *testing.T) {
+		request, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+
+		request.Header.Set(headers.LastEventID.String(), "latest")
+
+		response, err := http.DefaultClient.Do(request)
+		require.NoError(t, err)
+
+		defer response.Body.Close()
+
+		require.Equal(t, http.StatusBadRequest, response.StatusCode)
+	})
+}
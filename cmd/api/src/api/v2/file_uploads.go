@@ -32,6 +32,8 @@ import (
 	"github.com/specterops/bloodhound/src/api"
 	"github.com/specterops/bloodhound/src/auth"
 	"github.com/specterops/bloodhound/src/ctx"
+	"github.com/specterops/bloodhound/src/database"
+	"github.com/specterops/bloodhound/src/database/types/null"
 	"github.com/specterops/bloodhound/src/model"
 	ingestModel "github.com/specterops/bloodhound/src/model/ingest"
 
@@ -100,7 +102,7 @@ func (s Resources) ListFileUploadJobs(response http.ResponseWriter, request *htt
 			api.WriteErrorResponse(request.Context(), ErrBadQueryParameter(request, model.PaginationQueryParameterSkip, err), response)
 		} else if limit, err := ParseLimitQueryParameter(queryParams, 100); err != nil {
 			api.WriteErrorResponse(request.Context(), ErrBadQueryParameter(request, model.PaginationQueryParameterLimit, err), response)
-		} else if fileUploadJobs, count, err := ingest.GetAllIngestJobs(request.Context(), s.DB, skip, limit, strings.Join(order, ", "), sqlFilter); err != nil {
+		} else if fileUploadJobs, count, err := ingest.GetAllIngestJobs(request.Context(), s.DB, skip, limit, strings.Join(order, ", "), withWorkspaceFilter(request, sqlFilter)); err != nil {
 			api.HandleDatabaseError(request, response, err)
 		} else {
 			api.WriteResponseWrapperWithPagination(request.Context(), fileUploadJobs, limit, skip, count, http.StatusOK, response)
@@ -110,11 +112,18 @@ func (s Resources) ListFileUploadJobs(response http.ResponseWriter, request *htt
 
 func (s Resources) StartFileUploadJob(response http.ResponseWriter, request *http.Request) {
 	defer measure.ContextMeasure(request.Context(), slog.LevelDebug, "Starting new file upload job")()
-	reqCtx := ctx.Get(request.Context())
+	var (
+		reqCtx      = ctx.Get(request.Context())
+		workspaceID null.Int32
+	)
+
+	if reqCtx.Workspace != nil {
+		workspaceID = null.Int32From(reqCtx.Workspace.ID)
+	}
 
 	if user, valid := auth.GetUserFromAuthCtx(reqCtx.AuthCtx); !valid {
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusUnauthorized, api.ErrorResponseDetailsAuthenticationInvalid, request), response)
-	} else if ingestJob, err := ingest.StartIngestJob(request.Context(), s.DB, user); err != nil {
+	} else if ingestJob, err := ingest.StartIngestJob(request.Context(), s.DB, user, workspaceID); err != nil {
 		api.HandleDatabaseError(request, response, err)
 	} else {
 		api.WriteBasicResponse(request.Context(), ingestJob, http.StatusCreated, response)
@@ -137,7 +146,11 @@ func (s Resources) ProcessFileUpload(response http.ResponseWriter, request *http
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsIDMalformed, request), response)
 	} else if ingestJob, err := ingest.GetIngestJobByID(request.Context(), s.DB, int64(fileUploadJobID)); err != nil {
 		api.HandleDatabaseError(request, response, err)
-	} else if fileName, fileType, err := ingest.SaveIngestFile(s.Config.TempDirectory(), request); errors.Is(err, ingest.ErrInvalidJSON) {
+	} else if !inSelectedWorkspace(request, ingestJob) {
+		api.HandleDatabaseError(request, response, database.ErrNotFound)
+	} else if ingestJob.Status == model.JobStatusCanceled {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusConflict, "job has been canceled", request), response)
+	} else if fileName, fileType, err := ingest.SaveIngestFile(request.Context(), s.IngestStore, request); errors.Is(err, ingest.ErrInvalidJSON) {
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("Error saving ingest file: %v", err), request), response)
 	} else if err != nil {
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, fmt.Sprintf("Error saving ingest file: %v", err), request), response)
@@ -159,15 +172,123 @@ func (s Resources) EndFileUploadJob(response http.ResponseWriter, request *http.
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsIDMalformed, request), response)
 	} else if ingestJob, err := ingest.GetIngestJobByID(request.Context(), s.DB, int64(fileUploadJobID)); err != nil {
 		api.HandleDatabaseError(request, response, err)
+	} else if !inSelectedWorkspace(request, ingestJob) {
+		api.HandleDatabaseError(request, response, database.ErrNotFound)
 	} else if ingestJob.Status != model.JobStatusRunning {
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "job must be in running status to end", request), response)
-	} else if err := ingest.EndIngestJob(request.Context(), s.DB, ingestJob); err != nil {
+	} else if err := ingest.EndIngestJob(request.Context(), s.DB, ingestJob); errors.Is(err, ingest.ErrIngestJobStatusChanged) {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "job must be in running status to end", request), response)
+	} else if err != nil {
 		api.HandleDatabaseError(request, response, err)
 	} else {
 		response.WriteHeader(http.StatusOK)
 	}
 }
 
+// CancelFileUploadJob cancels a file upload job that is still accepting or ingesting files. Files of the job that are
+// being ingested are abandoned once the datapipe observes the cancellation.
+func (s Resources) CancelFileUploadJob(response http.ResponseWriter, request *http.Request) {
+	defer measure.ContextMeasure(request.Context(), slog.LevelDebug, "Canceling file upload job")()
+
+	fileUploadJobIdString := mux.Vars(request)[FileUploadJobIdPathParameterName]
+
+	if user, valid := auth.GetUserFromAuthCtx(ctx.FromRequest(request).AuthCtx); !valid {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusUnauthorized, api.ErrorResponseDetailsAuthenticationInvalid, request), response)
+	} else if fileUploadJobID, err := strconv.Atoi(fileUploadJobIdString); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsIDMalformed, request), response)
+	} else if ingestJob, err := ingest.GetIngestJobByID(request.Context(), s.DB, int64(fileUploadJobID)); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if !inSelectedWorkspace(request, ingestJob) {
+		api.HandleDatabaseError(request, response, database.ErrNotFound)
+	} else if !ingest.IsCancelable(ingestJob) {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusConflict, "job must be in running or ingesting status to cancel", request), response)
+	} else if err := ingest.CancelIngestJob(request.Context(), s.DB, ingestJob, user.ID.String()); errors.Is(err, ingest.ErrIngestJobStatusChanged) {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusConflict, "job must be in running or ingesting status to cancel", request), response)
+	} else if err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		response.WriteHeader(http.StatusAccepted)
+	}
+}
+
+// ListFileUploadJobObjects lists the nodes and relationships that a file upload job last wrote to the graph. Objects the
+// job wrote that a later job has written since are attributed to the later job.
+func (s Resources) ListFileUploadJobObjects(response http.ResponseWriter, request *http.Request) {
+	var (
+		queryParams           = request.URL.Query()
+		fileUploadJobIdString = mux.Vars(request)[FileUploadJobIdPathParameterName]
+	)
+
+	if fileUploadJobID, err := strconv.Atoi(fileUploadJobIdString); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsIDMalformed, request), response)
+	} else if skip, err := ParseSkipQueryParameter(queryParams, 0); err != nil {
+		api.WriteErrorResponse(request.Context(), ErrBadQueryParameter(request, model.PaginationQueryParameterSkip, err), response)
+	} else if limit, err := ParseLimitQueryParameter(queryParams, 100); err != nil {
+		api.WriteErrorResponse(request.Context(), ErrBadQueryParameter(request, model.PaginationQueryParameterLimit, err), response)
+	} else if ingestJob, err := ingest.GetIngestJobByID(request.Context(), s.DB, int64(fileUploadJobID)); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if !inSelectedWorkspace(request, ingestJob) {
+		api.HandleDatabaseError(request, response, database.ErrNotFound)
+	} else if objects, err := s.GraphQuery.GetIngestJobObjects(request.Context(), ingestJob.ID, skip, limit); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, api.ErrorResponseDetailsInternalServerError, request), response)
+	} else {
+		api.WriteBasicResponse(request.Context(), objects, http.StatusOK, response)
+	}
+}
+
+// RollbackFileUploadJob requests that the nodes and relationships a finished file upload job was the only source of be
+// removed from the graph. The datapipe performs the rollback and then reruns analysis.
+func (s Resources) RollbackFileUploadJob(response http.ResponseWriter, request *http.Request) {
+	defer measure.ContextMeasure(request.Context(), slog.LevelDebug, "Requesting file upload job rollback")()
+
+	fileUploadJobIdString := mux.Vars(request)[FileUploadJobIdPathParameterName]
+
+	if user, valid := auth.GetUserFromAuthCtx(ctx.FromRequest(request).AuthCtx); !valid {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusUnauthorized, api.ErrorResponseDetailsAuthenticationInvalid, request), response)
+	} else if fileUploadJobID, err := strconv.Atoi(fileUploadJobIdString); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsIDMalformed, request), response)
+	} else if ingestJob, err := ingest.GetIngestJobByID(request.Context(), s.DB, int64(fileUploadJobID)); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if !inSelectedWorkspace(request, ingestJob) {
+		api.HandleDatabaseError(request, response, database.ErrNotFound)
+	} else if !ingest.IsRollbackable(ingestJob) {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusConflict, "job must be finished ingesting and not already rolled back", request), response)
+	} else if err := ingest.RequestIngestJobRollback(request.Context(), s.DB, ingestJob, user.ID.String()); errors.Is(err, ingest.ErrIngestJobStatusChanged) {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusConflict, "job must be finished ingesting and not already rolled back", request), response)
+	} else if err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		response.WriteHeader(http.StatusAccepted)
+	}
+}
+
+// withWorkspaceFilter restricts the given filter to ingest jobs belonging to the workspace selected by the request
+func withWorkspaceFilter(request *http.Request, sqlFilter model.SQLFilter) model.SQLFilter {
+	workspaceFilter := model.SQLFilter{SQLString: "workspace_id IS NULL"}
+
+	if selectedWorkspace := ctx.FromRequest(request).Workspace; selectedWorkspace != nil {
+		workspaceFilter = model.SQLFilter{SQLString: "workspace_id = ?", Params: []any{selectedWorkspace.ID}}
+	}
+
+	if sqlFilter.SQLString == "" {
+		return workspaceFilter
+	}
+
+	return model.SQLFilter{
+		SQLString: sqlFilter.SQLString + " AND " + workspaceFilter.SQLString,
+		Params:    append(sqlFilter.Params, workspaceFilter.Params...),
+	}
+}
+
+// inSelectedWorkspace returns true if the given ingest job belongs to the workspace selected by the request
+func inSelectedWorkspace(request *http.Request, ingestJob model.IngestJob) bool {
+	if selectedWorkspace := ctx.FromRequest(request).Workspace; selectedWorkspace != nil {
+		return ingestJob.WorkspaceID.Valid && ingestJob.WorkspaceID.Int32 == selectedWorkspace.ID
+	}
+
+	return !ingestJob.WorkspaceID.Valid
+}
+
 func (s Resources) ListAcceptedFileUploadTypes(response http.ResponseWriter, request *http.Request) {
 	api.WriteBasicResponse(request.Context(), ingestModel.AllowedFileUploadTypes, http.StatusOK, response)
 }
@@ -24,14 +24,18 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/specterops/bloodhound/headers"
+	"github.com/specterops/bloodhound/mediatypes"
 	v2 "github.com/specterops/bloodhound/src/api/v2"
 	"github.com/specterops/bloodhound/src/api/v2/apitest"
 	"github.com/specterops/bloodhound/src/auth"
 	"github.com/specterops/bloodhound/src/ctx"
+	"github.com/specterops/bloodhound/src/database"
 	dbMocks "github.com/specterops/bloodhound/src/database/mocks"
 	"github.com/specterops/bloodhound/src/database/types/null"
 	"github.com/specterops/bloodhound/src/model"
 	"github.com/specterops/bloodhound/src/model/ingest"
+	queryMocks "github.com/specterops/bloodhound/src/queries/mocks"
 	"go.uber.org/mock/gomock"
 )
 
@@ -87,7 +91,7 @@ func TestResources_ListFileUploadJobs(t *testing.T) {
 					apitest.AddQueryParam(input, "user_id", "eq:123")
 				},
 				Setup: func() {
-					mockDB.EXPECT().GetAllIngestJobs(gomock.Any(), 1, 2, "start_time", model.SQLFilter{SQLString: "user_id = ?", Params: []any{"123"}}).Return([]model.IngestJob{}, 0, nil)
+					mockDB.EXPECT().GetAllIngestJobs(gomock.Any(), 1, 2, "start_time", model.SQLFilter{SQLString: "user_id = ? AND workspace_id IS NULL", Params: []any{"123"}}).Return([]model.IngestJob{}, 0, nil)
 				},
 				Test: func(output apitest.Output) {
 					apitest.StatusCode(output, http.StatusOK)
@@ -143,6 +147,47 @@ func TestResources_StartFileUploadJob(t *testing.T) {
 		})
 }
 
+func TestResources_ProcessFileUpload(t *testing.T) {
+	var (
+		mockCtrl  = gomock.NewController(t)
+		mockDB    = dbMocks.NewMockDatabase(mockCtrl)
+		resources = v2.Resources{DB: mockDB}
+	)
+	defer mockCtrl.Finish()
+
+	apitest.
+		NewHarness(t, resources.ProcessFileUpload).
+		Run([]apitest.Case{
+			{
+				Name: "InvalidContentType",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, v2.FileUploadJobIdPathParameterName, "123")
+					apitest.SetHeader(input, headers.ContentType.String(), "text/plain")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+				},
+			},
+			{
+				Name: "CanceledJob",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, v2.FileUploadJobIdPathParameterName, "123")
+					apitest.SetHeader(input, headers.ContentType.String(), mediatypes.ApplicationJson.String())
+					apitest.BodyString(input, `{"meta": {"type": "domains", "version": 4, "count": 0}, "data": []}`)
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetIngestJob(gomock.Any(), int64(123)).Return(model.IngestJob{
+						Status: model.JobStatusCanceled,
+					}, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusConflict)
+					apitest.BodyContains(output, "job has been canceled")
+				},
+			},
+		})
+}
+
 func TestResources_EndFileUploadJob(t *testing.T) {
 	var (
 		mockCtrl  = gomock.NewController(t)
@@ -199,12 +244,28 @@ func TestResources_EndFileUploadJob(t *testing.T) {
 					mockDB.EXPECT().GetIngestJob(gomock.Any(), gomock.Any()).Return(model.IngestJob{
 						Status: model.JobStatusRunning,
 					}, nil)
-					mockDB.EXPECT().UpdateIngestJob(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+					mockDB.EXPECT().EndIngestJob(gomock.Any(), gomock.Any()).Return(false, errors.New("database error"))
 				},
 				Test: func(output apitest.Output) {
 					apitest.StatusCode(output, http.StatusInternalServerError)
 				},
 			},
+			{
+				Name: "JobStatusChanged",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, v2.FileUploadJobIdPathParameterName, "123")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetIngestJob(gomock.Any(), gomock.Any()).Return(model.IngestJob{
+						Status: model.JobStatusRunning,
+					}, nil)
+					mockDB.EXPECT().EndIngestJob(gomock.Any(), gomock.Any()).Return(false, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "job must be in running status")
+				},
+			},
 			{
 				Name: "Success",
 				Input: func(input *apitest.Input) {
@@ -214,10 +275,327 @@ func TestResources_EndFileUploadJob(t *testing.T) {
 					mockDB.EXPECT().GetIngestJob(gomock.Any(), gomock.Any()).Return(model.IngestJob{
 						Status: model.JobStatusRunning,
 					}, nil)
-					mockDB.EXPECT().UpdateIngestJob(gomock.Any(), gomock.Any()).Return(nil)
+					mockDB.EXPECT().EndIngestJob(gomock.Any(), gomock.Any()).Return(true, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusOK)
+				},
+			},
+		})
+}
+
+func TestResources_CancelFileUploadJob(t *testing.T) {
+	var (
+		mockCtrl  = gomock.NewController(t)
+		mockDB    = dbMocks.NewMockDatabase(mockCtrl)
+		resources = v2.Resources{DB: mockDB}
+		user      = setupUser()
+		userCtx   = setupUserCtx(user)
+	)
+	defer mockCtrl.Finish()
+
+	apitest.
+		NewHarness(t, resources.CancelFileUploadJob).
+		Run([]apitest.Case{
+			{
+				Name: "Unauthorized",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, v2.FileUploadJobIdPathParameterName, "123")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusUnauthorized)
+				},
+			},
+			{
+				Name: "InvalidJobID",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, v2.FileUploadJobIdPathParameterName, "invalid")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+				},
+			},
+			{
+				Name: "GetIngestJobDatabaseError",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, v2.FileUploadJobIdPathParameterName, "123")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetIngestJob(gomock.Any(), int64(123)).Return(model.IngestJob{}, errors.New("db error"))
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusInternalServerError)
+				},
+			},
+			{
+				Name: "InvalidJobStatus",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, v2.FileUploadJobIdPathParameterName, "123")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetIngestJob(gomock.Any(), int64(123)).Return(model.IngestJob{
+						Status: model.JobStatusAnalyzing,
+					}, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusConflict)
+					apitest.BodyContains(output, "job must be in running or ingesting status")
+				},
+			},
+			{
+				Name: "UpdateIngestJobDatabaseError",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, v2.FileUploadJobIdPathParameterName, "123")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetIngestJob(gomock.Any(), int64(123)).Return(model.IngestJob{
+						Status: model.JobStatusIngesting,
+					}, nil)
+					mockDB.EXPECT().CancelIngestJob(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(false, errors.New("database error"))
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusInternalServerError)
+				},
+			},
+			{
+				Name: "JobStatusChanged",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, v2.FileUploadJobIdPathParameterName, "123")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetIngestJob(gomock.Any(), int64(123)).Return(model.IngestJob{
+						Status: model.JobStatusIngesting,
+					}, nil)
+					mockDB.EXPECT().CancelIngestJob(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusConflict)
+					apitest.BodyContains(output, "job must be in running or ingesting status")
+				},
+			},
+			{
+				Name: "Success",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, v2.FileUploadJobIdPathParameterName, "123")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetIngestJob(gomock.Any(), int64(123)).Return(model.IngestJob{
+						Status: model.JobStatusIngesting,
+					}, nil)
+					mockDB.EXPECT().CancelIngestJob(gomock.Any(), gomock.Any(), []model.JobStatus{model.JobStatusRunning, model.JobStatusIngesting}, user.ID.String(), gomock.Any()).Return(true, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusAccepted)
+				},
+			},
+		})
+}
+
+func TestResources_ListFileUploadJobObjects(t *testing.T) {
+	var (
+		mockCtrl  = gomock.NewController(t)
+		mockDB    = dbMocks.NewMockDatabase(mockCtrl)
+		mockGraph = queryMocks.NewMockGraph(mockCtrl)
+		resources = v2.Resources{DB: mockDB, GraphQuery: mockGraph}
+	)
+	defer mockCtrl.Finish()
+
+	apitest.
+		NewHarness(t, resources.ListFileUploadJobObjects).
+		Run([]apitest.Case{
+			{
+				Name: "InvalidJobID",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, v2.FileUploadJobIdPathParameterName, "invalid")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+				},
+			},
+			{
+				Name: "InvalidLimit",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, v2.FileUploadJobIdPathParameterName, "123")
+					apitest.AddQueryParam(input, "limit", "-1")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+				},
+			},
+			{
+				Name: "JobNotFound",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, v2.FileUploadJobIdPathParameterName, "123")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetIngestJob(gomock.Any(), int64(123)).Return(model.IngestJob{}, database.ErrNotFound)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNotFound)
+				},
+			},
+			{
+				Name: "GraphError",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, v2.FileUploadJobIdPathParameterName, "123")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetIngestJob(gomock.Any(), int64(123)).Return(model.IngestJob{BigSerial: model.BigSerial{ID: 123}}, nil)
+					mockGraph.EXPECT().GetIngestJobObjects(gomock.Any(), int64(123), 0, 100).Return(model.IngestJobObjects{}, errors.New("graph error"))
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusInternalServerError)
+				},
+			},
+			{
+				Name: "Success",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, v2.FileUploadJobIdPathParameterName, "123")
+					apitest.AddQueryParam(input, "skip", "10")
+					apitest.AddQueryParam(input, "limit", "5")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetIngestJob(gomock.Any(), int64(123)).Return(model.IngestJob{BigSerial: model.BigSerial{ID: 123}}, nil)
+					mockGraph.EXPECT().GetIngestJobObjects(gomock.Any(), int64(123), 10, 5).Return(model.IngestJobObjects{
+						NodeCount:         11,
+						RelationshipCount: 0,
+						UnifiedGraph: model.UnifiedGraph{
+							Nodes: map[string]model.UnifiedNode{"1": {ObjectId: "S-1-5-21-1", Kind: "User"}},
+							Edges: []model.UnifiedEdge{},
+						},
+					}, nil)
 				},
 				Test: func(output apitest.Output) {
+					var objects model.IngestJobObjects
+
 					apitest.StatusCode(output, http.StatusOK)
+					apitest.UnmarshalData(output, &objects)
+					apitest.Equal(output, int64(11), objects.NodeCount)
+					apitest.Equal(output, "S-1-5-21-1", objects.Nodes["1"].ObjectId)
+				},
+			},
+		})
+}
+
+func TestResources_RollbackFileUploadJob(t *testing.T) {
+	var (
+		mockCtrl  = gomock.NewController(t)
+		mockDB    = dbMocks.NewMockDatabase(mockCtrl)
+		resources = v2.Resources{DB: mockDB}
+		user      = setupUser()
+		userCtx   = setupUserCtx(user)
+	)
+	defer mockCtrl.Finish()
+
+	apitest.
+		NewHarness(t, resources.RollbackFileUploadJob).
+		Run([]apitest.Case{
+			{
+				Name: "Unauthorized",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, v2.FileUploadJobIdPathParameterName, "123")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusUnauthorized)
+				},
+			},
+			{
+				Name: "InvalidJobID",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, v2.FileUploadJobIdPathParameterName, "invalid")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+				},
+			},
+			{
+				Name: "StillIngesting",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, v2.FileUploadJobIdPathParameterName, "123")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetIngestJob(gomock.Any(), int64(123)).Return(model.IngestJob{
+						Status: model.JobStatusIngesting,
+					}, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusConflict)
+					apitest.BodyContains(output, "job must be finished ingesting")
+				},
+			},
+			{
+				Name: "AlreadyRequested",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, v2.FileUploadJobIdPathParameterName, "123")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetIngestJob(gomock.Any(), int64(123)).Return(model.IngestJob{
+						Status:              model.JobStatusComplete,
+						RollbackRequestedBy: null.StringFrom("someone"),
+					}, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusConflict)
+				},
+			},
+			{
+				Name: "RolledBackConcurrently",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, v2.FileUploadJobIdPathParameterName, "123")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetIngestJob(gomock.Any(), int64(123)).Return(model.IngestJob{
+						BigSerial: model.BigSerial{ID: 123},
+						Status:    model.JobStatusComplete,
+					}, nil)
+					mockDB.EXPECT().RequestIngestJobRollback(gomock.Any(), int64(123), user.ID.String()).Return(false, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusConflict)
+				},
+			},
+			{
+				Name: "DatabaseError",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, v2.FileUploadJobIdPathParameterName, "123")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetIngestJob(gomock.Any(), int64(123)).Return(model.IngestJob{
+						BigSerial: model.BigSerial{ID: 123},
+						Status:    model.JobStatusComplete,
+					}, nil)
+					mockDB.EXPECT().RequestIngestJobRollback(gomock.Any(), int64(123), user.ID.String()).Return(false, errors.New("db error"))
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusInternalServerError)
+				},
+			},
+			{
+				Name: "Success",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, v2.FileUploadJobIdPathParameterName, "123")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetIngestJob(gomock.Any(), int64(123)).Return(model.IngestJob{
+						BigSerial: model.BigSerial{ID: 123},
+						Status:    model.JobStatusComplete,
+					}, nil)
+					mockDB.EXPECT().RequestIngestJobRollback(gomock.Any(), int64(123), user.ID.String()).Return(true, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusAccepted)
 				},
 			},
 		})
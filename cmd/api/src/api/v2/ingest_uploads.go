@@ -0,0 +1,188 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/specterops/bloodhound/headers"
+	"github.com/specterops/bloodhound/src/api"
+	"github.com/specterops/bloodhound/src/ctx"
+	"github.com/specterops/bloodhound/src/database"
+	"github.com/specterops/bloodhound/src/model"
+	"github.com/specterops/bloodhound/src/services/ingest"
+)
+
+const (
+	IngestUploadIdPathParameterName = "ingest_upload_id"
+
+	// uploadChecksumAlgorithm is the only digest accepted in the Upload-Checksum header of a chunk
+	uploadChecksumAlgorithm = "sha256"
+)
+
+type StartIngestUploadRequest struct {
+	ContentType string `json:"content_type"`
+	TotalSize   int64  `json:"total_size"`
+}
+
+// StartIngestUpload begins a resumable upload of a file to a running file upload job. The file is sent in chunks that
+// are each appended at the received size of the upload.
+func (s Resources) StartIngestUpload(response http.ResponseWriter, request *http.Request) {
+	var (
+		payload               StartIngestUploadRequest
+		fileUploadJobIdString = mux.Vars(request)[FileUploadJobIdPathParameterName]
+	)
+
+	if fileUploadJobID, err := strconv.ParseInt(fileUploadJobIdString, 10, 64); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsIDMalformed, request), response)
+	} else if err := api.ReadJSONRequestPayloadLimited(&payload, request); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponsePayloadUnmarshalError, request), response)
+	} else if fileType, valid := ingest.FileTypeForContentType(payload.ContentType); !valid {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "Content type must be application/json or application/zip", request), response)
+	} else if payload.TotalSize <= 0 {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "total size must be greater than zero", request), response)
+	} else if ingestJob, err := ingest.GetIngestJobByID(request.Context(), s.DB, fileUploadJobID); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if !inSelectedWorkspace(request, ingestJob) {
+		api.HandleDatabaseError(request, response, database.ErrNotFound)
+	} else if ingestJob.Status != model.JobStatusRunning {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "job must be in running status to upload files", request), response)
+	} else if upload, err := ingest.StartIngestUpload(request.Context(), s.DB, ingestJob, fileType, payload.TotalSize); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		writeIngestUploadResponse(request, response, upload, http.StatusCreated)
+	}
+}
+
+// GetIngestUpload returns a resumable upload, including the received size that the next chunk must start at
+func (s Resources) GetIngestUpload(response http.ResponseWriter, request *http.Request) {
+	if _, upload, found := s.lookupIngestUpload(response, request); found {
+		writeIngestUploadResponse(request, response, upload, http.StatusOK)
+	}
+}
+
+// WriteIngestUploadChunk appends the request body to a resumable upload at the offset given by the Upload-Offset
+// header. Once the last chunk has been received the upload is assembled, validated and queued for ingest.
+func (s Resources) WriteIngestUploadChunk(response http.ResponseWriter, request *http.Request) {
+	requestId := ctx.FromRequest(request).RequestID
+
+	if request.Body != nil {
+		defer request.Body.Close()
+	}
+
+	if ingestJob, upload, found := s.lookupIngestUpload(response, request); !found {
+		return
+	} else if ingestJob.Status != model.JobStatusRunning {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "job must be in running status to upload files", request), response)
+	} else if offset, err := strconv.ParseInt(request.Header.Get(headers.UploadOffset.String()), 10, 64); err != nil || offset < 0 {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("%s header must be a non-negative integer", headers.UploadOffset), request), response)
+	} else if checksum, err := parseUploadChecksum(request.Header.Get(headers.UploadChecksum.String())); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
+	} else if upload, err := ingest.WriteIngestUploadChunk(request.Context(), s.DB, s.IngestStore, upload, offset, checksum, request.Body); err != nil {
+		handleIngestUploadError(request, response, err)
+	} else if err := ingest.TouchIngestJobLastIngest(request.Context(), s.DB, ingestJob); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if upload.ReceivedSize < upload.TotalSize {
+		writeIngestUploadResponse(request, response, upload, http.StatusOK)
+	} else if _, err := ingest.AssembleIngestUpload(request.Context(), s.DB, s.IngestStore, upload, requestId); err != nil {
+		handleIngestUploadError(request, response, err)
+	} else {
+		upload.Completed = true
+		writeIngestUploadResponse(request, response, upload, http.StatusOK)
+	}
+}
+
+// DeleteIngestUpload discards a resumable upload that has not been completed along with the chunks it has received
+func (s Resources) DeleteIngestUpload(response http.ResponseWriter, request *http.Request) {
+	if _, upload, found := s.lookupIngestUpload(response, request); !found {
+		return
+	} else if upload.Completed {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusConflict, ingest.ErrUploadCompleted.Error(), request), response)
+	} else if err := ingest.DiscardIngestUpload(request.Context(), s.DB, s.IngestStore, upload); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		response.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// lookupIngestUpload fetches the upload and file upload job identified by the request path, writing an error response
+// and returning false if either does not exist in the selected workspace
+func (s Resources) lookupIngestUpload(response http.ResponseWriter, request *http.Request) (model.IngestJob, model.IngestUpload, bool) {
+	var (
+		pathVars      = mux.Vars(request)
+		ingestJob     model.IngestJob
+		ingestUpload  model.IngestUpload
+		fileUploadJob = pathVars[FileUploadJobIdPathParameterName]
+		uploadID      = pathVars[IngestUploadIdPathParameterName]
+	)
+
+	if fileUploadJobID, err := strconv.ParseInt(fileUploadJob, 10, 64); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsIDMalformed, request), response)
+	} else if ingestUploadID, err := strconv.ParseInt(uploadID, 10, 64); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsIDMalformed, request), response)
+	} else if ingestJob, err = ingest.GetIngestJobByID(request.Context(), s.DB, fileUploadJobID); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if !inSelectedWorkspace(request, ingestJob) {
+		api.HandleDatabaseError(request, response, database.ErrNotFound)
+	} else if ingestUpload, err = s.DB.GetIngestUpload(request.Context(), ingestUploadID); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if ingestUpload.IngestJobID != ingestJob.ID {
+		api.HandleDatabaseError(request, response, database.ErrNotFound)
+	} else {
+		return ingestJob, ingestUpload, true
+	}
+
+	return ingestJob, ingestUpload, false
+}
+
+// parseUploadChecksum parses an Upload-Checksum header of the form "sha256 <base64 digest>". An empty header returns a
+// nil checksum.
+func parseUploadChecksum(value string) ([]byte, error) {
+	if value == "" {
+		return nil, nil
+	} else if algorithm, encoded, found := strings.Cut(value, " "); !found || !strings.EqualFold(algorithm, uploadChecksumAlgorithm) {
+		return nil, fmt.Errorf("%s header must use the %s algorithm", headers.UploadChecksum, uploadChecksumAlgorithm)
+	} else if checksum, err := base64.StdEncoding.DecodeString(encoded); err != nil {
+		return nil, fmt.Errorf("%s header must contain a base64 encoded digest", headers.UploadChecksum)
+	} else {
+		return checksum, nil
+	}
+}
+
+func handleIngestUploadError(request *http.Request, response http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, ingest.ErrUploadOffsetMismatch), errors.Is(err, ingest.ErrUploadConflict), errors.Is(err, ingest.ErrUploadCompleted):
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusConflict, err.Error(), request), response)
+	case errors.Is(err, ingest.ErrUploadSizeExceeded), errors.Is(err, ingest.ErrUploadChecksumMismatch), errors.Is(err, ingest.ErrUploadInvalid):
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
+	default:
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, fmt.Sprintf("Error writing upload chunk: %v", err), request), response)
+	}
+}
+
+// writeIngestUploadResponse writes the upload along with its received size in the Upload-Offset header so that clients
+// can resume from it
+func writeIngestUploadResponse(request *http.Request, response http.ResponseWriter, upload model.IngestUpload, statusCode int) {
+	response.Header().Set(headers.UploadOffset.String(), strconv.FormatInt(upload.ReceivedSize, 10))
+	api.WriteBasicResponse(request.Context(), upload, statusCode, response)
+}
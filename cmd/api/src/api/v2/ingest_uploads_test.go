@@ -0,0 +1,349 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/specterops/bloodhound/headers"
+	"github.com/specterops/bloodhound/mediatypes"
+	v2 "github.com/specterops/bloodhound/src/api/v2"
+	"github.com/specterops/bloodhound/src/api/v2/apitest"
+	dbMocks "github.com/specterops/bloodhound/src/database/mocks"
+	"github.com/specterops/bloodhound/src/model"
+	"github.com/specterops/bloodhound/src/services/ingest"
+	"github.com/specterops/bloodhound/src/services/ingest/storage"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func setIngestUploadURLVars(input *apitest.Input) {
+	apitest.SetURLVar(input, v2.FileUploadJobIdPathParameterName, "123")
+	apitest.SetURLVar(input, v2.IngestUploadIdPathParameterName, "7")
+}
+
+func TestResources_StartIngestUpload(t *testing.T) {
+	var (
+		mockCtrl  = gomock.NewController(t)
+		mockDB    = dbMocks.NewMockDatabase(mockCtrl)
+		resources = v2.Resources{DB: mockDB}
+	)
+	defer mockCtrl.Finish()
+
+	apitest.
+		NewHarness(t, resources.StartIngestUpload).
+		WithCommonRequest(func(input *apitest.Input) {
+			apitest.SetURLVar(input, v2.FileUploadJobIdPathParameterName, "123")
+			apitest.SetHeader(input, headers.ContentType.String(), mediatypes.ApplicationJson.String())
+		}).
+		Run([]apitest.Case{
+			{
+				Name: "InvalidJobID",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, v2.FileUploadJobIdPathParameterName, "invalid")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+				},
+			},
+			{
+				Name: "InvalidContentType",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, v2.StartIngestUploadRequest{ContentType: "text/plain", TotalSize: 10})
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "Content type must be")
+				},
+			},
+			{
+				Name: "InvalidTotalSize",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, v2.StartIngestUploadRequest{ContentType: "application/zip"})
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "total size must be greater than zero")
+				},
+			},
+			{
+				Name: "InvalidJobStatus",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, v2.StartIngestUploadRequest{ContentType: "application/zip", TotalSize: 10})
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetIngestJob(gomock.Any(), int64(123)).Return(model.IngestJob{Status: model.JobStatusIngesting}, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "job must be in running status")
+				},
+			},
+			{
+				Name: "Success",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, v2.StartIngestUploadRequest{ContentType: "application/zip", TotalSize: 10})
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetIngestJob(gomock.Any(), int64(123)).Return(model.IngestJob{Status: model.JobStatusRunning, BigSerial: model.BigSerial{ID: 123}}, nil)
+					mockDB.EXPECT().CreateIngestUpload(gomock.Any(), model.IngestUpload{IngestJobID: 123, FileType: model.FileTypeZip, TotalSize: 10}).
+						DoAndReturn(func(_ context.Context, upload model.IngestUpload) (model.IngestUpload, error) {
+							upload.ID = 7
+							return upload, nil
+						})
+				},
+				Test: func(output apitest.Output) {
+					var upload model.IngestUpload
+
+					apitest.StatusCode(output, http.StatusCreated)
+					apitest.UnmarshalData(output, &upload)
+					apitest.Equal(output, int64(7), upload.ID)
+				},
+			},
+		})
+}
+
+func TestResources_GetIngestUpload(t *testing.T) {
+	var (
+		mockCtrl  = gomock.NewController(t)
+		mockDB    = dbMocks.NewMockDatabase(mockCtrl)
+		resources = v2.Resources{DB: mockDB}
+		ingestJob = model.IngestJob{Status: model.JobStatusRunning, BigSerial: model.BigSerial{ID: 123}}
+	)
+	defer mockCtrl.Finish()
+
+	apitest.
+		NewHarness(t, resources.GetIngestUpload).
+		WithCommonRequest(setIngestUploadURLVars).
+		Run([]apitest.Case{
+			{
+				Name: "InvalidUploadID",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, v2.IngestUploadIdPathParameterName, "invalid")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+				},
+			},
+			{
+				Name: "UploadOfAnotherJob",
+				Setup: func() {
+					mockDB.EXPECT().GetIngestJob(gomock.Any(), int64(123)).Return(ingestJob, nil)
+					mockDB.EXPECT().GetIngestUpload(gomock.Any(), int64(7)).Return(model.IngestUpload{IngestJobID: 124}, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNotFound)
+				},
+			},
+			{
+				Name: "Success",
+				Setup: func() {
+					mockDB.EXPECT().GetIngestJob(gomock.Any(), int64(123)).Return(ingestJob, nil)
+					mockDB.EXPECT().GetIngestUpload(gomock.Any(), int64(7)).Return(model.IngestUpload{IngestJobID: 123, TotalSize: 10, ReceivedSize: 4}, nil)
+				},
+				Test: func(output apitest.Output) {
+					var upload model.IngestUpload
+
+					apitest.StatusCode(output, http.StatusOK)
+					apitest.UnmarshalData(output, &upload)
+					apitest.Equal(output, int64(4), upload.ReceivedSize)
+				},
+			},
+		})
+}
+
+func TestResources_WriteIngestUploadChunk(t *testing.T) {
+	var (
+		mockCtrl  = gomock.NewController(t)
+		mockDB    = dbMocks.NewMockDatabase(mockCtrl)
+		resources = v2.Resources{DB: mockDB, IngestStore: storage.NewFilesystemStore(t.TempDir())}
+		ingestJob = model.IngestJob{Status: model.JobStatusRunning, BigSerial: model.BigSerial{ID: 123}}
+		upload    = model.IngestUpload{IngestJobID: 123, FileType: model.FileTypeJson, TotalSize: 10, ReceivedSize: 4, BigSerial: model.BigSerial{ID: 7}}
+	)
+	defer mockCtrl.Finish()
+
+	apitest.
+		NewHarness(t, resources.WriteIngestUploadChunk).
+		WithCommonRequest(setIngestUploadURLVars).
+		Run([]apitest.Case{
+			{
+				Name: "MissingOffset",
+				Setup: func() {
+					mockDB.EXPECT().GetIngestJob(gomock.Any(), int64(123)).Return(ingestJob, nil)
+					mockDB.EXPECT().GetIngestUpload(gomock.Any(), int64(7)).Return(upload, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "Upload-Offset header must be a non-negative integer")
+				},
+			},
+			{
+				Name: "UnsupportedChecksumAlgorithm",
+				Input: func(input *apitest.Input) {
+					apitest.SetHeader(input, headers.UploadOffset.String(), "4")
+					apitest.SetHeader(input, headers.UploadChecksum.String(), "md5 AAAA")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetIngestJob(gomock.Any(), int64(123)).Return(ingestJob, nil)
+					mockDB.EXPECT().GetIngestUpload(gomock.Any(), int64(7)).Return(upload, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "Upload-Checksum header must use the sha256 algorithm")
+				},
+			},
+			{
+				Name: "OffsetMismatch",
+				Input: func(input *apitest.Input) {
+					apitest.SetHeader(input, headers.UploadOffset.String(), "0")
+					apitest.BodyString(input, "abcd")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetIngestJob(gomock.Any(), int64(123)).Return(ingestJob, nil)
+					mockDB.EXPECT().GetIngestUpload(gomock.Any(), int64(7)).Return(upload, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusConflict)
+				},
+			},
+			{
+				Name: "AppendDatabaseError",
+				Input: func(input *apitest.Input) {
+					apitest.SetHeader(input, headers.UploadOffset.String(), "4")
+					apitest.BodyString(input, "abcd")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetIngestJob(gomock.Any(), int64(123)).Return(ingestJob, nil)
+					mockDB.EXPECT().GetIngestUpload(gomock.Any(), int64(7)).Return(upload, nil)
+					mockDB.EXPECT().AppendIngestUploadChunk(gomock.Any(), gomock.Any()).Return(model.IngestUpload{}, errors.New("database error"))
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusInternalServerError)
+				},
+			},
+			{
+				Name: "PartialChunk",
+				Input: func(input *apitest.Input) {
+					checksum := sha256.Sum256([]byte("abcd"))
+
+					apitest.SetHeader(input, headers.UploadOffset.String(), "4")
+					apitest.SetHeader(input, headers.UploadChecksum.String(), "sha256 "+base64.StdEncoding.EncodeToString(checksum[:]))
+					apitest.BodyString(input, "abcd")
+				},
+				Setup: func() {
+					appended := upload
+					appended.ReceivedSize = 8
+
+					mockDB.EXPECT().GetIngestJob(gomock.Any(), int64(123)).Return(ingestJob, nil)
+					mockDB.EXPECT().GetIngestUpload(gomock.Any(), int64(7)).Return(upload, nil)
+					mockDB.EXPECT().AppendIngestUploadChunk(gomock.Any(), gomock.Any()).Return(appended, nil)
+					mockDB.EXPECT().TouchIngestJobLastIngest(gomock.Any(), gomock.Any()).Return(nil)
+				},
+				Test: func(output apitest.Output) {
+					var result model.IngestUpload
+
+					apitest.StatusCode(output, http.StatusOK)
+					apitest.UnmarshalData(output, &result)
+					apitest.Equal(output, int64(8), result.ReceivedSize)
+					apitest.Equal(output, false, result.Completed)
+				},
+			},
+			{
+				Name: "FinalChunkAssemblesUpload",
+				Input: func(input *apitest.Input) {
+					apitest.SetHeader(input, headers.UploadOffset.String(), "0")
+					apitest.BodyString(input, `{"meta": {"type": "domains", "version": 4, "count": 1}, "data": []}`)
+				},
+				Setup: func() {
+					var (
+						content = `{"meta": {"type": "domains", "version": 4, "count": 1}, "data": []}`
+						initial = model.IngestUpload{IngestJobID: 123, FileType: model.FileTypeJson, TotalSize: int64(len(content)), BigSerial: model.BigSerial{ID: 7}}
+						chunks  model.IngestUploadChunks
+					)
+
+					mockDB.EXPECT().GetIngestJob(gomock.Any(), int64(123)).Return(ingestJob, nil)
+					mockDB.EXPECT().GetIngestUpload(gomock.Any(), int64(7)).Return(initial, nil)
+					mockDB.EXPECT().AppendIngestUploadChunk(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, chunk model.IngestUploadChunk) (model.IngestUpload, error) {
+						chunks = append(chunks, chunk)
+
+						appended := initial
+						appended.ReceivedSize = chunk.Size
+						return appended, nil
+					})
+					mockDB.EXPECT().TouchIngestJobLastIngest(gomock.Any(), gomock.Any()).Return(nil)
+					mockDB.EXPECT().GetIngestUploadChunks(gomock.Any(), int64(7)).DoAndReturn(func(context.Context, int64) (model.IngestUploadChunks, error) {
+						return chunks, nil
+					})
+					mockDB.EXPECT().CompleteIngestUpload(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ model.IngestUpload, task model.IngestTask) (model.IngestTask, error) {
+						require.Equal(t, int64(123), task.TaskID.Int64)
+						return task, nil
+					})
+				},
+				Test: func(output apitest.Output) {
+					var result model.IngestUpload
+
+					apitest.StatusCode(output, http.StatusOK)
+					apitest.UnmarshalData(output, &result)
+					apitest.Equal(output, true, result.Completed)
+				},
+			},
+		})
+}
+
+func TestResources_DeleteIngestUpload(t *testing.T) {
+	var (
+		mockCtrl  = gomock.NewController(t)
+		mockDB    = dbMocks.NewMockDatabase(mockCtrl)
+		resources = v2.Resources{DB: mockDB, IngestStore: storage.NewFilesystemStore(t.TempDir())}
+		ingestJob = model.IngestJob{Status: model.JobStatusRunning, BigSerial: model.BigSerial{ID: 123}}
+	)
+	defer mockCtrl.Finish()
+
+	apitest.
+		NewHarness(t, resources.DeleteIngestUpload).
+		WithCommonRequest(setIngestUploadURLVars).
+		Run([]apitest.Case{
+			{
+				Name: "CompletedUpload",
+				Setup: func() {
+					mockDB.EXPECT().GetIngestJob(gomock.Any(), int64(123)).Return(ingestJob, nil)
+					mockDB.EXPECT().GetIngestUpload(gomock.Any(), int64(7)).Return(model.IngestUpload{IngestJobID: 123, Completed: true}, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusConflict)
+					apitest.BodyContains(output, ingest.ErrUploadCompleted.Error())
+				},
+			},
+			{
+				Name: "Success",
+				Setup: func() {
+					mockDB.EXPECT().GetIngestJob(gomock.Any(), int64(123)).Return(ingestJob, nil)
+					mockDB.EXPECT().GetIngestUpload(gomock.Any(), int64(7)).Return(model.IngestUpload{IngestJobID: 123, BigSerial: model.BigSerial{ID: 7}}, nil)
+					mockDB.EXPECT().GetIngestUploadChunks(gomock.Any(), int64(7)).Return(model.IngestUploadChunks{}, nil)
+					mockDB.EXPECT().DeleteIngestUpload(gomock.Any(), int64(7)).Return(nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNoContent)
+				},
+			},
+		})
+}
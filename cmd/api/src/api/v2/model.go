@@ -25,9 +25,11 @@ import (
 	"github.com/specterops/bloodhound/src/config"
 	"github.com/specterops/bloodhound/src/database"
 	"github.com/specterops/bloodhound/src/database/types/null"
+	"github.com/specterops/bloodhound/src/events"
 	"github.com/specterops/bloodhound/src/model"
 	"github.com/specterops/bloodhound/src/queries"
 	"github.com/specterops/bloodhound/src/serde"
+	"github.com/specterops/bloodhound/src/services/ingest/storage"
 )
 
 type ListPermissionsResponse struct {
@@ -108,6 +110,8 @@ type Resources struct {
 	CollectorManifests         config.CollectorManifests
 	Authorizer                 auth.Authorizer
 	Authenticator              api.Authenticator
+	Events                     *events.Bus
+	IngestStore                storage.Store
 }
 
 func NewResources(
@@ -119,6 +123,8 @@ func NewResources(
 	collectorManifests config.CollectorManifests,
 	authorizer auth.Authorizer,
 	authenticator api.Authenticator,
+	eventBus *events.Bus,
+	ingestStore storage.Store,
 ) Resources {
 	return Resources{
 		Decoder:                    schema.NewDecoder(),
@@ -131,5 +137,7 @@ func NewResources(
 		CollectorManifests:         collectorManifests,
 		Authorizer:                 authorizer,
 		Authenticator:              authenticator,
+		Events:                     eventBus,
+		IngestStore:                ingestStore,
 	}
 }
@@ -29,6 +29,7 @@ import (
 	"github.com/specterops/bloodhound/slicesext"
 	"github.com/specterops/bloodhound/src/api"
 	"github.com/specterops/bloodhound/src/api/bloodhoundgraph"
+	"github.com/specterops/bloodhound/src/api/graphexport"
 	"github.com/specterops/bloodhound/src/model"
 	"github.com/specterops/bloodhound/src/queries"
 )
@@ -55,6 +56,8 @@ func (s Resources) GetPathfindingResult(response http.ResponseWriter, request *h
 func writeShortestPathsResult(paths graph.PathSet, response http.ResponseWriter, request *http.Request) {
 	if paths.Len() == 0 {
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusNotFound, "Path not found", request), response)
+	} else if exportFormat, isExport := graphexport.NegotiateFormat(request.Header); isExport {
+		api.WriteGraphExportResponse(request.Context(), graphexport.FromPathSet(paths), exportFormat, http.StatusOK, response)
 	} else {
 		graphResponse := model.NewUnifiedGraph()
 
@@ -109,8 +112,8 @@ func parseRelationshipKindsParam(validKinds graph.Kinds, relationshipKindsParam
 	return validKinds, "in", nil
 }
 
-func parseRelationshipKindsParamFilter(relationshipKindsParam string) (graph.Criteria, error) {
-	validKinds := graph.Kinds(ad.Relationships()).Concatenate(azure.Relationships())
+func parseRelationshipKindsParamFilter(genericKinds graph.Kinds, relationshipKindsParam string) (graph.Criteria, error) {
+	validKinds := graph.Kinds(ad.Relationships()).Concatenate(azure.Relationships()).Concatenate(genericKinds)
 
 	if filterKinds, filterOperation, err := parseRelationshipKindsParam(validKinds, relationshipKindsParam); err != nil {
 		return nil, err
@@ -133,7 +136,9 @@ func (s Resources) GetShortestPath(response http.ResponseWriter, request *http.R
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "Missing query parameter: start_node", request), response)
 	} else if endNode == "" {
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "Missing query parameter: end_node", request), response)
-	} else if kindFilter, err := parseRelationshipKindsParamFilter(relationshipKindsParam); err != nil {
+	} else if genericKinds, err := s.DB.GetGenericKinds(request.Context()); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if kindFilter, err := parseRelationshipKindsParamFilter(genericKinds.EdgeKinds(), relationshipKindsParam); err != nil {
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
 	} else if paths, err := s.GraphQuery.GetAllShortestPaths(request.Context(), startNode, endNode, kindFilter); err != nil {
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, err.Error(), request), response)
@@ -26,6 +26,8 @@ import (
 	"github.com/specterops/bloodhound/src/api"
 	v2 "github.com/specterops/bloodhound/src/api/v2"
 	"github.com/specterops/bloodhound/src/api/v2/apitest"
+	dbMocks "github.com/specterops/bloodhound/src/database/mocks"
+	"github.com/specterops/bloodhound/src/model"
 	mocks_graph "github.com/specterops/bloodhound/src/queries/mocks"
 	"go.uber.org/mock/gomock"
 )
@@ -95,7 +97,8 @@ func TestResources_GetShortestPath(t *testing.T) {
 	var (
 		mockCtrl  = gomock.NewController(t)
 		mockGraph = mocks_graph.NewMockGraph(mockCtrl)
-		resources = v2.Resources{GraphQuery: mockGraph}
+		mockDB    = dbMocks.NewMockDatabase(mockCtrl)
+		resources = v2.Resources{GraphQuery: mockGraph, DB: mockDB}
 	)
 	defer mockCtrl.Finish()
 
@@ -127,6 +130,11 @@ func TestResources_GetShortestPath(t *testing.T) {
 					apitest.AddQueryParam(input, "end_node", "someOtherID")
 					apitest.AddQueryParam(input, "relationship_kinds", "wrx")
 				},
+				Setup: func() {
+					mockDB.EXPECT().
+						GetGenericKinds(gomock.Any()).
+						Return(model.GenericKinds{}, nil)
+				},
 				Test: func(output apitest.Output) {
 					apitest.StatusCode(output, http.StatusBadRequest)
 					apitest.UnmarshalBody(output, &api.ErrorWrapper{})
@@ -140,6 +148,11 @@ func TestResources_GetShortestPath(t *testing.T) {
 					apitest.AddQueryParam(input, "end_node", "someOtherID")
 					apitest.AddQueryParam(input, "relationship_kinds", "abcd:Owns,GenericAll,GenericWrite")
 				},
+				Setup: func() {
+					mockDB.EXPECT().
+						GetGenericKinds(gomock.Any()).
+						Return(model.GenericKinds{}, nil)
+				},
 				Test: func(output apitest.Output) {
 					apitest.StatusCode(output, http.StatusBadRequest)
 					apitest.UnmarshalBody(output, &api.ErrorWrapper{})
@@ -153,6 +166,11 @@ func TestResources_GetShortestPath(t *testing.T) {
 					apitest.AddQueryParam(input, "end_node", "someOtherID")
 					apitest.AddQueryParam(input, "relationship_kinds", "abcd:")
 				},
+				Setup: func() {
+					mockDB.EXPECT().
+						GetGenericKinds(gomock.Any()).
+						Return(model.GenericKinds{}, nil)
+				},
 				Test: func(output apitest.Output) {
 					apitest.StatusCode(output, http.StatusBadRequest)
 					apitest.UnmarshalBody(output, &api.ErrorWrapper{})
@@ -165,11 +183,31 @@ func TestResources_GetShortestPath(t *testing.T) {
 					apitest.AddQueryParam(input, "end_node", "someOtherID")
 					apitest.AddQueryParam(input, "relationship_kinds", "in:Owns,avbcs,GenericAll")
 				},
+				Setup: func() {
+					mockDB.EXPECT().
+						GetGenericKinds(gomock.Any()).
+						Return(model.GenericKinds{}, nil)
+				},
 				Test: func(output apitest.Output) {
 					apitest.StatusCode(output, http.StatusBadRequest)
 					apitest.UnmarshalBody(output, &api.ErrorWrapper{})
 				},
 			},
+			{
+				Name: "DatabaseGetGenericKindsError",
+				Input: func(input *apitest.Input) {
+					apitest.AddQueryParam(input, "start_node", "someID")
+					apitest.AddQueryParam(input, "end_node", "someOtherID")
+				},
+				Setup: func() {
+					mockDB.EXPECT().
+						GetGenericKinds(gomock.Any()).
+						Return(nil, errors.New("database error"))
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusInternalServerError)
+				},
+			},
 			{
 				Name: "GraphDBGetShortestPathsError",
 				Input: func(input *apitest.Input) {
@@ -177,6 +215,9 @@ func TestResources_GetShortestPath(t *testing.T) {
 					apitest.AddQueryParam(input, "end_node", "someOtherID")
 				},
 				Setup: func() {
+					mockDB.EXPECT().
+						GetGenericKinds(gomock.Any()).
+						Return(model.GenericKinds{}, nil)
 					mockGraph.EXPECT().
 						GetAllShortestPaths(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 						Return(nil, errors.New("graph error"))
@@ -194,6 +235,9 @@ func TestResources_GetShortestPath(t *testing.T) {
 					apitest.AddQueryParam(input, "end_node", "someOtherID")
 				},
 				Setup: func() {
+					mockDB.EXPECT().
+						GetGenericKinds(gomock.Any()).
+						Return(model.GenericKinds{}, nil)
 					mockGraph.EXPECT().
 						GetAllShortestPaths(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 						Return(graph.NewPathSet(), nil)
@@ -212,6 +256,9 @@ func TestResources_GetShortestPath(t *testing.T) {
 					apitest.AddQueryParam(input, "relationship_kinds", "nin:Owns,GenericAll,AZMGServicePrincipalEndpoint_ReadWrite_All")
 				},
 				Setup: func() {
+					mockDB.EXPECT().
+						GetGenericKinds(gomock.Any()).
+						Return(model.GenericKinds{}, nil)
 					mockGraph.EXPECT().
 						GetAllShortestPaths(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 						Return(graph.NewPathSet(), nil)
@@ -228,6 +275,9 @@ func TestResources_GetShortestPath(t *testing.T) {
 					apitest.AddQueryParam(input, "relationship_kinds", "in:Owns,GenericAll,GenericWrite,AZMGServicePrincipalEndpoint_ReadWrite_All")
 				},
 				Setup: func() {
+					mockDB.EXPECT().
+						GetGenericKinds(gomock.Any()).
+						Return(model.GenericKinds{}, nil)
 					mockGraph.EXPECT().
 						GetAllShortestPaths(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 						Return(graph.NewPathSet(), nil)
@@ -244,6 +294,9 @@ func TestResources_GetShortestPath(t *testing.T) {
 					apitest.AddQueryParam(input, "relationship_kinds", "nin:Owns,GenericAll,AZMGServicePrincipalEndpoint_ReadWrite_All")
 				},
 				Setup: func() {
+					mockDB.EXPECT().
+						GetGenericKinds(gomock.Any()).
+						Return(model.GenericKinds{}, nil)
 					mockGraph.EXPECT().
 						GetAllShortestPaths(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 						Return(graph.NewPathSet(graph.Path{
@@ -282,6 +335,9 @@ func TestResources_GetShortestPath(t *testing.T) {
 					apitest.AddQueryParam(input, "relationship_kinds", "in:Owns,GenericAll,GenericWrite,AZMGServicePrincipalEndpoint_ReadWrite_All")
 				},
 				Setup: func() {
+					mockDB.EXPECT().
+						GetGenericKinds(gomock.Any()).
+						Return(model.GenericKinds{}, nil)
 					mockGraph.EXPECT().
 						GetAllShortestPaths(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 						Return(graph.NewPathSet(graph.Path{
@@ -320,6 +376,9 @@ func TestResources_GetShortestPath(t *testing.T) {
 					apitest.AddQueryParam(input, "relationship_kinds", "in:Owns")
 				},
 				Setup: func() {
+					mockDB.EXPECT().
+						GetGenericKinds(gomock.Any()).
+						Return(model.GenericKinds{}, nil)
 					mockGraph.EXPECT().
 						GetAllShortestPaths(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 						Return(graph.NewPathSet(), nil)
@@ -328,6 +387,41 @@ func TestResources_GetShortestPath(t *testing.T) {
 					apitest.StatusCode(output, http.StatusNotFound)
 				},
 			},
+			{
+				Name: "SuccessInGenericKind",
+				Input: func(input *apitest.Input) {
+					apitest.AddQueryParam(input, "start_node", "someID")
+					apitest.AddQueryParam(input, "end_node", "someOtherID")
+					apitest.AddQueryParam(input, "relationship_kinds", "in:Owns,GHCanPush")
+				},
+				Setup: func() {
+					mockDB.EXPECT().
+						GetGenericKinds(gomock.Any()).
+						Return(model.GenericKinds{{Name: "GHUser"}, {Name: "GHCanPush", IsEdge: true}}, nil)
+					mockGraph.EXPECT().
+						GetAllShortestPaths(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+						Return(graph.NewPathSet(), nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNotFound)
+				},
+			},
+			{
+				Name: "InvalidGenericNodeKind",
+				Input: func(input *apitest.Input) {
+					apitest.AddQueryParam(input, "start_node", "someID")
+					apitest.AddQueryParam(input, "end_node", "someOtherID")
+					apitest.AddQueryParam(input, "relationship_kinds", "in:GHUser")
+				},
+				Setup: func() {
+					mockDB.EXPECT().
+						GetGenericKinds(gomock.Any()).
+						Return(model.GenericKinds{{Name: "GHUser"}, {Name: "GHCanPush", IsEdge: true}}, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+				},
+			},
 		})
 }
 
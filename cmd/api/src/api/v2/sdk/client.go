@@ -0,0 +1,413 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package sdk is a typed client for every route documented by the BloodHound OpenAPI spec. The operations and types
+// of this package are generated from the spec by schemagen; this file holds the transport that they are built on.
+package sdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/specterops/bloodhound/headers"
+	"github.com/specterops/bloodhound/mediatypes"
+	"github.com/specterops/bloodhound/src/api"
+	"github.com/specterops/bloodhound/src/api/v2/apiclient"
+)
+
+const (
+	// DefaultPageSize is the page size requested by pagination iterators when the given parameters do not set a limit.
+	DefaultPageSize = 100
+
+	mediaTypeEventStream = "text/event-stream"
+)
+
+type Client struct {
+	Credentials apiclient.CredentialsHandler
+	HTTP        *http.Client
+	ServiceURL  url.URL
+}
+
+// NewClient returns a client for the BloodHound instance at the given URL. Requests are authenticated with the given
+// credentials handler which may be nil for unauthenticated routes.
+func NewClient(rawServiceURL string, credentials apiclient.CredentialsHandler) (*Client, error) {
+	if serviceURL, err := url.Parse(rawServiceURL); err != nil {
+		return nil, err
+	} else {
+		return &Client{
+			Credentials: credentials,
+			HTTP: &http.Client{
+				Timeout: time.Minute,
+			},
+			ServiceURL: *serviceURL,
+		}, nil
+	}
+}
+
+// NewTokenClient returns a client that signs each request with the given API token.
+func NewTokenClient(rawServiceURL, tokenID, tokenKey string) (*Client, error) {
+	return NewClient(rawServiceURL, &apiclient.TokenCredentialsHandler{
+		TokenID:  tokenID,
+		TokenKey: tokenKey,
+	})
+}
+
+// StatusCode returns the HTTP status code of an error returned by the API or zero if the error did not originate from
+// an API response.
+func StatusCode(err error) int {
+	var apiError api.ErrorWrapper
+
+	if errors.As(err, &apiError) {
+		return apiError.HTTPStatus
+	}
+
+	return 0
+}
+
+type request struct {
+	method      string
+	path        string
+	parameters  any
+	body        any
+	rawBody     io.Reader
+	contentType string
+}
+
+func (s *Client) newRequest(ctx context.Context, spec request) (*http.Request, error) {
+	var (
+		endpoint = api.URLJoinPath(s.ServiceURL, spec.path)
+		body     io.Reader
+	)
+
+	query, header, err := encodeParameters(spec.parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint.RawQuery = query.Encode()
+
+	if spec.rawBody != nil {
+		body = spec.rawBody
+	} else if spec.body != nil {
+		buffer := &bytes.Buffer{}
+
+		if err := json.NewEncoder(buffer).Encode(spec.body); err != nil {
+			return nil, fmt.Errorf("failed encoding request body: %w", err)
+		}
+
+		body = buffer
+		header.Set(headers.ContentType.String(), mediatypes.ApplicationJson.String())
+	}
+
+	request, err := http.NewRequestWithContext(ctx, spec.method, endpoint.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	for name, values := range header {
+		request.Header[name] = values
+	}
+
+	if spec.rawBody != nil {
+		request.Header.Set(headers.ContentType.String(), spec.contentType)
+	}
+
+	// Credentials are handled last as request signing covers the request body
+	if s.Credentials != nil {
+		if err := s.Credentials.Handle(request); err != nil {
+			return nil, err
+		}
+	}
+
+	return request, nil
+}
+
+// send sends the request and returns the response if it carries a 2XX status code. Any other response is read into
+// an api.ErrorWrapper and returned as the error.
+func (s *Client) send(ctx context.Context, spec request) (*http.Response, error) {
+	if request, err := s.newRequest(ctx, spec); err != nil {
+		return nil, err
+	} else if response, err := s.HTTP.Do(request); err != nil {
+		return nil, err
+	} else if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		defer response.Body.Close()
+		return nil, readError(response)
+	} else {
+		return response, nil
+	}
+}
+
+// do sends the request and decodes the JSON response body into result if it is not nil.
+func (s *Client) do(ctx context.Context, spec request, result any) error {
+	if response, err := s.send(ctx, spec); err != nil {
+		return err
+	} else {
+		defer response.Body.Close()
+
+		if result == nil || response.StatusCode == http.StatusNoContent {
+			return nil
+		} else if err := json.NewDecoder(response.Body).Decode(result); err != nil {
+			return fmt.Errorf("failed decoding response body of %s %s: %w", spec.method, spec.path, err)
+		}
+
+		return nil
+	}
+}
+
+// stream sends the request and returns the response body for the caller to read and close.
+func (s *Client) stream(ctx context.Context, spec request) (io.ReadCloser, error) {
+	if response, err := s.send(ctx, spec); err != nil {
+		return nil, err
+	} else {
+		return response.Body, nil
+	}
+}
+
+// StreamEventsParams holds the header parameters of StreamEvents. Nil fields are omitted.
+type StreamEventsParams struct {
+	// The ID of the last event received by the client.
+	LastEventID *int64 `header:"Last-Event-ID"`
+	// The ID of the workspace whose events are streamed. Events of the default graph are streamed when nil.
+	Workspace *int32 `header:"Workspace"`
+
+	// Accept is set by StreamEvents to select the event stream over the client schedules of ListClientSchedules.
+	Accept string `header:"Accept"`
+}
+
+// StreamEvents sends GET /api/v2/events accepting text/event-stream and returns the stream of datapipe events for the
+// caller to read and close. The generated ListClientSchedules sends the same route accepting JSON.
+func (s *Client) StreamEvents(ctx context.Context, params StreamEventsParams) (io.ReadCloser, error) {
+	params.Accept = mediaTypeEventStream
+
+	return s.stream(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/events",
+	})
+}
+
+func readError(response *http.Response) error {
+	var (
+		apiError api.ErrorWrapper
+		content  []byte
+		err      error
+	)
+
+	if content, err = io.ReadAll(response.Body); err != nil {
+		return fmt.Errorf("failed reading error response: %w", err)
+	} else if json.Unmarshal(content, &apiError) == nil && len(apiError.Errors) > 0 {
+		apiError.HTTPStatus = response.StatusCode
+		return apiError
+	}
+
+	message := strings.TrimSpace(string(content))
+	if message == "" {
+		message = http.StatusText(response.StatusCode)
+	}
+
+	return api.ErrorWrapper{
+		HTTPStatus: response.StatusCode,
+		Errors: []api.ErrorDetails{{
+			Message: message,
+		}},
+	}
+}
+
+func formatParameter(value reflect.Value) string {
+	switch typed := value.Interface().(type) {
+	case time.Time:
+		return typed.Format(time.RFC3339Nano)
+	default:
+		return fmt.Sprint(typed)
+	}
+}
+
+func pathParameter(value any) string {
+	return url.PathEscape(formatParameter(reflect.ValueOf(value)))
+}
+
+// encodeParameters encodes the fields of a generated parameters struct as query values and headers. Nil fields are
+// omitted and each element of a slice field is encoded as a repeated value.
+func encodeParameters(parameters any) (url.Values, http.Header, error) {
+	var (
+		query  = url.Values{}
+		header = http.Header{}
+		value  = reflect.ValueOf(parameters)
+	)
+
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return query, header, nil
+		}
+
+		value = value.Elem()
+	}
+
+	if !value.IsValid() {
+		return query, header, nil
+	} else if value.Kind() != reflect.Struct {
+		return nil, nil, fmt.Errorf("parameters must be a struct but got %s", value.Type())
+	}
+
+	for idx := 0; idx < value.NumField(); idx++ {
+		var (
+			field = value.Type().Field(idx)
+			add   func(value string)
+		)
+
+		if name, isQuery := field.Tag.Lookup("query"); isQuery {
+			add = func(value string) { query.Add(name, value) }
+		} else if name, isHeader := field.Tag.Lookup("header"); isHeader {
+			add = func(value string) { header.Add(name, value) }
+		} else {
+			continue
+		}
+
+		switch fieldValue := value.Field(idx); fieldValue.Kind() {
+		case reflect.Pointer:
+			if !fieldValue.IsNil() {
+				add(formatParameter(fieldValue.Elem()))
+			}
+
+		case reflect.Slice:
+			for elementIdx := 0; elementIdx < fieldValue.Len(); elementIdx++ {
+				add(formatParameter(fieldValue.Index(elementIdx)))
+			}
+
+		case reflect.String:
+			if fieldValue.String() != "" {
+				add(fieldValue.String())
+			}
+
+		default:
+			add(formatParameter(fieldValue))
+		}
+	}
+
+	return query, header, nil
+}
+
+// paginate returns an iterator over every item of a skip and limit paginated route. Pages are requested starting from
+// the given skip until a page shorter than the page size is returned.
+func paginate[T any](skip, limit *int, fetch func(skip, limit int) ([]T, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		offset, pageSize := 0, DefaultPageSize
+
+		if skip != nil {
+			offset = *skip
+		}
+
+		if limit != nil && *limit > 0 {
+			pageSize = *limit
+		}
+
+		for {
+			page, err := fetch(offset, pageSize)
+
+			if err != nil {
+				var empty T
+				yield(empty, err)
+				return
+			}
+
+			for _, item := range page {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if len(page) < pageSize {
+				return
+			}
+
+			offset += len(page)
+		}
+	}
+}
+
+// Nullable is a value that the API may send as null, as the value itself or as an object holding the value along with
+// a valid flag. Nullable values are always sent as either null or the value.
+type Nullable[T any] struct {
+	Value T
+	Valid bool
+}
+
+// NullableValue returns a valid Nullable holding the given value.
+func NullableValue[T any](value T) Nullable[T] {
+	return Nullable[T]{
+		Value: value,
+		Valid: true,
+	}
+}
+
+func (s Nullable[T]) MarshalJSON() ([]byte, error) {
+	if !s.Valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(s.Value)
+}
+
+func (s *Nullable[T]) UnmarshalJSON(content []byte) error {
+	var empty T
+
+	s.Value, s.Valid = empty, false
+
+	// Nullable values are scalars so an object is the value and valid flag form
+	if trimmed := bytes.TrimSpace(content); bytes.Equal(trimmed, []byte("null")) {
+		return nil
+	} else if bytes.HasPrefix(trimmed, []byte("{")) {
+		var fields map[string]json.RawMessage
+
+		if err := json.Unmarshal(trimmed, &fields); err != nil {
+			return err
+		}
+
+		for name, value := range fields {
+			if strings.EqualFold(name, "valid") {
+				if err := json.Unmarshal(value, &s.Valid); err != nil {
+					return err
+				}
+			} else if err := json.Unmarshal(value, &s.Value); err != nil {
+				return err
+			}
+		}
+
+		if !s.Valid {
+			s.Value = empty
+		}
+
+		return nil
+	}
+
+	s.Valid = true
+	return json.Unmarshal(content, &s.Value)
+}
+
+// Ptr returns a pointer to the given value for setting optional parameters.
+func Ptr[T any](value T) *T {
+	return &value
+}
@@ -0,0 +1,799 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sdk_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/gorilla/mux"
+	"github.com/specterops/bloodhound/analysis/simulation"
+	"github.com/specterops/bloodhound/dawgs"
+	"github.com/specterops/bloodhound/dawgs/drivers/memory"
+	"github.com/specterops/bloodhound/dawgs/graph"
+	"github.com/specterops/bloodhound/graphschema/ad"
+	"github.com/specterops/bloodhound/graphschema/common"
+	"github.com/specterops/bloodhound/headers"
+	"github.com/specterops/bloodhound/mediatypes"
+	"github.com/specterops/bloodhound/openapi"
+	"github.com/specterops/bloodhound/src/api"
+	"github.com/specterops/bloodhound/src/api/graphexport"
+	v2 "github.com/specterops/bloodhound/src/api/v2"
+	"github.com/specterops/bloodhound/src/api/v2/sdk"
+	"github.com/specterops/bloodhound/src/auth"
+	bhCtx "github.com/specterops/bloodhound/src/ctx"
+	"github.com/specterops/bloodhound/src/database/mocks"
+	"github.com/specterops/bloodhound/src/database/types"
+	"github.com/specterops/bloodhound/src/database/types/null"
+	"github.com/specterops/bloodhound/src/events"
+	"github.com/specterops/bloodhound/src/model"
+	"github.com/specterops/bloodhound/src/model/appcfg"
+	"github.com/specterops/bloodhound/src/queries"
+	queriesMocks "github.com/specterops/bloodhound/src/queries/mocks"
+	"github.com/specterops/bloodhound/src/services/ingest/storage"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// contractActor is the administrator that every request served by a contractServer is authenticated as.
+var contractActor = model.User{
+	Unique: model.Unique{
+		ID: uuid.Must(uuid.NewV4()),
+	},
+	Roles: model.Roles{{
+		Name:        auth.RoleAdministrator,
+		Permissions: auth.Permissions().All(),
+	}},
+}
+
+// recordingWriter captures the response written by a handler so that it may be validated against the spec.
+type recordingWriter struct {
+	http.ResponseWriter
+
+	statusCode int
+	body       bytes.Buffer
+}
+
+func (s *recordingWriter) WriteHeader(statusCode int) {
+	s.statusCode = statusCode
+	s.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (s *recordingWriter) Write(content []byte) (int, error) {
+	if s.statusCode == 0 {
+		s.statusCode = http.StatusOK
+	}
+
+	s.body.Write(content)
+	return s.ResponseWriter.Write(content)
+}
+
+// Unwrap allows handlers to flush streamed responses through an http.ResponseController.
+func (s *recordingWriter) Unwrap() http.ResponseWriter {
+	return s.ResponseWriter
+}
+
+// contractServer serves handlers and validates every response they write against the OpenAPI spec.
+type contractServer struct {
+	document   *openapi.Document
+	router     *mux.Router
+	violations []error
+	lock       sync.Mutex
+}
+
+func newContractServer(t *testing.T) *contractServer {
+	document, err := openapi.Spec()
+	require.Nil(t, err)
+
+	server := &contractServer{
+		document: document,
+		router:   mux.NewRouter(),
+	}
+
+	server.router.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+			recorder := &recordingWriter{
+				ResponseWriter: response,
+			}
+
+			request = bhCtx.SetRequestContext(request, &bhCtx.Context{
+				AuthCtx: auth.Context{
+					Owner: contractActor,
+				},
+			})
+
+			next.ServeHTTP(recorder, request)
+
+			if err := document.ValidateResponse(request.Method, request.URL.Path, recorder.statusCode, recorder.Header().Get(headers.ContentType.String()), recorder.body.Bytes()); err != nil {
+				server.lock.Lock()
+				defer server.lock.Unlock()
+
+				server.violations = append(server.violations, err)
+			}
+		})
+	})
+
+	return server
+}
+
+func (s *contractServer) handle(method, path string, handler http.HandlerFunc) {
+	s.router.HandleFunc(path, handler).Methods(method)
+}
+
+// start serves the handlers registered so far and returns a client of the generated SDK along with the URL of the
+// server. The server is closed when the test completes.
+func (s *contractServer) start(t *testing.T) (*sdk.Client, string) {
+	httpServer := httptest.NewServer(s.router)
+	t.Cleanup(httpServer.Close)
+
+	client, err := sdk.NewClient(httpServer.URL, nil)
+	require.Nil(t, err)
+
+	return client, httpServer.URL
+}
+
+// requireNoViolations fails the test if any response served so far did not match the spec.
+func (s *contractServer) requireNoViolations(t *testing.T) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	require.Empty(t, s.violations)
+}
+
+func TestContract(t *testing.T) {
+	var (
+		mockCtrl  = gomock.NewController(t)
+		mockDB    = mocks.NewMockDatabase(mockCtrl)
+		resources = v2.Resources{DB: mockDB}
+		server    = newContractServer(t)
+		ctx       = context.Background()
+		now       = time.Now().UTC()
+		userID    = uuid.Must(uuid.NewV4())
+	)
+
+	server.handle(http.MethodGet, "/api/version", v2.GetVersion)
+	server.handle(http.MethodGet, "/api/v2/audit", resources.ListAuditLogs)
+	server.handle(http.MethodGet, "/api/v2/file-upload", resources.ListFileUploadJobs)
+	server.handle(http.MethodGet, "/api/v2/datapipe/status", resources.GetDatapipeStatus)
+	server.handle(http.MethodGet, "/api/v2/asset-groups", resources.ListAssetGroups)
+
+	client, _ := server.start(t)
+
+	mockDB.EXPECT().ListAuditLogs(gomock.Any(), gomock.Any(), gomock.Any(), 0, 2, gomock.Any(), gomock.Any()).Return(model.AuditLogs{{
+		ID:              1,
+		CreatedAt:       now,
+		ActorID:         userID.String(),
+		ActorName:       "admin",
+		ActorEmail:      "admin@example.com",
+		Action:          model.AuditLogActionCreateUser,
+		Fields:          types.JSONUntypedObject{"principal_name": "analyst"},
+		RequestID:       uuid.Must(uuid.NewV4()).String(),
+		SourceIpAddress: "127.0.0.1",
+		Status:          model.AuditLogStatusSuccess,
+		CommitID:        uuid.Must(uuid.NewV4()),
+	}}, 1, nil)
+
+	mockDB.EXPECT().GetAllIngestJobs(gomock.Any(), 0, 10, gomock.Any(), gomock.Any()).Return([]model.IngestJob{{
+		UserID:           userID,
+		UserEmailAddress: null.StringFrom("admin@example.com"),
+		Status:           model.JobStatusComplete,
+		StatusMessage:    "Complete",
+		StartTime:        now,
+		EndTime:          now,
+		LastIngest:       now,
+		TotalFiles:       2,
+		FailedFiles:      0,
+		BigSerial: model.BigSerial{
+			ID: 1,
+			Basic: model.Basic{
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+		},
+	}}, 1, nil)
+
+	mockDB.EXPECT().GetDatapipeStatus(gomock.Any()).Return(model.DatapipeStatusWrapper{
+		Status:                 model.DatapipeStatusIdle,
+		UpdatedAt:              now,
+		LastCompleteAnalysisAt: now,
+		LastAnalysisRunAt:      now,
+	}, nil)
+
+	mockDB.EXPECT().GetAllAssetGroups(gomock.Any(), gomock.Any(), gomock.Any()).Return(model.AssetGroups{{
+		Name:        "Admin Tier Zero",
+		Tag:         model.TierZeroAssetGroupTag,
+		SystemGroup: true,
+		Selectors: model.AssetGroupSelectors{{
+			AssetGroupID: 1,
+			Name:         "S-1-5-21-1",
+			Selector:     "S-1-5-21-1",
+			Serial: model.Serial{
+				ID: 1,
+			},
+		}},
+		MemberCount: 1,
+		Serial: model.Serial{
+			ID: 1,
+		},
+	}}, nil)
+
+	versionResponse, err := client.GetApiVersion(ctx, nil)
+	require.Nil(t, err)
+	require.Equal(t, "v2", versionResponse.Data.API.CurrentVersion)
+
+	auditLogs, err := client.ListAuditLogs(ctx, &sdk.ListAuditLogsParams{
+		Limit: sdk.Ptr(2),
+	})
+	require.Nil(t, err)
+	require.Len(t, auditLogs.Data.Logs, 1)
+	require.Equal(t, "admin", auditLogs.Data.Logs[0].ActorName)
+
+	var jobs []sdk.FileUploadJob
+
+	for job, err := range client.ListFileUploadJobsAll(ctx, sdk.ListFileUploadJobsParams{Limit: sdk.Ptr(10)}) {
+		require.Nil(t, err)
+		jobs = append(jobs, job)
+	}
+
+	require.Len(t, jobs, 1)
+	require.Equal(t, 2, jobs[0].TotalFiles)
+
+	datapipeStatus, err := client.GetDatapipeStatus(ctx, nil)
+	require.Nil(t, err)
+	require.Equal(t, sdk.DatapipeStatusIdle, datapipeStatus.Data.Status)
+
+	assetGroups, err := client.ListAssetGroups(ctx, nil)
+	require.Nil(t, err)
+	require.Len(t, assetGroups.Data.AssetGroups, 1)
+
+	server.requireNoViolations(t)
+}
+
+func TestContract_AssetGroupTags(t *testing.T) {
+	var (
+		mockCtrl  = gomock.NewController(t)
+		mockDB    = mocks.NewMockDatabase(mockCtrl)
+		mockGraph = queriesMocks.NewMockGraph(mockCtrl)
+		resources = v2.Resources{DB: mockDB, GraphQuery: mockGraph}
+		server    = newContractServer(t)
+		ctx       = context.Background()
+		now       = time.Now().UTC()
+		actorID   = contractActor.ID.String()
+		tierZero  = model.AssetGroupTag{
+			ID:             1,
+			Type:           model.AssetGroupTagTypeTier,
+			KindId:         1,
+			Name:           "Tier Zero",
+			CreatedAt:      now,
+			CreatedBy:      model.AssetGroupActorSystem,
+			UpdatedAt:      now,
+			UpdatedBy:      model.AssetGroupActorSystem,
+			Position:       null.Int32From(model.AssetGroupTierZeroPosition),
+			RequireCertify: null.BoolFrom(false),
+		}
+		tierOne = model.AssetGroupTag{
+			ID:             2,
+			Type:           model.AssetGroupTagTypeTier,
+			KindId:         2,
+			Name:           "Tier One",
+			Description:    "Tier One",
+			CreatedAt:      now,
+			CreatedBy:      actorID,
+			UpdatedAt:      now,
+			UpdatedBy:      actorID,
+			Position:       null.Int32From(2),
+			RequireCertify: null.BoolFrom(true),
+		}
+		members = graph.NewNodeSet(
+			graph.NewNode(1, graph.AsProperties(map[string]any{common.ObjectID.String(): "S-1-5-21-1-1000", common.Name.String(): "USER@EXAMPLE.COM"}), ad.Entity, ad.User, tierOne.ToKind()),
+		)
+		tagPath    = fmt.Sprintf("/api/v2/asset-group-tags/{%s}", api.URIPathVariableAssetGroupTagID)
+		membersURL = fmt.Sprintf("/api/v2/asset-group-tags/{%s}/members", api.URIPathVariableAssetGroupTagID)
+	)
+
+	server.handle(http.MethodGet, "/api/v2/asset-group-tags", resources.GetAssetGroupTags)
+	server.handle(http.MethodPost, "/api/v2/asset-group-tags", resources.CreateAssetGroupTag)
+	server.handle(http.MethodGet, tagPath, resources.GetAssetGroupTag)
+	server.handle(http.MethodPatch, tagPath, resources.UpdateAssetGroupTag)
+	server.handle(http.MethodDelete, tagPath, resources.DeleteAssetGroupTag)
+	server.handle(http.MethodGet, membersURL, resources.GetAssetGroupTagMembers)
+	server.handle(http.MethodGet, membersURL+"/counts", resources.GetAssetGroupTagMemberCountsByKind)
+	server.handle(http.MethodPut, fmt.Sprintf("%s/{%s}/certification", membersURL, api.URIPathVariableObjectID), resources.UpdateAssetGroupTagMemberCertification)
+
+	client, _ := server.start(t)
+
+	mockDB.EXPECT().GetAssetGroupTags(gomock.Any(), gomock.Any()).Return(model.AssetGroupTags{tierZero, tierOne}, nil)
+	mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), tierOne.ID).Return(tierOne, nil).AnyTimes()
+	mockDB.EXPECT().CreateAssetGroupTag(gomock.Any(), model.AssetGroupTagTypeLabel, actorID, "Owned", "", null.Int32{}, null.Bool{}).Return(model.AssetGroupTag{
+		ID:        3,
+		Type:      model.AssetGroupTagTypeLabel,
+		KindId:    3,
+		Name:      "Owned",
+		CreatedAt: now,
+		CreatedBy: actorID,
+		UpdatedAt: now,
+		UpdatedBy: actorID,
+	}, nil)
+	mockDB.EXPECT().CreateAssetGroupTag(gomock.Any(), model.AssetGroupTagTypeTier, actorID, "Tier Two", "", null.Int32{}, null.BoolFrom(false)).Return(model.AssetGroupTag{
+		ID:             4,
+		Type:           model.AssetGroupTagTypeTier,
+		KindId:         4,
+		Name:           "Tier Two",
+		CreatedAt:      now,
+		CreatedBy:      actorID,
+		UpdatedAt:      now,
+		UpdatedBy:      actorID,
+		Position:       null.Int32From(3),
+		RequireCertify: null.BoolFrom(false),
+	}, nil)
+	mockDB.EXPECT().UpdateAssetGroupTag(gomock.Any(), actorID, gomock.Any()).DoAndReturn(func(_ context.Context, _ string, tag model.AssetGroupTag) (model.AssetGroupTag, error) {
+		// Tiers updated without a position keep their current one
+		if !tag.Position.Valid {
+			tag.Position = tierOne.Position
+		}
+
+		return tag, nil
+	})
+	mockDB.EXPECT().DeleteAssetGroupTag(gomock.Any(), actorID, tierOne).Return(nil)
+	mockGraph.EXPECT().RemoveNodeKind(gomock.Any(), tierOne.ToKind()).Return(nil)
+	mockGraph.EXPECT().GetNodesByKind(gomock.Any(), tierOne.ToKind()).Return(members, nil)
+	mockDB.EXPECT().GetAssetGroupTagCertifications(gomock.Any(), tierOne.ID).Return(model.AssetGroupTagCertifications{}, nil)
+	mockGraph.EXPECT().GetPrimaryNodeKindCounts(gomock.Any(), tierOne.ToKind()).Return(map[string]int{ad.User.String(): 1}, nil)
+	mockGraph.EXPECT().FetchNodesByObjectIDsAndKinds(gomock.Any(), graph.Kinds{tierOne.ToKind()}, "S-1-5-21-1-1000").Return(members, nil)
+	mockDB.EXPECT().UpdateAssetGroupTagCertification(gomock.Any(), actorID, gomock.Any()).DoAndReturn(func(_ context.Context, _ string, certification model.AssetGroupTagCertification) (model.AssetGroupTagCertification, error) {
+		certification.ID = 1
+		certification.CertifiedBy = null.StringFrom(actorID)
+		certification.CertifiedAt = null.TimeFrom(now)
+		certification.CreatedAt = now
+		certification.UpdatedAt = now
+
+		return certification, nil
+	})
+
+	tags, err := client.GetAssetGroupTags(ctx, nil)
+	require.Nil(t, err)
+	require.Len(t, tags.Data.Tags, 2)
+
+	// Labels carry neither a position nor a certification requirement
+	label, err := client.CreateAssetGroupTag(ctx, sdk.CreateAssetGroupTagRequest{
+		Name: "Owned",
+		Type: int(model.AssetGroupTagTypeLabel),
+	}, nil)
+	require.Nil(t, err)
+	require.False(t, label.Data.Tag.Position.Valid)
+
+	tier, err := client.CreateAssetGroupTag(ctx, sdk.CreateAssetGroupTagRequest{
+		Name: "Tier Two",
+		Type: int(model.AssetGroupTagTypeTier),
+	}, nil)
+	require.Nil(t, err)
+	require.Equal(t, sdk.NullableValue[int32](3), tier.Data.Tag.Position)
+
+	tag, err := client.GetAssetGroupTag(ctx, int32(tierOne.ID), nil)
+	require.Nil(t, err)
+	require.Equal(t, tierOne.Name, tag.Data.Tag.Name)
+
+	updated, err := client.UpdateAssetGroupTag(ctx, int32(tierOne.ID), sdk.UpdateAssetGroupTagRequest{
+		Description: sdk.NullableValue("updated"),
+	}, nil)
+	require.Nil(t, err)
+	require.Equal(t, "updated", updated.Data.Tag.Description)
+	require.Equal(t, sdk.NullableValue[int32](2), updated.Data.Tag.Position)
+
+	tagMembers, err := client.GetAssetGroupTagMembers(ctx, int32(tierOne.ID), nil)
+	require.Nil(t, err)
+	require.Len(t, tagMembers.Data.Members, 1)
+
+	counts, err := client.ListAssetGroupTagMemberCountByKind(ctx, int32(tierOne.ID), nil)
+	require.Nil(t, err)
+	require.Equal(t, 1, counts.Data.TotalCount)
+
+	certification, err := client.UpdateAssetGroupTagMemberCertification(ctx, int32(tierOne.ID), "S-1-5-21-1-1000", sdk.UpdateAssetGroupTagMemberCertificationRequest{
+		Status: sdk.AssetGroupCertificationStatus(model.AssetGroupCertificationStatusCertified),
+	}, nil)
+	require.Nil(t, err)
+	require.Equal(t, "S-1-5-21-1-1000", certification.Data.ObjectID)
+
+	require.Nil(t, client.DeleteAssetGroupTag(ctx, int32(tierOne.ID), nil))
+
+	server.requireNoViolations(t)
+}
+
+func TestContract_Workspaces(t *testing.T) {
+	var (
+		mockCtrl    = gomock.NewController(t)
+		mockDB      = mocks.NewMockDatabase(mockCtrl)
+		memoryDB, _ = dawgs.Open(context.Background(), memory.DriverName, dawgs.Config{})
+		resources   = v2.Resources{DB: mockDB, Graph: graph.NewDatabaseSwitch(context.Background(), memoryDB), Authorizer: auth.NewAuthorizer(mockDB)}
+		server      = newContractServer(t)
+		ctx         = context.Background()
+		userID      = uuid.Must(uuid.NewV4())
+		workspace   = model.Workspace{
+			Name:        "engagement",
+			Description: "client engagement",
+			Serial: model.Serial{
+				ID: 1,
+				Basic: model.Basic{
+					CreatedAt: time.Now().UTC(),
+					UpdatedAt: time.Now().UTC(),
+				},
+			},
+		}
+		workspacePath = fmt.Sprintf("/api/v2/workspaces/{%s}", api.URIPathVariableWorkspaceID)
+	)
+
+	server.handle(http.MethodGet, "/api/v2/workspaces", resources.ListWorkspaces)
+	server.handle(http.MethodPost, "/api/v2/workspaces", resources.CreateWorkspace)
+	server.handle(http.MethodDelete, workspacePath, resources.DeleteWorkspace)
+	server.handle(http.MethodGet, workspacePath+"/users", resources.ListWorkspaceUsers)
+	server.handle(http.MethodPut, workspacePath+"/users", resources.AddWorkspaceUsers)
+	server.handle(http.MethodDelete, workspacePath+"/users", resources.RemoveWorkspaceUsers)
+
+	client, _ := server.start(t)
+
+	mockDB.EXPECT().GetAllWorkspaces(gomock.Any()).Return(model.Workspaces{workspace}, nil)
+	mockDB.EXPECT().CreateWorkspace(gomock.Any(), workspace.Name, workspace.Description).Return(workspace, nil)
+	mockDB.EXPECT().GetWorkspace(gomock.Any(), workspace.ID).Return(workspace, nil).Times(4)
+	mockDB.EXPECT().GetWorkspaceUsers(gomock.Any(), workspace.ID).Return([]uuid.UUID{userID}, nil)
+	mockDB.EXPECT().AddWorkspaceUsers(gomock.Any(), workspace.ID, userID).Return(nil)
+	mockDB.EXPECT().RemoveWorkspaceUsers(gomock.Any(), workspace.ID, userID).Return(nil)
+	mockDB.EXPECT().DeleteWorkspace(gomock.Any(), workspace.ID).Return(nil)
+
+	workspaces, err := client.ListWorkspaces(ctx, nil)
+	require.Nil(t, err)
+	require.Len(t, workspaces.Data, 1)
+
+	created, err := client.CreateWorkspace(ctx, sdk.CreateWorkspaceRequest{
+		Name:        workspace.Name,
+		Description: workspace.Description,
+	}, nil)
+	require.Nil(t, err)
+	require.Equal(t, workspace.Name, created.Data.Name)
+
+	require.Nil(t, client.AddWorkspaceUsers(ctx, workspace.ID, sdk.AddWorkspaceUsersRequest{UserIds: []string{userID.String()}}, nil))
+
+	users, err := client.ListWorkspaceUsers(ctx, workspace.ID, nil)
+	require.Nil(t, err)
+	require.Equal(t, []string{userID.String()}, users.Data.UserIds)
+
+	require.Nil(t, client.RemoveWorkspaceUsers(ctx, workspace.ID, sdk.RemoveWorkspaceUsersRequest{UserIds: []string{userID.String()}}, nil))
+	require.Nil(t, client.DeleteWorkspace(ctx, workspace.ID, nil))
+
+	server.requireNoViolations(t)
+}
+
+func TestContract_FileUploads(t *testing.T) {
+	var (
+		mockCtrl  = gomock.NewController(t)
+		mockDB    = mocks.NewMockDatabase(mockCtrl)
+		mockGraph = queriesMocks.NewMockGraph(mockCtrl)
+		resources = v2.Resources{DB: mockDB, GraphQuery: mockGraph, IngestStore: storage.NewFilesystemStore(t.TempDir())}
+		server    = newContractServer(t)
+		ctx       = context.Background()
+		now       = time.Now().UTC()
+		newJob    = func(id int64, status model.JobStatus) model.IngestJob {
+			return model.IngestJob{
+				UserID:     contractActor.ID,
+				Status:     status,
+				StartTime:  now,
+				EndTime:    now,
+				LastIngest: now,
+				BigSerial: model.BigSerial{
+					ID: id,
+					Basic: model.Basic{
+						CreatedAt: now,
+						UpdatedAt: now,
+					},
+				},
+			}
+		}
+		runningJob  = newJob(1, model.JobStatusRunning)
+		finishedJob = newJob(2, model.JobStatusComplete)
+		upload      = model.IngestUpload{
+			IngestJobID: runningJob.ID,
+			FileType:    model.FileTypeJson,
+			TotalSize:   10,
+			BigSerial: model.BigSerial{
+				ID: 7,
+				Basic: model.Basic{
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+			},
+		}
+		jobPath    = fmt.Sprintf("/api/v2/file-upload/{%s}", v2.FileUploadJobIdPathParameterName)
+		uploadPath = fmt.Sprintf("%s/uploads/{%s}", jobPath, v2.IngestUploadIdPathParameterName)
+	)
+
+	server.handle(http.MethodDelete, jobPath, resources.CancelFileUploadJob)
+	server.handle(http.MethodGet, jobPath+"/objects", resources.ListFileUploadJobObjects)
+	server.handle(http.MethodPost, jobPath+"/rollback", resources.RollbackFileUploadJob)
+	server.handle(http.MethodPost, jobPath+"/uploads", resources.StartIngestUpload)
+	server.handle(http.MethodGet, uploadPath, resources.GetIngestUpload)
+	server.handle(http.MethodPatch, uploadPath, resources.WriteIngestUploadChunk)
+	server.handle(http.MethodDelete, uploadPath, resources.DeleteIngestUpload)
+
+	client, _ := server.start(t)
+
+	mockDB.EXPECT().GetIngestJob(gomock.Any(), runningJob.ID).Return(runningJob, nil).Times(5)
+	mockDB.EXPECT().GetIngestJob(gomock.Any(), finishedJob.ID).Return(finishedJob, nil).Times(2)
+	mockDB.EXPECT().CreateIngestUpload(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, created model.IngestUpload) (model.IngestUpload, error) {
+		created.ID = upload.ID
+		created.CreatedAt = now
+		created.UpdatedAt = now
+
+		return created, nil
+	})
+	mockDB.EXPECT().GetIngestUpload(gomock.Any(), upload.ID).Return(upload, nil).Times(3)
+	mockDB.EXPECT().AppendIngestUploadChunk(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, chunk model.IngestUploadChunk) (model.IngestUpload, error) {
+		appended := upload
+		appended.ReceivedSize = chunk.Size
+
+		return appended, nil
+	})
+	mockDB.EXPECT().GetIngestUploadChunks(gomock.Any(), upload.ID).Return(model.IngestUploadChunks{}, nil)
+	mockDB.EXPECT().DeleteIngestUpload(gomock.Any(), upload.ID).Return(nil)
+	mockDB.EXPECT().TouchIngestJobLastIngest(gomock.Any(), runningJob.ID).Return(nil)
+	mockDB.EXPECT().CancelIngestJob(gomock.Any(), runningJob.ID, gomock.Any(), contractActor.ID.String(), gomock.Any()).Return(true, nil)
+	mockDB.EXPECT().RequestIngestJobRollback(gomock.Any(), finishedJob.ID, contractActor.ID.String()).Return(true, nil)
+	mockGraph.EXPECT().GetIngestJobObjects(gomock.Any(), finishedJob.ID, 0, 100).Return(model.IngestJobObjects{
+		NodeCount:    1,
+		UnifiedGraph: model.NewUnifiedGraph(),
+	}, nil)
+
+	started, err := client.StartIngestUpload(ctx, runningJob.ID, sdk.StartIngestUploadRequest{
+		ContentType: "application/json",
+		TotalSize:   upload.TotalSize,
+	}, nil)
+	require.Nil(t, err)
+	require.Equal(t, upload.ID, started.Data.ID)
+
+	fetched, err := client.GetIngestUpload(ctx, runningJob.ID, upload.ID, nil)
+	require.Nil(t, err)
+	require.Equal(t, upload.TotalSize, fetched.Data.TotalSize)
+
+	written, err := client.WriteIngestUploadChunk(ctx, runningJob.ID, upload.ID, strings.NewReader("{\"da"), "application/offset+octet-stream", &sdk.WriteIngestUploadChunkParams{
+		UploadOffset: 0,
+	})
+	require.Nil(t, err)
+	require.Equal(t, int64(4), written.Data.ReceivedSize)
+
+	require.Nil(t, client.DeleteIngestUpload(ctx, runningJob.ID, upload.ID, nil))
+	require.Nil(t, client.CancelFileUploadJob(ctx, runningJob.ID, nil))
+
+	objects, err := client.ListFileUploadJobObjects(ctx, finishedJob.ID, nil)
+	require.Nil(t, err)
+	require.Equal(t, int64(1), objects.Data.NodeCount)
+
+	require.Nil(t, client.RollbackFileUploadJob(ctx, finishedJob.ID, nil))
+
+	server.requireNoViolations(t)
+}
+
+func TestContract_Analysis(t *testing.T) {
+	var (
+		mockCtrl  = gomock.NewController(t)
+		mockDB    = mocks.NewMockDatabase(mockCtrl)
+		mockGraph = queriesMocks.NewMockGraph(mockCtrl)
+		resources = v2.Resources{DB: mockDB, GraphQuery: mockGraph}
+		server    = newContractServer(t)
+		ctx       = context.Background()
+		now       = time.Now().UTC()
+	)
+
+	server.handle(http.MethodPost, "/api/v2/analysis/cancel", resources.CancelAnalysis)
+	server.handle(http.MethodGet, "/api/v2/analysis/runs", resources.ListAnalysisRuns)
+	server.handle(http.MethodPost, "/api/v2/analysis/simulation", resources.SimulateRemediation)
+	server.handle(http.MethodGet, "/api/v2/cluster/status", resources.GetClusterStatus)
+
+	client, _ := server.start(t)
+
+	mockDB.EXPECT().RequestAnalysisCancellation(gomock.Any(), contractActor.ID.String()).Return(true, nil)
+	mockDB.EXPECT().GetAnalysisRuns(gomock.Any(), 0, 10, "").Return(model.AnalysisRuns{{
+		Trigger:     model.AnalysisRunTriggerIngest,
+		Status:      model.AnalysisRunStatusComplete,
+		StartedAt:   now,
+		CompletedAt: null.TimeFrom(now),
+		Steps: model.AnalysisRunSteps{{
+			Name:       "post_processing",
+			StartedAt:  now,
+			DurationMS: 10,
+		}},
+		EdgesCreated: model.AnalysisRunEdgeCounts{ad.AdminTo.String(): 1},
+		EdgesDeleted: model.AnalysisRunEdgeCounts{},
+		Errors:       model.AnalysisRunErrors{},
+		BigSerial: model.BigSerial{
+			ID: 1,
+			Basic: model.Basic{
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+		},
+	}}, 1, nil)
+	mockGraph.EXPECT().SimulateRemediation(gomock.Any(), gomock.Any()).Return(simulation.Report{
+		RemovedRelationships:     []graph.ID{1},
+		PrincipalsWithPathBefore: 2,
+		PrincipalsWithPathAfter:  1,
+		PrincipalsLosingPath:     1,
+		LostPrincipals: graph.NewNodeSet(
+			graph.NewNode(1, graph.AsProperties(map[string]any{common.ObjectID.String(): "S-1-5-21-1-1000", common.Name.String(): "USER@EXAMPLE.COM"}), ad.Entity, ad.User),
+		),
+	}, nil)
+	mockDB.EXPECT().GetClusterNodes(gomock.Any(), gomock.Any()).Return(model.ClusterNodes{{
+		NodeID:          "node-1",
+		Hostname:        "node-1",
+		IsLeader:        true,
+		StartedAt:       now,
+		LastHeartbeatAt: now,
+	}}, nil)
+
+	require.Nil(t, client.CancelAnalysis(ctx, nil))
+
+	var runs []sdk.AnalysisRun
+
+	for run, err := range client.ListAnalysisRunsAll(ctx, sdk.ListAnalysisRunsParams{Limit: sdk.Ptr(10)}) {
+		require.Nil(t, err)
+		runs = append(runs, run)
+	}
+
+	require.Len(t, runs, 1)
+
+	report, err := client.SimulateRemediation(ctx, sdk.SimulateRemediationRequest{
+		RemovedEdges: []sdk.SimulateRemediationRequestRemovedEdgesItem{{
+			Source: "S-1-5-21-1-1000",
+			Target: "S-1-5-21-1-2000",
+			Kind:   ad.AdminTo.String(),
+		}},
+	}, nil)
+	require.Nil(t, err)
+	require.Equal(t, 1, report.Data.PrincipalsLosingPath)
+	require.Len(t, report.Data.LostPrincipals, 1)
+
+	clusterStatus, err := client.GetClusterStatus(ctx, nil)
+	require.Nil(t, err)
+	require.Equal(t, "node-1", clusterStatus.Data.Leader.NodeID)
+
+	server.requireNoViolations(t)
+}
+
+func TestContract_Events(t *testing.T) {
+	var (
+		bus         = events.NewBus(events.DefaultHistorySize)
+		resources   = v2.Resources{Events: bus}
+		server      = newContractServer(t)
+		ctx, cancel = context.WithCancel(context.Background())
+	)
+
+	defer cancel()
+
+	server.handle(http.MethodGet, "/api/v2/events", resources.GetEvents)
+
+	// Streamed responses are validated once the stream closes, which closing the server waits for
+	t.Cleanup(func() { server.requireNoViolations(t) })
+
+	client, _ := server.start(t)
+
+	published := bus.Publish(events.TypeAnalysisStarted, events.AnalysisProgress{TotalSteps: 13, WorkspaceIDs: []int32{0}})
+
+	stream, err := client.StreamEvents(ctx, sdk.StreamEventsParams{LastEventID: sdk.Ptr(int64(0))})
+	require.Nil(t, err)
+
+	defer stream.Close()
+
+	bus.Publish(events.TypeIngestProgress, events.IngestProgress{TaskID: 1, WorkspaceID: 1})
+	completed := bus.Publish(events.TypeAnalysisCompleted, events.AnalysisProgress{TotalSteps: 13, WorkspaceIDs: []int32{0}})
+
+	reader := bufio.NewReader(stream)
+
+	for {
+		line, err := reader.ReadString('\n')
+		require.Nil(t, err)
+
+		// Events published before the stream opened are not replayed and events of other workspaces are skipped
+		require.NotEqual(t, fmt.Sprintf("id: %d\n", published.ID), line)
+		require.NotEqual(t, "event: ingest.progress\n", line)
+
+		if line == fmt.Sprintf("id: %d\n", completed.ID) {
+			break
+		}
+	}
+}
+
+func TestContract_GraphQueries(t *testing.T) {
+	var (
+		mockCtrl  = gomock.NewController(t)
+		mockDB    = mocks.NewMockDatabase(mockCtrl)
+		mockGraph = queriesMocks.NewMockGraph(mockCtrl)
+		resources = v2.Resources{DB: mockDB, GraphQuery: mockGraph}
+		server    = newContractServer(t)
+		ctx       = context.Background()
+		start     = graph.NewNode(1, graph.AsProperties(map[string]any{common.ObjectID.String(): "S-1-5-21-1-1000", common.Name.String(): "USER@EXAMPLE.COM"}), ad.Entity, ad.User)
+		end       = graph.NewNode(2, graph.AsProperties(map[string]any{common.ObjectID.String(): "S-1-5-21-1-2000", common.Name.String(): "COMPUTER.EXAMPLE.COM"}), ad.Entity, ad.Computer)
+		paths     = graph.NewPathSet(graph.Path{
+			Nodes: []*graph.Node{start, end},
+			Edges: []*graph.Relationship{graph.NewRelationship(1, start.ID, end.ID, graph.NewProperties(), ad.AdminTo)},
+		})
+	)
+
+	server.handle(http.MethodGet, "/api/v2/search", resources.SearchHandler)
+	server.handle(http.MethodPost, "/api/v2/graphs/cypher", resources.CypherQuery)
+	server.handle(http.MethodGet, "/api/v2/graphs/shortest-path", resources.GetShortestPath)
+	server.handle(http.MethodGet, fmt.Sprintf("/api/v2/users/{%s}/sessions", api.URIPathVariableObjectID), resources.ListADUserSessions)
+
+	client, serverURL := server.start(t)
+
+	mockGraph.EXPECT().SearchNodes(gomock.Any(), gomock.Any(), "user", 0, 10).Return([]model.SearchResult{{
+		ObjectID: "S-1-5-21-1-1000",
+		Type:     ad.User.String(),
+		Name:     "USER@EXAMPLE.COM",
+	}}, 1, nil)
+	mockGraph.EXPECT().PrepareCypherQuery(gomock.Any(), gomock.Any()).Return(queries.PreparedQuery{}, nil)
+	mockGraph.EXPECT().RawCypherQueryPaths(gomock.Any(), gomock.Any()).Return(paths, nil)
+	mockDB.EXPECT().GetGenericKinds(gomock.Any()).Return(model.GenericKinds{}, nil).Times(2)
+	mockGraph.EXPECT().GetAllShortestPaths(gomock.Any(), "S-1-5-21-1-1000", "S-1-5-21-1-2000", gomock.Any()).Return(paths, nil).Times(2)
+	mockDB.EXPECT().GetFlagByKey(gomock.Any(), appcfg.FeatureEntityPanelCaching).Return(appcfg.FeatureFlag{}, nil)
+	mockGraph.EXPECT().GetADEntityQueryExport(gomock.Any(), gomock.Any(), false).Return(graphexport.FromPathSet(paths), nil)
+
+	searchResponse, err := client.Search(ctx, &sdk.SearchParams{
+		Q:     "user",
+		Limit: sdk.Ptr(10),
+	})
+	require.Nil(t, err)
+	require.Len(t, searchResponse.Data, 1)
+	require.Equal(t, 1, searchResponse.Count)
+
+	shortestPath, err := client.GetShortestPath(ctx, &sdk.GetShortestPathParams{
+		StartNode: "S-1-5-21-1-1000",
+		EndNode:   "S-1-5-21-1-2000",
+	})
+	require.Nil(t, err)
+	require.Len(t, shortestPath.Data.Nodes, 2)
+
+	// Graph exports are negotiated through the Accept header, which the generated client does not send
+	for _, exportRequest := range []struct {
+		method string
+		path   string
+		body   string
+		accept string
+	}{
+		{method: http.MethodPost, path: "/api/v2/graphs/cypher", body: `{"query": "match p = ()-[]->() return p"}`, accept: mediatypes.ApplicationGraphmlXml.String()},
+		{method: http.MethodGet, path: "/api/v2/graphs/shortest-path?start_node=S-1-5-21-1-1000&end_node=S-1-5-21-1-2000", accept: mediatypes.ApplicationGexfXml.String()},
+		{method: http.MethodGet, path: "/api/v2/users/S-1-5-21-1-1000/sessions", accept: mediatypes.TextVndGraphviz.String()},
+	} {
+		request, err := http.NewRequestWithContext(ctx, exportRequest.method, serverURL+exportRequest.path, strings.NewReader(exportRequest.body))
+		require.Nil(t, err)
+
+		request.Header.Set(headers.Accept.String(), exportRequest.accept)
+		request.Header.Set(headers.ContentType.String(), mediatypes.ApplicationJson.String())
+
+		response, err := http.DefaultClient.Do(request)
+		require.Nil(t, err)
+		require.Nil(t, response.Body.Close())
+		require.Equal(t, http.StatusOK, response.StatusCode, exportRequest.path)
+	}
+
+	server.requireNoViolations(t)
+}
@@ -0,0 +1,54 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sdk_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/specterops/bloodhound/openapi"
+	"github.com/specterops/bloodhound/schemagen/generator"
+	"github.com/stretchr/testify/require"
+)
+
+// generatedMarker begins the generated portion of the client sources. The committed sources are preceded by the
+// license header, which the generator does not write.
+const generatedMarker = "// Code generated by schemagen."
+
+func TestGeneratedClient(t *testing.T) {
+	var (
+		generatedDir  = t.TempDir()
+		document, err = openapi.Spec()
+	)
+
+	require.Nil(t, err)
+	require.Nil(t, generator.GenerateGolangAPIClient("sdk", generatedDir, document))
+
+	for _, name := range []string{"operations.go", "types.go"} {
+		generated, err := os.ReadFile(filepath.Join(generatedDir, name))
+		require.Nil(t, err)
+
+		committed, err := os.ReadFile(name)
+		require.Nil(t, err)
+
+		_, committedSource, found := bytes.Cut(committed, []byte(generatedMarker))
+		require.True(t, found, "%s is missing the generated code marker", name)
+		require.Equal(t, string(generated), generatedMarker+string(committedSource), "%s is out of date with the OpenAPI spec, regenerate it with schemagen", name)
+	}
+}
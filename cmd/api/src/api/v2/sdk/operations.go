@@ -0,0 +1,6736 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by schemagen. DO NOT EDIT!
+// OpenAPI source: github.com/specterops/bloodhound/-/tree/main/packages/go/openapi/src/
+
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"iter"
+	"net/http"
+	"time"
+)
+
+// AcceptEulaParams holds the query and header parameters of AcceptEula. Nil and empty fields are omitted.
+type AcceptEulaParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// AcceptEula sends PUT /api/v2/accept-eula. Accept EULA.
+//
+// Accept BloodHound Enterprise EULA for logged in user (EULA applies to BHE customers only).
+func (s *Client) AcceptEula(ctx context.Context, params *AcceptEulaParams) error {
+	return s.do(ctx, request{
+		method:     http.MethodPut,
+		parameters: params,
+		path:       "/api/v2/accept-eula",
+	}, nil)
+}
+
+// GetAdDomainDataQualityStatsParams holds the query and header parameters of GetAdDomainDataQualityStats. Nil and
+// empty fields are omitted.
+type GetAdDomainDataQualityStatsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Sortable columns are created_at, updated_at.
+	SortBy []string `query:"sort_by"`
+	// Beginning datetime of range (inclusive) in RFC-3339 format; Defaults to current datetime minus 30 days
+	Start *time.Time `query:"start"`
+	// Ending datetime of range (exclusive) in RFC-3339 format; Defaults to current datetime
+	End *time.Time `query:"end"`
+	// This query parameter is used for determining the number of objects to skip in pagination.
+	Skip *int `query:"skip"`
+	// This query parameter is used for setting an upper limit of objects returned in paginated responses.
+	Limit *int `query:"limit"`
+}
+
+// GetAdDomainDataQualityStats sends GET /api/v2/ad-domains/{domain_id}/data-quality-stats. Get AD domain data quality
+// stats.
+//
+// Time series list of data quality stats for a given AD domain
+func (s *Client) GetAdDomainDataQualityStats(ctx context.Context, domainID string, params *GetAdDomainDataQualityStatsParams) (GetAdDomainDataQualityStatsResponse, error) {
+	var response GetAdDomainDataQualityStatsResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/ad-domains/" + pathParameter(domainID) + "/data-quality-stats",
+	}, &response)
+}
+
+// GetAdDomainDataQualityStatsAll returns an iterator over every item returned by GetAdDomainDataQualityStats,
+// requesting each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetAdDomainDataQualityStatsAll(ctx context.Context, domainID string, params GetAdDomainDataQualityStatsParams) iter.Seq2[ADDataQualityStat, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]ADDataQualityStat, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetAdDomainDataQualityStats(ctx, domainID, &params)
+		return response.Data, err
+	})
+}
+
+// GetAiaCaEntityParams holds the query and header parameters of GetAiaCaEntity. Nil and empty fields are omitted.
+type GetAiaCaEntityParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Include counts of related entities. Default value is `true`.
+	Counts *bool `query:"counts"`
+}
+
+// GetAiaCaEntity sends GET /api/v2/aiacas/{object_id}. Get AIA CA entity info.
+//
+// Get info and counts for this AIA CA node.
+func (s *Client) GetAiaCaEntity(ctx context.Context, objectID string, params *GetAiaCaEntityParams) (EntityInfoQueryResults, error) {
+	var response EntityInfoQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/aiacas/" + pathParameter(objectID),
+	}, &response)
+}
+
+// GetAiaCaEntityControllersParams holds the query and header parameters of GetAiaCaEntityControllers. Nil and empty
+// fields are omitted.
+type GetAiaCaEntityControllersParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetAiaCaEntityControllers sends GET /api/v2/aiacas/{object_id}/controllers. Get AIA CA entity controllers.
+//
+// Get a list, graph, or count of the principals that can control this AIA CA.
+func (s *Client) GetAiaCaEntityControllers(ctx context.Context, objectID string, params *GetAiaCaEntityControllersParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/aiacas/" + pathParameter(objectID) + "/controllers",
+	}, &response)
+}
+
+// GetAiaCaEntityControllersAll returns an iterator over every item returned by GetAiaCaEntityControllers, requesting
+// each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetAiaCaEntityControllersAll(ctx context.Context, objectID string, params GetAiaCaEntityControllersParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetAiaCaEntityControllers(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// StartAnalysisParams holds the query and header parameters of StartAnalysis. Nil and empty fields are omitted.
+type StartAnalysisParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// StartAnalysis sends PUT /api/v2/analysis. Start analysis.
+//
+// Flags the API to begin analyzing ingest data.
+func (s *Client) StartAnalysis(ctx context.Context, params *StartAnalysisParams) error {
+	return s.do(ctx, request{
+		method:     http.MethodPut,
+		parameters: params,
+		path:       "/api/v2/analysis",
+	}, nil)
+}
+
+// CancelAnalysisParams holds the query and header parameters of CancelAnalysis. Nil and empty fields are omitted.
+type CancelAnalysisParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// CancelAnalysis sends POST /api/v2/analysis/cancel. Cancel analysis.
+//
+// Requests cancellation of the in-flight analysis. Analysis stops once the datapipe observes the request. A
+// post-processing step that has already started runs to completion, so the post-processed relationships of the graph
+// are never left partially recomputed. Ingest jobs awaiting the canceled analysis are marked as canceled.
+func (s *Client) CancelAnalysis(ctx context.Context, params *CancelAnalysisParams) error {
+	return s.do(ctx, request{
+		method:     http.MethodPost,
+		parameters: params,
+		path:       "/api/v2/analysis/cancel",
+	}, nil)
+}
+
+// ListAnalysisRunsParams holds the query and header parameters of ListAnalysisRuns. Nil and empty fields are omitted.
+type ListAnalysisRunsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Sortable columns are `trigger`, `status`, `started_at`, and `completed_at`. Runs are sorted by most recent
+	// `started_at` by default.
+	SortBy []string `query:"sort_by"`
+	// This query parameter is used for determining the number of objects to skip in pagination.
+	Skip *int `query:"skip"`
+	// This query parameter is used for setting an upper limit of objects returned in paginated responses.
+	Limit *int `query:"limit"`
+}
+
+// ListAnalysisRuns sends GET /api/v2/analysis/runs. List analysis runs.
+//
+// Lists the history of analysis runs along with the duration of each analysis step and the number of post-processed
+// relationships created and deleted per kind.
+func (s *Client) ListAnalysisRuns(ctx context.Context, params *ListAnalysisRunsParams) (ListAnalysisRunsResponse, error) {
+	var response ListAnalysisRunsResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/analysis/runs",
+	}, &response)
+}
+
+// ListAnalysisRunsAll returns an iterator over every item returned by ListAnalysisRuns, requesting each page in turn
+// until a page shorter than the limit is returned.
+func (s *Client) ListAnalysisRunsAll(ctx context.Context, params ListAnalysisRunsParams) iter.Seq2[AnalysisRun, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]AnalysisRun, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.ListAnalysisRuns(ctx, &params)
+		return response.Data, err
+	})
+}
+
+// SimulateRemediationParams holds the query and header parameters of SimulateRemediation. Nil and empty fields are
+// omitted.
+type SimulateRemediationParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// SimulateRemediation sends POST /api/v2/analysis/simulation. Simulate remediation.
+//
+// Simulates the removal of the given edges and the given node property changes against an in-memory overlay of the
+// graph and reports how many principals would lose their paths to tier zero. Post-processed edges composed from
+// removed edges are re-derived as part of the simulation. The graph is not modified.
+func (s *Client) SimulateRemediation(ctx context.Context, body SimulateRemediationRequest, params *SimulateRemediationParams) (SimulateRemediationResponse, error) {
+	var response SimulateRemediationResponse
+	return response, s.do(ctx, request{
+		body:       body,
+		method:     http.MethodPost,
+		parameters: params,
+		path:       "/api/v2/analysis/simulation",
+	}, &response)
+}
+
+// GetAssetGroupTagsParams holds the query and header parameters of GetAssetGroupTags. Nil and empty fields are
+// omitted.
+type GetAssetGroupTagsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Type of tag. Either Tier = 1 or Label = 2
+	Type           string `query:"type"`
+	Name           string `query:"name"`
+	Description    string `query:"description"`
+	Position       string `query:"position"`
+	RequireCertify string `query:"require_certify"`
+	CreatedAt      string `query:"created_at"`
+	CreatedBy      string `query:"created_by"`
+	UpdatedAt      string `query:"updated_at"`
+	UpdatedBy      string `query:"updated_by"`
+}
+
+// GetAssetGroupTags sends GET /api/v2/asset-group-tags. List Asset Group Tags.
+//
+// Lists the asset group tags that have not been deleted. Tiers are listed first in order of their position.
+func (s *Client) GetAssetGroupTags(ctx context.Context, params *GetAssetGroupTagsParams) (GetAssetGroupTagsResponse, error) {
+	var response GetAssetGroupTagsResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/asset-group-tags",
+	}, &response)
+}
+
+// CreateAssetGroupTagParams holds the query and header parameters of CreateAssetGroupTag. Nil and empty fields are
+// omitted.
+type CreateAssetGroupTagParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// CreateAssetGroupTag sends POST /api/v2/asset-group-tags. Create Asset Group Tag.
+//
+// Creates a tier or a label. A tier that is created without a position is placed at the end of the tier order and the
+// tiers at or after the position of a new tier are moved down. Position and require_certify are limited to tiers.
+func (s *Client) CreateAssetGroupTag(ctx context.Context, body CreateAssetGroupTagRequest, params *CreateAssetGroupTagParams) (CreateAssetGroupTagResponse, error) {
+	var response CreateAssetGroupTagResponse
+	return response, s.do(ctx, request{
+		body:       body,
+		method:     http.MethodPost,
+		parameters: params,
+		path:       "/api/v2/asset-group-tags",
+	}, &response)
+}
+
+// GetAssetGroupTagParams holds the query and header parameters of GetAssetGroupTag. Nil and empty fields are omitted.
+type GetAssetGroupTagParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// GetAssetGroupTag sends GET /api/v2/asset-group-tags/{asset_group_tag_id}. Get Asset Group Tag.
+//
+// Retrieves an asset group tag by ID
+func (s *Client) GetAssetGroupTag(ctx context.Context, assetGroupTagID int32, params *GetAssetGroupTagParams) (GetAssetGroupTagResponse, error) {
+	var response GetAssetGroupTagResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/asset-group-tags/" + pathParameter(assetGroupTagID),
+	}, &response)
+}
+
+// DeleteAssetGroupTagParams holds the query and header parameters of DeleteAssetGroupTag. Nil and empty fields are
+// omitted.
+type DeleteAssetGroupTagParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// DeleteAssetGroupTag sends DELETE /api/v2/asset-group-tags/{asset_group_tag_id}. Delete Asset Group Tag.
+//
+// Deletes an asset group tag. The tiers after a deleted tier are moved up. Tier Zero can not be deleted.
+func (s *Client) DeleteAssetGroupTag(ctx context.Context, assetGroupTagID int32, params *DeleteAssetGroupTagParams) error {
+	return s.do(ctx, request{
+		method:     http.MethodDelete,
+		parameters: params,
+		path:       "/api/v2/asset-group-tags/" + pathParameter(assetGroupTagID),
+	}, nil)
+}
+
+// UpdateAssetGroupTagParams holds the query and header parameters of UpdateAssetGroupTag. Nil and empty fields are
+// omitted.
+type UpdateAssetGroupTagParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// UpdateAssetGroupTag sends PATCH /api/v2/asset-group-tags/{asset_group_tag_id}. Update Asset Group Tag.
+//
+// Updates the description, position and certification requirement of an asset group tag. Moving a tier shifts the
+// tiers between its previous and new position. The name of a tag and the position of Tier Zero can not be changed.
+func (s *Client) UpdateAssetGroupTag(ctx context.Context, assetGroupTagID int32, body UpdateAssetGroupTagRequest, params *UpdateAssetGroupTagParams) (UpdateAssetGroupTagResponse, error) {
+	var response UpdateAssetGroupTagResponse
+	return response, s.do(ctx, request{
+		body:       body,
+		method:     http.MethodPatch,
+		parameters: params,
+		path:       "/api/v2/asset-group-tags/" + pathParameter(assetGroupTagID),
+	}, &response)
+}
+
+// GetAssetGroupTagMembersParams holds the query and header parameters of GetAssetGroupTagMembers. Nil and empty fields
+// are omitted.
+type GetAssetGroupTagMembersParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// This query parameter is used for determining the number of objects to skip in pagination.
+	Skip *int `query:"skip"`
+	// This query parameter is used for setting an upper limit of objects returned in paginated responses.
+	Limit *int `query:"limit"`
+	// Only list the members with the given certification status
+	Status *AssetGroupCertificationStatus `query:"status"`
+}
+
+// GetAssetGroupTagMembers sends GET /api/v2/asset-group-tags/{asset_group_tag_id}/members. List Asset Group Tag
+// Members.
+//
+// Lists the members of an asset group tag ordered by object ID together with their certification status. Members that
+// have not been certified or revoked are pending.
+func (s *Client) GetAssetGroupTagMembers(ctx context.Context, assetGroupTagID int32, params *GetAssetGroupTagMembersParams) (GetAssetGroupTagMembersResponse, error) {
+	var response GetAssetGroupTagMembersResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/asset-group-tags/" + pathParameter(assetGroupTagID) + "/members",
+	}, &response)
+}
+
+// GetAssetGroupTagMembersAll returns an iterator over every item returned by GetAssetGroupTagMembers, requesting each
+// page in turn until a page shorter than the limit is returned.
+func (s *Client) GetAssetGroupTagMembersAll(ctx context.Context, assetGroupTagID int32, params GetAssetGroupTagMembersParams) iter.Seq2[AssetGroupTagMember, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]AssetGroupTagMember, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetAssetGroupTagMembers(ctx, assetGroupTagID, &params)
+		return response.Data.Members, err
+	})
+}
+
+// ListAssetGroupTagMemberCountByKindParams holds the query and header parameters of
+// ListAssetGroupTagMemberCountByKind. Nil and empty fields are omitted.
+type ListAssetGroupTagMemberCountByKindParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// ListAssetGroupTagMemberCountByKind sends GET /api/v2/asset-group-tags/{asset_group_tag_id}/members/counts. List
+// asset group tag member count by kind.
+//
+// List counts of members of an asset group tag by primary kind.
+func (s *Client) ListAssetGroupTagMemberCountByKind(ctx context.Context, assetGroupTagID int32, params *ListAssetGroupTagMemberCountByKindParams) (ListAssetGroupTagMemberCountByKindResponse, error) {
+	var response ListAssetGroupTagMemberCountByKindResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/asset-group-tags/" + pathParameter(assetGroupTagID) + "/members/counts",
+	}, &response)
+}
+
+// UpdateAssetGroupTagMemberCertificationParams holds the query and header parameters of
+// UpdateAssetGroupTagMemberCertification. Nil and empty fields are omitted.
+type UpdateAssetGroupTagMemberCertificationParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// UpdateAssetGroupTagMemberCertification sends PUT
+// /api/v2/asset-group-tags/{asset_group_tag_id}/members/{object_id}/certification. Update Asset Group Tag Member
+// Certification.
+//
+// Certifies, revokes or resets the certification of a member of a tier that requires certification. The requesting
+// user is recorded as the certifier of certified and revoked members.
+func (s *Client) UpdateAssetGroupTagMemberCertification(ctx context.Context, assetGroupTagID int32, objectID string, body UpdateAssetGroupTagMemberCertificationRequest, params *UpdateAssetGroupTagMemberCertificationParams) (UpdateAssetGroupTagMemberCertificationResponse, error) {
+	var response UpdateAssetGroupTagMemberCertificationResponse
+	return response, s.do(ctx, request{
+		body:       body,
+		method:     http.MethodPut,
+		parameters: params,
+		path:       "/api/v2/asset-group-tags/" + pathParameter(assetGroupTagID) + "/members/" + pathParameter(objectID) + "/certification",
+	}, &response)
+}
+
+// GetAssetGroupTagSelectorsParams holds the query and header parameters of GetAssetGroupTagSelectors. Nil and empty
+// fields are omitted.
+type GetAssetGroupTagSelectorsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Type of selector. Either ObjectID = 1 or Cypher = 2
+	Type *string `query:"type"`
+}
+
+// GetAssetGroupTagSelectors sends GET /api/v2/asset-group-tags/{asset_group_tag_id}/selectors. Get Asset Group Tag
+// selectors.
+//
+// Get a list of selectors for this group.
+func (s *Client) GetAssetGroupTagSelectors(ctx context.Context, assetGroupTagID int32, params *GetAssetGroupTagSelectorsParams) (GetAssetGroupTagSelectorsResponse, error) {
+	var response GetAssetGroupTagSelectorsResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/asset-group-tags/" + pathParameter(assetGroupTagID) + "/selectors",
+	}, &response)
+}
+
+// CreateAssetGroupTagSelectorParams holds the query and header parameters of CreateAssetGroupTagSelector. Nil and
+// empty fields are omitted.
+type CreateAssetGroupTagSelectorParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// CreateAssetGroupTagSelector sends POST /api/v2/asset-group-tags/{asset_group_tag_id}/selectors. Create Asset Group
+// Tag Selector.
+//
+// Creates an asset group tag selector.
+func (s *Client) CreateAssetGroupTagSelector(ctx context.Context, assetGroupTagID int32, body CreateAssetGroupTagSelectorRequest, params *CreateAssetGroupTagSelectorParams) (CreateAssetGroupTagSelectorResponse, error) {
+	var response CreateAssetGroupTagSelectorResponse
+	return response, s.do(ctx, request{
+		body:       body,
+		method:     http.MethodPost,
+		parameters: params,
+		path:       "/api/v2/asset-group-tags/" + pathParameter(assetGroupTagID) + "/selectors",
+	}, &response)
+}
+
+// UpdateAssetGroupTagSelectorParams holds the query and header parameters of UpdateAssetGroupTagSelector. Nil and
+// empty fields are omitted.
+type UpdateAssetGroupTagSelectorParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// UpdateAssetGroupTagSelector sends PATCH
+// /api/v2/asset-group-tags/{asset_group_tag_id}/selectors/{asset_group_tag_selector_id}. Update Asset Group Tag
+// Selector.
+//
+// Update an asset group tag selector's properties
+func (s *Client) UpdateAssetGroupTagSelector(ctx context.Context, assetGroupTagID int32, assetGroupTagSelectorID int32, body UpdateAssetGroupTagSelectorRequest, params *UpdateAssetGroupTagSelectorParams) (UpdateAssetGroupTagSelectorResponse, error) {
+	var response UpdateAssetGroupTagSelectorResponse
+	return response, s.do(ctx, request{
+		body:       body,
+		method:     http.MethodPatch,
+		parameters: params,
+		path:       "/api/v2/asset-group-tags/" + pathParameter(assetGroupTagID) + "/selectors/" + pathParameter(assetGroupTagSelectorID),
+	}, &response)
+}
+
+// ListAssetGroupsParams holds the query and header parameters of ListAssetGroups. Nil and empty fields are omitted.
+type ListAssetGroupsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Sortable columns are `name`, `tag`, and `member_count`.
+	SortBy      []string `query:"sort_by"`
+	Name        string   `query:"name"`
+	Tag         string   `query:"tag"`
+	SystemGroup string   `query:"system_group"`
+	MemberCount string   `query:"member_count"`
+	ID          string   `query:"id"`
+	// Filter results by `created_at` value. See filter schema details for valid predicates.
+	CreatedAt string `query:"created_at"`
+	// Filter results by `updated_at` value. See filter schema details for valid predicates.
+	UpdatedAt string `query:"updated_at"`
+	// Filter results by `deleted_at` value. See filter schema details for valid predicates.
+	DeletedAt string `query:"deleted_at"`
+}
+
+// ListAssetGroups sends GET /api/v2/asset-groups. List all asset isolation groups.
+//
+// Lists all asset isolation groups.
+func (s *Client) ListAssetGroups(ctx context.Context, params *ListAssetGroupsParams) (ListAssetGroupsResponse, error) {
+	var response ListAssetGroupsResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/asset-groups",
+	}, &response)
+}
+
+// CreateAssetGroupParams holds the query and header parameters of CreateAssetGroup. Nil and empty fields are omitted.
+type CreateAssetGroupParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// CreateAssetGroup sends POST /api/v2/asset-groups. Create an asset group.
+//
+// Creates an asset group
+func (s *Client) CreateAssetGroup(ctx context.Context, body AssetGroup, params *CreateAssetGroupParams) (CreateAssetGroupResponse, error) {
+	var response CreateAssetGroupResponse
+	return response, s.do(ctx, request{
+		body:       body,
+		method:     http.MethodPost,
+		parameters: params,
+		path:       "/api/v2/asset-groups",
+	}, &response)
+}
+
+// GetAssetGroupParams holds the query and header parameters of GetAssetGroup. Nil and empty fields are omitted.
+type GetAssetGroupParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// GetAssetGroup sends GET /api/v2/asset-groups/{asset_group_id}. Get asset group by ID.
+//
+// Retrieve asset group by ID
+func (s *Client) GetAssetGroup(ctx context.Context, assetGroupID int32, params *GetAssetGroupParams) (GetAssetGroupResponse, error) {
+	var response GetAssetGroupResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/asset-groups/" + pathParameter(assetGroupID),
+	}, &response)
+}
+
+// UpdateAssetGroupParams holds the query and header parameters of UpdateAssetGroup. Nil and empty fields are omitted.
+type UpdateAssetGroupParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// UpdateAssetGroup sends PUT /api/v2/asset-groups/{asset_group_id}. Update an asset group.
+//
+// Updates an asset group
+func (s *Client) UpdateAssetGroup(ctx context.Context, assetGroupID int32, body UpdateAssetGroupRequest, params *UpdateAssetGroupParams) (UpdateAssetGroupResponse, error) {
+	var response UpdateAssetGroupResponse
+	return response, s.do(ctx, request{
+		body:       body,
+		method:     http.MethodPut,
+		parameters: params,
+		path:       "/api/v2/asset-groups/" + pathParameter(assetGroupID),
+	}, &response)
+}
+
+// DeleteAssetGroupParams holds the query and header parameters of DeleteAssetGroup. Nil and empty fields are omitted.
+type DeleteAssetGroupParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// DeleteAssetGroup sends DELETE /api/v2/asset-groups/{asset_group_id}. Delete an asset group.
+//
+// Deletes an asset group
+func (s *Client) DeleteAssetGroup(ctx context.Context, assetGroupID int32, params *DeleteAssetGroupParams) error {
+	return s.do(ctx, request{
+		method:     http.MethodDelete,
+		parameters: params,
+		path:       "/api/v2/asset-groups/" + pathParameter(assetGroupID),
+	}, nil)
+}
+
+// ListAssetGroupCollectionsParams holds the query and header parameters of ListAssetGroupCollections. Nil and empty
+// fields are omitted.
+type ListAssetGroupCollectionsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int     `header:"Prefer"`
+	SortBy []string `query:"sort_by"`
+	ID     string   `query:"id"`
+	// Filter results by `created_at` value. See filter schema details for valid predicates.
+	CreatedAt string `query:"created_at"`
+	// Filter results by `updated_at` value. See filter schema details for valid predicates.
+	UpdatedAt string `query:"updated_at"`
+	// Filter results by `deleted_at` value. See filter schema details for valid predicates.
+	DeletedAt string `query:"deleted_at"`
+}
+
+// ListAssetGroupCollections sends GET /api/v2/asset-groups/{asset_group_id}/collections. List asset group collections.
+//
+// Returns all historical memberships if no URL params are specified.
+func (s *Client) ListAssetGroupCollections(ctx context.Context, assetGroupID int32, params *ListAssetGroupCollectionsParams) (ListAssetGroupCollectionsResponse, error) {
+	var response ListAssetGroupCollectionsResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/asset-groups/" + pathParameter(assetGroupID) + "/collections",
+	}, &response)
+}
+
+// GetAssetGroupComboNodeParams holds the query and header parameters of GetAssetGroupComboNode. Nil and empty fields
+// are omitted.
+type GetAssetGroupComboNodeParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Filter by Domain security identifier.
+	Domainsid *string `query:"domainsid"`
+}
+
+// GetAssetGroupComboNode sends GET /api/v2/asset-groups/{asset_group_id}/combo-node. Get the combo tree for an asset
+// group.
+//
+// # Gets the combo tree for an asset group
+//
+// Deprecated: the route is deprecated.
+func (s *Client) GetAssetGroupComboNode(ctx context.Context, assetGroupID int32, params *GetAssetGroupComboNodeParams) (GetAssetGroupComboNodeResponse, error) {
+	var response GetAssetGroupComboNodeResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/asset-groups/" + pathParameter(assetGroupID) + "/combo-node",
+	}, &response)
+}
+
+// GetAssetGroupCustomMemberCountParams holds the query and header parameters of GetAssetGroupCustomMemberCount. Nil
+// and empty fields are omitted.
+type GetAssetGroupCustomMemberCountParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// GetAssetGroupCustomMemberCount sends GET /api/v2/asset-groups/{asset_group_id}/custom-selectors. Get asset group
+// custom member count.
+//
+// Get asset group custom member count
+func (s *Client) GetAssetGroupCustomMemberCount(ctx context.Context, assetGroupID int32, params *GetAssetGroupCustomMemberCountParams) (GetAssetGroupCustomMemberCountResponse, error) {
+	var response GetAssetGroupCustomMemberCountResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/asset-groups/" + pathParameter(assetGroupID) + "/custom-selectors",
+	}, &response)
+}
+
+// ListAssetGroupMembersParams holds the query and header parameters of ListAssetGroupMembers. Nil and empty fields are
+// omitted.
+type ListAssetGroupMembersParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// This query parameter is used for determining the number of objects to skip in pagination.
+	Skip *int `query:"skip"`
+	// This query parameter is used for setting an upper limit of objects returned in paginated responses.
+	Limit *int `query:"limit"`
+	// Sortable columns are `object_id`, `asset_group_id`, `primary_kind`, `environment_id`, `environment_kind`, and
+	// `name`.
+	SortBy          []string `query:"sort_by"`
+	ObjectID        string   `query:"object_id"`
+	PrimaryKind     string   `query:"primary_kind"`
+	EnvironmentID   string   `query:"environment_id"`
+	EnvironmentKind string   `query:"environment_kind"`
+	Name            string   `query:"name"`
+	CustomMember    string   `query:"custom_member"`
+}
+
+// ListAssetGroupMembers sends GET /api/v2/asset-groups/{asset_group_id}/members. List all asset isolation group
+// members.
+//
+// List all members of an asset isolation group.
+func (s *Client) ListAssetGroupMembers(ctx context.Context, assetGroupID int32, params *ListAssetGroupMembersParams) (ListAssetGroupMembersResponse, error) {
+	var response ListAssetGroupMembersResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/asset-groups/" + pathParameter(assetGroupID) + "/members",
+	}, &response)
+}
+
+// ListAssetGroupMembersAll returns an iterator over every item returned by ListAssetGroupMembers, requesting each page
+// in turn until a page shorter than the limit is returned.
+func (s *Client) ListAssetGroupMembersAll(ctx context.Context, assetGroupID int32, params ListAssetGroupMembersParams) iter.Seq2[AssetGroupMember, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]AssetGroupMember, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.ListAssetGroupMembers(ctx, assetGroupID, &params)
+		return response.Data.Members, err
+	})
+}
+
+// ListAssetGroupMemberCountByKindParams holds the query and header parameters of ListAssetGroupMemberCountByKind. Nil
+// and empty fields are omitted.
+type ListAssetGroupMemberCountByKindParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer          *int   `header:"Prefer"`
+	ObjectID        string `query:"object_id"`
+	EnvironmentID   string `query:"environment_id"`
+	PrimaryKind     string `query:"primary_kind"`
+	EnvironmentKind string `query:"environment_kind"`
+	Name            string `query:"name"`
+	CustomMember    string `query:"custom_member"`
+}
+
+// ListAssetGroupMemberCountByKind sends GET /api/v2/asset-groups/{asset_group_id}/members/counts. List asset group
+// member count by kind.
+//
+// List counts of members of an asset isolation group by primary kind.
+func (s *Client) ListAssetGroupMemberCountByKind(ctx context.Context, assetGroupID int32, params *ListAssetGroupMemberCountByKindParams) (ListAssetGroupMemberCountByKindResponse, error) {
+	var response ListAssetGroupMemberCountByKindResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/asset-groups/" + pathParameter(assetGroupID) + "/members/counts",
+	}, &response)
+}
+
+// UpdateAssetGroupSelectorsParams holds the query and header parameters of UpdateAssetGroupSelectors. Nil and empty
+// fields are omitted.
+type UpdateAssetGroupSelectorsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// UpdateAssetGroupSelectors sends PUT /api/v2/asset-groups/{asset_group_id}/selectors. Update asset group selectors.
+//
+// Updates asset group selectors
+func (s *Client) UpdateAssetGroupSelectors(ctx context.Context, assetGroupID int32, body []AssetGroupSelectorSpec, params *UpdateAssetGroupSelectorsParams) (UpdateAssetGroupSelectorsResponse, error) {
+	var response UpdateAssetGroupSelectorsResponse
+	return response, s.do(ctx, request{
+		body:       body,
+		method:     http.MethodPut,
+		parameters: params,
+		path:       "/api/v2/asset-groups/" + pathParameter(assetGroupID) + "/selectors",
+	}, &response)
+}
+
+// UpdateAssetGroupSelectorsDeprecatedParams holds the query and header parameters of
+// UpdateAssetGroupSelectorsDeprecated. Nil and empty fields are omitted.
+type UpdateAssetGroupSelectorsDeprecatedParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// UpdateAssetGroupSelectorsDeprecated sends POST /api/v2/asset-groups/{asset_group_id}/selectors. Update asset group
+// selectors.
+//
+// DEPRECATED use PUT instead. Updates asset group selectors.
+//
+// Deprecated: the route is deprecated.
+func (s *Client) UpdateAssetGroupSelectorsDeprecated(ctx context.Context, assetGroupID int32, body []AssetGroupSelectorSpec, params *UpdateAssetGroupSelectorsDeprecatedParams) (UpdateAssetGroupSelectorsDeprecatedResponse, error) {
+	var response UpdateAssetGroupSelectorsDeprecatedResponse
+	return response, s.do(ctx, request{
+		body:       body,
+		method:     http.MethodPost,
+		parameters: params,
+		path:       "/api/v2/asset-groups/" + pathParameter(assetGroupID) + "/selectors",
+	}, &response)
+}
+
+// DeleteAssetGroupSelectorParams holds the query and header parameters of DeleteAssetGroupSelector. Nil and empty
+// fields are omitted.
+type DeleteAssetGroupSelectorParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// DeleteAssetGroupSelector sends DELETE /api/v2/asset-groups/{asset_group_id}/selectors/{asset_group_selector_id}.
+// Delete an asset group selector.
+//
+// Deletes an asset group selector
+func (s *Client) DeleteAssetGroupSelector(ctx context.Context, assetGroupID int32, assetGroupSelectorID int32, params *DeleteAssetGroupSelectorParams) error {
+	return s.do(ctx, request{
+		method:     http.MethodDelete,
+		parameters: params,
+		path:       "/api/v2/asset-groups/" + pathParameter(assetGroupID) + "/selectors/" + pathParameter(assetGroupSelectorID),
+	}, nil)
+}
+
+// ListAttackPathTypesParams holds the query and header parameters of ListAttackPathTypes. Nil and empty fields are
+// omitted.
+type ListAttackPathTypesParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Sort by column. The only sortable column is `finding`.
+	SortBy  []string `query:"sort_by"`
+	Finding string   `query:"finding"`
+}
+
+// ListAttackPathTypes sends GET /api/v2/attack-path-types. List all attack path types.
+//
+// Lists all possible attack path types
+func (s *Client) ListAttackPathTypes(ctx context.Context, params *ListAttackPathTypesParams) (ListAttackPathTypesResponse, error) {
+	var response ListAttackPathTypesResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/attack-path-types",
+	}, &response)
+}
+
+// StartAnalysisBheParams holds the query and header parameters of StartAnalysisBhe. Nil and empty fields are omitted.
+type StartAnalysisBheParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// StartAnalysisBhe sends PUT /api/v2/attack-paths. Start analysis.
+//
+// Starts generating attack paths
+func (s *Client) StartAnalysisBhe(ctx context.Context, params *StartAnalysisBheParams) error {
+	return s.do(ctx, request{
+		method:     http.MethodPut,
+		parameters: params,
+		path:       "/api/v2/attack-paths",
+	}, nil)
+}
+
+// FindingTrendsForEnvironmentParams holds the query and header parameters of FindingTrendsForEnvironment. Nil and
+// empty fields are omitted.
+type FindingTrendsForEnvironmentParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Environment IDs
+	Environments []string `query:"environments"`
+	// Beginning datetime of range (inclusive) in RFC-3339 format; Defaults to current datetime minus 30 days
+	Start *time.Time `query:"start"`
+	// Ending datetime of range (exclusive) in RFC-3339 format; Defaults to current datetime
+	End *time.Time `query:"end"`
+}
+
+// FindingTrendsForEnvironment sends GET /api/v2/attack-paths/finding-trends. List finding trends.
+//
+// Lists findings and their changes in between two dates for an environment
+func (s *Client) FindingTrendsForEnvironment(ctx context.Context, params *FindingTrendsForEnvironmentParams) (FindingTrendsForEnvironmentResponse, error) {
+	var response FindingTrendsForEnvironmentResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/attack-paths/finding-trends",
+	}, &response)
+}
+
+// UpdateAttackPathRiskParams holds the query and header parameters of UpdateAttackPathRisk. Nil and empty fields are
+// omitted.
+type UpdateAttackPathRiskParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// UpdateAttackPathRisk sends PUT /api/v2/attack-paths/{attack_path_id}/acceptance. Update attack path risk.
+//
+// Updates an attack path as an accepted or unaccepted risk until a given time.
+func (s *Client) UpdateAttackPathRisk(ctx context.Context, attackPathID int64, body UpdateAttackPathRiskRequest, params *UpdateAttackPathRiskParams) (ResponseFinding, error) {
+	var response ResponseFinding
+	return response, s.do(ctx, request{
+		body:       body,
+		method:     http.MethodPut,
+		parameters: params,
+		path:       "/api/v2/attack-paths/" + pathParameter(attackPathID) + "/acceptance",
+	}, &response)
+}
+
+// ListAuditLogsParams holds the query and header parameters of ListAuditLogs. Nil and empty fields are omitted.
+type ListAuditLogsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// This query parameter is used for determining the number of objects to skip in pagination.
+	Skip *int `query:"skip"`
+	// This query parameter is used for setting an upper limit of objects returned in paginated responses.
+	Limit *int `query:"limit"`
+	// Filter results by `created_at` value. See filter schema details for valid predicates.
+	CreatedAt string `query:"created_at"`
+	// Sortable columns are `id`, `actor_id`, `actor_name`, `actor_email`, `action`, `request_id`, `created_at`, `source`,
+	// and `status`.
+	SortBy []string `query:"sort_by"`
+	// Return logs created before the specified time. Value should be in the RFC-3339 format. If not supplied, this will
+	// default to the current time.
+	Before *time.Time `query:"before"`
+	// Return logs created after the specified time. Value should be in the RFC-3339 format. If not supplied, this will
+	// default to 1 year before the current time.
+	After      *time.Time `query:"after"`
+	ID         string     `query:"id"`
+	ActorID    string     `query:"actor_id"`
+	ActorName  string     `query:"actor_name"`
+	ActorEmail string     `query:"actor_email"`
+	Action     string     `query:"action"`
+	RequestID  string     `query:"request_id"`
+	Source     string     `query:"source"`
+	// Filter results by column value. Valid filter predicates are `eq`, `neq`. Valid values are `success` and `failure`.
+	Status string `query:"status"`
+}
+
+// ListAuditLogs sends GET /api/v2/audit. List audit logs.
+//
+// Returns a list of audit logs.
+func (s *Client) ListAuditLogs(ctx context.Context, params *ListAuditLogsParams) (ListAuditLogsResponse, error) {
+	var response ListAuditLogsResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/audit",
+	}, &response)
+}
+
+// ListAuditLogsAll returns an iterator over every item returned by ListAuditLogs, requesting each page in turn until a
+// page shorter than the limit is returned.
+func (s *Client) ListAuditLogsAll(ctx context.Context, params ListAuditLogsParams) iter.Seq2[AuditLog, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]AuditLog, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.ListAuditLogs(ctx, &params)
+		return response.Data.Logs, err
+	})
+}
+
+// GetAvailableDomainsParams holds the query and header parameters of GetAvailableDomains. Nil and empty fields are
+// omitted.
+type GetAvailableDomainsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Sortable columns are objectid, name.
+	SortBy    string `query:"sort_by"`
+	Objectid  string `query:"objectid"`
+	Name      string `query:"name"`
+	Collected string `query:"collected"`
+}
+
+// GetAvailableDomains sends GET /api/v2/available-domains. Get available domains.
+//
+// Gets available domains along with their collection status
+func (s *Client) GetAvailableDomains(ctx context.Context, params *GetAvailableDomainsParams) (GetAvailableDomainsResponse, error) {
+	var response GetAvailableDomainsResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/available-domains",
+	}, &response)
+}
+
+// GetAzureTenantDataQualityStatsParams holds the query and header parameters of GetAzureTenantDataQualityStats. Nil
+// and empty fields are omitted.
+type GetAzureTenantDataQualityStatsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Sortable columns are created_at, updated_at.
+	SortBy []string `query:"sort_by"`
+	// Beginning datetime of range (inclusive) in RFC-3339 format; Defaults to current datetime minus 30 days
+	Start *time.Time `query:"start"`
+	// Ending datetime of range (exclusive) in RFC-3339 format; Defaults to current datetime
+	End *time.Time `query:"end"`
+	// This query parameter is used for determining the number of objects to skip in pagination.
+	Skip *int `query:"skip"`
+	// This query parameter is used for setting an upper limit of objects returned in paginated responses.
+	Limit *int `query:"limit"`
+}
+
+// GetAzureTenantDataQualityStats sends GET /api/v2/azure-tenants/{tenant_id}/data-quality-stats. Get Azure tenant data
+// quality stats.
+//
+// Time series list of data quality stats for a given Azure tenant
+func (s *Client) GetAzureTenantDataQualityStats(ctx context.Context, tenantID string, params *GetAzureTenantDataQualityStatsParams) (GetAzureTenantDataQualityStatsResponse, error) {
+	var response GetAzureTenantDataQualityStatsResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/azure-tenants/" + pathParameter(tenantID) + "/data-quality-stats",
+	}, &response)
+}
+
+// GetAzureTenantDataQualityStatsAll returns an iterator over every item returned by GetAzureTenantDataQualityStats,
+// requesting each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetAzureTenantDataQualityStatsAll(ctx context.Context, tenantID string, params GetAzureTenantDataQualityStatsParams) iter.Seq2[AzureDataQualityStat, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]AzureDataQualityStat, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetAzureTenantDataQualityStats(ctx, tenantID, &params)
+		return response.Data, err
+	})
+}
+
+// GetAzureEntityParams holds the query and header parameters of GetAzureEntity. Nil and empty fields are omitted.
+type GetAzureEntityParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The object ID of the entity being operated on.
+	ObjectID string `query:"object_id"`
+	// Returns related entity counts. Does not work with `related_entity_type` parameter.
+	Counts *bool `query:"counts"`
+	// Flags the query to return related entity information based on the type passed.
+	RelatedEntityType *string `query:"related_entity_type"`
+	// The type of return data expected. Only works with `related_entity_type` parameter.
+	Type *string `query:"type"`
+	// This query parameter is used for determining the number of objects to skip in pagination. Only compatible with
+	// `related_entity_type` and `type=list`
+	Skip *int `query:"skip"`
+	// This query parameter is used for setting an upper limit of objects returned in paginated responses. Only compatible
+	// with `related_entity_type` and `type=list`
+	Limit *int `query:"limit"`
+}
+
+// GetAzureEntity sends GET /api/v2/azure/{entity_type}. Get Azure entity.
+//
+// Retrieves entity information for the given Azure object ID. If `related_entity_type` parameter is not set, this
+// endpoint will return information about a single entity. Using the `counts` boolean parameter will further modify the
+// response. If `related_entity_type` parameter is set, this endpoint will return information about entities related to
+// a single entity. The `type` parameter will morph the response data structure. The `list` value for the `type`
+// parameter also accepts `skip` and `limit` parameters.
+func (s *Client) GetAzureEntity(ctx context.Context, entityType string, params *GetAzureEntityParams) (json.RawMessage, error) {
+	var response json.RawMessage
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/azure/" + pathParameter(entityType),
+	}, &response)
+}
+
+// GetEntityParams holds the query and header parameters of GetEntity. Nil and empty fields are omitted.
+type GetEntityParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Include counts of related entities. Default value is `true`.
+	Counts *bool `query:"counts"`
+}
+
+// GetEntity sends GET /api/v2/base/{object_id}. Get entity info.
+//
+// Get basic info and counts for this node.
+func (s *Client) GetEntity(ctx context.Context, objectID string, params *GetEntityParams) (EntityInfoQueryResults, error) {
+	var response EntityInfoQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/base/" + pathParameter(objectID),
+	}, &response)
+}
+
+// GetEntityControllablesParams holds the query and header parameters of GetEntityControllables. Nil and empty fields
+// are omitted.
+type GetEntityControllablesParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetEntityControllables sends GET /api/v2/base/{object_id}/controllables. Get entity controllables.
+//
+// Get a list, graph, or count of the principals this node can control.
+func (s *Client) GetEntityControllables(ctx context.Context, objectID string, params *GetEntityControllablesParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/base/" + pathParameter(objectID) + "/controllables",
+	}, &response)
+}
+
+// GetEntityControllablesAll returns an iterator over every item returned by GetEntityControllables, requesting each
+// page in turn until a page shorter than the limit is returned.
+func (s *Client) GetEntityControllablesAll(ctx context.Context, objectID string, params GetEntityControllablesParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetEntityControllables(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetEntityControllersParams holds the query and header parameters of GetEntityControllers. Nil and empty fields are
+// omitted.
+type GetEntityControllersParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetEntityControllers sends GET /api/v2/base/{object_id}/controllers. Get entity controllers.
+//
+// Get a list, graph, or count of the principals that can control this node.
+func (s *Client) GetEntityControllers(ctx context.Context, objectID string, params *GetEntityControllersParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/base/" + pathParameter(objectID) + "/controllers",
+	}, &response)
+}
+
+// GetEntityControllersAll returns an iterator over every item returned by GetEntityControllers, requesting each page
+// in turn until a page shorter than the limit is returned.
+func (s *Client) GetEntityControllersAll(ctx context.Context, objectID string, params GetEntityControllersParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetEntityControllers(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// ListUsersParams holds the query and header parameters of ListUsers. Nil and empty fields are omitted.
+type ListUsersParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Sortable columns are first_name, last_name, email_address, principal_name, last_login, created_at, updated_at,
+	// deleted_at.
+	SortBy        []string `query:"sort_by"`
+	FirstName     string   `query:"first_name"`
+	LastName      string   `query:"last_name"`
+	EmailAddress  string   `query:"email_address"`
+	PrincipalName string   `query:"principal_name"`
+	ID            string   `query:"id"`
+	LastLogin     string   `query:"last_login"`
+	// Filter results by `created_at` value. See filter schema details for valid predicates.
+	CreatedAt string `query:"created_at"`
+	// Filter results by `updated_at` value. See filter schema details for valid predicates.
+	UpdatedAt string `query:"updated_at"`
+	// Filter results by `deleted_at` value. See filter schema details for valid predicates.
+	DeletedAt string `query:"deleted_at"`
+}
+
+// ListUsers sends GET /api/v2/bloodhound-users. List Users.
+//
+// Gets all BloodHound user details.
+func (s *Client) ListUsers(ctx context.Context, params *ListUsersParams) (ListUsersResponse, error) {
+	var response ListUsersResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/bloodhound-users",
+	}, &response)
+}
+
+// CreateUserParams holds the query and header parameters of CreateUser. Nil and empty fields are omitted.
+type CreateUserParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// CreateUser sends POST /api/v2/bloodhound-users. Create a New User.
+//
+// Create a new BloodHound user.
+func (s *Client) CreateUser(ctx context.Context, body CreateUserRequest, params *CreateUserParams) (CreateUserResponse, error) {
+	var response CreateUserResponse
+	return response, s.do(ctx, request{
+		body:       body,
+		method:     http.MethodPost,
+		parameters: params,
+		path:       "/api/v2/bloodhound-users",
+	}, &response)
+}
+
+// GetUserParams holds the query and header parameters of GetUser. Nil and empty fields are omitted.
+type GetUserParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// GetUser sends GET /api/v2/bloodhound-users/{user_id}. Get a user.
+//
+// Get a BloodHound user's details.
+func (s *Client) GetUser(ctx context.Context, userID string, params *GetUserParams) (GetUserResponse, error) {
+	var response GetUserResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/bloodhound-users/" + pathParameter(userID),
+	}, &response)
+}
+
+// DeleteUserParams holds the query and header parameters of DeleteUser. Nil and empty fields are omitted.
+type DeleteUserParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// DeleteUser sends DELETE /api/v2/bloodhound-users/{user_id}. Delete a User.
+//
+// Deletes an existing BloodHound user.
+func (s *Client) DeleteUser(ctx context.Context, userID string, params *DeleteUserParams) error {
+	return s.do(ctx, request{
+		method:     http.MethodDelete,
+		parameters: params,
+		path:       "/api/v2/bloodhound-users/" + pathParameter(userID),
+	}, nil)
+}
+
+// UpdateUserParams holds the query and header parameters of UpdateUser. Nil and empty fields are omitted.
+type UpdateUserParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// UpdateUser sends PATCH /api/v2/bloodhound-users/{user_id}. Update a User.
+//
+// Update a BloodHound user's properties'.
+func (s *Client) UpdateUser(ctx context.Context, userID string, body RequestsUserUpdate, params *UpdateUserParams) error {
+	return s.do(ctx, request{
+		body:       body,
+		method:     http.MethodPatch,
+		parameters: params,
+		path:       "/api/v2/bloodhound-users/" + pathParameter(userID),
+	}, nil)
+}
+
+// AddUserMfaParams holds the query and header parameters of AddUserMfa. Nil and empty fields are omitted.
+type AddUserMfaParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// AddUserMfa sends POST /api/v2/bloodhound-users/{user_id}/mfa. Enrolls user in multi-factor authentication.
+//
+// Enrolls user in multi-factor authentication
+func (s *Client) AddUserMfa(ctx context.Context, userID string, body AddUserMfaRequest, params *AddUserMfaParams) (AddUserMfaResponse, error) {
+	var response AddUserMfaResponse
+	return response, s.do(ctx, request{
+		body:       body,
+		method:     http.MethodPost,
+		parameters: params,
+		path:       "/api/v2/bloodhound-users/" + pathParameter(userID) + "/mfa",
+	}, &response)
+}
+
+// RemoveUserMfaParams holds the query and header parameters of RemoveUserMfa. Nil and empty fields are omitted.
+type RemoveUserMfaParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// RemoveUserMfa sends DELETE /api/v2/bloodhound-users/{user_id}/mfa. Unenroll user from multi-factor authentication.
+//
+// Unenrolls user from multi-factor authentication
+func (s *Client) RemoveUserMfa(ctx context.Context, userID string, body RemoveUserMfaRequest, params *RemoveUserMfaParams) (RemoveUserMfaResponse, error) {
+	var response RemoveUserMfaResponse
+	return response, s.do(ctx, request{
+		body:       body,
+		method:     http.MethodDelete,
+		parameters: params,
+		path:       "/api/v2/bloodhound-users/" + pathParameter(userID) + "/mfa",
+	}, &response)
+}
+
+// GetMfaActivationStatusParams holds the query and header parameters of GetMfaActivationStatus. Nil and empty fields
+// are omitted.
+type GetMfaActivationStatusParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// GetMfaActivationStatus sends GET /api/v2/bloodhound-users/{user_id}/mfa-activation. Returns MFA activation status
+// for a user.
+//
+// Returns multi-factor authentication status for a user
+func (s *Client) GetMfaActivationStatus(ctx context.Context, userID string, params *GetMfaActivationStatusParams) (GetMfaActivationStatusResponse, error) {
+	var response GetMfaActivationStatusResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/bloodhound-users/" + pathParameter(userID) + "/mfa-activation",
+	}, &response)
+}
+
+// ActivateUserMfaParams holds the query and header parameters of ActivateUserMfa. Nil and empty fields are omitted.
+type ActivateUserMfaParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// ActivateUserMfa sends POST /api/v2/bloodhound-users/{user_id}/mfa-activation. Activates MFA for an enrolled user.
+//
+// Activates multi-factor authentication for an enrolled user
+func (s *Client) ActivateUserMfa(ctx context.Context, userID string, body ActivateUserMfaRequest, params *ActivateUserMfaParams) (ActivateUserMfaResponse, error) {
+	var response ActivateUserMfaResponse
+	return response, s.do(ctx, request{
+		body:       body,
+		method:     http.MethodPost,
+		parameters: params,
+		path:       "/api/v2/bloodhound-users/" + pathParameter(userID) + "/mfa-activation",
+	}, &response)
+}
+
+// CreateOrSetUserSecretParams holds the query and header parameters of CreateOrSetUserSecret. Nil and empty fields are
+// omitted.
+type CreateOrSetUserSecretParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// CreateOrSetUserSecret sends PUT /api/v2/bloodhound-users/{user_id}/secret. Create or Set User Secret.
+//
+// Create or set a user's secret to use as a login password.
+func (s *Client) CreateOrSetUserSecret(ctx context.Context, userID string, body RequestsUserSetSecret, params *CreateOrSetUserSecretParams) error {
+	return s.do(ctx, request{
+		body:       body,
+		method:     http.MethodPut,
+		parameters: params,
+		path:       "/api/v2/bloodhound-users/" + pathParameter(userID) + "/secret",
+	}, nil)
+}
+
+// DeleteUserSecretParams holds the query and header parameters of DeleteUserSecret. Nil and empty fields are omitted.
+type DeleteUserSecretParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// DeleteUserSecret sends DELETE /api/v2/bloodhound-users/{user_id}/secret. Expire User Secret.
+//
+// Expire a user's secret to use as a login password.
+func (s *Client) DeleteUserSecret(ctx context.Context, userID string, params *DeleteUserSecretParams) error {
+	return s.do(ctx, request{
+		method:     http.MethodDelete,
+		parameters: params,
+		path:       "/api/v2/bloodhound-users/" + pathParameter(userID) + "/secret",
+	}, nil)
+}
+
+// GetCertTemplateEntityParams holds the query and header parameters of GetCertTemplateEntity. Nil and empty fields are
+// omitted.
+type GetCertTemplateEntityParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Include counts of related entities. Default value is `true`.
+	Counts *bool `query:"counts"`
+}
+
+// GetCertTemplateEntity sends GET /api/v2/certtemplates/{object_id}. Get Cert Template entity info.
+//
+// Get info and counts for this Cert Template node.
+func (s *Client) GetCertTemplateEntity(ctx context.Context, objectID string, params *GetCertTemplateEntityParams) (EntityInfoQueryResults, error) {
+	var response EntityInfoQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/certtemplates/" + pathParameter(objectID),
+	}, &response)
+}
+
+// GetCertTemplateEntityControllersParams holds the query and header parameters of GetCertTemplateEntityControllers.
+// Nil and empty fields are omitted.
+type GetCertTemplateEntityControllersParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetCertTemplateEntityControllers sends GET /api/v2/certtemplates/{object_id}/controllers. Get Cert Template entity
+// controllers.
+//
+// Get a list, graph, or count of the principals that can control this Cert Template.
+func (s *Client) GetCertTemplateEntityControllers(ctx context.Context, objectID string, params *GetCertTemplateEntityControllersParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/certtemplates/" + pathParameter(objectID) + "/controllers",
+	}, &response)
+}
+
+// GetCertTemplateEntityControllersAll returns an iterator over every item returned by
+// GetCertTemplateEntityControllers, requesting each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetCertTemplateEntityControllersAll(ctx context.Context, objectID string, params GetCertTemplateEntityControllersParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetCertTemplateEntityControllers(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// DeleteBloodHoundDatabaseParams holds the query and header parameters of DeleteBloodHoundDatabase. Nil and empty
+// fields are omitted.
+type DeleteBloodHoundDatabaseParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// DeleteBloodHoundDatabase sends POST /api/v2/clear-database. Delete your BloodHound data.
+//
+// Wipes your Bloodhound data permanently. Specify the data to delete in the request body. Possible data includes
+// collected graph data, custom high value selectors, file ingest history, and data quality history.
+func (s *Client) DeleteBloodHoundDatabase(ctx context.Context, body DeleteBloodHoundDatabaseRequest, params *DeleteBloodHoundDatabaseParams) error {
+	return s.do(ctx, request{
+		body:       body,
+		method:     http.MethodPost,
+		parameters: params,
+		path:       "/api/v2/clear-database",
+	}, nil)
+}
+
+// ListClientsParams holds the query and header parameters of ListClients. Nil and empty fields are omitted.
+type ListClientsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Filter results by `created_at` value. See filter schema details for valid predicates.
+	CreatedAt string `query:"created_at"`
+	// Filter results by `updated_at` value. See filter schema details for valid predicates.
+	UpdatedAt string `query:"updated_at"`
+	// Filter results by `deleted_at` value. See filter schema details for valid predicates.
+	DeletedAt string `query:"deleted_at"`
+	// When a value of `true` is passed, any Domains associated with scheduled and finished jobs for each client will have
+	// expanded properties including `name` and `type`. When a value of `false` is passed, these same Domains will only
+	// return as a list of `objectid`s.
+	HydrateDomains *bool `query:"hydrate_domains"`
+	// When a value of `true` is passed, any OUs associated with scheduled and finished jobs for each client will have
+	// expanded properties including `name` and `type`. When a value of `false` is passed, these same OUs will only return
+	// as a list of `objectid`s.
+	HydrateOus *bool `query:"hydrate_ous"`
+	// This query parameter is used for determining the number of objects to skip in pagination.
+	Skip *int `query:"skip"`
+	// This query parameter is used for setting an upper limit of objects returned in paginated responses.
+	Limit *int `query:"limit"`
+	// Sortable columns are `name`, `ip_address`, `hostname`, `configured_user`, `last_checkin`, `completed_job_count`,
+	// `created_at`, `updated_at`, `deleted_at`.
+	SortBy            []string `query:"sort_by"`
+	Name              string   `query:"name"`
+	IPAddress         string   `query:"ip_address"`
+	Hostname          string   `query:"hostname"`
+	ConfiguredUser    string   `query:"configured_user"`
+	Version           string   `query:"version"`
+	UserSID           string   `query:"user_sid"`
+	LastCheckin       string   `query:"last_checkin"`
+	CurrentJobID      string   `query:"current_job_id"`
+	CompletedJobCount string   `query:"completed_job_count"`
+	DomainController  string   `query:"domain_controller"`
+	ID                string   `query:"id"`
+}
+
+// ListClients sends GET /api/v2/clients. List Clients.
+//
+// Lists available clients for processing collection events.
+func (s *Client) ListClients(ctx context.Context, params *ListClientsParams) (ListClientsResponse, error) {
+	var response ListClientsResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/clients",
+	}, &response)
+}
+
+// ListClientsAll returns an iterator over every item returned by ListClients, requesting each page in turn until a
+// page shorter than the limit is returned.
+func (s *Client) ListClientsAll(ctx context.Context, params ListClientsParams) iter.Seq2[ClientDisplay, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]ClientDisplay, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.ListClients(ctx, &params)
+		return response.Data, err
+	})
+}
+
+// CreateClientParams holds the query and header parameters of CreateClient. Nil and empty fields are omitted.
+type CreateClientParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// CreateClient sends POST /api/v2/clients. Create Client.
+//
+// Creates a client for collection events
+func (s *Client) CreateClient(ctx context.Context, body CreateClientRequest, params *CreateClientParams) (CreateClientResponse, error) {
+	var response CreateClientResponse
+	return response, s.do(ctx, request{
+		body:       body,
+		method:     http.MethodPost,
+		parameters: params,
+		path:       "/api/v2/clients",
+	}, &response)
+}
+
+// LogClientErrorParams holds the query and header parameters of LogClientError. Nil and empty fields are omitted.
+type LogClientErrorParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// LogClientError sends POST /api/v2/clients/error. Client Error.
+//
+// Endpoint for clients to log enumeration errors.
+//
+// Note: caller must be a client. For users, this endpoint will return a 404 as they are not expected or allowed to
+// call this endpoint.
+func (s *Client) LogClientError(ctx context.Context, body LogClientErrorRequest, params *LogClientErrorParams) error {
+	return s.do(ctx, request{
+		body:       body,
+		method:     http.MethodPost,
+		parameters: params,
+		path:       "/api/v2/clients/error",
+	}, nil)
+}
+
+// UpdateClientInfoParams holds the query and header parameters of UpdateClientInfo. Nil and empty fields are omitted.
+type UpdateClientInfoParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// UpdateClientInfo sends PUT /api/v2/clients/update. Update Client Values.
+//
+// Endpoint for clients to update their own information at startup.
+//
+// Note: caller must be a client. For users, this endpoint will return a 404 as they are not expected or allowed to
+// call this endpoint.
+func (s *Client) UpdateClientInfo(ctx context.Context, body UpdateClientInfoRequest, params *UpdateClientInfoParams) (UpdateClientInfoResponse, error) {
+	var response UpdateClientInfoResponse
+	return response, s.do(ctx, request{
+		body:       body,
+		method:     http.MethodPut,
+		parameters: params,
+		path:       "/api/v2/clients/update",
+	}, &response)
+}
+
+// GetClientParams holds the query and header parameters of GetClient. Nil and empty fields are omitted.
+type GetClientParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// GetClient sends GET /api/v2/clients/{client_id}. Get Client.
+//
+// Gets given client for processing collection events
+func (s *Client) GetClient(ctx context.Context, clientID string, params *GetClientParams) (GetClientResponse, error) {
+	var response GetClientResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/clients/" + pathParameter(clientID),
+	}, &response)
+}
+
+// UpdateClientParams holds the query and header parameters of UpdateClient. Nil and empty fields are omitted.
+type UpdateClientParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// UpdateClient sends PUT /api/v2/clients/{client_id}. Update Client.
+//
+// Update a client for processing collection events
+func (s *Client) UpdateClient(ctx context.Context, clientID string, body UpdateClientRequest, params *UpdateClientParams) (UpdateClientResponse, error) {
+	var response UpdateClientResponse
+	return response, s.do(ctx, request{
+		body:       body,
+		method:     http.MethodPut,
+		parameters: params,
+		path:       "/api/v2/clients/" + pathParameter(clientID),
+	}, &response)
+}
+
+// DeleteClientParams holds the query and header parameters of DeleteClient. Nil and empty fields are omitted.
+type DeleteClientParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// DeleteClient sends DELETE /api/v2/clients/{client_id}. Delete Client.
+//
+// Delete a client for processing collection events
+func (s *Client) DeleteClient(ctx context.Context, clientID string, params *DeleteClientParams) error {
+	return s.do(ctx, request{
+		method:     http.MethodDelete,
+		parameters: params,
+		path:       "/api/v2/clients/" + pathParameter(clientID),
+	}, nil)
+}
+
+// ListClientCompletedJobsParams holds the query and header parameters of ListClientCompletedJobs. Nil and empty fields
+// are omitted.
+type ListClientCompletedJobsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Filter results by `created_at` value. See filter schema details for valid predicates.
+	CreatedAt string `query:"created_at"`
+	// Filter results by `updated_at` value. See filter schema details for valid predicates.
+	UpdatedAt string `query:"updated_at"`
+	// Filter results by `deleted_at` value. See filter schema details for valid predicates.
+	DeletedAt string `query:"deleted_at"`
+	// When a value of `true` is passed, any Domains associated with scheduled and finished jobs for each client will have
+	// expanded properties including `name` and `type`. When a value of `false` is passed, these same Domains will only
+	// return as a list of `objectid`s.
+	HydrateDomains *bool `query:"hydrate_domains"`
+	// When a value of `true` is passed, any OUs associated with scheduled and finished jobs for each client will have
+	// expanded properties including `name` and `type`. When a value of `false` is passed, these same OUs will only return
+	// as a list of `objectid`s.
+	HydrateOus *bool `query:"hydrate_ous"`
+	// This query parameter is used for determining the number of objects to skip in pagination.
+	Skip *int `query:"skip"`
+	// This query parameter is used for setting an upper limit of objects returned in paginated responses.
+	Limit *int `query:"limit"`
+	// Sortable columns are `event_id`, `execution_time`, `status`, `start_time`, `end_time`, `log_path`,
+	// `domain_controller`, `event_title`, `last_ingest`, `id`, `created_at`, `updated_at`, `deleted_at`.
+	SortBy                 []string `query:"sort_by"`
+	ID                     string   `query:"id"`
+	LogPath                string   `query:"log_path"`
+	SessionCollection      string   `query:"session_collection"`
+	LocalGroupCollection   string   `query:"local_group_collection"`
+	CertServicesCollection string   `query:"cert_services_collection"`
+	CARegistryCollection   string   `query:"ca_registry_collection"`
+	DcRegistryCollection   string   `query:"dc_registry_collection"`
+	ADStructureCollection  string   `query:"ad_structure_collection"`
+	DomainController       string   `query:"domain_controller"`
+	Status                 string   `query:"status"`
+	EventTitle             string   `query:"event_title"`
+	ClientID               string   `query:"client_id"`
+	EventID                string   `query:"event_id"`
+	ExecutionTime          string   `query:"execution_time"`
+	StartTime              string   `query:"start_time"`
+	EndTime                string   `query:"end_time"`
+	LastIngest             string   `query:"last_ingest"`
+}
+
+// ListClientCompletedJobs sends GET /api/v2/clients/{client_id}/completed-jobs. List all completed jobs for a client.
+//
+// List all completed jobs for a client
+func (s *Client) ListClientCompletedJobs(ctx context.Context, clientID string, params *ListClientCompletedJobsParams) (ListClientCompletedJobsResponse, error) {
+	var response ListClientCompletedJobsResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/clients/" + pathParameter(clientID) + "/completed-jobs",
+	}, &response)
+}
+
+// ListClientCompletedJobsAll returns an iterator over every item returned by ListClientCompletedJobs, requesting each
+// page in turn until a page shorter than the limit is returned.
+func (s *Client) ListClientCompletedJobsAll(ctx context.Context, clientID string, params ListClientCompletedJobsParams) iter.Seq2[ClientScheduledJobDisplay, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]ClientScheduledJobDisplay, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.ListClientCompletedJobs(ctx, clientID, &params)
+		return response.Data, err
+	})
+}
+
+// ListClientCompletedTasksParams holds the query and header parameters of ListClientCompletedTasks. Nil and empty
+// fields are omitted.
+type ListClientCompletedTasksParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Filter results by `created_at` value. See filter schema details for valid predicates.
+	CreatedAt string `query:"created_at"`
+	// Filter results by `updated_at` value. See filter schema details for valid predicates.
+	UpdatedAt string `query:"updated_at"`
+	// Filter results by `deleted_at` value. See filter schema details for valid predicates.
+	DeletedAt string `query:"deleted_at"`
+	// When a value of `true` is passed, any Domains associated with scheduled and finished jobs for each client will have
+	// expanded properties including `name` and `type`. When a value of `false` is passed, these same Domains will only
+	// return as a list of `objectid`s.
+	HydrateDomains *bool `query:"hydrate_domains"`
+	// When a value of `true` is passed, any OUs associated with scheduled and finished jobs for each client will have
+	// expanded properties including `name` and `type`. When a value of `false` is passed, these same OUs will only return
+	// as a list of `objectid`s.
+	HydrateOus *bool `query:"hydrate_ous"`
+	// This query parameter is used for determining the number of objects to skip in pagination.
+	Skip *int `query:"skip"`
+	// This query parameter is used for setting an upper limit of objects returned in paginated responses.
+	Limit *int `query:"limit"`
+	// Sortable columns are `event_id`, `execution_time`, `status`, `start_time`, `end_time`, `log_path`,
+	// `domain_controller`, `event_title`, `last_ingest`, `id`, `created_at`, `updated_at`, `deleted_at`.
+	SortBy                 []string `query:"sort_by"`
+	ID                     string   `query:"id"`
+	LogPath                string   `query:"log_path"`
+	SessionCollection      string   `query:"session_collection"`
+	LocalGroupCollection   string   `query:"local_group_collection"`
+	CertServicesCollection string   `query:"cert_services_collection"`
+	CARegistryCollection   string   `query:"ca_registry_collection"`
+	DcRegistryCollection   string   `query:"dc_registry_collection"`
+	ADStructureCollection  string   `query:"ad_structure_collection"`
+	DomainController       string   `query:"domain_controller"`
+	Status                 string   `query:"status"`
+	EventTitle             string   `query:"event_title"`
+	ClientID               string   `query:"client_id"`
+	EventID                string   `query:"event_id"`
+	ExecutionTime          string   `query:"execution_time"`
+	StartTime              string   `query:"start_time"`
+	EndTime                string   `query:"end_time"`
+	LastIngest             string   `query:"last_ingest"`
+}
+
+// ListClientCompletedTasks sends GET /api/v2/clients/{client_id}/completed-tasks. List all completed tasks for a
+// client.
+//
+// # List all completed tasks for a client
+//
+// Deprecated: the route is deprecated.
+func (s *Client) ListClientCompletedTasks(ctx context.Context, clientID string, params *ListClientCompletedTasksParams) (ListClientCompletedTasksResponse, error) {
+	var response ListClientCompletedTasksResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/clients/" + pathParameter(clientID) + "/completed-tasks",
+	}, &response)
+}
+
+// ListClientCompletedTasksAll returns an iterator over every item returned by ListClientCompletedTasks, requesting
+// each page in turn until a page shorter than the limit is returned.
+func (s *Client) ListClientCompletedTasksAll(ctx context.Context, clientID string, params ListClientCompletedTasksParams) iter.Seq2[ClientScheduledJobDisplay, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]ClientScheduledJobDisplay, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.ListClientCompletedTasks(ctx, clientID, &params)
+		return response.Data, err
+	})
+}
+
+// CreateClientScheduledJobParams holds the query and header parameters of CreateClientScheduledJob. Nil and empty
+// fields are omitted.
+type CreateClientScheduledJobParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// CreateClientScheduledJob sends POST /api/v2/clients/{client_id}/jobs. Creates a scheduled job.
+//
+// Creates a new scheduled job
+func (s *Client) CreateClientScheduledJob(ctx context.Context, clientID string, body ClientScheduledJob, params *CreateClientScheduledJobParams) (CreateClientScheduledJobResponse, error) {
+	var response CreateClientScheduledJobResponse
+	return response, s.do(ctx, request{
+		body:       body,
+		method:     http.MethodPost,
+		parameters: params,
+		path:       "/api/v2/clients/" + pathParameter(clientID) + "/jobs",
+	}, &response)
+}
+
+// CreateClientScheduledTaskParams holds the query and header parameters of CreateClientScheduledTask. Nil and empty
+// fields are omitted.
+type CreateClientScheduledTaskParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// CreateClientScheduledTask sends POST /api/v2/clients/{client_id}/tasks. Creates a scheduled task.
+//
+// # Creates a new scheduled task
+//
+// Deprecated: the route is deprecated.
+func (s *Client) CreateClientScheduledTask(ctx context.Context, clientID string, body ClientScheduledJob, params *CreateClientScheduledTaskParams) (CreateClientScheduledTaskResponse, error) {
+	var response CreateClientScheduledTaskResponse
+	return response, s.do(ctx, request{
+		body:       body,
+		method:     http.MethodPost,
+		parameters: params,
+		path:       "/api/v2/clients/" + pathParameter(clientID) + "/tasks",
+	}, &response)
+}
+
+// ReplaceClientTokenParams holds the query and header parameters of ReplaceClientToken. Nil and empty fields are
+// omitted.
+type ReplaceClientTokenParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// ReplaceClientToken sends PUT /api/v2/clients/{client_id}/token. Regenerate the authentication token for a client.
+//
+// Regenerate the authentication token for a client
+func (s *Client) ReplaceClientToken(ctx context.Context, clientID string, params *ReplaceClientTokenParams) (ReplaceClientTokenResponse, error) {
+	var response ReplaceClientTokenResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodPut,
+		parameters: params,
+		path:       "/api/v2/clients/" + pathParameter(clientID) + "/token",
+	}, &response)
+}
+
+// GetClusterStatusParams holds the query and header parameters of GetClusterStatus. Nil and empty fields are omitted.
+type GetClusterStatusParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// GetClusterStatus sends GET /api/v2/cluster/status. Get cluster status.
+//
+// Gets the API replicas sharing the application database that have recently sent a heartbeat. Every replica serves the
+// API while only the leader processes ingest, runs analysis and prunes data.
+func (s *Client) GetClusterStatus(ctx context.Context, params *GetClusterStatusParams) (GetClusterStatusResponse, error) {
+	var response GetClusterStatusResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/cluster/status",
+	}, &response)
+}
+
+// GetCollectorManifestParams holds the query and header parameters of GetCollectorManifest. Nil and empty fields are
+// omitted.
+type GetCollectorManifestParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// GetCollectorManifest sends GET /api/v2/collectors/{collector_type}. Get collector manifest.
+//
+// Retrieves the version manifest for a given collector
+func (s *Client) GetCollectorManifest(ctx context.Context, collectorType ClientType, params *GetCollectorManifestParams) (GetCollectorManifestResponse, error) {
+	var response GetCollectorManifestResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/collectors/" + pathParameter(collectorType),
+	}, &response)
+}
+
+// DownloadCollectorParams holds the query and header parameters of DownloadCollector. Nil and empty fields are
+// omitted.
+type DownloadCollectorParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// DownloadCollector sends GET /api/v2/collectors/{collector_type}/{release_tag}. Get collector download by version.
+//
+// Retrieves the download for a given collector with given version
+func (s *Client) DownloadCollector(ctx context.Context, collectorType ClientType, releaseTag string, params *DownloadCollectorParams) (io.ReadCloser, error) {
+	return s.stream(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/collectors/" + pathParameter(collectorType) + "/" + pathParameter(releaseTag),
+	})
+}
+
+// GetCollectorChecksumParams holds the query and header parameters of GetCollectorChecksum. Nil and empty fields are
+// omitted.
+type GetCollectorChecksumParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// GetCollectorChecksum sends GET /api/v2/collectors/{collector_type}/{release_tag}/checksum. Get collector checksum by
+// version.
+//
+// Retrieves the checksum file for a given collector with given version
+func (s *Client) GetCollectorChecksum(ctx context.Context, collectorType ClientType, releaseTag string, params *GetCollectorChecksumParams) (io.ReadCloser, error) {
+	return s.stream(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/collectors/" + pathParameter(collectorType) + "/" + pathParameter(releaseTag) + "/checksum",
+	})
+}
+
+// GetCompletenessStatsParams holds the query and header parameters of GetCompletenessStats. Nil and empty fields are
+// omitted.
+type GetCompletenessStatsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// GetCompletenessStats sends GET /api/v2/completeness. Get database completeness stats.
+//
+// Get the percentage of local admins and sessions collected
+func (s *Client) GetCompletenessStats(ctx context.Context, params *GetCompletenessStatsParams) (GetCompletenessStatsResponse, error) {
+	var response GetCompletenessStatsResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/completeness",
+	}, &response)
+}
+
+// GetComputerEntityParams holds the query and header parameters of GetComputerEntity. Nil and empty fields are
+// omitted.
+type GetComputerEntityParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Include counts of related entities. Default value is `true`.
+	Counts *bool `query:"counts"`
+}
+
+// GetComputerEntity sends GET /api/v2/computers/{object_id}. Get computer entity info.
+//
+// Get info and counts for this computer node.
+func (s *Client) GetComputerEntity(ctx context.Context, objectID string, params *GetComputerEntityParams) (EntityInfoQueryResults, error) {
+	var response EntityInfoQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/computers/" + pathParameter(objectID),
+	}, &response)
+}
+
+// GetComputerEntityAdminRightsParams holds the query and header parameters of GetComputerEntityAdminRights. Nil and
+// empty fields are omitted.
+type GetComputerEntityAdminRightsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetComputerEntityAdminRights sends GET /api/v2/computers/{object_id}/admin-rights. Get computer entity admin rights.
+//
+// Get a list, graph, or count of the systems this computer has admin rights to.
+func (s *Client) GetComputerEntityAdminRights(ctx context.Context, objectID string, params *GetComputerEntityAdminRightsParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/computers/" + pathParameter(objectID) + "/admin-rights",
+	}, &response)
+}
+
+// GetComputerEntityAdminRightsAll returns an iterator over every item returned by GetComputerEntityAdminRights,
+// requesting each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetComputerEntityAdminRightsAll(ctx context.Context, objectID string, params GetComputerEntityAdminRightsParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetComputerEntityAdminRights(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetComputerEntityAdminsParams holds the query and header parameters of GetComputerEntityAdmins. Nil and empty fields
+// are omitted.
+type GetComputerEntityAdminsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetComputerEntityAdmins sends GET /api/v2/computers/{object_id}/admin-users. Get computer entity admins.
+//
+// Get a list, graph, or count of the principals that have admin rights on this computer.
+func (s *Client) GetComputerEntityAdmins(ctx context.Context, objectID string, params *GetComputerEntityAdminsParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/computers/" + pathParameter(objectID) + "/admin-users",
+	}, &response)
+}
+
+// GetComputerEntityAdminsAll returns an iterator over every item returned by GetComputerEntityAdmins, requesting each
+// page in turn until a page shorter than the limit is returned.
+func (s *Client) GetComputerEntityAdminsAll(ctx context.Context, objectID string, params GetComputerEntityAdminsParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetComputerEntityAdmins(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetComputerEntityConstrainedDelegationRightsParams holds the query and header parameters of
+// GetComputerEntityConstrainedDelegationRights. Nil and empty fields are omitted.
+type GetComputerEntityConstrainedDelegationRightsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetComputerEntityConstrainedDelegationRights sends GET /api/v2/computers/{object_id}/constrained-delegation-rights.
+// Get computer entity constrained delegation rights.
+//
+// Get a list, graph, or count of the principals that this computer has constrained delegations rights to.
+func (s *Client) GetComputerEntityConstrainedDelegationRights(ctx context.Context, objectID string, params *GetComputerEntityConstrainedDelegationRightsParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/computers/" + pathParameter(objectID) + "/constrained-delegation-rights",
+	}, &response)
+}
+
+// GetComputerEntityConstrainedDelegationRightsAll returns an iterator over every item returned by
+// GetComputerEntityConstrainedDelegationRights, requesting each page in turn until a page shorter than the limit is
+// returned.
+func (s *Client) GetComputerEntityConstrainedDelegationRightsAll(ctx context.Context, objectID string, params GetComputerEntityConstrainedDelegationRightsParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetComputerEntityConstrainedDelegationRights(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetComputerEntityConstrainedUsersParams holds the query and header parameters of GetComputerEntityConstrainedUsers.
+// Nil and empty fields are omitted.
+type GetComputerEntityConstrainedUsersParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetComputerEntityConstrainedUsers sends GET /api/v2/computers/{object_id}/constrained-users. Get computer entity
+// constrained users.
+//
+// Get a list, graph, or count of the principals that have constrained delegation rights to this computer.
+func (s *Client) GetComputerEntityConstrainedUsers(ctx context.Context, objectID string, params *GetComputerEntityConstrainedUsersParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/computers/" + pathParameter(objectID) + "/constrained-users",
+	}, &response)
+}
+
+// GetComputerEntityConstrainedUsersAll returns an iterator over every item returned by
+// GetComputerEntityConstrainedUsers, requesting each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetComputerEntityConstrainedUsersAll(ctx context.Context, objectID string, params GetComputerEntityConstrainedUsersParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetComputerEntityConstrainedUsers(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetComputerEntityControllablesParams holds the query and header parameters of GetComputerEntityControllables. Nil
+// and empty fields are omitted.
+type GetComputerEntityControllablesParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetComputerEntityControllables sends GET /api/v2/computers/{object_id}/controllables. Get computer entity
+// controllables.
+//
+// Get a list, graph, or count of the principals this computer can control.
+func (s *Client) GetComputerEntityControllables(ctx context.Context, objectID string, params *GetComputerEntityControllablesParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/computers/" + pathParameter(objectID) + "/controllables",
+	}, &response)
+}
+
+// GetComputerEntityControllablesAll returns an iterator over every item returned by GetComputerEntityControllables,
+// requesting each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetComputerEntityControllablesAll(ctx context.Context, objectID string, params GetComputerEntityControllablesParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetComputerEntityControllables(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetComputerEntityControllersParams holds the query and header parameters of GetComputerEntityControllers. Nil and
+// empty fields are omitted.
+type GetComputerEntityControllersParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetComputerEntityControllers sends GET /api/v2/computers/{object_id}/controllers. Get computer entity controllers.
+//
+// Get a list, graph, or count of the principals that can control this computer.
+func (s *Client) GetComputerEntityControllers(ctx context.Context, objectID string, params *GetComputerEntityControllersParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/computers/" + pathParameter(objectID) + "/controllers",
+	}, &response)
+}
+
+// GetComputerEntityControllersAll returns an iterator over every item returned by GetComputerEntityControllers,
+// requesting each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetComputerEntityControllersAll(ctx context.Context, objectID string, params GetComputerEntityControllersParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetComputerEntityControllers(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetComputerEntityDcomRightsParams holds the query and header parameters of GetComputerEntityDcomRights. Nil and
+// empty fields are omitted.
+type GetComputerEntityDcomRightsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetComputerEntityDcomRights sends GET /api/v2/computers/{object_id}/dcom-rights. Get computer entity DCOM rights.
+//
+// Get a list, graph, or count of the systems this computer can execute DCOM on.
+func (s *Client) GetComputerEntityDcomRights(ctx context.Context, objectID string, params *GetComputerEntityDcomRightsParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/computers/" + pathParameter(objectID) + "/dcom-rights",
+	}, &response)
+}
+
+// GetComputerEntityDcomRightsAll returns an iterator over every item returned by GetComputerEntityDcomRights,
+// requesting each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetComputerEntityDcomRightsAll(ctx context.Context, objectID string, params GetComputerEntityDcomRightsParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetComputerEntityDcomRights(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetComputerEntityDcomUsersParams holds the query and header parameters of GetComputerEntityDcomUsers. Nil and empty
+// fields are omitted.
+type GetComputerEntityDcomUsersParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetComputerEntityDcomUsers sends GET /api/v2/computers/{object_id}/dcom-users. Get computer entity DCOM users.
+//
+// Get a list, graph, or count of the principals that can execute DCOM on this computer.
+func (s *Client) GetComputerEntityDcomUsers(ctx context.Context, objectID string, params *GetComputerEntityDcomUsersParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/computers/" + pathParameter(objectID) + "/dcom-users",
+	}, &response)
+}
+
+// GetComputerEntityDcomUsersAll returns an iterator over every item returned by GetComputerEntityDcomUsers, requesting
+// each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetComputerEntityDcomUsersAll(ctx context.Context, objectID string, params GetComputerEntityDcomUsersParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetComputerEntityDcomUsers(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetComputerEntityGroupMembershipParams holds the query and header parameters of GetComputerEntityGroupMembership.
+// Nil and empty fields are omitted.
+type GetComputerEntityGroupMembershipParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetComputerEntityGroupMembership sends GET /api/v2/computers/{object_id}/group-membership. Get computer entity group
+// membership.
+//
+// Get a list, graph, or count of the groups this computer is a member of.
+func (s *Client) GetComputerEntityGroupMembership(ctx context.Context, objectID string, params *GetComputerEntityGroupMembershipParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/computers/" + pathParameter(objectID) + "/group-membership",
+	}, &response)
+}
+
+// GetComputerEntityGroupMembershipAll returns an iterator over every item returned by
+// GetComputerEntityGroupMembership, requesting each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetComputerEntityGroupMembershipAll(ctx context.Context, objectID string, params GetComputerEntityGroupMembershipParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetComputerEntityGroupMembership(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetComputerEntityPsRemoteRightsParams holds the query and header parameters of GetComputerEntityPsRemoteRights. Nil
+// and empty fields are omitted.
+type GetComputerEntityPsRemoteRightsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetComputerEntityPsRemoteRights sends GET /api/v2/computers/{object_id}/ps-remote-rights. Get computer entity remote
+// PowerShell rights.
+//
+// Get a list, graph, or count of the systems this computer has remote PowerShell rights on.
+func (s *Client) GetComputerEntityPsRemoteRights(ctx context.Context, objectID string, params *GetComputerEntityPsRemoteRightsParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/computers/" + pathParameter(objectID) + "/ps-remote-rights",
+	}, &response)
+}
+
+// GetComputerEntityPsRemoteRightsAll returns an iterator over every item returned by GetComputerEntityPsRemoteRights,
+// requesting each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetComputerEntityPsRemoteRightsAll(ctx context.Context, objectID string, params GetComputerEntityPsRemoteRightsParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetComputerEntityPsRemoteRights(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetComputerEntityPsRemoteUsersParams holds the query and header parameters of GetComputerEntityPsRemoteUsers. Nil
+// and empty fields are omitted.
+type GetComputerEntityPsRemoteUsersParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetComputerEntityPsRemoteUsers sends GET /api/v2/computers/{object_id}/ps-remote-users. Get computer entity remote
+// PowerShell users.
+//
+// Get a list, graph, or count of the principals that have remote PowerShell rights on this computer.
+func (s *Client) GetComputerEntityPsRemoteUsers(ctx context.Context, objectID string, params *GetComputerEntityPsRemoteUsersParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/computers/" + pathParameter(objectID) + "/ps-remote-users",
+	}, &response)
+}
+
+// GetComputerEntityPsRemoteUsersAll returns an iterator over every item returned by GetComputerEntityPsRemoteUsers,
+// requesting each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetComputerEntityPsRemoteUsersAll(ctx context.Context, objectID string, params GetComputerEntityPsRemoteUsersParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetComputerEntityPsRemoteUsers(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetComputerEntityRdpRightsParams holds the query and header parameters of GetComputerEntityRdpRights. Nil and empty
+// fields are omitted.
+type GetComputerEntityRdpRightsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetComputerEntityRdpRights sends GET /api/v2/computers/{object_id}/rdp-rights. Get computer entity RDP rights.
+//
+// Get a list, graph, or count of the systems this computer can RDP to.
+func (s *Client) GetComputerEntityRdpRights(ctx context.Context, objectID string, params *GetComputerEntityRdpRightsParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/computers/" + pathParameter(objectID) + "/rdp-rights",
+	}, &response)
+}
+
+// GetComputerEntityRdpRightsAll returns an iterator over every item returned by GetComputerEntityRdpRights, requesting
+// each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetComputerEntityRdpRightsAll(ctx context.Context, objectID string, params GetComputerEntityRdpRightsParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetComputerEntityRdpRights(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetComputerEntityRdpUsersParams holds the query and header parameters of GetComputerEntityRdpUsers. Nil and empty
+// fields are omitted.
+type GetComputerEntityRdpUsersParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetComputerEntityRdpUsers sends GET /api/v2/computers/{object_id}/rdp-users. Get computer entity RDP users.
+//
+// Get a list, graph, or count of the principals that have RDP rights on this computer.
+func (s *Client) GetComputerEntityRdpUsers(ctx context.Context, objectID string, params *GetComputerEntityRdpUsersParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/computers/" + pathParameter(objectID) + "/rdp-users",
+	}, &response)
+}
+
+// GetComputerEntityRdpUsersAll returns an iterator over every item returned by GetComputerEntityRdpUsers, requesting
+// each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetComputerEntityRdpUsersAll(ctx context.Context, objectID string, params GetComputerEntityRdpUsersParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetComputerEntityRdpUsers(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetComputerEntitySessionsParams holds the query and header parameters of GetComputerEntitySessions. Nil and empty
+// fields are omitted.
+type GetComputerEntitySessionsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetComputerEntitySessions sends GET /api/v2/computers/{object_id}/sessions. Get computer entity sessions.
+//
+// Get a list, graph, or count of the principals with active sessions on this computer.
+func (s *Client) GetComputerEntitySessions(ctx context.Context, objectID string, params *GetComputerEntitySessionsParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/computers/" + pathParameter(objectID) + "/sessions",
+	}, &response)
+}
+
+// GetComputerEntitySessionsAll returns an iterator over every item returned by GetComputerEntitySessions, requesting
+// each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetComputerEntitySessionsAll(ctx context.Context, objectID string, params GetComputerEntitySessionsParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetComputerEntitySessions(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetComputerEntitySqlAdminsParams holds the query and header parameters of GetComputerEntitySqlAdmins. Nil and empty
+// fields are omitted.
+type GetComputerEntitySqlAdminsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetComputerEntitySqlAdmins sends GET /api/v2/computers/{object_id}/sql-admins. Get computer entity SQL admins.
+//
+// Get a list, graph, or count of the principals that have SQL admin rights on this computer.
+func (s *Client) GetComputerEntitySqlAdmins(ctx context.Context, objectID string, params *GetComputerEntitySqlAdminsParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/computers/" + pathParameter(objectID) + "/sql-admins",
+	}, &response)
+}
+
+// GetComputerEntitySqlAdminsAll returns an iterator over every item returned by GetComputerEntitySqlAdmins, requesting
+// each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetComputerEntitySqlAdminsAll(ctx context.Context, objectID string, params GetComputerEntitySqlAdminsParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetComputerEntitySqlAdmins(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// ListAppConfigParamsParams holds the query and header parameters of ListAppConfigParams. Nil and empty fields are
+// omitted.
+type ListAppConfigParamsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Parameter filter. Must follow the convention: parameter=eq:value
+	Parameter *string `query:"parameter"`
+}
+
+// ListAppConfigParams sends GET /api/v2/config. List application config parameters.
+//
+// Lists application configuration parameters for this instance
+func (s *Client) ListAppConfigParams(ctx context.Context, params *ListAppConfigParamsParams) (ListAppConfigParamsResponse, error) {
+	var response ListAppConfigParamsResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/config",
+	}, &response)
+}
+
+// SetAppConfigParamParams holds the query and header parameters of SetAppConfigParam. Nil and empty fields are
+// omitted.
+type SetAppConfigParamParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// SetAppConfigParam sends PUT /api/v2/config. Write application configuration parameters.
+//
+// Writes application configuration parameters for this instance
+func (s *Client) SetAppConfigParam(ctx context.Context, body AppConfigParam, params *SetAppConfigParamParams) (SetAppConfigParamResponse, error) {
+	var response SetAppConfigParamResponse
+	return response, s.do(ctx, request{
+		body:       body,
+		method:     http.MethodPut,
+		parameters: params,
+		path:       "/api/v2/config",
+	}, &response)
+}
+
+// GetContainerEntityParams holds the query and header parameters of GetContainerEntity. Nil and empty fields are
+// omitted.
+type GetContainerEntityParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Include counts of related entities. Default value is `true`.
+	Counts *bool `query:"counts"`
+}
+
+// GetContainerEntity sends GET /api/v2/containers/{object_id}. Get container entity info.
+//
+// Get basic info and counts for this container node.
+func (s *Client) GetContainerEntity(ctx context.Context, objectID string, params *GetContainerEntityParams) (EntityInfoQueryResults, error) {
+	var response EntityInfoQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/containers/" + pathParameter(objectID),
+	}, &response)
+}
+
+// GetContainerEntityControllersParams holds the query and header parameters of GetContainerEntityControllers. Nil and
+// empty fields are omitted.
+type GetContainerEntityControllersParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetContainerEntityControllers sends GET /api/v2/containers/{object_id}/controllers. Get container entity
+// controllers.
+//
+// Get a list, graph, or count of the principals that can control this container.
+func (s *Client) GetContainerEntityControllers(ctx context.Context, objectID string, params *GetContainerEntityControllersParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/containers/" + pathParameter(objectID) + "/controllers",
+	}, &response)
+}
+
+// GetContainerEntityControllersAll returns an iterator over every item returned by GetContainerEntityControllers,
+// requesting each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetContainerEntityControllersAll(ctx context.Context, objectID string, params GetContainerEntityControllersParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetContainerEntityControllers(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetDatapipeStatusParams holds the query and header parameters of GetDatapipeStatus. Nil and empty fields are
+// omitted.
+type GetDatapipeStatusParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// GetDatapipeStatus sends GET /api/v2/datapipe/status. Get datapipe status.
+//
+// Gets the current status of the datapipe
+func (s *Client) GetDatapipeStatus(ctx context.Context, params *GetDatapipeStatusParams) (GetDatapipeStatusResponse, error) {
+	var response GetDatapipeStatusResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/datapipe/status",
+	}, &response)
+}
+
+// ExportAttackPathFindingsParams holds the query and header parameters of ExportAttackPathFindings. Nil and empty
+// fields are omitted.
+type ExportAttackPathFindingsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Finding Type
+	Finding string `query:"finding"`
+	// Risk acceptance filter
+	FilterAccepted *RiskAcceptance `query:"filterAccepted"`
+	// Sort by column. The only sortable column is `finding`.
+	SortBy []string `query:"sort_by"`
+}
+
+// ExportAttackPathFindings sends GET /api/v2/domains/{domain_id}/attack-path-findings. Export attack path findings.
+//
+// Export the finding table for a given attack path
+func (s *Client) ExportAttackPathFindings(ctx context.Context, domainID string, params *ExportAttackPathFindingsParams) (io.ReadCloser, error) {
+	return s.stream(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/domains/" + pathParameter(domainID) + "/attack-path-findings",
+	})
+}
+
+// ListAvailableAttackPathTypesForDomainParams holds the query and header parameters of
+// ListAvailableAttackPathTypesForDomain. Nil and empty fields are omitted.
+type ListAvailableAttackPathTypesForDomainParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Sort by column. The only sortable column is `finding`.
+	SortBy  []string `query:"sort_by"`
+	Finding string   `query:"finding"`
+}
+
+// ListAvailableAttackPathTypesForDomain sends GET /api/v2/domains/{domain_id}/available-types. List available attack
+// paths.
+//
+// Lists available attack path types for a domain
+func (s *Client) ListAvailableAttackPathTypesForDomain(ctx context.Context, domainID string, params *ListAvailableAttackPathTypesForDomainParams) (ListAvailableAttackPathTypesForDomainResponse, error) {
+	var response ListAvailableAttackPathTypesForDomainResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/domains/" + pathParameter(domainID) + "/available-types",
+	}, &response)
+}
+
+// ListDomainAttackPathsDetailsParams holds the query and header parameters of ListDomainAttackPathsDetails. Nil and
+// empty fields are omitted.
+type ListDomainAttackPathsDetailsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Media type to determine the response format (application/json or text/csv).
+	Accept  string `query:"Accept"`
+	Finding string `query:"finding"`
+	// Sortable columns are `domain_sid`, `index`, `AcceptedUntil`, `id`, `created_at`, `updated_at`, `deleted_at`,
+	// `exposure_percentage`, `impact_percentage`. Relationship risks can be sorted on `FromPrincipal` and `ToPrincipal` in
+	// addition to the sortable columns for List Risks.
+	SortBy         []string `query:"sort_by"`
+	FromPrincipal  string   `query:"FromPrincipal"`
+	ToPrincipal    string   `query:"ToPrincipal"`
+	PrincipalsHash string   `query:"principals_hash"`
+	Accepted       string   `query:"Accepted"`
+	AcceptedUntil  string   `query:"AcceptedUntil"`
+	Principal      string   `query:"Principal"`
+	DomainSID      string   `query:"domain_sid"`
+	ID             string   `query:"id"`
+	// Filter results by `created_at` value. See filter schema details for valid predicates.
+	CreatedAt string `query:"created_at"`
+	// Filter results by `updated_at` value. See filter schema details for valid predicates.
+	UpdatedAt string `query:"updated_at"`
+	// Filter results by `deleted_at` value. See filter schema details for valid predicates.
+	DeletedAt string `query:"deleted_at"`
+	// This query parameter is used for determining the number of objects to skip in pagination.
+	Skip *int `query:"skip"`
+	// This query parameter is used for setting an upper limit of objects returned in paginated responses.
+	Limit *int `query:"limit"`
+}
+
+// ListDomainAttackPathsDetails sends GET /api/v2/domains/{domain_id}/details. List domain attack paths details.
+//
+// Lists detailed data about attack paths for a domain.
+//
+// __Note:__ `ImpactCount`, `ImpactPercentage`, `ExposureCount`, `ExposurePercentage` and `Severity` will have a value
+// other than zero when butterfly analysis is enabled.
+func (s *Client) ListDomainAttackPathsDetails(ctx context.Context, domainID string, params *ListDomainAttackPathsDetailsParams) (json.RawMessage, error) {
+	var response json.RawMessage
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/domains/" + pathParameter(domainID) + "/details",
+	}, &response)
+}
+
+// ListAttackPathSparklineValuesParams holds the query and header parameters of ListAttackPathSparklineValues. Nil and
+// empty fields are omitted.
+type ListAttackPathSparklineValuesParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Sortable columns are `CompositeRisk`, `FindingCount`, `ImpactedAssetCount`, `domain_sid`, `id`, `created_at`,
+	// `updated_at`, `deleted_at`.
+	SortBy  []string `query:"sort_by"`
+	Finding string   `query:"finding"`
+	// Beginning datetime of range (inclusive) in RFC-3339 format; Defaults to current datetime minus 30 days
+	From string `query:"from"`
+	// Ending datetime of range (exclusive) in RFC-3339 format; Defaults to current datetime
+	To string `query:"to"`
+}
+
+// ListAttackPathSparklineValues sends GET /api/v2/domains/{domain_id}/sparkline. List attack path sparkline values.
+//
+// List the values that represent the sparklines for individual attack paths
+func (s *Client) ListAttackPathSparklineValues(ctx context.Context, domainID string, params *ListAttackPathSparklineValuesParams) (ListAttackPathSparklineValuesResponse, error) {
+	var response ListAttackPathSparklineValuesResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/domains/" + pathParameter(domainID) + "/sparkline",
+	}, &response)
+}
+
+// GetDomainEntityParams holds the query and header parameters of GetDomainEntity. Nil and empty fields are omitted.
+type GetDomainEntityParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Include counts of related entities. Default value is `true`.
+	Counts *bool `query:"counts"`
+}
+
+// GetDomainEntity sends GET /api/v2/domains/{object_id}. Get domain entity info.
+//
+// Get basic info and counts for this domain node.
+func (s *Client) GetDomainEntity(ctx context.Context, objectID string, params *GetDomainEntityParams) (EntityInfoQueryResults, error) {
+	var response EntityInfoQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/domains/" + pathParameter(objectID),
+	}, &response)
+}
+
+// UpdateDomainEntityParams holds the query and header parameters of UpdateDomainEntity. Nil and empty fields are
+// omitted.
+type UpdateDomainEntityParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// UpdateDomainEntity sends PATCH /api/v2/domains/{object_id}. Update the Domain entity.
+//
+// Updates the supported properties on the Domain entity.
+func (s *Client) UpdateDomainEntity(ctx context.Context, objectID string, body UpdateDomainEntityRequest, params *UpdateDomainEntityParams) (UpdateDomainEntityResponse, error) {
+	var response UpdateDomainEntityResponse
+	return response, s.do(ctx, request{
+		body:       body,
+		method:     http.MethodPatch,
+		parameters: params,
+		path:       "/api/v2/domains/" + pathParameter(objectID),
+	}, &response)
+}
+
+// GetDomainEntityComputersParams holds the query and header parameters of GetDomainEntityComputers. Nil and empty
+// fields are omitted.
+type GetDomainEntityComputersParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetDomainEntityComputers sends GET /api/v2/domains/{object_id}/computers. Get domain entity computers.
+//
+// Get a list or count of the computers that belong to this domain.
+func (s *Client) GetDomainEntityComputers(ctx context.Context, objectID string, params *GetDomainEntityComputersParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/domains/" + pathParameter(objectID) + "/computers",
+	}, &response)
+}
+
+// GetDomainEntityComputersAll returns an iterator over every item returned by GetDomainEntityComputers, requesting
+// each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetDomainEntityComputersAll(ctx context.Context, objectID string, params GetDomainEntityComputersParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetDomainEntityComputers(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetDomainEntityControllersParams holds the query and header parameters of GetDomainEntityControllers. Nil and empty
+// fields are omitted.
+type GetDomainEntityControllersParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetDomainEntityControllers sends GET /api/v2/domains/{object_id}/controllers. Get domain entity controllers.
+//
+// Get a list, graph, or count of the principals that can control this domain.
+func (s *Client) GetDomainEntityControllers(ctx context.Context, objectID string, params *GetDomainEntityControllersParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/domains/" + pathParameter(objectID) + "/controllers",
+	}, &response)
+}
+
+// GetDomainEntityControllersAll returns an iterator over every item returned by GetDomainEntityControllers, requesting
+// each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetDomainEntityControllersAll(ctx context.Context, objectID string, params GetDomainEntityControllersParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetDomainEntityControllers(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetDomainEntityDcSyncersParams holds the query and header parameters of GetDomainEntityDcSyncers. Nil and empty
+// fields are omitted.
+type GetDomainEntityDcSyncersParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetDomainEntityDcSyncers sends GET /api/v2/domains/{object_id}/dc-syncers. Get domain entity DC Syncers.
+//
+// Get a list, graph, or count of the principals that can DC sync this domain.
+func (s *Client) GetDomainEntityDcSyncers(ctx context.Context, objectID string, params *GetDomainEntityDcSyncersParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/domains/" + pathParameter(objectID) + "/dc-syncers",
+	}, &response)
+}
+
+// GetDomainEntityDcSyncersAll returns an iterator over every item returned by GetDomainEntityDcSyncers, requesting
+// each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetDomainEntityDcSyncersAll(ctx context.Context, objectID string, params GetDomainEntityDcSyncersParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetDomainEntityDcSyncers(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetDomainEntityForeignAdminsParams holds the query and header parameters of GetDomainEntityForeignAdmins. Nil and
+// empty fields are omitted.
+type GetDomainEntityForeignAdminsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetDomainEntityForeignAdmins sends GET /api/v2/domains/{object_id}/foreign-admins. Get domain entity foreign admins.
+//
+// Get a list, graph, or count of the principals outside of this domain that have admin rights on principals in this
+// domain.
+func (s *Client) GetDomainEntityForeignAdmins(ctx context.Context, objectID string, params *GetDomainEntityForeignAdminsParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/domains/" + pathParameter(objectID) + "/foreign-admins",
+	}, &response)
+}
+
+// GetDomainEntityForeignAdminsAll returns an iterator over every item returned by GetDomainEntityForeignAdmins,
+// requesting each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetDomainEntityForeignAdminsAll(ctx context.Context, objectID string, params GetDomainEntityForeignAdminsParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetDomainEntityForeignAdmins(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetDomainEntityForeignGpoControllersParams holds the query and header parameters of
+// GetDomainEntityForeignGpoControllers. Nil and empty fields are omitted.
+type GetDomainEntityForeignGpoControllersParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetDomainEntityForeignGpoControllers sends GET /api/v2/domains/{object_id}/foreign-gpo-controllers. Get domain
+// entity foreign GPO controllers.
+//
+// Get a list, graph, or count of the principals outside of this domain that can control GPOs inside this domain.
+func (s *Client) GetDomainEntityForeignGpoControllers(ctx context.Context, objectID string, params *GetDomainEntityForeignGpoControllersParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/domains/" + pathParameter(objectID) + "/foreign-gpo-controllers",
+	}, &response)
+}
+
+// GetDomainEntityForeignGpoControllersAll returns an iterator over every item returned by
+// GetDomainEntityForeignGpoControllers, requesting each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetDomainEntityForeignGpoControllersAll(ctx context.Context, objectID string, params GetDomainEntityForeignGpoControllersParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetDomainEntityForeignGpoControllers(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetDomainEntityForeignGroupsParams holds the query and header parameters of GetDomainEntityForeignGroups. Nil and
+// empty fields are omitted.
+type GetDomainEntityForeignGroupsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetDomainEntityForeignGroups sends GET /api/v2/domains/{object_id}/foreign-groups. Get domain entity foregin groups.
+//
+// Get a list, graph, or count of the groups outside of this domain that are members of groups inside this domain.
+func (s *Client) GetDomainEntityForeignGroups(ctx context.Context, objectID string, params *GetDomainEntityForeignGroupsParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/domains/" + pathParameter(objectID) + "/foreign-groups",
+	}, &response)
+}
+
+// GetDomainEntityForeignGroupsAll returns an iterator over every item returned by GetDomainEntityForeignGroups,
+// requesting each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetDomainEntityForeignGroupsAll(ctx context.Context, objectID string, params GetDomainEntityForeignGroupsParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetDomainEntityForeignGroups(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetDomainEntityForeignUsersParams holds the query and header parameters of GetDomainEntityForeignUsers. Nil and
+// empty fields are omitted.
+type GetDomainEntityForeignUsersParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetDomainEntityForeignUsers sends GET /api/v2/domains/{object_id}/foreign-users. Get domain entity foreign users.
+//
+// Get a list, graph, or count of the users outside of this domain that are members of groups inside this domain.
+func (s *Client) GetDomainEntityForeignUsers(ctx context.Context, objectID string, params *GetDomainEntityForeignUsersParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/domains/" + pathParameter(objectID) + "/foreign-users",
+	}, &response)
+}
+
+// GetDomainEntityForeignUsersAll returns an iterator over every item returned by GetDomainEntityForeignUsers,
+// requesting each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetDomainEntityForeignUsersAll(ctx context.Context, objectID string, params GetDomainEntityForeignUsersParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetDomainEntityForeignUsers(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetDomainEntityGposParams holds the query and header parameters of GetDomainEntityGpos. Nil and empty fields are
+// omitted.
+type GetDomainEntityGposParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetDomainEntityGpos sends GET /api/v2/domains/{object_id}/gpos. Get domain entity GPOs.
+//
+// Get a list or count of the GPOs in this domain.
+func (s *Client) GetDomainEntityGpos(ctx context.Context, objectID string, params *GetDomainEntityGposParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/domains/" + pathParameter(objectID) + "/gpos",
+	}, &response)
+}
+
+// GetDomainEntityGposAll returns an iterator over every item returned by GetDomainEntityGpos, requesting each page in
+// turn until a page shorter than the limit is returned.
+func (s *Client) GetDomainEntityGposAll(ctx context.Context, objectID string, params GetDomainEntityGposParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetDomainEntityGpos(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetDomainEntityGroupsParams holds the query and header parameters of GetDomainEntityGroups. Nil and empty fields are
+// omitted.
+type GetDomainEntityGroupsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetDomainEntityGroups sends GET /api/v2/domains/{object_id}/groups. Get domain entity groups.
+//
+// Get a list or count of the groups in this domain.
+func (s *Client) GetDomainEntityGroups(ctx context.Context, objectID string, params *GetDomainEntityGroupsParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/domains/" + pathParameter(objectID) + "/groups",
+	}, &response)
+}
+
+// GetDomainEntityGroupsAll returns an iterator over every item returned by GetDomainEntityGroups, requesting each page
+// in turn until a page shorter than the limit is returned.
+func (s *Client) GetDomainEntityGroupsAll(ctx context.Context, objectID string, params GetDomainEntityGroupsParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetDomainEntityGroups(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetDomainEntityInboundTrustsParams holds the query and header parameters of GetDomainEntityInboundTrusts. Nil and
+// empty fields are omitted.
+type GetDomainEntityInboundTrustsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetDomainEntityInboundTrusts sends GET /api/v2/domains/{object_id}/inbound-trusts. Get domain entity inbound trusts.
+//
+// Get a list, graph, or count of the inbound trusts for this domain.
+func (s *Client) GetDomainEntityInboundTrusts(ctx context.Context, objectID string, params *GetDomainEntityInboundTrustsParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/domains/" + pathParameter(objectID) + "/inbound-trusts",
+	}, &response)
+}
+
+// GetDomainEntityInboundTrustsAll returns an iterator over every item returned by GetDomainEntityInboundTrusts,
+// requesting each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetDomainEntityInboundTrustsAll(ctx context.Context, objectID string, params GetDomainEntityInboundTrustsParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetDomainEntityInboundTrusts(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetDomainEntityLinkedGposParams holds the query and header parameters of GetDomainEntityLinkedGpos. Nil and empty
+// fields are omitted.
+type GetDomainEntityLinkedGposParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetDomainEntityLinkedGpos sends GET /api/v2/domains/{object_id}/linked-gpos. Get domain entity linked GPOs.
+//
+// Get a list, graph, or count of the GPOs linked to this domain.
+func (s *Client) GetDomainEntityLinkedGpos(ctx context.Context, objectID string, params *GetDomainEntityLinkedGposParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/domains/" + pathParameter(objectID) + "/linked-gpos",
+	}, &response)
+}
+
+// GetDomainEntityLinkedGposAll returns an iterator over every item returned by GetDomainEntityLinkedGpos, requesting
+// each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetDomainEntityLinkedGposAll(ctx context.Context, objectID string, params GetDomainEntityLinkedGposParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetDomainEntityLinkedGpos(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetDomainEntityOusParams holds the query and header parameters of GetDomainEntityOus. Nil and empty fields are
+// omitted.
+type GetDomainEntityOusParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetDomainEntityOus sends GET /api/v2/domains/{object_id}/ous. Get domain entity OUs.
+//
+// Get a list or count of the OUs in this domain.
+func (s *Client) GetDomainEntityOus(ctx context.Context, objectID string, params *GetDomainEntityOusParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/domains/" + pathParameter(objectID) + "/ous",
+	}, &response)
+}
+
+// GetDomainEntityOusAll returns an iterator over every item returned by GetDomainEntityOus, requesting each page in
+// turn until a page shorter than the limit is returned.
+func (s *Client) GetDomainEntityOusAll(ctx context.Context, objectID string, params GetDomainEntityOusParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetDomainEntityOus(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetDomainEntityOutboundTrustsParams holds the query and header parameters of GetDomainEntityOutboundTrusts. Nil and
+// empty fields are omitted.
+type GetDomainEntityOutboundTrustsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetDomainEntityOutboundTrusts sends GET /api/v2/domains/{object_id}/outbound-trusts. Get domain entity outbound
+// trusts.
+//
+// Get a list, graph, or count of the outbound trusts for this domain.
+func (s *Client) GetDomainEntityOutboundTrusts(ctx context.Context, objectID string, params *GetDomainEntityOutboundTrustsParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/domains/" + pathParameter(objectID) + "/outbound-trusts",
+	}, &response)
+}
+
+// GetDomainEntityOutboundTrustsAll returns an iterator over every item returned by GetDomainEntityOutboundTrusts,
+// requesting each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetDomainEntityOutboundTrustsAll(ctx context.Context, objectID string, params GetDomainEntityOutboundTrustsParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetDomainEntityOutboundTrusts(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetDomainEntityUsersParams holds the query and header parameters of GetDomainEntityUsers. Nil and empty fields are
+// omitted.
+type GetDomainEntityUsersParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetDomainEntityUsers sends GET /api/v2/domains/{object_id}/users. Get domain entity users.
+//
+// Get a list or count of the users in this domain.
+func (s *Client) GetDomainEntityUsers(ctx context.Context, objectID string, params *GetDomainEntityUsersParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/domains/" + pathParameter(objectID) + "/users",
+	}, &response)
+}
+
+// GetDomainEntityUsersAll returns an iterator over every item returned by GetDomainEntityUsers, requesting each page
+// in turn until a page shorter than the limit is returned.
+func (s *Client) GetDomainEntityUsersAll(ctx context.Context, objectID string, params GetDomainEntityUsersParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetDomainEntityUsers(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetEnterpriseCaEntityParams holds the query and header parameters of GetEnterpriseCaEntity. Nil and empty fields are
+// omitted.
+type GetEnterpriseCaEntityParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Include counts of related entities. Default value is `true`.
+	Counts *bool `query:"counts"`
+}
+
+// GetEnterpriseCaEntity sends GET /api/v2/enterprisecas/{object_id}. Get Enterprise CA entity info.
+//
+// Get info and counts for this Enterprise CA node.
+func (s *Client) GetEnterpriseCaEntity(ctx context.Context, objectID string, params *GetEnterpriseCaEntityParams) (EntityInfoQueryResults, error) {
+	var response EntityInfoQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/enterprisecas/" + pathParameter(objectID),
+	}, &response)
+}
+
+// GetEnterpriseCaEntityControllersParams holds the query and header parameters of GetEnterpriseCaEntityControllers.
+// Nil and empty fields are omitted.
+type GetEnterpriseCaEntityControllersParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetEnterpriseCaEntityControllers sends GET /api/v2/enterprisecas/{object_id}/controllers. Get Enterprise CA entity
+// controllers.
+//
+// Get a list, graph, or count of the principals that can control this Enterprise CA.
+func (s *Client) GetEnterpriseCaEntityControllers(ctx context.Context, objectID string, params *GetEnterpriseCaEntityControllersParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/enterprisecas/" + pathParameter(objectID) + "/controllers",
+	}, &response)
+}
+
+// GetEnterpriseCaEntityControllersAll returns an iterator over every item returned by
+// GetEnterpriseCaEntityControllers, requesting each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetEnterpriseCaEntityControllersAll(ctx context.Context, objectID string, params GetEnterpriseCaEntityControllersParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetEnterpriseCaEntityControllers(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// ListClientSchedulesParams holds the query and header parameters of ListClientSchedules. Nil and empty fields are
+// omitted.
+type ListClientSchedulesParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The ID of the last event received by the client. Only used by event streams.
+	LastEventID *int64 `header:"Last-Event-ID"`
+	// Sortable columns are `next_scheduled_at`, `id`, `created_at`, `updated_at`, `deleted_at`.
+	SortBy                 []string `query:"sort_by"`
+	ID                     string   `query:"id"`
+	Rrule                  string   `query:"rrule"`
+	NextScheduledAt        string   `query:"next_scheduled_at"`
+	ClientID               string   `query:"client_id"`
+	SessionCollection      string   `query:"session_collection"`
+	LocalGroupCollection   string   `query:"local_group_collection"`
+	ADStructureCollection  string   `query:"ad_structure_collection"`
+	CertServicesCollection string   `query:"cert_services_collection"`
+	CARegistryCollection   string   `query:"ca_registry_collection"`
+	DcRegistryCollection   string   `query:"dc_registry_collection"`
+	// When a value of `true` is passed, any Domains associated with scheduled and finished jobs for each client will have
+	// expanded properties including `name` and `type`. When a value of `false` is passed, these same Domains will only
+	// return as a list of `objectid`s.
+	HydrateDomains *bool `query:"hydrate_domains"`
+	// When a value of `true` is passed, any OUs associated with scheduled and finished jobs for each client will have
+	// expanded properties including `name` and `type`. When a value of `false` is passed, these same OUs will only return
+	// as a list of `objectid`s.
+	HydrateOus *bool `query:"hydrate_ous"`
+	// Filter results by `created_at` value. See filter schema details for valid predicates.
+	CreatedAt string `query:"created_at"`
+	// Filter results by `updated_at` value. See filter schema details for valid predicates.
+	UpdatedAt string `query:"updated_at"`
+	// Filter results by `deleted_at` value. See filter schema details for valid predicates.
+	DeletedAt string `query:"deleted_at"`
+	// This query parameter is used for determining the number of objects to skip in pagination.
+	Skip *int `query:"skip"`
+	// This query parameter is used for setting an upper limit of objects returned in paginated responses.
+	Limit *int `query:"limit"`
+}
+
+// ListClientSchedules sends GET /api/v2/events. List events.
+//
+// Gets all client scheduled events. Requests that accept `text/event-stream` instead stream fine-grained datapipe
+// progress as server-sent events. Each event is sent with its ID, its type as the event name and the JSON encoded
+// event as its data. Event types are `ingest.task.started`, `ingest.file.started`, `ingest.progress`,
+// `ingest.task.completed`, `ingest.task.failed`, `ingest.task.canceled`, `analysis.started`, `analysis.progress`,
+// `analysis.completed`, `analysis.failed` and `analysis.canceled`. Only events describing the graph of the workspace
+// selected by the `Workspace` header, or the default graph when none is selected, are streamed. Clients that reconnect
+// with a `Last-Event-ID` header are first sent the retained events published after that event.
+func (s *Client) ListClientSchedules(ctx context.Context, params *ListClientSchedulesParams) (ListClientSchedulesResponse, error) {
+	var response ListClientSchedulesResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/events",
+	}, &response)
+}
+
+// ListClientSchedulesAll returns an iterator over every item returned by ListClientSchedules, requesting each page in
+// turn until a page shorter than the limit is returned.
+func (s *Client) ListClientSchedulesAll(ctx context.Context, params ListClientSchedulesParams) iter.Seq2[ClientScheduleDisplay, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]ClientScheduleDisplay, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.ListClientSchedules(ctx, &params)
+		return response.Data, err
+	})
+}
+
+// CreateClientScheduleParams holds the query and header parameters of CreateClientSchedule. Nil and empty fields are
+// omitted.
+type CreateClientScheduleParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// CreateClientSchedule sends POST /api/v2/events. Create Event.
+//
+// Creates a scheduled event for data collection
+func (s *Client) CreateClientSchedule(ctx context.Context, body ClientSchedule, params *CreateClientScheduleParams) (CreateClientScheduleResponse, error) {
+	var response CreateClientScheduleResponse
+	return response, s.do(ctx, request{
+		body:       body,
+		method:     http.MethodPost,
+		parameters: params,
+		path:       "/api/v2/events",
+	}, &response)
+}
+
+// GetClientScheduleParams holds the query and header parameters of GetClientSchedule. Nil and empty fields are
+// omitted.
+type GetClientScheduleParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// GetClientSchedule sends GET /api/v2/events/{event_id}. Get Event.
+//
+// Gets a scheduled job event by ID.
+func (s *Client) GetClientSchedule(ctx context.Context, eventID int32, params *GetClientScheduleParams) (GetClientScheduleResponse, error) {
+	var response GetClientScheduleResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/events/" + pathParameter(eventID),
+	}, &response)
+}
+
+// UpdateClientEventParams holds the query and header parameters of UpdateClientEvent. Nil and empty fields are
+// omitted.
+type UpdateClientEventParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// UpdateClientEvent sends PUT /api/v2/events/{event_id}. Update Event.
+//
+// Updates a scheduled event
+func (s *Client) UpdateClientEvent(ctx context.Context, eventID int32, body ClientSchedule, params *UpdateClientEventParams) (UpdateClientEventResponse, error) {
+	var response UpdateClientEventResponse
+	return response, s.do(ctx, request{
+		body:       body,
+		method:     http.MethodPut,
+		parameters: params,
+		path:       "/api/v2/events/" + pathParameter(eventID),
+	}, &response)
+}
+
+// DeleteClientEventParams holds the query and header parameters of DeleteClientEvent. Nil and empty fields are
+// omitted.
+type DeleteClientEventParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// DeleteClientEvent sends DELETE /api/v2/events/{event_id}. Delete Event.
+//
+// Deletes a scheduled event and associated tasks by id
+func (s *Client) DeleteClientEvent(ctx context.Context, eventID int32, params *DeleteClientEventParams) error {
+	return s.do(ctx, request{
+		method:     http.MethodDelete,
+		parameters: params,
+		path:       "/api/v2/events/" + pathParameter(eventID),
+	}, nil)
+}
+
+// ListFeatureFlagsParams holds the query and header parameters of ListFeatureFlags. Nil and empty fields are omitted.
+type ListFeatureFlagsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// ListFeatureFlags sends GET /api/v2/features. List feature flags.
+//
+// Lists all feature flags for this instance
+func (s *Client) ListFeatureFlags(ctx context.Context, params *ListFeatureFlagsParams) (ListFeatureFlagsResponse, error) {
+	var response ListFeatureFlagsResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/features",
+	}, &response)
+}
+
+// ToggleFeatureFlagParams holds the query and header parameters of ToggleFeatureFlag. Nil and empty fields are
+// omitted.
+type ToggleFeatureFlagParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// ToggleFeatureFlag sends PUT /api/v2/features/{feature_id}/toggle. Toggle a feature flag's enabled status to either
+// enable or disable it.
+//
+// Writes application configuration parameters for this instance
+func (s *Client) ToggleFeatureFlag(ctx context.Context, featureID int32, params *ToggleFeatureFlagParams) (ToggleFeatureFlagResponse, error) {
+	var response ToggleFeatureFlagResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodPut,
+		parameters: params,
+		path:       "/api/v2/features/" + pathParameter(featureID) + "/toggle",
+	}, &response)
+}
+
+// ListFileUploadJobsParams holds the query and header parameters of ListFileUploadJobs. Nil and empty fields are
+// omitted.
+type ListFileUploadJobsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Sortable columns are `user_email_address`, `status`, `status_message`, `start_time`, `end_time`, `last_ingest`,
+	// `id`, `created_at`, `updated_at`, and `deleted_at`.
+	SortBy           []string `query:"sort_by"`
+	UserID           string   `query:"user_id"`
+	UserEmailAddress string   `query:"user_email_address"`
+	Status           string   `query:"status"`
+	StatusMessage    string   `query:"status_message"`
+	StartTime        string   `query:"start_time"`
+	EndTime          string   `query:"end_time"`
+	LastIngest       string   `query:"last_ingest"`
+	ID               string   `query:"id"`
+	// Filter results by `created_at` value. See filter schema details for valid predicates.
+	CreatedAt string `query:"created_at"`
+	// Filter results by `updated_at` value. See filter schema details for valid predicates.
+	UpdatedAt string `query:"updated_at"`
+	// Filter results by `deleted_at` value. See filter schema details for valid predicates.
+	DeletedAt string `query:"deleted_at"`
+	// This query parameter is used for determining the number of objects to skip in pagination.
+	Skip *int `query:"skip"`
+	// This query parameter is used for setting an upper limit of objects returned in paginated responses.
+	Limit *int `query:"limit"`
+}
+
+// ListFileUploadJobs sends GET /api/v2/file-upload. List File Upload Jobs.
+//
+// Lists available file upload jobs
+func (s *Client) ListFileUploadJobs(ctx context.Context, params *ListFileUploadJobsParams) (ListFileUploadJobsResponse, error) {
+	var response ListFileUploadJobsResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/file-upload",
+	}, &response)
+}
+
+// ListFileUploadJobsAll returns an iterator over every item returned by ListFileUploadJobs, requesting each page in
+// turn until a page shorter than the limit is returned.
+func (s *Client) ListFileUploadJobsAll(ctx context.Context, params ListFileUploadJobsParams) iter.Seq2[FileUploadJob, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]FileUploadJob, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.ListFileUploadJobs(ctx, &params)
+		return response.Data, err
+	})
+}
+
+// ListAcceptedFileUploadTypesParams holds the query and header parameters of ListAcceptedFileUploadTypes. Nil and
+// empty fields are omitted.
+type ListAcceptedFileUploadTypesParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// ListAcceptedFileUploadTypes sends GET /api/v2/file-upload/accepted-types. List accepted file upload types.
+//
+// List accepted file types for collection file uploads
+func (s *Client) ListAcceptedFileUploadTypes(ctx context.Context, params *ListAcceptedFileUploadTypesParams) (ListAcceptedFileUploadTypesResponse, error) {
+	var response ListAcceptedFileUploadTypesResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/file-upload/accepted-types",
+	}, &response)
+}
+
+// CreateFileUploadJobParams holds the query and header parameters of CreateFileUploadJob. Nil and empty fields are
+// omitted.
+type CreateFileUploadJobParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// CreateFileUploadJob sends POST /api/v2/file-upload/start. Create File Upload Job.
+//
+// Creates a file upload job for sending collection files
+func (s *Client) CreateFileUploadJob(ctx context.Context, params *CreateFileUploadJobParams) (CreateFileUploadJobResponse, error) {
+	var response CreateFileUploadJobResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodPost,
+		parameters: params,
+		path:       "/api/v2/file-upload/start",
+	}, &response)
+}
+
+// UploadFileToJobParams holds the query and header parameters of UploadFileToJob. Nil and empty fields are omitted.
+type UploadFileToJobParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Content type header, used to specify the type of content being sent by the client.
+	ContentType string `header:"Content-Type"`
+}
+
+// UploadFileToJob sends POST /api/v2/file-upload/{file_upload_job_id}. Upload File To Job.
+//
+// Saves a collection file to a file upload job
+func (s *Client) UploadFileToJob(ctx context.Context, fileUploadJobID int64, body map[string]any, params *UploadFileToJobParams) error {
+	return s.do(ctx, request{
+		body:       body,
+		method:     http.MethodPost,
+		parameters: params,
+		path:       "/api/v2/file-upload/" + pathParameter(fileUploadJobID),
+	}, nil)
+}
+
+// CancelFileUploadJobParams holds the query and header parameters of CancelFileUploadJob. Nil and empty fields are
+// omitted.
+type CancelFileUploadJobParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// CancelFileUploadJob sends DELETE /api/v2/file-upload/{file_upload_job_id}. Cancel File Upload Job.
+//
+// Cancels a file upload job that is still accepting or ingesting files. Files of the job that are being ingested are
+// abandoned once the datapipe observes the cancellation; data already written to the graph remains.
+func (s *Client) CancelFileUploadJob(ctx context.Context, fileUploadJobID int64, params *CancelFileUploadJobParams) error {
+	return s.do(ctx, request{
+		method:     http.MethodDelete,
+		parameters: params,
+		path:       "/api/v2/file-upload/" + pathParameter(fileUploadJobID),
+	}, nil)
+}
+
+// EndFileUploadJobParams holds the query and header parameters of EndFileUploadJob. Nil and empty fields are omitted.
+type EndFileUploadJobParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// EndFileUploadJob sends POST /api/v2/file-upload/{file_upload_job_id}/end. End File Upload Job.
+//
+// End a file upload job
+func (s *Client) EndFileUploadJob(ctx context.Context, fileUploadJobID int64, params *EndFileUploadJobParams) error {
+	return s.do(ctx, request{
+		method:     http.MethodPost,
+		parameters: params,
+		path:       "/api/v2/file-upload/" + pathParameter(fileUploadJobID) + "/end",
+	}, nil)
+}
+
+// ListFileUploadJobObjectsParams holds the query and header parameters of ListFileUploadJobObjects. Nil and empty
+// fields are omitted.
+type ListFileUploadJobObjectsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// This query parameter is used for determining the number of objects to skip in pagination.
+	Skip *int `query:"skip"`
+	// This query parameter is used for setting an upper limit of objects returned in paginated responses.
+	Limit *int `query:"limit"`
+}
+
+// ListFileUploadJobObjects sends GET /api/v2/file-upload/{file_upload_job_id}/objects. List File Upload Job Objects.
+//
+// Lists the nodes and relationships that a file upload job last wrote to the graph, along with the total number of
+// each. Objects the job wrote that a later job has written since are attributed to the later job. The skip and limit
+// apply to the nodes and to the relationships separately.
+func (s *Client) ListFileUploadJobObjects(ctx context.Context, fileUploadJobID int64, params *ListFileUploadJobObjectsParams) (ListFileUploadJobObjectsResponse, error) {
+	var response ListFileUploadJobObjectsResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/file-upload/" + pathParameter(fileUploadJobID) + "/objects",
+	}, &response)
+}
+
+// RollbackFileUploadJobParams holds the query and header parameters of RollbackFileUploadJob. Nil and empty fields are
+// omitted.
+type RollbackFileUploadJobParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// RollbackFileUploadJob sends POST /api/v2/file-upload/{file_upload_job_id}/rollback. Roll Back File Upload Job.
+//
+// Requests that the nodes and relationships a finished file upload job was the only source of be removed from the
+// graph. Objects that another job has also written are kept. The datapipe performs the rollback and then reruns
+// analysis.
+func (s *Client) RollbackFileUploadJob(ctx context.Context, fileUploadJobID int64, params *RollbackFileUploadJobParams) error {
+	return s.do(ctx, request{
+		method:     http.MethodPost,
+		parameters: params,
+		path:       "/api/v2/file-upload/" + pathParameter(fileUploadJobID) + "/rollback",
+	}, nil)
+}
+
+// StartIngestUploadParams holds the query and header parameters of StartIngestUpload. Nil and empty fields are
+// omitted.
+type StartIngestUploadParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// StartIngestUpload sends POST /api/v2/file-upload/{file_upload_job_id}/uploads. Start Resumable File Upload.
+//
+// Starts a resumable upload of a collection file to a running file upload job. The file is sent in chunks that can be
+// resumed from the received size of the upload after an interruption, and is validated and queued for ingest once its
+// last chunk has been received.
+func (s *Client) StartIngestUpload(ctx context.Context, fileUploadJobID int64, body StartIngestUploadRequest, params *StartIngestUploadParams) (StartIngestUploadResponse, error) {
+	var response StartIngestUploadResponse
+	return response, s.do(ctx, request{
+		body:       body,
+		method:     http.MethodPost,
+		parameters: params,
+		path:       "/api/v2/file-upload/" + pathParameter(fileUploadJobID) + "/uploads",
+	}, &response)
+}
+
+// GetIngestUploadParams holds the query and header parameters of GetIngestUpload. Nil and empty fields are omitted.
+type GetIngestUploadParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// GetIngestUpload sends GET /api/v2/file-upload/{file_upload_job_id}/uploads/{ingest_upload_id}. Get Resumable File
+// Upload.
+//
+// Gets a resumable file upload. Clients resume an interrupted upload by sending the chunk that starts at its received
+// size.
+func (s *Client) GetIngestUpload(ctx context.Context, fileUploadJobID int64, ingestUploadID int64, params *GetIngestUploadParams) (GetIngestUploadResponse, error) {
+	var response GetIngestUploadResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/file-upload/" + pathParameter(fileUploadJobID) + "/uploads/" + pathParameter(ingestUploadID),
+	}, &response)
+}
+
+// DeleteIngestUploadParams holds the query and header parameters of DeleteIngestUpload. Nil and empty fields are
+// omitted.
+type DeleteIngestUploadParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// DeleteIngestUpload sends DELETE /api/v2/file-upload/{file_upload_job_id}/uploads/{ingest_upload_id}. Delete
+// Resumable File Upload.
+//
+// Discards a resumable file upload that has not been completed along with the chunks it has received.
+func (s *Client) DeleteIngestUpload(ctx context.Context, fileUploadJobID int64, ingestUploadID int64, params *DeleteIngestUploadParams) error {
+	return s.do(ctx, request{
+		method:     http.MethodDelete,
+		parameters: params,
+		path:       "/api/v2/file-upload/" + pathParameter(fileUploadJobID) + "/uploads/" + pathParameter(ingestUploadID),
+	}, nil)
+}
+
+// WriteIngestUploadChunkParams holds the query and header parameters of WriteIngestUploadChunk. Nil and empty fields
+// are omitted.
+type WriteIngestUploadChunkParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The offset within the file that the chunk starts at.
+	UploadOffset int64 `header:"Upload-Offset"`
+	// The checksum of the chunk in the form `sha256 <base64 digest>`. When given, a chunk whose digest does not match is
+	// rejected.
+	UploadChecksum *string `header:"Upload-Checksum"`
+}
+
+// WriteIngestUploadChunk sends PATCH /api/v2/file-upload/{file_upload_job_id}/uploads/{ingest_upload_id}. Upload File
+// Chunk.
+//
+// Appends a chunk to a resumable file upload. The chunk must start at the received size of the upload. Once the last
+// chunk has been received the file is assembled, validated and queued for ingest, and an upload that fails validation
+// is discarded.
+func (s *Client) WriteIngestUploadChunk(ctx context.Context, fileUploadJobID int64, ingestUploadID int64, body io.Reader, contentType string, params *WriteIngestUploadChunkParams) (WriteIngestUploadChunkResponse, error) {
+	var response WriteIngestUploadChunkResponse
+	return response, s.do(ctx, request{
+		contentType: contentType,
+		method:      http.MethodPatch,
+		parameters:  params,
+		path:        "/api/v2/file-upload/" + pathParameter(fileUploadJobID) + "/uploads/" + pathParameter(ingestUploadID),
+		rawBody:     body,
+	}, &response)
+}
+
+// GetGpoEntityParams holds the query and header parameters of GetGpoEntity. Nil and empty fields are omitted.
+type GetGpoEntityParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Include counts of related entities. Default value is `true`.
+	Counts *bool `query:"counts"`
+}
+
+// GetGpoEntity sends GET /api/v2/gpos/{object_id}. Get GPO entity info.
+//
+// Get info and counts for this GPO node.
+func (s *Client) GetGpoEntity(ctx context.Context, objectID string, params *GetGpoEntityParams) (EntityInfoQueryResults, error) {
+	var response EntityInfoQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/gpos/" + pathParameter(objectID),
+	}, &response)
+}
+
+// GetGpoEntityComputersParams holds the query and header parameters of GetGpoEntityComputers. Nil and empty fields are
+// omitted.
+type GetGpoEntityComputersParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetGpoEntityComputers sends GET /api/v2/gpos/{object_id}/computers. Get GPO entity computer.
+//
+// Get a list, graph, or count of the computers affected by this GPO.
+func (s *Client) GetGpoEntityComputers(ctx context.Context, objectID string, params *GetGpoEntityComputersParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/gpos/" + pathParameter(objectID) + "/computers",
+	}, &response)
+}
+
+// GetGpoEntityComputersAll returns an iterator over every item returned by GetGpoEntityComputers, requesting each page
+// in turn until a page shorter than the limit is returned.
+func (s *Client) GetGpoEntityComputersAll(ctx context.Context, objectID string, params GetGpoEntityComputersParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetGpoEntityComputers(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetGpoEntityControllersParams holds the query and header parameters of GetGpoEntityControllers. Nil and empty fields
+// are omitted.
+type GetGpoEntityControllersParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetGpoEntityControllers sends GET /api/v2/gpos/{object_id}/controllers. Get GPO entity controllers.
+//
+// Get a list, graph, or count of the principals that can control this OU.
+func (s *Client) GetGpoEntityControllers(ctx context.Context, objectID string, params *GetGpoEntityControllersParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/gpos/" + pathParameter(objectID) + "/controllers",
+	}, &response)
+}
+
+// GetGpoEntityControllersAll returns an iterator over every item returned by GetGpoEntityControllers, requesting each
+// page in turn until a page shorter than the limit is returned.
+func (s *Client) GetGpoEntityControllersAll(ctx context.Context, objectID string, params GetGpoEntityControllersParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetGpoEntityControllers(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetGpoEntityOusParams holds the query and header parameters of GetGpoEntityOus. Nil and empty fields are omitted.
+type GetGpoEntityOusParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetGpoEntityOus sends GET /api/v2/gpos/{object_id}/ous. Get GPO entity OUs.
+//
+// Get a list, graph, or count of the OUs affected by this GPO.
+func (s *Client) GetGpoEntityOus(ctx context.Context, objectID string, params *GetGpoEntityOusParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/gpos/" + pathParameter(objectID) + "/ous",
+	}, &response)
+}
+
+// GetGpoEntityOusAll returns an iterator over every item returned by GetGpoEntityOus, requesting each page in turn
+// until a page shorter than the limit is returned.
+func (s *Client) GetGpoEntityOusAll(ctx context.Context, objectID string, params GetGpoEntityOusParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetGpoEntityOus(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetGpoEntityTierZeroParams holds the query and header parameters of GetGpoEntityTierZero. Nil and empty fields are
+// omitted.
+type GetGpoEntityTierZeroParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetGpoEntityTierZero sends GET /api/v2/gpos/{object_id}/tier-zero. Get GPO entity tier-zero.
+//
+// Get a list, graph, or count of the tier-zero principals associated with this GPO.
+func (s *Client) GetGpoEntityTierZero(ctx context.Context, objectID string, params *GetGpoEntityTierZeroParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/gpos/" + pathParameter(objectID) + "/tier-zero",
+	}, &response)
+}
+
+// GetGpoEntityTierZeroAll returns an iterator over every item returned by GetGpoEntityTierZero, requesting each page
+// in turn until a page shorter than the limit is returned.
+func (s *Client) GetGpoEntityTierZeroAll(ctx context.Context, objectID string, params GetGpoEntityTierZeroParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetGpoEntityTierZero(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetGpoEntityUsersParams holds the query and header parameters of GetGpoEntityUsers. Nil and empty fields are
+// omitted.
+type GetGpoEntityUsersParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetGpoEntityUsers sends GET /api/v2/gpos/{object_id}/users. Get GPO entity users.
+//
+// Get a list, graph, or count of the users affected by this GPO.
+func (s *Client) GetGpoEntityUsers(ctx context.Context, objectID string, params *GetGpoEntityUsersParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/gpos/" + pathParameter(objectID) + "/users",
+	}, &response)
+}
+
+// GetGpoEntityUsersAll returns an iterator over every item returned by GetGpoEntityUsers, requesting each page in turn
+// until a page shorter than the limit is returned.
+func (s *Client) GetGpoEntityUsersAll(ctx context.Context, objectID string, params GetGpoEntityUsersParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetGpoEntityUsers(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetSearchResultParams holds the query and header parameters of GetSearchResult. Nil and empty fields are omitted.
+type GetSearchResultParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Search query
+	Query string `query:"query"`
+	// The type of search strategy to use. Default is `fuzzy`.
+	Type *string `query:"type"`
+}
+
+// GetSearchResult sends GET /api/v2/graph-search. Get search result.
+//
+// Get the result of searching a graph for a node by name
+func (s *Client) GetSearchResult(ctx context.Context, params *GetSearchResultParams) (GetSearchResultResponse, error) {
+	var response GetSearchResultResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/graph-search",
+	}, &response)
+}
+
+// RunCypherQueryParams holds the query and header parameters of RunCypherQuery. Nil and empty fields are omitted.
+type RunCypherQueryParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// RunCypherQuery sends POST /api/v2/graphs/cypher. Run a cypher query.
+//
+// Runs a manual cypher query directly against the database.
+//
+// Results of queries that do not modify the graph may be exported to GraphML, GEXF or DOT for external graph tooling
+// by requesting the matching media type in the `Accept` header. Node kinds, properties and asset group tags are
+// exported as attributes.
+func (s *Client) RunCypherQuery(ctx context.Context, body RunCypherQueryRequest, params *RunCypherQueryParams) (RunCypherQueryResponse, error) {
+	var response RunCypherQueryResponse
+	return response, s.do(ctx, request{
+		body:       body,
+		method:     http.MethodPost,
+		parameters: params,
+		path:       "/api/v2/graphs/cypher",
+	}, &response)
+}
+
+// GetPathCompositionParams holds the query and header parameters of GetPathComposition. Nil and empty fields are
+// omitted.
+type GetPathCompositionParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The ID of the starting node.
+	SourceNode int32 `query:"source_node"`
+	// The ID of the ending node.
+	TargetNode int32 `query:"target_node"`
+	// The type of edge to show the composition for.
+	EdgeType string `query:"edge_type"`
+}
+
+// GetPathComposition sends GET /api/v2/graphs/edge-composition. Get path composition.
+//
+// Returns a graph representing the various nodes and edges that make up the complex post-processed edge.
+func (s *Client) GetPathComposition(ctx context.Context, params *GetPathCompositionParams) (GetPathCompositionResponse, error) {
+	var response GetPathCompositionResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/graphs/edge-composition",
+	}, &response)
+}
+
+// GetKindsParams holds the query and header parameters of GetKinds. Nil and empty fields are omitted.
+type GetKindsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// GetKinds sends GET /api/v2/graphs/kinds. Get kinds.
+//
+// Gets kinds
+func (s *Client) GetKinds(ctx context.Context, params *GetKindsParams) (GetKindsResponse, error) {
+	var response GetKindsResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/graphs/kinds",
+	}, &response)
+}
+
+// GetRelayTargetsParams holds the query and header parameters of GetRelayTargets. Nil and empty fields are omitted.
+type GetRelayTargetsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The ID of the starting node.
+	SourceNode int32 `query:"source_node"`
+	// The ID of the ending node.
+	TargetNode int32 `query:"target_node"`
+	// The type of edge to show the composition for.
+	EdgeType string `query:"edge_type"`
+}
+
+// GetRelayTargets sends GET /api/v2/graphs/relay-targets. Get relay targets.
+//
+// Returns a graph representing the various nodes that are valid relay targets for this edge
+func (s *Client) GetRelayTargets(ctx context.Context, params *GetRelayTargetsParams) (GetRelayTargetsResponse, error) {
+	var response GetRelayTargetsResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/graphs/relay-targets",
+	}, &response)
+}
+
+// GetShortestPathParams holds the query and header parameters of GetShortestPath. Nil and empty fields are omitted.
+type GetShortestPathParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The start node objectId
+	StartNode string `query:"start_node"`
+	// The end node objectId
+	EndNode           string `query:"end_node"`
+	RelationshipKinds string `query:"relationship_kinds"`
+}
+
+// GetShortestPath sends GET /api/v2/graphs/shortest-path. Get the shortest path graph.
+//
+// A graph of the shortest path from `start_node` to `end_node`.
+//
+// The graph may be exported to GraphML, GEXF or DOT for external graph tooling by requesting the matching media type
+// in the `Accept` header.
+func (s *Client) GetShortestPath(ctx context.Context, params *GetShortestPathParams) (GetShortestPathResponse, error) {
+	var response GetShortestPathResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/graphs/shortest-path",
+	}, &response)
+}
+
+// GetGroupEntityParams holds the query and header parameters of GetGroupEntity. Nil and empty fields are omitted.
+type GetGroupEntityParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Include counts of related entities. Default value is `true`.
+	Counts *bool `query:"counts"`
+}
+
+// GetGroupEntity sends GET /api/v2/groups/{object_id}. Get Group entity info.
+//
+// Get info and counts for this Group node.
+func (s *Client) GetGroupEntity(ctx context.Context, objectID string, params *GetGroupEntityParams) (EntityInfoQueryResults, error) {
+	var response EntityInfoQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/groups/" + pathParameter(objectID),
+	}, &response)
+}
+
+// GetGroupEntityAdminRightsParams holds the query and header parameters of GetGroupEntityAdminRights. Nil and empty
+// fields are omitted.
+type GetGroupEntityAdminRightsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetGroupEntityAdminRights sends GET /api/v2/groups/{object_id}/admin-rights. Get Group entity admin rights.
+//
+// Get a list, graph, or count of the systems this group has admin rights to.
+func (s *Client) GetGroupEntityAdminRights(ctx context.Context, objectID string, params *GetGroupEntityAdminRightsParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/groups/" + pathParameter(objectID) + "/admin-rights",
+	}, &response)
+}
+
+// GetGroupEntityAdminRightsAll returns an iterator over every item returned by GetGroupEntityAdminRights, requesting
+// each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetGroupEntityAdminRightsAll(ctx context.Context, objectID string, params GetGroupEntityAdminRightsParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetGroupEntityAdminRights(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetGroupEntityControllablesParams holds the query and header parameters of GetGroupEntityControllables. Nil and
+// empty fields are omitted.
+type GetGroupEntityControllablesParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetGroupEntityControllables sends GET /api/v2/groups/{object_id}/controllables. Get Group entity controllables.
+//
+// Get a list, graph, or count of the principals this group can control.
+func (s *Client) GetGroupEntityControllables(ctx context.Context, objectID string, params *GetGroupEntityControllablesParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/groups/" + pathParameter(objectID) + "/controllables",
+	}, &response)
+}
+
+// GetGroupEntityControllablesAll returns an iterator over every item returned by GetGroupEntityControllables,
+// requesting each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetGroupEntityControllablesAll(ctx context.Context, objectID string, params GetGroupEntityControllablesParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetGroupEntityControllables(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetGroupEntityControllersParams holds the query and header parameters of GetGroupEntityControllers. Nil and empty
+// fields are omitted.
+type GetGroupEntityControllersParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetGroupEntityControllers sends GET /api/v2/groups/{object_id}/controllers. Get Group entity controllers.
+//
+// Get a list, graph, or count of the principals that can control this group.
+func (s *Client) GetGroupEntityControllers(ctx context.Context, objectID string, params *GetGroupEntityControllersParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/groups/" + pathParameter(objectID) + "/controllers",
+	}, &response)
+}
+
+// GetGroupEntityControllersAll returns an iterator over every item returned by GetGroupEntityControllers, requesting
+// each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetGroupEntityControllersAll(ctx context.Context, objectID string, params GetGroupEntityControllersParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetGroupEntityControllers(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetGroupEntityDcomRightsParams holds the query and header parameters of GetGroupEntityDcomRights. Nil and empty
+// fields are omitted.
+type GetGroupEntityDcomRightsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetGroupEntityDcomRights sends GET /api/v2/groups/{object_id}/dcom-rights. Get Group entity DCOMRights.
+//
+// Get a list, graph, or count of the systems this group can execute DCOM on.
+func (s *Client) GetGroupEntityDcomRights(ctx context.Context, objectID string, params *GetGroupEntityDcomRightsParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/groups/" + pathParameter(objectID) + "/dcom-rights",
+	}, &response)
+}
+
+// GetGroupEntityDcomRightsAll returns an iterator over every item returned by GetGroupEntityDcomRights, requesting
+// each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetGroupEntityDcomRightsAll(ctx context.Context, objectID string, params GetGroupEntityDcomRightsParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetGroupEntityDcomRights(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetGroupEntityMembersParams holds the query and header parameters of GetGroupEntityMembers. Nil and empty fields are
+// omitted.
+type GetGroupEntityMembersParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetGroupEntityMembers sends GET /api/v2/groups/{object_id}/members. Get Group entity members.
+//
+// Get a list, graph, or count of the principals that are a member of this group.
+func (s *Client) GetGroupEntityMembers(ctx context.Context, objectID string, params *GetGroupEntityMembersParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/groups/" + pathParameter(objectID) + "/members",
+	}, &response)
+}
+
+// GetGroupEntityMembersAll returns an iterator over every item returned by GetGroupEntityMembers, requesting each page
+// in turn until a page shorter than the limit is returned.
+func (s *Client) GetGroupEntityMembersAll(ctx context.Context, objectID string, params GetGroupEntityMembersParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetGroupEntityMembers(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetGroupEntityMembershipsParams holds the query and header parameters of GetGroupEntityMemberships. Nil and empty
+// fields are omitted.
+type GetGroupEntityMembershipsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetGroupEntityMemberships sends GET /api/v2/groups/{object_id}/memberships. Get Group entity memberships.
+//
+// Get a list, graph, or count of the groups this group is a member of.
+func (s *Client) GetGroupEntityMemberships(ctx context.Context, objectID string, params *GetGroupEntityMembershipsParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/groups/" + pathParameter(objectID) + "/memberships",
+	}, &response)
+}
+
+// GetGroupEntityMembershipsAll returns an iterator over every item returned by GetGroupEntityMemberships, requesting
+// each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetGroupEntityMembershipsAll(ctx context.Context, objectID string, params GetGroupEntityMembershipsParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetGroupEntityMemberships(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetGroupEntityPsRemoteRightsParams holds the query and header parameters of GetGroupEntityPsRemoteRights. Nil and
+// empty fields are omitted.
+type GetGroupEntityPsRemoteRightsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetGroupEntityPsRemoteRights sends GET /api/v2/groups/{object_id}/ps-remote-rights. Get Group entity PowerShell
+// remote rights.
+//
+// Get a list, graph, or count of the systems this group can execute PowerShell remote on.
+func (s *Client) GetGroupEntityPsRemoteRights(ctx context.Context, objectID string, params *GetGroupEntityPsRemoteRightsParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/groups/" + pathParameter(objectID) + "/ps-remote-rights",
+	}, &response)
+}
+
+// GetGroupEntityPsRemoteRightsAll returns an iterator over every item returned by GetGroupEntityPsRemoteRights,
+// requesting each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetGroupEntityPsRemoteRightsAll(ctx context.Context, objectID string, params GetGroupEntityPsRemoteRightsParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetGroupEntityPsRemoteRights(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetGroupEntityRdpRightsParams holds the query and header parameters of GetGroupEntityRdpRights. Nil and empty fields
+// are omitted.
+type GetGroupEntityRdpRightsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetGroupEntityRdpRights sends GET /api/v2/groups/{object_id}/rdp-rights. Get Group entity RDP rights.
+//
+// Get a list, graph, or count of the systems this group can RDP to.
+func (s *Client) GetGroupEntityRdpRights(ctx context.Context, objectID string, params *GetGroupEntityRdpRightsParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/groups/" + pathParameter(objectID) + "/rdp-rights",
+	}, &response)
+}
+
+// GetGroupEntityRdpRightsAll returns an iterator over every item returned by GetGroupEntityRdpRights, requesting each
+// page in turn until a page shorter than the limit is returned.
+func (s *Client) GetGroupEntityRdpRightsAll(ctx context.Context, objectID string, params GetGroupEntityRdpRightsParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetGroupEntityRdpRights(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetGroupEntitySessionsParams holds the query and header parameters of GetGroupEntitySessions. Nil and empty fields
+// are omitted.
+type GetGroupEntitySessionsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetGroupEntitySessions sends GET /api/v2/groups/{object_id}/sessions. Get Group entity sessions.
+//
+// Get a list, graph, or count of the active sessions for users that belong to this group.
+func (s *Client) GetGroupEntitySessions(ctx context.Context, objectID string, params *GetGroupEntitySessionsParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/groups/" + pathParameter(objectID) + "/sessions",
+	}, &response)
+}
+
+// GetGroupEntitySessionsAll returns an iterator over every item returned by GetGroupEntitySessions, requesting each
+// page in turn until a page shorter than the limit is returned.
+func (s *Client) GetGroupEntitySessionsAll(ctx context.Context, objectID string, params GetGroupEntitySessionsParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetGroupEntitySessions(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// IngestDataParams holds the query and header parameters of IngestData. Nil and empty fields are omitted.
+type IngestDataParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// IngestData sends POST /api/v2/ingest. Endpoint for data ingestion.
+//
+// Ingests data from collector clients
+func (s *Client) IngestData(ctx context.Context, params *IngestDataParams) error {
+	return s.do(ctx, request{
+		method:     http.MethodPost,
+		parameters: params,
+		path:       "/api/v2/ingest",
+	}, nil)
+}
+
+// GetClientJobsParams holds the query and header parameters of GetClientJobs. Nil and empty fields are omitted.
+type GetClientJobsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Sortable columns are `client_name`, `event_id`, `execution_time`, `status`, `start_time`, `end_time`, `log_path`,
+	// `domain_controller`, `event_title`, `last_ingest`, `id`, `created_at`, `updated_at`, `deleted_at`.
+	SortBy                 []string `query:"sort_by"`
+	LogPath                string   `query:"log_path"`
+	SessionCollection      string   `query:"session_collection"`
+	LocalGroupCollection   string   `query:"local_group_collection"`
+	CertServicesCollection string   `query:"cert_services_collection"`
+	CARegistryCollection   string   `query:"ca_registry_collection"`
+	DcRegistryCollection   string   `query:"dc_registry_collection"`
+	ADStructureCollection  string   `query:"ad_structure_collection"`
+	DomainController       string   `query:"domain_controller"`
+	Status                 string   `query:"status"`
+	EventTitle             string   `query:"event_title"`
+	ClientID               string   `query:"client_id"`
+	EventID                string   `query:"event_id"`
+	ExecutionTime          string   `query:"execution_time"`
+	StartTime              string   `query:"start_time"`
+	EndTime                string   `query:"end_time"`
+	LastIngest             string   `query:"last_ingest"`
+	// Filter results by `created_at` value. See filter schema details for valid predicates.
+	CreatedAt string `query:"created_at"`
+	// Filter results by `updated_at` value. See filter schema details for valid predicates.
+	UpdatedAt string `query:"updated_at"`
+	// Filter results by `deleted_at` value. See filter schema details for valid predicates.
+	DeletedAt string `query:"deleted_at"`
+}
+
+// GetClientJobs sends GET /api/v2/jobs. Get jobs.
+//
+// Gets client jobs
+func (s *Client) GetClientJobs(ctx context.Context, params *GetClientJobsParams) (GetClientJobsResponse, error) {
+	var response GetClientJobsResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/jobs",
+	}, &response)
+}
+
+// ListAvailableClientJobsParams holds the query and header parameters of ListAvailableClientJobs. Nil and empty fields
+// are omitted.
+type ListAvailableClientJobsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Sortable columns are `event_id`, `execution_time`, `status`, `start_time`, `end_time`, `log_path`,
+	// `domain_controller`, `event_title`, `last_ingest`, `id`, `created_at`, `updated_at`, and `deleted_at`.
+	SortBy                 []string `query:"sort_by"`
+	LogPath                string   `query:"log_path"`
+	SessionCollection      string   `query:"session_collection"`
+	LocalGroupCollection   string   `query:"local_group_collection"`
+	CertServicesCollection string   `query:"cert_services_collection"`
+	CARegistryCollection   string   `query:"ca_registry_collection"`
+	DcRegistryCollection   string   `query:"dc_registry_collection"`
+	ADStructureCollection  string   `query:"ad_structure_collection"`
+	DomainController       string   `query:"domain_controller"`
+	Status                 string   `query:"status"`
+	EventTitle             string   `query:"event_title"`
+	ClientID               string   `query:"client_id"`
+	EventID                string   `query:"event_id"`
+	ExecutionTime          string   `query:"execution_time"`
+	StartTime              string   `query:"start_time"`
+	EndTime                string   `query:"end_time"`
+	LastIngest             string   `query:"last_ingest"`
+	// When a value of `true` is passed, any OUs associated with scheduled and finished jobs for each client will have
+	// expanded properties including `name` and `type`. When a value of `false` is passed, these same OUs will only return
+	// as a list of `objectid`s.
+	HydrateOus *bool `query:"hydrate_ous"`
+	// When a value of `true` is passed, any Domains associated with scheduled and finished jobs for each client will have
+	// expanded properties including `name` and `type`. When a value of `false` is passed, these same Domains will only
+	// return as a list of `objectid`s.
+	HydrateDomains *bool `query:"hydrate_domains"`
+	// Filter results by `created_at` value. See filter schema details for valid predicates.
+	CreatedAt string `query:"created_at"`
+	// Filter results by `updated_at` value. See filter schema details for valid predicates.
+	UpdatedAt string `query:"updated_at"`
+	// Filter results by `deleted_at` value. See filter schema details for valid predicates.
+	DeletedAt string `query:"deleted_at"`
+}
+
+// ListAvailableClientJobs sends GET /api/v2/jobs/available. List available client jobs.
+//
+// Endpoint for clients to get next available jobs.
+//
+// Note: caller must be a client. For users, this endpoint will return a 404 as they are not expected or allowed to
+// call this endpoint.
+func (s *Client) ListAvailableClientJobs(ctx context.Context, params *ListAvailableClientJobsParams) (ListAvailableClientJobsResponse, error) {
+	var response ListAvailableClientJobsResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/jobs/available",
+	}, &response)
+}
+
+// GetClientCurrentJobParams holds the query and header parameters of GetClientCurrentJob. Nil and empty fields are
+// omitted.
+type GetClientCurrentJobParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// GetClientCurrentJob sends GET /api/v2/jobs/current. Get client current job.
+//
+// Gets the current job for the authenticated client.
+//
+// Note: caller must be a client. For users, this endpoint will return a 404 as they are not expected or allowed to
+// call this endpoint.
+func (s *Client) GetClientCurrentJob(ctx context.Context, params *GetClientCurrentJobParams) (GetClientCurrentJobResponse, error) {
+	var response GetClientCurrentJobResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/jobs/current",
+	}, &response)
+}
+
+// EndClientJobParams holds the query and header parameters of EndClientJob. Nil and empty fields are omitted.
+type EndClientJobParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// EndClientJob sends POST /api/v2/jobs/end. Notifies the API of a job ending.
+//
+// Endpoint for clients to end a job and mark the end time.
+//
+// Note: caller must be a client. For users, this endpoint will return a 404 as they are not expected or allowed to
+// call this endpoint.
+func (s *Client) EndClientJob(ctx context.Context, params *EndClientJobParams) (EndClientJobResponse, error) {
+	var response EndClientJobResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodPost,
+		parameters: params,
+		path:       "/api/v2/jobs/end",
+	}, &response)
+}
+
+// ListClientFinishedJobsParams holds the query and header parameters of ListClientFinishedJobs. Nil and empty fields
+// are omitted.
+type ListClientFinishedJobsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Sortable columns are `client_name`, `event_id`, `execution_time`, `status`, `start_time`, `end_time`, `log_path`,
+	// `domain_controller`, `event_title`, `last_ingest`, `id`, `created_at`, `updated_at`, `deleted_at`.
+	SortBy                 []string `query:"sort_by"`
+	LogPath                string   `query:"log_path"`
+	SessionCollection      string   `query:"session_collection"`
+	LocalGroupCollection   string   `query:"local_group_collection"`
+	CertServicesCollection string   `query:"cert_services_collection"`
+	CARegistryCollection   string   `query:"ca_registry_collection"`
+	DcRegistryCollection   string   `query:"dc_registry_collection"`
+	ADStructureCollection  string   `query:"ad_structure_collection"`
+	DomainController       string   `query:"domain_controller"`
+	Status                 string   `query:"status"`
+	EventTitle             string   `query:"event_title"`
+	ClientID               string   `query:"client_id"`
+	EventID                string   `query:"event_id"`
+	ExecutionTime          string   `query:"execution_time"`
+	StartTime              string   `query:"start_time"`
+	EndTime                string   `query:"end_time"`
+	LastIngest             string   `query:"last_ingest"`
+	// When a value of `true` is passed, any Domains associated with scheduled and finished jobs for each client will have
+	// expanded properties including `name` and `type`. When a value of `false` is passed, these same Domains will only
+	// return as a list of `objectid`s.
+	HydrateDomains *bool `query:"hydrate_domains"`
+	// When a value of `true` is passed, any OUs associated with scheduled and finished jobs for each client will have
+	// expanded properties including `name` and `type`. When a value of `false` is passed, these same OUs will only return
+	// as a list of `objectid`s.
+	HydrateOus *bool `query:"hydrate_ous"`
+	// Filter results by `created_at` value. See filter schema details for valid predicates.
+	CreatedAt string `query:"created_at"`
+	// Filter results by `updated_at` value. See filter schema details for valid predicates.
+	UpdatedAt string `query:"updated_at"`
+	// Filter results by `deleted_at` value. See filter schema details for valid predicates.
+	DeletedAt string `query:"deleted_at"`
+	// This query parameter is used for determining the number of objects to skip in pagination.
+	Skip *int `query:"skip"`
+	// This query parameter is used for setting an upper limit of objects returned in paginated responses.
+	Limit *int `query:"limit"`
+}
+
+// ListClientFinishedJobs sends GET /api/v2/jobs/finished. List finished jobs.
+//
+// Gets all finished jobs
+func (s *Client) ListClientFinishedJobs(ctx context.Context, params *ListClientFinishedJobsParams) (ListClientFinishedJobsResponse, error) {
+	var response ListClientFinishedJobsResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/jobs/finished",
+	}, &response)
+}
+
+// ListClientFinishedJobsAll returns an iterator over every item returned by ListClientFinishedJobs, requesting each
+// page in turn until a page shorter than the limit is returned.
+func (s *Client) ListClientFinishedJobsAll(ctx context.Context, params ListClientFinishedJobsParams) iter.Seq2[ClientScheduledJobDisplay, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]ClientScheduledJobDisplay, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.ListClientFinishedJobs(ctx, &params)
+		return response.Data, err
+	})
+}
+
+// StartClientJobParams holds the query and header parameters of StartClientJob. Nil and empty fields are omitted.
+type StartClientJobParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// StartClientJob sends POST /api/v2/jobs/start. Notifies the API of a job start.
+//
+// Endpoint for clients to start a new job and mark the start time.
+//
+// Note: caller must be a client. For users, this endpoint will return a 404 as they are not expected or allowed to
+// call this endpoint.
+func (s *Client) StartClientJob(ctx context.Context, body StartClientJobRequest, params *StartClientJobParams) (StartClientJobResponse, error) {
+	var response StartClientJobResponse
+	return response, s.do(ctx, request{
+		body:       body,
+		method:     http.MethodPost,
+		parameters: params,
+		path:       "/api/v2/jobs/start",
+	}, &response)
+}
+
+// GetClientJobParams holds the query and header parameters of GetClientJob. Nil and empty fields are omitted.
+type GetClientJobParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// GetClientJob sends GET /api/v2/jobs/{job_id}. Get client job.
+//
+// Gets client job
+func (s *Client) GetClientJob(ctx context.Context, jobID int64, params *GetClientJobParams) (GetClientJobResponse, error) {
+	var response GetClientJobResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/jobs/" + pathParameter(jobID),
+	}, &response)
+}
+
+// CancelClientJobParams holds the query and header parameters of CancelClientJob. Nil and empty fields are omitted.
+type CancelClientJobParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// CancelClientJob sends PUT /api/v2/jobs/{job_id}/cancel. Cancels a scheduled job.
+//
+// Cancels a scheduled job
+func (s *Client) CancelClientJob(ctx context.Context, jobID int64, params *CancelClientJobParams) (CancelClientJobResponse, error) {
+	var response CancelClientJobResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodPut,
+		parameters: params,
+		path:       "/api/v2/jobs/" + pathParameter(jobID) + "/cancel",
+	}, &response)
+}
+
+// GetClientJobLogParams holds the query and header parameters of GetClientJobLog. Nil and empty fields are omitted.
+type GetClientJobLogParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// GetClientJobLog sends GET /api/v2/jobs/{job_id}/log. Get Job Log File.
+//
+// Get the log file from a SharpHound run
+func (s *Client) GetClientJobLog(ctx context.Context, jobID int64, params *GetClientJobLogParams) (GetClientJobLogResponse, error) {
+	var response GetClientJobLogResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/jobs/" + pathParameter(jobID) + "/log",
+	}, &response)
+}
+
+// LoginParams holds the query and header parameters of Login. Nil and empty fields are omitted.
+type LoginParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// Login sends POST /api/v2/login. Login to BloodHound.
+//
+// Login to BloodHound with user credentials or a one time password.
+func (s *Client) Login(ctx context.Context, body LoginRequest, params *LoginParams) (LoginResponse, error) {
+	var response LoginResponse
+	return response, s.do(ctx, request{
+		body:       body,
+		method:     http.MethodPost,
+		parameters: params,
+		path:       "/api/v2/login",
+	}, &response)
+}
+
+// LogoutParams holds the query and header parameters of Logout. Nil and empty fields are omitted.
+type LogoutParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// Logout sends POST /api/v2/logout. Logout of BloodHound.
+//
+// Logout of BloodHound and delete the user session JWT.
+func (s *Client) Logout(ctx context.Context, params *LogoutParams) (io.ReadCloser, error) {
+	return s.stream(ctx, request{
+		method:     http.MethodPost,
+		parameters: params,
+		path:       "/api/v2/logout",
+	})
+}
+
+// GetLatestTierZeroComboNodeParams holds the query and header parameters of GetLatestTierZeroComboNode. Nil and empty
+// fields are omitted.
+type GetLatestTierZeroComboNodeParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// GetLatestTierZeroComboNode sends GET /api/v2/meta-nodes/{domain_id}. Get latest tier zero combo node.
+//
+// Get latest tier zero combo node
+func (s *Client) GetLatestTierZeroComboNode(ctx context.Context, domainID string, params *GetLatestTierZeroComboNodeParams) (GetLatestTierZeroComboNodeResponse, error) {
+	var response GetLatestTierZeroComboNodeResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/meta-nodes/" + pathParameter(domainID),
+	}, &response)
+}
+
+// GetComboTreeGraphParams holds the query and header parameters of GetComboTreeGraph. Nil and empty fields are
+// omitted.
+type GetComboTreeGraphParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Node ID
+	NodeID *int64 `query:"node_id"`
+}
+
+// GetComboTreeGraph sends GET /api/v2/meta-trees/{domain_id}. Get the graph for meta tree.
+//
+// Gets meta nodes and connecting edges
+func (s *Client) GetComboTreeGraph(ctx context.Context, domainID string, params *GetComboTreeGraphParams) (GetComboTreeGraphResponse, error) {
+	var response GetComboTreeGraphResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/meta-trees/" + pathParameter(domainID),
+	}, &response)
+}
+
+// GetMetaEntityParams holds the query and header parameters of GetMetaEntity. Nil and empty fields are omitted.
+type GetMetaEntityParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// GetMetaEntity sends GET /api/v2/meta/{object_id}. Get Meta entity info.
+//
+// Get info and counts for this Meta node.
+func (s *Client) GetMetaEntity(ctx context.Context, objectID string, params *GetMetaEntityParams) (GetMetaEntityResponse, error) {
+	var response GetMetaEntityResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/meta/" + pathParameter(objectID),
+	}, &response)
+}
+
+// GetNtAuthStoreEntityParams holds the query and header parameters of GetNtAuthStoreEntity. Nil and empty fields are
+// omitted.
+type GetNtAuthStoreEntityParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Include counts of related entities. Default value is `true`.
+	Counts *bool `query:"counts"`
+}
+
+// GetNtAuthStoreEntity sends GET /api/v2/ntauthstores/{object_id}. Get NT Auth Store entity info.
+//
+// Get info and counts for this NT Auth Store node.
+func (s *Client) GetNtAuthStoreEntity(ctx context.Context, objectID string, params *GetNtAuthStoreEntityParams) (EntityInfoQueryResults, error) {
+	var response EntityInfoQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/ntauthstores/" + pathParameter(objectID),
+	}, &response)
+}
+
+// GetNtAuthStoreEntityControllersParams holds the query and header parameters of GetNtAuthStoreEntityControllers. Nil
+// and empty fields are omitted.
+type GetNtAuthStoreEntityControllersParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetNtAuthStoreEntityControllers sends GET /api/v2/ntauthstores/{object_id}/controllers. Get NT Auth Store entity
+// controllers.
+//
+// Get a list, graph, or count of the principals that can control this NT Auth Store.
+func (s *Client) GetNtAuthStoreEntityControllers(ctx context.Context, objectID string, params *GetNtAuthStoreEntityControllersParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/ntauthstores/" + pathParameter(objectID) + "/controllers",
+	}, &response)
+}
+
+// GetNtAuthStoreEntityControllersAll returns an iterator over every item returned by GetNtAuthStoreEntityControllers,
+// requesting each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetNtAuthStoreEntityControllersAll(ctx context.Context, objectID string, params GetNtAuthStoreEntityControllersParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetNtAuthStoreEntityControllers(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetOuEntityParams holds the query and header parameters of GetOuEntity. Nil and empty fields are omitted.
+type GetOuEntityParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Include counts of related entities. Default value is `true`.
+	Counts *bool `query:"counts"`
+}
+
+// GetOuEntity sends GET /api/v2/ous/{object_id}. Get OU entity info.
+//
+// Get info and counts for this OU node.
+func (s *Client) GetOuEntity(ctx context.Context, objectID string, params *GetOuEntityParams) (EntityInfoQueryResults, error) {
+	var response EntityInfoQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/ous/" + pathParameter(objectID),
+	}, &response)
+}
+
+// GetOuEntityComputersParams holds the query and header parameters of GetOuEntityComputers. Nil and empty fields are
+// omitted.
+type GetOuEntityComputersParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetOuEntityComputers sends GET /api/v2/ous/{object_id}/computers. Get OU entity computers.
+//
+// Get a list, graph, or count of the computers contained by this OU.
+func (s *Client) GetOuEntityComputers(ctx context.Context, objectID string, params *GetOuEntityComputersParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/ous/" + pathParameter(objectID) + "/computers",
+	}, &response)
+}
+
+// GetOuEntityComputersAll returns an iterator over every item returned by GetOuEntityComputers, requesting each page
+// in turn until a page shorter than the limit is returned.
+func (s *Client) GetOuEntityComputersAll(ctx context.Context, objectID string, params GetOuEntityComputersParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetOuEntityComputers(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetOuEntityGposParams holds the query and header parameters of GetOuEntityGpos. Nil and empty fields are omitted.
+type GetOuEntityGposParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetOuEntityGpos sends GET /api/v2/ous/{object_id}/gpos. Get OU entity GPOs.
+//
+// Get a list, graph, or count of the GPOs that affect this OU.
+func (s *Client) GetOuEntityGpos(ctx context.Context, objectID string, params *GetOuEntityGposParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/ous/" + pathParameter(objectID) + "/gpos",
+	}, &response)
+}
+
+// GetOuEntityGposAll returns an iterator over every item returned by GetOuEntityGpos, requesting each page in turn
+// until a page shorter than the limit is returned.
+func (s *Client) GetOuEntityGposAll(ctx context.Context, objectID string, params GetOuEntityGposParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetOuEntityGpos(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetOuEntityGroupsParams holds the query and header parameters of GetOuEntityGroups. Nil and empty fields are
+// omitted.
+type GetOuEntityGroupsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetOuEntityGroups sends GET /api/v2/ous/{object_id}/groups. Get OU entity groups.
+//
+// Get a list, graph, or count of the groups contained by this OU.
+func (s *Client) GetOuEntityGroups(ctx context.Context, objectID string, params *GetOuEntityGroupsParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/ous/" + pathParameter(objectID) + "/groups",
+	}, &response)
+}
+
+// GetOuEntityGroupsAll returns an iterator over every item returned by GetOuEntityGroups, requesting each page in turn
+// until a page shorter than the limit is returned.
+func (s *Client) GetOuEntityGroupsAll(ctx context.Context, objectID string, params GetOuEntityGroupsParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetOuEntityGroups(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetOuEntityUsersParams holds the query and header parameters of GetOuEntityUsers. Nil and empty fields are omitted.
+type GetOuEntityUsersParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetOuEntityUsers sends GET /api/v2/ous/{object_id}/users. Get OU entity users.
+//
+// Get a list, graph, or count of the users contained by this OU.
+func (s *Client) GetOuEntityUsers(ctx context.Context, objectID string, params *GetOuEntityUsersParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/ous/" + pathParameter(objectID) + "/users",
+	}, &response)
+}
+
+// GetOuEntityUsersAll returns an iterator over every item returned by GetOuEntityUsers, requesting each page in turn
+// until a page shorter than the limit is returned.
+func (s *Client) GetOuEntityUsersAll(ctx context.Context, objectID string, params GetOuEntityUsersParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetOuEntityUsers(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// PathfindingParams holds the query and header parameters of Pathfinding. Nil and empty fields are omitted.
+type PathfindingParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Start Node
+	StartNode string `query:"start_node"`
+	// End Node
+	EndNode string `query:"end_node"`
+}
+
+// Pathfinding sends GET /api/v2/pathfinding. Get pathfinding result.
+//
+// DEPRECATED use GetShortestPath instead. Get the result of pathfinding between two nodes in graph format.
+//
+// Deprecated: the route is deprecated.
+func (s *Client) Pathfinding(ctx context.Context, params *PathfindingParams) (PathfindingResponse, error) {
+	var response PathfindingResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/pathfinding",
+	}, &response)
+}
+
+// ListPermissionsParams holds the query and header parameters of ListPermissions. Nil and empty fields are omitted.
+type ListPermissionsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Sortable columns are `authority`, `name`, `id`, `created_at`, `updated_at`, `deleted_at`.
+	SortBy    []string `query:"sort_by"`
+	Authority string   `query:"authority"`
+	Name      string   `query:"name"`
+	ID        string   `query:"id"`
+	// Filter results by `created_at` value. See filter schema details for valid predicates.
+	CreatedAt string `query:"created_at"`
+	// Filter results by `updated_at` value. See filter schema details for valid predicates.
+	UpdatedAt string `query:"updated_at"`
+	// Filter results by `deleted_at` value. See filter schema details for valid predicates.
+	DeletedAt string `query:"deleted_at"`
+}
+
+// ListPermissions sends GET /api/v2/permissions. List Permissions.
+//
+// List all authorization permissions.
+func (s *Client) ListPermissions(ctx context.Context, params *ListPermissionsParams) (ListPermissionsResponse, error) {
+	var response ListPermissionsResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/permissions",
+	}, &response)
+}
+
+// GetPermissionParams holds the query and header parameters of GetPermission. Nil and empty fields are omitted.
+type GetPermissionParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// GetPermission sends GET /api/v2/permissions/{permission_id}. Get Permission.
+//
+// Gets an authorization permission's details.
+func (s *Client) GetPermission(ctx context.Context, permissionID int32, params *GetPermissionParams) (GetPermissionResponse, error) {
+	var response GetPermissionResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/permissions/" + pathParameter(permissionID),
+	}, &response)
+}
+
+// GetPlatformDataQualityAggregateParams holds the query and header parameters of GetPlatformDataQualityAggregate. Nil
+// and empty fields are omitted.
+type GetPlatformDataQualityAggregateParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Sortable columns are created_at, updated_at.
+	SortBy []string `query:"sort_by"`
+	// Beginning datetime of range (inclusive) in RFC-3339 format; Defaults to current datetime minus 30 days
+	Start *time.Time `query:"start"`
+	// Ending datetime of range (exclusive) in RFC-3339 format; Defaults to current datetime
+	End *time.Time `query:"end"`
+	// This query parameter is used for determining the number of objects to skip in pagination.
+	Skip *int `query:"skip"`
+	// This query parameter is used for setting an upper limit of objects returned in paginated responses.
+	Limit *int `query:"limit"`
+}
+
+// GetPlatformDataQualityAggregate sends GET /api/v2/platform/{platform_id}/data-quality-stats. Get platform data
+// quality aggregate.
+//
+// Time series list of aggregate data quality stats for a given platform
+func (s *Client) GetPlatformDataQualityAggregate(ctx context.Context, platformID string, params *GetPlatformDataQualityAggregateParams) (ResponseDataQualityPlatformAggregate, error) {
+	var response ResponseDataQualityPlatformAggregate
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/platform/" + pathParameter(platformID) + "/data-quality-stats",
+	}, &response)
+}
+
+// GetPlatformDataQualityAggregateAll returns an iterator over every item returned by GetPlatformDataQualityAggregate,
+// requesting each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetPlatformDataQualityAggregateAll(ctx context.Context, platformID string, params GetPlatformDataQualityAggregateParams) iter.Seq2[json.RawMessage, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]json.RawMessage, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetPlatformDataQualityAggregate(ctx, platformID, &params)
+		return response.Data, err
+	})
+}
+
+// PostureHistoryForEnvironmentsParams holds the query and header parameters of PostureHistoryForEnvironments. Nil and
+// empty fields are omitted.
+type PostureHistoryForEnvironmentsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Environment IDs
+	Environments []string `query:"environments"`
+	// Beginning datetime of range (inclusive) in RFC-3339 format; Defaults to current datetime minus 30 days
+	Start *time.Time `query:"start"`
+	// Ending datetime of range (exclusive) in RFC-3339 format; Defaults to current datetime
+	End *time.Time `query:"end"`
+}
+
+// PostureHistoryForEnvironments sends GET /api/v2/posture-history/{data_type}. Get Posture History.
+//
+// Gets posture data count changes over a time period
+func (s *Client) PostureHistoryForEnvironments(ctx context.Context, dataType PostureHistoryType, params *PostureHistoryForEnvironmentsParams) (PostureHistoryForEnvironmentsResponse, error) {
+	var response PostureHistoryForEnvironmentsResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/posture-history/" + pathParameter(dataType),
+	}, &response)
+}
+
+// GetPostureStatsParams holds the query and header parameters of GetPostureStats. Nil and empty fields are omitted.
+type GetPostureStatsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Sortable columns are `domain_sid`, `exposure_index`, `tier_zero_count`, `critical_risk_count`, `id`, `created_at`,
+	// `updated_at`, `deleted_at`.
+	SortBy []string `query:"sort_by"`
+	// Lower bound for created_at; to return posture stats starting at a specific date/time
+	From *time.Time `query:"from"`
+	// Upper bound for created_at; to return posture stats upto a specific date/time
+	To                *time.Time `query:"to"`
+	DomainSID         string     `query:"domain_sid"`
+	ExposureIndex     string     `query:"exposure_index"`
+	TierZeroCount     string     `query:"tier_zero_count"`
+	CriticalRiskCount string     `query:"critical_risk_count"`
+	ID                string     `query:"id"`
+}
+
+// GetPostureStats sends GET /api/v2/posture-stats. Get Posture Statistics.
+//
+// Gets the history of database stats saved in the database
+func (s *Client) GetPostureStats(ctx context.Context, params *GetPostureStatsParams) (GetPostureStatsResponse, error) {
+	var response GetPostureStatsResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/posture-stats",
+	}, &response)
+}
+
+// ListRolesParams holds the query and header parameters of ListRoles. Nil and empty fields are omitted.
+type ListRolesParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Sortable columns are `name`, `description`, `id`, `created_at`, `updated_at`, `deleted_at`.
+	SortBy []string `query:"sort_by"`
+	Name   string   `query:"name"`
+	ID     string   `query:"id"`
+	// Filter results by `created_at` value. See filter schema details for valid predicates.
+	CreatedAt string `query:"created_at"`
+	// Filter results by `updated_at` value. See filter schema details for valid predicates.
+	UpdatedAt string `query:"updated_at"`
+	// Filter results by `deleted_at` value. See filter schema details for valid predicates.
+	DeletedAt string `query:"deleted_at"`
+}
+
+// ListRoles sends GET /api/v2/roles. List Roles.
+//
+// List all authorization roles.
+func (s *Client) ListRoles(ctx context.Context, params *ListRolesParams) (ListRolesResponse, error) {
+	var response ListRolesResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/roles",
+	}, &response)
+}
+
+// GetRoleParams holds the query and header parameters of GetRole. Nil and empty fields are omitted.
+type GetRoleParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// GetRole sends GET /api/v2/roles/{role_id}. Get Role.
+//
+// Gets an authorization role's details.
+func (s *Client) GetRole(ctx context.Context, roleID int32, params *GetRoleParams) (GetRoleResponse, error) {
+	var response GetRoleResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/roles/" + pathParameter(roleID),
+	}, &response)
+}
+
+// GetRootCaEntityParams holds the query and header parameters of GetRootCaEntity. Nil and empty fields are omitted.
+type GetRootCaEntityParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Include counts of related entities. Default value is `true`.
+	Counts *bool `query:"counts"`
+}
+
+// GetRootCaEntity sends GET /api/v2/rootcas/{object_id}. Get Root CA entity info.
+//
+// Get info and counts for this Root CA node.
+func (s *Client) GetRootCaEntity(ctx context.Context, objectID string, params *GetRootCaEntityParams) (EntityInfoQueryResults, error) {
+	var response EntityInfoQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/rootcas/" + pathParameter(objectID),
+	}, &response)
+}
+
+// GetRootCaEntityControllersParams holds the query and header parameters of GetRootCaEntityControllers. Nil and empty
+// fields are omitted.
+type GetRootCaEntityControllersParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetRootCaEntityControllers sends GET /api/v2/rootcas/{object_id}/controllers. Get Root CA entity controllers.
+//
+// Get a list, graph, or count of the principals that can control this Root CA.
+func (s *Client) GetRootCaEntityControllers(ctx context.Context, objectID string, params *GetRootCaEntityControllersParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/rootcas/" + pathParameter(objectID) + "/controllers",
+	}, &response)
+}
+
+// GetRootCaEntityControllersAll returns an iterator over every item returned by GetRootCaEntityControllers, requesting
+// each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetRootCaEntityControllersAll(ctx context.Context, objectID string, params GetRootCaEntityControllersParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetRootCaEntityControllers(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// ListSamlProvidersParams holds the query and header parameters of ListSamlProviders. Nil and empty fields are
+// omitted.
+type ListSamlProvidersParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// ListSamlProviders sends GET /api/v2/saml. List SAML Providers.
+//
+// **Deprecated**: This endpoint will no longer be supported in a future release. Please use `GET
+// /api/v2/sso-providers` instead.
+//
+// Deprecated: the route is deprecated.
+func (s *Client) ListSamlProviders(ctx context.Context, params *ListSamlProvidersParams) (ListSamlProvidersResponse, error) {
+	var response ListSamlProvidersResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/saml",
+	}, &response)
+}
+
+// CreateSamlProvider sends POST /api/v2/saml/providers. Create a New SAML Provider from Metadata.
+//
+// **Deprecated**: This endpoint will no longer be supported in a future release. Please use `POST
+// /api/v2/sso-providers/saml` instead.
+//
+// Deprecated: the route is deprecated.
+func (s *Client) CreateSamlProvider(ctx context.Context, body io.Reader, contentType string) (CreateSamlProviderResponse, error) {
+	var response CreateSamlProviderResponse
+	return response, s.do(ctx, request{
+		contentType: contentType,
+		method:      http.MethodPost,
+		path:        "/api/v2/saml/providers",
+		rawBody:     body,
+	}, &response)
+}
+
+// GetSamlProviderParams holds the query and header parameters of GetSamlProvider. Nil and empty fields are omitted.
+type GetSamlProviderParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// GetSamlProvider sends GET /api/v2/saml/providers/{saml_provider_id}. Get SAML Provider.
+//
+// **Deprecated**: This endpoint will no longer be supported in a future release. Please use `GET
+// /api/v2/sso-providers` to list all SAML providers instead.
+//
+// Deprecated: the route is deprecated.
+func (s *Client) GetSamlProvider(ctx context.Context, samlProviderID int32, params *GetSamlProviderParams) (GetSamlProviderResponse, error) {
+	var response GetSamlProviderResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/saml/providers/" + pathParameter(samlProviderID),
+	}, &response)
+}
+
+// DeleteSamlProviderParams holds the query and header parameters of DeleteSamlProvider. Nil and empty fields are
+// omitted.
+type DeleteSamlProviderParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// DeleteSamlProvider sends DELETE /api/v2/saml/providers/{saml_provider_id}. Delete a SAML Provider.
+//
+// **Deprecated**: This endpoint will no longer be supported in a future release. Please use `DELETE
+// /api/v2/sso-providers/{sso_provider_id}` instead.
+//
+// Deprecated: the route is deprecated.
+func (s *Client) DeleteSamlProvider(ctx context.Context, samlProviderID int32, params *DeleteSamlProviderParams) (DeleteSamlProviderResponse, error) {
+	var response DeleteSamlProviderResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodDelete,
+		parameters: params,
+		path:       "/api/v2/saml/providers/" + pathParameter(samlProviderID),
+	}, &response)
+}
+
+// GetSamlSignSignOnEndpointsParams holds the query and header parameters of GetSamlSignSignOnEndpoints. Nil and empty
+// fields are omitted.
+type GetSamlSignSignOnEndpointsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// GetSamlSignSignOnEndpoints sends GET /api/v2/saml/sso. Get all SAML sign on endpoints.
+//
+// **Deprecated**: This endpoint will no longer be supported in a future release. Please use `GET
+// /api/v2/sso-providers` instead to list available SSO endpoints.
+//
+// Deprecated: the route is deprecated.
+func (s *Client) GetSamlSignSignOnEndpoints(ctx context.Context, params *GetSamlSignSignOnEndpointsParams) (GetSamlSignSignOnEndpointsResponse, error) {
+	var response GetSamlSignSignOnEndpointsResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/saml/sso",
+	}, &response)
+}
+
+// ListSavedQueriesParams holds the query and header parameters of ListSavedQueries. Nil and empty fields are omitted.
+type ListSavedQueriesParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// This query parameter is used for determining the number of objects to skip in pagination.
+	Skip *int `query:"skip"`
+	// This query parameter is used for setting an upper limit of objects returned in paginated responses.
+	Limit *int `query:"limit"`
+	// Sortable columns are user_id, name, query, id, created_at, updated_at, deleted_at.
+	SortBy []string `query:"sort_by"`
+	Name   string   `query:"name"`
+	Query  string   `query:"query"`
+	UserID string   `query:"user_id"`
+	Scope  string   `query:"scope"`
+}
+
+// ListSavedQueries sends GET /api/v2/saved-queries. List saved queries.
+//
+// Get all saved queries for the current user
+func (s *Client) ListSavedQueries(ctx context.Context, params *ListSavedQueriesParams) (ListSavedQueriesResponse, error) {
+	var response ListSavedQueriesResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/saved-queries",
+	}, &response)
+}
+
+// ListSavedQueriesAll returns an iterator over every item returned by ListSavedQueries, requesting each page in turn
+// until a page shorter than the limit is returned.
+func (s *Client) ListSavedQueriesAll(ctx context.Context, params ListSavedQueriesParams) iter.Seq2[SavedQuery, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]SavedQuery, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.ListSavedQueries(ctx, &params)
+		return response.Data, err
+	})
+}
+
+// CreateSavedQueryParams holds the query and header parameters of CreateSavedQuery. Nil and empty fields are omitted.
+type CreateSavedQueryParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// CreateSavedQuery sends POST /api/v2/saved-queries. Create a saved query.
+//
+// Create a new saved query
+func (s *Client) CreateSavedQuery(ctx context.Context, body SavedQuery, params *CreateSavedQueryParams) (CreateSavedQueryResponse, error) {
+	var response CreateSavedQueryResponse
+	return response, s.do(ctx, request{
+		body:       body,
+		method:     http.MethodPost,
+		parameters: params,
+		path:       "/api/v2/saved-queries",
+	}, &response)
+}
+
+// UpdateSavedQueryParams holds the query and header parameters of UpdateSavedQuery. Nil and empty fields are omitted.
+type UpdateSavedQueryParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// UpdateSavedQuery sends PUT /api/v2/saved-queries/{saved_query_id}. Update a saved query.
+//
+// Update an existing saved query by ID
+func (s *Client) UpdateSavedQuery(ctx context.Context, savedQueryID int32, body SavedQuery, params *UpdateSavedQueryParams) (UpdateSavedQueryResponse, error) {
+	var response UpdateSavedQueryResponse
+	return response, s.do(ctx, request{
+		body:       body,
+		method:     http.MethodPut,
+		parameters: params,
+		path:       "/api/v2/saved-queries/" + pathParameter(savedQueryID),
+	}, &response)
+}
+
+// DeleteSavedQueryParams holds the query and header parameters of DeleteSavedQuery. Nil and empty fields are omitted.
+type DeleteSavedQueryParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// DeleteSavedQuery sends DELETE /api/v2/saved-queries/{saved_query_id}. Delete a saved query.
+//
+// Delete an existing saved query by ID
+func (s *Client) DeleteSavedQuery(ctx context.Context, savedQueryID int32, params *DeleteSavedQueryParams) error {
+	return s.do(ctx, request{
+		method:     http.MethodDelete,
+		parameters: params,
+		path:       "/api/v2/saved-queries/" + pathParameter(savedQueryID),
+	}, nil)
+}
+
+// ShareSavedQueryParams holds the query and header parameters of ShareSavedQuery. Nil and empty fields are omitted.
+type ShareSavedQueryParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// ShareSavedQuery sends PUT /api/v2/saved-queries/{saved_query_id}/permissions. Share a saved query or set it to
+// public.
+//
+// Shares an existing saved query or makes it public
+func (s *Client) ShareSavedQuery(ctx context.Context, savedQueryID int32, body ShareSavedQueryRequest, params *ShareSavedQueryParams) (ShareSavedQueryResponse, error) {
+	var response ShareSavedQueryResponse
+	return response, s.do(ctx, request{
+		body:       body,
+		method:     http.MethodPut,
+		parameters: params,
+		path:       "/api/v2/saved-queries/" + pathParameter(savedQueryID) + "/permissions",
+	}, &response)
+}
+
+// DeleteSavedQueryPermissionsParams holds the query and header parameters of DeleteSavedQueryPermissions. Nil and
+// empty fields are omitted.
+type DeleteSavedQueryPermissionsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// DeleteSavedQueryPermissions sends DELETE /api/v2/saved-queries/{saved_query_id}/permissions. Revokes permission of a
+// saved query from users.
+//
+// Revokes permission of a saved query from a given set of users
+func (s *Client) DeleteSavedQueryPermissions(ctx context.Context, savedQueryID int32, body DeleteSavedQueryPermissionsRequest, params *DeleteSavedQueryPermissionsParams) error {
+	return s.do(ctx, request{
+		body:       body,
+		method:     http.MethodDelete,
+		parameters: params,
+		path:       "/api/v2/saved-queries/" + pathParameter(savedQueryID) + "/permissions",
+	}, nil)
+}
+
+// SearchParams holds the query and header parameters of Search. Nil and empty fields are omitted.
+type SearchParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Free-form search text. Each whitespace separated term must match a searchable property of a node.
+	Q string `query:"q"`
+	// Node type. Some AD examples: `Base`, `User`, `Computer`, `Group`, `Container`. Some Azure examples: `AZBase`,
+	// `AZApp`, `AZDevice`.
+	Type *string `query:"type"`
+	// This query parameter is used for determining the number of objects to skip in pagination.
+	Skip *int `query:"skip"`
+	// This query parameter is used for setting an upper limit of objects returned in paginated responses.
+	Limit *int `query:"limit"`
+}
+
+// Search sends GET /api/v2/search. Search for objects.
+//
+// Search for graph objects, filtered by type. Every term of the search must match at least one of the object's name,
+// object ID, display name, description, email, SAM account name, distinguished name, user principal name or service
+// principal names. Results are ranked by relevance and include the properties that matched with each matching term
+// wrapped in `<mark>` tags. Property values are HTML escaped.
+func (s *Client) Search(ctx context.Context, params *SearchParams) (SearchResponse, error) {
+	var response SearchResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/search",
+	}, &response)
+}
+
+// SearchAll returns an iterator over every item returned by Search, requesting each page in turn until a page shorter
+// than the limit is returned.
+func (s *Client) SearchAll(ctx context.Context, params SearchParams) iter.Seq2[SearchResult, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]SearchResult, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.Search(ctx, &params)
+		return response.Data, err
+	})
+}
+
+// GetSelfParams holds the query and header parameters of GetSelf. Nil and empty fields are omitted.
+type GetSelfParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// GetSelf sends GET /api/v2/self. Get self.
+//
+// Get the currently authenticated requester details. For Community, this will only ever be valid for users. In
+// Enterprise, this could be either a BloodHound user or a client (collector).
+func (s *Client) GetSelf(ctx context.Context, params *GetSelfParams) (ResponseAuthenticatedRequester, error) {
+	var response ResponseAuthenticatedRequester
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/self",
+	}, &response)
+}
+
+// GetApiSpecParams holds the query and header parameters of GetApiSpec. Nil and empty fields are omitted.
+type GetApiSpecParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// GetApiSpec sends GET /api/v2/spec/openapi.yaml. Get API Spec.
+//
+// Returns an Open API 3.0 compatible BloodHound API spec
+func (s *Client) GetApiSpec(ctx context.Context, params *GetApiSpecParams) (io.ReadCloser, error) {
+	return s.stream(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/spec/openapi.yaml",
+	})
+}
+
+// ListAuthProvidersParams holds the query and header parameters of ListAuthProviders. Nil and empty fields are
+// omitted.
+type ListAuthProvidersParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// ListAuthProviders sends GET /api/v2/sso-providers. List SSO Providers.
+//
+// Lists all available SSO providers (SAML and OIDC)
+func (s *Client) ListAuthProviders(ctx context.Context, params *ListAuthProvidersParams) (ListAuthProvidersResponse, error) {
+	var response ListAuthProvidersResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/sso-providers",
+	}, &response)
+}
+
+// CreateOIDCProviderParams holds the query and header parameters of CreateOIDCProvider. Nil and empty fields are
+// omitted.
+type CreateOIDCProviderParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// CreateOIDCProvider sends POST /api/v2/sso-providers/oidc. Create OIDC Provider.
+//
+// Creates a new OIDC provider for SSO authentication
+func (s *Client) CreateOIDCProvider(ctx context.Context, body CreateOIDCProviderRequest, params *CreateOIDCProviderParams) (CreateOIDCProviderResponse, error) {
+	var response CreateOIDCProviderResponse
+	return response, s.do(ctx, request{
+		body:       body,
+		method:     http.MethodPost,
+		parameters: params,
+		path:       "/api/v2/sso-providers/oidc",
+	}, &response)
+}
+
+// CreateSSOSAMLProvider sends POST /api/v2/sso-providers/saml. Create a New SAML Provider from Metadata.
+//
+// Creates a new SAML provider with the given name and metadata XML.
+func (s *Client) CreateSSOSAMLProvider(ctx context.Context, body io.Reader, contentType string) (CreateSSOSAMLProviderResponse, error) {
+	var response CreateSSOSAMLProviderResponse
+	return response, s.do(ctx, request{
+		contentType: contentType,
+		method:      http.MethodPost,
+		path:        "/api/v2/sso-providers/saml",
+		rawBody:     body,
+	}, &response)
+}
+
+// DeleteSSOProviderParams holds the query and header parameters of DeleteSSOProvider. Nil and empty fields are
+// omitted.
+type DeleteSSOProviderParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// DeleteSSOProvider sends DELETE /api/v2/sso-providers/{sso_provider_id}. Delete SSO Provider.
+//
+// Deletes an existing SSO provider
+func (s *Client) DeleteSSOProvider(ctx context.Context, ssoProviderID int32, params *DeleteSSOProviderParams) (DeleteSSOProviderResponse, error) {
+	var response DeleteSSOProviderResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodDelete,
+		parameters: params,
+		path:       "/api/v2/sso-providers/" + pathParameter(ssoProviderID),
+	}, &response)
+}
+
+// PatchSSOProviderParams holds the query and header parameters of PatchSSOProvider. Nil and empty fields are omitted.
+type PatchSSOProviderParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// PatchSSOProvider sends PATCH /api/v2/sso-providers/{sso_provider_id}. Update SSO Provider.
+//
+// Updates an existing SSO provider. Updating saml provider requires a "multipart/form-data" body. Updating oidc
+// provider requires "application/json" body. Response is respective provider
+func (s *Client) PatchSSOProvider(ctx context.Context, ssoProviderID int32, body PatchSSOProviderRequest, params *PatchSSOProviderParams) (json.RawMessage, error) {
+	var response json.RawMessage
+	return response, s.do(ctx, request{
+		body:       body,
+		method:     http.MethodPatch,
+		parameters: params,
+		path:       "/api/v2/sso-providers/" + pathParameter(ssoProviderID),
+	}, &response)
+}
+
+// GetSSOProviderSAMLSigningCertificateParams holds the query and header parameters of
+// GetSSOProviderSAMLSigningCertificate. Nil and empty fields are omitted.
+type GetSSOProviderSAMLSigningCertificateParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// GetSSOProviderSAMLSigningCertificate sends GET /api/v2/sso-providers/{sso_provider_id}/signing-certificate. Get SAML
+// Provider Signing Certificate.
+//
+// Download the SAML Provider Signing Certificate. Only applies to SAML providers.
+func (s *Client) GetSSOProviderSAMLSigningCertificate(ctx context.Context, ssoProviderID int32, params *GetSSOProviderSAMLSigningCertificateParams) (io.ReadCloser, error) {
+	return s.stream(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/sso-providers/" + pathParameter(ssoProviderID) + "/signing-certificate",
+	})
+}
+
+// ListAuthTokensParams holds the query and header parameters of ListAuthTokens. Nil and empty fields are omitted.
+type ListAuthTokensParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Provide a user id to filter tokens by. This filter is only honored for Admin users.
+	UserID string `query:"user_id"`
+	// Sortable columns are `user_id`, `client_id`, `name`, `last_access`, `created_at`, `updated_at`, `deleted_at`.
+	SortBy     []string `query:"sort_by"`
+	Name       string   `query:"name"`
+	Key        string   `query:"key"`
+	HmacMethod string   `query:"hmac_method"`
+	LastAccess string   `query:"last_access"`
+	ID         string   `query:"id"`
+	// Filter results by `created_at` value. See filter schema details for valid predicates.
+	CreatedAt string `query:"created_at"`
+	// Filter results by `updated_at` value. See filter schema details for valid predicates.
+	UpdatedAt string `query:"updated_at"`
+	// Filter results by `deleted_at` value. See filter schema details for valid predicates.
+	DeletedAt string `query:"deleted_at"`
+}
+
+// ListAuthTokens sends GET /api/v2/tokens. List Auth Tokens.
+//
+// Get all auth tokens.
+func (s *Client) ListAuthTokens(ctx context.Context, params *ListAuthTokensParams) (ListAuthTokensResponse, error) {
+	var response ListAuthTokensResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/tokens",
+	}, &response)
+}
+
+// CreateAuthTokenParams holds the query and header parameters of CreateAuthToken. Nil and empty fields are omitted.
+type CreateAuthTokenParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// CreateAuthToken sends POST /api/v2/tokens. Create Token for User.
+//
+// Create a new token to use with request signing based authentication for a given user.
+func (s *Client) CreateAuthToken(ctx context.Context, body CreateAuthTokenRequest, params *CreateAuthTokenParams) (CreateAuthTokenResponse, error) {
+	var response CreateAuthTokenResponse
+	return response, s.do(ctx, request{
+		body:       body,
+		method:     http.MethodPost,
+		parameters: params,
+		path:       "/api/v2/tokens",
+	}, &response)
+}
+
+// DeleteAuthTokenParams holds the query and header parameters of DeleteAuthToken. Nil and empty fields are omitted.
+type DeleteAuthTokenParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// DeleteAuthToken sends DELETE /api/v2/tokens/{token_id}. Delete a User Token.
+//
+// Delete a request signing token for a given user.
+func (s *Client) DeleteAuthToken(ctx context.Context, tokenID string, params *DeleteAuthTokenParams) error {
+	return s.do(ctx, request{
+		method:     http.MethodDelete,
+		parameters: params,
+		path:       "/api/v2/tokens/" + pathParameter(tokenID),
+	}, nil)
+}
+
+// GetUserEntityParams holds the query and header parameters of GetUserEntity. Nil and empty fields are omitted.
+type GetUserEntityParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// Include counts of related entities. Default value is `true`.
+	Counts *bool `query:"counts"`
+}
+
+// GetUserEntity sends GET /api/v2/users/{object_id}. Get User entity info.
+//
+// Get info and counts for this User node.
+func (s *Client) GetUserEntity(ctx context.Context, objectID string, params *GetUserEntityParams) (EntityInfoQueryResults, error) {
+	var response EntityInfoQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/users/" + pathParameter(objectID),
+	}, &response)
+}
+
+// GetUserEntityAdminRightsParams holds the query and header parameters of GetUserEntityAdminRights. Nil and empty
+// fields are omitted.
+type GetUserEntityAdminRightsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetUserEntityAdminRights sends GET /api/v2/users/{object_id}/admin-rights. Get User entity admin rights.
+//
+// Get a list, graph, or count of the systems this user has admin rights to.
+func (s *Client) GetUserEntityAdminRights(ctx context.Context, objectID string, params *GetUserEntityAdminRightsParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/users/" + pathParameter(objectID) + "/admin-rights",
+	}, &response)
+}
+
+// GetUserEntityAdminRightsAll returns an iterator over every item returned by GetUserEntityAdminRights, requesting
+// each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetUserEntityAdminRightsAll(ctx context.Context, objectID string, params GetUserEntityAdminRightsParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetUserEntityAdminRights(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetUserEntityConstrainedDelegationRightsParams holds the query and header parameters of
+// GetUserEntityConstrainedDelegationRights. Nil and empty fields are omitted.
+type GetUserEntityConstrainedDelegationRightsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetUserEntityConstrainedDelegationRights sends GET /api/v2/users/{object_id}/constrained-delegation-rights. Get User
+// entity constrained delegation rights.
+//
+// Get a list, graph, or count of the systems this user has constrained delegation rights to.
+func (s *Client) GetUserEntityConstrainedDelegationRights(ctx context.Context, objectID string, params *GetUserEntityConstrainedDelegationRightsParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/users/" + pathParameter(objectID) + "/constrained-delegation-rights",
+	}, &response)
+}
+
+// GetUserEntityConstrainedDelegationRightsAll returns an iterator over every item returned by
+// GetUserEntityConstrainedDelegationRights, requesting each page in turn until a page shorter than the limit is
+// returned.
+func (s *Client) GetUserEntityConstrainedDelegationRightsAll(ctx context.Context, objectID string, params GetUserEntityConstrainedDelegationRightsParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetUserEntityConstrainedDelegationRights(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetUserEntityControllablesParams holds the query and header parameters of GetUserEntityControllables. Nil and empty
+// fields are omitted.
+type GetUserEntityControllablesParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetUserEntityControllables sends GET /api/v2/users/{object_id}/controllables. Get User entity controllables.
+//
+// Get a list, graph, or count of the principals this user can control.
+func (s *Client) GetUserEntityControllables(ctx context.Context, objectID string, params *GetUserEntityControllablesParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/users/" + pathParameter(objectID) + "/controllables",
+	}, &response)
+}
+
+// GetUserEntityControllablesAll returns an iterator over every item returned by GetUserEntityControllables, requesting
+// each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetUserEntityControllablesAll(ctx context.Context, objectID string, params GetUserEntityControllablesParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetUserEntityControllables(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetUserEntityControllersParams holds the query and header parameters of GetUserEntityControllers. Nil and empty
+// fields are omitted.
+type GetUserEntityControllersParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetUserEntityControllers sends GET /api/v2/users/{object_id}/controllers. Get User entity controllers.
+//
+// Get a list, graph, or count of the principals that can control this User.
+func (s *Client) GetUserEntityControllers(ctx context.Context, objectID string, params *GetUserEntityControllersParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/users/" + pathParameter(objectID) + "/controllers",
+	}, &response)
+}
+
+// GetUserEntityControllersAll returns an iterator over every item returned by GetUserEntityControllers, requesting
+// each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetUserEntityControllersAll(ctx context.Context, objectID string, params GetUserEntityControllersParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetUserEntityControllers(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetUserEntityDcomRightsParams holds the query and header parameters of GetUserEntityDcomRights. Nil and empty fields
+// are omitted.
+type GetUserEntityDcomRightsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetUserEntityDcomRights sends GET /api/v2/users/{object_id}/dcom-rights. Get User entity DCOM rights.
+//
+// Get a list, graph, or count of the systems this user can execute DCOM on.
+func (s *Client) GetUserEntityDcomRights(ctx context.Context, objectID string, params *GetUserEntityDcomRightsParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/users/" + pathParameter(objectID) + "/dcom-rights",
+	}, &response)
+}
+
+// GetUserEntityDcomRightsAll returns an iterator over every item returned by GetUserEntityDcomRights, requesting each
+// page in turn until a page shorter than the limit is returned.
+func (s *Client) GetUserEntityDcomRightsAll(ctx context.Context, objectID string, params GetUserEntityDcomRightsParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetUserEntityDcomRights(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetUserEntityMembershipParams holds the query and header parameters of GetUserEntityMembership. Nil and empty fields
+// are omitted.
+type GetUserEntityMembershipParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetUserEntityMembership sends GET /api/v2/users/{object_id}/memberships. Get User entity membership.
+//
+// Get a list, graph, or count of the groups this user is a member of.
+func (s *Client) GetUserEntityMembership(ctx context.Context, objectID string, params *GetUserEntityMembershipParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/users/" + pathParameter(objectID) + "/memberships",
+	}, &response)
+}
+
+// GetUserEntityMembershipAll returns an iterator over every item returned by GetUserEntityMembership, requesting each
+// page in turn until a page shorter than the limit is returned.
+func (s *Client) GetUserEntityMembershipAll(ctx context.Context, objectID string, params GetUserEntityMembershipParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetUserEntityMembership(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetUserEntityPsRemoteRightsParams holds the query and header parameters of GetUserEntityPsRemoteRights. Nil and
+// empty fields are omitted.
+type GetUserEntityPsRemoteRightsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetUserEntityPsRemoteRights sends GET /api/v2/users/{object_id}/ps-remote-rights. Get User entity PowerShell remote
+// rights.
+//
+// Get a list, graph, or count of the systems this user can execute PowerShell remote on.
+func (s *Client) GetUserEntityPsRemoteRights(ctx context.Context, objectID string, params *GetUserEntityPsRemoteRightsParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/users/" + pathParameter(objectID) + "/ps-remote-rights",
+	}, &response)
+}
+
+// GetUserEntityPsRemoteRightsAll returns an iterator over every item returned by GetUserEntityPsRemoteRights,
+// requesting each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetUserEntityPsRemoteRightsAll(ctx context.Context, objectID string, params GetUserEntityPsRemoteRightsParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetUserEntityPsRemoteRights(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetUserEntityRdpRightsParams holds the query and header parameters of GetUserEntityRdpRights. Nil and empty fields
+// are omitted.
+type GetUserEntityRdpRightsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetUserEntityRdpRights sends GET /api/v2/users/{object_id}/rdp-rights. Get User entity RDP rights.
+//
+// Get a list, graph, or count of the systems this user has RDP rights to.
+func (s *Client) GetUserEntityRdpRights(ctx context.Context, objectID string, params *GetUserEntityRdpRightsParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/users/" + pathParameter(objectID) + "/rdp-rights",
+	}, &response)
+}
+
+// GetUserEntityRdpRightsAll returns an iterator over every item returned by GetUserEntityRdpRights, requesting each
+// page in turn until a page shorter than the limit is returned.
+func (s *Client) GetUserEntityRdpRightsAll(ctx context.Context, objectID string, params GetUserEntityRdpRightsParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetUserEntityRdpRights(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetUserEntitySessionsParams holds the query and header parameters of GetUserEntitySessions. Nil and empty fields are
+// omitted.
+type GetUserEntitySessionsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetUserEntitySessions sends GET /api/v2/users/{object_id}/sessions. Get User entity sessions.
+//
+// Get a list, graph, or count of the systems this user has an active session on.
+func (s *Client) GetUserEntitySessions(ctx context.Context, objectID string, params *GetUserEntitySessionsParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/users/" + pathParameter(objectID) + "/sessions",
+	}, &response)
+}
+
+// GetUserEntitySessionsAll returns an iterator over every item returned by GetUserEntitySessions, requesting each page
+// in turn until a page shorter than the limit is returned.
+func (s *Client) GetUserEntitySessionsAll(ctx context.Context, objectID string, params GetUserEntitySessionsParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetUserEntitySessions(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// GetUserEntitySqlAdminRightsParams holds the query and header parameters of GetUserEntitySqlAdminRights. Nil and
+// empty fields are omitted.
+type GetUserEntitySqlAdminRightsParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+	// The number of entries to skip for pagination. Only available for `type=list`.
+	Skip *int `query:"skip"`
+	// The number of entries to limit in the response. Only available for `type=list`.
+	Limit *int `query:"limit"`
+	// The type of return data requested. If no type is provided, query will default to `list`. The only supported type is
+	// `list`, but the unsupported `graph` type can be used. Some entity query endpoints do not support the `graph` type.
+	// For those interested in using the undocumented graph type parameter, the response type is described in the schema
+	// `model.bh-graph.graph`.
+	Type *string `query:"type"`
+	// Sort by column. Can be used multiple times; prepend a hyphen for descending order. Columns available for sorting are
+	// dependent on the entity object kind.
+	SortBy []string `query:"sort_by"`
+}
+
+// GetUserEntitySqlAdminRights sends GET /api/v2/users/{object_id}/sql-admin-rights. Get User entity SQL admin rights.
+//
+// Get a list, graph, or count of the systems this user has SQL admin rights to.
+func (s *Client) GetUserEntitySqlAdminRights(ctx context.Context, objectID string, params *GetUserEntitySqlAdminRightsParams) (RelatedEntityQueryResults, error) {
+	var response RelatedEntityQueryResults
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/users/" + pathParameter(objectID) + "/sql-admin-rights",
+	}, &response)
+}
+
+// GetUserEntitySqlAdminRightsAll returns an iterator over every item returned by GetUserEntitySqlAdminRights,
+// requesting each page in turn until a page shorter than the limit is returned.
+func (s *Client) GetUserEntitySqlAdminRightsAll(ctx context.Context, objectID string, params GetUserEntitySqlAdminRightsParams) iter.Seq2[PagedNodeListEntry, error] {
+	return paginate(params.Skip, params.Limit, func(skip, limit int) ([]PagedNodeListEntry, error) {
+		params.Skip, params.Limit = &skip, &limit
+
+		response, err := s.GetUserEntitySqlAdminRights(ctx, objectID, &params)
+		return response.Data, err
+	})
+}
+
+// ListWorkspacesParams holds the query and header parameters of ListWorkspaces. Nil and empty fields are omitted.
+type ListWorkspacesParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// ListWorkspaces sends GET /api/v2/workspaces. List workspaces.
+//
+// Lists the workspaces the requesting user has been granted access to. Users with the `ManageUsers` permission are
+// shown all workspaces.
+func (s *Client) ListWorkspaces(ctx context.Context, params *ListWorkspacesParams) (ListWorkspacesResponse, error) {
+	var response ListWorkspacesResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/workspaces",
+	}, &response)
+}
+
+// CreateWorkspaceParams holds the query and header parameters of CreateWorkspace. Nil and empty fields are omitted.
+type CreateWorkspaceParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// CreateWorkspace sends POST /api/v2/workspaces. Create a workspace.
+//
+// Creates a workspace backed by its own isolated graph. Requests select a workspace either with the `Workspace` header
+// set to the workspace ID or by prefixing the request path with `/workspaces/{workspace_id}`, for example
+// `/workspaces/1/api/v2/graph-search`. Requests that do not select a workspace operate on the default graph.
+// Workspaces require the PostgreSQL graph driver. Asset group, asset group tag, data quality and database completeness
+// requests are backed by application data that is shared by all graphs and are rejected when a workspace is selected.
+func (s *Client) CreateWorkspace(ctx context.Context, body CreateWorkspaceRequest, params *CreateWorkspaceParams) (CreateWorkspaceResponse, error) {
+	var response CreateWorkspaceResponse
+	return response, s.do(ctx, request{
+		body:       body,
+		method:     http.MethodPost,
+		parameters: params,
+		path:       "/api/v2/workspaces",
+	}, &response)
+}
+
+// DeleteWorkspaceParams holds the query and header parameters of DeleteWorkspace. Nil and empty fields are omitted.
+type DeleteWorkspaceParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// DeleteWorkspace sends DELETE /api/v2/workspaces/{workspace_id}. Delete a workspace.
+//
+// Deletes a workspace along with its user grants, its file upload jobs and all of its graph data.
+func (s *Client) DeleteWorkspace(ctx context.Context, workspaceID int32, params *DeleteWorkspaceParams) error {
+	return s.do(ctx, request{
+		method:     http.MethodDelete,
+		parameters: params,
+		path:       "/api/v2/workspaces/" + pathParameter(workspaceID),
+	}, nil)
+}
+
+// ListWorkspaceUsersParams holds the query and header parameters of ListWorkspaceUsers. Nil and empty fields are
+// omitted.
+type ListWorkspaceUsersParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// ListWorkspaceUsers sends GET /api/v2/workspaces/{workspace_id}/users. List workspace users.
+//
+// Lists the IDs of the users granted access to a workspace
+func (s *Client) ListWorkspaceUsers(ctx context.Context, workspaceID int32, params *ListWorkspaceUsersParams) (ListWorkspaceUsersResponse, error) {
+	var response ListWorkspaceUsersResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/v2/workspaces/" + pathParameter(workspaceID) + "/users",
+	}, &response)
+}
+
+// AddWorkspaceUsersParams holds the query and header parameters of AddWorkspaceUsers. Nil and empty fields are
+// omitted.
+type AddWorkspaceUsersParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// AddWorkspaceUsers sends PUT /api/v2/workspaces/{workspace_id}/users. Grant users access to a workspace.
+//
+// Grants a given set of users access to a workspace
+func (s *Client) AddWorkspaceUsers(ctx context.Context, workspaceID int32, body AddWorkspaceUsersRequest, params *AddWorkspaceUsersParams) error {
+	return s.do(ctx, request{
+		body:       body,
+		method:     http.MethodPut,
+		parameters: params,
+		path:       "/api/v2/workspaces/" + pathParameter(workspaceID) + "/users",
+	}, nil)
+}
+
+// RemoveWorkspaceUsersParams holds the query and header parameters of RemoveWorkspaceUsers. Nil and empty fields are
+// omitted.
+type RemoveWorkspaceUsersParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// RemoveWorkspaceUsers sends DELETE /api/v2/workspaces/{workspace_id}/users. Revoke users' access to a workspace.
+//
+// Revokes a given set of users' access to a workspace
+func (s *Client) RemoveWorkspaceUsers(ctx context.Context, workspaceID int32, body RemoveWorkspaceUsersRequest, params *RemoveWorkspaceUsersParams) error {
+	return s.do(ctx, request{
+		body:       body,
+		method:     http.MethodDelete,
+		parameters: params,
+		path:       "/api/v2/workspaces/" + pathParameter(workspaceID) + "/users",
+	}, nil)
+}
+
+// GetApiVersionParams holds the query and header parameters of GetApiVersion. Nil and empty fields are omitted.
+type GetApiVersionParams struct {
+	// Prefer header, used to specify a custom timeout in seconds using the wait parameter as per RFC7240.
+	Prefer *int `header:"Prefer"`
+}
+
+// GetApiVersion sends GET /api/version. Get API version.
+//
+// Returns the supported API versions.
+func (s *Client) GetApiVersion(ctx context.Context, params *GetApiVersionParams) (GetApiVersionResponse, error) {
+	var response GetApiVersionResponse
+	return response, s.do(ctx, request{
+		method:     http.MethodGet,
+		parameters: params,
+		path:       "/api/version",
+	}, &response)
+}
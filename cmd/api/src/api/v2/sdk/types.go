@@ -0,0 +1,1768 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by schemagen. DO NOT EDIT!
+// OpenAPI source: github.com/specterops/bloodhound/-/tree/main/packages/go/openapi/src/
+
+package sdk
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type ResponsePagination struct {
+	Count int `json:"count"`
+	Limit int `json:"limit"`
+	Skip  int `json:"skip"`
+}
+
+type ResponseTimeWindow struct {
+	End   time.Time `json:"end"`
+	Start time.Time `json:"start"`
+}
+
+type ComponentsInt32ID struct {
+	ID int32 `json:"id"`
+}
+
+type ComponentsTimestamps struct {
+	CreatedAt time.Time           `json:"created_at"`
+	DeletedAt Nullable[time.Time] `json:"deleted_at"`
+	UpdatedAt time.Time           `json:"updated_at"`
+}
+
+type ADDataQualityStat struct {
+	ComponentsInt32ID
+	ComponentsTimestamps
+
+	Acls                   int     `json:"acls"`
+	Aiacas                 int     `json:"aiacas"`
+	Certtemplates          int     `json:"certtemplates"`
+	Computers              int     `json:"computers"`
+	Containers             int     `json:"containers"`
+	DomainSID              string  `json:"domain_sid"`
+	Enterprisecas          int     `json:"enterprisecas"`
+	Gpos                   int     `json:"gpos"`
+	Groups                 int     `json:"groups"`
+	LocalGroupCompleteness float64 `json:"local_group_completeness"`
+	Ntauthstores           int     `json:"ntauthstores"`
+	Ous                    int     `json:"ous"`
+	Relationships          int     `json:"relationships"`
+	Rootcas                int     `json:"rootcas"`
+	RunID                  string  `json:"run_id"`
+	SessionCompleteness    float64 `json:"session_completeness"`
+	Sessions               int     `json:"sessions"`
+	Users                  int     `json:"users"`
+}
+
+type GetAdDomainDataQualityStatsResponse struct {
+	ResponsePagination
+	ResponseTimeWindow
+
+	Data []ADDataQualityStat `json:"data,omitempty"`
+}
+
+type EntityInfoQueryResultsData struct {
+	Props map[string]map[string]any `json:"props,omitempty"`
+}
+
+type EntityInfoQueryResults struct {
+	Data EntityInfoQueryResultsData `json:"data"`
+}
+
+type PagedNodeListEntry struct {
+	Label    string `json:"label"`
+	Name     string `json:"name"`
+	ObjectID string `json:"objectID"`
+}
+
+type RelatedEntityQueryResults struct {
+	ResponsePagination
+
+	Data []PagedNodeListEntry `json:"data,omitempty"`
+}
+
+type ComponentsInt64ID struct {
+	ID int64 `json:"id"`
+}
+
+type AnalysisRunStepsItem struct {
+	DurationMs  int64     `json:"duration_ms"`
+	Error       string    `json:"error"`
+	Name        string    `json:"name"`
+	StartedAt   time.Time `json:"started_at"`
+	WorkspaceID int32     `json:"workspace_id"`
+}
+
+type AnalysisRun struct {
+	ComponentsInt64ID
+	ComponentsTimestamps
+
+	CompletedAt  Nullable[time.Time]    `json:"completed_at"`
+	EdgesCreated map[string]int64       `json:"edges_created,omitempty"`
+	EdgesDeleted map[string]int64       `json:"edges_deleted,omitempty"`
+	Errors       []string               `json:"errors,omitempty"`
+	RequestedBy  string                 `json:"requested_by"`
+	StartedAt    time.Time              `json:"started_at"`
+	Status       string                 `json:"status"`
+	Steps        []AnalysisRunStepsItem `json:"steps,omitempty"`
+	Trigger      string                 `json:"trigger"`
+}
+
+type ListAnalysisRunsResponse struct {
+	ResponsePagination
+
+	Data []AnalysisRun `json:"data,omitempty"`
+}
+
+type SimulateRemediationRequestNodeChangesItem struct {
+	ObjectID   string         `json:"object_id"`
+	Properties map[string]any `json:"properties,omitempty"`
+}
+
+type SimulateRemediationRequestRemovedEdgesItem struct {
+	Kind   string `json:"kind"`
+	Source string `json:"source"`
+	Target string `json:"target"`
+}
+
+type SimulateRemediationRequest struct {
+	Limit        int                                          `json:"limit"`
+	NodeChanges  []SimulateRemediationRequestNodeChangesItem  `json:"node_changes,omitempty"`
+	RemovedEdges []SimulateRemediationRequestRemovedEdgesItem `json:"removed_edges,omitempty"`
+}
+
+type UnifiedGraphNode struct {
+	IsOwnedObject bool                      `json:"isOwnedObject"`
+	IsTierZero    bool                      `json:"isTierZero"`
+	Kind          string                    `json:"kind"`
+	Label         string                    `json:"label"`
+	LastSeen      time.Time                 `json:"lastSeen"`
+	ObjectID      string                    `json:"objectId"`
+	Properties    map[string]map[string]any `json:"properties,omitempty"`
+}
+
+type SimulateRemediationResponseData struct {
+	DerivedEdges             int                `json:"derived_edges"`
+	LostPrincipals           []UnifiedGraphNode `json:"lost_principals,omitempty"`
+	PrincipalsLosingPath     int                `json:"principals_losing_path"`
+	PrincipalsWithPathAfter  int                `json:"principals_with_path_after"`
+	PrincipalsWithPathBefore int                `json:"principals_with_path_before"`
+	RemovedEdges             int                `json:"removed_edges"`
+}
+
+type SimulateRemediationResponse struct {
+	Data SimulateRemediationResponseData `json:"data"`
+}
+
+type AssetGroupTag struct {
+	CreatedAt      time.Time           `json:"created_at"`
+	CreatedBy      string              `json:"created_by"`
+	DeletedAt      Nullable[time.Time] `json:"deleted_at"`
+	DeletedBy      Nullable[string]    `json:"deleted_by"`
+	Description    string              `json:"description"`
+	ID             int                 `json:"id"`
+	KindID         int                 `json:"kind_id"`
+	Name           string              `json:"name"`
+	Position       Nullable[int32]     `json:"position"`
+	RequireCertify Nullable[bool]      `json:"require_certify"`
+	Type           int                 `json:"type"`
+	UpdatedAt      time.Time           `json:"updated_at"`
+	UpdatedBy      string              `json:"updated_by"`
+}
+
+type GetAssetGroupTagsResponseData struct {
+	Tags []AssetGroupTag `json:"tags,omitempty"`
+}
+
+type GetAssetGroupTagsResponse struct {
+	Data GetAssetGroupTagsResponseData `json:"data"`
+}
+
+type CreateAssetGroupTagRequest struct {
+	Description    string          `json:"description"`
+	Name           string          `json:"name"`
+	Position       Nullable[int32] `json:"position"`
+	RequireCertify Nullable[bool]  `json:"require_certify"`
+	Type           int             `json:"type"`
+}
+
+type CreateAssetGroupTagResponseData struct {
+	Tag AssetGroupTag `json:"tag"`
+}
+
+type CreateAssetGroupTagResponse struct {
+	Data CreateAssetGroupTagResponseData `json:"data"`
+}
+
+type GetAssetGroupTagResponseData struct {
+	Tag AssetGroupTag `json:"tag"`
+}
+
+type GetAssetGroupTagResponse struct {
+	Data GetAssetGroupTagResponseData `json:"data"`
+}
+
+type UpdateAssetGroupTagRequest struct {
+	Description    Nullable[string] `json:"description"`
+	Position       Nullable[int32]  `json:"position"`
+	RequireCertify Nullable[bool]   `json:"require_certify"`
+}
+
+type UpdateAssetGroupTagResponseData struct {
+	Tag AssetGroupTag `json:"tag"`
+}
+
+type UpdateAssetGroupTagResponse struct {
+	Data UpdateAssetGroupTagResponseData `json:"data"`
+}
+
+// The certification status of a member of a tier. Members without a certification are `pending`.
+type AssetGroupCertificationStatus string
+
+const (
+	AssetGroupCertificationStatusPending   AssetGroupCertificationStatus = "pending"
+	AssetGroupCertificationStatusCertified AssetGroupCertificationStatus = "certified"
+	AssetGroupCertificationStatusRevoked   AssetGroupCertificationStatus = "revoked"
+)
+
+type AssetGroupTagMember struct {
+	CertifiedAt Nullable[time.Time]           `json:"certified_at"`
+	CertifiedBy Nullable[string]              `json:"certified_by"`
+	Name        string                        `json:"name"`
+	Note        Nullable[string]              `json:"note"`
+	ObjectID    string                        `json:"object_id"`
+	PrimaryKind string                        `json:"primary_kind"`
+	Status      AssetGroupCertificationStatus `json:"status"`
+}
+
+type GetAssetGroupTagMembersResponseData struct {
+	Members []AssetGroupTagMember `json:"members,omitempty"`
+}
+
+type GetAssetGroupTagMembersResponse struct {
+	ResponsePagination
+
+	Data GetAssetGroupTagMembersResponseData `json:"data"`
+}
+
+type ListAssetGroupTagMemberCountByKindResponseData struct {
+	Counts     map[string]int `json:"counts,omitempty"`
+	TotalCount int            `json:"total_count"`
+}
+
+type ListAssetGroupTagMemberCountByKindResponse struct {
+	Data ListAssetGroupTagMemberCountByKindResponseData `json:"data"`
+}
+
+type UpdateAssetGroupTagMemberCertificationRequest struct {
+	Note   string                        `json:"note"`
+	Status AssetGroupCertificationStatus `json:"status"`
+}
+
+type AssetGroupTagCertification struct {
+	AssetGroupTagID int32                         `json:"asset_group_tag_id"`
+	CertifiedAt     Nullable[time.Time]           `json:"certified_at"`
+	CertifiedBy     Nullable[string]              `json:"certified_by"`
+	CreatedAt       time.Time                     `json:"created_at"`
+	ID              int64                         `json:"id"`
+	Note            Nullable[string]              `json:"note"`
+	ObjectID        string                        `json:"object_id"`
+	Status          AssetGroupCertificationStatus `json:"status"`
+	UpdatedAt       time.Time                     `json:"updated_at"`
+}
+
+type UpdateAssetGroupTagMemberCertificationResponse struct {
+	Data AssetGroupTagCertification `json:"data"`
+}
+
+type AssetGroupTagsSelectorSeedRequest struct {
+	Type  int    `json:"type"`
+	Value string `json:"value"`
+}
+
+type AssetGroupTagsSelectorRequest struct {
+	AutoCertify bool                                `json:"auto_certify"`
+	Description string                              `json:"description"`
+	Name        string                              `json:"name"`
+	Seeds       []AssetGroupTagsSelectorSeedRequest `json:"seeds,omitempty"`
+}
+
+type AssetGroupTagsSelectorSeedResponse struct {
+	AssetGroupTagsSelectorSeedRequest
+
+	SelectorID int `json:"selector_id"`
+}
+
+type AssetGroupTagsSelectorResponse struct {
+	AssetGroupTagsSelectorRequest
+
+	AllowDisable    bool                                 `json:"allow_disable"`
+	AssetGroupTagID int                                  `json:"asset_group_tag_id"`
+	CreatedAt       time.Time                            `json:"created_at"`
+	CreatedBy       string                               `json:"created_by"`
+	DisabledAt      Nullable[time.Time]                  `json:"disabled_at"`
+	DisabledBy      Nullable[string]                     `json:"disabled_by"`
+	ID              int                                  `json:"id"`
+	IsDefault       bool                                 `json:"is_default"`
+	Seeds           []AssetGroupTagsSelectorSeedResponse `json:"seeds,omitempty"`
+	UpdatedAt       time.Time                            `json:"updated_at"`
+	UpdatedBy       string                               `json:"updated_by"`
+}
+
+type GetAssetGroupTagSelectorsResponseData struct {
+	Selectors []AssetGroupTagsSelectorResponse `json:"selectors,omitempty"`
+}
+
+type GetAssetGroupTagSelectorsResponse struct {
+	Data GetAssetGroupTagSelectorsResponseData `json:"data"`
+}
+
+type CreateAssetGroupTagSelectorRequest struct {
+	AssetGroupTagsSelectorRequest
+}
+
+type CreateAssetGroupTagSelectorResponse struct {
+	Data AssetGroupTagsSelectorResponse `json:"data"`
+}
+
+type UpdateAssetGroupTagSelectorRequest struct {
+	AssetGroupTagsSelectorRequest
+
+	DisabledAt Nullable[time.Time] `json:"disabled_at"`
+}
+
+type UpdateAssetGroupTagSelectorResponse struct {
+	Data AssetGroupTagsSelectorResponse `json:"data"`
+}
+
+type AssetGroupSelector struct {
+	ComponentsInt32ID
+	ComponentsTimestamps
+
+	AssetGroupID   int32  `json:"asset_group_id"`
+	Name           string `json:"name"`
+	Selector       string `json:"selector"`
+	SystemSelector bool   `json:"system_selector"`
+}
+
+type AssetGroup struct {
+	ComponentsInt32ID
+	ComponentsTimestamps
+
+	Selectors   []AssetGroupSelector `json:"Selectors,omitempty"`
+	MemberCount int                  `json:"member_count"`
+	Name        string               `json:"name"`
+	SystemGroup bool                 `json:"system_group"`
+	Tag         string               `json:"tag"`
+}
+
+type ListAssetGroupsResponseData struct {
+	AssetGroups []AssetGroup `json:"asset_groups,omitempty"`
+}
+
+type ListAssetGroupsResponse struct {
+	Data ListAssetGroupsResponseData `json:"data"`
+}
+
+type CreateAssetGroupResponse struct {
+	Data AssetGroup `json:"data"`
+}
+
+type GetAssetGroupResponse struct {
+	Data AssetGroup `json:"data"`
+}
+
+type UpdateAssetGroupRequest struct {
+	Name string `json:"name"`
+}
+
+type UpdateAssetGroupResponse struct {
+	Data AssetGroup `json:"data"`
+}
+
+type AssetGroupCollectionEntry struct {
+	ComponentsInt64ID
+	ComponentsTimestamps
+
+	AssetGroupCollectionID int64          `json:"asset_group_collection_id"`
+	NodeLabel              string         `json:"node_label"`
+	ObjectID               string         `json:"object_id"`
+	Properties             map[string]any `json:"properties,omitempty"`
+}
+
+type AssetGroupCollection struct {
+	ComponentsInt64ID
+	ComponentsTimestamps
+
+	Entries []AssetGroupCollectionEntry `json:"entries,omitempty"`
+}
+
+type ListAssetGroupCollectionsResponse struct {
+	Data []AssetGroupCollection `json:"data,omitempty"`
+}
+
+type GetAssetGroupComboNodeResponse struct {
+	Data map[string]json.RawMessage `json:"data,omitempty"`
+}
+
+type GetAssetGroupCustomMemberCountResponse struct {
+	CustomMemberCount int `json:"custom_member_count"`
+}
+
+type AssetGroupMember struct {
+	AssetGroupID    int      `json:"asset_group_id"`
+	CustomMember    bool     `json:"custom_member"`
+	EnvironmentID   string   `json:"environment_id"`
+	EnvironmentKind string   `json:"environment_kind"`
+	Kinds           []string `json:"kinds,omitempty"`
+	Name            string   `json:"name"`
+	ObjectID        string   `json:"object_id"`
+	PrimaryKind     string   `json:"primary_kind"`
+}
+
+type ListAssetGroupMembersResponseData struct {
+	Members []AssetGroupMember `json:"members,omitempty"`
+}
+
+type ListAssetGroupMembersResponse struct {
+	ResponsePagination
+
+	Data ListAssetGroupMembersResponseData `json:"data"`
+}
+
+type ListAssetGroupMemberCountByKindResponseData struct {
+	Counts     map[string]int `json:"counts,omitempty"`
+	TotalCount int            `json:"total_count"`
+}
+
+type ListAssetGroupMemberCountByKindResponse struct {
+	Data ListAssetGroupMemberCountByKindResponseData `json:"data"`
+}
+
+type AssetGroupSelectorSpec struct {
+	Action       string `json:"action"`
+	SelectorName string `json:"selector_name"`
+	SID          string `json:"sid"`
+}
+
+type UpdateAssetGroupSelectorsResponseData struct {
+	AddedSelectors   []AssetGroupSelector `json:"added_selectors,omitempty"`
+	RemovedSelectors []AssetGroupSelector `json:"removed_selectors,omitempty"`
+}
+
+type UpdateAssetGroupSelectorsResponse struct {
+	Data UpdateAssetGroupSelectorsResponseData `json:"data"`
+}
+
+type UpdateAssetGroupSelectorsDeprecatedResponseData struct {
+	AddedSelectors   []AssetGroupSelector `json:"added_selectors,omitempty"`
+	RemovedSelectors []AssetGroupSelector `json:"removed_selectors,omitempty"`
+}
+
+type UpdateAssetGroupSelectorsDeprecatedResponse struct {
+	Data UpdateAssetGroupSelectorsDeprecatedResponseData `json:"data"`
+}
+
+type ListAttackPathTypesResponse struct {
+	Data []string `json:"data,omitempty"`
+}
+
+type FindingTrendsForEnvironmentResponseDataFindingsItem struct {
+	CompositeRisk        float64       `json:"composite_risk"`
+	DisplayTitle         string        `json:"display_title"`
+	DisplayType          string        `json:"display_type"`
+	EnvironmentIds       []string      `json:"environment_ids,omitempty"`
+	Finding              string        `json:"finding"`
+	FindingCountDecrease int           `json:"finding_count_decrease"`
+	FindingCountEnd      int           `json:"finding_count_end"`
+	FindingCountIncrease int           `json:"finding_count_increase"`
+	FindingCountStart    int           `json:"finding_count_start"`
+	FindingExposureCount Nullable[int] `json:"finding_exposure_count"`
+	FindingImpactCount   Nullable[int] `json:"finding_impact_count"`
+}
+
+type FindingTrendsForEnvironmentResponseData struct {
+	Findings               []FindingTrendsForEnvironmentResponseDataFindingsItem `json:"findings,omitempty"`
+	TotalFindingCountEnd   int                                                   `json:"total_finding_count_end"`
+	TotalFindingCountStart int                                                   `json:"total_finding_count_start"`
+}
+
+type FindingTrendsForEnvironmentResponse struct {
+	ResponseTimeWindow
+
+	Data         FindingTrendsForEnvironmentResponseData `json:"data"`
+	Environments []string                                `json:"environments,omitempty"`
+}
+
+type UpdateAttackPathRiskRequest struct {
+	AcceptUntil time.Time `json:"accept_until"`
+	Accepted    bool      `json:"accepted"`
+	RiskType    string    `json:"risk_type"`
+}
+
+type ResponseFinding struct {
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+type AuditLogStatus string
+
+const (
+	AuditLogStatusIntent  AuditLogStatus = "intent"
+	AuditLogStatusSuccess AuditLogStatus = "success"
+	AuditLogStatusFailure AuditLogStatus = "failure"
+)
+
+type AuditLog struct {
+	ComponentsInt64ID
+
+	Action          string         `json:"action"`
+	ActorEmail      string         `json:"actor_email"`
+	ActorID         string         `json:"actor_id"`
+	ActorName       string         `json:"actor_name"`
+	CommitID        string         `json:"commit_id"`
+	CreatedAt       time.Time      `json:"created_at"`
+	Fields          map[string]any `json:"fields,omitempty"`
+	RequestID       string         `json:"request_id"`
+	SourceIPAddress string         `json:"source_ip_address"`
+	Status          AuditLogStatus `json:"status"`
+}
+
+type ListAuditLogsResponseData struct {
+	Logs []AuditLog `json:"logs,omitempty"`
+}
+
+type ListAuditLogsResponse struct {
+	ResponsePagination
+
+	Data ListAuditLogsResponseData `json:"data"`
+}
+
+type DomainSelector struct {
+	Collected bool   `json:"collected"`
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Type      string `json:"type"`
+}
+
+type GetAvailableDomainsResponse struct {
+	Data []DomainSelector `json:"data,omitempty"`
+}
+
+type AzureDataQualityStat struct {
+	ComponentsInt32ID
+	ComponentsTimestamps
+
+	Apps              int    `json:"apps"`
+	Devices           int    `json:"devices"`
+	Groups            int    `json:"groups"`
+	KeyVaults         int    `json:"key_vaults"`
+	ManagementGroups  int    `json:"management_groups"`
+	Relationships     int    `json:"relationships"`
+	ResourceGroups    int    `json:"resource_groups"`
+	RunID             string `json:"run_id"`
+	ServicePrincipals int    `json:"service_principals"`
+	Subscriptions     int    `json:"subscriptions"`
+	Tenantid          string `json:"tenantid"`
+	Users             int    `json:"users"`
+	Vms               int    `json:"vms"`
+}
+
+type GetAzureTenantDataQualityStatsResponse struct {
+	ResponsePagination
+	ResponseTimeWindow
+
+	Data []AzureDataQualityStat `json:"data,omitempty"`
+}
+
+type ComponentsUUID struct {
+	ID string `json:"id"`
+}
+
+type AuthSecret struct {
+	ComponentsInt32ID
+	ComponentsTimestamps
+
+	DigestMethod  string    `json:"digest_method"`
+	ExpiresAt     time.Time `json:"expires_at"`
+	TotpActivated bool      `json:"totp_activated"`
+}
+
+type Permission struct {
+	ComponentsInt32ID
+	ComponentsTimestamps
+
+	Authority string `json:"authority"`
+	Name      string `json:"name"`
+}
+
+type Role struct {
+	ComponentsInt32ID
+	ComponentsTimestamps
+
+	Description string       `json:"description"`
+	Name        string       `json:"name"`
+	Permissions []Permission `json:"permissions,omitempty"`
+}
+
+type User struct {
+	ComponentsUUID
+	ComponentsTimestamps
+
+	AuthSecret     AuthSecret       `json:"AuthSecret"`
+	EmailAddress   Nullable[string] `json:"email_address"`
+	EulaAccepted   bool             `json:"eula_accepted"`
+	FirstName      Nullable[string] `json:"first_name"`
+	IsDisabled     bool             `json:"is_disabled"`
+	LastLogin      time.Time        `json:"last_login"`
+	LastName       Nullable[string] `json:"last_name"`
+	PrincipalName  string           `json:"principal_name"`
+	Roles          []Role           `json:"roles,omitempty"`
+	SAMLProviderID Nullable[int32]  `json:"saml_provider_id"`
+	SSOProviderID  Nullable[int32]  `json:"sso_provider_id"`
+}
+
+type ListUsersResponseData struct {
+	Users []User `json:"users,omitempty"`
+}
+
+type ListUsersResponse struct {
+	Data ListUsersResponseData `json:"data"`
+}
+
+type RequestsUserUpdate struct {
+	EmailAddress   string          `json:"email_address"`
+	FirstName      string          `json:"first_name"`
+	IsDisabled     bool            `json:"is_disabled"`
+	LastName       string          `json:"last_name"`
+	Principal      string          `json:"principal"`
+	Roles          []int32         `json:"roles,omitempty"`
+	SAMLProviderID string          `json:"saml_provider_id"`
+	SSOProviderID  Nullable[int32] `json:"sso_provider_id"`
+}
+
+type RequestsUserSetSecret struct {
+	NeedsPasswordReset bool   `json:"needs_password_reset"`
+	Secret             string `json:"secret"`
+}
+
+type CreateUserRequest struct {
+	RequestsUserUpdate
+	RequestsUserSetSecret
+}
+
+type CreateUserResponse struct {
+	Data User `json:"data"`
+}
+
+type GetUserResponse struct {
+	Data User `json:"data"`
+}
+
+type AddUserMfaRequest struct {
+	Secret string `json:"secret"`
+}
+
+type AddUserMfaResponseData struct {
+	QrCode     string `json:"qr_code"`
+	TotpSecret string `json:"totp_secret"`
+}
+
+type AddUserMfaResponse struct {
+	Data AddUserMfaResponseData `json:"data"`
+}
+
+type RemoveUserMfaRequest struct {
+	Secret string `json:"secret"`
+}
+
+// The activation status of multi-factor authentication on a BloodHound user.
+type MFAActivationStatus string
+
+const (
+	MFAActivationStatusActivated   MFAActivationStatus = "activated"
+	MFAActivationStatusDeactivated MFAActivationStatus = "deactivated"
+	MFAActivationStatusPending     MFAActivationStatus = "pending"
+)
+
+type RemoveUserMfaResponseData struct {
+	Status MFAActivationStatus `json:"status"`
+}
+
+type RemoveUserMfaResponse struct {
+	Data RemoveUserMfaResponseData `json:"data"`
+}
+
+type GetMfaActivationStatusResponseData struct {
+	Status MFAActivationStatus `json:"status"`
+}
+
+type GetMfaActivationStatusResponse struct {
+	Data GetMfaActivationStatusResponseData `json:"data"`
+}
+
+type ActivateUserMfaRequest struct {
+	Otp string `json:"otp"`
+}
+
+type ActivateUserMfaResponseData struct {
+	Status MFAActivationStatus `json:"status"`
+}
+
+type ActivateUserMfaResponse struct {
+	Data ActivateUserMfaResponseData `json:"data"`
+}
+
+type DeleteBloodHoundDatabaseRequest struct {
+	DeleteAssetGroupSelectors []int `json:"deleteAssetGroupSelectors,omitempty"`
+	DeleteCollectedGraphData  bool  `json:"deleteCollectedGraphData"`
+	DeleteDataQualityHistory  bool  `json:"deleteDataQualityHistory"`
+	DeleteFileIngestHistory   bool  `json:"deleteFileIngestHistory"`
+}
+
+type DomainCollectionResult struct {
+	ComponentsInt64ID
+	ComponentsTimestamps
+
+	AiacaCount        int    `json:"aiaca_count"`
+	CerttemplateCount int    `json:"certtemplate_count"`
+	ComputerCount     int    `json:"computer_count"`
+	ContainerCount    int    `json:"container_count"`
+	DeletedCount      int    `json:"deleted_count"`
+	DomainName        string `json:"domain_name"`
+	EnterprisecaCount int    `json:"enterpriseca_count"`
+	GpoCount          int    `json:"gpo_count"`
+	GroupCount        int    `json:"group_count"`
+	JobID             int64  `json:"job_id"`
+	Message           string `json:"message"`
+	NtauthstoreCount  int    `json:"ntauthstore_count"`
+	OUCount           int    `json:"ou_count"`
+	RootcaCount       int    `json:"rootca_count"`
+	Success           bool   `json:"success"`
+	UserCount         int    `json:"user_count"`
+}
+
+type ComponentsBaseADEntity struct {
+	Exists   bool   `json:"exists"`
+	Name     string `json:"name"`
+	Objectid string `json:"objectid"`
+}
+
+type DomainDetails struct {
+	ComponentsBaseADEntity
+
+	Type string `json:"type"`
+}
+
+type OUDetails struct {
+	ComponentsBaseADEntity
+
+	Distinguishedname string `json:"distinguishedname"`
+	Type              string `json:"type"`
+}
+
+type ClientScheduledJobDisplay struct {
+	ComponentsInt64ID
+
+	ADStructureCollection  bool                     `json:"ad_structure_collection"`
+	AllTrustedDomains      bool                     `json:"all_trusted_domains"`
+	CARegistryCollection   bool                     `json:"ca_registry_collection"`
+	CertServicesCollection bool                     `json:"cert_services_collection"`
+	ClientID               string                   `json:"client_id"`
+	ClientName             string                   `json:"client_name"`
+	DcRegistryCollection   bool                     `json:"dc_registry_collection"`
+	DomainController       string                   `json:"domain_controller"`
+	DomainResults          []DomainCollectionResult `json:"domain_results,omitempty"`
+	Domains                []DomainDetails          `json:"domains,omitempty"`
+	EndTime                time.Time                `json:"end_time"`
+	EventID                Nullable[int32]          `json:"event_id"`
+	ExecutionTime          time.Time                `json:"execution_time"`
+	LocalGroupCollection   bool                     `json:"local_group_collection"`
+	Ous                    []OUDetails              `json:"ous,omitempty"`
+	SessionCollection      bool                     `json:"session_collection"`
+	StartTime              time.Time                `json:"start_time"`
+	Status                 int                      `json:"status"`
+	StatusMessage          string                   `json:"status_message"`
+}
+
+type ClientScheduleDisplay struct {
+	ComponentsInt32ID
+
+	ADStructureCollection  bool            `json:"ad_structure_collection"`
+	AllTrustedDomains      bool            `json:"all_trusted_domains"`
+	CARegistryCollection   bool            `json:"ca_registry_collection"`
+	CertServicesCollection bool            `json:"cert_services_collection"`
+	ClientID               string          `json:"client_id"`
+	DcRegistryCollection   bool            `json:"dc_registry_collection"`
+	Domains                []DomainDetails `json:"domains,omitempty"`
+	LocalGroupCollection   bool            `json:"local_group_collection"`
+	Ous                    []OUDetails     `json:"ous,omitempty"`
+	Rrule                  string          `json:"rrule"`
+	SessionCollection      bool            `json:"session_collection"`
+}
+
+type AuthToken struct {
+	ComponentsUUID
+	ComponentsTimestamps
+
+	HmacMethod string           `json:"hmac_method"`
+	Key        string           `json:"key"`
+	LastAccess time.Time        `json:"last_access"`
+	Name       Nullable[string] `json:"name"`
+	UserID     Nullable[string] `json:"user_id"`
+}
+
+// This enum describes the collector client type.
+type ClientType string
+
+const (
+	ClientTypeSharphound ClientType = "sharphound"
+	ClientTypeAzurehound ClientType = "azurehound"
+)
+
+type ClientDisplay struct {
+	ComponentsUUID
+
+	CompletedJobCount  int32                     `json:"completed_job_count"`
+	CompletedTaskCount int32                     `json:"completed_task_count"`
+	ConfiguredUser     string                    `json:"configured_user"`
+	CurrentJob         ClientScheduledJobDisplay `json:"current_job"`
+	CurrentJobID       Nullable[int64]           `json:"current_job_id"`
+	CurrentTask        ClientScheduledJobDisplay `json:"current_task"`
+	CurrentTaskID      Nullable[int64]           `json:"current_task_id"`
+	DomainController   Nullable[string]          `json:"domain_controller"`
+	Events             []ClientScheduleDisplay   `json:"events,omitempty"`
+	Hostname           string                    `json:"hostname"`
+	IPAddress          string                    `json:"ip_address"`
+	LastCheckin        time.Time                 `json:"last_checkin"`
+	Name               string                    `json:"name"`
+	Token              AuthToken                 `json:"token"`
+	Type               ClientType                `json:"type"`
+	UserSID            Nullable[string]          `json:"user_sid"`
+	Version            string                    `json:"version"`
+}
+
+type ListClientsResponse struct {
+	ResponsePagination
+
+	Data []ClientDisplay `json:"data,omitempty"`
+}
+
+type ClientSchedule struct {
+	ComponentsInt32ID
+	ComponentsTimestamps
+
+	ADStructureCollection  bool      `json:"ad_structure_collection"`
+	AllTrustedDomains      bool      `json:"all_trusted_domains"`
+	CARegistryCollection   bool      `json:"ca_registry_collection"`
+	CertServicesCollection bool      `json:"cert_services_collection"`
+	ClientID               string    `json:"client_id"`
+	DcRegistryCollection   bool      `json:"dc_registry_collection"`
+	Domains                []string  `json:"domains,omitempty"`
+	LocalGroupCollection   bool      `json:"local_group_collection"`
+	NextScheduledAt        time.Time `json:"next_scheduled_at"`
+	Ous                    []string  `json:"ous,omitempty"`
+	Rrule                  string    `json:"rrule"`
+	SessionCollection      bool      `json:"session_collection"`
+}
+
+type CreateClientRequest struct {
+	DomainController string           `json:"domain_controller"`
+	Events           []ClientSchedule `json:"events,omitempty"`
+	Name             string           `json:"name"`
+	Type             ClientType       `json:"type"`
+}
+
+type ClientScheduledJob struct {
+	ComponentsInt64ID
+	ComponentsTimestamps
+
+	ADStructureCollection  bool                     `json:"ad_structure_collection"`
+	AllTrustedDomains      bool                     `json:"all_trusted_domains"`
+	CARegistryCollection   bool                     `json:"ca_registry_collection"`
+	CertServicesCollection bool                     `json:"cert_services_collection"`
+	ClientID               string                   `json:"client_id"`
+	ClientName             string                   `json:"client_name"`
+	DcRegistryCollection   bool                     `json:"dc_registry_collection"`
+	DomainController       Nullable[string]         `json:"domain_controller"`
+	DomainResults          []DomainCollectionResult `json:"domain_results,omitempty"`
+	Domains                []string                 `json:"domains,omitempty"`
+	EndTime                time.Time                `json:"end_time"`
+	EventID                Nullable[int32]          `json:"event_id"`
+	EventTitle             string                   `json:"event_title"`
+	LastIngest             time.Time                `json:"last_ingest"`
+	LocalGroupCollection   bool                     `json:"local_group_collection"`
+	LogPath                Nullable[string]         `json:"log_path"`
+	Ous                    []string                 `json:"ous,omitempty"`
+	SessionCollection      bool                     `json:"session_collection"`
+	StartTime              time.Time                `json:"start_time"`
+	Status                 int                      `json:"status"`
+	StatusMessage          string                   `json:"statusMessage"`
+}
+
+type Client2 struct {
+	ComponentsUUID
+	ComponentsTimestamps
+
+	CompletedJobCount int32              `json:"completed_job_count"`
+	ConfiguredUser    string             `json:"configured_user"`
+	CurrentJob        ClientScheduledJob `json:"current_job"`
+	CurrentJobID      Nullable[int64]    `json:"current_job_id"`
+	DomainController  Nullable[string]   `json:"domain_controller"`
+	Events            []ClientSchedule   `json:"events,omitempty"`
+	Hostname          string             `json:"hostname"`
+	IPAddress         string             `json:"ip_address"`
+	LastCheckin       time.Time          `json:"last_checkin"`
+	Name              string             `json:"name"`
+	Token             AuthToken          `json:"token"`
+	Type              ClientType         `json:"type"`
+	UserSID           Nullable[string]   `json:"user_sid"`
+	Version           string             `json:"version"`
+}
+
+type CreateClientResponse struct {
+	Data Client2 `json:"data"`
+}
+
+type LogClientErrorRequest struct {
+	Additional map[string]string `json:"additional,omitempty"`
+	TaskError  string            `json:"task_error"`
+}
+
+type UpdateClientInfoRequest struct {
+	Address  string `json:"address"`
+	Hostname string `json:"hostname"`
+	Username string `json:"username"`
+	Usersid  string `json:"usersid"`
+	Version  string `json:"version"`
+}
+
+type UpdateClientInfoResponse struct {
+	Data Client2 `json:"data"`
+}
+
+type GetClientResponse struct {
+	Data ClientDisplay `json:"data"`
+}
+
+type UpdateClientRequest struct {
+	DomainController string `json:"domain_controller"`
+	Name             string `json:"name"`
+}
+
+type UpdateClientResponse struct {
+	Data Client2 `json:"data"`
+}
+
+type ListClientCompletedJobsResponse struct {
+	ResponsePagination
+
+	Data []ClientScheduledJobDisplay `json:"data,omitempty"`
+}
+
+type ListClientCompletedTasksResponse struct {
+	ResponsePagination
+
+	Data []ClientScheduledJobDisplay `json:"data,omitempty"`
+}
+
+type CreateClientScheduledJobResponse struct {
+	Data ClientScheduledJob `json:"data"`
+}
+
+type CreateClientScheduledTaskResponse struct {
+	Data ClientScheduledJob `json:"data"`
+}
+
+type ReplaceClientTokenResponse struct {
+	Data AuthToken `json:"data"`
+}
+
+// An API replica sharing the application database with the other replicas of a deployment.
+type ClusterNode struct {
+	Hostname        string    `json:"hostname"`
+	IsLeader        bool      `json:"is_leader"`
+	LastHeartbeatAt time.Time `json:"last_heartbeat_at"`
+	NodeID          string    `json:"node_id"`
+	StartedAt       time.Time `json:"started_at"`
+}
+
+type GetClusterStatusResponseData struct {
+	Leader ClusterNode   `json:"leader"`
+	NodeID string        `json:"node_id"`
+	Nodes  []ClusterNode `json:"nodes,omitempty"`
+}
+
+type GetClusterStatusResponse struct {
+	Data GetClusterStatusResponseData `json:"data"`
+}
+
+type CollectorVersion struct {
+	Deprecated bool   `json:"deprecated"`
+	Sha256sum  string `json:"sha256sum"`
+	Version    string `json:"version"`
+}
+
+type CollectorManifest struct {
+	Latest   string             `json:"latest"`
+	Versions []CollectorVersion `json:"versions,omitempty"`
+}
+
+type GetCollectorManifestResponse struct {
+	Data CollectorManifest `json:"data"`
+}
+
+type GetCompletenessStatsResponse struct {
+	Data map[string]float64 `json:"data,omitempty"`
+}
+
+type AppConfigParam struct {
+	ComponentsInt32ID
+	ComponentsTimestamps
+
+	Description string         `json:"description"`
+	Key         string         `json:"key"`
+	Name        string         `json:"name"`
+	Value       map[string]any `json:"value,omitempty"`
+}
+
+type ListAppConfigParamsResponse struct {
+	Data []AppConfigParam `json:"data,omitempty"`
+}
+
+type SetAppConfigParamResponseData struct {
+	Key   string         `json:"key"`
+	Value map[string]any `json:"value,omitempty"`
+}
+
+type SetAppConfigParamResponse struct {
+	Data SetAppConfigParamResponseData `json:"data"`
+}
+
+type DatapipeStatus string
+
+const (
+	DatapipeStatusIdle      DatapipeStatus = "idle"
+	DatapipeStatusIngesting DatapipeStatus = "ingesting"
+	DatapipeStatusAnalyzing DatapipeStatus = "analyzing"
+)
+
+type GetDatapipeStatusResponseData struct {
+	CancellationRequestedAt Nullable[time.Time] `json:"cancellation_requested_at"`
+	CancellationRequestedBy Nullable[string]    `json:"cancellation_requested_by"`
+	LastAnalysisRunAt       time.Time           `json:"last_analysis_run_at"`
+	LastCompleteAnalysisAt  time.Time           `json:"last_complete_analysis_at"`
+	Status                  DatapipeStatus      `json:"status"`
+	UpdatedAt               time.Time           `json:"updated_at"`
+}
+
+type GetDatapipeStatusResponse struct {
+	Data GetDatapipeStatusResponseData `json:"data"`
+}
+
+type RiskAcceptance string
+
+const (
+	RiskAcceptanceAccepted   RiskAcceptance = "accepted"
+	RiskAcceptanceUnaccepted RiskAcceptance = "unaccepted"
+	RiskAcceptanceAll        RiskAcceptance = "all"
+)
+
+type ListAvailableAttackPathTypesForDomainResponse struct {
+	Data []string `json:"data,omitempty"`
+}
+
+type RiskCounts struct {
+	ComponentsInt64ID
+	ComponentsTimestamps
+
+	CompositeRisk      float64 `json:"CompositeRisk"`
+	DomainSID          string  `json:"DomainSID"`
+	Finding            string  `json:"Finding"`
+	FindingCount       int     `json:"FindingCount"`
+	ImpactedAssetCount int     `json:"ImpactedAssetCount"`
+}
+
+type ListAttackPathSparklineValuesResponse struct {
+	ResponseTimeWindow
+
+	Data []RiskCounts `json:"data,omitempty"`
+}
+
+type UpdateDomainEntityRequest struct {
+	Collected bool `json:"collected"`
+}
+
+type UpdateDomainEntityResponseData struct {
+	Collected bool `json:"collected"`
+}
+
+type UpdateDomainEntityResponse struct {
+	Data UpdateDomainEntityResponseData `json:"data"`
+}
+
+type ListClientSchedulesResponse struct {
+	ResponsePagination
+
+	Data []ClientScheduleDisplay `json:"data,omitempty"`
+}
+
+type CreateClientScheduleResponse struct {
+	Data ClientScheduleDisplay `json:"data"`
+}
+
+type GetClientScheduleResponse struct {
+	Data ClientScheduleDisplay `json:"data"`
+}
+
+type UpdateClientEventResponse struct {
+	Data ClientScheduleDisplay `json:"data"`
+}
+
+type FeatureFlag struct {
+	ComponentsInt32ID
+	ComponentsTimestamps
+
+	Description   string `json:"description"`
+	Enabled       bool   `json:"enabled"`
+	Key           string `json:"key"`
+	Name          string `json:"name"`
+	UserUpdatable bool   `json:"user_updatable"`
+}
+
+type ListFeatureFlagsResponse struct {
+	Data []FeatureFlag `json:"data,omitempty"`
+}
+
+type ToggleFeatureFlagResponseData struct {
+	Enabled bool `json:"enabled"`
+}
+
+type ToggleFeatureFlagResponse struct {
+	Data ToggleFeatureFlagResponseData `json:"data"`
+}
+
+type FileUploadJob struct {
+	ComponentsInt64ID
+	ComponentsTimestamps
+
+	CanceledBy          Nullable[string]    `json:"canceled_by"`
+	EndTime             time.Time           `json:"end_time"`
+	FailedFiles         int                 `json:"failed_files"`
+	LastIngest          time.Time           `json:"last_ingest"`
+	RollbackRequestedBy Nullable[string]    `json:"rollback_requested_by"`
+	RolledBackAt        Nullable[time.Time] `json:"rolled_back_at"`
+	StartTime           time.Time           `json:"start_time"`
+	Status              int                 `json:"status"`
+	StatusMessage       string              `json:"status_message"`
+	TotalFiles          int                 `json:"total_files"`
+	UserEmailAddress    string              `json:"user_email_address"`
+	UserID              string              `json:"user_id"`
+	WorkspaceID         Nullable[int32]     `json:"workspace_id"`
+}
+
+type ListFileUploadJobsResponse struct {
+	ResponsePagination
+
+	Data []FileUploadJob `json:"data,omitempty"`
+}
+
+type ListAcceptedFileUploadTypesResponse struct {
+	Data []string `json:"data,omitempty"`
+}
+
+type CreateFileUploadJobResponse struct {
+	Data FileUploadJob `json:"data"`
+}
+
+type UnifiedGraphEdge struct {
+	Kind       string                    `json:"kind"`
+	Label      string                    `json:"label"`
+	LastSeen   time.Time                 `json:"lastSeen"`
+	Properties map[string]map[string]any `json:"properties,omitempty"`
+	Source     string                    `json:"source"`
+	Target     string                    `json:"target"`
+}
+
+type UnifiedGraphGraph struct {
+	Edges []UnifiedGraphEdge          `json:"edges,omitempty"`
+	Nodes map[string]UnifiedGraphNode `json:"nodes,omitempty"`
+}
+
+type FileUploadJobObjects struct {
+	UnifiedGraphGraph
+
+	EdgeCount int64 `json:"edge_count"`
+	NodeCount int64 `json:"node_count"`
+}
+
+type ListFileUploadJobObjectsResponse struct {
+	Data FileUploadJobObjects `json:"data"`
+}
+
+type StartIngestUploadRequest struct {
+	ContentType string `json:"content_type"`
+	TotalSize   int64  `json:"total_size"`
+}
+
+type IngestUpload struct {
+	ComponentsInt64ID
+	ComponentsTimestamps
+
+	Completed    bool  `json:"completed"`
+	FileType     int   `json:"file_type"`
+	IngestJobID  int64 `json:"ingest_job_id"`
+	ReceivedSize int64 `json:"received_size"`
+	TotalSize    int64 `json:"total_size"`
+}
+
+type StartIngestUploadResponse struct {
+	Data IngestUpload `json:"data"`
+}
+
+type GetIngestUploadResponse struct {
+	Data IngestUpload `json:"data"`
+}
+
+type WriteIngestUploadChunkResponse struct {
+	Data IngestUpload `json:"data"`
+}
+
+type BhGraphItemBorder struct {
+	Color string `json:"color"`
+}
+
+type BhGraphFontIcon struct {
+	Color      string `json:"color"`
+	FontFamily string `json:"fontFamily"`
+	Text       string `json:"text"`
+}
+
+type BhGraphLabel struct {
+	Bold       bool   `json:"bold"`
+	Color      string `json:"color"`
+	FontFamily string `json:"fontFamily"`
+	Text       string `json:"text"`
+}
+
+type BhGraphGlyph struct {
+	Angle    int               `json:"angle"`
+	Blink    bool              `json:"blink"`
+	Border   BhGraphItemBorder `json:"border"`
+	Color    string            `json:"color"`
+	FontIcon BhGraphFontIcon   `json:"fontIcon"`
+	Image    string            `json:"image"`
+	Label    BhGraphLabel      `json:"label"`
+	Position string            `json:"position"`
+	Radius   int               `json:"radius"`
+	Size     int               `json:"size"`
+}
+
+type BhGraphItem struct {
+	Color  string         `json:"color"`
+	Data   map[string]any `json:"data,omitempty"`
+	Fade   bool           `json:"fade"`
+	Glyphs []BhGraphGlyph `json:"glyphs,omitempty"`
+}
+
+type BhGraphNodeBorder struct {
+	Color     string `json:"color"`
+	LineStyle string `json:"lineStyle"`
+	Width     int    `json:"width"`
+}
+
+type BhGraphNodeCoordinates struct {
+	Lat int `json:"lat"`
+	Lng int `json:"lng"`
+}
+
+type BhGraphNodeHalosItem struct {
+	Color  string `json:"color"`
+	Radius int    `json:"radius"`
+	Width  int    `json:"width"`
+}
+
+type BhGraphNodeLabel struct {
+	BackgroundColor string `json:"backgroundColor"`
+	Bold            bool   `json:"bold"`
+	Center          bool   `json:"center"`
+	Color           string `json:"color"`
+	FontFamily      string `json:"fontFamily"`
+	FontSize        int    `json:"fontSize"`
+	Text            string `json:"text"`
+}
+
+type BhGraphNode struct {
+	BhGraphItem
+
+	Border      BhGraphNodeBorder      `json:"border"`
+	Coordinates BhGraphNodeCoordinates `json:"coordinates"`
+	Cutout      bool                   `json:"cutout"`
+	FontIcon    BhGraphFontIcon        `json:"fontIcon"`
+	Halos       []BhGraphNodeHalosItem `json:"halos,omitempty"`
+	Image       string                 `json:"image"`
+	Label       BhGraphNodeLabel       `json:"label"`
+	Shape       string                 `json:"shape"`
+	Size        int                    `json:"size"`
+}
+
+type GetSearchResultResponse struct {
+	Data map[string]BhGraphNode `json:"data,omitempty"`
+}
+
+type RunCypherQueryRequest struct {
+	IncludeProperties bool   `json:"include_properties"`
+	Query             string `json:"query"`
+}
+
+type RunCypherQueryResponse struct {
+	Data UnifiedGraphGraph `json:"data"`
+}
+
+type GetPathCompositionResponse struct {
+	Data UnifiedGraphGraph `json:"data"`
+}
+
+type GetKindsResponseData struct {
+	Kinds []string `json:"kinds,omitempty"`
+}
+
+type GetKindsResponse struct {
+	Data GetKindsResponseData `json:"data"`
+}
+
+type GetRelayTargetsResponse struct {
+	Data UnifiedGraphGraph `json:"data"`
+}
+
+type GetShortestPathResponse struct {
+	Data UnifiedGraphGraph `json:"data"`
+}
+
+type GetClientJobsResponse struct {
+	Data []ClientScheduledJobDisplay `json:"data,omitempty"`
+}
+
+type ListAvailableClientJobsResponse struct {
+	Data []ClientScheduledJobDisplay `json:"data,omitempty"`
+}
+
+type GetClientCurrentJobResponse struct {
+	Data ClientScheduledJobDisplay `json:"data"`
+}
+
+type EndClientJobResponse struct {
+	Data ClientScheduledJob `json:"data"`
+}
+
+type ListClientFinishedJobsResponse struct {
+	ResponsePagination
+
+	Data []ClientScheduledJobDisplay `json:"data,omitempty"`
+}
+
+type StartClientJobRequest struct {
+	ID int64 `json:"id"`
+}
+
+type StartClientJobResponse struct {
+	Data ClientScheduledJob `json:"data"`
+}
+
+type GetClientJobResponse struct {
+	Data ClientScheduledJobDisplay `json:"data"`
+}
+
+type CancelClientJobResponse struct {
+	Data ClientScheduledJobDisplay `json:"data"`
+}
+
+type GetClientJobLogResponseData struct {
+	Log string `json:"log"`
+}
+
+type GetClientJobLogResponse struct {
+	Data GetClientJobLogResponseData `json:"data"`
+}
+
+type LoginRequest struct {
+	LoginMethod string `json:"login_method"`
+	Otp         string `json:"otp"`
+	Secret      string `json:"secret"`
+	Username    string `json:"username"`
+}
+
+type LoginResponseData struct {
+	AuthExpired  bool   `json:"auth_expired"`
+	SessionToken string `json:"session_token"`
+	UserID       string `json:"user_id"`
+}
+
+type LoginResponse struct {
+	Data LoginResponseData `json:"data"`
+}
+
+type GetLatestTierZeroComboNodeResponse struct {
+	Data map[string]BhGraphNode `json:"data,omitempty"`
+}
+
+type GetComboTreeGraphResponse struct {
+	Data map[string]json.RawMessage `json:"data,omitempty"`
+}
+
+type GetMetaEntityResponseData struct {
+	Props map[string]map[string]any `json:"props,omitempty"`
+}
+
+type GetMetaEntityResponse struct {
+	Data GetMetaEntityResponseData `json:"data"`
+}
+
+type PathfindingResponse struct {
+	Data map[string]json.RawMessage `json:"data,omitempty"`
+}
+
+type ListPermissionsResponseData struct {
+	Permissions []Permission `json:"permissions,omitempty"`
+}
+
+type ListPermissionsResponse struct {
+	Data ListPermissionsResponseData `json:"data"`
+}
+
+type GetPermissionResponse struct {
+	Data Permission `json:"data"`
+}
+
+type ResponseDataQualityPlatformAggregate struct {
+	ResponsePagination
+	ResponseTimeWindow
+
+	Data []json.RawMessage `json:"data,omitempty"`
+}
+
+type PostureHistoryForEnvironmentsResponseDataItem struct {
+	Date  time.Time `json:"date"`
+	Value float64   `json:"value"`
+}
+
+// This enum describes the valid data types for posture history results.
+type PostureHistoryType string
+
+const (
+	PostureHistoryTypeFindings            PostureHistoryType = "findings"
+	PostureHistoryTypeExposure            PostureHistoryType = "exposure"
+	PostureHistoryTypeAssets              PostureHistoryType = "assets"
+	PostureHistoryTypeSessionCompleteness PostureHistoryType = "session-completeness"
+	PostureHistoryTypeGroupCompleteness   PostureHistoryType = "group-completeness"
+	PostureHistoryTypeAttackPaths         PostureHistoryType = "attack-paths"
+)
+
+type PostureHistoryForEnvironmentsResponse struct {
+	ResponseTimeWindow
+
+	Data         []PostureHistoryForEnvironmentsResponseDataItem `json:"data,omitempty"`
+	DataType     PostureHistoryType                              `json:"data_type"`
+	Environments []string                                        `json:"environments,omitempty"`
+}
+
+type RiskPostureStat struct {
+	ComponentsInt64ID
+	ComponentsTimestamps
+
+	CriticalRiskCount int     `json:"critical_risk_count"`
+	DomainSID         string  `json:"domain_sid"`
+	ExposureIndex     float64 `json:"exposure_index"`
+	TierZeroCount     int64   `json:"tier_zero_count"`
+}
+
+type GetPostureStatsResponse struct {
+	ResponsePagination
+	ResponseTimeWindow
+
+	Data []RiskPostureStat `json:"data,omitempty"`
+}
+
+type ListRolesResponseData struct {
+	Roles []Role `json:"roles,omitempty"`
+}
+
+type ListRolesResponse struct {
+	Data ListRolesResponseData `json:"data"`
+}
+
+type GetRoleResponse struct {
+	Data Role `json:"data"`
+}
+
+type SAMLProvider struct {
+	ComponentsInt32ID
+	ComponentsTimestamps
+
+	DisplayName                string          `json:"display_name"`
+	IdpIssuerURI               string          `json:"idp_issuer_uri"`
+	IdpSSOURI                  string          `json:"idp_sso_uri"`
+	Name                       string          `json:"name"`
+	PrincipalAttributeMappings []string        `json:"principal_attribute_mappings,omitempty"`
+	SpAcsURI                   string          `json:"sp_acs_uri"`
+	SpIssuerURI                string          `json:"sp_issuer_uri"`
+	SpMetadataURI              string          `json:"sp_metadata_uri"`
+	SpSSOURI                   string          `json:"sp_sso_uri"`
+	SSOProviderID              Nullable[int32] `json:"sso_provider_id"`
+}
+
+type ListSamlProvidersResponseData struct {
+	SAMLProviders []SAMLProvider `json:"saml_providers,omitempty"`
+}
+
+type ListSamlProvidersResponse struct {
+	Data ListSamlProvidersResponseData `json:"data"`
+}
+
+type CreateSamlProviderResponse struct {
+	Data SAMLProvider `json:"data"`
+}
+
+type GetSamlProviderResponse struct {
+	Data SAMLProvider `json:"data"`
+}
+
+type DeleteSamlProviderResponseData struct {
+	AffectedUser []User `json:"affected_user,omitempty"`
+}
+
+type DeleteSamlProviderResponse struct {
+	Data DeleteSamlProviderResponseData `json:"data"`
+}
+
+type SAMLSignOnEndpoint struct {
+	IdpURL string `json:"idp_url"`
+	Name   string `json:"name"`
+}
+
+type GetSamlSignSignOnEndpointsResponseData struct {
+	Endpoints []SAMLSignOnEndpoint `json:"endpoints,omitempty"`
+}
+
+type GetSamlSignSignOnEndpointsResponse struct {
+	Data GetSamlSignSignOnEndpointsResponseData `json:"data"`
+}
+
+type SavedQuery struct {
+	ComponentsInt64ID
+	ComponentsTimestamps
+
+	Description string `json:"description"`
+	Name        string `json:"name"`
+	Query       string `json:"query"`
+	UserID      string `json:"user_id"`
+}
+
+type ListSavedQueriesResponse struct {
+	ResponsePagination
+
+	Data []SavedQuery `json:"data,omitempty"`
+}
+
+type CreateSavedQueryResponse struct {
+	Data SavedQuery `json:"data"`
+}
+
+type UpdateSavedQueryResponse struct {
+	Data SavedQuery `json:"data"`
+}
+
+type ShareSavedQueryRequest struct {
+	Public  bool     `json:"public"`
+	UserIds []string `json:"user_ids,omitempty"`
+}
+
+type SavedQueriesPermissions struct {
+	ComponentsInt64ID
+	ComponentsTimestamps
+
+	Public         bool             `json:"public"`
+	QueryID        int64            `json:"query_id"`
+	SharedToUserID Nullable[string] `json:"shared_to_user_id"`
+}
+
+type ShareSavedQueryResponse struct {
+	Data []SavedQueriesPermissions `json:"data,omitempty"`
+}
+
+type DeleteSavedQueryPermissionsRequest struct {
+	UserIds []string `json:"user_ids,omitempty"`
+}
+
+type SearchResult struct {
+	Distinguishedname string            `json:"distinguishedname"`
+	Highlights        map[string]string `json:"highlights,omitempty"`
+	Name              string            `json:"name"`
+	Objectid          string            `json:"objectid"`
+	Score             float64           `json:"score"`
+	SystemTags        string            `json:"system_tags"`
+	Type              string            `json:"type"`
+}
+
+type SearchResponse struct {
+	ResponsePagination
+
+	Data []SearchResult `json:"data,omitempty"`
+}
+
+type ResponseAuthenticatedRequester struct {
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+type AuthProvider struct {
+	Details json.RawMessage `json:"details,omitempty"`
+	ID      int32           `json:"id"`
+	Name    string          `json:"name"`
+	Slug    string          `json:"slug"`
+	Type    string          `json:"type"`
+}
+
+type ListAuthProvidersResponse struct {
+	Data []AuthProvider `json:"data,omitempty"`
+}
+
+type CreateOIDCProviderRequestConfigAutoProvision struct {
+	DefaultRoleID int32 `json:"default_role_id"`
+	Enabled       bool  `json:"enabled"`
+	RoleProvision bool  `json:"role_provision"`
+}
+
+type CreateOIDCProviderRequestConfig struct {
+	AutoProvision CreateOIDCProviderRequestConfigAutoProvision `json:"auto_provision"`
+}
+
+type CreateOIDCProviderRequest struct {
+	ClientID string                          `json:"client_id"`
+	Config   CreateOIDCProviderRequestConfig `json:"config"`
+	Issuer   string                          `json:"issuer"`
+	Name     string                          `json:"name"`
+}
+
+type OIDCProvider struct {
+	ComponentsInt32ID
+	ComponentsTimestamps
+
+	ClientID      string `json:"client_id"`
+	Issuer        string `json:"issuer"`
+	SSOProviderID int32  `json:"sso_provider_id"`
+}
+
+type CreateOIDCProviderResponse struct {
+	Data OIDCProvider `json:"data"`
+}
+
+type CreateSSOSAMLProviderResponse struct {
+	Data SAMLProvider `json:"data"`
+}
+
+type DeleteSSOProviderResponseData struct {
+	AffectedUsers []User `json:"affected_users,omitempty"`
+}
+
+type DeleteSSOProviderResponse struct {
+	Data DeleteSSOProviderResponseData `json:"data"`
+}
+
+type PatchSSOProviderRequestConfigAutoProvision struct {
+	DefaultRoleID int32 `json:"default_role_id"`
+	Enabled       bool  `json:"enabled"`
+	RoleProvision bool  `json:"role_provision"`
+}
+
+type PatchSSOProviderRequestConfig struct {
+	AutoProvision PatchSSOProviderRequestConfigAutoProvision `json:"auto_provision"`
+}
+
+type PatchSSOProviderRequest struct {
+	ClientID string                        `json:"client_id"`
+	Config   PatchSSOProviderRequestConfig `json:"config"`
+	Issuer   string                        `json:"issuer"`
+	Name     string                        `json:"name"`
+}
+
+type ListAuthTokensResponseData struct {
+	Tokens []AuthToken `json:"tokens,omitempty"`
+}
+
+type ListAuthTokensResponse struct {
+	Data ListAuthTokensResponseData `json:"data"`
+}
+
+type CreateAuthTokenRequest struct {
+	TokenName string `json:"token_name"`
+	UserID    string `json:"user_id"`
+}
+
+type CreateAuthTokenResponse struct {
+	Data AuthToken `json:"data"`
+}
+
+type Workspace struct {
+	ComponentsInt32ID
+	ComponentsTimestamps
+
+	Description string `json:"description"`
+	Name        string `json:"name"`
+}
+
+type ListWorkspacesResponse struct {
+	Data []Workspace `json:"data,omitempty"`
+}
+
+type CreateWorkspaceRequest struct {
+	Description string `json:"description"`
+	Name        string `json:"name"`
+}
+
+type CreateWorkspaceResponse struct {
+	Data Workspace `json:"data"`
+}
+
+type ListWorkspaceUsersResponseData struct {
+	UserIds []string `json:"user_ids,omitempty"`
+}
+
+type ListWorkspaceUsersResponse struct {
+	Data ListWorkspaceUsersResponseData `json:"data"`
+}
+
+type AddWorkspaceUsersRequest struct {
+	UserIds []string `json:"user_ids,omitempty"`
+}
+
+type RemoveWorkspaceUsersRequest struct {
+	UserIds []string `json:"user_ids,omitempty"`
+}
+
+type GetApiVersionResponseDataAPI struct {
+	CurrentVersion    string `json:"current_version"`
+	DeprecatedVersion string `json:"deprecated_version"`
+}
+
+type GetApiVersionResponseData struct {
+	API           GetApiVersionResponseDataAPI `json:"API"`
+	ServerVersion string                       `json:"server_version"`
+}
+
+type GetApiVersionResponse struct {
+	Data GetApiVersionResponseData `json:"data"`
+}
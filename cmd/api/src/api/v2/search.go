@@ -44,10 +44,10 @@ func (s Resources) SearchHandler(response http.ResponseWriter, request *http.Req
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("Invalid query parameter: %v", err), request), response)
 	} else if nodeKinds, err := analysis.ParseKinds(nodeTypes...); err != nil {
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "Invalid type parameter", request), response)
-	} else if result, err := s.GraphQuery.SearchNodesByName(ctx, nodeKinds, searchQuery, skip, limit); err != nil {
+	} else if result, total, err := s.GraphQuery.SearchNodes(ctx, nodeKinds, searchQuery, skip, limit); err != nil {
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, fmt.Sprintf("Graph error: %v", err), request), response)
 	} else {
-		api.WriteBasicResponse(request.Context(), result, http.StatusOK, response)
+		api.WriteResponseWrapperWithPagination(request.Context(), result, limit, skip, total, http.StatusOK, response)
 	}
 }
 
@@ -25,6 +25,7 @@ import (
 	"github.com/specterops/bloodhound/dawgs/graph"
 	v2 "github.com/specterops/bloodhound/src/api/v2"
 	"github.com/specterops/bloodhound/src/api/v2/apitest"
+	"github.com/specterops/bloodhound/src/model"
 	graphMocks "github.com/specterops/bloodhound/src/queries/mocks"
 	"go.uber.org/mock/gomock"
 )
@@ -75,8 +76,8 @@ func TestResources_SearchHandler(t *testing.T) {
 				},
 				Setup: func() {
 					mockGraph.EXPECT().
-						SearchNodesByName(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-						Return(nil, errors.New("graph error"))
+						SearchNodes(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+						Return(nil, 0, errors.New("graph error"))
 				},
 				Test: func(output apitest.Output) {
 					apitest.StatusCode(output, http.StatusInternalServerError)
@@ -87,14 +88,24 @@ func TestResources_SearchHandler(t *testing.T) {
 				Name: "Success",
 				Input: func(input *apitest.Input) {
 					apitest.AddQueryParam(input, "q", "search value")
+					apitest.AddQueryParam(input, "skip", "10")
+					apitest.AddQueryParam(input, "limit", "5")
 				},
 				Setup: func() {
 					mockGraph.EXPECT().
-						SearchNodesByName(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-						Return(nil, nil)
+						SearchNodes(gomock.Any(), gomock.Any(), "search value", 10, 5).
+						Return([]model.SearchResult{{
+							ObjectID:   "S-1-5-21-1",
+							Name:       "SEARCH VALUE@CORP.LOCAL",
+							Score:      0.5,
+							Highlights: map[string]string{"name": "<mark>SEARCH</mark> <mark>VALUE</mark>@CORP.LOCAL"},
+						}}, 11, nil)
 				},
 				Test: func(output apitest.Output) {
 					apitest.StatusCode(output, http.StatusOK)
+					apitest.BodyContains(output, `"count":11`)
+					apitest.BodyContains(output, `"score":0.5`)
+					apitest.BodyContains(output, `"highlights":{"name":`)
 				},
 			},
 		})
@@ -0,0 +1,133 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/specterops/bloodhound/analysis"
+	"github.com/specterops/bloodhound/analysis/simulation"
+	"github.com/specterops/bloodhound/src/api"
+	"github.com/specterops/bloodhound/src/model"
+)
+
+const (
+	ErrorResponseSimulationNoChanges    = "at least one removed edge or node change must be specified"
+	ErrorResponseSimulationEdgeInvalid  = "removed edges must specify a source, target and kind"
+	ErrorResponseSimulationNodeInvalid  = "node changes must specify an object_id and at least one property"
+	ErrorResponseSimulationLimitInvalid = "limit must not be negative"
+)
+
+type SimulatedEdgeRemoval struct {
+	Source string `json:"source"`
+	Target string `json:"target"`
+	Kind   string `json:"kind"`
+}
+
+type SimulatedNodeChange struct {
+	ObjectID   string         `json:"object_id"`
+	Properties map[string]any `json:"properties"`
+}
+
+type RemediationSimulationRequest struct {
+	RemovedEdges []SimulatedEdgeRemoval `json:"removed_edges"`
+	NodeChanges  []SimulatedNodeChange  `json:"node_changes"`
+	Limit        int                    `json:"limit"`
+}
+
+type RemediationSimulationResponse struct {
+	RemovedEdges             int                 `json:"removed_edges"`
+	DerivedEdges             int                 `json:"derived_edges"`
+	PrincipalsWithPathBefore int                 `json:"principals_with_path_before"`
+	PrincipalsWithPathAfter  int                 `json:"principals_with_path_after"`
+	PrincipalsLosingPath     int                 `json:"principals_losing_path"`
+	LostPrincipals           []model.UnifiedNode `json:"lost_principals"`
+}
+
+func (s RemediationSimulationRequest) Changes() (simulation.Changes, error) {
+	changes := simulation.Changes{
+		ReportLimit: s.Limit,
+	}
+
+	if len(s.RemovedEdges) == 0 && len(s.NodeChanges) == 0 {
+		return changes, errors.New(ErrorResponseSimulationNoChanges)
+	} else if s.Limit < 0 {
+		return changes, errors.New(ErrorResponseSimulationLimitInvalid)
+	}
+
+	for _, removedEdge := range s.RemovedEdges {
+		if removedEdge.Source == "" || removedEdge.Target == "" || removedEdge.Kind == "" {
+			return changes, errors.New(ErrorResponseSimulationEdgeInvalid)
+		} else if kind, err := analysis.ParseKind(removedEdge.Kind); err != nil {
+			return changes, err
+		} else {
+			changes.RemovedRelationships = append(changes.RemovedRelationships, simulation.RelationshipRemoval{
+				StartObjectID: removedEdge.Source,
+				EndObjectID:   removedEdge.Target,
+				Kind:          kind,
+			})
+		}
+	}
+
+	for _, nodeChange := range s.NodeChanges {
+		if nodeChange.ObjectID == "" || len(nodeChange.Properties) == 0 {
+			return changes, errors.New(ErrorResponseSimulationNodeInvalid)
+		}
+
+		changes.NodeChanges = append(changes.NodeChanges, simulation.NodeChange{
+			ObjectID:   nodeChange.ObjectID,
+			Properties: nodeChange.Properties,
+		})
+	}
+
+	return changes, nil
+}
+
+// SimulateRemediation reports how many principals would lose their paths to tier zero if the requested edges were
+// removed and node changes applied. The graph is not modified.
+func (s Resources) SimulateRemediation(response http.ResponseWriter, request *http.Request) {
+	var simulationRequest RemediationSimulationRequest
+
+	if err := api.ReadJSONRequestPayloadLimited(&simulationRequest, request); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
+	} else if changes, err := simulationRequest.Changes(); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
+	} else if report, err := s.GraphQuery.SimulateRemediation(request.Context(), changes); err != nil {
+		if errors.Is(err, simulation.ErrNodeNotFound) || errors.Is(err, simulation.ErrRelationshipNotFound) {
+			api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusNotFound, err.Error(), request), response)
+		} else {
+			api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, fmt.Sprintf("Error: %v", err), request), response)
+		}
+	} else {
+		simulationResponse := RemediationSimulationResponse{
+			RemovedEdges:             len(report.RemovedRelationships),
+			DerivedEdges:             len(report.DerivedRelationships),
+			PrincipalsWithPathBefore: report.PrincipalsWithPathBefore,
+			PrincipalsWithPathAfter:  report.PrincipalsWithPathAfter,
+			PrincipalsLosingPath:     report.PrincipalsLosingPath,
+			LostPrincipals:           make([]model.UnifiedNode, 0, report.LostPrincipals.Len()),
+		}
+
+		for _, node := range report.LostPrincipals {
+			simulationResponse.LostPrincipals = append(simulationResponse.LostPrincipals, model.FromDAWGSNode(node, false))
+		}
+
+		api.WriteBasicResponse(request.Context(), simulationResponse, http.StatusOK, response)
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/specterops/bloodhound/analysis/simulation"
+	"github.com/specterops/bloodhound/dawgs/graph"
+	"github.com/specterops/bloodhound/graphschema/ad"
+	"github.com/specterops/bloodhound/graphschema/common"
+	"github.com/specterops/bloodhound/headers"
+	"github.com/specterops/bloodhound/mediatypes"
+	v2 "github.com/specterops/bloodhound/src/api/v2"
+	"github.com/specterops/bloodhound/src/api/v2/apitest"
+	mocks_graph "github.com/specterops/bloodhound/src/queries/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestResources_SimulateRemediation(t *testing.T) {
+	var (
+		mockCtrl  = gomock.NewController(t)
+		mockGraph = mocks_graph.NewMockGraph(mockCtrl)
+		resources = v2.Resources{GraphQuery: mockGraph}
+		validBody = v2.RemediationSimulationRequest{
+			RemovedEdges: []v2.SimulatedEdgeRemoval{{Source: "USER", Target: "GROUP", Kind: ad.MemberOf.String()}},
+		}
+	)
+	defer mockCtrl.Finish()
+
+	apitest.NewHarness(t, resources.SimulateRemediation).
+		WithCommonRequest(func(input *apitest.Input) {
+			apitest.SetHeader(input, headers.ContentType.String(), mediatypes.ApplicationJson.String())
+		}).
+		Run([]apitest.Case{
+			{
+				Name: "InvalidPayload",
+				Input: func(input *apitest.Input) {
+					apitest.BodyString(input, "not json")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+				},
+			},
+			{
+				Name: "NoChanges",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, v2.RemediationSimulationRequest{})
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, v2.ErrorResponseSimulationNoChanges)
+				},
+			},
+			{
+				Name: "IncompleteEdge",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, v2.RemediationSimulationRequest{
+						RemovedEdges: []v2.SimulatedEdgeRemoval{{Source: "USER", Kind: ad.MemberOf.String()}},
+					})
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, v2.ErrorResponseSimulationEdgeInvalid)
+				},
+			},
+			{
+				Name: "UnknownKind",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, v2.RemediationSimulationRequest{
+						RemovedEdges: []v2.SimulatedEdgeRemoval{{Source: "USER", Target: "GROUP", Kind: "NotAKind"}},
+					})
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+				},
+			},
+			{
+				Name: "IncompleteNodeChange",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, v2.RemediationSimulationRequest{
+						NodeChanges: []v2.SimulatedNodeChange{{ObjectID: "USER"}},
+					})
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, v2.ErrorResponseSimulationNodeInvalid)
+				},
+			},
+			{
+				Name: "NegativeLimit",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, v2.RemediationSimulationRequest{
+						RemovedEdges: validBody.RemovedEdges,
+						Limit:        -1,
+					})
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, v2.ErrorResponseSimulationLimitInvalid)
+				},
+			},
+			{
+				Name: "RelationshipNotFound",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, validBody)
+				},
+				Setup: func() {
+					mockGraph.EXPECT().
+						SimulateRemediation(gomock.Any(), gomock.Any()).
+						Return(simulation.Report{}, fmt.Errorf("%w: (USER)-[MemberOf]->(GROUP)", simulation.ErrRelationshipNotFound))
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNotFound)
+				},
+			},
+			{
+				Name: "GraphDBError",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, validBody)
+				},
+				Setup: func() {
+					mockGraph.EXPECT().
+						SimulateRemediation(gomock.Any(), gomock.Any()).
+						Return(simulation.Report{}, errors.New("graph error"))
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusInternalServerError)
+					apitest.BodyContains(output, "Error:")
+				},
+			},
+			{
+				Name: "Success",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, validBody)
+				},
+				Setup: func() {
+					mockGraph.EXPECT().
+						SimulateRemediation(gomock.Any(), simulation.Changes{
+							RemovedRelationships: []simulation.RelationshipRemoval{{StartObjectID: "USER", EndObjectID: "GROUP", Kind: ad.MemberOf}},
+						}).
+						Return(simulation.Report{
+							RemovedRelationships:     []graph.ID{10},
+							DerivedRelationships:     []graph.ID{11, 12},
+							PrincipalsWithPathBefore: 5,
+							PrincipalsWithPathAfter:  4,
+							PrincipalsLosingPath:     1,
+							LostPrincipals: graph.NewNodeSet(
+								graph.NewNode(1, graph.NewProperties().Set(common.ObjectID.String(), "USER"), ad.Entity, ad.User),
+							),
+						}, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusOK)
+
+					var result v2.RemediationSimulationResponse
+					apitest.UnmarshalData(output, &result)
+
+					require.Equal(t, 1, result.RemovedEdges)
+					require.Equal(t, 2, result.DerivedEdges)
+					require.Equal(t, 5, result.PrincipalsWithPathBefore)
+					require.Equal(t, 4, result.PrincipalsWithPathAfter)
+					require.Equal(t, 1, result.PrincipalsLosingPath)
+					require.Len(t, result.LostPrincipals, 1)
+					require.Equal(t, "USER", result.LostPrincipals[0].ObjectId)
+				},
+			},
+		})
+}
@@ -0,0 +1,164 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gofrs/uuid"
+	"github.com/gorilla/mux"
+	"github.com/specterops/bloodhound/src/api"
+	"github.com/specterops/bloodhound/src/auth"
+	ctx2 "github.com/specterops/bloodhound/src/ctx"
+	"github.com/specterops/bloodhound/src/database"
+	"github.com/specterops/bloodhound/src/model"
+	"github.com/specterops/bloodhound/src/services/workspace"
+)
+
+type CreateWorkspaceRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+type WorkspaceUsersRequest struct {
+	UserIDs []uuid.UUID `json:"user_ids"`
+}
+
+type WorkspaceUsersResponse struct {
+	UserIDs []uuid.UUID `json:"user_ids"`
+}
+
+// ListWorkspaces returns the workspaces the requesting user has been granted access to. Users with the permission
+// "permission://auth/ManageUsers" may see all workspaces.
+func (s Resources) ListWorkspaces(response http.ResponseWriter, request *http.Request) {
+	var (
+		bhCtx      = ctx2.FromRequest(request)
+		workspaces model.Workspaces
+		err        error
+	)
+
+	if s.Authorizer.AllowsPermission(bhCtx.AuthCtx, auth.Permissions().AuthManageUsers) {
+		workspaces, err = s.DB.GetAllWorkspaces(request.Context())
+	} else if user, isUser := auth.GetUserFromAuthCtx(bhCtx.AuthCtx); !isUser {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "No associated user found", request), response)
+		return
+	} else {
+		workspaces, err = s.DB.GetWorkspacesForUser(request.Context(), user.ID)
+	}
+
+	if err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		api.WriteBasicResponse(request.Context(), workspaces, http.StatusOK, response)
+	}
+}
+
+// CreateWorkspace creates a new workspace along with the graph namespace backing it
+func (s Resources) CreateWorkspace(response http.ResponseWriter, request *http.Request) {
+	var createRequest CreateWorkspaceRequest
+
+	if err := api.ReadJSONRequestPayloadLimited(&createRequest, request); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
+	} else if createRequest.Name == "" {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "the name field is empty", request), response)
+	} else if !workspace.IsSupported(s.Graph) {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, workspace.ErrUnsupportedGraphDriver.Error(), request), response)
+	} else if newWorkspace, err := s.DB.CreateWorkspace(request.Context(), createRequest.Name, createRequest.Description); errors.Is(err, database.ErrDuplicateWorkspaceName) {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusConflict, "duplicate name for workspace: please choose a different name", request), response)
+	} else if err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if err := workspace.Initialize(request.Context(), s.Graph, newWorkspace); err != nil {
+		if deleteErr := s.DB.DeleteWorkspace(request.Context(), newWorkspace.ID); deleteErr != nil {
+			slog.ErrorContext(request.Context(), fmt.Sprintf("Failed to remove workspace %d after graph initialization failure: %v", newWorkspace.ID, deleteErr))
+		}
+
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, fmt.Sprintf("error initializing workspace graph: %v", err), request), response)
+	} else {
+		api.WriteBasicResponse(request.Context(), newWorkspace, http.StatusCreated, response)
+	}
+}
+
+// DeleteWorkspace removes a workspace, its user grants, its ingest jobs and all graph data held in its graph namespace
+func (s Resources) DeleteWorkspace(response http.ResponseWriter, request *http.Request) {
+	if workspaceID, err := parseWorkspaceID(request); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsIDMalformed, request), response)
+	} else if existingWorkspace, err := s.DB.GetWorkspace(request.Context(), workspaceID); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if err := workspace.DeleteGraphData(request.Context(), s.Graph, existingWorkspace); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, fmt.Sprintf("error deleting workspace graph data: %v", err), request), response)
+	} else if err := s.DB.DeleteWorkspace(request.Context(), workspaceID); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		response.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// ListWorkspaceUsers returns the IDs of the users granted access to a workspace
+func (s Resources) ListWorkspaceUsers(response http.ResponseWriter, request *http.Request) {
+	if workspaceID, err := parseWorkspaceID(request); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsIDMalformed, request), response)
+	} else if _, err := s.DB.GetWorkspace(request.Context(), workspaceID); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if userIDs, err := s.DB.GetWorkspaceUsers(request.Context(), workspaceID); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		api.WriteBasicResponse(request.Context(), WorkspaceUsersResponse{UserIDs: userIDs}, http.StatusOK, response)
+	}
+}
+
+// AddWorkspaceUsers grants the given users access to a workspace
+func (s Resources) AddWorkspaceUsers(response http.ResponseWriter, request *http.Request) {
+	var usersRequest WorkspaceUsersRequest
+
+	if workspaceID, err := parseWorkspaceID(request); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsIDMalformed, request), response)
+	} else if err := api.ReadJSONRequestPayloadLimited(&usersRequest, request); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
+	} else if _, err := s.DB.GetWorkspace(request.Context(), workspaceID); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if err := s.DB.AddWorkspaceUsers(request.Context(), workspaceID, usersRequest.UserIDs...); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		response.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// RemoveWorkspaceUsers revokes the given users' access to a workspace
+func (s Resources) RemoveWorkspaceUsers(response http.ResponseWriter, request *http.Request) {
+	var usersRequest WorkspaceUsersRequest
+
+	if workspaceID, err := parseWorkspaceID(request); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsIDMalformed, request), response)
+	} else if err := api.ReadJSONRequestPayloadLimited(&usersRequest, request); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
+	} else if _, err := s.DB.GetWorkspace(request.Context(), workspaceID); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if err := s.DB.RemoveWorkspaceUsers(request.Context(), workspaceID, usersRequest.UserIDs...); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		response.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func parseWorkspaceID(request *http.Request) (int32, error) {
+	workspaceID, err := strconv.ParseInt(mux.Vars(request)[api.URIPathVariableWorkspaceID], 10, 32)
+	return int32(workspaceID), err
+}
@@ -0,0 +1,177 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/specterops/bloodhound/dawgs"
+	"github.com/specterops/bloodhound/dawgs/drivers/memory"
+	"github.com/specterops/bloodhound/dawgs/graph"
+	"github.com/specterops/bloodhound/headers"
+	"github.com/specterops/bloodhound/mediatypes"
+	"github.com/specterops/bloodhound/src/api"
+	v2 "github.com/specterops/bloodhound/src/api/v2"
+	"github.com/specterops/bloodhound/src/api/v2/apitest"
+	"github.com/specterops/bloodhound/src/database"
+	dbMocks "github.com/specterops/bloodhound/src/database/mocks"
+	"github.com/specterops/bloodhound/src/model"
+	"go.uber.org/mock/gomock"
+)
+
+func TestResources_CreateWorkspace(t *testing.T) {
+	var (
+		mockCtrl    = gomock.NewController(t)
+		mockDB      = dbMocks.NewMockDatabase(mockCtrl)
+		memoryDB, _ = dawgs.Open(context.Background(), memory.DriverName, dawgs.Config{})
+		resources   = v2.Resources{DB: mockDB, Graph: graph.NewDatabaseSwitch(context.Background(), memoryDB)}
+	)
+	defer mockCtrl.Finish()
+
+	apitest.
+		NewHarness(t, resources.CreateWorkspace).
+		Run([]apitest.Case{
+			{
+				Name: "EmptyName",
+				Input: func(input *apitest.Input) {
+					apitest.SetHeader(input, headers.ContentType.String(), mediatypes.ApplicationJson.String())
+					apitest.BodyStruct(input, v2.CreateWorkspaceRequest{})
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+				},
+			},
+			{
+				Name: "DuplicateName",
+				Input: func(input *apitest.Input) {
+					apitest.SetHeader(input, headers.ContentType.String(), mediatypes.ApplicationJson.String())
+					apitest.BodyStruct(input, v2.CreateWorkspaceRequest{Name: "engagement"})
+				},
+				Setup: func() {
+					mockDB.EXPECT().CreateWorkspace(gomock.Any(), "engagement", "").Return(model.Workspace{}, fmt.Errorf("%w: constraint", database.ErrDuplicateWorkspaceName))
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusConflict)
+				},
+			},
+			{
+				Name: "Success",
+				Input: func(input *apitest.Input) {
+					apitest.SetHeader(input, headers.ContentType.String(), mediatypes.ApplicationJson.String())
+					apitest.BodyStruct(input, v2.CreateWorkspaceRequest{Name: "engagement", Description: "client engagement"})
+				},
+				Setup: func() {
+					mockDB.EXPECT().CreateWorkspace(gomock.Any(), "engagement", "client engagement").Return(model.Workspace{Name: "engagement", Serial: model.Serial{ID: 1}}, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusCreated)
+				},
+			},
+		})
+}
+
+func TestResources_CreateWorkspace_UnsupportedDriver(t *testing.T) {
+	var (
+		mockCtrl    = gomock.NewController(t)
+		mockDB      = dbMocks.NewMockDatabase(mockCtrl)
+		memoryDB, _ = dawgs.Open(context.Background(), memory.DriverName, dawgs.Config{})
+		resources   = v2.Resources{DB: mockDB, Graph: memoryDB}
+	)
+	defer mockCtrl.Finish()
+
+	apitest.
+		NewHarness(t, resources.CreateWorkspace).
+		Run([]apitest.Case{
+			{
+				Name: "UnsupportedDriver",
+				Input: func(input *apitest.Input) {
+					apitest.SetHeader(input, headers.ContentType.String(), mediatypes.ApplicationJson.String())
+					apitest.BodyStruct(input, v2.CreateWorkspaceRequest{Name: "engagement"})
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+				},
+			},
+		})
+}
+
+func TestResources_DeleteWorkspace(t *testing.T) {
+	var (
+		mockCtrl    = gomock.NewController(t)
+		mockDB      = dbMocks.NewMockDatabase(mockCtrl)
+		memoryDB, _ = dawgs.Open(context.Background(), memory.DriverName, dawgs.Config{})
+		resources   = v2.Resources{DB: mockDB, Graph: graph.NewDatabaseSwitch(context.Background(), memoryDB)}
+		workspace   = model.Workspace{Name: "engagement", Serial: model.Serial{ID: 1}}
+	)
+	defer mockCtrl.Finish()
+
+	apitest.
+		NewHarness(t, resources.DeleteWorkspace).
+		Run([]apitest.Case{
+			{
+				Name: "MalformedID",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableWorkspaceID, "engagement")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+				},
+			},
+			{
+				Name: "NotFound",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableWorkspaceID, "2")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetWorkspace(gomock.Any(), int32(2)).Return(model.Workspace{}, database.ErrNotFound)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNotFound)
+				},
+			},
+			{
+				Name: "DatabaseError",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableWorkspaceID, "1")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetWorkspace(gomock.Any(), int32(1)).Return(workspace, nil)
+					mockDB.EXPECT().DeleteWorkspace(gomock.Any(), int32(1)).Return(errors.New("database error"))
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusInternalServerError)
+				},
+			},
+			{
+				Name: "Success",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableWorkspaceID, "1")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetWorkspace(gomock.Any(), int32(1)).Return(workspace, nil)
+					mockDB.EXPECT().DeleteWorkspace(gomock.Any(), int32(1)).Return(nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNoContent)
+				},
+			},
+		})
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/specterops/bloodhound/src/config"
 	"github.com/specterops/bloodhound/src/daemons"
 	"github.com/specterops/bloodhound/src/database"
+	"github.com/specterops/bloodhound/src/tracing"
 )
 
 type DatabaseConnections[DBType database.Database, GraphType graph.Database] struct {
@@ -58,6 +59,20 @@ func (s Initializer[DBType, GraphType]) Launch(parentCtx context.Context, handle
 		return fmt.Errorf("log initialization error: %w", err)
 	}
 
+	if shutdownTracing, err := tracing.Initialize(ctx, s.Configuration.Tracing); err != nil {
+		return fmt.Errorf("tracing initialization error: %w", err)
+	} else {
+		// Flush buffered spans once the daemons have stopped
+		defer func() {
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), DefaultServerShutdownTimeout)
+			defer cancel()
+
+			if err := shutdownTracing(shutdownCtx); err != nil {
+				slog.Error(fmt.Sprintf("Failed shutting down tracing: %v", err))
+			}
+		}()
+	}
+
 	if err := EnsureServerDirectories(s.Configuration); err != nil {
 		return fmt.Errorf("failed to ensure server directories: %w", err)
 	}
@@ -32,6 +32,7 @@ import (
 	"github.com/specterops/bloodhound/dawgs/util/size"
 	"github.com/specterops/bloodhound/src/api/tools"
 	"github.com/specterops/bloodhound/src/config"
+	"github.com/specterops/bloodhound/src/metrics"
 )
 
 func ensureDirectory(path string) error {
@@ -114,7 +115,10 @@ func ConnectGraph(ctx context.Context, cfg config.Configuration) (*graph.Databas
 	}); err != nil {
 		return nil, err
 	} else {
-		return graph.NewDatabaseSwitch(ctx, graphDatabase), nil
+		graphDB := graph.NewDatabaseSwitch(ctx, graphDatabase)
+		graphDB.SetTransactionObserver(metrics.ObserveGraphTransaction)
+
+		return graphDB, nil
 	}
 }
 
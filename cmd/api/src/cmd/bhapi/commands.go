@@ -0,0 +1,113 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
+	"github.com/specterops/bloodhound/dawgs/dump"
+	"github.com/specterops/bloodhound/src/bootstrap"
+	"github.com/specterops/bloodhound/src/config"
+)
+
+const (
+	graphDumpCommand    = "graph-dump"
+	graphRestoreCommand = "graph-restore"
+)
+
+// runCommand executes the named maintenance subcommand against the configured graph database instead of starting the
+// API server.
+func runCommand(ctx context.Context, cfg config.Configuration, args []string) error {
+	var (
+		command  = args[0]
+		flags    = flag.NewFlagSet(command, flag.ContinueOnError)
+		filePath string
+	)
+
+	flags.StringVar(&filePath, "file", "", "Path of the graph dump file. Defaults to stdout for graph-dump and stdin for graph-restore.")
+
+	if err := flags.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	switch command {
+	case graphDumpCommand:
+		return runGraphDump(ctx, cfg, filePath)
+
+	case graphRestoreCommand:
+		return runGraphRestore(ctx, cfg, filePath)
+
+	default:
+		return fmt.Errorf("unknown command %q; expected one of: %s, %s", command, graphDumpCommand, graphRestoreCommand)
+	}
+}
+
+func runGraphDump(ctx context.Context, cfg config.Configuration, filePath string) error {
+	var output io.WriteCloser = os.Stdout
+
+	if graphDB, err := bootstrap.ConnectGraph(ctx, cfg); err != nil {
+		return fmt.Errorf("failed connecting to the graph database: %w", err)
+	} else {
+		defer graphDB.Close(ctx)
+
+		if filePath != "" {
+			if output, err = os.Create(filePath); err != nil {
+				return err
+			}
+		}
+
+		if stats, err := dump.Dump(ctx, graphDB, output); err != nil {
+			output.Close()
+			return fmt.Errorf("graph dump failed after %d nodes and %d relationships: %w", stats.Nodes, stats.Relationships, err)
+		} else {
+			slog.InfoContext(ctx, fmt.Sprintf("Graph dump wrote %d nodes and %d relationships", stats.Nodes, stats.Relationships))
+		}
+
+		return output.Close()
+	}
+}
+
+func runGraphRestore(ctx context.Context, cfg config.Configuration, filePath string) error {
+	var input io.ReadCloser = os.Stdin
+
+	if graphDB, err := bootstrap.ConnectGraph(ctx, cfg); err != nil {
+		return fmt.Errorf("failed connecting to the graph database: %w", err)
+	} else {
+		defer graphDB.Close(ctx)
+
+		if filePath != "" {
+			if input, err = os.Open(filePath); err != nil {
+				return err
+			}
+		}
+
+		defer input.Close()
+
+		if stats, err := dump.Restore(ctx, graphDB, input); err != nil {
+			return fmt.Errorf("graph restore failed after %d nodes and %d relationships: %w", stats.Nodes, stats.Relationships, err)
+		} else {
+			slog.InfoContext(ctx, fmt.Sprintf("Graph restore wrote %d nodes and %d relationships", stats.Nodes, stats.Relationships))
+		}
+
+		return nil
+	}
+}
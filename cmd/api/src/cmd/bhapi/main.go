@@ -44,8 +44,9 @@ func main() {
 	)
 
 	flag.Usage = func() {
-		fmt.Fprintf(flag.CommandLine.Output(), "BloodHound Community Edition API Server\n\nUsage of %s\n", os.Args[0])
+		fmt.Fprintf(flag.CommandLine.Output(), "BloodHound Community Edition API Server\n\nUsage of %s [flags] [command]\n", os.Args[0])
 		flag.PrintDefaults()
+		fmt.Fprintf(flag.CommandLine.Output(), "\nCommands:\n  %s\tWrite a dump of the graph database\n  %s\tRestore a graph database dump\n", graphDumpCommand, graphRestoreCommand)
 	}
 
 	flag.BoolVar(&versionFlag, "version", false, "Get binary version.")
@@ -56,20 +57,32 @@ func main() {
 		printVersion()
 	}
 
+	// Commands may write their output to stdout so keep logs out of the way
+	logOutput := os.Stdout
+
+	if flag.NArg() > 0 {
+		logOutput = os.Stderr
+	}
+
 	// Jump the bootstrap initializer so all logs are configured properly
 	if enabled, err := config.GetTextLoggerEnabled(); err != nil {
-		bhlog.ConfigureDefaultJSON(os.Stdout)
+		bhlog.ConfigureDefaultJSON(logOutput)
 		slog.Error(fmt.Sprintf("Failed to check text logger enabled: %v", err))
 		os.Exit(1)
 	} else if enabled {
-		bhlog.ConfigureDefaultText(os.Stdout)
+		bhlog.ConfigureDefaultText(logOutput)
 	} else {
-		bhlog.ConfigureDefaultJSON(os.Stdout)
+		bhlog.ConfigureDefaultJSON(logOutput)
 	}
 
 	if cfg, err := config.GetConfiguration(configFilePath, config.NewDefaultConfiguration); err != nil {
 		slog.Error(fmt.Sprintf("Unable to read configuration %s: %v", configFilePath, err))
 		os.Exit(1)
+	} else if flag.NArg() > 0 {
+		if err := runCommand(context.Background(), cfg, flag.Args()); err != nil {
+			slog.Error(fmt.Sprintf("Command %s failed: %v", flag.Arg(0), err))
+			os.Exit(1)
+		}
 	} else {
 		initializer := bootstrap.Initializer[*database.BloodhoundDB, *graph.DatabaseSwitch]{
 			Configuration:       cfg,
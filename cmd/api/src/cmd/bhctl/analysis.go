@@ -0,0 +1,130 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/specterops/bloodhound/src/model"
+)
+
+var (
+	ErrAnalysisTimeout = errors.New("timed out waiting for analysis")
+)
+
+// analysisFinished returns true once the datapipe is idle after an analysis run that started after the given baseline.
+// A zero baseline only waits for the datapipe to become idle.
+func analysisFinished(status model.DatapipeStatusWrapper, baseline time.Time) bool {
+	return status.Status == model.DatapipeStatusIdle && (baseline.IsZero() || status.LastAnalysisRunAt.After(baseline))
+}
+
+// waitForAnalysis polls the datapipe status until analysisFinished is satisfied. The baseline is read from the server
+// rather than the local clock so that clock skew between bhctl and the server does not matter.
+func waitForAnalysis(env *environment, baseline time.Time, pollInterval, timeout time.Duration) error {
+	var (
+		deadline   = time.Now().Add(timeout)
+		lastStatus model.DatapipeStatus
+	)
+
+	for {
+		status, err := env.client.GetDatapipeStatus()
+		if err != nil {
+			return fmt.Errorf("failed reading datapipe status: %w", err)
+		}
+
+		if status.Status != lastStatus {
+			fmt.Fprintf(env.stderr, "Datapipe is %s\n", status.Status)
+			lastStatus = status.Status
+		}
+
+		if analysisFinished(status, baseline) {
+			if !baseline.IsZero() && status.LastCompleteAnalysisAt.Before(status.LastAnalysisRunAt) {
+				return errors.New("analysis finished without completing successfully; check the server logs for details")
+			}
+
+			return nil
+		}
+
+		if time.Now().Add(pollInterval).After(deadline) {
+			return fmt.Errorf("%w after %s", ErrAnalysisTimeout, timeout)
+		}
+
+		time.Sleep(pollInterval)
+	}
+}
+
+func writeDatapipeStatus(env *environment, status model.DatapipeStatusWrapper) error {
+	return env.write(status, []string{"STATUS", "UPDATED", "LAST ANALYSIS RUN", "LAST COMPLETE ANALYSIS"}, [][]string{{
+		string(status.Status),
+		formatTime(status.UpdatedAt),
+		formatTime(status.LastAnalysisRunAt),
+		formatTime(status.LastCompleteAnalysisAt),
+	}})
+}
+
+func runAnalysis(env *environment, args []string) error {
+	var (
+		flags = env.flagSet("analysis")
+
+		wait         bool
+		timeout      time.Duration
+		pollInterval time.Duration
+	)
+
+	flags.BoolVar(&wait, "wait", false, "Wait for the requested analysis to finish.")
+	flags.DurationVar(&timeout, "timeout", time.Hour, "Maximum time to wait for analysis.")
+	flags.DurationVar(&pollInterval, "poll-interval", 10*time.Second, "Interval between datapipe status checks.")
+
+	if len(args) == 0 {
+		return fmt.Errorf("%w: expected one of request, status or wait", ErrUsage)
+	} else if err := flags.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "request":
+		status, err := env.client.GetDatapipeStatus()
+		if err != nil {
+			return fmt.Errorf("failed reading datapipe status: %w", err)
+		} else if err := env.client.RequestAnalysis(); err != nil {
+			return fmt.Errorf("failed requesting analysis: %w", err)
+		}
+
+		fmt.Fprintln(env.stderr, "Analysis requested")
+
+		if wait {
+			return waitForAnalysis(env, status.LastAnalysisRunAt, pollInterval, timeout)
+		}
+
+		return nil
+
+	case "status":
+		if status, err := env.client.GetDatapipeStatus(); err != nil {
+			return fmt.Errorf("failed reading datapipe status: %w", err)
+		} else {
+			return writeDatapipeStatus(env, status)
+		}
+
+	case "wait":
+		return waitForAnalysis(env, time.Time{}, pollInterval, timeout)
+
+	default:
+		return fmt.Errorf("%w: unknown analysis command %q", ErrUsage, args[0])
+	}
+}
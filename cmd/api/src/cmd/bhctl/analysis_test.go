@@ -0,0 +1,121 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/specterops/bloodhound/src/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunAnalysis(t *testing.T) {
+	var (
+		baseline = time.Now().Add(-time.Hour).UTC()
+		analyzed = time.Now().UTC()
+	)
+
+	t.Run("Request", func(t *testing.T) {
+		fake := newFakeAPI(t)
+		fake.reply(http.MethodGet, "/api/v2/datapipe/status", model.DatapipeStatusWrapper{Status: model.DatapipeStatusIdle})
+		fake.reply(http.MethodPut, "/api/v2/analysis", nil)
+
+		result := fake.run("", "analysis", "request")
+		require.Zero(t, result.exitCode, result.stderr)
+		require.Equal(t, "Analysis requested\n", result.stderr)
+		require.Len(t, fake.requested(http.MethodPut, "/api/v2/analysis"), 1)
+	})
+
+	t.Run("Request And Wait", func(t *testing.T) {
+		fake := newFakeAPI(t)
+		fake.reply(http.MethodPut, "/api/v2/analysis", nil)
+		fake.handle(http.MethodGet, "/api/v2/datapipe/status", datapipeStatuses(
+			model.DatapipeStatusWrapper{Status: model.DatapipeStatusIdle, LastAnalysisRunAt: baseline, LastCompleteAnalysisAt: baseline},
+			model.DatapipeStatusWrapper{Status: model.DatapipeStatusAnalyzing, LastAnalysisRunAt: baseline, LastCompleteAnalysisAt: baseline},
+			model.DatapipeStatusWrapper{Status: model.DatapipeStatusIdle, LastAnalysisRunAt: analyzed, LastCompleteAnalysisAt: baseline},
+		))
+
+		// Analysis that ran without completing is reported as a failure
+		result := fake.run("", "analysis", "request", "-wait", "-poll-interval", "1ms")
+		require.Equal(t, 1, result.exitCode)
+		require.Contains(t, result.stderr, "Datapipe is analyzing\nDatapipe is idle\n")
+		require.Contains(t, result.stderr, "bhctl: analysis finished without completing successfully")
+	})
+
+	t.Run("Request Failure", func(t *testing.T) {
+		fake := newFakeAPI(t)
+		fake.reply(http.MethodGet, "/api/v2/datapipe/status", model.DatapipeStatusWrapper{Status: model.DatapipeStatusIdle})
+		fake.fail(http.MethodPut, "/api/v2/analysis", http.StatusForbidden, "permission denied")
+
+		result := fake.run("", "analysis", "request")
+		require.Equal(t, 1, result.exitCode)
+		require.Equal(t, "bhctl: failed requesting analysis: Code: 403 - errors: permission denied\n", result.stderr)
+	})
+
+	t.Run("Status", func(t *testing.T) {
+		fake := newFakeAPI(t)
+		fake.reply(http.MethodGet, "/api/v2/datapipe/status", model.DatapipeStatusWrapper{Status: model.DatapipeStatusAnalyzing, LastAnalysisRunAt: analyzed})
+
+		result := fake.run("", "analysis", "status")
+		require.Zero(t, result.exitCode, result.stderr)
+
+		rows := tableRows(result.stdout)
+		require.Equal(t, []string{"STATUS", "UPDATED", "LAST", "ANALYSIS", "RUN", "LAST", "COMPLETE", "ANALYSIS"}, rows[0])
+		require.Equal(t, []string{"analyzing", "-", analyzed.Local().Format(time.RFC3339), "-"}, rows[1])
+
+		result = fake.run("", "-output", "json", "analysis", "status")
+		require.Zero(t, result.exitCode, result.stderr)
+
+		var status model.DatapipeStatusWrapper
+		require.Nil(t, json.Unmarshal([]byte(result.stdout), &status))
+		require.Equal(t, model.DatapipeStatusAnalyzing, status.Status)
+	})
+
+	t.Run("Status Failure", func(t *testing.T) {
+		fake := newFakeAPI(t)
+		fake.fail(http.MethodGet, "/api/v2/datapipe/status", http.StatusInternalServerError, "database unavailable")
+
+		result := fake.run("", "analysis", "status")
+		require.Equal(t, 1, result.exitCode)
+		require.Equal(t, "bhctl: failed reading datapipe status: Code: 500 - errors: database unavailable\n", result.stderr)
+	})
+
+	t.Run("Wait Times Out", func(t *testing.T) {
+		fake := newFakeAPI(t)
+		fake.reply(http.MethodGet, "/api/v2/datapipe/status", model.DatapipeStatusWrapper{Status: model.DatapipeStatusAnalyzing})
+
+		result := fake.run("", "analysis", "wait", "-timeout", "1ms", "-poll-interval", "1s")
+		require.Equal(t, 1, result.exitCode)
+		require.Contains(t, result.stderr, "bhctl: timed out waiting for analysis after 1ms")
+	})
+
+	t.Run("Reports Invalid Usage", func(t *testing.T) {
+		fake := newFakeAPI(t)
+
+		result := fake.run("", "analysis")
+		require.Equal(t, 1, result.exitCode)
+		require.Contains(t, result.stderr, "bhctl: invalid usage: expected one of request, status or wait")
+
+		result = fake.run("", "analysis", "cancel")
+		require.Equal(t, 1, result.exitCode)
+		require.Contains(t, result.stderr, `bhctl: invalid usage: unknown analysis command "cancel"`)
+		require.Zero(t, fake.requestCount())
+	})
+}
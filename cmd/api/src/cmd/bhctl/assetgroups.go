@@ -0,0 +1,139 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	v2 "github.com/specterops/bloodhound/src/api/v2"
+	"github.com/specterops/bloodhound/src/model"
+)
+
+func parseAssetGroupID(value string) (int32, error) {
+	if id, err := strconv.ParseInt(value, 10, 32); err != nil {
+		return 0, fmt.Errorf("invalid asset group ID %q: %w", value, err)
+	} else {
+		return int32(id), nil
+	}
+}
+
+func writeAssetGroups(env *environment, assetGroups ...model.AssetGroup) error {
+	rows := make([][]string, 0, len(assetGroups))
+
+	for _, assetGroup := range assetGroups {
+		rows = append(rows, []string{
+			strconv.FormatInt(int64(assetGroup.ID), 10),
+			assetGroup.Name,
+			assetGroup.Tag,
+			strconv.FormatBool(assetGroup.SystemGroup),
+			strconv.Itoa(assetGroup.MemberCount),
+		})
+	}
+
+	return env.write(assetGroups, []string{"ID", "NAME", "TAG", "SYSTEM", "MEMBERS"}, rows)
+}
+
+// updateAssetGroupMembers adds or removes the given objects as selectors of an asset group. Each selector is named
+// after the object ID it selects.
+func updateAssetGroupMembers(env *environment, action string, args []string) error {
+	if len(args) < 2 {
+		return fmt.Errorf("%w: asset-groups %s <group id> <object id>...", ErrUsage, action)
+	}
+
+	assetGroupID, err := parseAssetGroupID(args[0])
+	if err != nil {
+		return err
+	}
+
+	specs := make([]model.AssetGroupSelectorSpec, 0, len(args)-1)
+
+	for _, objectID := range args[1:] {
+		specs = append(specs, model.AssetGroupSelectorSpec{
+			SelectorName:   objectID,
+			EntityObjectID: objectID,
+			Action:         action,
+		})
+	}
+
+	if updated, err := env.client.UpdateAssetGroupSelectors(assetGroupID, specs); err != nil {
+		return fmt.Errorf("failed updating asset group selectors: %w", err)
+	} else {
+		var rows [][]string
+
+		for _, selector := range updated.Added {
+			rows = append(rows, []string{model.SelectorSpecActionAdd, selector.Name, selector.Selector})
+		}
+
+		for _, selector := range updated.Removed {
+			rows = append(rows, []string{model.SelectorSpecActionRemove, selector.Name, selector.Selector})
+		}
+
+		return env.write(updated, []string{"ACTION", "NAME", "SELECTOR"}, rows)
+	}
+}
+
+func runAssetGroups(env *environment, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("%w: expected one of list, create, delete, add or remove", ErrUsage)
+	}
+
+	switch subcommand, args := args[0], args[1:]; subcommand {
+	case "list":
+		if assetGroups, err := env.client.ListAssetGroups(); err != nil {
+			return fmt.Errorf("failed listing asset groups: %w", err)
+		} else {
+			return writeAssetGroups(env, assetGroups.AssetGroups...)
+		}
+
+	case "create":
+		var (
+			flags   = env.flagSet("asset-groups create")
+			request v2.CreateAssetGroupRequest
+		)
+
+		flags.StringVar(&request.Name, "name", "", "Name of the asset group.")
+		flags.StringVar(&request.Tag, "tag", "", "Tag applied to the members of the asset group.")
+
+		if err := flags.Parse(args); err != nil {
+			return err
+		} else if request.Name == "" || request.Tag == "" {
+			return fmt.Errorf("%w: asset-groups create -name name -tag tag", ErrUsage)
+		} else if assetGroup, err := env.client.CreateAssetGroup(request); err != nil {
+			return fmt.Errorf("failed creating asset group: %w", err)
+		} else {
+			return writeAssetGroups(env, assetGroup)
+		}
+
+	case "delete":
+		if len(args) != 1 {
+			return fmt.Errorf("%w: asset-groups delete <id>", ErrUsage)
+		} else if assetGroupID, err := parseAssetGroupID(args[0]); err != nil {
+			return err
+		} else if err := env.client.DeleteAssetGroup(assetGroupID); err != nil {
+			return fmt.Errorf("failed deleting asset group: %w", err)
+		}
+
+		return nil
+
+	case model.SelectorSpecActionAdd, model.SelectorSpecActionRemove:
+		return updateAssetGroupMembers(env, subcommand, args)
+
+	default:
+		return fmt.Errorf("%w: unknown asset-groups command %q", ErrUsage, subcommand)
+	}
+}
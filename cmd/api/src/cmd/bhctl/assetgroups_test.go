@@ -0,0 +1,109 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"net/http"
+	"testing"
+
+	v2 "github.com/specterops/bloodhound/src/api/v2"
+	"github.com/specterops/bloodhound/src/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunAssetGroups(t *testing.T) {
+	testAssetGroup := model.AssetGroup{Name: "Crown Jewels", Tag: "crown_jewels", MemberCount: 2, Serial: model.Serial{ID: 4}}
+
+	t.Run("List", func(t *testing.T) {
+		fake := newFakeAPI(t)
+		fake.reply(http.MethodGet, "/api/v2/asset-groups", v2.ListAssetGroupsResponse{AssetGroups: model.AssetGroups{testAssetGroup}})
+
+		result := fake.run("", "asset-groups", "list")
+		require.Zero(t, result.exitCode, result.stderr)
+		require.Equal(t, "ID  NAME          TAG           SYSTEM  MEMBERS\n4   Crown Jewels  crown_jewels  false   2\n", result.stdout)
+	})
+
+	t.Run("Create", func(t *testing.T) {
+		fake := newFakeAPI(t)
+		fake.reply(http.MethodPost, "/api/v2/asset-groups", testAssetGroup)
+
+		result := fake.run("", "asset-groups", "create", "-name", "Crown Jewels", "-tag", "crown_jewels")
+		require.Zero(t, result.exitCode, result.stderr)
+		require.Contains(t, result.stdout, "Crown Jewels")
+
+		requests := fake.requested(http.MethodPost, "/api/v2/asset-groups")
+		require.Len(t, requests, 1)
+		require.Equal(t, v2.CreateAssetGroupRequest{Name: "Crown Jewels", Tag: "crown_jewels"}, decodeBody[v2.CreateAssetGroupRequest](t, requests[0]))
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		fake := newFakeAPI(t)
+		fake.fail(http.MethodDelete, "/api/v2/asset-groups/4", http.StatusConflict, "system asset groups can not be deleted")
+
+		result := fake.run("", "asset-groups", "delete", "4")
+		require.Equal(t, 1, result.exitCode)
+		require.Equal(t, "bhctl: failed deleting asset group: Code: 409 - errors: system asset groups can not be deleted\n", result.stderr)
+		require.Len(t, fake.requested(http.MethodDelete, "/api/v2/asset-groups/4"), 1)
+	})
+
+	t.Run("Add And Remove Members", func(t *testing.T) {
+		fake := newFakeAPI(t)
+		fake.reply(http.MethodPut, "/api/v2/asset-groups/4/selectors", model.UpdatedAssetGroupSelectors{
+			Added: model.AssetGroupSelectors{{Name: "S-1-5-21-1-1001", Selector: "S-1-5-21-1-1001"}},
+		})
+
+		result := fake.run("", "asset-groups", "add", "4", "S-1-5-21-1-1001", "S-1-5-21-1-1002")
+		require.Zero(t, result.exitCode, result.stderr)
+		require.Equal(t, [][]string{
+			{"ACTION", "NAME", "SELECTOR"},
+			{"add", "S-1-5-21-1-1001", "S-1-5-21-1-1001"},
+		}, tableRows(result.stdout))
+
+		result = fake.run("", "asset-groups", "remove", "4", "S-1-5-21-1-1001")
+		require.Zero(t, result.exitCode, result.stderr)
+
+		requests := fake.requested(http.MethodPut, "/api/v2/asset-groups/4/selectors")
+		require.Len(t, requests, 2)
+		require.Equal(t, []model.AssetGroupSelectorSpec{
+			{SelectorName: "S-1-5-21-1-1001", EntityObjectID: "S-1-5-21-1-1001", Action: model.SelectorSpecActionAdd},
+			{SelectorName: "S-1-5-21-1-1002", EntityObjectID: "S-1-5-21-1-1002", Action: model.SelectorSpecActionAdd},
+		}, decodeBody[[]model.AssetGroupSelectorSpec](t, requests[0]))
+		require.Equal(t, []model.AssetGroupSelectorSpec{
+			{SelectorName: "S-1-5-21-1-1001", EntityObjectID: "S-1-5-21-1-1001", Action: model.SelectorSpecActionRemove},
+		}, decodeBody[[]model.AssetGroupSelectorSpec](t, requests[1]))
+	})
+
+	t.Run("Reports Invalid Usage", func(t *testing.T) {
+		fake := newFakeAPI(t)
+
+		for expected, args := range map[string][]string{
+			"bhctl: invalid usage: expected one of list, create, delete, add or remove": {"asset-groups"},
+			`bhctl: invalid usage: unknown asset-groups command "rename"`:               {"asset-groups", "rename"},
+			"bhctl: invalid usage: asset-groups create -name name -tag tag":             {"asset-groups", "create", "-name", "Crown Jewels"},
+			"bhctl: invalid usage: asset-groups delete <id>":                            {"asset-groups", "delete"},
+			`bhctl: invalid asset group ID "crown_jewels"`:                              {"asset-groups", "delete", "crown_jewels"},
+			"bhctl: invalid usage: asset-groups add <group id> <object id>...":          {"asset-groups", "add", "4"},
+			`bhctl: invalid asset group ID "x"`:                                         {"asset-groups", "remove", "x", "S-1-5-21-1-1001"},
+		} {
+			result := fake.run("", args...)
+			require.Equal(t, 1, result.exitCode, expected)
+			require.Contains(t, result.stderr, expected)
+		}
+
+		require.Zero(t, fake.requestCount())
+	})
+}
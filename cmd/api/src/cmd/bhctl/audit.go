@@ -0,0 +1,62 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+func runAudit(env *environment, args []string) error {
+	var (
+		flags = env.flagSet("audit")
+
+		since  time.Duration
+		offset int
+		limit  int
+	)
+
+	flags.DurationVar(&since, "since", 24*time.Hour, "Read entries logged within this duration.")
+	flags.IntVar(&offset, "offset", 0, "Number of entries to skip.")
+	flags.IntVar(&limit, "limit", 100, "Maximum number of entries to read.")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	before := time.Now()
+
+	if logs, err := env.client.ListAuditLogs(before.Add(-since), before, offset, limit); err != nil {
+		return fmt.Errorf("failed reading audit logs: %w", err)
+	} else {
+		rows := make([][]string, 0, len(logs.Logs))
+
+		for _, entry := range logs.Logs {
+			rows = append(rows, []string{
+				strconv.FormatInt(entry.ID, 10),
+				formatTime(entry.CreatedAt),
+				entry.ActorName,
+				string(entry.Action),
+				string(entry.Status),
+				entry.SourceIpAddress,
+			})
+		}
+
+		return env.write(logs, []string{"ID", "TIME", "ACTOR", "ACTION", "STATUS", "SOURCE"}, rows)
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	v2 "github.com/specterops/bloodhound/src/api/v2"
+	"github.com/specterops/bloodhound/src/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunAudit(t *testing.T) {
+	t.Run("Reads Entries Within The Window", func(t *testing.T) {
+		var (
+			fake     = newFakeAPI(t)
+			loggedAt = time.Now().Add(-time.Minute).UTC()
+		)
+
+		fake.reply(http.MethodGet, "/api/v2/audit", v2.AuditLogsResponse{Logs: model.AuditLogs{{
+			ID:              41,
+			CreatedAt:       loggedAt,
+			ActorName:       "alice",
+			Action:          model.AuditLogActionCreateUser,
+			Status:          model.AuditLogStatusSuccess,
+			SourceIpAddress: "10.0.0.8",
+		}}})
+
+		started := time.Now()
+
+		result := fake.run("", "audit", "-since", "2h", "-offset", "5", "-limit", "10")
+		require.Zero(t, result.exitCode, result.stderr)
+		require.Equal(t, [][]string{
+			{"ID", "TIME", "ACTOR", "ACTION", "STATUS", "SOURCE"},
+			{"41", loggedAt.Local().Format(time.RFC3339), "alice", string(model.AuditLogActionCreateUser), string(model.AuditLogStatusSuccess), "10.0.0.8"},
+		}, tableRows(result.stdout))
+
+		requests := fake.requested(http.MethodGet, "/api/v2/audit")
+		require.Len(t, requests, 1)
+		require.Equal(t, "5", requests[0].query.Get(model.PaginationQueryParameterOffset))
+		require.Equal(t, "10", requests[0].query.Get(model.PaginationQueryParameterLimit))
+
+		after, err := time.Parse(time.RFC3339Nano, requests[0].query.Get(model.PaginationQueryParameterAfter))
+		require.Nil(t, err)
+
+		before, err := time.Parse(time.RFC3339Nano, requests[0].query.Get(model.PaginationQueryParameterBefore))
+		require.Nil(t, err)
+		require.Equal(t, 2*time.Hour, before.Sub(after))
+		require.False(t, before.Before(started.Truncate(time.Second)))
+	})
+
+	t.Run("Reports Errors", func(t *testing.T) {
+		fake := newFakeAPI(t)
+		fake.fail(http.MethodGet, "/api/v2/audit", http.StatusForbidden, "permission denied")
+
+		result := fake.run("", "audit")
+		require.Equal(t, 1, result.exitCode)
+		require.Equal(t, "bhctl: failed reading audit logs: Code: 403 - errors: permission denied\n", result.stderr)
+		require.Empty(t, result.stdout)
+	})
+}
@@ -0,0 +1,321 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/specterops/bloodhound/headers"
+	"github.com/specterops/bloodhound/mediatypes"
+	"github.com/specterops/bloodhound/src/api"
+	"github.com/stretchr/testify/require"
+)
+
+// recordedRequest is a request received by a fakeAPI.
+type recordedRequest struct {
+	method      string
+	path        string
+	query       url.Values
+	contentType string
+	body        []byte
+}
+
+// fakeAPI stands in for the BloodHound API. It replies to the requests of the routes registered with it, records every
+// request it receives and fails the test on requests to any other route.
+type fakeAPI struct {
+	t        *testing.T
+	server   *httptest.Server
+	lock     sync.Mutex
+	routes   map[string]http.HandlerFunc
+	requests []recordedRequest
+}
+
+func newFakeAPI(t *testing.T) *fakeAPI {
+	fake := &fakeAPI{
+		t:      t,
+		routes: map[string]http.HandlerFunc{},
+	}
+
+	fake.server = httptest.NewServer(http.HandlerFunc(fake.serve))
+	t.Cleanup(fake.server.Close)
+
+	return fake
+}
+
+func (s *fakeAPI) serve(response http.ResponseWriter, request *http.Request) {
+	body, err := io.ReadAll(request.Body)
+	require.Nil(s.t, err)
+
+	route := request.Method + " " + request.URL.Path
+
+	s.lock.Lock()
+	handler, found := s.routes[route]
+	s.requests = append(s.requests, recordedRequest{
+		method:      request.Method,
+		path:        request.URL.Path,
+		query:       request.URL.Query(),
+		contentType: request.Header.Get(headers.ContentType.String()),
+		body:        body,
+	})
+	s.lock.Unlock()
+
+	if !found {
+		s.t.Errorf("unexpected request %s", route)
+		writeAPIError(response, http.StatusNotFound, "not found")
+	} else {
+		handler(response, request)
+	}
+}
+
+func (s *fakeAPI) handle(method, path string, handler http.HandlerFunc) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.routes[method+" "+path] = handler
+}
+
+// reply registers a route that replies with the given data.
+func (s *fakeAPI) reply(method, path string, data any) {
+	s.handle(method, path, func(response http.ResponseWriter, _ *http.Request) {
+		writeAPIData(response, data)
+	})
+}
+
+// fail registers a route that replies with an API error.
+func (s *fakeAPI) fail(method, path string, status int, message string) {
+	s.handle(method, path, func(response http.ResponseWriter, _ *http.Request) {
+		writeAPIError(response, status, message)
+	})
+}
+
+// requested returns the requests received for the given route.
+func (s *fakeAPI) requested(method, path string) []recordedRequest {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var matched []recordedRequest
+
+	for _, request := range s.requests {
+		if request.method == method && request.path == path {
+			matched = append(matched, request)
+		}
+	}
+
+	return matched
+}
+
+// requestCount returns the number of requests received for any route.
+func (s *fakeAPI) requestCount() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return len(s.requests)
+}
+
+// run runs bhctl against the fake API without credentials and with a configuration file of its own.
+func (s *fakeAPI) run(stdin string, args ...string) bhctlResult {
+	return runBhctl(s.t, filepath.Join(s.t.TempDir(), "config.json"), stdin, append([]string{"-url", s.server.URL}, args...)...)
+}
+
+func writeAPIData(response http.ResponseWriter, data any) {
+	response.Header().Set(headers.ContentType.String(), mediatypes.ApplicationJson.String())
+	_ = json.NewEncoder(response).Encode(api.ResponseWrapper{Data: data})
+}
+
+func writeAPIError(response http.ResponseWriter, status int, message string) {
+	response.Header().Set(headers.ContentType.String(), mediatypes.ApplicationJson.String())
+	response.WriteHeader(status)
+	_ = json.NewEncoder(response).Encode(api.ErrorWrapper{
+		HTTPStatus: status,
+		Errors:     []api.ErrorDetails{{Message: message}},
+	})
+}
+
+// decodeBody decodes the JSON body of a recorded request.
+func decodeBody[T any](t *testing.T, request recordedRequest) T {
+	var value T
+
+	require.Equal(t, mediatypes.ApplicationJson.String(), request.contentType)
+	require.Nil(t, json.Unmarshal(request.body, &value))
+
+	return value
+}
+
+type bhctlResult struct {
+	exitCode int
+	stdout   string
+	stderr   string
+}
+
+// unsetEnvironment clears the bhctl environment variables for the duration of the test.
+func unsetEnvironment(t *testing.T) {
+	for _, envVar := range []string{envProfile, envURL, envTokenID, envTokenKey, envUsername, envSecret} {
+		t.Setenv(envVar, "")
+		require.Nil(t, os.Unsetenv(envVar))
+	}
+}
+
+// runBhctl runs bhctl with the given configuration file and standard input.
+func runBhctl(t *testing.T, configPath string, stdin string, args ...string) bhctlResult {
+	var (
+		stdout strings.Builder
+		stderr strings.Builder
+	)
+
+	unsetEnvironment(t)
+
+	exitCode := execute(append([]string{"-config", configPath}, args...), strings.NewReader(stdin), &stdout, &stderr)
+
+	return bhctlResult{
+		exitCode: exitCode,
+		stdout:   stdout.String(),
+		stderr:   stderr.String(),
+	}
+}
+
+// tableRows returns the fields of each line of table output.
+func tableRows(output string) [][]string {
+	var rows [][]string
+
+	for _, line := range strings.Split(strings.TrimRight(output, "\n"), "\n") {
+		rows = append(rows, strings.Fields(line))
+	}
+
+	return rows
+}
+
+func TestExecute(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.json")
+
+	t.Run("Requires A Command", func(t *testing.T) {
+		result := runBhctl(t, configPath, "")
+		require.Equal(t, 1, result.exitCode)
+		require.Contains(t, result.stderr, "Usage: bhctl [options] command [arguments]")
+		require.Contains(t, result.stderr, "bhctl: invalid usage: no command given")
+		require.Empty(t, result.stdout)
+	})
+
+	t.Run("Rejects Unknown Command", func(t *testing.T) {
+		result := runBhctl(t, configPath, "", "deploy")
+		require.Equal(t, 1, result.exitCode)
+		require.Contains(t, result.stderr, `bhctl: invalid usage: unknown command "deploy"`)
+	})
+
+	t.Run("Prints Usage On Help", func(t *testing.T) {
+		result := runBhctl(t, configPath, "", "-help")
+		require.Equal(t, 0, result.exitCode)
+		require.Contains(t, result.stderr, "asset-groups")
+		require.Contains(t, result.stderr, envTokenKey)
+		require.NotContains(t, result.stderr, "bhctl: ")
+	})
+
+	t.Run("Rejects Unknown Output Format", func(t *testing.T) {
+		result := runBhctl(t, configPath, "", "-output", "yaml", "audit")
+		require.Equal(t, 1, result.exitCode)
+		require.Contains(t, result.stderr, `bhctl: invalid usage: unknown output format "yaml"; expected table or json`)
+	})
+
+	t.Run("Requires A URL For API Commands", func(t *testing.T) {
+		result := runBhctl(t, configPath, "", "audit")
+		require.Equal(t, 1, result.exitCode)
+		require.Contains(t, result.stderr, "bhctl: no BloodHound URL configured")
+	})
+
+	t.Run("Reports Invalid Command Flags", func(t *testing.T) {
+		result := newFakeAPI(t).run("", "audit", "-limit", "many")
+		require.Equal(t, 1, result.exitCode)
+		require.Contains(t, result.stderr, `invalid value "many" for flag -limit`)
+		require.Contains(t, result.stderr, "Usage of audit:")
+	})
+
+	t.Run("Signs Requests With The Profile Token", func(t *testing.T) {
+		var (
+			fake       = newFakeAPI(t)
+			configPath = filepath.Join(t.TempDir(), "config.json")
+			authorized string
+		)
+
+		fake.handle(http.MethodGet, "/api/v2/audit", func(response http.ResponseWriter, request *http.Request) {
+			authorized = request.Header.Get(headers.Authorization.String())
+			writeAPIData(response, nil)
+		})
+
+		require.Zero(t, runBhctl(t, configPath, "", "profile", "set", "prod", "-url", fake.server.URL, "-token-id", "id", "-token-key", "key").exitCode)
+		require.Zero(t, runBhctl(t, configPath, "", "audit").exitCode)
+		require.Equal(t, api.AuthorizationSchemeBHESignature+" id", authorized)
+	})
+}
+
+func TestProfiles_SaveAndResolve(t *testing.T) {
+	var (
+		path   = filepath.Join(t.TempDir(), "bhctl", "config.json")
+		stored = profiles{
+			Current: "prod",
+			Profiles: map[string]profile{
+				"prod": {URL: "https://bloodhound.example.com", TokenID: "id", TokenKey: "key"},
+			},
+		}
+	)
+
+	missing, err := loadProfiles(path)
+	require.Nil(t, err)
+	require.Empty(t, missing.Profiles)
+
+	require.Nil(t, stored.save(path))
+
+	loaded, err := loadProfiles(path)
+	require.Nil(t, err)
+	require.Equal(t, stored, loaded)
+
+	current, err := loaded.resolve("")
+	require.Nil(t, err)
+	require.Equal(t, "token", current.authentication())
+
+	t.Setenv(envURL, "http://localhost:8080")
+
+	overridden, err := loaded.resolve("prod")
+	require.Nil(t, err)
+	require.Equal(t, "http://localhost:8080", overridden.URL)
+	require.Equal(t, "key", overridden.TokenKey)
+
+	_, err = loaded.resolve("staging")
+	require.ErrorContains(t, err, "does not exist")
+}
+
+func TestUploadContentType(t *testing.T) {
+	contentType, err := uploadContentType("collection.ZIP")
+	require.Nil(t, err)
+	require.Equal(t, "application/zip", contentType)
+
+	_, err = uploadContentType("collection.txt")
+	require.NotNil(t, err)
+}
+
+func TestFormatBytes(t *testing.T) {
+	require.Equal(t, "512 B", formatBytes(512))
+	require.Equal(t, "1.5 KiB", formatBytes(1536))
+	require.Equal(t, "2.0 MiB", formatBytes(2*1024*1024))
+}
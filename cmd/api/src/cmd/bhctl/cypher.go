@@ -0,0 +1,141 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	v2 "github.com/specterops/bloodhound/src/api/v2"
+	"github.com/specterops/bloodhound/src/model"
+)
+
+// findSavedQuery returns the saved query with the given name or ID.
+func findSavedQuery(env *environment, nameOrID string) (model.SavedQuery, error) {
+	savedQueries, err := env.client.ListSavedQueries()
+	if err != nil {
+		return model.SavedQuery{}, fmt.Errorf("failed listing saved queries: %w", err)
+	}
+
+	for _, savedQuery := range savedQueries {
+		if savedQuery.Name == nameOrID || strconv.FormatInt(savedQuery.ID, 10) == nameOrID {
+			return savedQuery, nil
+		}
+	}
+
+	return model.SavedQuery{}, fmt.Errorf("saved query %q does not exist", nameOrID)
+}
+
+func readCypherQuery(env *environment, flags *flag.FlagSet, saved string) (string, error) {
+	switch {
+	case saved != "" && flags.NArg() > 0:
+		return "", fmt.Errorf("%w: give either a saved query or a query, not both", ErrUsage)
+
+	case saved != "":
+		if savedQuery, err := findSavedQuery(env, saved); err != nil {
+			return "", err
+		} else {
+			return savedQuery.Query, nil
+		}
+
+	case flags.NArg() == 1 && flags.Arg(0) == "-":
+		if content, err := io.ReadAll(env.stdin); err != nil {
+			return "", fmt.Errorf("failed reading query from stdin: %w", err)
+		} else {
+			return string(content), nil
+		}
+
+	case flags.NArg() > 0:
+		return strings.Join(flags.Args(), " "), nil
+
+	default:
+		return "", fmt.Errorf("%w: cypher requires a query, - to read one from stdin or -saved", ErrUsage)
+	}
+}
+
+func writeCypherResult(env *environment, result model.UnifiedGraph) error {
+	if env.output == outputJSON {
+		return env.write(result, nil, nil)
+	}
+
+	var (
+		nodeIDs   = make([]string, 0, len(result.Nodes))
+		nodeRows  [][]string
+		edgeRows  [][]string
+		nodeLabel = func(id string) string {
+			if node, found := result.Nodes[id]; found && node.Label != "" {
+				return node.Label
+			}
+
+			return id
+		}
+	)
+
+	for id := range result.Nodes {
+		nodeIDs = append(nodeIDs, id)
+	}
+
+	sort.Strings(nodeIDs)
+
+	for _, id := range nodeIDs {
+		node := result.Nodes[id]
+		nodeRows = append(nodeRows, []string{id, node.Kind, node.Label, node.ObjectId})
+	}
+
+	for _, edge := range result.Edges {
+		edgeRows = append(edgeRows, []string{nodeLabel(edge.Source), edge.Kind, nodeLabel(edge.Target)})
+	}
+
+	if err := env.write(nil, []string{"ID", "KIND", "LABEL", "OBJECT ID"}, nodeRows); err != nil {
+		return err
+	}
+
+	if len(edgeRows) > 0 {
+		fmt.Fprintln(env.stdout)
+		return env.write(nil, []string{"SOURCE", "RELATIONSHIP", "TARGET"}, edgeRows)
+	}
+
+	return nil
+}
+
+func runCypher(env *environment, args []string) error {
+	var (
+		flags = env.flagSet("cypher")
+
+		saved             string
+		includeProperties bool
+	)
+
+	flags.StringVar(&saved, "saved", "", "Name or ID of a saved query to run.")
+	flags.BoolVar(&includeProperties, "include-properties", false, "Include node and relationship properties in JSON output.")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if query, err := readCypherQuery(env, flags, saved); err != nil {
+		return err
+	} else if result, err := env.client.CypherQuery(v2.CypherQueryPayload{Query: query, IncludeProperties: includeProperties}); err != nil {
+		return fmt.Errorf("cypher query failed: %w", err)
+	} else {
+		return writeCypherResult(env, result)
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"net/http"
+	"testing"
+
+	v2 "github.com/specterops/bloodhound/src/api/v2"
+	"github.com/specterops/bloodhound/src/model"
+	"github.com/stretchr/testify/require"
+)
+
+var cypherTestResult = model.UnifiedGraph{
+	Nodes: map[string]model.UnifiedNode{
+		"1": {Label: "ALICE@EXAMPLE.COM", Kind: "User", ObjectId: "S-1-5-21-1-1001"},
+		"2": {Label: "DOMAIN ADMINS@EXAMPLE.COM", Kind: "Group", ObjectId: "S-1-5-21-1-512"},
+	},
+	Edges: []model.UnifiedEdge{
+		{Source: "1", Target: "2", Kind: "MemberOf"},
+	},
+}
+
+func TestRunCypher(t *testing.T) {
+	t.Run("Runs Query From Arguments", func(t *testing.T) {
+		fake := newFakeAPI(t)
+		fake.reply(http.MethodPost, "/api/v2/graphs/cypher", cypherTestResult)
+
+		result := fake.run("", "cypher", "-include-properties", "match (n)-[r]->(m)", "return", "n, r, m")
+		require.Zero(t, result.exitCode, result.stderr)
+		require.Equal(t, `ID  KIND   LABEL                      OBJECT ID
+1   User   ALICE@EXAMPLE.COM          S-1-5-21-1-1001
+2   Group  DOMAIN ADMINS@EXAMPLE.COM  S-1-5-21-1-512
+
+SOURCE             RELATIONSHIP  TARGET
+ALICE@EXAMPLE.COM  MemberOf      DOMAIN ADMINS@EXAMPLE.COM
+`, result.stdout)
+
+		requests := fake.requested(http.MethodPost, "/api/v2/graphs/cypher")
+		require.Len(t, requests, 1)
+		require.Equal(t, v2.CypherQueryPayload{Query: "match (n)-[r]->(m) return n, r, m", IncludeProperties: true}, decodeBody[v2.CypherQueryPayload](t, requests[0]))
+	})
+
+	t.Run("Runs Query From Standard Input", func(t *testing.T) {
+		fake := newFakeAPI(t)
+		fake.reply(http.MethodPost, "/api/v2/graphs/cypher", model.UnifiedGraph{})
+
+		result := fake.run("match (n)\nreturn n", "-output", "json", "cypher", "-")
+		require.Zero(t, result.exitCode, result.stderr)
+		require.JSONEq(t, `{"nodes": null, "edges": null}`, result.stdout)
+
+		requests := fake.requested(http.MethodPost, "/api/v2/graphs/cypher")
+		require.Len(t, requests, 1)
+		require.Equal(t, "match (n)\nreturn n", decodeBody[v2.CypherQueryPayload](t, requests[0]).Query)
+	})
+
+	t.Run("Runs Saved Query By Name Or ID", func(t *testing.T) {
+		fake := newFakeAPI(t)
+		fake.reply(http.MethodGet, "/api/v2/saved-queries", model.SavedQueries{
+			{Name: "Kerberoastable Users", Query: "match (u:User {hasspn: true}) return u", BigSerial: model.BigSerial{ID: 12}},
+		})
+		fake.reply(http.MethodPost, "/api/v2/graphs/cypher", model.UnifiedGraph{})
+
+		for _, saved := range []string{"Kerberoastable Users", "12"} {
+			result := fake.run("", "cypher", "-saved", saved)
+			require.Zero(t, result.exitCode, result.stderr)
+		}
+
+		requests := fake.requested(http.MethodPost, "/api/v2/graphs/cypher")
+		require.Len(t, requests, 2)
+
+		for _, request := range requests {
+			require.Equal(t, "match (u:User {hasspn: true}) return u", decodeBody[v2.CypherQueryPayload](t, request).Query)
+		}
+
+		result := fake.run("", "cypher", "-saved", "13")
+		require.Equal(t, 1, result.exitCode)
+		require.Contains(t, result.stderr, `bhctl: saved query "13" does not exist`)
+		require.Len(t, fake.requested(http.MethodPost, "/api/v2/graphs/cypher"), 2)
+	})
+
+	t.Run("Reports Query Errors", func(t *testing.T) {
+		fake := newFakeAPI(t)
+		fake.fail(http.MethodPost, "/api/v2/graphs/cypher", http.StatusBadRequest, "syntax error")
+
+		result := fake.run("", "cypher", "match (n) retrun n")
+		require.Equal(t, 1, result.exitCode)
+		require.Equal(t, "bhctl: cypher query failed: Code: 400 - errors: syntax error\n", result.stderr)
+		require.Empty(t, result.stdout)
+	})
+
+	t.Run("Reports Invalid Usage", func(t *testing.T) {
+		fake := newFakeAPI(t)
+
+		result := fake.run("", "cypher")
+		require.Equal(t, 1, result.exitCode)
+		require.Contains(t, result.stderr, "bhctl: invalid usage: cypher requires a query, - to read one from stdin or -saved")
+
+		result = fake.run("", "cypher", "-saved", "12", "match (n) return n")
+		require.Equal(t, 1, result.exitCode)
+		require.Contains(t, result.stderr, "bhctl: invalid usage: give either a saved query or a query, not both")
+		require.Zero(t, fake.requestCount())
+	})
+}
@@ -0,0 +1,209 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Command bhctl is a command-line client for the BloodHound API.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/specterops/bloodhound/src/api/v2/apiclient"
+)
+
+var (
+	ErrUsage = errors.New("invalid usage")
+)
+
+// environment carries the global options and the configured API client to each command.
+type environment struct {
+	client      apiclient.Client
+	stdin       io.Reader
+	stdout      io.Writer
+	stderr      io.Writer
+	output      outputFormat
+	profileName string
+	profiles    profiles
+	configPath  string
+}
+
+// flagSet returns a flag set for the named command that reports parsing errors and usage on the error output.
+func (s *environment) flagSet(name string) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(s.stderr)
+
+	return flags
+}
+
+type commandFunc func(env *environment, args []string) error
+
+type command struct {
+	usage       string
+	description string
+	run         commandFunc
+
+	// Commands that manage the local configuration do not need an API client
+	local bool
+}
+
+var commands = map[string]command{
+	"profile": {
+		usage:       "profile list|show|set|use|delete",
+		description: "Manage connection profiles",
+		run:         runProfile,
+		local:       true,
+	},
+	"upload": {
+		usage:       "upload [-wait] [-timeout duration] file...",
+		description: "Upload collector zip or json files for ingest",
+		run:         runUpload,
+	},
+	"analysis": {
+		usage:       "analysis request|status|wait",
+		description: "Request analysis and wait for the datapipe to finish",
+		run:         runAnalysis,
+	},
+	"cypher": {
+		usage:       "cypher [-saved name|id] [-include-properties] [query|-]",
+		description: "Run a saved or ad-hoc cypher query",
+		run:         runCypher,
+	},
+	"users": {
+		usage:       "users list|create|delete",
+		description: "Manage users",
+		run:         runUsers,
+	},
+	"tokens": {
+		usage:       "tokens list|create|delete",
+		description: "Manage API tokens",
+		run:         runTokens,
+	},
+	"asset-groups": {
+		usage:       "asset-groups list|create|delete|add|remove",
+		description: "Manage asset groups and their members",
+		run:         runAssetGroups,
+	},
+	"audit": {
+		usage:       "audit [-since duration] [-limit n]",
+		description: "Read audit log entries",
+		run:         runAudit,
+	},
+}
+
+func usage(flags *flag.FlagSet) func() {
+	return func() {
+		var (
+			writer = flags.Output()
+			names  = make([]string, 0, len(commands))
+		)
+
+		for name := range commands {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		fmt.Fprint(writer, "BloodHound command-line client\n\nUsage: bhctl [options] command [arguments]\n\nOptions:\n")
+		flags.PrintDefaults()
+		fmt.Fprint(writer, "\nCommands:\n")
+
+		for _, name := range names {
+			fmt.Fprintf(writer, "  %-14s %s\n", name, commands[name].description)
+		}
+
+		fmt.Fprintf(writer, "\nCredentials are read from the selected profile and may be overridden with the %s, %s, %s, %s and %s environment variables.\n",
+			envURL, envTokenID, envTokenKey, envUsername, envSecret)
+	}
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	var (
+		env = environment{
+			stdin:  stdin,
+			stdout: stdout,
+			stderr: stderr,
+		}
+		flags = env.flagSet("bhctl")
+
+		serviceURL string
+		output     string
+		timeout    time.Duration
+	)
+
+	flags.StringVar(&env.configPath, "config", defaultConfigPath(), "Path of the bhctl configuration file.")
+	flags.StringVar(&env.profileName, "profile", os.Getenv(envProfile), "Name of the connection profile to use. Defaults to the current profile.")
+	flags.StringVar(&serviceURL, "url", "", "URL of the BloodHound instance. Overrides the profile URL.")
+	flags.StringVar(&output, "output", string(outputTable), "Output format, either table or json.")
+	flags.DurationVar(&timeout, "request-timeout", 5*time.Minute, "Timeout of each API request.")
+	flags.Usage = usage(flags)
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	} else if flags.NArg() == 0 {
+		flags.Usage()
+		return fmt.Errorf("%w: no command given", ErrUsage)
+	}
+
+	selected, found := commands[flags.Arg(0)]
+	if !found {
+		flags.Usage()
+		return fmt.Errorf("%w: unknown command %q", ErrUsage, flags.Arg(0))
+	}
+
+	if parsedOutput, err := parseOutputFormat(output); err != nil {
+		return err
+	} else {
+		env.output = parsedOutput
+	}
+
+	if loadedProfiles, err := loadProfiles(env.configPath); err != nil {
+		return err
+	} else {
+		env.profiles = loadedProfiles
+	}
+
+	if !selected.local {
+		if client, err := env.profiles.client(env.profileName, serviceURL); err != nil {
+			return err
+		} else {
+			client.Http.Timeout = timeout
+			env.client = client
+		}
+	}
+
+	return selected.run(&env, flags.Args()[1:])
+}
+
+// execute runs bhctl with the given arguments and returns its exit code. Errors are printed to the error output.
+func execute(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if err := run(args, stdin, stdout, stderr); errors.Is(err, flag.ErrHelp) {
+		return 0
+	} else if err != nil {
+		fmt.Fprintf(stderr, "bhctl: %v\n", err)
+		return 1
+	}
+
+	return 0
+}
+
+func main() {
+	os.Exit(execute(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
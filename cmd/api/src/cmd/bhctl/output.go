@@ -0,0 +1,90 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+type outputFormat string
+
+const (
+	outputTable outputFormat = "table"
+	outputJSON  outputFormat = "json"
+)
+
+func parseOutputFormat(value string) (outputFormat, error) {
+	switch format := outputFormat(strings.ToLower(value)); format {
+	case outputTable, outputJSON:
+		return format, nil
+	default:
+		return "", fmt.Errorf("%w: unknown output format %q; expected table or json", ErrUsage, value)
+	}
+}
+
+// write prints a command result. JSON output encodes the given value as returned by the API while table output prints
+// the given rows under the given header.
+func (s *environment) write(value any, header []string, rows [][]string) error {
+	if s.output == outputJSON {
+		encoder := json.NewEncoder(s.stdout)
+		encoder.SetIndent("", "  ")
+
+		return encoder.Encode(value)
+	}
+
+	writer := tabwriter.NewWriter(s.stdout, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(writer, strings.Join(header, "\t"))
+
+	for _, row := range rows {
+		fmt.Fprintln(writer, strings.Join(row, "\t"))
+	}
+
+	return writer.Flush()
+}
+
+func formatTime(value time.Time) string {
+	if value.IsZero() {
+		return "-"
+	}
+
+	return value.Local().Format(time.RFC3339)
+}
+
+func formatBytes(size int64) string {
+	const unit = 1024
+
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+
+	var (
+		divisor  = int64(unit)
+		exponent = 0
+	)
+
+	for remaining := size / unit; remaining >= unit; remaining /= unit {
+		divisor *= unit
+		exponent++
+	}
+
+	return fmt.Sprintf("%.1f %ciB", float64(size)/float64(divisor), "KMGTPE"[exponent])
+}
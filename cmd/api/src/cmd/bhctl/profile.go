@@ -0,0 +1,328 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/specterops/bloodhound/src/api/v2/apiclient"
+)
+
+const (
+	envProfile  = "BHCTL_PROFILE"
+	envURL      = "BHCTL_URL"
+	envTokenID  = "BHCTL_TOKEN_ID"
+	envTokenKey = "BHCTL_TOKEN_KEY"
+	envUsername = "BHCTL_USERNAME"
+	envSecret   = "BHCTL_SECRET"
+
+	defaultProfileName = "default"
+	redactedValue      = "********"
+)
+
+// profile holds the connection details for a BloodHound instance. HMAC signed token credentials are preferred over a
+// username and secret when both are set.
+type profile struct {
+	URL      string `json:"url"`
+	TokenID  string `json:"token_id,omitempty"`
+	TokenKey string `json:"token_key,omitempty"`
+	Username string `json:"username,omitempty"`
+	Secret   string `json:"secret,omitempty"`
+}
+
+func (s profile) authentication() string {
+	if s.TokenID != "" && s.TokenKey != "" {
+		return "token"
+	} else if s.Username != "" && s.Secret != "" {
+		return "secret"
+	}
+
+	return "none"
+}
+
+// withEnvironment returns a copy of the profile with any values set in the environment taking precedence.
+func (s profile) withEnvironment() profile {
+	for envVar, value := range map[string]*string{
+		envURL:      &s.URL,
+		envTokenID:  &s.TokenID,
+		envTokenKey: &s.TokenKey,
+		envUsername: &s.Username,
+		envSecret:   &s.Secret,
+	} {
+		if envValue, isSet := os.LookupEnv(envVar); isSet {
+			*value = envValue
+		}
+	}
+
+	return s
+}
+
+func (s profile) redacted() profile {
+	if s.TokenKey != "" {
+		s.TokenKey = redactedValue
+	}
+
+	if s.Secret != "" {
+		s.Secret = redactedValue
+	}
+
+	return s
+}
+
+// profiles is the content of the bhctl configuration file.
+type profiles struct {
+	Current  string             `json:"current_profile"`
+	Profiles map[string]profile `json:"profiles"`
+}
+
+func defaultConfigPath() string {
+	if configDir, err := os.UserConfigDir(); err == nil {
+		return filepath.Join(configDir, "bhctl", "config.json")
+	}
+
+	return "bhctl.json"
+}
+
+// loadProfiles reads the configuration file at the given path. A missing file is treated as an empty configuration.
+func loadProfiles(path string) (profiles, error) {
+	loaded := profiles{
+		Profiles: map[string]profile{},
+	}
+
+	if content, err := os.ReadFile(path); errors.Is(err, fs.ErrNotExist) {
+		return loaded, nil
+	} else if err != nil {
+		return loaded, fmt.Errorf("failed reading configuration %s: %w", path, err)
+	} else if err := json.Unmarshal(content, &loaded); err != nil {
+		return loaded, fmt.Errorf("failed parsing configuration %s: %w", path, err)
+	}
+
+	if loaded.Profiles == nil {
+		loaded.Profiles = map[string]profile{}
+	}
+
+	return loaded, nil
+}
+
+// save writes the configuration file. The file holds credentials so it is only readable by its owner.
+func (s profiles) save(path string) error {
+	if content, err := json.MarshalIndent(s, "", "  "); err != nil {
+		return err
+	} else if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("failed creating configuration directory: %w", err)
+	} else if err := os.WriteFile(path, append(content, '\n'), 0600); err != nil {
+		return fmt.Errorf("failed writing configuration %s: %w", path, err)
+	}
+
+	return nil
+}
+
+func (s profiles) currentName() string {
+	if s.Current != "" {
+		return s.Current
+	}
+
+	return defaultProfileName
+}
+
+// resolve returns the named profile, or the current profile if no name is given, with environment overrides applied.
+// Only an explicitly named profile must exist so that bhctl may be configured entirely through the environment.
+func (s profiles) resolve(name string) (profile, error) {
+	if name == "" {
+		return s.Profiles[s.currentName()].withEnvironment(), nil
+	} else if named, found := s.Profiles[name]; !found {
+		return profile{}, fmt.Errorf("profile %q does not exist", name)
+	} else {
+		return named.withEnvironment(), nil
+	}
+}
+
+func (s profiles) client(name, serviceURL string) (apiclient.Client, error) {
+	resolved, err := s.resolve(name)
+	if err != nil {
+		return apiclient.Client{}, err
+	}
+
+	if serviceURL != "" {
+		resolved.URL = serviceURL
+	}
+
+	if resolved.URL == "" {
+		return apiclient.Client{}, fmt.Errorf("no BloodHound URL configured; set one with `bhctl profile set` or %s", envURL)
+	}
+
+	client, err := apiclient.NewClient(resolved.URL)
+	if err != nil {
+		return client, fmt.Errorf("invalid BloodHound URL %s: %w", resolved.URL, err)
+	}
+
+	switch resolved.authentication() {
+	case "token":
+		client.Credentials = &apiclient.TokenCredentialsHandler{
+			TokenID:  resolved.TokenID,
+			TokenKey: resolved.TokenKey,
+		}
+
+	case "secret":
+		client.Credentials = &apiclient.SecretCredentialsHandler{
+			Username: resolved.Username,
+			Secret:   resolved.Secret,
+			Client:   client,
+		}
+	}
+
+	return client, nil
+}
+
+func runProfile(env *environment, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("%w: expected one of list, show, set, use or delete", ErrUsage)
+	}
+
+	switch subcommand, args := args[0], args[1:]; subcommand {
+	case "list":
+		var (
+			names = make([]string, 0, len(env.profiles.Profiles))
+			rows  [][]string
+		)
+
+		for name := range env.profiles.Profiles {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		for _, name := range names {
+			current := ""
+
+			if name == env.profiles.currentName() {
+				current = "*"
+			}
+
+			rows = append(rows, []string{current, name, env.profiles.Profiles[name].URL, env.profiles.Profiles[name].authentication()})
+		}
+
+		return env.write(env.profiles.redacted(), []string{"CURRENT", "NAME", "URL", "AUTHENTICATION"}, rows)
+
+	case "show":
+		if resolved, err := env.profiles.resolve(env.profileName); err != nil {
+			return err
+		} else {
+			resolved = resolved.redacted()
+			return env.write(resolved, []string{"URL", "TOKEN ID", "USERNAME", "AUTHENTICATION"}, [][]string{{resolved.URL, resolved.TokenID, resolved.Username, resolved.authentication()}})
+		}
+
+	case "set":
+		return setProfile(env, args)
+
+	case "use":
+		if len(args) != 1 {
+			return fmt.Errorf("%w: profile use <name>", ErrUsage)
+		} else if _, found := env.profiles.Profiles[args[0]]; !found {
+			return fmt.Errorf("profile %q does not exist", args[0])
+		} else {
+			env.profiles.Current = args[0]
+			return env.profiles.save(env.configPath)
+		}
+
+	case "delete":
+		if len(args) != 1 {
+			return fmt.Errorf("%w: profile delete <name>", ErrUsage)
+		} else if _, found := env.profiles.Profiles[args[0]]; !found {
+			return fmt.Errorf("profile %q does not exist", args[0])
+		} else {
+			delete(env.profiles.Profiles, args[0])
+
+			if env.profiles.Current == args[0] {
+				env.profiles.Current = ""
+			}
+
+			return env.profiles.save(env.configPath)
+		}
+
+	default:
+		return fmt.Errorf("%w: unknown profile command %q", ErrUsage, subcommand)
+	}
+}
+
+func (s profiles) redacted() profiles {
+	redacted := profiles{
+		Current:  s.Current,
+		Profiles: make(map[string]profile, len(s.Profiles)),
+	}
+
+	for name, existing := range s.Profiles {
+		redacted.Profiles[name] = existing.redacted()
+	}
+
+	return redacted
+}
+
+// setProfile creates or updates the named profile. Only the values given as flags are changed and the first profile
+// created becomes the current profile.
+func setProfile(env *environment, args []string) error {
+	var (
+		flags   = env.flagSet("profile set")
+		updated profile
+	)
+
+	flags.StringVar(&updated.URL, "url", "", "URL of the BloodHound instance.")
+	flags.StringVar(&updated.TokenID, "token-id", "", "ID of the API token used to sign requests.")
+	flags.StringVar(&updated.TokenKey, "token-key", "", "Key of the API token used to sign requests.")
+	flags.StringVar(&updated.Username, "username", "", "Username to log in with when no API token is set.")
+	flags.StringVar(&updated.Secret, "secret", "", "Secret to log in with when no API token is set.")
+
+	if len(args) == 0 {
+		return fmt.Errorf("%w: profile set <name> [flags]", ErrUsage)
+	} else if err := flags.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	var (
+		name     = args[0]
+		existing = env.profiles.Profiles[name]
+	)
+
+	flags.Visit(func(setFlag *flag.Flag) {
+		switch setFlag.Name {
+		case "url":
+			existing.URL = updated.URL
+		case "token-id":
+			existing.TokenID = updated.TokenID
+		case "token-key":
+			existing.TokenKey = updated.TokenKey
+		case "username":
+			existing.Username = updated.Username
+		case "secret":
+			existing.Secret = updated.Secret
+		}
+	})
+
+	if len(env.profiles.Profiles) == 0 {
+		env.profiles.Current = name
+	}
+
+	env.profiles.Profiles[name] = existing
+	return env.profiles.save(env.configPath)
+}
@@ -0,0 +1,107 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunProfile(t *testing.T) {
+	var (
+		configPath = filepath.Join(t.TempDir(), "bhctl", "config.json")
+		bhctl      = func(args ...string) bhctlResult {
+			return runBhctl(t, configPath, "", args...)
+		}
+	)
+
+	t.Run("Set Creates The First Profile As Current", func(t *testing.T) {
+		require.Zero(t, bhctl("profile", "set", "prod", "-url", "https://bloodhound.example.com", "-token-id", "id", "-token-key", "key").exitCode)
+		require.Zero(t, bhctl("profile", "set", "lab", "-url", "http://localhost:8080", "-username", "admin", "-secret", "password").exitCode)
+
+		loaded, err := loadProfiles(configPath)
+		require.Nil(t, err)
+		require.Equal(t, "prod", loaded.Current)
+		require.Equal(t, profile{URL: "http://localhost:8080", Username: "admin", Secret: "password"}, loaded.Profiles["lab"])
+	})
+
+	t.Run("Set Only Changes The Given Values", func(t *testing.T) {
+		require.Zero(t, bhctl("profile", "set", "prod", "-token-key", "rotated").exitCode)
+
+		loaded, err := loadProfiles(configPath)
+		require.Nil(t, err)
+		require.Equal(t, profile{URL: "https://bloodhound.example.com", TokenID: "id", TokenKey: "rotated"}, loaded.Profiles["prod"])
+	})
+
+	t.Run("List Marks The Current Profile", func(t *testing.T) {
+		result := bhctl("profile", "list")
+		require.Zero(t, result.exitCode)
+		require.Equal(t, [][]string{
+			{"CURRENT", "NAME", "URL", "AUTHENTICATION"},
+			{"lab", "http://localhost:8080", "secret"},
+			{"*", "prod", "https://bloodhound.example.com", "token"},
+		}, tableRows(result.stdout))
+	})
+
+	t.Run("Show Redacts Credentials", func(t *testing.T) {
+		result := bhctl("-output", "json", "-profile", "lab", "profile", "show")
+		require.Zero(t, result.exitCode)
+
+		var shown profile
+		require.Nil(t, json.Unmarshal([]byte(result.stdout), &shown))
+		require.Equal(t, profile{URL: "http://localhost:8080", Username: "admin", Secret: redactedValue}, shown)
+	})
+
+	t.Run("Use Switches The Current Profile", func(t *testing.T) {
+		require.Zero(t, bhctl("profile", "use", "lab").exitCode)
+
+		loaded, err := loadProfiles(configPath)
+		require.Nil(t, err)
+		require.Equal(t, "lab", loaded.Current)
+
+		result := bhctl("profile", "use", "staging")
+		require.Equal(t, 1, result.exitCode)
+		require.Contains(t, result.stderr, `bhctl: profile "staging" does not exist`)
+	})
+
+	t.Run("Delete Clears The Current Profile", func(t *testing.T) {
+		require.Zero(t, bhctl("profile", "delete", "lab").exitCode)
+
+		loaded, err := loadProfiles(configPath)
+		require.Nil(t, err)
+		require.Empty(t, loaded.Current)
+		require.NotContains(t, loaded.Profiles, "lab")
+	})
+
+	t.Run("Reports Invalid Usage", func(t *testing.T) {
+		for expected, args := range map[string][]string{
+			"bhctl: invalid usage: expected one of list, show, set, use or delete": {"profile"},
+			`bhctl: invalid usage: unknown profile command "rename"`:               {"profile", "rename"},
+			"bhctl: invalid usage: profile use <name>":                             {"profile", "use"},
+			"bhctl: invalid usage: profile delete <name>":                          {"profile", "delete", "prod", "lab"},
+			"bhctl: invalid usage: profile set <name> [flags]":                     {"profile", "set"},
+			"flag provided but not defined: -password":                             {"profile", "set", "prod", "-password", "secret"},
+		} {
+			result := bhctl(args...)
+			require.Equal(t, 1, result.exitCode, expected)
+			require.Contains(t, result.stderr, expected)
+		}
+	})
+}
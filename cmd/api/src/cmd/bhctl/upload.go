@@ -0,0 +1,165 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/specterops/bloodhound/mediatypes"
+)
+
+// progressReader reports the progress of a file upload as the file is read by the HTTP client.
+type progressReader struct {
+	reader      io.Reader
+	output      io.Writer
+	name        string
+	total       int64
+	read        int64
+	lastPercent int
+}
+
+func newProgressReader(reader io.Reader, output io.Writer, name string, total int64) *progressReader {
+	return &progressReader{
+		reader:      reader,
+		output:      output,
+		name:        name,
+		total:       total,
+		lastPercent: -1,
+	}
+}
+
+func (s *progressReader) Read(buffer []byte) (int, error) {
+	read, err := s.reader.Read(buffer)
+	s.read += int64(read)
+
+	// Only report whole percentage changes to keep the output readable on slow terminals
+	if percent := s.percent(); percent != s.lastPercent {
+		s.lastPercent = percent
+		fmt.Fprintf(s.output, "\rUploading %s: %3d%% (%s / %s)", s.name, percent, formatBytes(s.read), formatBytes(s.total))
+	}
+
+	return read, err
+}
+
+func (s *progressReader) percent() int {
+	if s.total <= 0 {
+		return 100
+	}
+
+	return int(s.read * 100 / s.total)
+}
+
+func uploadContentType(path string) (string, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".zip":
+		return mediatypes.ApplicationZip.String(), nil
+	case ".json":
+		return mediatypes.ApplicationJson.String(), nil
+	default:
+		return "", fmt.Errorf("%s is neither a zip nor a json file", path)
+	}
+}
+
+func uploadFile(env *environment, jobID int64, path string) error {
+	contentType, err := uploadContentType(path)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	err = env.client.SendFileUploadStream(jobID, newProgressReader(file, env.stderr, filepath.Base(path), info.Size()), contentType)
+	fmt.Fprintln(env.stderr)
+
+	if err != nil {
+		return fmt.Errorf("failed uploading %s: %w", path, err)
+	}
+
+	return nil
+}
+
+func runUpload(env *environment, args []string) error {
+	var (
+		flags = env.flagSet("upload")
+
+		wait         bool
+		timeout      time.Duration
+		pollInterval time.Duration
+	)
+
+	flags.BoolVar(&wait, "wait", false, "Wait for ingest and the following analysis to finish.")
+	flags.DurationVar(&timeout, "timeout", time.Hour, "Maximum time to wait for analysis.")
+	flags.DurationVar(&pollInterval, "poll-interval", 10*time.Second, "Interval between datapipe status checks.")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	} else if flags.NArg() == 0 {
+		return fmt.Errorf("%w: upload requires at least one file", ErrUsage)
+	}
+
+	// Check every file up front so that a typo does not leave a partially uploaded job behind
+	for _, path := range flags.Args() {
+		if _, err := uploadContentType(path); err != nil {
+			return err
+		} else if _, err := os.Stat(path); err != nil {
+			return err
+		}
+	}
+
+	status, err := env.client.GetDatapipeStatus()
+	if err != nil {
+		return fmt.Errorf("failed reading datapipe status: %w", err)
+	}
+
+	job, err := env.client.CreateFileUploadTask()
+	if err != nil {
+		return fmt.Errorf("failed starting file upload job: %w", err)
+	}
+
+	for _, path := range flags.Args() {
+		if err := uploadFile(env, job.ID, path); err != nil {
+			return fmt.Errorf("file upload job %d: %w", job.ID, err)
+		}
+	}
+
+	if err := env.client.CompleteFileUpload(job.ID); err != nil {
+		return fmt.Errorf("failed completing file upload job %d: %w", job.ID, err)
+	}
+
+	fmt.Fprintf(env.stderr, "File upload job %d completed with %d files\n", job.ID, flags.NArg())
+
+	if wait {
+		return waitForAnalysis(env, status.LastAnalysisRunAt, pollInterval, timeout)
+	}
+
+	return nil
+}
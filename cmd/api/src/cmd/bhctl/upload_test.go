@@ -0,0 +1,143 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/specterops/bloodhound/mediatypes"
+	"github.com/specterops/bloodhound/src/model"
+	"github.com/stretchr/testify/require"
+)
+
+// datapipeStatuses returns a handler that replies with each of the given statuses in turn, repeating the last.
+func datapipeStatuses(statuses ...model.DatapipeStatusWrapper) http.HandlerFunc {
+	next := 0
+
+	return func(response http.ResponseWriter, _ *http.Request) {
+		writeAPIData(response, statuses[min(next, len(statuses)-1)])
+		next++
+	}
+}
+
+func writeCollectionFiles(t *testing.T, names ...string) []string {
+	var (
+		directory = t.TempDir()
+		paths     = make([]string, 0, len(names))
+	)
+
+	for _, name := range names {
+		path := filepath.Join(directory, name)
+		require.Nil(t, os.WriteFile(path, []byte(name), 0644))
+
+		paths = append(paths, path)
+	}
+
+	return paths
+}
+
+func expectFileUploadJob(fake *fakeAPI, jobID int64) {
+	fake.reply(http.MethodGet, "/api/v2/datapipe/status", model.DatapipeStatusWrapper{Status: model.DatapipeStatusIdle})
+	fake.reply(http.MethodPost, "/api/v2/file-upload/start", model.IngestJob{BigSerial: model.BigSerial{ID: jobID}})
+	fake.reply(http.MethodPost, fmt.Sprintf("/api/v2/file-upload/%d", jobID), nil)
+	fake.reply(http.MethodPost, fmt.Sprintf("/api/v2/file-upload/%d/end", jobID), nil)
+}
+
+func TestRunUpload(t *testing.T) {
+	t.Run("Uploads Files Under One Job", func(t *testing.T) {
+		var (
+			fake  = newFakeAPI(t)
+			paths = writeCollectionFiles(t, "computers.json", "collection.zip")
+		)
+
+		expectFileUploadJob(fake, 7)
+
+		result := fake.run("", "upload", paths[0], paths[1])
+		require.Zero(t, result.exitCode, result.stderr)
+		require.Contains(t, result.stderr, "Uploading collection.zip: 100%")
+		require.Contains(t, result.stderr, "File upload job 7 completed with 2 files")
+
+		uploads := fake.requested(http.MethodPost, "/api/v2/file-upload/7")
+		require.Len(t, uploads, 2)
+		require.Equal(t, mediatypes.ApplicationJson.String(), uploads[0].contentType)
+		require.Equal(t, "computers.json", string(uploads[0].body))
+		require.Equal(t, mediatypes.ApplicationZip.String(), uploads[1].contentType)
+		require.Equal(t, "collection.zip", string(uploads[1].body))
+		require.Len(t, fake.requested(http.MethodPost, "/api/v2/file-upload/7/end"), 1)
+	})
+
+	t.Run("Checks Files Before Starting A Job", func(t *testing.T) {
+		var (
+			fake  = newFakeAPI(t)
+			paths = writeCollectionFiles(t, "computers.json", "notes.txt")
+		)
+
+		result := fake.run("", "upload", paths[0], paths[1])
+		require.Equal(t, 1, result.exitCode)
+		require.Contains(t, result.stderr, "notes.txt is neither a zip nor a json file")
+
+		result = fake.run("", "upload", paths[0], filepath.Join(t.TempDir(), "missing.json"))
+		require.Equal(t, 1, result.exitCode)
+		require.Contains(t, result.stderr, "missing.json: no such file or directory")
+
+		result = fake.run("", "upload")
+		require.Equal(t, 1, result.exitCode)
+		require.Contains(t, result.stderr, "bhctl: invalid usage: upload requires at least one file")
+		require.Zero(t, fake.requestCount())
+	})
+
+	t.Run("Leaves Job Open When A File Fails To Upload", func(t *testing.T) {
+		var (
+			fake  = newFakeAPI(t)
+			paths = writeCollectionFiles(t, "computers.json")
+		)
+
+		expectFileUploadJob(fake, 7)
+		fake.fail(http.MethodPost, "/api/v2/file-upload/7", http.StatusBadRequest, "file is not valid json")
+
+		result := fake.run("", "upload", paths[0])
+		require.Equal(t, 1, result.exitCode)
+		require.Contains(t, result.stderr, "bhctl: file upload job 7: failed uploading "+paths[0]+": Code: 400 - errors: file is not valid json")
+		require.Empty(t, fake.requested(http.MethodPost, "/api/v2/file-upload/7/end"))
+	})
+
+	t.Run("Waits For Analysis", func(t *testing.T) {
+		var (
+			fake     = newFakeAPI(t)
+			paths    = writeCollectionFiles(t, "computers.json")
+			baseline = time.Now().Add(-time.Hour).UTC()
+			analyzed = time.Now().UTC()
+		)
+
+		expectFileUploadJob(fake, 7)
+		fake.handle(http.MethodGet, "/api/v2/datapipe/status", datapipeStatuses(
+			model.DatapipeStatusWrapper{Status: model.DatapipeStatusIdle, LastAnalysisRunAt: baseline, LastCompleteAnalysisAt: baseline},
+			model.DatapipeStatusWrapper{Status: model.DatapipeStatusIngesting, LastAnalysisRunAt: baseline, LastCompleteAnalysisAt: baseline},
+			model.DatapipeStatusWrapper{Status: model.DatapipeStatusIdle, LastAnalysisRunAt: analyzed, LastCompleteAnalysisAt: analyzed},
+		))
+
+		result := fake.run("", "upload", "-wait", "-poll-interval", "1ms", paths[0])
+		require.Zero(t, result.exitCode, result.stderr)
+		require.Contains(t, result.stderr, "Datapipe is ingesting\nDatapipe is idle\n")
+		require.Len(t, fake.requested(http.MethodGet, "/api/v2/datapipe/status"), 3)
+	})
+}
@@ -0,0 +1,256 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"github.com/specterops/bloodhound/src/model"
+)
+
+// stringList is a repeatable string flag.
+type stringList []string
+
+func (s *stringList) String() string {
+	return strings.Join(*s, ",")
+}
+
+func (s *stringList) Set(value string) error {
+	*s = append(*s, value)
+	return nil
+}
+
+func parseUUIDArg(args []string, usage string) (uuid.UUID, error) {
+	if len(args) != 1 {
+		return uuid.UUID{}, fmt.Errorf("%w: %s", ErrUsage, usage)
+	} else if id, err := uuid.FromString(args[0]); err != nil {
+		return uuid.UUID{}, fmt.Errorf("invalid ID %q: %w", args[0], err)
+	} else {
+		return id, nil
+	}
+}
+
+func resolveRoleIDs(env *environment, roleNames []string) ([]int32, error) {
+	roles, err := env.client.ListRoles()
+	if err != nil {
+		return nil, fmt.Errorf("failed listing roles: %w", err)
+	}
+
+	roleIDs := make([]int32, 0, len(roleNames))
+
+	for _, roleName := range roleNames {
+		if role, found := roles.Roles.FindByName(roleName); !found {
+			return nil, fmt.Errorf("role %q does not exist", roleName)
+		} else {
+			roleIDs = append(roleIDs, role.ID)
+		}
+	}
+
+	return roleIDs, nil
+}
+
+func writeUsers(env *environment, users ...model.User) error {
+	rows := make([][]string, 0, len(users))
+
+	for _, user := range users {
+		rows = append(rows, []string{
+			user.ID.String(),
+			user.PrincipalName,
+			user.EmailAddress.ValueOrZero(),
+			strings.Join(user.Roles.Names(), ","),
+			strconv.FormatBool(user.IsDisabled),
+			formatTime(user.LastLogin),
+		})
+	}
+
+	return env.write(users, []string{"ID", "PRINCIPAL", "EMAIL", "ROLES", "DISABLED", "LAST LOGIN"}, rows)
+}
+
+func createUser(env *environment, args []string) error {
+	var (
+		flags = env.flagSet("users create")
+
+		principal     string
+		email         string
+		secret        string
+		resetPassword bool
+		roleNames     stringList
+	)
+
+	flags.StringVar(&principal, "principal", "", "Principal name of the new user.")
+	flags.StringVar(&email, "email", "", "Email address of the new user.")
+	flags.StringVar(&secret, "secret", "", "Initial password of the new user.")
+	flags.BoolVar(&resetPassword, "reset-password", true, "Require the user to change the initial password on first login.")
+	flags.Var(&roleNames, "role", "Name of a role to grant. May be repeated.")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	} else if principal == "" || email == "" {
+		return fmt.Errorf("%w: users create -principal name -email address [-role name]... [-secret password]", ErrUsage)
+	}
+
+	if roleIDs, err := resolveRoleIDs(env, roleNames); err != nil {
+		return err
+	} else if user, err := env.client.CreateUser(principal, email, roleIDs); err != nil {
+		return fmt.Errorf("failed creating user: %w", err)
+	} else if secret != "" {
+		if err := env.client.SetUserSecret(user.ID, secret, resetPassword); err != nil {
+			return fmt.Errorf("user %s was created but setting the password failed: %w", user.ID, err)
+		}
+
+		return writeUsers(env, user)
+	} else {
+		return writeUsers(env, user)
+	}
+}
+
+func runUsers(env *environment, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("%w: expected one of list, create or delete", ErrUsage)
+	}
+
+	switch subcommand, args := args[0], args[1:]; subcommand {
+	case "list":
+		if users, err := env.client.ListUsers(); err != nil {
+			return fmt.Errorf("failed listing users: %w", err)
+		} else {
+			return writeUsers(env, users.Users...)
+		}
+
+	case "create":
+		return createUser(env, args)
+
+	case "delete":
+		if userID, err := parseUUIDArg(args, "users delete <id>"); err != nil {
+			return err
+		} else if err := env.client.DeleteUser(userID); err != nil {
+			return fmt.Errorf("failed deleting user: %w", err)
+		}
+
+		return nil
+
+	default:
+		return fmt.Errorf("%w: unknown users command %q", ErrUsage, subcommand)
+	}
+}
+
+func writeTokens(env *environment, showKey bool, tokens ...model.AuthToken) error {
+	var (
+		header = []string{"ID", "NAME", "USER ID", "HMAC METHOD", "LAST ACCESS"}
+		rows   = make([][]string, 0, len(tokens))
+	)
+
+	if showKey {
+		header = append(header, "KEY")
+	}
+
+	for _, token := range tokens {
+		row := []string{
+			token.ID.String(),
+			token.Name.ValueOrZero(),
+			token.UserID.UUID.String(),
+			token.HmacMethod,
+			formatTime(token.LastAccess),
+		}
+
+		if showKey {
+			row = append(row, token.Key)
+		}
+
+		rows = append(rows, row)
+	}
+
+	return env.write(tokens, header, rows)
+}
+
+func runTokens(env *environment, args []string) error {
+	var (
+		flags = env.flagSet("tokens")
+
+		userID string
+		name   string
+	)
+
+	flags.StringVar(&userID, "user", "", "ID of the user that owns the tokens. Defaults to the authenticated user when creating a token.")
+	flags.StringVar(&name, "name", "", "Name of the token to create.")
+
+	if len(args) == 0 {
+		return fmt.Errorf("%w: expected one of list, create or delete", ErrUsage)
+	} else if err := flags.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	var owner uuid.UUID
+
+	if userID != "" {
+		if parsedID, err := uuid.FromString(userID); err != nil {
+			return fmt.Errorf("invalid user ID %q: %w", userID, err)
+		} else {
+			owner = parsedID
+		}
+	}
+
+	switch args[0] {
+	case "list":
+		if owner.IsNil() {
+			if tokens, err := env.client.ListAuthTokens(); err != nil {
+				return fmt.Errorf("failed listing tokens: %w", err)
+			} else {
+				return writeTokens(env, false, tokens.Tokens...)
+			}
+		} else if tokens, err := env.client.ListUserTokens(owner); err != nil {
+			return fmt.Errorf("failed listing tokens: %w", err)
+		} else {
+			return writeTokens(env, false, tokens.Tokens...)
+		}
+
+	case "create":
+		if name == "" {
+			return fmt.Errorf("%w: tokens create -name name [-user id]", ErrUsage)
+		}
+
+		if owner.IsNil() {
+			if self, err := env.client.GetSelf(); err != nil {
+				return fmt.Errorf("failed reading the authenticated user: %w", err)
+			} else {
+				owner = self.ID
+			}
+		}
+
+		if token, err := env.client.CreateUserToken(owner, name); err != nil {
+			return fmt.Errorf("failed creating token: %w", err)
+		} else {
+			fmt.Fprintln(env.stderr, "The token key is only shown once; store it now")
+			return writeTokens(env, true, token)
+		}
+
+	case "delete":
+		if tokenID, err := parseUUIDArg(flags.Args(), "tokens delete <id>"); err != nil {
+			return err
+		} else if err := env.client.DeleteUserToken(tokenID); err != nil {
+			return fmt.Errorf("failed deleting token: %w", err)
+		}
+
+		return nil
+
+	default:
+		return fmt.Errorf("%w: unknown tokens command %q", ErrUsage, args[0])
+	}
+}
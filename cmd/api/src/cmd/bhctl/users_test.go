@@ -0,0 +1,218 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	v2 "github.com/specterops/bloodhound/src/api/v2"
+	"github.com/specterops/bloodhound/src/database/types/null"
+	"github.com/specterops/bloodhound/src/model"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testUserID  = uuid.FromStringOrNil("a7f2b9e4-3c1d-4e8f-9a6b-5d2c8e1f4a3b")
+	testTokenID = uuid.FromStringOrNil("1c9e6f3a-8b2d-4a7e-b5c1-9f4d2e8a6b3c")
+	testRoles   = v2.ListRolesResponse{Roles: model.Roles{
+		{Name: "Administrator", Serial: model.Serial{ID: 1}},
+		{Name: "Read-Only", Serial: model.Serial{ID: 3}},
+	}}
+	testUser = model.User{
+		PrincipalName: "alice",
+		EmailAddress:  null.StringFrom("alice@example.com"),
+		Roles:         testRoles.Roles[1:],
+		Unique:        model.Unique{ID: testUserID},
+	}
+)
+
+func TestRunUsers(t *testing.T) {
+	t.Run("List", func(t *testing.T) {
+		fake := newFakeAPI(t)
+		fake.reply(http.MethodGet, "/api/v2/bloodhound-users", v2.ListUsersResponse{Users: model.Users{testUser}})
+
+		result := fake.run("", "users", "list")
+		require.Zero(t, result.exitCode, result.stderr)
+		require.Equal(t, [][]string{
+			{"ID", "PRINCIPAL", "EMAIL", "ROLES", "DISABLED", "LAST", "LOGIN"},
+			{testUserID.String(), "alice", "alice@example.com", "Read-Only", "false", "-"},
+		}, tableRows(result.stdout))
+	})
+
+	t.Run("Create With Roles And Secret", func(t *testing.T) {
+		fake := newFakeAPI(t)
+		fake.reply(http.MethodGet, "/api/v2/roles", testRoles)
+		fake.reply(http.MethodPost, "/api/v2/bloodhound-users", testUser)
+		fake.reply(http.MethodPut, "/api/v2/bloodhound-users/"+testUserID.String()+"/secret", nil)
+
+		result := fake.run("", "-output", "json", "users", "create", "-principal", "alice", "-email", "alice@example.com", "-role", "Read-Only", "-role", "Administrator", "-secret", "Tr0ub4dor&3!", "-reset-password=false")
+		require.Zero(t, result.exitCode, result.stderr)
+
+		var created []model.User
+		require.Nil(t, json.Unmarshal([]byte(result.stdout), &created))
+		require.Len(t, created, 1)
+		require.Equal(t, testUserID, created[0].ID)
+
+		requests := fake.requested(http.MethodPost, "/api/v2/bloodhound-users")
+		require.Len(t, requests, 1)
+
+		payload := decodeBody[v2.CreateUserRequest](t, requests[0])
+		require.Equal(t, "alice", payload.Principal)
+		require.Equal(t, "alice@example.com", payload.EmailAddress)
+		require.Equal(t, []int32{3, 1}, payload.Roles)
+
+		requests = fake.requested(http.MethodPut, "/api/v2/bloodhound-users/"+testUserID.String()+"/secret")
+		require.Len(t, requests, 1)
+		require.Equal(t, v2.SetUserSecretRequest{Secret: "Tr0ub4dor&3!"}, decodeBody[v2.SetUserSecretRequest](t, requests[0]))
+	})
+
+	t.Run("Create Reports Failed Secret", func(t *testing.T) {
+		fake := newFakeAPI(t)
+		fake.reply(http.MethodGet, "/api/v2/roles", testRoles)
+		fake.reply(http.MethodPost, "/api/v2/bloodhound-users", testUser)
+		fake.fail(http.MethodPut, "/api/v2/bloodhound-users/"+testUserID.String()+"/secret", http.StatusBadRequest, "password is too short")
+
+		result := fake.run("", "users", "create", "-principal", "alice", "-email", "alice@example.com", "-secret", "short")
+		require.Equal(t, 1, result.exitCode)
+		require.Equal(t, "bhctl: user "+testUserID.String()+" was created but setting the password failed: Code: 400 - errors: password is too short\n", result.stderr)
+	})
+
+	t.Run("Create Rejects Unknown Role", func(t *testing.T) {
+		fake := newFakeAPI(t)
+		fake.reply(http.MethodGet, "/api/v2/roles", testRoles)
+
+		result := fake.run("", "users", "create", "-principal", "alice", "-email", "alice@example.com", "-role", "Auditor")
+		require.Equal(t, 1, result.exitCode)
+		require.Contains(t, result.stderr, `bhctl: role "Auditor" does not exist`)
+		require.Empty(t, fake.requested(http.MethodPost, "/api/v2/bloodhound-users"))
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		fake := newFakeAPI(t)
+		fake.reply(http.MethodDelete, "/api/v2/bloodhound-users/"+testUserID.String(), nil)
+
+		result := fake.run("", "users", "delete", testUserID.String())
+		require.Zero(t, result.exitCode, result.stderr)
+		require.Len(t, fake.requested(http.MethodDelete, "/api/v2/bloodhound-users/"+testUserID.String()), 1)
+	})
+
+	t.Run("Reports Invalid Usage", func(t *testing.T) {
+		fake := newFakeAPI(t)
+
+		for expected, args := range map[string][]string{
+			"bhctl: invalid usage: expected one of list, create or delete":                                         {"users"},
+			`bhctl: invalid usage: unknown users command "disable"`:                                                {"users", "disable"},
+			"bhctl: invalid usage: users create -principal name -email address [-role name]... [-secret password]": {"users", "create", "-principal", "alice"},
+			"bhctl: invalid usage: users delete <id>":                                                              {"users", "delete"},
+			`bhctl: invalid ID "alice"`:                                                                            {"users", "delete", "alice"},
+		} {
+			result := fake.run("", args...)
+			require.Equal(t, 1, result.exitCode, expected)
+			require.Contains(t, result.stderr, expected)
+		}
+
+		require.Zero(t, fake.requestCount())
+	})
+}
+
+func TestRunTokens(t *testing.T) {
+	testToken := model.AuthToken{
+		UserID:     uuid.NullUUID{UUID: testUserID, Valid: true},
+		Name:       null.StringFrom("automation"),
+		HmacMethod: "hmac-sha2-256",
+		Unique:     model.Unique{ID: testTokenID},
+	}
+
+	t.Run("List", func(t *testing.T) {
+		fake := newFakeAPI(t)
+		fake.reply(http.MethodGet, "/api/v2/tokens", v2.ListTokensResponse{Tokens: model.AuthTokens{testToken}})
+
+		result := fake.run("", "tokens", "list")
+		require.Zero(t, result.exitCode, result.stderr)
+		require.Equal(t, [][]string{
+			{"ID", "NAME", "USER", "ID", "HMAC", "METHOD", "LAST", "ACCESS"},
+			{testTokenID.String(), "automation", testUserID.String(), "hmac-sha2-256", "-"},
+		}, tableRows(result.stdout))
+
+		result = fake.run("", "tokens", "list", "-user", testUserID.String())
+		require.Zero(t, result.exitCode, result.stderr)
+
+		requests := fake.requested(http.MethodGet, "/api/v2/tokens")
+		require.Len(t, requests, 2)
+		require.Empty(t, requests[0].query)
+		require.Equal(t, "eq:"+testUserID.String(), requests[1].query.Get("user_id"))
+	})
+
+	t.Run("Create For The Authenticated User", func(t *testing.T) {
+		created := testToken
+		created.Key = "c2VjcmV0"
+
+		fake := newFakeAPI(t)
+		fake.reply(http.MethodGet, "/api/v2/self", testUser)
+		fake.reply(http.MethodPost, "/api/v2/tokens", created)
+
+		result := fake.run("", "tokens", "create", "-name", "automation")
+		require.Zero(t, result.exitCode, result.stderr)
+		require.Equal(t, "The token key is only shown once; store it now\n", result.stderr)
+		require.Equal(t, "KEY", tableRows(result.stdout)[0][8])
+		require.Equal(t, "c2VjcmV0", tableRows(result.stdout)[1][5])
+
+		requests := fake.requested(http.MethodPost, "/api/v2/tokens")
+		require.Len(t, requests, 1)
+		require.Equal(t, v2.CreateUserToken{TokenName: "automation", UserID: testUserID.String()}, decodeBody[v2.CreateUserToken](t, requests[0]))
+	})
+
+	t.Run("Create Reports Errors", func(t *testing.T) {
+		fake := newFakeAPI(t)
+		fake.fail(http.MethodPost, "/api/v2/tokens", http.StatusForbidden, "permission denied")
+
+		result := fake.run("", "tokens", "create", "-name", "automation", "-user", testUserID.String())
+		require.Equal(t, 1, result.exitCode)
+		require.Equal(t, "bhctl: failed creating token: Code: 403 - errors: permission denied\n", result.stderr)
+		require.Empty(t, fake.requested(http.MethodGet, "/api/v2/self"))
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		fake := newFakeAPI(t)
+		fake.reply(http.MethodDelete, "/api/v2/tokens/"+testTokenID.String(), nil)
+
+		result := fake.run("", "tokens", "delete", testTokenID.String())
+		require.Zero(t, result.exitCode, result.stderr)
+		require.Len(t, fake.requested(http.MethodDelete, "/api/v2/tokens/"+testTokenID.String()), 1)
+	})
+
+	t.Run("Reports Invalid Usage", func(t *testing.T) {
+		fake := newFakeAPI(t)
+
+		for expected, args := range map[string][]string{
+			"bhctl: invalid usage: expected one of list, create or delete": {"tokens"},
+			`bhctl: invalid usage: unknown tokens command "rotate"`:        {"tokens", "rotate"},
+			"bhctl: invalid usage: tokens create -name name [-user id]":    {"tokens", "create"},
+			`bhctl: invalid user ID "alice"`:                               {"tokens", "list", "-user", "alice"},
+			"bhctl: invalid usage: tokens delete <id>":                     {"tokens", "delete"},
+		} {
+			result := fake.run("", args...)
+			require.Equal(t, 1, result.exitCode, expected)
+			require.Contains(t, result.stderr, expected)
+		}
+
+		require.Zero(t, fake.requestCount())
+	})
+}
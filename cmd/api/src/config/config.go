@@ -50,6 +50,65 @@ func (s TLSConfiguration) Enabled() bool {
 	return s.CertFile != "" && s.KeyFile != ""
 }
 
+// TracingConfiguration configures the export of OpenTelemetry traces over OTLP/HTTP. Tracing is disabled unless an
+// endpoint is set.
+type TracingConfiguration struct {
+	Endpoint    string  `json:"endpoint"`     // Collector URL, for example http://localhost:4318
+	ServiceName string  `json:"service_name"` // Reported as the service.name resource attribute
+	SampleRatio float64 `json:"sample_ratio"` // Fraction of new traces sampled, between 0 and 1
+}
+
+func (s TracingConfiguration) Enabled() bool {
+	return s.Endpoint != ""
+}
+
+// IngestConfiguration sets the concurrency of the datapipe ingest pipeline and where ingest files are stored. Worker
+// and buffer counts below one are treated as one.
+type IngestConfiguration struct {
+	ExtractionWorkers int                        `json:"extraction_workers"` // Archive entries extracted and normalized to UTF-8 at once
+	DecodeWorkers     int                        `json:"decode_workers"`     // Ingest files decoded and converted at once
+	WriteBufferSize   int                        `json:"write_buffer_size"`  // Converted chunks buffered per file ahead of the graph writer
+	Storage           IngestStorageConfiguration `json:"storage"`
+	DropDirectory     DropDirectoryConfiguration `json:"drop_directory"`
+}
+
+// IngestStorageConfiguration selects where ingest files are held from the time they are uploaded until they have been
+// ingested. Files are held in the temp directory of the work directory unless the s3 backend is selected.
+type IngestStorageConfiguration struct {
+	Backend string                 `json:"backend"` // Either "filesystem", the default, or "s3"
+	S3      S3StorageConfiguration `json:"s3"`
+}
+
+// S3StorageConfiguration configures an S3-compatible object store. Objects are addressed path-style, as in
+// https://endpoint/bucket/key, which both AWS S3 and self-hosted stores such as MinIO support.
+type S3StorageConfiguration struct {
+	Endpoint        string `json:"endpoint"`
+	Region          string `json:"region"`
+	Bucket          string `json:"bucket"`
+	Prefix          string `json:"prefix"` // Key prefix of ingest files within the bucket
+	AccessKeyID     string `json:"access_key_id"`
+	SecretAccessKey string `json:"secret_access_key"`
+	PartSizeMB      int    `json:"part_size_mb"` // Size of the parts uploaded files are streamed in, at least 5
+}
+
+// DropDirectoryConfiguration configures a directory, such as a network share that collectors write to, that is watched
+// for collection files to ingest. The directory is only watched when a path is set. Files are picked up once their size
+// has not changed between two scans, or once a marker file named after them with a ".done" suffix exists if a marker
+// is required, and are then moved to the archive or failed subdirectory of the drop directory.
+type DropDirectoryConfiguration struct {
+	Path                string `json:"path"`
+	ServiceUser         string `json:"service_user"`          // Principal name or email address of the user that ingest jobs of dropped files are attributed to
+	PollIntervalSeconds int    `json:"poll_interval_seconds"` // How often the directory is scanned for files
+	RequireMarker       bool   `json:"require_marker"`
+}
+
+// ClusterConfiguration identifies this API instance among the replicas sharing an application database. Replicas must
+// share the ingest file storage, either a shared work directory or an S3-compatible store, so that an upload accepted
+// by any replica can be ingested by the leader.
+type ClusterConfiguration struct {
+	NodeID string `json:"node_id"` // Defaults to the hostname of the instance
+}
+
 type DatabaseConfiguration struct {
 	Connection            string `json:"connection"`
 	Address               string `json:"addr"`
@@ -140,12 +199,14 @@ type Configuration struct {
 	SlowQueryThreshold           int64                     `json:"slow_query_threshold"`
 	MaxGraphQueryCacheSize       int                       `json:"max_graphdb_cache_size"`
 	MaxAPICacheSize              int                       `json:"max_api_cache_size"`
+	CacheWarmingLimit            int                       `json:"cache_warming_limit"`
 	MetricsPort                  string                    `json:"metrics_port"`
 	RootURL                      serde.URL                 `json:"root_url"`
 	WorkDir                      string                    `json:"work_dir"`
 	LogLevel                     string                    `json:"log_level"`
 	LogPath                      string                    `json:"log_path"`
 	TLS                          TLSConfiguration          `json:"tls"`
+	Tracing                      TracingConfiguration      `json:"tracing"`
 	GraphDriver                  string                    `json:"graph_driver"`
 	Database                     DatabaseConfiguration     `json:"database"`
 	Neo4J                        DatabaseConfiguration     `json:"neo4j"`
@@ -155,6 +216,8 @@ type Configuration struct {
 	CollectorsBucketURL          serde.URL                 `json:"collectors_bucket_url"`
 	CollectorsBasePath           string                    `json:"collectors_base_path"`
 	DatapipeInterval             int                       `json:"datapipe_interval"`
+	Ingest                       IngestConfiguration       `json:"ingest"`
+	Cluster                      ClusterConfiguration      `json:"cluster"`
 	EnableStartupWaitPeriod      bool                      `json:"enable_startup_wait_period"`
 	EnableAPILogging             bool                      `json:"enable_api_logging"`
 	EnableCypherMutations        bool                      `json:"enable_cypher_mutations"`
@@ -167,6 +230,7 @@ type Configuration struct {
 	FedRAMPEULAText              string                    `json:"fedramp_eula_text"` // Enterprise only
 	EnableTextLogger             bool                      `json:"enable_text_logger"`
 	RecreateDefaultAdmin         bool                      `json:"recreate_default_admin"`
+	PostProcessingRulesPath      string                    `json:"post_processing_rules_path"`
 }
 
 func (s Configuration) AuthSessionTTL() time.Duration {
@@ -177,6 +241,17 @@ func (s Configuration) TempDirectory() string {
 	return filepath.Join(s.WorkDir, "tmp")
 }
 
+// ClusterNodeID returns the ID identifying this instance among the replicas of a deployment
+func (s Configuration) ClusterNodeID() string {
+	if s.Cluster.NodeID != "" {
+		return s.Cluster.NodeID
+	} else if hostname, err := os.Hostname(); err == nil {
+		return hostname
+	}
+
+	return "bloodhound"
+}
+
 func (s Configuration) ClientLogDirectory() string {
 	return filepath.Join(s.WorkDir, "client_logs")
 }
@@ -38,6 +38,7 @@ func NewDefaultConfiguration() (Configuration, error) {
 			SlowQueryThreshold:           100, // Threshold in ms for caching queries
 			MaxGraphQueryCacheSize:       100, // Number of cache items for graph queries
 			MaxAPICacheSize:              200, // Number of cache items for API utilities
+			CacheWarmingLimit:            0,   // Number of most requested graph query cache items to warm after analysis
 			MetricsPort:                  ":2112",
 			RootURL:                      serde.MustParseURL("http://localhost"),
 			WorkDir:                      "/opt/bhe/work",
@@ -83,6 +84,25 @@ func NewDefaultConfiguration() (Configuration, error) {
 				LastName:      "User",
 				ExpireNow:     true,
 			},
+			Ingest: IngestConfiguration{
+				ExtractionWorkers: 4,
+				DecodeWorkers:     4,
+				WriteBufferSize:   4,
+				Storage: IngestStorageConfiguration{
+					Backend: "filesystem",
+					S3: S3StorageConfiguration{
+						Region:     "us-east-1",
+						PartSizeMB: 16,
+					},
+				},
+				DropDirectory: DropDirectoryConfiguration{
+					PollIntervalSeconds: 30,
+				},
+			},
+			Tracing: TracingConfiguration{
+				ServiceName: "bloodhound",
+				SampleRatio: 1,
+			},
 		}, nil
 	}
 }
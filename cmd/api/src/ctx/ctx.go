@@ -43,6 +43,10 @@ type Context struct {
 	RequestedURL model.AuditableURL
 	RequestIP    string
 	RemoteAddr   string
+	Workspace    *model.Workspace
+
+	// WorkspaceScoped is set when the actor may only access the graphs of the workspaces it has been granted
+	WorkspaceScoped bool
 }
 
 func (s *Context) ConstructGoContext() context.Context {
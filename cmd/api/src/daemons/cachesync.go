@@ -0,0 +1,152 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package daemons
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/specterops/bloodhound/cache"
+)
+
+// CacheSyncInterval is how often replicas check whether analysis on the leader has invalidated their caches
+const CacheSyncInterval = 5 * time.Second
+
+// AnalysisGenerations is the shared store of the analysis generation, which the leader increments each time analysis
+// changes the graph
+type AnalysisGenerations interface {
+	GetAnalysisGeneration(ctx context.Context) (int64, error)
+}
+
+// CacheSyncDaemon resets the graph query cache of this replica once analysis on any replica has changed the graph.
+// The leader invalidates its own cache as analysis completes and records the generation it has applied with Observe so
+// that its cache is not reset a second time.
+type CacheSyncDaemon struct {
+	generations AnalysisGenerations
+	cache       cache.Cache
+	interval    time.Duration
+	lock        sync.Mutex
+	generation  int64
+	synced      bool
+	exitC       chan struct{}
+	doneC       chan struct{}
+}
+
+func NewCacheSyncDaemon(generations AnalysisGenerations, cache cache.Cache, interval time.Duration) *CacheSyncDaemon {
+	return &CacheSyncDaemon{
+		generations: generations,
+		cache:       cache,
+		interval:    interval,
+		exitC:       make(chan struct{}),
+		doneC:       make(chan struct{}),
+	}
+}
+
+func (s *CacheSyncDaemon) Name() string {
+	return "Cache Sync Daemon"
+}
+
+// Observe records that the cache of this replica already reflects the changes of the given analysis generation. The
+// cache is still reset if generations between the last one seen and the given one have been missed, as happens when
+// leadership moves between replicas.
+func (s *CacheSyncDaemon) Observe(ctx context.Context, generation int64) {
+	s.lock.Lock()
+	missed := s.synced && generation > s.generation+1
+
+	if !s.synced || generation > s.generation {
+		s.generation = generation
+		s.synced = true
+	}
+
+	s.lock.Unlock()
+
+	if missed {
+		s.reset(ctx, generation)
+	}
+}
+
+// sync resets the cache if the analysis generation has changed since it was last seen. The first generation seen is
+// only recorded, as the cache of a replica that has just started holds nothing that analysis could have changed.
+func (s *CacheSyncDaemon) sync(ctx context.Context) {
+	if generation, err := s.generations.GetAnalysisGeneration(ctx); err != nil {
+		slog.ErrorContext(ctx, fmt.Sprintf("Failed fetching the analysis generation: %v", err))
+	} else if s.advance(generation) {
+		s.reset(ctx, generation)
+	}
+}
+
+func (s *CacheSyncDaemon) reset(ctx context.Context, generation int64) {
+	if err := s.cache.Reset(); err != nil {
+		slog.ErrorContext(ctx, fmt.Sprintf("Error while resetting the cache: %v", err))
+	} else {
+		slog.InfoContext(ctx, fmt.Sprintf("Cache reset for analysis generation %d", generation))
+	}
+}
+
+// advance records the given generation and returns true if the cache must be reset because of it
+func (s *CacheSyncDaemon) advance(generation int64) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if !s.synced {
+		s.generation = generation
+		s.synced = true
+		return false
+	} else if generation <= s.generation {
+		return false
+	}
+
+	s.generation = generation
+	return true
+}
+
+func (s *CacheSyncDaemon) Start(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+
+	defer close(s.doneC)
+	defer ticker.Stop()
+
+	for {
+		s.sync(ctx)
+
+		select {
+		case <-ticker.C:
+		case <-s.exitC:
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *CacheSyncDaemon) Stop(ctx context.Context) error {
+	select {
+	case <-s.exitC:
+	default:
+		close(s.exitC)
+	}
+
+	select {
+	case <-s.doneC:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package daemons_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/specterops/bloodhound/cache"
+	"github.com/specterops/bloodhound/src/daemons"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeGenerations struct {
+	lock       sync.Mutex
+	generation int64
+}
+
+func (s *fakeGenerations) GetAnalysisGeneration(ctx context.Context) (int64, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.generation, nil
+}
+
+func (s *fakeGenerations) set(generation int64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.generation = generation
+}
+
+func TestCacheSyncDaemon(t *testing.T) {
+	var (
+		ctx, cancel = context.WithCancel(context.Background())
+		generations = &fakeGenerations{generation: 3}
+		graphCache  = newTestCache(t)
+		daemon      = daemons.NewCacheSyncDaemon(generations, graphCache, testHeartbeatInterval)
+		isCached    = func() bool {
+			var value string
+
+			found, err := graphCache.Get("key", &value)
+			require.Nil(t, err)
+			return found
+		}
+	)
+
+	defer cancel()
+
+	go daemon.Start(ctx)
+
+	// The generation seen when the daemon starts does not reset the cache
+	_, _, err := graphCache.Set("key", "value")
+	require.Nil(t, err)
+	require.Never(t, func() bool { return !isCached() }, 5*testHeartbeatInterval, testHeartbeatInterval)
+
+	t.Run("Analysis On Another Replica Resets The Cache", func(t *testing.T) {
+		generations.set(4)
+		require.Eventually(t, func() bool { return !isCached() }, testTimeout, testHeartbeatInterval)
+	})
+
+	t.Run("Observed Generations Do Not Reset The Cache", func(t *testing.T) {
+		_, _, err := graphCache.Set("key", "value")
+		require.Nil(t, err)
+
+		daemon.Observe(ctx, 5)
+		generations.set(5)
+		require.Never(t, func() bool { return !isCached() }, 5*testHeartbeatInterval, testHeartbeatInterval)
+	})
+
+	t.Run("Observing A Later Generation Resets The Cache When Generations Were Missed", func(t *testing.T) {
+		daemon.Observe(ctx, 7)
+		generations.set(7)
+		require.False(t, isCached())
+	})
+
+	require.Nil(t, daemon.Stop(ctx))
+}
+
+func newTestCache(t *testing.T) cache.Cache {
+	graphCache, err := cache.NewCache(cache.Config{MaxSize: 10})
+	require.Nil(t, err)
+
+	return graphCache
+}
@@ -0,0 +1,323 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package datapipe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/specterops/bloodhound/bhlog/measure"
+	"github.com/specterops/bloodhound/cache"
+	"github.com/specterops/bloodhound/dawgs/graph"
+	"github.com/specterops/bloodhound/dawgs/ops"
+	"github.com/specterops/bloodhound/dawgs/query"
+	"github.com/specterops/bloodhound/graphschema/ad"
+	"github.com/specterops/bloodhound/graphschema/azure"
+	"github.com/specterops/bloodhound/graphschema/common"
+	"github.com/specterops/bloodhound/src/config"
+	"github.com/specterops/bloodhound/src/database"
+	"github.com/specterops/bloodhound/src/model"
+	"github.com/specterops/bloodhound/src/model/appcfg"
+	"github.com/specterops/bloodhound/src/queries"
+)
+
+var (
+	// Members of a selected group are selected as well
+	assetGroupTagGroupKinds      = graph.Kinds{ad.Group, azure.Group}
+	assetGroupTagMembershipKinds = graph.Kinds{ad.MemberOf, azure.MemberOf}
+
+	// Children of a selected OU or container are selected as well
+	assetGroupTagContainerKinds = graph.Kinds{ad.OU, ad.Container}
+	assetGroupTagContainsKinds  = graph.Kinds{ad.Contains}
+)
+
+// TagAssetGroupTags evaluates the selectors of every asset group tag and applies the kind of each tag to the nodes
+// selected for it. Nodes that are no longer selected lose the kind of the tag, as do the members of deleted tags. The
+// nodes selected by each selector are recorded so that the membership of a tag can be traced back to its selectors. A
+// tag with a selector that fails to evaluate keeps its current members.
+//
+// The selections of tiers are expanded to include the members of selected groups and the children of selected OUs and
+// containers. The selections of labels are not expanded.
+func TagAssetGroupTags(ctx context.Context, db database.Database, graphDB graph.Database) error {
+	defer measure.ContextMeasure(ctx, slog.LevelInfo, "Finished tagging asset group tags")()
+
+	if tierManagementFlag, err := db.GetFlagByKey(ctx, appcfg.FeatureTierManagement); err != nil {
+		return err
+	} else if !tierManagementFlag.Enabled {
+		return nil
+	}
+
+	tags, err := db.GetAssetGroupTags(ctx, model.SQLFilter{})
+	if err != nil {
+		return err
+	}
+
+	var (
+		errs       []error
+		tagKinds   = make(graph.Kinds, 0, len(tags))
+		graphQuery = queries.NewGraphQuery(graphDB, cache.Cache{}, config.Configuration{})
+	)
+
+	for _, tag := range tags {
+		tagKinds = append(tagKinds, tag.ToKind())
+
+		if members, err := selectAssetGroupTagMembers(ctx, db, graphDB, graphQuery, tag); err != nil {
+			errs = append(errs, fmt.Errorf("selecting members of asset group tag %d failed: %w", tag.ID, err))
+		} else if err := applyAssetGroupTagKind(ctx, graphDB, tag.ToKind(), members); err != nil {
+			errs = append(errs, fmt.Errorf("tagging members of asset group tag %d failed: %w", tag.ID, err))
+		}
+	}
+
+	if err := clearDeletedAssetGroupTagKinds(ctx, graphDB, tagKinds); err != nil {
+		errs = append(errs, fmt.Errorf("clearing deleted asset group tags failed: %w", err))
+	}
+
+	return errors.Join(errs...)
+}
+
+// selectAssetGroupTagMembers evaluates the enabled selectors of a tag and records the nodes selected by each. Every
+// selector is evaluated before any selection is recorded: if a selector fails to evaluate an error is returned for the
+// tag and its selector nodes are left as they are, as are the kind assignments of its current members.
+func selectAssetGroupTagMembers(ctx context.Context, db database.Database, graphDB graph.Database, graphQuery queries.Graph, tag model.AssetGroupTag) (graph.NodeSet, error) {
+	var (
+		members    = graph.NewNodeSet()
+		selections = map[int]graph.NodeSet{}
+	)
+
+	selectors, err := db.GetAssetGroupTagSelectorsByTagId(ctx, tag.ID, model.SQLFilter{}, model.SQLFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, selector := range selectors {
+		if selector.DisabledAt.Valid {
+			selections[selector.ID] = graph.NewNodeSet()
+		} else if nodes, err := selectAssetGroupTagSelectorNodes(ctx, graphDB, graphQuery, selector.Seeds); err != nil {
+			return nil, fmt.Errorf("evaluating selector %d failed: %w", selector.ID, err)
+		} else if tag.Type == model.AssetGroupTagTypeTier {
+			if selections[selector.ID], err = expandAssetGroupTagSelection(ctx, graphDB, nodes); err != nil {
+				return nil, err
+			}
+		} else {
+			selections[selector.ID] = nodes
+		}
+	}
+
+	for _, selector := range selectors {
+		var (
+			selected      = selections[selector.ID]
+			selectorNodes = make([]model.AssetGroupTagSelectorNode, 0, selected.Len())
+		)
+
+		for _, node := range selected {
+			objectID, _ := node.Properties.GetOrDefault(common.ObjectID.String(), "").String()
+			selectorNodes = append(selectorNodes, model.AssetGroupTagSelectorNode{
+				NodeId:   node.ID,
+				ObjectID: objectID,
+			})
+		}
+
+		if err := db.UpdateAssetGroupTagSelectorNodes(ctx, selector.ID, selectorNodes); err != nil {
+			return nil, err
+		}
+
+		members.AddSet(selected)
+	}
+
+	return members, nil
+}
+
+// selectAssetGroupTagSelectorNodes returns the nodes matched by the seeds of a selector. Object ID seeds match the node
+// with the given object ID and Cypher seeds match every node returned by the query.
+func selectAssetGroupTagSelectorNodes(ctx context.Context, graphDB graph.Database, graphQuery queries.Graph, seeds []model.SelectorSeed) (graph.NodeSet, error) {
+	var (
+		selected  = graph.NewNodeSet()
+		objectIDs []string
+	)
+
+	for _, seed := range seeds {
+		switch seed.Type {
+		case model.SelectorTypeObjectId:
+			objectIDs = append(objectIDs, seed.Value)
+
+		case model.SelectorTypeCypher:
+			if preparedQuery, err := graphQuery.PrepareCypherQuery(seed.Value, queries.QueryComplexityLimitSelector); err != nil {
+				return nil, fmt.Errorf("cypher is invalid: %w", err)
+			} else if preparedQuery.HasMutation {
+				return nil, fmt.Errorf("cypher selectors may not update the graph")
+			} else if pathSet, err := graphQuery.RawCypherQueryPaths(ctx, preparedQuery); err != nil {
+				return nil, err
+			} else {
+				selected.AddSet(pathSet.AllNodes())
+			}
+
+		default:
+			return nil, fmt.Errorf("invalid seed type %v", seed.Type)
+		}
+	}
+
+	if len(objectIDs) > 0 {
+		if err := graphDB.ReadTransaction(ctx, func(tx graph.Transaction) error {
+			if nodes, err := ops.FetchNodeSet(tx.Nodes().Filter(
+				query.In(query.NodeProperty(common.ObjectID.String()), objectIDs),
+			)); err != nil {
+				return err
+			} else {
+				selected.AddSet(nodes)
+				return nil
+			}
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return selected, nil
+}
+
+// expandAssetGroupTagSelection adds the transitive members of selected groups and the transitive children of selected
+// OUs and containers to the selection.
+func expandAssetGroupTagSelection(ctx context.Context, graphDB graph.Database, selected graph.NodeSet) (graph.NodeSet, error) {
+	var (
+		expanded = graph.NewNodeSet()
+		frontier = selected
+	)
+
+	expanded.AddSet(selected)
+
+	return expanded, graphDB.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		for frontier.Len() > 0 {
+			var (
+				groupIDs     []graph.ID
+				containerIDs []graph.ID
+				next         = graph.NewNodeSet()
+			)
+
+			for _, node := range frontier {
+				if node.Kinds.ContainsOneOf(assetGroupTagGroupKinds...) {
+					groupIDs = append(groupIDs, node.ID)
+				}
+
+				if node.Kinds.ContainsOneOf(assetGroupTagContainerKinds...) {
+					containerIDs = append(containerIDs, node.ID)
+				}
+			}
+
+			if len(groupIDs) > 0 {
+				if members, err := ops.FetchStartNodes(tx.Relationships().Filter(query.And(
+					query.KindIn(query.Relationship(), assetGroupTagMembershipKinds...),
+					query.InIDs(query.EndID(), groupIDs...),
+				))); err != nil {
+					return err
+				} else {
+					next.AddSet(members)
+				}
+			}
+
+			if len(containerIDs) > 0 {
+				if children, err := ops.FetchEndNodes(tx.Relationships().Filter(query.And(
+					query.KindIn(query.Relationship(), assetGroupTagContainsKinds...),
+					query.InIDs(query.StartID(), containerIDs...),
+				))); err != nil {
+					return err
+				} else {
+					next.AddSet(children)
+				}
+			}
+
+			frontier = graph.NewNodeSet()
+
+			for _, node := range next {
+				if !expanded.ContainsID(node.ID) {
+					expanded.Add(node)
+					frontier.Add(node)
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+// applyAssetGroupTagKind adds the kind of a tag to its members and removes it from the nodes that are no longer
+// members.
+func applyAssetGroupTagKind(ctx context.Context, graphDB graph.Database, tagKind graph.Kind, members graph.NodeSet) error {
+	return graphDB.WriteTransaction(ctx, func(tx graph.Transaction) error {
+		if tagged, err := ops.FetchNodeSet(tx.Nodes().Filter(query.Kind(query.Node(), tagKind))); err != nil {
+			return err
+		} else {
+			for _, node := range tagged {
+				if !members.ContainsID(node.ID) {
+					node.DeleteKinds(tagKind)
+
+					if err := tx.UpdateNode(node); err != nil {
+						return err
+					}
+				}
+			}
+
+			for _, node := range members {
+				if !tagged.ContainsID(node.ID) {
+					node.AddKinds(tagKind)
+
+					if err := tx.UpdateNode(node); err != nil {
+						return err
+					}
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+// clearDeletedAssetGroupTagKinds removes the kinds of tags that no longer exist from the graph.
+func clearDeletedAssetGroupTagKinds(ctx context.Context, graphDB graph.Database, tagKinds graph.Kinds) error {
+	var deletedTagKinds graph.Kinds
+
+	if kinds, err := graphDB.FetchKinds(ctx); err != nil {
+		return err
+	} else {
+		for _, kind := range kinds {
+			if strings.HasPrefix(kind.String(), model.AssetGroupTagKindPrefix) && !tagKinds.ContainsOneOf(kind) {
+				deletedTagKinds = append(deletedTagKinds, kind)
+			}
+		}
+	}
+
+	if len(deletedTagKinds) == 0 {
+		return nil
+	}
+
+	return graphDB.WriteTransaction(ctx, func(tx graph.Transaction) error {
+		if nodes, err := ops.FetchNodeSet(tx.Nodes().Filter(query.KindIn(query.Node(), deletedTagKinds...))); err != nil {
+			return err
+		} else {
+			for _, node := range nodes {
+				node.DeleteKinds(deletedTagKinds...)
+
+				if err := tx.UpdateNode(node); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,203 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package datapipe_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/specterops/bloodhound/dawgs"
+	"github.com/specterops/bloodhound/dawgs/drivers/memory"
+	"github.com/specterops/bloodhound/dawgs/graph"
+	"github.com/specterops/bloodhound/dawgs/ops"
+	"github.com/specterops/bloodhound/dawgs/query"
+	"github.com/specterops/bloodhound/graphschema/ad"
+	"github.com/specterops/bloodhound/graphschema/common"
+	"github.com/specterops/bloodhound/src/daemons/datapipe"
+	dbmocks "github.com/specterops/bloodhound/src/database/mocks"
+	"github.com/specterops/bloodhound/src/database/types/null"
+	"github.com/specterops/bloodhound/src/model"
+	"github.com/specterops/bloodhound/src/model/appcfg"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTagAssetGroupTags(t *testing.T) {
+	var (
+		ctx         = context.Background()
+		mockCtrl    = gomock.NewController(t)
+		mockDB      = dbmocks.NewMockDatabase(mockCtrl)
+		graphDB, _  = dawgs.Open(ctx, memory.DriverName, dawgs.Config{})
+		tierOne     = model.AssetGroupTag{ID: 2, Type: model.AssetGroupTagTypeTier, Name: "Tier One", Position: null.Int32From(2)}
+		owned       = model.AssetGroupTag{ID: 3, Type: model.AssetGroupTagTypeLabel, Name: "Owned"}
+		deletedKind = graph.StringKind(model.AssetGroupTagKindPrefix + "Deleted")
+		selected    = map[int][]string{}
+	)
+
+	require.Nil(t, graphDB.WriteTransaction(ctx, func(tx graph.Transaction) error {
+		node := func(objectID string, kinds ...graph.Kind) *graph.Node {
+			created, err := tx.CreateNode(graph.AsProperties(map[string]any{common.ObjectID.String(): objectID}), kinds...)
+			require.Nil(t, err)
+			return created
+		}
+		relate := func(start, end *graph.Node, kind graph.Kind) {
+			_, err := tx.CreateRelationshipByIDs(start.ID, end.ID, kind, graph.NewProperties())
+			require.Nil(t, err)
+		}
+
+		var (
+			group         = node("GROUP", ad.Entity, ad.Group)
+			member        = node("MEMBER", ad.Entity, ad.User)
+			nestedGroup   = node("NESTED-GROUP", ad.Entity, ad.Group)
+			nestedMember  = node("NESTED-MEMBER", ad.Entity, ad.User)
+			ou            = node("OU", ad.Entity, ad.OU)
+			child         = node("CHILD", ad.Entity, ad.Computer)
+			staleMember   = node("STALE", ad.Entity, ad.User, tierOne.ToKind())
+			deletedMember = node("DELETED", ad.Entity, ad.User, deletedKind)
+		)
+
+		relate(member, group, ad.MemberOf)
+		relate(nestedGroup, group, ad.MemberOf)
+		relate(nestedMember, nestedGroup, ad.MemberOf)
+		relate(ou, child, ad.Contains)
+
+		require.NotNil(t, staleMember)
+		require.NotNil(t, deletedMember)
+		return nil
+	}))
+
+	mockDB.EXPECT().GetFlagByKey(gomock.Any(), appcfg.FeatureTierManagement).Return(appcfg.FeatureFlag{Enabled: true}, nil)
+	mockDB.EXPECT().GetAssetGroupTags(gomock.Any(), model.SQLFilter{}).Return(model.AssetGroupTags{tierOne, owned}, nil)
+	mockDB.EXPECT().GetAssetGroupTagSelectorsByTagId(gomock.Any(), tierOne.ID, model.SQLFilter{}, model.SQLFilter{}).Return(model.AssetGroupTagSelectors{
+		{ID: 1, AssetGroupTagId: tierOne.ID, Seeds: []model.SelectorSeed{{Type: model.SelectorTypeObjectId, Value: "GROUP"}}},
+		{ID: 2, AssetGroupTagId: tierOne.ID, Seeds: []model.SelectorSeed{{Type: model.SelectorTypeCypher, Value: "MATCH (n:OU) RETURN n"}}},
+	}, nil)
+	mockDB.EXPECT().GetAssetGroupTagSelectorsByTagId(gomock.Any(), owned.ID, model.SQLFilter{}, model.SQLFilter{}).Return(model.AssetGroupTagSelectors{
+		{ID: 3, AssetGroupTagId: owned.ID, Seeds: []model.SelectorSeed{{Type: model.SelectorTypeObjectId, Value: "GROUP"}}},
+		{ID: 4, AssetGroupTagId: owned.ID, DisabledAt: null.TimeFrom(time.Now()), Seeds: []model.SelectorSeed{{Type: model.SelectorTypeObjectId, Value: "MEMBER"}}},
+	}, nil)
+	mockDB.EXPECT().UpdateAssetGroupTagSelectorNodes(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, selectorID int, nodes []model.AssetGroupTagSelectorNode) error {
+		for _, node := range nodes {
+			selected[selectorID] = append(selected[selectorID], node.ObjectID)
+		}
+
+		sort.Strings(selected[selectorID])
+		return nil
+	}).Times(4)
+
+	require.Nil(t, datapipe.TagAssetGroupTags(ctx, mockDB, graphDB))
+
+	// Tier selections are expanded to group members and OU children while label selections are not
+	require.Equal(t, []string{"GROUP", "MEMBER", "NESTED-GROUP", "NESTED-MEMBER"}, selected[1])
+	require.Equal(t, []string{"CHILD", "OU"}, selected[2])
+	require.Equal(t, []string{"GROUP"}, selected[3])
+	require.Empty(t, selected[4])
+
+	taggedObjectIDs := func(kind graph.Kind) []string {
+		var objectIDs []string
+
+		require.Nil(t, graphDB.ReadTransaction(ctx, func(tx graph.Transaction) error {
+			nodes, err := ops.FetchNodeSet(tx.Nodes().Filter(query.Kind(query.Node(), kind)))
+			for _, node := range nodes {
+				objectID, _ := node.Properties.Get(common.ObjectID.String()).String()
+				objectIDs = append(objectIDs, objectID)
+			}
+			return err
+		}))
+
+		sort.Strings(objectIDs)
+		return objectIDs
+	}
+
+	require.Equal(t, []string{"CHILD", "GROUP", "MEMBER", "NESTED-GROUP", "NESTED-MEMBER", "OU"}, taggedObjectIDs(tierOne.ToKind()))
+	require.Equal(t, []string{"GROUP"}, taggedObjectIDs(owned.ToKind()))
+	require.Empty(t, taggedObjectIDs(deletedKind))
+}
+
+func TestTagAssetGroupTags_FailingSelector(t *testing.T) {
+	var (
+		ctx        = context.Background()
+		mockCtrl   = gomock.NewController(t)
+		mockDB     = dbmocks.NewMockDatabase(mockCtrl)
+		graphDB, _ = dawgs.Open(ctx, memory.DriverName, dawgs.Config{})
+		tierOne    = model.AssetGroupTag{ID: 2, Type: model.AssetGroupTagTypeTier, Name: "Tier One", Position: null.Int32From(2)}
+		owned      = model.AssetGroupTag{ID: 3, Type: model.AssetGroupTagTypeLabel, Name: "Owned"}
+	)
+
+	require.Nil(t, graphDB.WriteTransaction(ctx, func(tx graph.Transaction) error {
+		for objectID, kinds := range map[string]graph.Kinds{
+			"MEMBER": {ad.Entity, ad.User, tierOne.ToKind()},
+			"GROUP":  {ad.Entity, ad.Group},
+		} {
+			if _, err := tx.CreateNode(graph.AsProperties(map[string]any{common.ObjectID.String(): objectID}), kinds...); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}))
+
+	mockDB.EXPECT().GetFlagByKey(gomock.Any(), appcfg.FeatureTierManagement).Return(appcfg.FeatureFlag{Enabled: true}, nil)
+	mockDB.EXPECT().GetAssetGroupTags(gomock.Any(), model.SQLFilter{}).Return(model.AssetGroupTags{tierOne, owned}, nil)
+	mockDB.EXPECT().GetAssetGroupTagSelectorsByTagId(gomock.Any(), tierOne.ID, model.SQLFilter{}, model.SQLFilter{}).Return(model.AssetGroupTagSelectors{
+		{ID: 1, AssetGroupTagId: tierOne.ID, Seeds: []model.SelectorSeed{{Type: model.SelectorTypeObjectId, Value: "GROUP"}}},
+		{ID: 2, AssetGroupTagId: tierOne.ID, Seeds: []model.SelectorSeed{{Type: model.SelectorTypeCypher, Value: "MATCH (n"}}},
+	}, nil)
+	mockDB.EXPECT().GetAssetGroupTagSelectorsByTagId(gomock.Any(), owned.ID, model.SQLFilter{}, model.SQLFilter{}).Return(model.AssetGroupTagSelectors{
+		{ID: 3, AssetGroupTagId: owned.ID, Seeds: []model.SelectorSeed{{Type: model.SelectorTypeObjectId, Value: "GROUP"}}},
+	}, nil)
+
+	// Only the selections of the tag whose selectors all evaluated are recorded
+	mockDB.EXPECT().UpdateAssetGroupTagSelectorNodes(gomock.Any(), 3, gomock.Any()).Return(nil)
+
+	err := datapipe.TagAssetGroupTags(ctx, mockDB, graphDB)
+	require.ErrorContains(t, err, "asset group tag 2 failed: evaluating selector 2 failed")
+
+	taggedObjectIDs := func(kind graph.Kind) []string {
+		var objectIDs []string
+
+		require.Nil(t, graphDB.ReadTransaction(ctx, func(tx graph.Transaction) error {
+			nodes, err := ops.FetchNodeSet(tx.Nodes().Filter(query.Kind(query.Node(), kind)))
+			for _, node := range nodes {
+				objectID, _ := node.Properties.Get(common.ObjectID.String()).String()
+				objectIDs = append(objectIDs, objectID)
+			}
+			return err
+		}))
+
+		return objectIDs
+	}
+
+	// The tag with the failing selector keeps its current members and gains none selected by its other selectors
+	require.Equal(t, []string{"MEMBER"}, taggedObjectIDs(tierOne.ToKind()))
+	require.Equal(t, []string{"GROUP"}, taggedObjectIDs(owned.ToKind()))
+}
+
+func TestTagAssetGroupTags_TierManagementDisabled(t *testing.T) {
+	var (
+		ctx        = context.Background()
+		mockCtrl   = gomock.NewController(t)
+		mockDB     = dbmocks.NewMockDatabase(mockCtrl)
+		graphDB, _ = dawgs.Open(ctx, memory.DriverName, dawgs.Config{})
+	)
+
+	mockDB.EXPECT().GetFlagByKey(gomock.Any(), appcfg.FeatureTierManagement).Return(appcfg.FeatureFlag{Enabled: false}, nil)
+
+	require.Nil(t, datapipe.TagAssetGroupTags(ctx, mockDB, graphDB))
+}
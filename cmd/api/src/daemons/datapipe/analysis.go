@@ -21,17 +21,27 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/specterops/bloodhound/analysis"
 	adAnalysis "github.com/specterops/bloodhound/analysis/ad"
+	azureAnalysis "github.com/specterops/bloodhound/analysis/azure"
 	"github.com/specterops/bloodhound/dawgs/graph"
+	adSchema "github.com/specterops/bloodhound/graphschema/ad"
+	azureSchema "github.com/specterops/bloodhound/graphschema/azure"
 	"github.com/specterops/bloodhound/src/analysis/ad"
 	"github.com/specterops/bloodhound/src/analysis/azure"
 	"github.com/specterops/bloodhound/src/config"
 	"github.com/specterops/bloodhound/src/database"
+	"github.com/specterops/bloodhound/src/metrics"
+	"github.com/specterops/bloodhound/src/model"
 	"github.com/specterops/bloodhound/src/model/appcfg"
 	"github.com/specterops/bloodhound/src/services/agi"
 	"github.com/specterops/bloodhound/src/services/dataquality"
+	"github.com/specterops/bloodhound/src/services/workspace"
+	"github.com/specterops/bloodhound/src/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var (
@@ -39,72 +49,250 @@ var (
 	ErrAnalysisPartiallyCompleted = errors.New("analysis partially completed")
 )
 
-func RunAnalysisOperations(ctx context.Context, db database.Database, graphDB graph.Database, _ config.Configuration) error {
+// observeAnalysisStep runs the given analysis step in its own span and records its duration. The step is reported to
+// the analysis progress and analysis run recorder of the context, if any.
+//
+// The step is skipped if analysis has already been canceled. A step that has started is given the analysis context and
+// stops at the next point where it checks for cancellation.
+func observeAnalysisStep[T any](ctx context.Context, step string, delegate func(ctx context.Context) (T, error)) (T, error) {
+	if ctx.Err() != nil {
+		var skipped T
+		return skipped, context.Cause(ctx)
+	}
+
+	defer metrics.ObserveAnalysisStep(step)()
+
+	if progress, hasProgress := analysisProgressFrom(ctx); hasProgress {
+		progress.startStep(step)
+		defer progress.completeStep()
+	}
+
 	var (
-		collectedErrors      []error
-		compositionIdCounter = analysis.NewCompositionCounter()
+		startedAt     = time.Now()
+		stepCtx, span = tracing.Start(ctx, "analysis."+step)
+		result, err   = delegate(stepCtx)
 	)
 
-	if err := adAnalysis.FixWellKnownNodeTypes(ctx, graphDB); err != nil {
-		collectedErrors = append(collectedErrors, fmt.Errorf("fix well known node types failed: %w", err))
+	if recorder, hasRecorder := analysisRunRecorderFrom(ctx); hasRecorder {
+		recorder.recordStep(step, startedAt, result, err)
 	}
 
-	if err := adAnalysis.RunDomainAssociations(ctx, graphDB); err != nil {
-		collectedErrors = append(collectedErrors, fmt.Errorf("domain association and pruning failed: %w", err))
+	return result, tracing.End(span, err)
+}
+
+func observeAnalysisOperation(ctx context.Context, step string, delegate func(ctx context.Context) error) error {
+	_, err := observeAnalysisStep(ctx, step, func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, delegate(ctx)
+	})
+
+	return err
+}
+
+// runAnalysisUnit runs analysis steps that clear state of the graph before applying it again as a single unit. The
+// unit is skipped if analysis has already been canceled, but once it has started none of its steps are interrupted by
+// cancellation.
+func runAnalysisUnit(ctx context.Context, delegate func(ctx context.Context)) {
+	if ctx.Err() == nil {
+		ctx = context.WithoutCancel(ctx)
 	}
 
-	if err := adAnalysis.LinkWellKnownGroups(ctx, graphDB); err != nil {
-		collectedErrors = append(collectedErrors, fmt.Errorf("well known group linking failed: %w", err))
+	delegate(ctx)
+}
+
+// observePostProcessingStep runs the given post-processing step, which deletes and then recomputes the given
+// relationship kinds. A step interrupted by canceled analysis has only recomputed some of its relationships, so all of
+// them are deleted rather than leaving an incomplete set in the graph. Runs triggered by ingest note when the step
+// left a different set of relationships behind, as those changes are not scoped to what the ingest jobs wrote.
+func observePostProcessingStep(ctx context.Context, graphDB graph.Database, step string, relationshipKinds graph.Kinds, delegate func(ctx context.Context) (*analysis.AtomicPostProcessingStats, error)) (*analysis.AtomicPostProcessingStats, error) {
+	var (
+		started               = ctx.Err() == nil
+		recorder, hasRecorder = analysisRunRecorderFrom(ctx)
+		tracksChanges         = started && hasRecorder && recorder.tracksPostProcessedChanges() && len(relationshipKinds) > 0
+		fingerprint           uint64
+	)
+
+	if tracksChanges {
+		if before, err := relationshipsFingerprint(ctx, graphDB, relationshipKinds); err != nil {
+			slog.WarnContext(ctx, fmt.Sprintf("Error fingerprinting relationships before step %s: %v", step, err))
+			recorder.changedPostProcessedRelationships = true
+			tracksChanges = false
+		} else {
+			fingerprint = before
+		}
 	}
 
-	if err := updateAssetGroupIsolationTags(ctx, db, graphDB); err != nil {
-		collectedErrors = append(collectedErrors, fmt.Errorf("asset group isolation tagging failed: %w", err))
+	stats, err := observeAnalysisStep(ctx, step, delegate)
+
+	if started && err != nil && ctx.Err() != nil && len(relationshipKinds) > 0 {
+		if _, deleteErr := analysis.DeleteTransitEdges(context.WithoutCancel(ctx), graphDB, graph.Kinds{adSchema.Entity, azureSchema.Entity}, relationshipKinds...); deleteErr != nil {
+			err = errors.Join(err, fmt.Errorf("failed deleting relationships of interrupted step %s: %w", step, deleteErr))
+		}
 	}
 
-	if err := TagActiveDirectoryTierZero(ctx, db, graphDB); err != nil {
-		collectedErrors = append(collectedErrors, fmt.Errorf("active directory tier zero tagging failed: %w", err))
+	if tracksChanges {
+		if after, fingerprintErr := relationshipsFingerprint(context.WithoutCancel(ctx), graphDB, relationshipKinds); fingerprintErr != nil {
+			slog.WarnContext(ctx, fmt.Sprintf("Error fingerprinting relationships after step %s: %v", step, fingerprintErr))
+			recorder.changedPostProcessedRelationships = true
+		} else if after != fingerprint {
+			recorder.changedPostProcessedRelationships = true
+		}
 	}
 
-	if err := ParallelTagAzureTierZero(ctx, graphDB); err != nil {
-		collectedErrors = append(collectedErrors, fmt.Errorf("azure tier zero tagging failed: %w", err))
+	return stats, err
+}
+
+// recordAnalysisErrors records the given errors against the analysis run of the context, if any.
+func recordAnalysisErrors(ctx context.Context, collectedErrors []error) {
+	if recorder, hasRecorder := analysisRunRecorderFrom(ctx); hasRecorder {
+		for _, err := range collectedErrors {
+			recorder.recordError(err)
+		}
 	}
+}
 
+// runGraphAnalysisOperations runs the analysis operations whose results are written only to the graph. Canceled
+// analysis interrupts the running step and skips the rest. The tagging steps run as a single unit as they clear the
+// tags of the graph before applying them again, and interrupted post-processing steps delete the relationships that
+// they had partially recomputed.
+//
+// Pruning, asset group isolation tagging and Active Directory tier zero tagging are driven by the asset groups and
+// selectors of the default graph, so they are skipped unless the default graph is being analyzed.
+func runGraphAnalysisOperations(ctx context.Context, db database.Database, graphDB graph.Database, defaultGraph bool) ([]error, bool, bool) {
 	var (
-		adFailed          = false
-		azureFailed       = false
-		agiFailed         = false
-		dataQualityFailed = false
+		collectedErrors      []error
+		compositionIdCounter = analysis.NewCompositionCounter()
+		adFailed             = false
+		azureFailed          = false
 	)
 
+	// Reconcile the graph before post-processing so that no relationships are derived through expired data
+	if defaultGraph {
+		if stats, err := observeAnalysisStep(ctx, "prune_expired_graph_data", func(ctx context.Context) (PruneStats, error) {
+			return PruneExpiredGraphData(ctx, db, graphDB)
+		}); err != nil {
+			collectedErrors = append(collectedErrors, fmt.Errorf("graph reconciliation failed: %w", err))
+		} else {
+			stats.LogStats()
+		}
+	}
+
+	if err := observeAnalysisOperation(ctx, "fix_well_known_node_types", func(ctx context.Context) error {
+		return adAnalysis.FixWellKnownNodeTypes(ctx, graphDB)
+	}); err != nil {
+		collectedErrors = append(collectedErrors, fmt.Errorf("fix well known node types failed: %w", err))
+	}
+
+	if err := observeAnalysisOperation(ctx, "domain_associations", func(ctx context.Context) error {
+		return adAnalysis.RunDomainAssociations(ctx, graphDB)
+	}); err != nil {
+		collectedErrors = append(collectedErrors, fmt.Errorf("domain association and pruning failed: %w", err))
+	}
+
+	if err := observeAnalysisOperation(ctx, "link_well_known_groups", func(ctx context.Context) error {
+		return adAnalysis.LinkWellKnownGroups(ctx, graphDB)
+	}); err != nil {
+		collectedErrors = append(collectedErrors, fmt.Errorf("well known group linking failed: %w", err))
+	}
+
+	runAnalysisUnit(ctx, func(ctx context.Context) {
+		if defaultGraph {
+			if err := observeAnalysisOperation(ctx, "asset_group_isolation_tags", func(ctx context.Context) error {
+				return updateAssetGroupIsolationTags(ctx, db, graphDB)
+			}); err != nil {
+				collectedErrors = append(collectedErrors, fmt.Errorf("asset group isolation tagging failed: %w", err))
+			}
+
+			if err := observeAnalysisOperation(ctx, "ad_tier_zero_tagging", func(ctx context.Context) error {
+				return TagActiveDirectoryTierZero(ctx, db, graphDB)
+			}); err != nil {
+				collectedErrors = append(collectedErrors, fmt.Errorf("active directory tier zero tagging failed: %w", err))
+			}
+		}
+
+		if err := observeAnalysisOperation(ctx, "azure_tier_zero_tagging", func(ctx context.Context) error {
+			return ParallelTagAzureTierZero(ctx, graphDB)
+		}); err != nil {
+			collectedErrors = append(collectedErrors, fmt.Errorf("azure tier zero tagging failed: %w", err))
+		}
+	})
+
 	// TODO: Cleanup #ADCSFeatureFlag after full launch.
 	if adcsFlag, err := db.GetFlagByKey(ctx, appcfg.FeatureAdcs); err != nil {
 		collectedErrors = append(collectedErrors, fmt.Errorf("error retrieving ADCS feature flag: %w", err))
 	} else if ntlmFlag, err := db.GetFlagByKey(ctx, appcfg.FeatureNTLMPostProcessing); err != nil {
 		collectedErrors = append(collectedErrors, fmt.Errorf("error retrieving NTLM Post Processing feature flag: %w", err))
-	} else if stats, err := ad.Post(ctx, graphDB, adcsFlag.Enabled, appcfg.GetCitrixRDPSupport(ctx, db), ntlmFlag.Enabled, &compositionIdCounter); err != nil {
+	} else if stats, err := observePostProcessingStep(ctx, graphDB, "ad_post_processing", adAnalysis.PostProcessedRelationships(), func(ctx context.Context) (*analysis.AtomicPostProcessingStats, error) {
+		return ad.Post(ctx, graphDB, adcsFlag.Enabled, appcfg.GetCitrixRDPSupport(ctx, db), ntlmFlag.Enabled, &compositionIdCounter)
+	}); err != nil {
 		collectedErrors = append(collectedErrors, fmt.Errorf("error during ad post: %w", err))
 		adFailed = true
 	} else {
 		stats.LogStats()
+		metrics.ObservePostProcessingStats(stats)
 	}
 
-	if stats, err := azure.Post(ctx, graphDB); err != nil {
+	if stats, err := observePostProcessingStep(ctx, graphDB, "azure_post_processing", azureAnalysis.PostProcessedRelationships(), func(ctx context.Context) (*analysis.AtomicPostProcessingStats, error) {
+		return azure.Post(ctx, graphDB)
+	}); err != nil {
 		collectedErrors = append(collectedErrors, fmt.Errorf("error during azure post: %w", err))
 		azureFailed = true
 	} else {
 		stats.LogStats()
+		metrics.ObservePostProcessingStats(stats)
+	}
+
+	// Registered post-processors derive relationships between AD and Azure entities after the built-in post-processing
+	if stats, err := observePostProcessingStep(ctx, graphDB, "registered_post_processing", analysis.RegisteredPostProcessors().PostProcessedRelationships(), func(ctx context.Context) (*analysis.AtomicPostProcessingStats, error) {
+		return analysis.RegisteredPostProcessors().Run(ctx, graphDB, graph.Kinds{adSchema.Entity, azureSchema.Entity})
+	}); err != nil {
+		collectedErrors = append(collectedErrors, fmt.Errorf("error during registered post-processing: %w", err))
+	} else {
+		stats.LogStats()
+		metrics.ObservePostProcessingStats(stats)
 	}
 
-	if err := agi.RunAssetGroupIsolationCollections(ctx, db, graphDB); err != nil {
+	return collectedErrors, adFailed, azureFailed
+}
+
+func RunAnalysisOperations(ctx context.Context, db database.Database, graphDB graph.Database, _ config.Configuration) error {
+	ctx, span := tracing.Start(ctx, "datapipe.analysis")
+	defer span.End()
+
+	var (
+		collectedErrors, adFailed, azureFailed = runGraphAnalysisOperations(ctx, db, graphDB, true)
+
+		agiFailed         = false
+		dataQualityFailed = false
+	)
+
+	runAnalysisUnit(ctx, func(ctx context.Context) {
+		if err := observeAnalysisOperation(ctx, "asset_group_tag_selectors", func(ctx context.Context) error {
+			return TagAssetGroupTags(ctx, db, graphDB)
+		}); err != nil {
+			collectedErrors = append(collectedErrors, fmt.Errorf("asset group tag selector evaluation failed: %w", err))
+		}
+	})
+
+	if err := observeAnalysisOperation(ctx, "asset_group_isolation_collections", func(ctx context.Context) error {
+		return agi.RunAssetGroupIsolationCollections(ctx, db, graphDB)
+	}); err != nil {
 		collectedErrors = append(collectedErrors, fmt.Errorf("asset group isolation collection failed: %w", err))
 		agiFailed = true
 	}
 
-	if err := dataquality.SaveDataQuality(ctx, db, graphDB); err != nil {
+	if err := observeAnalysisOperation(ctx, "data_quality", func(ctx context.Context) error {
+		return dataquality.SaveDataQuality(ctx, db, graphDB)
+	}); err != nil {
 		collectedErrors = append(collectedErrors, fmt.Errorf("error saving data quality stat: %v", err))
 		dataQualityFailed = true
 	}
 
+	if isCanceled(ctx, ErrAnalysisCanceled) {
+		return ErrAnalysisCanceled
+	}
+
+	recordAnalysisErrors(ctx, collectedErrors)
+
 	if len(collectedErrors) > 0 {
 		for _, err := range collectedErrors {
 			slog.ErrorContext(ctx, fmt.Sprintf("Analysis error encountered: %v", err))
@@ -119,3 +307,31 @@ func RunAnalysisOperations(ctx context.Context, db database.Database, graphDB gr
 
 	return nil
 }
+
+// RunWorkspaceAnalysisOperations runs analysis against the graph namespace of the given workspace. Graph pruning, asset
+// group tagging and Active Directory tier zero tagging are applied, and asset group isolation collections and data
+// quality stats are recorded, for the default graph only.
+func RunWorkspaceAnalysisOperations(ctx context.Context, db database.Database, graphDB graph.Database, targetWorkspace model.Workspace) error {
+	ctx, span := tracing.Start(ctx, "datapipe.analysis", trace.WithAttributes(attribute.Int64("bloodhound.workspace.id", int64(targetWorkspace.ID))))
+	defer span.End()
+
+	collectedErrors, adFailed, azureFailed := runGraphAnalysisOperations(workspace.WithTarget(ctx, targetWorkspace), db, graphDB, false)
+
+	if isCanceled(ctx, ErrAnalysisCanceled) {
+		return ErrAnalysisCanceled
+	}
+
+	for _, err := range collectedErrors {
+		slog.ErrorContext(ctx, fmt.Sprintf("Analysis error encountered for workspace %d: %v", targetWorkspace.ID, err))
+	}
+
+	recordAnalysisErrors(ctx, collectedErrors)
+
+	if adFailed && azureFailed {
+		return ErrAnalysisFailed
+	} else if adFailed || azureFailed {
+		return ErrAnalysisPartiallyCompleted
+	}
+
+	return nil
+}
@@ -0,0 +1,210 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package datapipe
+
+import (
+	"context"
+	"testing"
+
+	"github.com/specterops/bloodhound/analysis"
+	"github.com/specterops/bloodhound/dawgs"
+	"github.com/specterops/bloodhound/dawgs/drivers/memory"
+	"github.com/specterops/bloodhound/dawgs/graph"
+	"github.com/specterops/bloodhound/dawgs/query"
+	adSchema "github.com/specterops/bloodhound/graphschema/ad"
+	"github.com/specterops/bloodhound/src/database/mocks"
+	"github.com/specterops/bloodhound/src/model"
+	"github.com/specterops/bloodhound/src/model/appcfg"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestObserveAnalysisStep_Cancel(t *testing.T) {
+	var (
+		ctx, cancel = context.WithCancelCause(context.Background())
+		ran         []string
+	)
+
+	_, err := observeAnalysisStep(ctx, "first", func(ctx context.Context) (struct{}, error) {
+		// A step that has started observes cancellation
+		cancel(ErrAnalysisCanceled)
+		require.ErrorIs(t, context.Cause(ctx), ErrAnalysisCanceled)
+
+		ran = append(ran, "first")
+		return struct{}{}, context.Cause(ctx)
+	})
+	require.ErrorIs(t, err, ErrAnalysisCanceled)
+
+	_, err = observeAnalysisStep(ctx, "second", func(ctx context.Context) (struct{}, error) {
+		ran = append(ran, "second")
+		return struct{}{}, nil
+	})
+	require.ErrorIs(t, err, ErrAnalysisCanceled)
+	require.Equal(t, []string{"first"}, ran)
+}
+
+func countRelationships(t *testing.T, graphDB graph.Database, kind graph.Kind) int64 {
+	var count int64
+
+	require.Nil(t, graphDB.ReadTransaction(context.Background(), func(tx graph.Transaction) error {
+		fetched, err := tx.Relationships().Filter(query.Kind(query.Relationship(), kind)).Count()
+		count = fetched
+		return err
+	}))
+
+	return count
+}
+
+func TestObservePostProcessingStep_Interrupted(t *testing.T) {
+	var (
+		graphDB, _  = dawgs.Open(context.Background(), memory.DriverName, dawgs.Config{})
+		ctx, cancel = context.WithCancelCause(context.Background())
+		computer    *graph.Node
+		user        *graph.Node
+		postProcess = func(ctx context.Context) (*analysis.AtomicPostProcessingStats, error) {
+			stats := analysis.NewAtomicPostProcessingStats()
+
+			return &stats, graphDB.WriteTransaction(context.Background(), func(tx graph.Transaction) error {
+				_, err := tx.CreateRelationshipByIDs(user.ID, computer.ID, adSchema.AdminTo, graph.NewProperties())
+				return err
+			})
+		}
+	)
+
+	require.Nil(t, graphDB.WriteTransaction(context.Background(), func(tx graph.Transaction) error {
+		var err error
+
+		if user, err = tx.CreateNode(graph.NewProperties(), adSchema.Entity, adSchema.User); err != nil {
+			return err
+		} else if computer, err = tx.CreateNode(graph.NewProperties(), adSchema.Entity, adSchema.Computer); err != nil {
+			return err
+		}
+
+		_, err = tx.CreateRelationshipByIDs(user.ID, computer.ID, adSchema.MemberOf, graph.NewProperties())
+		return err
+	}))
+
+	// A step that completes keeps the relationships it recomputed
+	_, err := observePostProcessingStep(ctx, graphDB, "completed", graph.Kinds{adSchema.AdminTo}, postProcess)
+	require.Nil(t, err)
+	require.Equal(t, int64(1), countRelationships(t, graphDB, adSchema.AdminTo))
+
+	// A step interrupted after recomputing some of its relationships leaves none of them behind
+	_, err = observePostProcessingStep(ctx, graphDB, "interrupted", graph.Kinds{adSchema.AdminTo}, func(ctx context.Context) (*analysis.AtomicPostProcessingStats, error) {
+		stats, _ := postProcess(ctx)
+		cancel(ErrAnalysisCanceled)
+
+		return stats, context.Cause(ctx)
+	})
+	require.ErrorIs(t, err, ErrAnalysisCanceled)
+	require.Equal(t, int64(0), countRelationships(t, graphDB, adSchema.AdminTo))
+	require.Equal(t, int64(1), countRelationships(t, graphDB, adSchema.MemberOf))
+}
+
+func TestObservePostProcessingStep_ChangedRelationships(t *testing.T) {
+	var (
+		graphDB, _ = dawgs.Open(context.Background(), memory.DriverName, dawgs.Config{})
+		recorder   = newAnalysisRunRecorder(model.AnalysisRunTriggerIngest, "")
+		ctx        = withAnalysisRunRecorder(context.Background(), recorder)
+		nodes      []*graph.Node
+		recompute  = func(targets ...*graph.Node) func(ctx context.Context) (*analysis.AtomicPostProcessingStats, error) {
+			return func(ctx context.Context) (*analysis.AtomicPostProcessingStats, error) {
+				stats, err := analysis.DeleteTransitEdges(ctx, graphDB, graph.Kinds{adSchema.Entity}, adSchema.AdminTo)
+				if err != nil {
+					return stats, err
+				}
+
+				return stats, graphDB.WriteTransaction(ctx, func(tx graph.Transaction) error {
+					for _, target := range targets {
+						if _, err := tx.CreateRelationshipByIDs(nodes[0].ID, target.ID, adSchema.AdminTo, graph.NewProperties()); err != nil {
+							return err
+						}
+					}
+
+					return nil
+				})
+			}
+		}
+	)
+
+	require.Nil(t, graphDB.WriteTransaction(context.Background(), func(tx graph.Transaction) error {
+		for _, kind := range []graph.Kind{adSchema.User, adSchema.Computer, adSchema.Computer} {
+			if node, err := tx.CreateNode(graph.NewProperties(), adSchema.Entity, kind); err != nil {
+				return err
+			} else {
+				nodes = append(nodes, node)
+			}
+		}
+
+		_, err := tx.CreateRelationshipByIDs(nodes[0].ID, nodes[1].ID, adSchema.AdminTo, graph.NewProperties())
+		return err
+	}))
+
+	// Recomputing the same relationships leaves the cache invalidation scoped to the ingested data
+	_, err := observePostProcessingStep(ctx, graphDB, "unchanged", graph.Kinds{adSchema.AdminTo}, recompute(nodes[1]))
+	require.Nil(t, err)
+	require.False(t, recorder.changedPostProcessedRelationships)
+
+	// Relationships to nodes outside of the ingested data require the whole cache to be reset
+	_, err = observePostProcessingStep(ctx, graphDB, "changed", graph.Kinds{adSchema.AdminTo}, recompute(nodes[1], nodes[2]))
+	require.Nil(t, err)
+	require.True(t, recorder.changedPostProcessedRelationships)
+	require.False(t, recorder.tracksPostProcessedChanges())
+}
+
+func TestRunGraphAnalysisOperations_Cancel(t *testing.T) {
+	var (
+		mockCtrl    = gomock.NewController(t)
+		mockDB      = mocks.NewMockDatabase(mockCtrl)
+		graphDB, _  = dawgs.Open(context.Background(), memory.DriverName, dawgs.Config{})
+		recorder    = newAnalysisRunRecorder(model.AnalysisRunTriggerUserRequest, "")
+		ctx, cancel = context.WithCancelCause(context.Background())
+	)
+
+	mockDB.EXPECT().GetFlagByKey(gomock.Any(), gomock.Any()).Return(appcfg.FeatureFlag{}, nil).AnyTimes()
+
+	// Analysis canceled before it starts runs no steps
+	cancel(ErrAnalysisCanceled)
+	runGraphAnalysisOperations(withAnalysisRunRecorder(ctx, recorder), mockDB, graphDB, true)
+
+	require.Empty(t, recorder.run.Steps)
+}
+
+func TestRunGraphAnalysisOperations_Workspace(t *testing.T) {
+	var (
+		mockCtrl   = gomock.NewController(t)
+		mockDB     = mocks.NewMockDatabase(mockCtrl)
+		graphDB, _ = dawgs.Open(context.Background(), memory.DriverName, dawgs.Config{})
+		recorder   = newAnalysisRunRecorder(model.AnalysisRunTriggerUserRequest, "")
+		stepNames  []string
+	)
+
+	// Pruning and tagging read the asset groups and selectors of the default graph, which must not be consulted
+	mockDB.EXPECT().GetFlagByKey(gomock.Any(), gomock.Any()).Return(appcfg.FeatureFlag{}, nil).AnyTimes()
+	mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.CitrixRDPSupportKey).Return(appcfg.Parameter{}, nil).AnyTimes()
+
+	runGraphAnalysisOperations(withAnalysisRunRecorder(context.Background(), recorder), mockDB, graphDB, false)
+
+	for _, step := range recorder.run.Steps {
+		stepNames = append(stepNames, step.Name)
+	}
+
+	require.Len(t, stepNames, workspaceGraphAnalysisSteps)
+	require.NotContains(t, stepNames, "prune_expired_graph_data")
+	require.NotContains(t, stepNames, "asset_group_isolation_tags")
+	require.NotContains(t, stepNames, "ad_tier_zero_tagging")
+}
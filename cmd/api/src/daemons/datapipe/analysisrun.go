@@ -0,0 +1,130 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package datapipe
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/specterops/bloodhound/analysis"
+	"github.com/specterops/bloodhound/src/database/types/null"
+	"github.com/specterops/bloodhound/src/model"
+)
+
+// analysisRunRecorder accumulates the record of an analysis run as its steps complete. Steps run one at a time, so the
+// recorder is not safe for concurrent use.
+type analysisRunRecorder struct {
+	run                               model.AnalysisRun
+	workspaceID                       int32
+	prunedGraphData                   bool
+	changedPostProcessedRelationships bool
+}
+
+type analysisRunRecorderKey struct{}
+
+func newAnalysisRunRecorder(trigger model.AnalysisRunTrigger, requestedBy string) *analysisRunRecorder {
+	return &analysisRunRecorder{
+		run: model.AnalysisRun{
+			Trigger:      trigger,
+			RequestedBy:  requestedBy,
+			Status:       model.AnalysisRunStatusRunning,
+			StartedAt:    time.Now().UTC(),
+			Steps:        model.AnalysisRunSteps{},
+			EdgesCreated: model.AnalysisRunEdgeCounts{},
+			EdgesDeleted: model.AnalysisRunEdgeCounts{},
+			Errors:       model.AnalysisRunErrors{},
+		},
+	}
+}
+
+// withAnalysisRunRecorder returns a context that records the analysis steps run with it to the given recorder.
+func withAnalysisRunRecorder(ctx context.Context, recorder *analysisRunRecorder) context.Context {
+	return context.WithValue(ctx, analysisRunRecorderKey{}, recorder)
+}
+
+func analysisRunRecorderFrom(ctx context.Context) (*analysisRunRecorder, bool) {
+	recorder, hasRecorder := ctx.Value(analysisRunRecorderKey{}).(*analysisRunRecorder)
+	return recorder, hasRecorder
+}
+
+func (s *analysisRunRecorder) startWorkspace(workspaceID int32) {
+	s.workspaceID = workspaceID
+}
+
+// recordStep records a completed step. The relationships created and deleted by post-processing steps are added to the
+// per-kind counts of the run and steps that pruned graph data are noted.
+func (s *analysisRunRecorder) recordStep(name string, startedAt time.Time, result any, err error) {
+	step := model.AnalysisRunStep{
+		Name:        name,
+		WorkspaceID: s.workspaceID,
+		StartedAt:   startedAt,
+		DurationMS:  time.Since(startedAt).Milliseconds(),
+	}
+
+	if err != nil {
+		step.Error = err.Error()
+	}
+
+	if stats, isStats := result.(*analysis.AtomicPostProcessingStats); isStats && stats != nil {
+		for kind, numCreated := range stats.RelationshipsCreated {
+			s.run.EdgesCreated[kind.String()] += int64(*numCreated)
+		}
+
+		for kind, numDeleted := range stats.RelationshipsDeleted {
+			s.run.EdgesDeleted[kind.String()] += int64(*numDeleted)
+		}
+	}
+
+	if stats, isPruneStats := result.(PruneStats); isPruneStats && totalCount(stats.NodesPruned)+totalCount(stats.RelationshipsPruned) > 0 {
+		s.prunedGraphData = true
+	}
+
+	s.run.Steps = append(s.run.Steps, step)
+}
+
+// tracksPostProcessedChanges returns true if post-processing steps should check whether they changed the relationships
+// they recompute. Only runs triggered by ingest scope cache invalidation to what changed, and once one step has changed
+// its relationships the others need not be checked.
+func (s *analysisRunRecorder) tracksPostProcessedChanges() bool {
+	return s.run.Trigger == model.AnalysisRunTriggerIngest && !s.changedPostProcessedRelationships
+}
+
+func (s *analysisRunRecorder) recordError(err error) {
+	s.run.Errors = append(s.run.Errors, err.Error())
+}
+
+// finish records the outcome of the run given the error it ended with.
+func (s *analysisRunRecorder) finish(err error) {
+	s.run.CompletedAt = null.TimeFrom(time.Now().UTC())
+	s.run.Status = analysisRunStatus(err)
+}
+
+func analysisRunStatus(err error) model.AnalysisRunStatus {
+	switch {
+	case err == nil:
+		return model.AnalysisRunStatusComplete
+	case errors.Is(err, ErrAnalysisCanceled):
+		return model.AnalysisRunStatusCanceled
+	case errors.Is(err, ErrAnalysisFailed):
+		return model.AnalysisRunStatusFailed
+	case errors.Is(err, ErrAnalysisPartiallyCompleted):
+		return model.AnalysisRunStatusPartiallyComplete
+	default:
+		return model.AnalysisRunStatusFailed
+	}
+}
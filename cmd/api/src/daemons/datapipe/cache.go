@@ -0,0 +1,145 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package datapipe
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"log/slog"
+
+	"github.com/specterops/bloodhound/dawgs/graph"
+	"github.com/specterops/bloodhound/dawgs/query"
+	"github.com/specterops/bloodhound/src/model"
+	"github.com/specterops/bloodhound/src/queries"
+)
+
+// ChangedEntityQueryCacheTags returns the entity query cache tags of the nodes last written by the given ingest job.
+func ChangedEntityQueryCacheTags(ctx context.Context, graphDB graph.Database, jobID int64) ([]string, error) {
+	var (
+		seen = map[string]struct{}{}
+		tags []string
+	)
+
+	err := graphDB.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		return tx.Nodes().Filter(lastIngestJobNodeFilter(jobID)).Fetch(func(cursor graph.Cursor[*graph.Node]) error {
+			for node := range cursor.Chan() {
+				for _, tag := range queries.EntityQueryCacheTags(ctx, node) {
+					if _, isSeen := seen[tag]; !isSeen {
+						seen[tag] = struct{}{}
+						tags = append(tags, tag)
+					}
+				}
+			}
+
+			return cursor.Error()
+		})
+	})
+
+	return tags, err
+}
+
+// relationshipsFingerprint returns a fingerprint of the relationships of the given kinds. The fingerprint sums the
+// hashes of the start, end and kind of each relationship so that it does not depend on the order they are read in nor
+// on the IDs of relationships that were deleted and created again.
+func relationshipsFingerprint(ctx context.Context, graphDB graph.Database, kinds graph.Kinds) (uint64, error) {
+	var fingerprint uint64
+
+	err := graphDB.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		return tx.Relationships().Filter(query.KindIn(query.Relationship(), kinds...)).FetchKinds(func(cursor graph.Cursor[graph.RelationshipKindsResult]) error {
+			var (
+				hash   = fnv.New64a()
+				buffer = make([]byte, 16)
+			)
+
+			for next := range cursor.Chan() {
+				binary.BigEndian.PutUint64(buffer, next.StartID.Uint64())
+				binary.BigEndian.PutUint64(buffer[8:], next.EndID.Uint64())
+
+				hash.Reset()
+				hash.Write(buffer)
+				hash.Write([]byte(next.Kind.String()))
+
+				fingerprint += hash.Sum64()
+			}
+
+			return cursor.Error()
+		})
+	})
+
+	return fingerprint, err
+}
+
+// changedCacheTags returns the cache tags of the data written by the given analyzed ingest jobs. Only analysis
+// triggered by ingest that neither pruned graph data nor changed post-processed relationships can be scoped to what
+// the ingest jobs wrote, as the endpoints of post-processed relationships may lie outside of the ingested scopes;
+// false is returned when the changes made to the graph are not known and the whole cache must be reset.
+func (s *Daemon) changedCacheTags(trigger model.AnalysisRunTrigger, analyzedJobs []model.IngestJob, run *analysisRunRecorder) ([]string, bool) {
+	if trigger != model.AnalysisRunTriggerIngest || len(analyzedJobs) == 0 || run.prunedGraphData || run.changedPostProcessedRelationships {
+		return nil, false
+	}
+
+	var tags []string
+
+	for _, job := range analyzedJobs {
+		if jobCtx, err := s.ingestJobContext(s.ctx, job); err != nil {
+			slog.ErrorContext(s.ctx, fmt.Sprintf("Error targeting graph of ingest job %d for cache invalidation: %v", job.ID, err))
+			return nil, false
+		} else if jobTags, err := ChangedEntityQueryCacheTags(jobCtx, s.graphdb, job.ID); err != nil {
+			slog.ErrorContext(s.ctx, fmt.Sprintf("Error finding cache entries changed by ingest job %d: %v", job.ID, err))
+			return nil, false
+		} else {
+			tags = append(tags, jobTags...)
+		}
+	}
+
+	return tags, true
+}
+
+// invalidateCache removes the graph query cache entries that may have been changed by analysis. Entries are warmed in
+// the background afterward when entity panel caching is enabled and a warming limit is configured. The analysis
+// generation is incremented so that the other replicas reset their caches.
+func (s *Daemon) invalidateCache(trigger model.AnalysisRunTrigger, analyzedJobs []model.IngestJob, run *analysisRunRecorder, entityPanelCachingEnabled bool) {
+	if tags, scoped := s.changedCacheTags(trigger, analyzedJobs, run); !scoped {
+		resetCache(s.cache, entityPanelCachingEnabled)
+	} else {
+		removed := s.cache.Invalidate(tags...)
+		slog.InfoContext(s.ctx, fmt.Sprintf("Invalidated %d cache entries for %d changed scopes", removed, len(tags)))
+	}
+
+	if generation, err := s.db.IncrementAnalysisGeneration(s.ctx); err != nil {
+		slog.ErrorContext(s.ctx, fmt.Sprintf("Error incrementing the analysis generation: %v", err))
+	} else {
+		s.generations.Observe(s.ctx, generation)
+	}
+
+	if entityPanelCachingEnabled && s.cfg.CacheWarmingLimit > 0 && s.cacheWarming.CompareAndSwap(false, true) {
+		go func() {
+			defer s.cacheWarming.Store(false)
+			s.warmCache()
+		}()
+	}
+}
+
+func (s *Daemon) warmCache() {
+	if warmed, err := s.cache.Warm(s.ctx, s.cfg.CacheWarmingLimit); err != nil {
+		slog.WarnContext(s.ctx, fmt.Sprintf("Warmed %d cache entries with errors: %v", warmed, err))
+	} else {
+		slog.InfoContext(s.ctx, fmt.Sprintf("Warmed %d cache entries", warmed))
+	}
+}
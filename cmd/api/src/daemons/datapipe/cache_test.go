@@ -0,0 +1,45 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package datapipe_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/specterops/bloodhound/dawgs"
+	"github.com/specterops/bloodhound/dawgs/drivers/memory"
+	"github.com/specterops/bloodhound/src/daemons/datapipe"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangedEntityQueryCacheTags(t *testing.T) {
+	graphDB, err := dawgs.Open(context.Background(), memory.DriverName, dawgs.Config{})
+	require.Nil(t, err)
+
+	ingestUsersForJob(t, graphDB, 1, "USER-A")
+	ingestUsersForJob(t, graphDB, 2, "USER-B")
+
+	// Job 2 last wrote USER-B and, through its membership, GROUP
+	tags, err := datapipe.ChangedEntityQueryCacheTags(context.Background(), graphDB, 2)
+	require.Nil(t, err)
+	require.ElementsMatch(t, []string{"entity-query-object_USER-B", "entity-query-object_GROUP"}, tags)
+
+	// Job 1 no longer last wrote GROUP
+	tags, err = datapipe.ChangedEntityQueryCacheTags(context.Background(), graphDB, 1)
+	require.Nil(t, err)
+	require.Equal(t, []string{"entity-query-object_USER-A"}, tags)
+}
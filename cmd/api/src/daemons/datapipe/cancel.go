@@ -0,0 +1,97 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package datapipe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/specterops/bloodhound/src/model"
+)
+
+// cancellationPollInterval is how often in-flight analysis and ingest check for a cancellation request.
+const cancellationPollInterval = time.Second * 5
+
+var (
+	ErrAnalysisCanceled = errors.New("analysis canceled")
+	ErrIngestCanceled   = errors.New("ingest canceled")
+)
+
+// cancellationCheck returns true if cancellation of the work it watches has been requested.
+type cancellationCheck func(ctx context.Context) (bool, error)
+
+// withCancellation returns a context derived from the given one that is canceled with the given cause once the check
+// reports a cancellation request. Graph operations run under the returned context are interrupted through their own
+// derived contexts. The returned function stops the check and must be called once the work is done.
+func withCancellation(ctx context.Context, cause error, check cancellationCheck) (context.Context, context.CancelFunc) {
+	cancellableCtx, cancel := context.WithCancelCause(ctx)
+
+	go func() {
+		ticker := time.NewTicker(cancellationPollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-cancellableCtx.Done():
+				return
+
+			case <-ticker.C:
+				if canceled, err := check(cancellableCtx); err != nil {
+					if cancellableCtx.Err() == nil {
+						slog.ErrorContext(ctx, fmt.Sprintf("Error checking for cancellation: %v", err))
+					}
+				} else if canceled {
+					slog.InfoContext(ctx, fmt.Sprintf("Cancellation requested: %v", cause))
+					cancel(cause)
+					return
+				}
+			}
+		}
+	}()
+
+	return cancellableCtx, func() {
+		cancel(nil)
+	}
+}
+
+// isCanceled returns true if the given context was canceled with the given cause.
+func isCanceled(ctx context.Context, cause error) bool {
+	return errors.Is(context.Cause(ctx), cause)
+}
+
+// analysisCancellationCheck reports whether cancellation of the in-flight analysis has been requested.
+func (s *Daemon) analysisCancellationCheck(ctx context.Context) (bool, error) {
+	if status, err := s.db.GetDatapipeStatus(ctx); err != nil {
+		return false, err
+	} else {
+		return status.CancellationRequestedAt.Valid, nil
+	}
+}
+
+// ingestCancellationCheck returns a check reporting whether the ingest job with the given ID has been canceled.
+func (s *Daemon) ingestCancellationCheck(jobID int64) cancellationCheck {
+	return func(ctx context.Context) (bool, error) {
+		if job, err := s.db.GetIngestJob(ctx, jobID); err != nil {
+			return false, err
+		} else {
+			return job.Status == model.JobStatusCanceled, nil
+		}
+	}
+}
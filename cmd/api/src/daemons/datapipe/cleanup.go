@@ -16,60 +16,38 @@
 
 package datapipe
 
-//go:generate go run go.uber.org/mock/mockgen -copyright_file=../../../../../LICENSE.header -destination=./mocks/cleanup.go -package=mocks . FileOperations
-
 import (
 	"context"
 	"fmt"
 	"log/slog"
-	"os"
-	"path/filepath"
-	"strings"
 	"sync"
-)
-
-// FileOperations is an interface for describing filesystem actions. This implementation exists due to deficiencies in
-// the fs package where the fs.FS interface does not support destructive operations like RemoveAll.
-type FileOperations interface {
-	ReadDir(path string) ([]os.DirEntry, error)
-	RemoveAll(path string) error
-}
-
-// osFileOperations is a passthrough implementation of the FileOperations interface that uses the os package
-// functions.
-type osFileOperations struct{}
-
-func NewOSFileOperations() FileOperations {
-	return osFileOperations{}
-}
+	"time"
 
-func (s osFileOperations) ReadDir(path string) ([]os.DirEntry, error) {
-	return os.ReadDir(path)
-}
+	"github.com/specterops/bloodhound/src/services/ingest/storage"
+)
 
-func (s osFileOperations) RemoveAll(path string) error {
-	return os.RemoveAll(path)
-}
+// orphanedFileGracePeriod is how long a stored file is left alone after it was last modified. Replicas share the
+// ingest store, so a file that is not yet referenced by an ingest task may still be written by an upload being
+// accepted by another replica.
+const orphanedFileGracePeriod = time.Hour
 
 // OrphanFileSweeper is a file cleanup utility that allows only one goroutine to attempt file cleanup at a time.
 type OrphanFileSweeper struct {
-	lock                  *sync.Mutex
-	fileOps               FileOperations
-	tempDirectoryRootPath string
+	lock  *sync.Mutex
+	store storage.Store
 }
 
-func NewOrphanFileSweeper(fileOps FileOperations, tempDirectoryRootPath string) *OrphanFileSweeper {
+func NewOrphanFileSweeper(store storage.Store) *OrphanFileSweeper {
 	return &OrphanFileSweeper{
-		lock:                  &sync.Mutex{},
-		fileOps:               fileOps,
-		tempDirectoryRootPath: strings.TrimSuffix(tempDirectoryRootPath, string(filepath.Separator)),
+		lock:  &sync.Mutex{},
+		store: store,
 	}
 }
 
-// Clear takes a context and a list of expected file names. The function will list all directory entries within the
-// configured tempDirectoryRootPath that the sweeper was instantiated with and compare the resulting list against the
-// passed expected file names. The function will then call RemoveAll on each directory entry not found in the expected
-// file name slice.
+// Clear takes a context and a list of expected file names. The function will list all files held by the ingest store
+// that the sweeper was instantiated with and compare the resulting list against the passed expected file names. The
+// function will then remove each file not found in the expected file name slice that has not been modified within the
+// grace period.
 func (s *OrphanFileSweeper) Clear(ctx context.Context, expectedFileNames []string) {
 	// Only allow one background thread to run for clearing orphaned data/
 	if !s.lock.TryLock() {
@@ -79,43 +57,39 @@ func (s *OrphanFileSweeper) Clear(ctx context.Context, expectedFileNames []strin
 	// Release the lock once finished
 	defer s.lock.Unlock()
 
-	slog.InfoContext(ctx, fmt.Sprintf("Running OrphanFileSweeper for path %s", s.tempDirectoryRootPath))
+	slog.InfoContext(ctx, fmt.Sprintf("Running OrphanFileSweeper for %s", s.store.Location()))
 	slog.DebugContext(ctx, fmt.Sprintf("OrphanFileSweeper expected names %v", expectedFileNames))
 
-	if dirEntries, err := s.fileOps.ReadDir(s.tempDirectoryRootPath); err != nil {
-		slog.ErrorContext(ctx, fmt.Sprintf("Failed reading work directory %s: %v", s.tempDirectoryRootPath, err))
+	if objects, err := s.store.List(ctx); err != nil {
+		slog.ErrorContext(ctx, fmt.Sprintf("Failed listing ingest files in %s: %v", s.store.Location(), err))
 	} else {
-		numDeleted := 0
+		var (
+			expected   = make(map[string]struct{}, len(expectedFileNames))
+			numDeleted = 0
+		)
 
-		// Remove expected files from the deletion list
 		for _, expectedFileName := range expectedFileNames {
-			expectedDir, expectedFN := filepath.Split(expectedFileName)
-			if expectedDir != "" {
-				expectedDir = strings.TrimSuffix(expectedDir, string(filepath.Separator))
-				if expectedDir != s.tempDirectoryRootPath {
-					slog.WarnContext(ctx, fmt.Sprintf("directory '%s' for expectedFileName '%s' does not match tempDirectoryRootPath '%s': skipping", expectedDir, expectedFileName, s.tempDirectoryRootPath))
-					continue
-				}
-			}
-			for idx, dirEntry := range dirEntries {
-				if expectedFN == dirEntry.Name() {
-					slog.DebugContext(ctx, fmt.Sprintf("skipping expected file %s", expectedFN))
-					dirEntries = append(dirEntries[:idx], dirEntries[idx+1:]...)
-				}
-			}
+			expected[expectedFileName] = struct{}{}
 		}
 
-		for _, orphanedDirEntry := range dirEntries {
+		for _, object := range objects {
 			// Check for context cancellation before each file deletion
 			if ctx.Err() != nil {
 				break
 			}
 
-			slog.InfoContext(ctx, fmt.Sprintf("Removing orphaned file %s", orphanedDirEntry.Name()))
-			fullPath := filepath.Join(s.tempDirectoryRootPath, orphanedDirEntry.Name())
+			if _, isExpected := expected[object.Name]; isExpected {
+				slog.DebugContext(ctx, fmt.Sprintf("skipping expected file %s", object.Name))
+				continue
+			} else if time.Since(object.ModTime) < orphanedFileGracePeriod {
+				slog.DebugContext(ctx, fmt.Sprintf("skipping recently modified file %s", object.Name))
+				continue
+			}
+
+			slog.InfoContext(ctx, fmt.Sprintf("Removing orphaned file %s", object.Name))
 
-			if err := s.fileOps.RemoveAll(fullPath); err != nil {
-				slog.ErrorContext(ctx, fmt.Sprintf("Failed removing orphaned file %s: %v", fullPath, err))
+			if err := s.store.Remove(ctx, object.Name); err != nil {
+				slog.ErrorContext(ctx, fmt.Sprintf("Failed removing orphaned file %s: %v", object.Name, err))
 			}
 
 			numDeleted += 1
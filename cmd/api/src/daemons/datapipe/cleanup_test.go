@@ -18,65 +18,50 @@ package datapipe_test
 
 import (
 	"context"
-	"io/fs"
-	"os"
-	"path/filepath"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/specterops/bloodhound/src/daemons/datapipe"
-	"github.com/specterops/bloodhound/src/daemons/datapipe/mocks"
+	"github.com/specterops/bloodhound/src/services/ingest/storage"
+	"github.com/specterops/bloodhound/src/services/ingest/storage/mocks"
 	"go.uber.org/mock/gomock"
 )
 
-type dirEntry struct {
-	name    string
-	isDir   bool
-	mode    fs.FileMode
-	info    fs.FileInfo
-	infoErr error
-}
-
-func (s dirEntry) Name() string {
-	return s.name
-}
-
-func (s dirEntry) IsDir() bool {
-	return s.isDir
-}
-
-func (s dirEntry) Type() fs.FileMode {
-	return s.mode
-}
-
-func (s dirEntry) Info() (fs.FileInfo, error) {
-	return s.info, s.infoErr
-}
-
 func TestOrphanFileSweeper_Clear(t *testing.T) {
 	const workDir = "/fake/work/dir"
 
+	var (
+		staleTime = time.Now().Add(-2 * time.Hour)
+		newStore  = func(mockCtrl *gomock.Controller) *mocks.MockStore {
+			mockStore := mocks.NewMockStore(mockCtrl)
+			mockStore.EXPECT().Location().Return(workDir).AnyTimes()
+
+			return mockStore
+		}
+	)
+
 	t.Run("Allow Only One Goroutine", func(t *testing.T) {
 		var (
 			mockCtrl       = gomock.NewController(t)
-			mockFileOps    = mocks.NewMockFileOperations(mockCtrl)
-			sweeper        = datapipe.NewOrphanFileSweeper(mockFileOps, workDir)
+			mockStore      = newStore(mockCtrl)
+			sweeper        = datapipe.NewOrphanFileSweeper(mockStore)
 			wgCoordination = &sync.WaitGroup{}
-			wgReadDir      = &sync.WaitGroup{}
+			wgList         = &sync.WaitGroup{}
 		)
 
 		defer mockCtrl.Finish()
 
 		// Prep the wait groups for coordination
 		wgCoordination.Add(1)
-		wgReadDir.Add(1)
+		wgList.Add(1)
 
-		mockFileOps.EXPECT().ReadDir(workDir).DoAndReturn(func(path string) ([]os.DirEntry, error) {
+		mockStore.EXPECT().List(gomock.Any()).DoAndReturn(func(ctx context.Context) ([]storage.Object, error) {
 			// Release the coordination wait group
 			wgCoordination.Done()
 
-			// Block on the readDir wait group
-			wgReadDir.Wait()
+			// Block on the list wait group
+			wgList.Wait()
 
 			return nil, nil
 		})
@@ -84,101 +69,91 @@ func TestOrphanFileSweeper_Clear(t *testing.T) {
 		// Launch the clear function in a goroutine. The wait group will cause this call to block
 		go sweeper.Clear(context.Background(), []string{})
 
-		// Wait for the go routine to reach the ReadDir function
+		// Wait for the go routine to reach the List function
 		wgCoordination.Wait()
 
 		// Run the clear function in the current thread context as this should exit without blocking
 		sweeper.Clear(context.Background(), []string{})
 
 		// Release the wait group to complete the test
-		wgReadDir.Done()
+		wgList.Done()
 	})
 
 	t.Run("Clear Orphan Files", func(t *testing.T) {
 		var (
-			mockCtrl    = gomock.NewController(t)
-			mockFileOps = mocks.NewMockFileOperations(mockCtrl)
-			sweeper     = datapipe.NewOrphanFileSweeper(mockFileOps, workDir)
+			mockCtrl  = gomock.NewController(t)
+			mockStore = newStore(mockCtrl)
+			sweeper   = datapipe.NewOrphanFileSweeper(mockStore)
 		)
 
 		defer mockCtrl.Finish()
 
-		mockFileOps.EXPECT().ReadDir(workDir).Return([]os.DirEntry{
-			dirEntry{
-				name: "1",
-			},
+		mockStore.EXPECT().List(gomock.Any()).Return([]storage.Object{
+			{Name: workDir + "/1", ModTime: staleTime},
 		}, nil)
 
-		mockFileOps.EXPECT().RemoveAll(filepath.Join(workDir, "1")).Return(nil)
+		mockStore.EXPECT().Remove(gomock.Any(), workDir+"/1").Times(1)
 
 		sweeper.Clear(context.Background(), []string{})
 	})
 
 	t.Run("Exclude Expected Files", func(t *testing.T) {
 		var (
-			mockCtrl    = gomock.NewController(t)
-			mockFileOps = mocks.NewMockFileOperations(mockCtrl)
-			sweeper     = datapipe.NewOrphanFileSweeper(mockFileOps, workDir)
+			mockCtrl  = gomock.NewController(t)
+			mockStore = newStore(mockCtrl)
+			sweeper   = datapipe.NewOrphanFileSweeper(mockStore)
 		)
 
 		defer mockCtrl.Finish()
 
-		mockFileOps.EXPECT().ReadDir(workDir).Return([]os.DirEntry{
-			dirEntry{
-				name: "1",
-			},
+		mockStore.EXPECT().List(gomock.Any()).Return([]storage.Object{
+			{Name: workDir + "/1", ModTime: staleTime},
+			{Name: "ingest/bh2", ModTime: staleTime},
 		}, nil)
 
-		// This one is a negative assertion. Because we're passing in "1" to be excluded the listed dirEntries will be
-		// empty and therefore the sweeper MUST NOT call RemoveAll() on the FileOperations mock. If RemoveAll() is
-		// called then this test MUST fail.
-		sweeper.Clear(context.Background(), []string{"1"})
+		// This one is a negative assertion. Because we're passing in both files to be excluded the sweeper MUST NOT
+		// call Remove() on the Store mock. If Remove() is called then this test MUST fail.
+		sweeper.Clear(context.Background(), []string{workDir + "/1", "ingest/bh2"})
 	})
 
-	t.Run("Exclude Files With Paths", func(t *testing.T) {
+	t.Run("Skip Recently Modified Files", func(t *testing.T) {
 		var (
-			mockCtrl    = gomock.NewController(t)
-			mockFileOps = mocks.NewMockFileOperations(mockCtrl)
-			sweeper     = datapipe.NewOrphanFileSweeper(mockFileOps, workDir)
+			mockCtrl  = gomock.NewController(t)
+			mockStore = newStore(mockCtrl)
+			sweeper   = datapipe.NewOrphanFileSweeper(mockStore)
 		)
 
 		defer mockCtrl.Finish()
 
-		mockFileOps.EXPECT().ReadDir(workDir).Return([]os.DirEntry{
-			dirEntry{
-				name: "1",
-			},
-			dirEntry{
-				name: "2",
-			},
+		mockStore.EXPECT().List(gomock.Any()).Return([]storage.Object{
+			{Name: workDir + "/1", ModTime: time.Now()},
+			{Name: workDir + "/2", ModTime: staleTime},
 		}, nil)
 
-		// This one is a negative assertion. Because we're passing in paths to be excluded the listed dirEntries will be
-		// empty and therefore the sweeper MUST NOT call RemoveAll() on the FileOperations mock. If RemoveAll() is
-		// called then this test MUST fail.
-		sweeper.Clear(context.Background(), []string{"1", workDir + "/2"})
+		// File "1" may still be written by an upload accepted by another replica so only "2" is removed
+		mockStore.EXPECT().Remove(gomock.Any(), workDir+"/2").Times(1)
+
+		sweeper.Clear(context.Background(), []string{})
 	})
 
 	t.Run("Exit on Context Cancellation", func(t *testing.T) {
 		var (
-			mockCtrl    = gomock.NewController(t)
-			mockFileOps = mocks.NewMockFileOperations(mockCtrl)
-			sweeper     = datapipe.NewOrphanFileSweeper(mockFileOps, workDir)
+			mockCtrl  = gomock.NewController(t)
+			mockStore = newStore(mockCtrl)
+			sweeper   = datapipe.NewOrphanFileSweeper(mockStore)
 		)
 
 		defer mockCtrl.Finish()
 
-		mockFileOps.EXPECT().ReadDir(workDir).Return([]os.DirEntry{
-			dirEntry{
-				name: "1",
-			},
+		mockStore.EXPECT().List(gomock.Any()).Return([]storage.Object{
+			{Name: workDir + "/1", ModTime: staleTime},
 		}, nil)
 
 		// Create a cancellable context and cancel it right away
 		ctx, done := context.WithCancel(context.Background())
 		done()
 
-		// When passed in with the cancelled context the sweeper should not call os.RemoveAll("1")
+		// When passed in with the cancelled context the sweeper should not call Remove("1")
 		sweeper.Clear(ctx, []string{})
 	})
 }
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"sync/atomic"
 	"time"
 
 	"github.com/specterops/bloodhound/bhlog/measure"
@@ -28,43 +29,64 @@ import (
 	"github.com/specterops/bloodhound/dawgs/graph"
 	"github.com/specterops/bloodhound/src/bootstrap"
 	"github.com/specterops/bloodhound/src/config"
+	"github.com/specterops/bloodhound/src/daemons"
 	"github.com/specterops/bloodhound/src/database"
+	"github.com/specterops/bloodhound/src/events"
 	"github.com/specterops/bloodhound/src/model"
 	"github.com/specterops/bloodhound/src/model/appcfg"
 	"github.com/specterops/bloodhound/src/services/ingest"
+	"github.com/specterops/bloodhound/src/services/ingest/storage"
+	"github.com/specterops/bloodhound/src/services/workspace"
 )
 
 const (
 	pruningInterval = time.Hour * 24
 )
 
+// AnalysisGenerationObserver is told of each analysis generation whose changes the cache of this replica reflects
+type AnalysisGenerationObserver interface {
+	Observe(ctx context.Context, generation int64)
+}
+
 type Daemon struct {
 	db                  database.Database
 	graphdb             graph.Database
 	cache               cache.Cache
+	generations         AnalysisGenerationObserver
 	cfg                 config.Configuration
 	tickInterval        time.Duration
 	ctx                 context.Context
 	orphanedFileSweeper *OrphanFileSweeper
+	events              *events.Bus
+	store               storage.Store
+	cacheWarming        atomic.Bool
+	doneC               chan struct{}
 }
 
 func (s *Daemon) Name() string {
 	return "Data Pipe Daemon"
 }
 
-func NewDaemon(ctx context.Context, cfg config.Configuration, connections bootstrap.DatabaseConnections[*database.BloodhoundDB, *graph.DatabaseSwitch], cache cache.Cache, tickInterval time.Duration) *Daemon {
+func NewDaemon(ctx context.Context, cfg config.Configuration, connections bootstrap.DatabaseConnections[*database.BloodhoundDB, *graph.DatabaseSwitch], cache cache.Cache, generations AnalysisGenerationObserver, tickInterval time.Duration, eventBus *events.Bus, store storage.Store) *Daemon {
 	return &Daemon{
 		db:                  connections.RDMS,
 		graphdb:             connections.Graph,
 		cache:               cache,
+		generations:         generations,
 		cfg:                 cfg,
 		ctx:                 ctx,
-		orphanedFileSweeper: NewOrphanFileSweeper(NewOSFileOperations(), cfg.TempDirectory()),
+		orphanedFileSweeper: NewOrphanFileSweeper(store),
 		tickInterval:        tickInterval,
+		events:              eventBus,
+		store:               store,
+		doneC:               make(chan struct{}),
 	}
 }
 
 func (s *Daemon) analyze() {
+	// The analysis request is read before it is deleted so that the analysis run records what triggered it
+	trigger, requestedBy := s.analysisTrigger()
+
 	// Ensure that the user-requested analysis switch is deleted. This is done at the beginning of the
 	// function so that any re-analysis requests are caught while analysis is in-progress.
 	if err := s.db.DeleteAnalysisRequest(s.ctx); err != nil {
@@ -83,8 +105,24 @@ func (s *Daemon) analyze() {
 
 	defer measure.LogAndMeasure(slog.LevelInfo, "Graph Analysis")()
 
-	if err := RunAnalysisOperations(s.ctx, s.db, s.graphdb, s.cfg); err != nil {
-		if errors.Is(err, ErrAnalysisFailed) {
+	analysisCtx, stopCancellationCheck := withCancellation(s.ctx, ErrAnalysisCanceled, s.analysisCancellationCheck)
+	defer stopCancellationCheck()
+
+	// The ingest jobs under analysis are read before they are completed so that only the cache entries depending on the
+	// data they wrote are invalidated once analysis succeeds
+	analyzedJobs, err := s.db.GetIngestJobsWithStatus(s.ctx, model.JobStatusAnalyzing)
+	if err != nil {
+		slog.ErrorContext(s.ctx, fmt.Sprintf("Error loading ingest jobs under analysis: %v", err))
+	}
+
+	run := s.startAnalysisRun(trigger, requestedBy)
+	err = s.runAnalysisOperations(analysisCtx, run, trigger, analyzedJobs)
+	s.finishAnalysisRun(run, err)
+
+	if err != nil {
+		if errors.Is(err, ErrAnalysisCanceled) {
+			s.analysisCanceled()
+		} else if errors.Is(err, ErrAnalysisFailed) {
 			FailAnalyzedIngestJobs(s.ctx, s.db)
 			if err := s.db.SetDatapipeStatus(s.ctx, model.DatapipeStatusIdle, false); err != nil {
 				slog.ErrorContext(s.ctx, fmt.Sprintf("Error setting datapipe status: %v", err))
@@ -104,7 +142,7 @@ func (s *Daemon) analyze() {
 		if entityPanelCachingFlag, err := s.db.GetFlagByKey(s.ctx, appcfg.FeatureEntityPanelCaching); err != nil {
 			slog.ErrorContext(s.ctx, fmt.Sprintf("Error retrieving entity panel caching flag: %v", err))
 		} else {
-			resetCache(s.cache, entityPanelCachingFlag.Enabled)
+			s.invalidateCache(trigger, analyzedJobs, run, entityPanelCachingFlag.Enabled)
 		}
 
 		if err := s.db.SetDatapipeStatus(s.ctx, model.DatapipeStatusIdle, true); err != nil {
@@ -114,6 +152,111 @@ func (s *Daemon) analyze() {
 	}
 }
 
+// analysisTrigger returns what triggered the pending analysis along with who requested it. Analysis is triggered by
+// ingest unless it was requested.
+func (s *Daemon) analysisTrigger() (model.AnalysisRunTrigger, string) {
+	if analysisRequest, err := s.db.GetAnalysisRequest(s.ctx); err != nil {
+		if !errors.Is(err, database.ErrNotFound) {
+			slog.ErrorContext(s.ctx, fmt.Sprintf("Error getting analysis request: %v", err))
+		}
+
+		return model.AnalysisRunTriggerIngest, ""
+	} else {
+		return model.AnalysisRunTriggerFor(analysisRequest), analysisRequest.RequestedBy
+	}
+}
+
+// startAnalysisRun records the start of an analysis run so that runs in progress are visible.
+func (s *Daemon) startAnalysisRun(trigger model.AnalysisRunTrigger, requestedBy string) *analysisRunRecorder {
+	recorder := newAnalysisRunRecorder(trigger, requestedBy)
+
+	if run, err := s.db.CreateAnalysisRun(s.ctx, recorder.run); err != nil {
+		slog.ErrorContext(s.ctx, fmt.Sprintf("Error recording analysis run: %v", err))
+	} else {
+		recorder.run = run
+	}
+
+	return recorder
+}
+
+// finishAnalysisRun records the outcome of an analysis run. Runs that could not be recorded at their start are
+// recorded now.
+func (s *Daemon) finishAnalysisRun(recorder *analysisRunRecorder, err error) {
+	recorder.finish(err)
+
+	if err := s.db.UpdateAnalysisRun(s.ctx, recorder.run); err != nil {
+		slog.ErrorContext(s.ctx, fmt.Sprintf("Error recording analysis run: %v", err))
+	}
+}
+
+// analysisCanceled records the cancellation of analysis against the ingest jobs that were waiting for it.
+func (s *Daemon) analysisCanceled() {
+	canceledBy := ""
+
+	if status, err := s.db.GetDatapipeStatus(s.ctx); err != nil {
+		slog.ErrorContext(s.ctx, fmt.Sprintf("Error getting datapipe status: %v", err))
+	} else {
+		canceledBy = status.CancellationRequestedBy.ValueOrZero()
+	}
+
+	CancelAnalyzedIngestJobs(s.ctx, s.db, canceledBy)
+
+	if err := s.db.SetDatapipeStatus(s.ctx, model.DatapipeStatusIdle, false); err != nil {
+		slog.ErrorContext(s.ctx, fmt.Sprintf("Error setting datapipe status: %v", err))
+	}
+}
+
+// runAnalysisOperations analyzes the default graph followed by the graph of each workspace selected by
+// WorkspacesToAnalyze, recording each step to the given analysis run. Analysis is reported as partially completed if
+// any workspace fails analysis, and as canceled if it is canceled through the given context.
+func (s *Daemon) runAnalysisOperations(ctx context.Context, run *analysisRunRecorder, trigger model.AnalysisRunTrigger, analyzedJobs model.IngestJobs) error {
+	var (
+		workspaces    model.Workspaces
+		workspacesErr error
+	)
+
+	// Workspaces are fetched up front so that the progress of the run accounts for their analysis steps
+	if workspace.IsSupported(s.graphdb) {
+		if workspaces, workspacesErr = s.db.GetAllWorkspaces(ctx); workspacesErr == nil {
+			workspaces = WorkspacesToAnalyze(workspaces, trigger, analyzedJobs)
+		}
+	}
+
+	var (
+		progress    = newAnalysisProgress(s.events, workspaces)
+		analysisCtx = withAnalysisRunRecorder(withAnalysisProgress(ctx, progress), run)
+	)
+
+	progress.started()
+	analysisErr := RunAnalysisOperations(analysisCtx, s.db, s.graphdb, s.cfg)
+
+	if workspacesErr != nil {
+		slog.ErrorContext(ctx, fmt.Sprintf("Error fetching workspaces for analysis: %v", workspacesErr))
+		run.recordError(fmt.Errorf("error fetching workspaces for analysis: %w", workspacesErr))
+		analysisErr = errors.Join(analysisErr, ErrAnalysisPartiallyCompleted)
+	} else {
+		for _, nextWorkspace := range workspaces {
+			if ctx.Err() != nil {
+				break
+			}
+
+			progress.startWorkspace(nextWorkspace.ID)
+			run.startWorkspace(nextWorkspace.ID)
+
+			if err := RunWorkspaceAnalysisOperations(analysisCtx, s.db, s.graphdb, nextWorkspace); err != nil && analysisErr == nil {
+				analysisErr = ErrAnalysisPartiallyCompleted
+			}
+		}
+	}
+
+	if isCanceled(ctx, ErrAnalysisCanceled) {
+		analysisErr = ErrAnalysisCanceled
+	}
+
+	progress.finished(analysisErr)
+	return analysisErr
+}
+
 func resetCache(cacher cache.Cache, _ bool) {
 	if err := cacher.Reset(); err != nil {
 		slog.Error(fmt.Sprintf("Error while resetting the cache: %v", err))
@@ -136,6 +279,7 @@ func (s *Daemon) Start(ctx context.Context) {
 		pruningTicker     = time.NewTicker(pruningInterval)
 	)
 
+	defer close(s.doneC)
 	defer datapipeLoopTimer.Stop()
 	defer pruningTicker.Stop()
 
@@ -147,10 +291,18 @@ func (s *Daemon) Start(ctx context.Context) {
 			s.clearOrphanedData()
 
 		case <-datapipeLoopTimer.C:
+			// Graph data must only be written by the leader, which may have lost the leader lock since the last cycle
+			if !s.verifyLeadership() {
+				return
+			}
+
 			if s.db.HasCollectedGraphDataDeletionRequest(s.ctx) {
 				s.deleteData()
 			}
 
+			// Roll back the graph data of ingest jobs whose rollback was requested
+			s.rollbackIngestJobs()
+
 			// Ingest all available ingest tasks
 			s.ingestAvailableTasks()
 
@@ -164,6 +316,10 @@ func (s *Daemon) Start(ctx context.Context) {
 			if hasJobsWaitingForAnalysis, err := HasIngestJobsWaitingForAnalysis(s.ctx, s.db); err != nil {
 				slog.ErrorContext(ctx, fmt.Sprintf("Failed looking up jobs waiting for analysis: %v", err))
 			} else if hasJobsWaitingForAnalysis || s.db.HasAnalysisRequest(s.ctx) {
+				if !s.verifyLeadership() {
+					return
+				}
+
 				s.analyze()
 			}
 
@@ -175,6 +331,17 @@ func (s *Daemon) Start(ctx context.Context) {
 	}
 }
 
+// verifyLeadership checks that this replica still holds the leader lock. Losing the lock cancels the context of the
+// daemon.
+func (s *Daemon) verifyLeadership() bool {
+	if err := daemons.VerifyLeadership(s.ctx); err != nil {
+		slog.ErrorContext(s.ctx, fmt.Sprintf("Datapipe stopping: %v", err))
+		return false
+	}
+
+	return true
+}
+
 func (s *Daemon) deleteData() {
 	defer func() {
 		_ = s.db.SetDatapipeStatus(s.ctx, model.DatapipeStatusIdle, false)
@@ -199,18 +366,35 @@ func (s *Daemon) deleteData() {
 	}
 }
 
+// Stop waits for the datapipe loop to exit once the context the daemon was created with is canceled. Work in flight,
+// such as a post-processing step, is allowed to finish so that the graph is left consistent for the next leader.
 func (s *Daemon) Stop(ctx context.Context) error {
-	return nil
+	select {
+	case <-s.doneC:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
+// clearOrphanedData removes files from the ingest store that belong to neither a queued ingest task nor an upload still
+// receiving chunks. Uploads abandoned by their file upload job are deleted first so that their chunks are swept.
 func (s *Daemon) clearOrphanedData() {
-	if ingestTasks, err := s.db.GetAllIngestTasks(s.ctx); err != nil {
+	if err := s.db.DeleteAbandonedIngestUploads(s.ctx); err != nil {
+		slog.ErrorContext(s.ctx, fmt.Sprintf("Failed deleting abandoned ingest uploads: %v", err))
+	} else if ingestTasks, err := s.db.GetAllIngestTasks(s.ctx); err != nil {
 		slog.ErrorContext(s.ctx, fmt.Sprintf("Failed fetching available ingest tasks: %v", err))
+	} else if uploadChunks, err := s.db.GetAllIngestUploadChunks(s.ctx); err != nil {
+		slog.ErrorContext(s.ctx, fmt.Sprintf("Failed fetching ingest upload chunks: %v", err))
 	} else {
-		expectedFiles := make([]string, len(ingestTasks))
+		expectedFiles := make([]string, 0, len(ingestTasks)+len(uploadChunks))
+
+		for _, ingestTask := range ingestTasks {
+			expectedFiles = append(expectedFiles, ingestTask.FileName)
+		}
 
-		for idx, ingestTask := range ingestTasks {
-			expectedFiles[idx] = ingestTask.FileName
+		for _, uploadChunk := range uploadChunks {
+			expectedFiles = append(expectedFiles, uploadChunk.FileName)
 		}
 
 		go s.orphanedFileSweeper.Clear(s.ctx, expectedFiles)
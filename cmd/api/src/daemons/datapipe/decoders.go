@@ -25,6 +25,8 @@ import (
 	"github.com/specterops/bloodhound/dawgs/graph"
 	"github.com/specterops/bloodhound/dawgs/util"
 	"github.com/specterops/bloodhound/ein"
+	"github.com/specterops/bloodhound/src/metrics"
+	"github.com/specterops/bloodhound/src/model/ingest"
 )
 
 /*
@@ -34,7 +36,31 @@ T is any of the ingest types
 */
 type ConversionFunc[T any] func(decoded T, converted *ConvertedData)
 
-func decodeBasicData[T any](batch graph.Batch, reader io.ReadSeeker, conversionFunc ConversionFunc[T]) error {
+// DecodeProgressFunc is called with the number of objects decoded since it was last called each time a batch of
+// decoded objects is written to the graph.
+type DecodeProgressFunc func(decoded int)
+
+// IngestWriteFunc writes a chunk of converted ingest data to the graph.
+type IngestWriteFunc func(batch graph.Batch) error
+
+// IngestSink receives the chunks of converted data of an ingest file in the order they were decoded, along with the
+// number of objects each chunk was decoded from. The decoders hand off each chunk and start a new one, so a sink may
+// hold on to a chunk and write it later.
+type IngestSink func(decoded int, write IngestWriteFunc) error
+
+// NewBatchSink returns an IngestSink that writes each chunk to the given batch as soon as it is decoded.
+func NewBatchSink(batch graph.Batch, progress DecodeProgressFunc) IngestSink {
+	if progress == nil {
+		progress = func(int) {}
+	}
+
+	return func(decoded int, write IngestWriteFunc) error {
+		progress(decoded)
+		return write(batch)
+	}
+}
+
+func decodeBasicData[T any](reader io.ReadSeeker, dataType ingest.DataType, conversionFunc ConversionFunc[T], sink IngestSink) error {
 	decoder, err := CreateIngestDecoder(reader)
 	if err != nil {
 		return err
@@ -51,27 +77,29 @@ func decodeBasicData[T any](batch graph.Batch, reader io.ReadSeeker, conversionF
 		var decodeTarget T
 		if err := decoder.Decode(&decodeTarget); err != nil {
 			slog.Error(fmt.Sprintf("Error decoding %T object: %v", decodeTarget, err))
+			metrics.IngestDecodeErrors.WithLabelValues(string(dataType)).Inc()
 			if errors.Is(err, io.EOF) {
 				break
 			}
 			return err
 		} else {
+			metrics.IngestObjectsProcessed.WithLabelValues(string(dataType)).Inc()
 			count++
 			conversionFunc(decodeTarget, &convertedData)
 		}
 
 		if count == IngestCountThreshold {
-			if err = IngestBasicData(batch, convertedData); err != nil {
+			if err = sink(count, convertedData.Write); err != nil {
 				errs.Add(err)
 			}
-			convertedData.Clear()
-			count = 0
 
+			convertedData = ConvertedData{}
+			count = 0
 		}
 	}
 
 	if count > 0 {
-		if err = IngestBasicData(batch, convertedData); err != nil {
+		if err = sink(count, convertedData.Write); err != nil {
 			errs.Add(err)
 		}
 	}
@@ -79,7 +107,7 @@ func decodeBasicData[T any](batch graph.Batch, reader io.ReadSeeker, conversionF
 	return errs.Combined()
 }
 
-func decodeGroupData(batch graph.Batch, reader io.ReadSeeker) error {
+func decodeGroupData(reader io.ReadSeeker, dataType ingest.DataType, sink IngestSink) error {
 	decoder, err := CreateIngestDecoder(reader)
 	if err != nil {
 		return err
@@ -95,26 +123,28 @@ func decodeGroupData(batch graph.Batch, reader io.ReadSeeker) error {
 		var group ein.Group
 		if err = decoder.Decode(&group); err != nil {
 			slog.Error(fmt.Sprintf("Error decoding group object: %v", err))
+			metrics.IngestDecodeErrors.WithLabelValues(string(dataType)).Inc()
 			if errors.Is(err, io.EOF) {
 				break
 			}
 			return err
 		} else {
+			metrics.IngestObjectsProcessed.WithLabelValues(string(dataType)).Inc()
 			count++
 			convertGroupData(group, &convertedData)
 			if count == IngestCountThreshold {
-				if err = IngestGroupData(batch, convertedData); err != nil {
+				if err = sink(count, convertedData.Write); err != nil {
 					errs.Add(err)
 				}
 
-				convertedData.Clear()
+				convertedData = ConvertedGroupData{}
 				count = 0
 			}
 		}
 	}
 
 	if count > 0 {
-		if err = IngestGroupData(batch, convertedData); err != nil {
+		if err = sink(count, convertedData.Write); err != nil {
 			errs.Add(err)
 		}
 	}
@@ -122,7 +152,7 @@ func decodeGroupData(batch graph.Batch, reader io.ReadSeeker) error {
 	return errs.Combined()
 }
 
-func decodeSessionData(batch graph.Batch, reader io.ReadSeeker) error {
+func decodeSessionData(reader io.ReadSeeker, dataType ingest.DataType, sink IngestSink) error {
 	decoder, err := CreateIngestDecoder(reader)
 	if err != nil {
 		return err
@@ -137,25 +167,28 @@ func decodeSessionData(batch graph.Batch, reader io.ReadSeeker) error {
 		var session ein.Session
 		if err = decoder.Decode(&session); err != nil {
 			slog.Error(fmt.Sprintf("Error decoding session object: %v", err))
+			metrics.IngestDecodeErrors.WithLabelValues(string(dataType)).Inc()
 			if errors.Is(err, io.EOF) {
 				break
 			}
 			return err
 		} else {
+			metrics.IngestObjectsProcessed.WithLabelValues(string(dataType)).Inc()
 			count++
 			convertSessionData(session, &convertedData)
 			if count == IngestCountThreshold {
-				if err = IngestSessions(batch, convertedData.SessionProps); err != nil {
+				if err = sink(count, convertedData.Write); err != nil {
 					errs.Add(err)
 				}
-				convertedData.Clear()
+
+				convertedData = ConvertedSessionData{}
 				count = 0
 			}
 		}
 	}
 
 	if count > 0 {
-		if err = IngestSessions(batch, convertedData.SessionProps); err != nil {
+		if err = sink(count, convertedData.Write); err != nil {
 			errs.Add(err)
 		}
 	}
@@ -163,7 +196,7 @@ func decodeSessionData(batch graph.Batch, reader io.ReadSeeker) error {
 	return errs.Combined()
 }
 
-func decodeAzureData(batch graph.Batch, reader io.ReadSeeker) error {
+func decodeAzureData(reader io.ReadSeeker, dataType ingest.DataType, sink IngestSink) error {
 	decoder, err := CreateIngestDecoder(reader)
 	if err != nil {
 		return err
@@ -179,26 +212,29 @@ func decodeAzureData(batch graph.Batch, reader io.ReadSeeker) error {
 		var data AzureBase
 		if err = decoder.Decode(&data); err != nil {
 			slog.Error(fmt.Sprintf("Error decoding azure object: %v", err))
+			metrics.IngestDecodeErrors.WithLabelValues(string(dataType)).Inc()
 			if errors.Is(err, io.EOF) {
 				break
 			}
 			return err
 		} else {
+			metrics.IngestObjectsProcessed.WithLabelValues(string(dataType)).Inc()
 			convert := getKindConverter(data.Kind)
 			convert(data.Data, &convertedData)
 			count++
 			if count == IngestCountThreshold {
-				if err = IngestAzureData(batch, convertedData); err != nil {
+				if err = sink(count, convertedData.Write); err != nil {
 					errs.Add(err)
 				}
-				convertedData.Clear()
+
+				convertedData = ConvertedAzureData{}
 				count = 0
 			}
 		}
 	}
 
 	if count > 0 {
-		if err = IngestAzureData(batch, convertedData); err != nil {
+		if err = sink(count, convertedData.Write); err != nil {
 			errs.Add(err)
 		}
 	}
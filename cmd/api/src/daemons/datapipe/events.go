@@ -0,0 +1,195 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package datapipe
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/specterops/bloodhound/src/events"
+	"github.com/specterops/bloodhound/src/model"
+)
+
+const (
+	// ingestProgressInterval limits how often object counts are published for a file that is being ingested
+	ingestProgressInterval = 250 * time.Millisecond
+
+	// graphAnalysisSteps is the number of steps run by runGraphAnalysisOperations against the default graph
+	graphAnalysisSteps = 10
+
+	// workspaceGraphAnalysisSteps is the number of steps run by runGraphAnalysisOperations against a workspace graph,
+	// which skips the steps driven by the application state of the default graph
+	workspaceGraphAnalysisSteps = graphAnalysisSteps - 3
+
+	// analysisSteps is the number of steps run by RunAnalysisOperations
+	analysisSteps = graphAnalysisSteps + 3
+)
+
+// ingestProgress publishes the progress of a single ingest task to the event bus.
+type ingestProgress struct {
+	bus           *events.Bus
+	data          events.IngestProgress
+	lastPublished time.Time
+}
+
+func newIngestProgress(bus *events.Bus, ingestTask model.IngestTask, workspaceID int32) *ingestProgress {
+	return &ingestProgress{
+		bus: bus,
+		data: events.IngestProgress{
+			TaskID:      ingestTask.ID,
+			JobID:       ingestTask.TaskID.ValueOrZero(),
+			WorkspaceID: workspaceID,
+		},
+	}
+}
+
+func (s *ingestProgress) publish(eventType events.Type) {
+	s.lastPublished = time.Now()
+	s.bus.Publish(eventType, s.data)
+}
+
+func (s *ingestProgress) startFile(name string, index, count int) {
+	s.data.File = name
+	s.data.FileIndex = index
+	s.data.FileCount = count
+
+	s.publish(events.TypeIngestFileStarted)
+}
+
+// decoded is the DecodeProgressFunc of the ingest task.
+func (s *ingestProgress) decoded(count int) {
+	s.data.ObjectsDecoded += int64(count)
+
+	if time.Since(s.lastPublished) >= ingestProgressInterval {
+		s.publish(events.TypeIngestProgress)
+	}
+}
+
+func (s *ingestProgress) complete(failedFiles int) {
+	s.data.FailedFiles = failedFiles
+	s.publish(events.TypeIngestTaskCompleted)
+}
+
+func (s *ingestProgress) fail(err error) {
+	s.data.Error = err.Error()
+
+	if errors.Is(err, ErrIngestCanceled) {
+		s.publish(events.TypeIngestTaskCanceled)
+	} else {
+		s.publish(events.TypeIngestTaskFailed)
+	}
+}
+
+// analysisProgress publishes the progress of an analysis run to the event bus as each analysis step starts. A single
+// run covers the default graph and the graph of every workspace.
+type analysisProgress struct {
+	bus            *events.Bus
+	workspaceID    int32
+	workspaceIDs   []int32
+	completedSteps int
+	totalSteps     int
+}
+
+type analysisProgressKey struct{}
+
+// newAnalysisProgress creates the progress of a run that analyzes the default graph followed by the graph of each of
+// the given workspaces.
+func newAnalysisProgress(bus *events.Bus, workspaces model.Workspaces) *analysisProgress {
+	workspaceIDs := []int32{0}
+	for _, nextWorkspace := range workspaces {
+		workspaceIDs = append(workspaceIDs, nextWorkspace.ID)
+	}
+
+	return &analysisProgress{
+		bus:          bus,
+		workspaceIDs: workspaceIDs,
+		totalSteps:   analysisSteps + len(workspaces)*workspaceGraphAnalysisSteps,
+	}
+}
+
+// withAnalysisProgress returns a context that reports the analysis steps run with it to the given progress.
+func withAnalysisProgress(ctx context.Context, progress *analysisProgress) context.Context {
+	return context.WithValue(ctx, analysisProgressKey{}, progress)
+}
+
+func analysisProgressFrom(ctx context.Context) (*analysisProgress, bool) {
+	progress, hasProgress := ctx.Value(analysisProgressKey{}).(*analysisProgress)
+	return progress, hasProgress
+}
+
+func (s *analysisProgress) data(step string) events.AnalysisProgress {
+	// Clamp in case the steps run outnumber those counted
+	percentComplete := 100.0
+	if s.completedSteps < s.totalSteps {
+		percentComplete = float64(s.completedSteps) / float64(s.totalSteps) * 100
+	}
+
+	return events.AnalysisProgress{
+		Step:            step,
+		WorkspaceID:     s.workspaceID,
+		CompletedSteps:  s.completedSteps,
+		TotalSteps:      s.totalSteps,
+		PercentComplete: percentComplete,
+	}
+}
+
+// runData returns the data of events that describe the whole run, which are delivered to subscribers of every
+// workspace the run analyzes.
+func (s *analysisProgress) runData() events.AnalysisProgress {
+	data := s.data("")
+	data.WorkspaceID = 0
+	data.WorkspaceIDs = s.workspaceIDs
+
+	return data
+}
+
+func (s *analysisProgress) started() {
+	s.bus.Publish(events.TypeAnalysisStarted, s.runData())
+}
+
+// startWorkspace attributes the steps that follow to the given workspace. A workspace ID of zero is the default graph.
+func (s *analysisProgress) startWorkspace(workspaceID int32) {
+	s.workspaceID = workspaceID
+}
+
+func (s *analysisProgress) startStep(step string) {
+	s.bus.Publish(events.TypeAnalysisProgress, s.data(step))
+}
+
+func (s *analysisProgress) completeStep() {
+	s.completedSteps++
+}
+
+// finished publishes the outcome of the analysis run. Runs that partially completed are published as completed along
+// with the error describing what failed.
+func (s *analysisProgress) finished(err error) {
+	s.completedSteps = s.totalSteps
+
+	data := s.runData()
+	if err != nil {
+		data.Error = err.Error()
+	}
+
+	if errors.Is(err, ErrAnalysisCanceled) {
+		s.bus.Publish(events.TypeAnalysisCanceled, data)
+	} else if errors.Is(err, ErrAnalysisFailed) {
+		s.bus.Publish(events.TypeAnalysisFailed, data)
+	} else {
+		s.bus.Publish(events.TypeAnalysisCompleted, data)
+	}
+}
@@ -0,0 +1,516 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package datapipe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/specterops/bloodhound/dawgs/graph"
+	"github.com/specterops/bloodhound/dawgs/query"
+	"github.com/specterops/bloodhound/dawgs/util"
+	"github.com/specterops/bloodhound/graphschema"
+	"github.com/specterops/bloodhound/graphschema/ad"
+	"github.com/specterops/bloodhound/graphschema/azure"
+	"github.com/specterops/bloodhound/graphschema/common"
+	"github.com/specterops/bloodhound/src/database"
+	"github.com/specterops/bloodhound/src/metrics"
+	"github.com/specterops/bloodhound/src/model"
+	"github.com/specterops/bloodhound/src/model/ingest"
+)
+
+var (
+	ErrGenericIngestUnsupported = errors.New("generic ingest is not supported by this reader")
+	ErrGenericEndpointNotFound  = errors.New("no node matches generic edge endpoint")
+
+	genericKindPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+)
+
+// GenericKindRegistrar registers the kinds declared by a generic ingest file before its data is written to the graph.
+type GenericKindRegistrar func(kindSchema ingest.GenericKindSchema) error
+
+// NewGenericKindRegistrar returns a GenericKindRegistrar that registers kinds with RegisterGenericKinds. Calls are
+// serialized so that files decoded concurrently are validated against each other's kinds.
+func NewGenericKindRegistrar(ctx context.Context, db database.Database, graphDB graph.Database) GenericKindRegistrar {
+	lock := &sync.Mutex{}
+
+	return func(kindSchema ingest.GenericKindSchema) error {
+		lock.Lock()
+		defer lock.Unlock()
+
+		return RegisterGenericKinds(ctx, db, graphDB, kindSchema)
+	}
+}
+
+// GenericGraphSchema returns the default graph schema extended with the given generic kinds.
+func GenericGraphSchema(kinds model.GenericKinds) graph.Schema {
+	var (
+		dbSchema     = graphschema.DefaultGraphSchema()
+		defaultGraph = dbSchema.DefaultGraph
+	)
+
+	defaultGraph.Nodes = defaultGraph.Nodes.Concatenate(kinds.NodeKinds())
+	defaultGraph.Edges = defaultGraph.Edges.Concatenate(kinds.EdgeKinds())
+
+	dbSchema.DefaultGraph = defaultGraph
+	dbSchema.Graphs = []graph.Graph{defaultGraph}
+
+	return dbSchema
+}
+
+// RegisterGenericKinds validates the kind schema declared by a generic ingest file against the built-in kinds and the
+// generic kinds declared by earlier files. Kinds not seen before are asserted into the graph schema and then recorded.
+func RegisterGenericKinds(ctx context.Context, db database.Database, graphDB graph.Database, kindSchema ingest.GenericKindSchema) error {
+	if registered, err := db.GetGenericKinds(ctx); err != nil {
+		return fmt.Errorf("error fetching generic kinds: %w", err)
+	} else if declared, err := newGenericKinds(kindSchema, registered); err != nil {
+		return err
+	} else if len(declared) == 0 {
+		return nil
+	} else if err := graphDB.AssertSchema(ctx, GenericGraphSchema(append(registered, declared...))); err != nil {
+		return fmt.Errorf("error asserting generic kinds: %w", err)
+	} else if err := db.CreateGenericKinds(ctx, declared); err != nil {
+		return fmt.Errorf("error recording generic kinds: %w", err)
+	} else {
+		slog.InfoContext(ctx, fmt.Sprintf("Registered %d new generic kinds", len(declared)))
+		return nil
+	}
+}
+
+// newGenericKinds validates the given kind schema and returns the kinds it declares that have not been registered yet.
+func newGenericKinds(kindSchema ingest.GenericKindSchema, registered model.GenericKinds) (model.GenericKinds, error) {
+	var (
+		builtin  = graphschema.DefaultGraph()
+		declared = map[string]bool{}
+		newKinds model.GenericKinds
+	)
+
+	for _, kind := range registered {
+		declared[kind.Name] = kind.IsEdge
+	}
+
+	for _, next := range []struct {
+		names  []string
+		isEdge bool
+	}{{kindSchema.Nodes, false}, {kindSchema.Edges, true}} {
+		for _, name := range next.names {
+			if !genericKindPattern.MatchString(name) {
+				return nil, fmt.Errorf("%w: kind names must start with a letter and contain only letters, digits and underscores: %q", ingest.ErrInvalidGenericKind, name)
+			} else if builtin.Nodes.ContainsOneOf(graph.StringKind(name)) || builtin.Edges.ContainsOneOf(graph.StringKind(name)) {
+				return nil, fmt.Errorf("%w: %s is a built-in kind", ingest.ErrInvalidGenericKind, name)
+			} else if isEdge, found := declared[name]; found && isEdge != next.isEdge {
+				return nil, fmt.Errorf("%w: %s is declared as both a node and an edge kind", ingest.ErrInvalidGenericKind, name)
+			} else if !found {
+				declared[name] = next.isEdge
+				newKinds = append(newKinds, model.GenericKind{
+					Name:   name,
+					IsEdge: next.isEdge,
+				})
+			}
+		}
+	}
+
+	return newKinds, nil
+}
+
+func validateGenericProperties(properties map[string]any) error {
+	for key, value := range properties {
+		switch typed := value.(type) {
+		case nil, bool, float64, string:
+		case []any:
+			for _, element := range typed {
+				switch element.(type) {
+				case nil, bool, float64, string:
+				default:
+					return fmt.Errorf("property %s must be a list of primitive values", key)
+				}
+			}
+		default:
+			return fmt.Errorf("property %s must be a primitive value or a list of primitive values", key)
+		}
+	}
+
+	return nil
+}
+
+func validateGenericNode(kindSchema ingest.GenericKindSchema, node ingest.GenericNode) error {
+	if node.ID == "" {
+		return fmt.Errorf("%w: node is missing an id", ingest.ErrInvalidGenericData)
+	} else if len(node.Kinds) == 0 {
+		return fmt.Errorf("%w: node %s has no kinds", ingest.ErrInvalidGenericData, node.ID)
+	} else if err := validateGenericProperties(node.Properties); err != nil {
+		return fmt.Errorf("%w: node %s: %w", ingest.ErrInvalidGenericData, node.ID, err)
+	}
+
+	for _, kind := range node.Kinds {
+		if !slices.Contains(kindSchema.Nodes, kind) {
+			return fmt.Errorf("%w: node %s has undeclared kind %s", ingest.ErrInvalidGenericData, node.ID, kind)
+		}
+	}
+
+	return nil
+}
+
+func validateGenericEdgeEndpoint(kindSchema ingest.GenericKindSchema, endpoint ingest.GenericEdgeEndpoint) error {
+	switch endpoint.MatchBy {
+	case "", ingest.GenericMatchByID:
+	case ingest.GenericMatchByProperty:
+		if endpoint.Property == "" {
+			return errors.New("endpoint matched by property is missing a property name")
+		}
+	default:
+		return fmt.Errorf("endpoint has unknown match_by %q", endpoint.MatchBy)
+	}
+
+	if endpoint.Value == "" {
+		return errors.New("endpoint is missing a value")
+	} else if endpoint.Kind != "" && !slices.Contains(kindSchema.Nodes, endpoint.Kind) && genericEndpointIdentityKind(graph.StringKind(endpoint.Kind)) == common.GenericEntity {
+		return fmt.Errorf("endpoint has undeclared kind %s", endpoint.Kind)
+	}
+
+	return nil
+}
+
+func validateGenericEdge(kindSchema ingest.GenericKindSchema, edge ingest.GenericEdge) error {
+	if !slices.Contains(kindSchema.Edges, edge.Kind) {
+		return fmt.Errorf("%w: edge has undeclared kind %q", ingest.ErrInvalidGenericData, edge.Kind)
+	} else if err := validateGenericEdgeEndpoint(kindSchema, edge.Start); err != nil {
+		return fmt.Errorf("%w: %s edge start: %w", ingest.ErrInvalidGenericData, edge.Kind, err)
+	} else if err := validateGenericEdgeEndpoint(kindSchema, edge.End); err != nil {
+		return fmt.Errorf("%w: %s edge end: %w", ingest.ErrInvalidGenericData, edge.Kind, err)
+	} else if err := validateGenericProperties(edge.Properties); err != nil {
+		return fmt.Errorf("%w: %s edge: %w", ingest.ErrInvalidGenericData, edge.Kind, err)
+	}
+
+	return nil
+}
+
+// genericEndpointIdentityKind returns the base kind of the nodes an edge endpoint of the given kind may match. Nodes
+// of the built-in kinds are identified within their own base kind and all other nodes within the generic base kind.
+func genericEndpointIdentityKind(kind graph.Kind) graph.Kind {
+	if graph.Kinds(ad.NodeKinds()).ContainsOneOf(kind) {
+		return ad.Entity
+	} else if graph.Kinds(azure.NodeKinds()).ContainsOneOf(kind) {
+		return azure.Entity
+	}
+
+	return common.GenericEntity
+}
+
+// genericIdentityValue returns the given value of an identity property with the upper casing applied to the property
+// when nodes are written.
+func genericIdentityValue(property string, value string) string {
+	switch property {
+	case common.ObjectID.String(), common.Name.String(), common.OperatingSystem.String(), ad.DistinguishedName.String():
+		return strings.ToUpper(value)
+	default:
+		return value
+	}
+}
+
+// genericEndpointIdentity returns the property an edge endpoint is matched by along with the value to match.
+func genericEndpointIdentity(endpoint ingest.GenericEdgeEndpoint) (string, string) {
+	property := common.ObjectID.String()
+
+	if endpoint.MatchBy == ingest.GenericMatchByProperty {
+		property = endpoint.Property
+	}
+
+	return property, genericIdentityValue(property, endpoint.Value)
+}
+
+// genericNodeKey identifies the nodes with the given value of a property, optionally restricted to nodes of a kind.
+type genericNodeKey struct {
+	property string
+	value    string
+	kind     string
+}
+
+// genericNodeIndex records the nodes that edge endpoints matched by property have been matched to. Nodes of the file
+// being ingested are indexed as they are decoded, as the batch they are written with may not have written them yet
+// when their edges are, and only the properties that edges of the file match by are indexed.
+type genericNodeIndex struct {
+	properties map[string]struct{}
+	nodes      map[genericNodeKey]struct{}
+}
+
+func newGenericNodeIndex() *genericNodeIndex {
+	return &genericNodeIndex{
+		properties: map[string]struct{}{},
+		nodes:      map[genericNodeKey]struct{}{},
+	}
+}
+
+// addEndpoints records the properties the given edges match their endpoints by. Nodes decoded before a property was
+// first matched by are not indexed by it.
+func (s *genericNodeIndex) addEndpoints(edges []ingest.GenericEdge) {
+	for _, edge := range edges {
+		for _, endpoint := range []ingest.GenericEdgeEndpoint{edge.Start, edge.End} {
+			if endpoint.MatchBy == ingest.GenericMatchByProperty && endpoint.Property != "" {
+				s.properties[endpoint.Property] = struct{}{}
+			}
+		}
+	}
+}
+
+func (s *genericNodeIndex) addNode(node ingest.GenericNode) {
+	for property := range s.properties {
+		var value string
+
+		if property == common.ObjectID.String() {
+			value = node.ID
+		} else if typed, isString := node.Properties[property].(string); isString {
+			value = typed
+		} else {
+			continue
+		}
+
+		key := genericNodeKey{property: property, value: genericIdentityValue(property, value)}
+		s.nodes[key] = struct{}{}
+
+		for _, kind := range node.Kinds {
+			key.kind = kind
+			s.nodes[key] = struct{}{}
+		}
+	}
+}
+
+// matches returns true if a node of the file or of the graph matches the given endpoint matched by property. Nodes
+// found in the graph are indexed so that the graph is only queried once for each endpoint.
+func (s *genericNodeIndex) matches(batch graph.Batch, endpoint ingest.GenericEdgeEndpoint) (bool, error) {
+	var (
+		property, value = genericEndpointIdentity(endpoint)
+		key             = genericNodeKey{property: property, value: value, kind: endpoint.Kind}
+		criteria        = []graph.Criteria{
+			query.Equals(query.NodeProperty(property), value),
+		}
+	)
+
+	if _, found := s.nodes[key]; found {
+		return true, nil
+	}
+
+	if endpoint.Kind != "" {
+		kind := graph.StringKind(endpoint.Kind)
+		criteria = append(criteria, query.Kind(query.Node(), genericEndpointIdentityKind(kind)), query.Kind(query.Node(), kind))
+	} else {
+		criteria = append(criteria, query.Kind(query.Node(), common.GenericEntity))
+	}
+
+	if count, err := batch.Nodes().Filter(query.And(criteria...)).Count(); err != nil {
+		return false, err
+	} else if count == 0 {
+		return false, nil
+	}
+
+	s.nodes[key] = struct{}{}
+	return true, nil
+}
+
+// genericEdgeEndpoint prepares the node of an edge endpoint. Endpoints matched by object ID create a placeholder node
+// with that object ID when no node has it yet.
+func genericEdgeEndpoint(endpoint ingest.GenericEdgeEndpoint, nowUTC time.Time) (*graph.Node, graph.Kind, string) {
+	var (
+		identityKind            = common.GenericEntity
+		identityProperty, value = genericEndpointIdentity(endpoint)
+		kinds                   graph.Kinds
+	)
+
+	if endpoint.Kind != "" {
+		kind := graph.StringKind(endpoint.Kind)
+
+		kinds = graph.Kinds{kind}
+		identityKind = genericEndpointIdentityKind(kind)
+	}
+
+	return graph.PrepareNode(graph.AsProperties(map[string]any{
+		identityProperty:         value,
+		common.LastSeen.String(): nowUTC,
+	}), kinds...), identityKind, identityProperty
+}
+
+func ingestGenericNode(batch graph.Batch, nowUTC time.Time, node ingest.GenericNode) error {
+	if node.Properties == nil {
+		node.Properties = map[string]any{}
+	}
+
+	normalizedProperties := NormalizeEinNodeProperties(node.Properties, node.ID, nowUTC)
+
+	return batch.UpdateNodeBy(graph.NodeUpdate{
+		Node:         graph.PrepareNode(graph.AsProperties(normalizedProperties), graph.StringsToKinds(node.Kinds)...),
+		IdentityKind: common.GenericEntity,
+		IdentityProperties: []string{
+			common.ObjectID.String(),
+		},
+	})
+}
+
+// ingestGenericEdge writes the given edge. Edges with an endpoint matched by property are skipped unless a node
+// matches the endpoint, as writing them would create a placeholder node without an object ID.
+func ingestGenericEdge(batch graph.Batch, nowUTC time.Time, index *genericNodeIndex, edge ingest.GenericEdge) error {
+	for _, endpoint := range []ingest.GenericEdgeEndpoint{edge.Start, edge.End} {
+		if endpoint.MatchBy != ingest.GenericMatchByProperty {
+			continue
+		} else if matched, err := index.matches(batch, endpoint); err != nil {
+			return err
+		} else if !matched {
+			return fmt.Errorf("%w: %s %s", ErrGenericEndpointNotFound, endpoint.Property, endpoint.Value)
+		}
+	}
+
+	if edge.Properties == nil {
+		edge.Properties = map[string]any{}
+	}
+
+	edge.Properties[common.LastSeen.String()] = nowUTC
+
+	var (
+		start, startIdentityKind, startIdentityProperty = genericEdgeEndpoint(edge.Start, nowUTC)
+		end, endIdentityKind, endIdentityProperty       = genericEdgeEndpoint(edge.End, nowUTC)
+	)
+
+	return batch.UpdateRelationshipBy(graph.RelationshipUpdate{
+		Relationship: graph.PrepareRelationship(graph.AsProperties(edge.Properties), graph.StringKind(edge.Kind)),
+
+		Start:             start,
+		StartIdentityKind: startIdentityKind,
+		StartIdentityProperties: []string{
+			startIdentityProperty,
+		},
+
+		End:             end,
+		EndIdentityKind: endIdentityKind,
+		EndIdentityProperties: []string{
+			endIdentityProperty,
+		},
+	})
+}
+
+func IngestGenericData(batch graph.Batch, converted ConvertedGenericData) error {
+	var (
+		nowUTC = time.Now().UTC()
+		errs   = util.NewErrorCollector()
+		index  = converted.index
+	)
+
+	if index == nil {
+		index = newGenericNodeIndex()
+		index.addEndpoints(converted.Edges)
+
+		for _, next := range converted.Nodes {
+			index.addNode(next)
+		}
+	}
+
+	for _, next := range converted.Nodes {
+		if err := ingestGenericNode(batch, nowUTC, next); err != nil {
+			slog.Error(fmt.Sprintf("Error ingesting generic node ID %s: %v", next.ID, err))
+			errs.Add(err)
+		}
+	}
+
+	for _, next := range converted.Edges {
+		if err := ingestGenericEdge(batch, nowUTC, index, next); err != nil {
+			slog.Error(fmt.Sprintf("Error ingesting generic %s edge from %s to %s: %v", next.Kind, next.Start.Value, next.End.Value, err))
+			errs.Add(err)
+		}
+	}
+
+	return errs.Combined()
+}
+
+func decodeGenericData(reader io.ReadSeeker, meta ingest.Metadata, registerKinds GenericKindRegistrar, sink IngestSink) error {
+	if registerKinds == nil {
+		return ErrGenericIngestUnsupported
+	} else if err := registerKinds(meta.Kinds); err != nil {
+		return err
+	}
+
+	decoder, err := CreateIngestDecoder(reader)
+	if err != nil {
+		return err
+	}
+
+	var (
+		index         = newGenericNodeIndex()
+		convertedData = ConvertedGenericData{index: index}
+		count         = 0
+		errs          = util.NewErrorCollector()
+	)
+
+	for decoder.More() {
+		var data ingest.GenericGraph
+		if err = decoder.Decode(&data); err != nil {
+			slog.Error(fmt.Sprintf("Error decoding generic object: %v", err))
+			metrics.IngestDecodeErrors.WithLabelValues(string(meta.Type)).Inc()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return err
+		}
+
+		index.addEndpoints(data.Edges)
+
+		for _, node := range data.Nodes {
+			metrics.IngestObjectsProcessed.WithLabelValues(string(meta.Type)).Inc()
+			count++
+
+			if err := validateGenericNode(meta.Kinds, node); err != nil {
+				errs.Add(err)
+			} else {
+				index.addNode(node)
+				convertedData.Nodes = append(convertedData.Nodes, node)
+			}
+		}
+
+		for _, edge := range data.Edges {
+			metrics.IngestObjectsProcessed.WithLabelValues(string(meta.Type)).Inc()
+			count++
+
+			if err := validateGenericEdge(meta.Kinds, edge); err != nil {
+				errs.Add(err)
+			} else {
+				convertedData.Edges = append(convertedData.Edges, edge)
+			}
+		}
+
+		if count >= IngestCountThreshold {
+			if err = sink(count, convertedData.Write); err != nil {
+				errs.Add(err)
+			}
+
+			convertedData = ConvertedGenericData{index: index}
+			count = 0
+		}
+	}
+
+	if count > 0 {
+		if err = sink(count, convertedData.Write); err != nil {
+			errs.Add(err)
+		}
+	}
+
+	return errs.Combined()
+}
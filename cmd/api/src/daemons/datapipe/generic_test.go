@@ -0,0 +1,265 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package datapipe_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/specterops/bloodhound/cache"
+	"github.com/specterops/bloodhound/dawgs"
+	"github.com/specterops/bloodhound/dawgs/drivers/memory"
+	"github.com/specterops/bloodhound/dawgs/graph"
+	"github.com/specterops/bloodhound/dawgs/ops"
+	"github.com/specterops/bloodhound/dawgs/query"
+	"github.com/specterops/bloodhound/graphschema/ad"
+	"github.com/specterops/bloodhound/graphschema/common"
+	"github.com/specterops/bloodhound/src/config"
+	"github.com/specterops/bloodhound/src/daemons/datapipe"
+	dbmocks "github.com/specterops/bloodhound/src/database/mocks"
+	"github.com/specterops/bloodhound/src/model"
+	"github.com/specterops/bloodhound/src/model/ingest"
+	"github.com/specterops/bloodhound/src/queries"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const genericIngestContent = `{
+	"meta": {"type": "generic", "version": 1, "kinds": {"nodes": ["GHUser", "GHTeam", "GHRepository"], "edges": ["GHMemberOf", "GHCanPush", "GHHasIdentity"]}},
+	"data": [
+		{
+			"nodes": [
+				{"id": "gh-user-1", "kinds": ["GHUser"], "properties": {"name": "alice", "admin": false, "emails": ["alice@example.com"]}},
+				{"id": "gh-team-1", "kinds": ["GHTeam"], "properties": {"name": "platform"}},
+				{"id": "gh-repo-1", "kinds": ["GHRepository"], "properties": {"name": "api"}},
+				{"id": "gh-bad-1", "kinds": ["GHUndeclared"]}
+			],
+			"edges": [
+				{"kind": "GHMemberOf", "start": {"value": "gh-user-1"}, "end": {"match_by": "id", "value": "gh-team-1"}},
+				{"kind": "GHCanPush", "start": {"value": "gh-team-1"}, "end": {"match_by": "property", "property": "name", "value": "api", "kind": "GHRepository"}, "properties": {"branch": "main"}},
+				{"kind": "GHHasIdentity", "start": {"value": "gh-user-1"}, "end": {"value": "S-1-5-21-1-1001", "kind": "User"}},
+				{"kind": "MemberOf", "start": {"value": "gh-user-1"}, "end": {"value": "gh-team-1"}}
+			]
+		}
+	]
+}`
+
+func TestReadFileForIngest_Generic(t *testing.T) {
+	var (
+		ctx        = context.Background()
+		mockCtrl   = gomock.NewController(t)
+		mockDB     = dbmocks.NewMockDatabase(mockCtrl)
+		graphDB, _ = dawgs.Open(ctx, memory.DriverName, dawgs.Config{})
+		decoded    = 0
+		recorded   model.GenericKinds
+	)
+
+	mockDB.EXPECT().GetGenericKinds(gomock.Any()).Return(model.GenericKinds{{Name: "GHUser"}}, nil)
+	mockDB.EXPECT().CreateGenericKinds(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, kinds model.GenericKinds) error {
+		recorded = kinds
+		return nil
+	})
+
+	require.Nil(t, graphDB.WriteTransaction(ctx, func(tx graph.Transaction) error {
+		_, err := tx.CreateNode(graph.AsProperties(map[string]any{common.ObjectID.String(): "S-1-5-21-1-1001"}), ad.Entity, ad.User)
+		return err
+	}))
+
+	err := graphDB.BatchOperation(ctx, func(batch graph.Batch) error {
+		return datapipe.ReadFileForIngest(batch, strings.NewReader(genericIngestContent), false, datapipe.NewGenericKindRegistrar(ctx, mockDB, graphDB), func(count int) {
+			decoded += count
+		})
+	})
+
+	// The entries that do not match the declared kind schema are reported and skipped
+	require.ErrorIs(t, err, ingest.ErrInvalidGenericData)
+	require.ErrorContains(t, err, "node gh-bad-1 has undeclared kind GHUndeclared")
+	require.ErrorContains(t, err, `edge has undeclared kind "MemberOf"`)
+	require.Equal(t, 8, decoded)
+
+	require.Equal(t, graph.Kinds{graph.StringKind("GHTeam"), graph.StringKind("GHRepository")}, recorded.NodeKinds())
+	require.Equal(t, graph.Kinds{graph.StringKind("GHMemberOf"), graph.StringKind("GHCanPush"), graph.StringKind("GHHasIdentity")}, recorded.EdgeKinds())
+
+	kinds, err := graphDB.FetchKinds(ctx)
+	require.Nil(t, err)
+	require.True(t, kinds.ContainsOneOf(graph.StringKind("GHRepository")))
+	require.True(t, kinds.ContainsOneOf(graph.StringKind("GHCanPush")))
+
+	require.Nil(t, graphDB.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		nodes, err := ops.FetchNodes(tx.Nodes().Filterf(func() graph.Criteria {
+			return query.Kind(query.Node(), common.GenericEntity)
+		}))
+		require.Nil(t, err)
+		require.Len(t, nodes, 3)
+
+		for _, node := range nodes {
+			objectID, _ := node.Properties.Get(common.ObjectID.String()).String()
+
+			switch objectID {
+			case "GH-USER-1":
+				require.True(t, node.Kinds.ContainsOneOf(graph.StringKind("GHUser")))
+
+				name, _ := node.Properties.Get(common.Name.String()).String()
+				require.Equal(t, "ALICE", name)
+			case "GH-TEAM-1", "GH-REPO-1":
+			default:
+				t.Fatalf("unexpected generic node %s", objectID)
+			}
+		}
+
+		count, err := tx.Relationships().Filterf(func() graph.Criteria {
+			return query.And(
+				query.Kind(query.Relationship(), graph.StringKind("GHHasIdentity")),
+				query.Kind(query.End(), ad.User),
+				query.Equals(query.EndProperty(common.ObjectID.String()), "S-1-5-21-1-1001"),
+			)
+		}).Count()
+		require.Nil(t, err)
+		require.Equal(t, int64(1), count)
+
+		return nil
+	}))
+
+	paths, err := queries.NewGraphQuery(graphDB, cache.Cache{}, config.Configuration{}).GetAllShortestPaths(ctx, "GH-USER-1", "GH-REPO-1", nil)
+	require.Nil(t, err)
+	require.Equal(t, 1, paths.Len())
+	require.Len(t, paths[0].Edges, 2)
+}
+
+const genericPropertyMatchContent = `{
+	"meta": {"type": "generic", "version": 1, "kinds": {"nodes": ["GHUser"], "edges": ["GHHasIdentity"]}},
+	"data": [
+		{
+			"nodes": [
+				{"id": "gh-user-1", "kinds": ["GHUser"], "properties": {"login": "alice"}}
+			],
+			"edges": [
+				{"kind": "GHHasIdentity", "start": {"match_by": "property", "property": "login", "value": "alice"}, "end": {"match_by": "property", "property": "name", "value": "alice@example.com", "kind": "User"}},
+				{"kind": "GHHasIdentity", "start": {"match_by": "property", "property": "login", "value": "alice"}, "end": {"match_by": "property", "property": "name", "value": "missing@example.com", "kind": "User"}},
+				{"kind": "GHHasIdentity", "start": {"match_by": "property", "property": "login", "value": "bob"}, "end": {"value": "S-1-5-21-1-1002", "kind": "User"}}
+			]
+		}
+	]
+}`
+
+func TestReadFileForIngest_GenericPropertyMatch(t *testing.T) {
+	var (
+		ctx        = context.Background()
+		mockCtrl   = gomock.NewController(t)
+		mockDB     = dbmocks.NewMockDatabase(mockCtrl)
+		graphDB, _ = dawgs.Open(ctx, memory.DriverName, dawgs.Config{})
+	)
+
+	mockDB.EXPECT().GetGenericKinds(gomock.Any()).Return(model.GenericKinds{{Name: "GHUser"}, {Name: "GHHasIdentity", IsEdge: true}}, nil)
+
+	require.Nil(t, graphDB.WriteTransaction(ctx, func(tx graph.Transaction) error {
+		_, err := tx.CreateNode(graph.AsProperties(map[string]any{
+			common.ObjectID.String(): "S-1-5-21-1-1001",
+			common.Name.String():     "ALICE@EXAMPLE.COM",
+		}), ad.Entity, ad.User)
+		return err
+	}))
+
+	err := graphDB.BatchOperation(ctx, func(batch graph.Batch) error {
+		return datapipe.ReadFileForIngest(batch, strings.NewReader(genericPropertyMatchContent), false, datapipe.NewGenericKindRegistrar(ctx, mockDB, graphDB), nil)
+	})
+
+	// Edges with an endpoint matched by property that no node matches are reported and skipped
+	require.ErrorIs(t, err, datapipe.ErrGenericEndpointNotFound)
+	require.ErrorContains(t, err, "name missing@example.com")
+	require.ErrorContains(t, err, "login bob")
+
+	require.Nil(t, graphDB.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		// Only the user of the file and the user already in the graph exist, as no placeholder nodes were created
+		count, err := tx.Nodes().Count()
+		require.Nil(t, err)
+		require.Equal(t, int64(2), count)
+
+		count, err = tx.Relationships().Filterf(func() graph.Criteria {
+			return query.And(
+				query.Kind(query.Relationship(), graph.StringKind("GHHasIdentity")),
+				query.Equals(query.StartProperty(common.ObjectID.String()), "GH-USER-1"),
+				query.Equals(query.EndProperty(common.ObjectID.String()), "S-1-5-21-1-1001"),
+			)
+		}).Count()
+		require.Nil(t, err)
+		require.Equal(t, int64(1), count)
+
+		count, err = tx.Relationships().Count()
+		require.Nil(t, err)
+		require.Equal(t, int64(1), count)
+
+		return nil
+	}))
+}
+
+func TestRegisterGenericKinds(t *testing.T) {
+	var (
+		ctx        = context.Background()
+		mockCtrl   = gomock.NewController(t)
+		mockDB     = dbmocks.NewMockDatabase(mockCtrl)
+		graphDB, _ = dawgs.Open(ctx, memory.DriverName, dawgs.Config{})
+		registered = model.GenericKinds{{Name: "GHUser"}, {Name: "GHCanPush", IsEdge: true}}
+	)
+
+	t.Run("invalid kind name", func(t *testing.T) {
+		mockDB.EXPECT().GetGenericKinds(gomock.Any()).Return(registered, nil)
+
+		err := datapipe.RegisterGenericKinds(ctx, mockDB, graphDB, ingest.GenericKindSchema{Nodes: []string{"GH User"}})
+		require.ErrorIs(t, err, ingest.ErrInvalidGenericKind)
+	})
+
+	t.Run("built-in kind", func(t *testing.T) {
+		mockDB.EXPECT().GetGenericKinds(gomock.Any()).Return(registered, nil)
+
+		err := datapipe.RegisterGenericKinds(ctx, mockDB, graphDB, ingest.GenericKindSchema{Edges: []string{ad.MemberOf.String()}})
+		require.ErrorIs(t, err, ingest.ErrInvalidGenericKind)
+		require.ErrorContains(t, err, "MemberOf is a built-in kind")
+	})
+
+	t.Run("kind declared as node and edge", func(t *testing.T) {
+		mockDB.EXPECT().GetGenericKinds(gomock.Any()).Return(registered, nil)
+
+		err := datapipe.RegisterGenericKinds(ctx, mockDB, graphDB, ingest.GenericKindSchema{Edges: []string{"GHUser"}})
+		require.ErrorIs(t, err, ingest.ErrInvalidGenericKind)
+	})
+
+	t.Run("already registered", func(t *testing.T) {
+		mockDB.EXPECT().GetGenericKinds(gomock.Any()).Return(registered, nil)
+
+		require.Nil(t, datapipe.RegisterGenericKinds(ctx, mockDB, graphDB, ingest.GenericKindSchema{Nodes: []string{"GHUser"}, Edges: []string{"GHCanPush"}}))
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		mockDB.EXPECT().GetGenericKinds(gomock.Any()).Return(nil, errors.New("database error"))
+
+		require.ErrorContains(t, datapipe.RegisterGenericKinds(ctx, mockDB, graphDB, ingest.GenericKindSchema{}), "database error")
+	})
+}
+
+func TestReadFileForIngest_GenericWithoutRegistrar(t *testing.T) {
+	var (
+		ctx        = context.Background()
+		graphDB, _ = dawgs.Open(ctx, memory.DriverName, dawgs.Config{})
+	)
+
+	require.ErrorIs(t, graphDB.BatchOperation(ctx, func(batch graph.Batch) error {
+		return datapipe.ReadFileForIngest(batch, strings.NewReader(genericIngestContent), false, nil, nil)
+	}), datapipe.ErrGenericIngestUnsupported)
+}
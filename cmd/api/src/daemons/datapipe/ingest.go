@@ -29,6 +29,7 @@ import (
 	"github.com/specterops/bloodhound/graphschema/ad"
 	"github.com/specterops/bloodhound/graphschema/azure"
 	"github.com/specterops/bloodhound/graphschema/common"
+	"github.com/specterops/bloodhound/src/metrics"
 	"github.com/specterops/bloodhound/src/model/ingest"
 	ingest_service "github.com/specterops/bloodhound/src/services/ingest"
 )
@@ -38,14 +39,44 @@ const (
 	ReconcileProperty    = "reconcile"
 )
 
-func ReadFileForIngest(batch graph.Batch, reader io.ReadSeeker, adcsEnabled bool) error {
+// ReadFileForIngest validates the meta tag of an ingest file and writes its contents to the graph. The given progress
+// func, if any, is called as decoded objects are written. Generic ingest files are only accepted when a kind registrar
+// is given.
+func ReadFileForIngest(batch graph.Batch, reader io.ReadSeeker, adcsEnabled bool, registerKinds GenericKindRegistrar, progress DecodeProgressFunc) error {
+	meta, err := DecodeFileForIngest(reader, adcsEnabled, registerKinds, NewBatchSink(batch, progress))
+	observeIngestFile(meta, err)
+
+	return err
+}
+
+// DecodeFileForIngest validates the meta tag of an ingest file and hands its converted contents to the given sink. The
+// returned metadata is empty when the meta tag is invalid.
+func DecodeFileForIngest(reader io.ReadSeeker, adcsEnabled bool, registerKinds GenericKindRegistrar, sink IngestSink) (ingest.Metadata, error) {
+	return decodeFileForIngest(reader, adcsEnabled, registerKinds, func(ingest.Metadata) IngestSink {
+		return sink
+	})
+}
+
+// decodeFileForIngest is DecodeFileForIngest for a sink that depends on the metadata of the file, which is only known
+// once its meta tag has been validated.
+func decodeFileForIngest(reader io.ReadSeeker, adcsEnabled bool, registerKinds GenericKindRegistrar, newSink func(meta ingest.Metadata) IngestSink) (ingest.Metadata, error) {
 	if meta, err := ingest_service.ValidateMetaTag(reader, false); err != nil {
-		return fmt.Errorf("error validating meta tag: %w", err)
+		return ingest.Metadata{}, fmt.Errorf("error validating meta tag: %w", err)
 	} else {
-		return IngestWrapper(batch, reader, meta, adcsEnabled)
+		return meta, IngestWrapper(reader, meta, adcsEnabled, registerKinds, newSink(meta))
 	}
 }
 
+// observeIngestFile records the outcome of ingesting a single file.
+func observeIngestFile(meta ingest.Metadata, err error) {
+	dataType := string(meta.Type)
+	if dataType == "" {
+		dataType = metrics.UnknownDataType
+	}
+
+	metrics.IngestFilesProcessed.WithLabelValues(dataType, metrics.Status(err)).Inc()
+}
+
 func IngestBasicData(batch graph.Batch, converted ConvertedData) error {
 	errs := util.NewErrorCollector()
 
@@ -96,40 +127,42 @@ func IngestAzureData(batch graph.Batch, converted ConvertedAzureData) error {
 	return errs.Combined()
 }
 
-func IngestWrapper(batch graph.Batch, reader io.ReadSeeker, meta ingest.Metadata, adcsEnabled bool) error {
+func IngestWrapper(reader io.ReadSeeker, meta ingest.Metadata, adcsEnabled bool, registerKinds GenericKindRegistrar, sink IngestSink) error {
 	switch meta.Type {
 	case ingest.DataTypeComputer:
 		if meta.Version >= 5 {
-			return decodeBasicData(batch, reader, convertComputerData)
+			return decodeBasicData(reader, meta.Type, convertComputerData, sink)
 		}
 	case ingest.DataTypeUser:
-		return decodeBasicData(batch, reader, convertUserData)
+		return decodeBasicData(reader, meta.Type, convertUserData, sink)
 	case ingest.DataTypeGroup:
-		return decodeGroupData(batch, reader)
+		return decodeGroupData(reader, meta.Type, sink)
 	case ingest.DataTypeDomain:
-		return decodeBasicData(batch, reader, convertDomainData)
+		return decodeBasicData(reader, meta.Type, convertDomainData, sink)
 	case ingest.DataTypeGPO:
-		return decodeBasicData(batch, reader, convertGPOData)
+		return decodeBasicData(reader, meta.Type, convertGPOData, sink)
 	case ingest.DataTypeOU:
-		return decodeBasicData(batch, reader, convertOUData)
+		return decodeBasicData(reader, meta.Type, convertOUData, sink)
 	case ingest.DataTypeSession:
-		return decodeSessionData(batch, reader)
+		return decodeSessionData(reader, meta.Type, sink)
 	case ingest.DataTypeContainer:
-		return decodeBasicData(batch, reader, convertContainerData)
+		return decodeBasicData(reader, meta.Type, convertContainerData, sink)
 	case ingest.DataTypeAIACA:
-		return decodeBasicData(batch, reader, convertAIACAData)
+		return decodeBasicData(reader, meta.Type, convertAIACAData, sink)
 	case ingest.DataTypeRootCA:
-		return decodeBasicData(batch, reader, convertRootCAData)
+		return decodeBasicData(reader, meta.Type, convertRootCAData, sink)
 	case ingest.DataTypeEnterpriseCA:
-		return decodeBasicData(batch, reader, convertEnterpriseCAData)
+		return decodeBasicData(reader, meta.Type, convertEnterpriseCAData, sink)
 	case ingest.DataTypeNTAuthStore:
-		return decodeBasicData(batch, reader, convertNTAuthStoreData)
+		return decodeBasicData(reader, meta.Type, convertNTAuthStoreData, sink)
 	case ingest.DataTypeCertTemplate:
-		return decodeBasicData(batch, reader, convertCertTemplateData)
+		return decodeBasicData(reader, meta.Type, convertCertTemplateData, sink)
 	case ingest.DataTypeAzure:
-		return decodeAzureData(batch, reader)
+		return decodeAzureData(reader, meta.Type, sink)
 	case ingest.DataTypeIssuancePolicy:
-		return decodeBasicData(batch, reader, convertIssuancePolicy)
+		return decodeBasicData(reader, meta.Type, convertIssuancePolicy, sink)
+	case ingest.DataTypeGeneric:
+		return decodeGenericData(reader, meta, registerKinds, sink)
 	}
 
 	return nil
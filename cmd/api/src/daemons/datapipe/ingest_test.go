@@ -17,13 +17,23 @@
 package datapipe_test
 
 import (
+	"context"
+	"errors"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/specterops/bloodhound/dawgs"
+	"github.com/specterops/bloodhound/dawgs/drivers/memory"
+	"github.com/specterops/bloodhound/dawgs/graph"
+	"github.com/specterops/bloodhound/dawgs/query"
+	"github.com/specterops/bloodhound/dawgs/util/size"
 	"github.com/specterops/bloodhound/graphschema/ad"
 	"github.com/specterops/bloodhound/graphschema/common"
 	"github.com/specterops/bloodhound/src/daemons/datapipe"
+	"github.com/specterops/bloodhound/src/model/ingest"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNormalizeEinNodeProperties(t *testing.T) {
@@ -46,3 +56,101 @@ func TestNormalizeEinNodeProperties(t *testing.T) {
 	assert.Equal(t, "DISTINGUISHED-NAME", normalizedProperties[ad.DistinguishedName.String()])
 	assert.Equal(t, "TEMPLE", normalizedProperties[common.OperatingSystem.String()])
 }
+
+func TestReadFileForIngest_Progress(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		graphDB = graph.NewDatabaseSwitch(ctx, memory.NewDriver(size.Gibibyte))
+		decoded = 0
+		content = `{
+			"meta": {"type": "users", "version": 6, "count": 2, "methods": 0},
+			"data": [
+				{"ObjectIdentifier": "S-1-5-21-1-1001", "Properties": {"name": "alice"}},
+				{"ObjectIdentifier": "S-1-5-21-1-1002", "Properties": {"name": "bob"}}
+			]
+		}`
+	)
+
+	require.Nil(t, graphDB.BatchOperation(ctx, func(batch graph.Batch) error {
+		return datapipe.ReadFileForIngest(batch, strings.NewReader(content), false, nil, func(count int) {
+			decoded += count
+		})
+	}))
+
+	assert.Equal(t, 2, decoded)
+}
+
+func TestDecodeFileForIngest_DeferredWrites(t *testing.T) {
+	var (
+		ctx        = context.Background()
+		graphDB, _ = dawgs.Open(ctx, memory.DriverName, dawgs.Config{})
+		chunks     []datapipe.IngestWriteFunc
+		decoded    = 0
+		content    = `{
+			"meta": {"type": "groups", "version": 6, "count": 1, "methods": 0},
+			"data": [
+				{
+					"ObjectIdentifier": "S-1-5-21-1-512",
+					"Properties": {"name": "domain admins"},
+					"Members": [{"ObjectIdentifier": "S-1-5-21-1-1001", "ObjectType": "User"}]
+				}
+			]
+		}`
+	)
+
+	meta, err := datapipe.DecodeFileForIngest(strings.NewReader(content), false, nil, func(count int, write datapipe.IngestWriteFunc) error {
+		decoded += count
+		chunks = append(chunks, write)
+		return nil
+	})
+	require.Nil(t, err)
+	require.Equal(t, ingest.DataTypeGroup, meta.Type)
+	require.Equal(t, 1, decoded)
+	require.Len(t, chunks, 1)
+
+	// Nothing is written until the sink writes the decoded chunks
+	require.Nil(t, graphDB.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		count, err := tx.Nodes().Count()
+		require.Nil(t, err)
+		require.Zero(t, count)
+		return nil
+	}))
+
+	require.Nil(t, graphDB.BatchOperation(ctx, func(batch graph.Batch) error {
+		for _, write := range chunks {
+			if err := write(batch); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}))
+
+	require.Nil(t, graphDB.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		count, err := tx.Relationships().Filterf(func() graph.Criteria {
+			return query.And(
+				query.Kind(query.Relationship(), ad.MemberOf),
+				query.Equals(query.StartProperty(common.ObjectID.String()), "S-1-5-21-1-1001"),
+				query.Equals(query.EndProperty(common.ObjectID.String()), "S-1-5-21-1-512"),
+			)
+		}).Count()
+		require.Nil(t, err)
+		require.Equal(t, int64(1), count)
+		return nil
+	}))
+}
+
+func TestDecodeFileForIngest_SinkError(t *testing.T) {
+	var (
+		sinkErr = errors.New("sink closed")
+		content = `{
+			"meta": {"type": "users", "version": 6, "count": 1, "methods": 0},
+			"data": [{"ObjectIdentifier": "S-1-5-21-1-1001", "Properties": {"name": "alice"}}]
+		}`
+	)
+
+	_, err := datapipe.DecodeFileForIngest(strings.NewReader(content), false, nil, func(int, datapipe.IngestWriteFunc) error {
+		return sinkErr
+	})
+	require.ErrorIs(t, err, sinkErr)
+}
@@ -21,18 +21,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"io"
 	"io/fs"
 	"log/slog"
-	"os"
+	"path/filepath"
 
-	"github.com/specterops/bloodhound/bomenc"
 	"github.com/specterops/bloodhound/dawgs/graph"
-	"github.com/specterops/bloodhound/dawgs/util"
 	"github.com/specterops/bloodhound/src/database"
+	"github.com/specterops/bloodhound/src/events"
 	"github.com/specterops/bloodhound/src/model"
 	"github.com/specterops/bloodhound/src/model/appcfg"
 	"github.com/specterops/bloodhound/src/services/ingest"
+	"github.com/specterops/bloodhound/src/services/workspace"
+	"github.com/specterops/bloodhound/src/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func HasIngestJobsWaitingForAnalysis(ctx context.Context, db database.Database) (bool, error) {
@@ -43,6 +45,34 @@ func HasIngestJobsWaitingForAnalysis(ctx context.Context, db database.Database)
 	}
 }
 
+// WorkspacesToAnalyze returns the workspaces whose graphs are analyzed by an analysis run. Runs triggered by ingest
+// only analyze the workspaces that the analyzed ingest jobs wrote to, while requested and scheduled runs analyze every
+// workspace. The default graph is always analyzed and is not represented in the result.
+func WorkspacesToAnalyze(workspaces model.Workspaces, trigger model.AnalysisRunTrigger, analyzedJobs model.IngestJobs) model.Workspaces {
+	if trigger != model.AnalysisRunTriggerIngest {
+		return workspaces
+	}
+
+	var (
+		ingestedWorkspaceIDs = map[int32]struct{}{}
+		selected             model.Workspaces
+	)
+
+	for _, job := range analyzedJobs {
+		if job.WorkspaceID.Valid {
+			ingestedWorkspaceIDs[job.WorkspaceID.Int32] = struct{}{}
+		}
+	}
+
+	for _, nextWorkspace := range workspaces {
+		if _, ingested := ingestedWorkspaceIDs[nextWorkspace.ID]; ingested {
+			selected = append(selected, nextWorkspace)
+		}
+	}
+
+	return selected
+}
+
 func FailAnalyzedIngestJobs(ctx context.Context, db database.Database) {
 	// Because our database interfaces do not yet accept contexts this is a best-effort check to ensure that we do not
 	// commit state transitions when we are shutting down.
@@ -61,6 +91,25 @@ func FailAnalyzedIngestJobs(ctx context.Context, db database.Database) {
 	}
 }
 
+// CancelAnalyzedIngestJobs transitions all jobs in an analyzing state to a canceled state after analysis is canceled.
+func CancelAnalyzedIngestJobs(ctx context.Context, db database.Database, canceledBy string) {
+	// Because our database interfaces do not yet accept contexts this is a best-effort check to ensure that we do not
+	// commit state transitions when we are shutting down.
+	if ctx.Err() != nil {
+		return
+	}
+
+	if ingestJobsUnderAnalysis, err := db.GetIngestJobsWithStatus(ctx, model.JobStatusAnalyzing); err != nil {
+		slog.ErrorContext(ctx, fmt.Sprintf("Failed to load ingest jobs under analysis: %v", err))
+	} else {
+		for _, job := range ingestJobsUnderAnalysis {
+			if err := ingest.CancelAnalyzedIngestJob(ctx, db, job, canceledBy); err != nil {
+				slog.ErrorContext(ctx, fmt.Sprintf("Failed updating ingest job %d to canceled status: %v", job.ID, err))
+			}
+		}
+	}
+}
+
 func PartialCompleteIngestJobs(ctx context.Context, db database.Database) {
 	// Because our database interfaces do not yet accept contexts this is a best-effort check to ensure that we do not
 	// commit state transitions when we are shutting down.
@@ -142,97 +191,110 @@ func (s *Daemon) clearFileTask(ingestTask model.IngestTask) {
 	}
 }
 
-// preProcessIngestFile will take a path and extract zips if necessary, returning the paths for files to process
-// along with any errors and the number of failed files (in the case of a zip archive)
-func (s *Daemon) preProcessIngestFile(path string, fileType model.FileType) ([]string, int, error) {
+// ingestFile is a file to process for an ingest task. The path of a file is its name in the ingest store and the name
+// of a file extracted from an archive is its name within the archive.
+type ingestFile struct {
+	path string
+	name string
+}
+
+// preProcessIngestFile will take a path and extract zips if necessary, returning the files to process along with any
+// errors and the number of failed files (in the case of a zip archive)
+func (s *Daemon) preProcessIngestFile(ctx context.Context, path string, fileType model.FileType) ([]ingestFile, int, error) {
 	if fileType == model.FileTypeJson {
 		//If this isn't a zip file, just return a slice with the path in it and let stuff process as normal
-		return []string{path}, 0, nil
-	} else if archive, err := zip.OpenReader(path); err != nil {
-		return []string{}, 0, err
+		return []ingestFile{{path: path, name: filepath.Base(path)}}, 0, nil
+	} else if archiveFile, err := s.store.Open(ctx, path); err != nil {
+		return []ingestFile{}, 0, err
 	} else {
-		var (
-			errs      = util.NewErrorCollector()
-			failed    = 0
-			filePaths = make([]string, len(archive.File))
-		)
+		// Archives are read in place so that large archives held by a remote store are not staged on local disk
+		archive, err := zip.NewReader(archiveFile, archiveFile.Size())
+		if err != nil {
+			archiveFile.Close()
+			return []ingestFile{}, 0, err
+		}
+
+		entries := make([]*zip.File, 0, len(archive.File))
 
-		for i, f := range archive.File {
+		for _, f := range archive.File {
 			//skip directories
-			if f.FileInfo().IsDir() {
-				continue
-			}
-			// Break out if temp file creation fails
-			// Collect errors for other failures within the archive
-			if tempFile, err := os.CreateTemp(s.cfg.TempDirectory(), "bh"); err != nil {
-				return []string{}, 0, err
-			} else if srcFile, err := f.Open(); err != nil {
-				errs.Add(fmt.Errorf("error opening file %s in archive %s: %v", f.Name, path, err))
-				failed++
-			} else if normFile, err := bomenc.NormalizeToUTF8(srcFile); err != nil {
-				errs.Add(fmt.Errorf("error normalizing file %s to UTF8 in archive %s: %v", f.Name, path, err))
-				failed++
-			} else if _, err := io.Copy(tempFile, normFile); err != nil {
-				errs.Add(fmt.Errorf("error extracting file %s in archive %s: %v", f.Name, path, err))
-				failed++
-			} else if err := tempFile.Close(); err != nil {
-				errs.Add(fmt.Errorf("error closing temp file %s: %v", f.Name, err))
-				failed++
-			} else {
-				filePaths[i] = tempFile.Name()
+			if !f.FileInfo().IsDir() {
+				entries = append(entries, f)
 			}
 		}
 
+		files, failed, err := extractArchiveFiles(ctx, s.store, path, entries, s.cfg.Ingest.ExtractionWorkers)
+
 		//Close the archive and delete it
-		if err := archive.Close(); err != nil {
-			slog.ErrorContext(s.ctx, fmt.Sprintf("Error closing archive %s: %v", path, err))
-		} else if err := os.Remove(path); err != nil {
-			slog.ErrorContext(s.ctx, fmt.Sprintf("Error deleting archive %s: %v", path, err))
+		if err := archiveFile.Close(); err != nil {
+			slog.ErrorContext(ctx, fmt.Sprintf("Error closing archive %s: %v", path, err))
+		} else if err := s.store.Remove(ctx, path); err != nil {
+			slog.ErrorContext(ctx, fmt.Sprintf("Error deleting archive %s: %v", path, err))
 		}
 
-		return filePaths, failed, errs.Combined()
+		return files, failed, err
 	}
 }
 
-// processIngestFile reads the files at the path supplied, and returns the total number of files in the
-// archive, the number of files that failed to ingest as JSON, and an error
-func (s *Daemon) processIngestFile(ctx context.Context, path string, fileType model.FileType) (int, int, error) {
+// processIngestFile reads the files at the path supplied on behalf of the given ingest job, and returns the total number
+// of files in the archive, the number of files that failed to ingest as JSON, and an error
+func (s *Daemon) processIngestFile(ctx context.Context, jobID int64, path string, fileType model.FileType, progress *ingestProgress) (int, int, error) {
 	adcsEnabled := false
 	if adcsFlag, err := s.db.GetFlagByKey(ctx, appcfg.FeatureAdcs); err != nil {
 		slog.ErrorContext(ctx, fmt.Sprintf("Error getting ADCS flag: %v", err))
 	} else {
 		adcsEnabled = adcsFlag.Enabled
 	}
-	if paths, failed, err := s.preProcessIngestFile(path, fileType); err != nil {
+	registerKinds := NewGenericKindRegistrar(ctx, s.db, s.graphdb)
+
+	if files, failed, err := s.preProcessIngestFile(ctx, path, fileType); err != nil {
 		return 0, failed, err
 	} else {
-		failed = 0
-
-		return len(paths), failed, s.graphdb.BatchOperation(ctx, func(batch graph.Batch) error {
-			for _, filePath := range paths {
-				file, err := os.Open(filePath)
-				if err != nil {
-					failed++
-					return err
-				} else if err := ReadFileForIngest(batch, file, adcsEnabled); err != nil {
-					failed++
-					slog.ErrorContext(ctx, fmt.Sprintf("Error reading ingest file %s: %v", filePath, err))
-				}
-
-				if err := file.Close(); err != nil {
-					slog.ErrorContext(ctx, fmt.Sprintf("Error closing ingest file %s: %v", filePath, err))
-				} else if err := os.Remove(filePath); errors.Is(err, fs.ErrNotExist) {
-					slog.WarnContext(ctx, fmt.Sprintf("Removing ingest file %s: %v", filePath, err))
-				} else if err != nil {
-					slog.ErrorContext(ctx, fmt.Sprintf("Error removing ingest file %s: %v", filePath, err))
-				}
-			}
+		options := ingestOptions{
+			jobID:           jobID,
+			store:           s.store,
+			adcsEnabled:     adcsEnabled,
+			registerKinds:   registerKinds,
+			decodeWorkers:   s.cfg.Ingest.DecodeWorkers,
+			writeBufferSize: s.cfg.Ingest.WriteBufferSize,
+		}
 
+		return len(files), failed, s.graphdb.BatchOperation(ctx, func(batch graph.Batch) error {
+			failed = ingestFiles(ctx, batch, files, options, progress)
 			return nil
 		})
 	}
 }
 
+// processCancelableIngestFile processes the file of the given ingest task. Processing is abandoned with
+// ErrIngestCanceled if the ingest job is canceled in the meantime; files already written to the graph remain and record
+// the job as the first ingest job of the objects they added.
+func (s *Daemon) processCancelableIngestFile(ctx context.Context, jobID int64, ingestTask model.IngestTask, progress *ingestProgress) (int, int, error) {
+	cancelableCtx, stopCancellationCheck := withCancellation(ctx, ErrIngestCanceled, s.ingestCancellationCheck(jobID))
+	defer stopCancellationCheck()
+
+	total, failed, err := s.processIngestFile(cancelableCtx, jobID, ingestTask.FileName, ingestTask.FileType, progress)
+
+	if isCanceled(cancelableCtx, ErrIngestCanceled) {
+		return total, failed, ErrIngestCanceled
+	}
+
+	return total, failed, err
+}
+
+// ingestJobContext returns a context that directs graph writes at the workspace the given ingest job belongs to.
+func (s *Daemon) ingestJobContext(ctx context.Context, job model.IngestJob) (context.Context, error) {
+	if !job.WorkspaceID.Valid {
+		return ctx, nil
+	} else if !workspace.IsSupported(s.graphdb) {
+		return nil, workspace.ErrUnsupportedGraphDriver
+	} else if jobWorkspace, err := s.db.GetWorkspace(ctx, job.WorkspaceID.Int32); err != nil {
+		return nil, fmt.Errorf("error fetching workspace %d: %w", job.WorkspaceID.Int32, err)
+	} else {
+		return workspace.WithTarget(ctx, jobWorkspace), nil
+	}
+}
+
 // processIngestTasks covers the generic ingest case for ingested data.
 func (s *Daemon) processIngestTasks(ctx context.Context, ingestTasks model.IngestTasks) {
 	if err := s.db.SetDatapipeStatus(s.ctx, model.DatapipeStatusIngesting, false); err != nil {
@@ -253,21 +315,61 @@ func (s *Daemon) processIngestTasks(ctx context.Context, ingestTasks model.Inges
 			return
 		}
 
-		total, failed, err := s.processIngestFile(ctx, ingestTask.FileName, ingestTask.FileType)
-		if errors.Is(err, fs.ErrNotExist) {
-			slog.WarnContext(ctx, fmt.Sprintf("Did not process ingest task %d with file %s: %v", ingestTask.ID, ingestTask.FileName, err))
-		} else if err != nil {
-			slog.ErrorContext(ctx, fmt.Sprintf("Failed processing ingest task %d with file %s: %v", ingestTask.ID, ingestTask.FileName, err))
-		} else if job, err := s.db.GetIngestJob(ctx, ingestTask.TaskID.ValueOrZero()); err != nil {
-			slog.ErrorContext(ctx, fmt.Sprintf("Failed to fetch job for ingest task %d: %v", ingestTask.ID, err))
-		} else {
-			job.TotalFiles = total
-			job.FailedFiles += failed
-			if err = s.db.UpdateIngestJob(ctx, job); err != nil {
-				slog.ErrorContext(ctx, fmt.Sprintf("Failed to update number of failed files for ingest job ID %d: %v", job.ID, err))
-			}
+		s.processIngestTask(ctx, ingestTask)
+	}
+}
+
+// processIngestTask ingests the file of a single ingest task and removes the task once it has been processed.
+func (s *Daemon) processIngestTask(ctx context.Context, ingestTask model.IngestTask) {
+	ctx, span := tracing.Start(ctx, "datapipe.ingest_task", trace.WithAttributes(
+		attribute.Int64("bloodhound.ingest_task.id", ingestTask.ID),
+		attribute.Int64("bloodhound.ingest_job.id", ingestTask.TaskID.ValueOrZero()),
+		attribute.Int("bloodhound.ingest_task.file_type", int(ingestTask.FileType)),
+	))
+	defer span.End()
+
+	// The ingest job determines which graph the task's file is written to. Tasks are never ingested without it.
+	job, err := s.db.GetIngestJob(ctx, ingestTask.TaskID.ValueOrZero())
+
+	// Events of the task are only delivered to subscribers of the job's workspace
+	progress := newIngestProgress(s.events, ingestTask, job.WorkspaceID.ValueOrZero())
+	progress.publish(events.TypeIngestTaskStarted)
+
+	if err != nil {
+		tracing.SetError(span, err)
+		progress.fail(err)
+		slog.ErrorContext(ctx, fmt.Sprintf("Failed to fetch job for ingest task %d: %v", ingestTask.ID, err))
+	} else if job.Status == model.JobStatusCanceled {
+		progress.fail(ErrIngestCanceled)
+		slog.InfoContext(ctx, fmt.Sprintf("Skipped ingest task %d of canceled ingest job %d", ingestTask.ID, job.ID))
+
+		if err := s.store.Remove(ctx, ingestTask.FileName); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			slog.ErrorContext(ctx, fmt.Sprintf("Error removing ingest file %s: %v", ingestTask.FileName, err))
 		}
+	} else if ingestCtx, err := s.ingestJobContext(ctx, job); err != nil {
+		tracing.SetError(span, err)
+		progress.fail(err)
+		slog.ErrorContext(ctx, fmt.Sprintf("Failed to target graph for ingest task %d: %v", ingestTask.ID, err))
+	} else if total, failed, err := s.processCancelableIngestFile(ingestCtx, job.ID, ingestTask, progress); errors.Is(err, ErrIngestCanceled) {
+		progress.fail(err)
+		slog.InfoContext(ctx, fmt.Sprintf("Canceled ingest task %d of ingest job %d", ingestTask.ID, job.ID))
+	} else if errors.Is(err, fs.ErrNotExist) {
+		progress.fail(err)
+		slog.WarnContext(ctx, fmt.Sprintf("Did not process ingest task %d with file %s: %v", ingestTask.ID, ingestTask.FileName, err))
+	} else if err != nil {
+		tracing.SetError(span, err)
+		progress.fail(err)
+		slog.ErrorContext(ctx, fmt.Sprintf("Failed processing ingest task %d with file %s: %v", ingestTask.ID, ingestTask.FileName, err))
+	} else {
+		span.SetAttributes(attribute.Int("bloodhound.ingest_task.files", total), attribute.Int("bloodhound.ingest_task.failed_files", failed))
+		progress.complete(failed)
 
-		s.clearFileTask(ingestTask)
+		// Only the file counts are written so that a cancellation or rollback request recorded while the file was being
+		// ingested is kept
+		if err = ingest.AddIngestJobFiles(ctx, s.db, job, total, failed); err != nil {
+			slog.ErrorContext(ctx, fmt.Sprintf("Failed to update number of failed files for ingest job ID %d: %v", job.ID, err))
+		}
 	}
+
+	s.clearFileTask(ingestTask)
 }
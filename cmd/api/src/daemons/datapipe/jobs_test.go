@@ -22,6 +22,7 @@ import (
 
 	"github.com/specterops/bloodhound/src/daemons/datapipe"
 	"github.com/specterops/bloodhound/src/database/mocks"
+	"github.com/specterops/bloodhound/src/database/types/null"
 	"github.com/specterops/bloodhound/src/model"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -72,15 +73,36 @@ func TestFailAnalyzedIngestJobs(t *testing.T) {
 			Status: model.JobStatusAnalyzing,
 		}}, nil)
 
-		dbMock.EXPECT().UpdateIngestJob(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, job model.IngestJob) error {
-			require.Equal(t, model.JobStatusFailed, job.Status)
-			return nil
-		})
+		dbMock.EXPECT().UpdateIngestJobStatus(gomock.Any(), jobID, model.JobStatusFailed, gomock.Any()).Return(true, nil)
 
 		datapipe.FailAnalyzedIngestJobs(context.Background(), dbMock)
 	})
 }
 
+func TestCancelAnalyzedIngestJobs(t *testing.T) {
+	const jobID int64 = 1
+
+	var (
+		mockCtrl = gomock.NewController(t)
+		dbMock   = mocks.NewMockDatabase(mockCtrl)
+	)
+
+	defer mockCtrl.Finish()
+
+	t.Run("Cancel Analyzed Ingest Jobs", func(t *testing.T) {
+		dbMock.EXPECT().GetIngestJobsWithStatus(gomock.Any(), model.JobStatusAnalyzing).Return([]model.IngestJob{{
+			BigSerial: model.BigSerial{
+				ID: jobID,
+			},
+			Status: model.JobStatusAnalyzing,
+		}}, nil)
+
+		dbMock.EXPECT().CancelIngestJob(gomock.Any(), jobID, []model.JobStatus{model.JobStatusAnalyzing}, "user", gomock.Any()).Return(true, nil)
+
+		datapipe.CancelAnalyzedIngestJobs(context.Background(), dbMock, "user")
+	})
+}
+
 func TestCompleteAnalyzedIngestJobs(t *testing.T) {
 	const jobID int64 = 1
 
@@ -99,10 +121,7 @@ func TestCompleteAnalyzedIngestJobs(t *testing.T) {
 			Status: model.JobStatusAnalyzing,
 		}}, nil)
 
-		dbMock.EXPECT().UpdateIngestJob(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, IngestJob model.IngestJob) error {
-			require.Equal(t, model.JobStatusComplete, IngestJob.Status)
-			return nil
-		})
+		dbMock.EXPECT().UpdateIngestJobStatus(gomock.Any(), jobID, model.JobStatusComplete, gomock.Any()).Return(true, nil)
 
 		datapipe.CompleteAnalyzedIngestJobs(context.Background(), dbMock)
 	})
@@ -127,10 +146,7 @@ func TestProcessFinishedIngestJobs(t *testing.T) {
 		}}, nil)
 
 		dbMock.EXPECT().GetIngestTasksForJob(gomock.Any(), jobID).Return([]model.IngestTask{}, nil)
-		dbMock.EXPECT().UpdateIngestJob(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, IngestJob model.IngestJob) error {
-			require.Equal(t, model.JobStatusAnalyzing, IngestJob.Status)
-			return nil
-		})
+		dbMock.EXPECT().UpdateIngestJobStatus(gomock.Any(), jobID, model.JobStatusAnalyzing, gomock.Any()).Return(true, nil)
 
 		datapipe.ProcessFinishedIngestJobs(context.Background(), dbMock)
 	})
@@ -148,3 +164,27 @@ func TestProcessFinishedIngestJobs(t *testing.T) {
 		datapipe.ProcessFinishedIngestJobs(context.Background(), dbMock)
 	})
 }
+
+func TestWorkspacesToAnalyze(t *testing.T) {
+	var (
+		workspaces   = model.Workspaces{{Serial: model.Serial{ID: 1}}, {Serial: model.Serial{ID: 2}}, {Serial: model.Serial{ID: 3}}}
+		analyzedJobs = model.IngestJobs{
+			{WorkspaceID: null.Int32From(2)},
+			{WorkspaceID: null.Int32From(2)},
+			{},
+		}
+	)
+
+	t.Run("Ingest Analyzes Ingested Workspaces", func(t *testing.T) {
+		require.Equal(t, model.Workspaces{workspaces[1]}, datapipe.WorkspacesToAnalyze(workspaces, model.AnalysisRunTriggerIngest, analyzedJobs))
+	})
+
+	t.Run("Ingest Into Default Graph Analyzes No Workspaces", func(t *testing.T) {
+		require.Empty(t, datapipe.WorkspacesToAnalyze(workspaces, model.AnalysisRunTriggerIngest, model.IngestJobs{{}}))
+	})
+
+	t.Run("Requested Analysis Analyzes All Workspaces", func(t *testing.T) {
+		require.Equal(t, workspaces, datapipe.WorkspacesToAnalyze(workspaces, model.AnalysisRunTriggerUserRequest, nil))
+		require.Equal(t, workspaces, datapipe.WorkspacesToAnalyze(workspaces, model.AnalysisRunTriggerSchedule, nil))
+	})
+}
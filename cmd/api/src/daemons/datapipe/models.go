@@ -20,7 +20,9 @@ import (
 	"encoding/json"
 
 	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/specterops/bloodhound/dawgs/graph"
 	"github.com/specterops/bloodhound/ein"
+	"github.com/specterops/bloodhound/src/model/ingest"
 )
 
 type ConvertedData struct {
@@ -33,6 +35,10 @@ func (s *ConvertedData) Clear() {
 	s.RelProps = s.RelProps[:0]
 }
 
+func (s ConvertedData) Write(batch graph.Batch) error {
+	return IngestBasicData(batch, s)
+}
+
 type ConvertedGroupData struct {
 	NodeProps              []ein.IngestibleNode
 	RelProps               []ein.IngestibleRelationship
@@ -45,6 +51,10 @@ func (s *ConvertedGroupData) Clear() {
 	s.DistinguishedNameProps = s.DistinguishedNameProps[:0]
 }
 
+func (s ConvertedGroupData) Write(batch graph.Batch) error {
+	return IngestGroupData(batch, s)
+}
+
 type ConvertedSessionData struct {
 	SessionProps []ein.IngestibleSession
 }
@@ -53,6 +63,10 @@ func (s *ConvertedSessionData) Clear() {
 	s.SessionProps = s.SessionProps[:0]
 }
 
+func (s ConvertedSessionData) Write(batch graph.Batch) error {
+	return IngestSessions(batch, s.SessionProps)
+}
+
 type AzureBase struct {
 	Kind enums.Kind      `json:"kind"`
 	Data json.RawMessage `json:"data"`
@@ -69,3 +83,24 @@ func (s *ConvertedAzureData) Clear() {
 	s.RelProps = s.RelProps[:0]
 	s.OnPremNodes = s.OnPremNodes[:0]
 }
+
+func (s ConvertedAzureData) Write(batch graph.Batch) error {
+	return IngestAzureData(batch, s)
+}
+
+type ConvertedGenericData struct {
+	Nodes []ingest.GenericNode
+	Edges []ingest.GenericEdge
+
+	// index holds the nodes of the file the data was decoded from that edges may be matched to by property
+	index *genericNodeIndex
+}
+
+func (s *ConvertedGenericData) Clear() {
+	s.Nodes = s.Nodes[:0]
+	s.Edges = s.Edges[:0]
+}
+
+func (s ConvertedGenericData) Write(batch graph.Batch) error {
+	return IngestGenericData(batch, s)
+}
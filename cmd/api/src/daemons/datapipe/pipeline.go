@@ -0,0 +1,252 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package datapipe
+
+import (
+	"archive/zip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+
+	"github.com/specterops/bloodhound/bomenc"
+	"github.com/specterops/bloodhound/dawgs/graph"
+	"github.com/specterops/bloodhound/dawgs/util"
+	"github.com/specterops/bloodhound/dawgs/util/channels"
+	"github.com/specterops/bloodhound/src/model/ingest"
+	"github.com/specterops/bloodhound/src/services/ingest/storage"
+)
+
+// extractArchiveFiles extracts the given archive entries to new files in the ingest store using the given number of
+// workers, normalizing each to UTF-8. The returned files are in the order of the entries.
+func extractArchiveFiles(ctx context.Context, store storage.Store, archivePath string, entries []*zip.File, numWorkers int) ([]ingestFile, int, error) {
+	var (
+		files    = make([]ingestFile, len(entries))
+		indexC   = make(chan int)
+		errs     = util.NewErrorCollector()
+		failed   = &atomic.Int64{}
+		workerWG = &sync.WaitGroup{}
+	)
+
+	for workerID := 0; workerID < max(numWorkers, 1); workerID++ {
+		workerWG.Add(1)
+
+		go func() {
+			defer workerWG.Done()
+
+			for idx := range indexC {
+				if file, err := extractArchiveFile(ctx, store, archivePath, entries[idx]); err != nil {
+					errs.Add(err)
+					failed.Add(1)
+				} else {
+					files[idx] = file
+				}
+			}
+		}()
+	}
+
+	for idx := range entries {
+		indexC <- idx
+	}
+
+	close(indexC)
+	workerWG.Wait()
+
+	return files, int(failed.Load()), errs.Combined()
+}
+
+func extractArchiveFile(ctx context.Context, store storage.Store, archivePath string, entry *zip.File) (ingestFile, error) {
+	writer, err := store.Create(ctx)
+	if err != nil {
+		return ingestFile{}, err
+	}
+
+	if srcFile, err := entry.Open(); err != nil {
+		writer.Abort()
+		return ingestFile{}, fmt.Errorf("error opening file %s in archive %s: %v", entry.Name, archivePath, err)
+	} else {
+		defer srcFile.Close()
+
+		if normFile, err := bomenc.NormalizeToUTF8(srcFile); err != nil {
+			writer.Abort()
+			return ingestFile{}, fmt.Errorf("error normalizing file %s to UTF8 in archive %s: %v", entry.Name, archivePath, err)
+		} else if _, err := io.Copy(writer, normFile); err != nil {
+			writer.Abort()
+			return ingestFile{}, fmt.Errorf("error extracting file %s in archive %s: %v", entry.Name, archivePath, err)
+		} else if err := writer.Close(); err != nil {
+			return ingestFile{}, fmt.Errorf("error closing extracted file %s: %v", entry.Name, err)
+		} else {
+			return ingestFile{
+				path: writer.Name(),
+				name: entry.Name,
+			}, nil
+		}
+	}
+}
+
+// ingestOptions configures how ingestFiles decodes and writes files.
+type ingestOptions struct {
+	jobID           int64
+	store           storage.Store
+	adcsEnabled     bool
+	registerKinds   GenericKindRegistrar
+	decodeWorkers   int
+	writeBufferSize int
+}
+
+// ingestChunk is a chunk of converted ingest data waiting to be written to the graph.
+type ingestChunk struct {
+	decoded int
+	write   IngestWriteFunc
+}
+
+// ingestFileResult is the outcome of decoding a single ingest file.
+type ingestFileResult struct {
+	meta ingest.Metadata
+	err  error
+}
+
+// pipelineFile carries the converted chunks of a single file from its decode worker to the graph writer.
+type pipelineFile struct {
+	ingestFile
+
+	chunks chan ingestChunk
+	result chan ingestFileResult
+}
+
+// contextReader fails reads once its context is done so that decoders stop early when ingest is cancelled.
+type contextReader struct {
+	io.ReadSeeker
+
+	ctx context.Context
+}
+
+func (s contextReader) Read(p []byte) (int, error) {
+	if err := s.ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	return s.ReadSeeker.Read(p)
+}
+
+// ingestFiles decodes the given files with a pool of workers and writes their converted contents to the batch. Files
+// are handed to workers and drained by the writer in order, so chunks reach the batch in the same order as when files
+// are ingested one at a time, and the nodes of each chunk are upserted before its relationships. Each worker runs at
+// most writeBufferSize chunks ahead of the writer. Every file is removed once processed. The number of files that
+// failed to ingest is returned.
+func ingestFiles(ctx context.Context, batch graph.Batch, files []ingestFile, options ingestOptions, progress *ingestProgress) int {
+	var (
+		pipelineFiles = make([]pipelineFile, len(files))
+		fileC         = make(chan pipelineFile, len(files))
+		workerWG      = &sync.WaitGroup{}
+		failed        = 0
+	)
+
+	for idx, file := range files {
+		pipelineFiles[idx] = pipelineFile{
+			ingestFile: file,
+			chunks:     make(chan ingestChunk, max(options.writeBufferSize, 1)),
+			result:     make(chan ingestFileResult, 1),
+		}
+
+		fileC <- pipelineFiles[idx]
+	}
+
+	close(fileC)
+
+	for workerID := 0; workerID < max(options.decodeWorkers, 1); workerID++ {
+		workerWG.Add(1)
+
+		go func() {
+			defer workerWG.Done()
+
+			for file := range fileC {
+				decodeIngestFile(ctx, file, options)
+			}
+		}()
+	}
+
+	// Workers pick up files in order, so the file the writer waits on next has always been handed to a worker
+	for idx, file := range pipelineFiles {
+		writeErrs := util.NewErrorCollector()
+		progress.startFile(file.name, idx+1, len(files))
+
+		for chunk := range file.chunks {
+			progress.decoded(chunk.decoded)
+
+			if err := chunk.write(batch); err != nil {
+				writeErrs.Add(err)
+			}
+		}
+
+		result := <-file.result
+		err := errors.Join(result.err, writeErrs.Combined())
+
+		if err != nil {
+			failed++
+			slog.ErrorContext(ctx, fmt.Sprintf("Error reading ingest file %s: %v", file.path, err))
+		}
+
+		observeIngestFile(result.meta, err)
+
+		if err := options.store.Remove(ctx, file.path); errors.Is(err, fs.ErrNotExist) {
+			slog.WarnContext(ctx, fmt.Sprintf("Removing ingest file %s: %v", file.path, err))
+		} else if err != nil {
+			slog.ErrorContext(ctx, fmt.Sprintf("Error removing ingest file %s: %v", file.path, err))
+		}
+	}
+
+	workerWG.Wait()
+	return failed
+}
+
+// decodeIngestFile decodes a single file, submitting its converted chunks to the writer stamped with the provenance of
+// the file. The chunk channel is closed and the result sent once the file has been decoded.
+func decodeIngestFile(ctx context.Context, file pipelineFile, options ingestOptions) {
+	defer close(file.chunks)
+
+	var (
+		result  ingestFileResult
+		newSink = func(meta ingest.Metadata) IngestSink {
+			provenance := NewIngestProvenance(options.jobID, meta)
+
+			return func(decoded int, write IngestWriteFunc) error {
+				if !channels.Submit(ctx, file.chunks, ingestChunk{decoded: decoded, write: provenance.Write(write)}) {
+					return ctx.Err()
+				}
+
+				return nil
+			}
+		}
+	)
+
+	if reader, err := options.store.Open(ctx, file.path); err != nil {
+		result.err = err
+	} else {
+		result.meta, result.err = decodeFileForIngest(contextReader{ReadSeeker: reader, ctx: ctx}, options.adcsEnabled, options.registerKinds, newSink)
+
+		if err := reader.Close(); err != nil {
+			slog.ErrorContext(ctx, fmt.Sprintf("Error closing ingest file %s: %v", file.path, err))
+		}
+	}
+
+	file.result <- result
+}
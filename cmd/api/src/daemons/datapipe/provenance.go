@@ -0,0 +1,242 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package datapipe
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/specterops/bloodhound/bhlog/measure"
+	"github.com/specterops/bloodhound/dawgs/graph"
+	"github.com/specterops/bloodhound/dawgs/query"
+	"github.com/specterops/bloodhound/graphschema/common"
+	"github.com/specterops/bloodhound/src/database"
+	"github.com/specterops/bloodhound/src/model"
+	"github.com/specterops/bloodhound/src/model/ingest"
+)
+
+// IngestProvenance identifies the ingest job that wrote an object to the graph along with the type and version of the
+// collected data it was written from.
+type IngestProvenance struct {
+	JobID         int64
+	SourceType    ingest.DataType
+	SourceVersion int
+}
+
+func NewIngestProvenance(jobID int64, meta ingest.Metadata) IngestProvenance {
+	return IngestProvenance{
+		JobID:         jobID,
+		SourceType:    meta.Type,
+		SourceVersion: meta.Version,
+	}
+}
+
+// Stamp sets the provenance properties on the given node or relationship properties. The first ingest job is only
+// written by upserts that create the object, see provenanceCreateOnlyProperties.
+func (s IngestProvenance) Stamp(properties *graph.Properties) {
+	properties.Set(common.FirstIngestJob.String(), s.JobID)
+	properties.Set(common.LastIngestJob.String(), s.JobID)
+	properties.Set(common.IngestSourceType.String(), string(s.SourceType))
+	properties.Set(common.IngestSourceVersion.String(), s.SourceVersion)
+}
+
+// Write returns an IngestWriteFunc that stamps the provenance on every node and relationship the given write upserts.
+func (s IngestProvenance) Write(write IngestWriteFunc) IngestWriteFunc {
+	return func(batch graph.Batch) error {
+		return write(provenanceBatch{Batch: batch, provenance: s})
+	}
+}
+
+// provenanceCreateOnlyProperties are the provenance properties that upserts write only when they create the object so
+// that they record the ingest job that added the object to the graph
+var provenanceCreateOnlyProperties = []string{common.FirstIngestJob.String()}
+
+// provenanceBatch is a graph.Batch that stamps ingest provenance on upserted nodes and relationships, including the
+// start and end nodes of upserted relationships.
+type provenanceBatch struct {
+	graph.Batch
+	provenance IngestProvenance
+}
+
+func (s provenanceBatch) UpdateNodeBy(update graph.NodeUpdate) error {
+	s.stampNode(update.Node)
+	update.CreateOnlyProperties = append(update.CreateOnlyProperties, provenanceCreateOnlyProperties...)
+
+	return s.Batch.UpdateNodeBy(update)
+}
+
+func (s provenanceBatch) UpdateRelationshipBy(update graph.RelationshipUpdate) error {
+	if update.Relationship != nil {
+		if update.Relationship.Properties == nil {
+			update.Relationship.Properties = graph.NewProperties()
+		}
+
+		s.provenance.Stamp(update.Relationship.Properties)
+	}
+
+	s.stampNode(update.Start)
+	s.stampNode(update.End)
+	update.CreateOnlyProperties = append(update.CreateOnlyProperties, provenanceCreateOnlyProperties...)
+
+	return s.Batch.UpdateRelationshipBy(update)
+}
+
+func (s provenanceBatch) stampNode(node *graph.Node) {
+	if node != nil {
+		if node.Properties == nil {
+			node.Properties = graph.NewProperties()
+		}
+
+		s.provenance.Stamp(node.Properties)
+	}
+}
+
+func lastIngestJobNodeFilter(jobID int64) graph.Criteria {
+	return query.Equals(query.NodeProperty(common.LastIngestJob.String()), jobID)
+}
+
+// RollbackIngestJobGraphData deletes the nodes and relationships that the given ingest job was the only source of:
+// objects it added to the graph that no later ingest job has written since. Deleting a node also deletes the
+// relationships attached to it. Objects of unknown origin, which were in the graph before ingest jobs were recorded on
+// them, are never rolled back.
+func RollbackIngestJobGraphData(ctx context.Context, graphDB graph.Database, jobID int64) (PruneStats, error) {
+	if jobID == model.UnknownIngestJobID {
+		return NewPruneStats(false), fmt.Errorf("graph data of unknown origin can not be rolled back")
+	}
+
+	var (
+		stats      = NewPruneStats(false)
+		onlySource = func(firstIngestJob, lastIngestJob graph.Criteria) graph.Criteria {
+			return query.And(
+				query.Equals(firstIngestJob, jobID),
+				query.Equals(lastIngestJob, jobID),
+			)
+		}
+	)
+
+	if relationshipIDs, err := fetchPrunedRelationships(ctx, graphDB, onlySource(
+		query.RelationshipProperty(common.FirstIngestJob.String()),
+		query.RelationshipProperty(common.LastIngestJob.String()),
+	), stats); err != nil {
+		return stats, fmt.Errorf("failed to fetch relationships to roll back: %w", err)
+	} else if nodeIDs, err := fetchPrunedNodes(ctx, graphDB, onlySource(
+		query.NodeProperty(common.FirstIngestJob.String()),
+		query.NodeProperty(common.LastIngestJob.String()),
+	), stats); err != nil {
+		return stats, fmt.Errorf("failed to fetch nodes to roll back: %w", err)
+	} else if err := deletePruned(ctx, graphDB, nodeIDs, relationshipIDs); err != nil {
+		return stats, fmt.Errorf("failed to roll back graph data: %w", err)
+	}
+
+	return stats, nil
+}
+
+// rollbackIngestJobs rolls back the graph data of every ingest job with a pending rollback request. Each rollback is
+// recorded in the audit log. A failed rollback has its request cleared so that it is not retried on every pass. Analysis
+// is requested afterwards so that post-processed relationships that depended on the removed data are recomputed.
+func (s *Daemon) rollbackIngestJobs() {
+	if jobs, err := s.db.GetIngestJobsPendingRollback(s.ctx); err != nil {
+		slog.ErrorContext(s.ctx, fmt.Sprintf("Failed fetching ingest jobs pending rollback: %v", err))
+	} else if len(jobs) > 0 {
+		if err := s.db.SetDatapipeStatus(s.ctx, model.DatapipeStatusPurging, false); err != nil {
+			slog.ErrorContext(s.ctx, fmt.Sprintf("Error setting datapipe status: %v", err))
+			return
+		}
+
+		defer s.db.SetDatapipeStatus(s.ctx, model.DatapipeStatusIdle, false)
+
+		for _, job := range jobs {
+			if s.ctx.Err() != nil {
+				return
+			}
+
+			s.rollbackIngestJob(job)
+		}
+
+		if err := s.db.RequestAnalysis(s.ctx, "datapipe"); err != nil {
+			slog.ErrorContext(s.ctx, fmt.Sprintf("Failed requesting analysis after ingest job rollback: %v", err))
+		}
+	}
+}
+
+func (s *Daemon) rollbackIngestJob(job model.IngestJob) {
+	defer measure.ContextMeasure(s.ctx, slog.LevelInfo, fmt.Sprintf("Finished rolling back ingest job %d", job.ID))()
+
+	var (
+		stats       PruneStats
+		rollbackErr error
+	)
+
+	if jobCtx, err := s.ingestJobContext(s.ctx, job); err != nil {
+		rollbackErr = fmt.Errorf("failed to target graph: %w", err)
+	} else {
+		stats, rollbackErr = RollbackIngestJobGraphData(jobCtx, s.graphdb, job.ID)
+	}
+
+	auditRollbackIngestJob(s.ctx, s.db, job, stats, rollbackErr)
+
+	if rollbackErr != nil {
+		slog.ErrorContext(s.ctx, fmt.Sprintf("Failed rolling back ingest job %d: %v", job.ID, rollbackErr))
+
+		// Clear the request rather than retry it on every pass so that a rollback that keeps failing can be requested
+		// again once the cause has been addressed
+		if err := s.db.ClearIngestJobRollbackRequest(s.ctx, job.ID); err != nil {
+			slog.ErrorContext(s.ctx, fmt.Sprintf("Failed to clear rollback request of ingest job %d: %v", job.ID, err))
+		}
+	} else {
+		slog.InfoContext(s.ctx, fmt.Sprintf("Rolled back ingest job %d: deleted %d nodes and %d relationships", job.ID, totalCount(stats.NodesPruned), totalCount(stats.RelationshipsPruned)))
+
+		if err := s.db.SetIngestJobRolledBack(s.ctx, job.ID); err != nil {
+			slog.ErrorContext(s.ctx, fmt.Sprintf("Failed to record rollback of ingest job %d: %v", job.ID, err))
+		}
+	}
+}
+
+func auditRollbackIngestJob(ctx context.Context, db database.Database, job model.IngestJob, stats PruneStats, rollbackErr error) {
+	auditStatus := model.AuditLogStatusSuccess
+	if rollbackErr != nil {
+		auditStatus = model.AuditLogStatusFailure
+	}
+
+	if auditEntry, err := model.NewAuditEntry(model.AuditLogActionRollbackIngestJob, auditStatus, model.AuditData{
+		"ingest_job_id":         job.ID,
+		"requested_by":          job.RollbackRequestedBy.ValueOrZero(),
+		"nodes_deleted":         kindCounts(stats.NodesPruned),
+		"relationships_deleted": kindCounts(stats.RelationshipsPruned),
+	}); err != nil {
+		slog.ErrorContext(ctx, fmt.Sprintf("Failed to create ingest job rollback audit entry: %v", err))
+	} else {
+		if rollbackErr != nil {
+			auditEntry.ErrorMsg = rollbackErr.Error()
+		}
+
+		if err := db.AppendAuditLog(ctx, auditEntry); err != nil {
+			slog.ErrorContext(ctx, fmt.Sprintf("Failed to write ingest job rollback audit entry: %v", err))
+		}
+	}
+}
+
+func totalCount(counts map[graph.Kind]int) int {
+	total := 0
+
+	for _, count := range counts {
+		total += count
+	}
+
+	return total
+}
@@ -0,0 +1,175 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package datapipe_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/specterops/bloodhound/dawgs"
+	"github.com/specterops/bloodhound/dawgs/drivers/memory"
+	"github.com/specterops/bloodhound/dawgs/graph"
+	"github.com/specterops/bloodhound/dawgs/query"
+	"github.com/specterops/bloodhound/ein"
+	"github.com/specterops/bloodhound/graphschema/ad"
+	"github.com/specterops/bloodhound/graphschema/common"
+	"github.com/specterops/bloodhound/src/daemons/datapipe"
+	"github.com/specterops/bloodhound/src/migrations"
+	"github.com/specterops/bloodhound/src/model"
+	"github.com/specterops/bloodhound/src/model/ingest"
+	"github.com/stretchr/testify/require"
+)
+
+func ingestUsersForJob(t *testing.T, graphDB graph.Database, jobID int64, objectIDs ...string) {
+	var (
+		ctx        = context.Background()
+		provenance = datapipe.NewIngestProvenance(jobID, ingest.Metadata{Type: ingest.DataTypeUser, Version: 6})
+		converted  datapipe.ConvertedData
+	)
+
+	for _, objectID := range objectIDs {
+		converted.NodeProps = append(converted.NodeProps, ein.IngestibleNode{
+			ObjectID:    objectID,
+			PropertyMap: map[string]any{},
+			Label:       ad.User,
+		})
+
+		converted.RelProps = append(converted.RelProps, ein.IngestibleRelationship{
+			Source:     objectID,
+			SourceType: ad.User,
+			Target:     "GROUP",
+			TargetType: ad.Group,
+			RelProps:   map[string]any{},
+			RelType:    ad.MemberOf,
+		})
+	}
+
+	require.Nil(t, graphDB.BatchOperation(ctx, func(batch graph.Batch) error {
+		return provenance.Write(converted.Write)(batch)
+	}))
+}
+
+func fetchNodeByObjectID(t *testing.T, graphDB graph.Database, objectID string) *graph.Node {
+	var node *graph.Node
+
+	require.Nil(t, graphDB.ReadTransaction(context.Background(), func(tx graph.Transaction) error {
+		fetched, err := tx.Nodes().Filter(query.Equals(query.NodeProperty(common.ObjectID.String()), objectID)).First()
+		node = fetched
+		return err
+	}))
+
+	return node
+}
+
+func requireIngestJobs(t *testing.T, properties *graph.Properties, firstJobID, lastJobID int64) {
+	firstIngestJob, err := properties.Get(common.FirstIngestJob.String()).Int64()
+	require.Nil(t, err)
+	require.Equal(t, firstJobID, firstIngestJob)
+
+	lastIngestJob, err := properties.Get(common.LastIngestJob.String()).Int64()
+	require.Nil(t, err)
+	require.Equal(t, lastJobID, lastIngestJob)
+}
+
+func TestIngestProvenance(t *testing.T) {
+	graphDB, err := dawgs.Open(context.Background(), memory.DriverName, dawgs.Config{})
+	require.Nil(t, err)
+
+	ingestUsersForJob(t, graphDB, 1, "USER-A", "USER-B")
+	ingestUsersForJob(t, graphDB, 2, "USER-B", "USER-C")
+
+	userA := fetchNodeByObjectID(t, graphDB, "USER-A")
+	requireIngestJobs(t, userA.Properties, 1, 1)
+
+	sourceType, err := userA.Properties.Get(common.IngestSourceType.String()).String()
+	require.Nil(t, err)
+	require.Equal(t, string(ingest.DataTypeUser), sourceType)
+
+	sourceVersion, err := userA.Properties.Get(common.IngestSourceVersion.String()).Int()
+	require.Nil(t, err)
+	require.Equal(t, 6, sourceVersion)
+
+	requireIngestJobs(t, fetchNodeByObjectID(t, graphDB, "USER-B").Properties, 1, 2)
+	requireIngestJobs(t, fetchNodeByObjectID(t, graphDB, "USER-C").Properties, 2, 2)
+
+	// The group was only written as the end of relationships, which still records the jobs that wrote it
+	requireIngestJobs(t, fetchNodeByObjectID(t, graphDB, "GROUP").Properties, 1, 2)
+
+	require.Nil(t, graphDB.ReadTransaction(context.Background(), func(tx graph.Transaction) error {
+		return tx.Relationships().Fetch(func(cursor graph.Cursor[*graph.Relationship]) error {
+			for relationship := range cursor.Chan() {
+				_, err := relationship.Properties.Get(common.LastIngestJob.String()).Int64()
+				require.Nil(t, err)
+			}
+
+			return cursor.Error()
+		})
+	}))
+}
+
+func TestRollbackIngestJobGraphData(t *testing.T) {
+	graphDB, err := dawgs.Open(context.Background(), memory.DriverName, dawgs.Config{})
+	require.Nil(t, err)
+
+	ingestUsersForJob(t, graphDB, 1, "USER-A", "USER-B")
+	ingestUsersForJob(t, graphDB, 2, "USER-B", "USER-C")
+
+	stats, err := datapipe.RollbackIngestJobGraphData(context.Background(), graphDB, 2)
+	require.Nil(t, err)
+
+	// Only the user and membership that the second job added are removed
+	require.Equal(t, 1, stats.NodesPruned[ad.User])
+	require.Equal(t, 1, stats.RelationshipsPruned[ad.MemberOf])
+
+	require.NotNil(t, fetchNodeByObjectID(t, graphDB, "USER-A"))
+	require.NotNil(t, fetchNodeByObjectID(t, graphDB, "USER-B"))
+	require.NotNil(t, fetchNodeByObjectID(t, graphDB, "GROUP"))
+
+	require.Nil(t, graphDB.ReadTransaction(context.Background(), func(tx graph.Transaction) error {
+		_, err := tx.Nodes().Filter(query.Equals(query.NodeProperty(common.ObjectID.String()), "USER-C")).First()
+		require.ErrorIs(t, err, graph.ErrNoResultsFound)
+		return nil
+	}))
+}
+
+func TestRollbackIngestJobGraphData_UnknownOrigin(t *testing.T) {
+	graphDB, err := dawgs.Open(context.Background(), memory.DriverName, dawgs.Config{})
+	require.Nil(t, err)
+
+	// Graph data written before ingest jobs were recorded on the objects they add
+	require.Nil(t, graphDB.WriteTransaction(context.Background(), func(tx graph.Transaction) error {
+		_, err := tx.CreateNode(graph.AsProperties(map[string]any{common.ObjectID.String(): "USER-A"}), ad.Entity, ad.User)
+		return err
+	}))
+
+	require.Nil(t, migrations.Version_740_Migration(context.Background(), graphDB))
+
+	ingestUsersForJob(t, graphDB, 1, "USER-A", "USER-B")
+
+	// The pre-existing user keeps its unknown origin while the user the job added records the job
+	requireIngestJobs(t, fetchNodeByObjectID(t, graphDB, "USER-A").Properties, model.UnknownIngestJobID, 1)
+	requireIngestJobs(t, fetchNodeByObjectID(t, graphDB, "USER-B").Properties, 1, 1)
+
+	stats, err := datapipe.RollbackIngestJobGraphData(context.Background(), graphDB, 1)
+	require.Nil(t, err)
+	require.Equal(t, 1, stats.NodesPruned[ad.User])
+	require.NotNil(t, fetchNodeByObjectID(t, graphDB, "USER-A"))
+
+	_, err = datapipe.RollbackIngestJobGraphData(context.Background(), graphDB, model.UnknownIngestJobID)
+	require.NotNil(t, err)
+	require.NotNil(t, fetchNodeByObjectID(t, graphDB, "USER-A"))
+}
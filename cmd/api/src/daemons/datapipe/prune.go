@@ -0,0 +1,263 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package datapipe
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	commonanalysis "github.com/specterops/bloodhound/analysis"
+	"github.com/specterops/bloodhound/bhlog/measure"
+	"github.com/specterops/bloodhound/dawgs/graph"
+	"github.com/specterops/bloodhound/dawgs/query"
+	"github.com/specterops/bloodhound/graphschema/ad"
+	"github.com/specterops/bloodhound/graphschema/common"
+	"github.com/specterops/bloodhound/src/database"
+	"github.com/specterops/bloodhound/src/model"
+	"github.com/specterops/bloodhound/src/model/appcfg"
+)
+
+// PruneStats records the number of nodes and relationships, by kind, that were pruned or marked stale by a graph
+// reconciliation pass.
+type PruneStats struct {
+	SoftDelete          bool
+	NodesPruned         map[graph.Kind]int
+	RelationshipsPruned map[graph.Kind]int
+}
+
+func NewPruneStats(softDelete bool) PruneStats {
+	return PruneStats{
+		SoftDelete:          softDelete,
+		NodesPruned:         map[graph.Kind]int{},
+		RelationshipsPruned: map[graph.Kind]int{},
+	}
+}
+
+func kindCounts(counts map[graph.Kind]int) map[string]int {
+	converted := make(map[string]int, len(counts))
+
+	for kind, count := range counts {
+		converted[kind.String()] = count
+	}
+
+	return converted
+}
+
+func (s PruneStats) AuditData() model.AuditData {
+	return model.AuditData{
+		"soft_delete":          s.SoftDelete,
+		"nodes_pruned":         kindCounts(s.NodesPruned),
+		"relationships_pruned": kindCounts(s.RelationshipsPruned),
+	}
+}
+
+func (s PruneStats) LogStats() {
+	for kind, count := range s.NodesPruned {
+		slog.Info(fmt.Sprintf("Pruned %d %s nodes", count, kind))
+	}
+
+	for kind, count := range s.RelationshipsPruned {
+		slog.Info(fmt.Sprintf("Pruned %d %s relationships", count, kind))
+	}
+}
+
+func expiredRelationshipsFilter(now time.Time, ttl appcfg.PruneTTLParameters) graph.Criteria {
+	return query.Or(
+		query.And(
+			query.Kind(query.Relationship(), ad.HasSession),
+			query.Before(query.RelationshipProperty(common.LastSeen.String()), now.Add(-ttl.HasSessionEdgeTTL)),
+		),
+		query.And(
+			query.Not(query.Kind(query.Relationship(), ad.HasSession)),
+			query.Before(query.RelationshipProperty(common.LastSeen.String()), now.Add(-ttl.BaseTTL)),
+		),
+	)
+}
+
+func expiredNodesFilter(now time.Time, ttl appcfg.PruneTTLParameters, protectedKinds graph.Kinds, protectedObjectIDs []string) graph.Criteria {
+	criteria := query.And(
+		query.Before(query.NodeProperty(common.LastSeen.String()), now.Add(-ttl.BaseTTL)),
+	)
+
+	if len(protectedKinds) > 0 {
+		criteria.Add(query.Not(query.KindIn(query.Node(), protectedKinds...)))
+	}
+
+	if len(protectedObjectIDs) > 0 {
+		criteria.Add(query.Not(query.In(query.NodeProperty(common.ObjectID.String()), protectedObjectIDs)))
+	}
+
+	return criteria
+}
+
+func fetchPrunedRelationships(ctx context.Context, graphDB graph.Database, filter graph.Criteria, stats PruneStats) ([]graph.ID, error) {
+	var relationshipIDs []graph.ID
+
+	err := graphDB.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		return tx.Relationships().Filter(filter).FetchKinds(func(cursor graph.Cursor[graph.RelationshipKindsResult]) error {
+			for next := range cursor.Chan() {
+				relationshipIDs = append(relationshipIDs, next.ID)
+				stats.RelationshipsPruned[next.Kind]++
+			}
+
+			return cursor.Error()
+		})
+	})
+
+	return relationshipIDs, err
+}
+
+func fetchPrunedNodes(ctx context.Context, graphDB graph.Database, filter graph.Criteria, stats PruneStats) ([]graph.ID, error) {
+	var nodeIDs []graph.ID
+
+	err := graphDB.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		return tx.Nodes().Filter(filter).FetchKinds(func(cursor graph.Cursor[graph.KindsResult]) error {
+			for next := range cursor.Chan() {
+				nodeIDs = append(nodeIDs, next.ID)
+				stats.NodesPruned[commonanalysis.GetNodeKind(graph.NewNode(next.ID, nil, next.Kinds...))]++
+			}
+
+			return cursor.Error()
+		})
+	})
+
+	return nodeIDs, err
+}
+
+func deletePruned(ctx context.Context, graphDB graph.Database, nodeIDs, relationshipIDs []graph.ID) error {
+	return graphDB.BatchOperation(ctx, func(batch graph.Batch) error {
+		for _, relationshipID := range relationshipIDs {
+			if err := batch.DeleteRelationship(relationshipID); err != nil {
+				return err
+			}
+		}
+
+		for _, nodeID := range nodeIDs {
+			if err := batch.DeleteNode(nodeID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// markStale sets the stale property on the given nodes and relationships and clears it from any node or relationship
+// that has since been seen again. Both the expired nodes and the expired relationships of a pruning pass must be given
+// in a single call as the stale property is cleared from every node or relationship not given.
+func markStale(ctx context.Context, graphDB graph.Database, nodeIDs, relationshipIDs []graph.ID) error {
+	var (
+		staleProperties = graph.NewProperties()
+		seenProperties  = graph.NewProperties()
+	)
+
+	staleProperties.Set(common.Stale.String(), true)
+	seenProperties.Delete(common.Stale.String())
+
+	var (
+		seenNodes         = query.And(query.Exists(query.NodeProperty(common.Stale.String())))
+		seenRelationships = query.And(query.Exists(query.RelationshipProperty(common.Stale.String())))
+	)
+
+	if len(nodeIDs) > 0 {
+		seenNodes.Add(query.Not(query.InIDs(query.NodeID(), nodeIDs...)))
+	}
+
+	if len(relationshipIDs) > 0 {
+		seenRelationships.Add(query.Not(query.InIDs(query.RelationshipID(), relationshipIDs...)))
+	}
+
+	return graphDB.WriteTransaction(ctx, func(tx graph.Transaction) error {
+		if err := tx.Nodes().Filter(seenNodes).Update(seenProperties); err != nil {
+			return err
+		} else if err := tx.Relationships().Filter(seenRelationships).Update(seenProperties); err != nil {
+			return err
+		}
+
+		if len(nodeIDs) > 0 {
+			if err := tx.Nodes().Filter(query.InIDs(query.NodeID(), nodeIDs...)).Update(staleProperties); err != nil {
+				return err
+			}
+		}
+
+		if len(relationshipIDs) > 0 {
+			if err := tx.Relationships().Filter(query.InIDs(query.RelationshipID(), relationshipIDs...)).Update(staleProperties); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// PruneExpiredGraphData removes collected nodes and relationships whose lastseen timestamp is older than the
+// configured prune TTLs. HasSession relationships expire after their own, shorter TTL. Members of asset group tags and
+// nodes selected by asset group selector seeds are kept regardless of age. When prune soft deletion is enabled the
+// expired data is marked stale rather than deleted. Each pass is recorded in the audit log. Nothing is pruned unless
+// pruning is enabled and reconciliation is not disabled.
+func PruneExpiredGraphData(ctx context.Context, db database.Database, graphDB graph.Database) (PruneStats, error) {
+	if !appcfg.GetPruneEnabledParameter(ctx, db) || !appcfg.GetReconciliationParameter(ctx, db) {
+		return PruneStats{}, nil
+	}
+
+	defer measure.ContextMeasure(ctx, slog.LevelInfo, "Finished pruning expired graph data")()
+
+	var (
+		softDelete = appcfg.GetPruneSoftDeleteParameter(ctx, db)
+		ttl        = appcfg.GetPruneTTLParameters(ctx, db)
+		now        = time.Now().UTC()
+		stats      = NewPruneStats(softDelete)
+		pruneErr   error
+	)
+
+	if tags, err := db.GetAssetGroupTags(ctx, model.SQLFilter{}); err != nil {
+		pruneErr = fmt.Errorf("failed to fetch asset group tags: %w", err)
+	} else if protectedObjectIDs, err := db.GetSelectorSeedObjectIDs(ctx); err != nil {
+		pruneErr = fmt.Errorf("failed to fetch asset group selector seeds: %w", err)
+	} else if relationshipIDs, err := fetchPrunedRelationships(ctx, graphDB, expiredRelationshipsFilter(now, ttl), stats); err != nil {
+		pruneErr = fmt.Errorf("failed to fetch expired relationships: %w", err)
+	} else if nodeIDs, err := fetchPrunedNodes(ctx, graphDB, expiredNodesFilter(now, ttl, tags.ToKinds(), protectedObjectIDs), stats); err != nil {
+		pruneErr = fmt.Errorf("failed to fetch expired nodes: %w", err)
+	} else if softDelete {
+		if err := markStale(ctx, graphDB, nodeIDs, relationshipIDs); err != nil {
+			pruneErr = fmt.Errorf("failed to mark expired graph data stale: %w", err)
+		}
+	} else if err := deletePruned(ctx, graphDB, nodeIDs, relationshipIDs); err != nil {
+		pruneErr = fmt.Errorf("failed to delete expired graph data: %w", err)
+	}
+
+	auditStatus := model.AuditLogStatusSuccess
+	if pruneErr != nil {
+		auditStatus = model.AuditLogStatusFailure
+	}
+
+	if auditEntry, err := model.NewAuditEntry(model.AuditLogActionPruneGraph, auditStatus, stats.AuditData()); err != nil {
+		slog.ErrorContext(ctx, fmt.Sprintf("Failed to create graph pruning audit entry: %v", err))
+	} else {
+		if pruneErr != nil {
+			auditEntry.ErrorMsg = pruneErr.Error()
+		}
+
+		if err := db.AppendAuditLog(ctx, auditEntry); err != nil {
+			slog.ErrorContext(ctx, fmt.Sprintf("Failed to write graph pruning audit entry: %v", err))
+		}
+	}
+
+	return stats, pruneErr
+}
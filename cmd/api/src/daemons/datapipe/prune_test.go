@@ -0,0 +1,216 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package datapipe_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/specterops/bloodhound/dawgs"
+	"github.com/specterops/bloodhound/dawgs/drivers/memory"
+	"github.com/specterops/bloodhound/dawgs/graph"
+	"github.com/specterops/bloodhound/dawgs/ops"
+	"github.com/specterops/bloodhound/graphschema/ad"
+	"github.com/specterops/bloodhound/graphschema/common"
+	"github.com/specterops/bloodhound/src/daemons/datapipe"
+	dbmocks "github.com/specterops/bloodhound/src/database/mocks"
+	"github.com/specterops/bloodhound/src/database/types"
+	"github.com/specterops/bloodhound/src/model"
+	"github.com/specterops/bloodhound/src/model/appcfg"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type pruneTestGraph struct {
+	db                graph.Database
+	freshUser         *graph.Node
+	staleUser         *graph.Node
+	seedUser          *graph.Node
+	taggedUser        *graph.Node
+	computer          *graph.Node
+	freshSession      *graph.Relationship
+	expiredSession    *graph.Relationship
+	expiredMembership *graph.Relationship
+}
+
+// pruneTestTag is an asset group tag whose members were selected by something other than an object ID seed
+var pruneTestTag = model.AssetGroupTag{ID: 1, Type: model.AssetGroupTagTypeTier, Name: "Tier Zero"}
+
+func newPruneTestGraph(t *testing.T) pruneTestGraph {
+	var (
+		ctx       = context.Background()
+		db, err   = dawgs.Open(ctx, memory.DriverName, dawgs.Config{})
+		now       = time.Now().UTC()
+		testGraph = pruneTestGraph{
+			db: db,
+		}
+		node = func(tx graph.Transaction, objectID string, lastSeen time.Time, kinds ...graph.Kind) *graph.Node {
+			created, err := tx.CreateNode(graph.AsProperties(map[string]any{
+				common.ObjectID.String(): objectID,
+				common.LastSeen.String(): lastSeen,
+			}), kinds...)
+
+			require.Nil(t, err)
+			return created
+		}
+		relationship = func(tx graph.Transaction, start, end *graph.Node, kind graph.Kind, lastSeen time.Time) *graph.Relationship {
+			created, err := tx.CreateRelationshipByIDs(start.ID, end.ID, kind, graph.AsProperties(map[string]any{
+				common.LastSeen.String(): lastSeen,
+			}))
+
+			require.Nil(t, err)
+			return created
+		}
+	)
+
+	require.Nil(t, err)
+	require.Nil(t, db.WriteTransaction(ctx, func(tx graph.Transaction) error {
+		testGraph.freshUser = node(tx, "fresh", now, ad.Entity, ad.User)
+		testGraph.staleUser = node(tx, "stale", now.Add(-10*24*time.Hour), ad.Entity, ad.User)
+		testGraph.seedUser = node(tx, "seed", now.Add(-10*24*time.Hour), ad.Entity, ad.User)
+		testGraph.taggedUser = node(tx, "tagged", now.Add(-10*24*time.Hour), ad.Entity, ad.User, pruneTestTag.ToKind())
+		testGraph.computer = node(tx, "computer", now, ad.Entity, ad.Computer)
+
+		testGraph.freshSession = relationship(tx, testGraph.computer, testGraph.freshUser, ad.HasSession, now.Add(-2*24*time.Hour))
+		testGraph.expiredSession = relationship(tx, testGraph.computer, testGraph.seedUser, ad.HasSession, now.Add(-4*24*time.Hour))
+		testGraph.expiredMembership = relationship(tx, testGraph.freshUser, testGraph.computer, ad.AdminTo, now.Add(-8*24*time.Hour))
+
+		return nil
+	}))
+
+	return testGraph
+}
+
+func expectPruneParameters(mockDB *dbmocks.MockDatabase, softDelete bool) {
+	pruneEnabled, _ := types.NewJSONBObject(appcfg.PruneEnabledParameter{Enabled: true})
+	reconciliation, _ := types.NewJSONBObject(appcfg.ReconciliationParameter{Enabled: true})
+	pruneSoftDelete, _ := types.NewJSONBObject(appcfg.PruneSoftDeleteParameter{Enabled: softDelete})
+
+	mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.PruneEnabledKey).Return(appcfg.Parameter{Value: pruneEnabled}, nil)
+	mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.ReconciliationKey).Return(appcfg.Parameter{Value: reconciliation}, nil)
+	mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.PruneSoftDeleteKey).Return(appcfg.Parameter{Value: pruneSoftDelete}, nil)
+	mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.PruneTTL).Return(appcfg.Parameter{}, errors.New("not configured"))
+	mockDB.EXPECT().GetAssetGroupTags(gomock.Any(), model.SQLFilter{}).Return(model.AssetGroupTags{pruneTestTag}, nil)
+	mockDB.EXPECT().GetSelectorSeedObjectIDs(gomock.Any()).Return([]string{"seed"}, nil)
+}
+
+func TestPruneExpiredGraphData_Disabled(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		mockCtrl  = gomock.NewController(t)
+		mockDB    = dbmocks.NewMockDatabase(mockCtrl)
+		testGraph = newPruneTestGraph(t)
+	)
+
+	// Pruning is disabled unless explicitly enabled, even when reconciliation is enabled
+	mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.PruneEnabledKey).Return(appcfg.Parameter{}, errors.New("not configured"))
+
+	stats, err := datapipe.PruneExpiredGraphData(ctx, mockDB, testGraph.db)
+	require.Nil(t, err)
+	require.Empty(t, stats.NodesPruned)
+
+	require.Nil(t, testGraph.db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		nodeCount, err := tx.Nodes().Count()
+		require.Nil(t, err)
+		require.Equal(t, int64(5), nodeCount)
+		return nil
+	}))
+}
+
+func TestPruneExpiredGraphData(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		mockCtrl  = gomock.NewController(t)
+		mockDB    = dbmocks.NewMockDatabase(mockCtrl)
+		testGraph = newPruneTestGraph(t)
+	)
+
+	expectPruneParameters(mockDB, false)
+	mockDB.EXPECT().AppendAuditLog(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, entry model.AuditEntry) error {
+		require.Equal(t, model.AuditLogActionPruneGraph, entry.Action)
+		require.Equal(t, model.AuditLogStatusSuccess, entry.Status)
+		return nil
+	})
+
+	stats, err := datapipe.PruneExpiredGraphData(ctx, mockDB, testGraph.db)
+	require.Nil(t, err)
+	require.Equal(t, map[graph.Kind]int{ad.User: 1}, stats.NodesPruned)
+	require.Equal(t, map[graph.Kind]int{ad.HasSession: 1, ad.AdminTo: 1}, stats.RelationshipsPruned)
+
+	require.Nil(t, testGraph.db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		nodeIDs, err := ops.FetchNodeIDs(tx.Nodes())
+		require.Nil(t, err)
+		require.ElementsMatch(t, []graph.ID{testGraph.freshUser.ID, testGraph.seedUser.ID, testGraph.taggedUser.ID, testGraph.computer.ID}, nodeIDs)
+
+		relationshipIDs, err := ops.FetchRelationshipIDs(tx.Relationships())
+		require.Nil(t, err)
+		require.Equal(t, []graph.ID{testGraph.freshSession.ID}, relationshipIDs)
+
+		return nil
+	}))
+}
+
+func TestPruneExpiredGraphData_SoftDelete(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		mockCtrl  = gomock.NewController(t)
+		mockDB    = dbmocks.NewMockDatabase(mockCtrl)
+		testGraph = newPruneTestGraph(t)
+	)
+
+	expectPruneParameters(mockDB, true)
+	mockDB.EXPECT().AppendAuditLog(gomock.Any(), gomock.Any()).Return(nil)
+
+	stats, err := datapipe.PruneExpiredGraphData(ctx, mockDB, testGraph.db)
+	require.Nil(t, err)
+	require.Equal(t, map[graph.Kind]int{ad.User: 1}, stats.NodesPruned)
+
+	require.Nil(t, testGraph.db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		if staleUser, err := ops.FetchNode(tx, testGraph.staleUser.ID); err != nil {
+			return err
+		} else if freshUser, err := ops.FetchNode(tx, testGraph.freshUser.ID); err != nil {
+			return err
+		} else {
+			stale, err := staleUser.Properties.Get(common.Stale.String()).Bool()
+			require.Nil(t, err)
+			require.True(t, stale)
+			require.False(t, freshUser.Properties.Exists(common.Stale.String()))
+		}
+
+		relationshipCount, err := tx.Relationships().Count()
+		require.Nil(t, err)
+		require.Equal(t, int64(3), relationshipCount)
+
+		// Expired relationships must remain stale after the expired nodes are marked
+		for _, expired := range []*graph.Relationship{testGraph.expiredSession, testGraph.expiredMembership} {
+			relationship, err := ops.FetchRelationship(tx, expired.ID)
+			require.Nil(t, err)
+
+			stale, err := relationship.Properties.Get(common.Stale.String()).Bool()
+			require.Nil(t, err)
+			require.True(t, stale)
+		}
+
+		freshSession, err := ops.FetchRelationship(tx, testGraph.freshSession.ID)
+		require.Nil(t, err)
+		require.False(t, freshSession.Properties.Exists(common.Stale.String()))
+
+		return nil
+	}))
+}
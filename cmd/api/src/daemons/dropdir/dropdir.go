@@ -0,0 +1,309 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dropdir
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/specterops/bloodhound/src/config"
+	"github.com/specterops/bloodhound/src/database/types/null"
+	"github.com/specterops/bloodhound/src/model"
+	"github.com/specterops/bloodhound/src/services/ingest"
+	"github.com/specterops/bloodhound/src/services/ingest/storage"
+)
+
+const (
+	// ArchiveDirectory is the subdirectory of the drop directory that files are moved to once they have been queued
+	// for ingest
+	ArchiveDirectory = "archive"
+
+	// FailedDirectory is the subdirectory of the drop directory that files failing validation are moved to
+	FailedDirectory = "failed"
+
+	// MarkerSuffix is appended to the name of a dropped file to name the marker file signaling that it is complete
+	MarkerSuffix = ".done"
+
+	// movedFileTimeFormat prefixes the names of moved files so that files dropped again under the same name do not
+	// replace earlier ones
+	movedFileTimeFormat = "20060102T150405Z"
+)
+
+// Database is the application data used to queue dropped files for ingest
+type Database interface {
+	ingest.IngestData
+	LookupUser(ctx context.Context, name string) (model.User, error)
+}
+
+// droppedFile is the size and modification time of a file observed in a scan of the drop directory
+type droppedFile struct {
+	size    int64
+	modTime time.Time
+}
+
+func observeDroppedFile(info fs.FileInfo) droppedFile {
+	return droppedFile{size: info.Size(), modTime: info.ModTime()}
+}
+
+func (s droppedFile) unchanged(other droppedFile) bool {
+	return s.size == other.size && s.modTime.Equal(other.modTime)
+}
+
+// Daemon watches a drop directory for collection files, queueing them for ingest with the same validation and ingest
+// tasks as files uploaded through the API. The files found ready in a scan are ingested under a single ingest job
+// attributed to the configured service user.
+//
+// Files that were queued but could not be moved to the archive directory are remembered so that they are not queued
+// again; moving them is retried on every scan until it succeeds or the file is replaced.
+type Daemon struct {
+	exitC    chan struct{}
+	cfg      config.DropDirectoryConfiguration
+	db       Database
+	store    storage.Store
+	interval time.Duration
+	seen     map[string]droppedFile
+	queued   map[string]droppedFile
+}
+
+// NewDaemon creates a new drop directory daemon
+func NewDaemon(cfg config.DropDirectoryConfiguration, db Database, store storage.Store) *Daemon {
+	return &Daemon{
+		exitC:    make(chan struct{}),
+		cfg:      cfg,
+		db:       db,
+		store:    store,
+		interval: time.Duration(max(cfg.PollIntervalSeconds, 1)) * time.Second,
+		seen:     make(map[string]droppedFile),
+		queued:   make(map[string]droppedFile),
+	}
+}
+
+// Name returns the name of the daemon
+func (s *Daemon) Name() string {
+	return "Drop Directory Daemon"
+}
+
+// Start scans the drop directory on every poll interval until a stop signal is received in the exit channel
+func (s *Daemon) Start(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+
+	defer close(s.exitC)
+	defer ticker.Stop()
+
+	for _, subdirectory := range []string{ArchiveDirectory, FailedDirectory} {
+		if err := os.MkdirAll(filepath.Join(s.cfg.Path, subdirectory), 0755); err != nil {
+			slog.ErrorContext(ctx, fmt.Sprintf("Error creating drop directory %s: %v", subdirectory, err))
+		}
+	}
+
+	slog.InfoContext(ctx, fmt.Sprintf("Watching drop directory %s for collection files", s.cfg.Path))
+
+	for {
+		select {
+		case <-ticker.C:
+			s.scan(ctx)
+
+		case <-s.exitC:
+			return
+		}
+	}
+}
+
+// Stop passes in a stop signal to the exit channel, thereby killing the daemon
+func (s *Daemon) Stop(ctx context.Context) error {
+	s.exitC <- struct{}{}
+
+	select {
+	case <-s.exitC:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	return nil
+}
+
+// scan queues the files that are ready in the drop directory under a new ingest job
+func (s *Daemon) scan(ctx context.Context) {
+	s.archiveQueuedFiles(ctx)
+
+	ready := s.readyFiles(ctx)
+
+	if len(ready) == 0 {
+		return
+	} else if user, err := s.db.LookupUser(ctx, s.cfg.ServiceUser); err != nil {
+		slog.ErrorContext(ctx, fmt.Sprintf("Error looking up drop directory service user %s: %v", s.cfg.ServiceUser, err))
+	} else if job, err := ingest.StartIngestJob(ctx, s.db, user, null.Int32{}); err != nil {
+		slog.ErrorContext(ctx, fmt.Sprintf("Error starting ingest job for drop directory files: %v", err))
+	} else {
+		queued := 0
+
+		for _, name := range ready {
+			if err := s.queueFile(ctx, job, name); errors.Is(err, ingest.ErrInvalidIngestFile) {
+				slog.WarnContext(ctx, fmt.Sprintf("Drop directory file %s failed validation: %v", name, err))
+				if err := s.moveFile(ctx, name, FailedDirectory); err != nil {
+					slog.ErrorContext(ctx, fmt.Sprintf("Error moving drop directory file %s to %s: %v", name, FailedDirectory, err))
+				}
+			} else if err != nil {
+				// The file is left in place to be retried on the next scan
+				slog.ErrorContext(ctx, fmt.Sprintf("Error queueing drop directory file %s for ingest: %v", name, err))
+			} else {
+				queued++
+				s.archiveFile(ctx, name)
+			}
+		}
+
+		if queued == 0 {
+			if err := ingest.UpdateIngestJobStatus(ctx, s.db, job, model.JobStatusFailed, "No drop directory files could be queued for ingest"); err != nil {
+				slog.ErrorContext(ctx, fmt.Sprintf("Error updating ingest job %d: %v", job.ID, err))
+			}
+		} else if err := ingest.EndIngestJob(ctx, s.db, job); err != nil {
+			slog.ErrorContext(ctx, fmt.Sprintf("Error ending ingest job %d: %v", job.ID, err))
+		} else {
+			slog.InfoContext(ctx, fmt.Sprintf("Queued %d drop directory file(s) for ingest under job %d", queued, job.ID))
+		}
+	}
+}
+
+// readyFiles returns the names of the collection files in the drop directory that are complete. Without a marker file a
+// file is complete once its size and modification time are unchanged since the previous scan.
+func (s *Daemon) readyFiles(ctx context.Context) []string {
+	var (
+		ready   []string
+		current = make(map[string]droppedFile)
+	)
+
+	entries, err := os.ReadDir(s.cfg.Path)
+	if err != nil {
+		slog.ErrorContext(ctx, fmt.Sprintf("Error reading drop directory %s: %v", s.cfg.Path, err))
+		return nil
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+
+		if entry.IsDir() || strings.HasPrefix(name, ".") || !isCollectionFile(name) {
+			continue
+		} else if info, err := entry.Info(); err != nil {
+			// The file may have been moved away since the directory was read
+			continue
+		} else {
+			observed := observeDroppedFile(info)
+
+			if queuedFile, queued := s.queued[name]; queued && queuedFile.unchanged(observed) {
+				// The file was already queued for ingest and is waiting to be archived
+				continue
+			} else if s.cfg.RequireMarker {
+				if _, err := os.Stat(filepath.Join(s.cfg.Path, name+MarkerSuffix)); err == nil {
+					ready = append(ready, name)
+					continue
+				}
+			} else if previous, seen := s.seen[name]; seen && previous.unchanged(observed) {
+				ready = append(ready, name)
+				continue
+			}
+
+			current[name] = observed
+		}
+	}
+
+	s.seen = current
+	return ready
+}
+
+// queueFile validates a dropped file while writing it to the ingest store and creates its ingest task
+func (s *Daemon) queueFile(ctx context.Context, job model.IngestJob, name string) error {
+	fileType := model.FileTypeJson
+	if strings.EqualFold(filepath.Ext(name), ".zip") {
+		fileType = model.FileTypeZip
+	}
+
+	file, err := os.Open(filepath.Join(s.cfg.Path, name))
+	if err != nil {
+		return err
+	}
+
+	defer file.Close()
+
+	if requestID, err := uuid.NewV4(); err != nil {
+		return err
+	} else if fileName, err := ingest.StoreIngestFile(ctx, s.store, fileType, file); err != nil {
+		return err
+	} else if _, err := ingest.CreateIngestTask(ctx, s.db, fileName, fileType, requestID.String(), job.ID); err != nil {
+		if err := s.store.Remove(ctx, fileName); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			slog.ErrorContext(ctx, fmt.Sprintf("Error removing ingest file %s: %v", fileName, err))
+		}
+
+		return err
+	} else {
+		return nil
+	}
+}
+
+// archiveFile moves a queued file into the archive directory. A file that can not be moved is remembered as queued so
+// that later scans do not ingest it again.
+func (s *Daemon) archiveFile(ctx context.Context, name string) {
+	if err := s.moveFile(ctx, name, ArchiveDirectory); err == nil {
+		delete(s.queued, name)
+	} else if info, statErr := os.Stat(filepath.Join(s.cfg.Path, name)); statErr != nil {
+		slog.ErrorContext(ctx, fmt.Sprintf("Error moving drop directory file %s to %s: %v", name, ArchiveDirectory, errors.Join(err, statErr)))
+	} else {
+		slog.ErrorContext(ctx, fmt.Sprintf("Error moving drop directory file %s to %s, it will not be queued again until it is replaced: %v", name, ArchiveDirectory, err))
+		s.queued[name] = observeDroppedFile(info)
+	}
+}
+
+// archiveQueuedFiles retries moving the files that were queued for ingest by earlier scans into the archive directory.
+// Files that were removed or replaced since they were queued are forgotten.
+func (s *Daemon) archiveQueuedFiles(ctx context.Context) {
+	for name, queuedFile := range s.queued {
+		if info, err := os.Stat(filepath.Join(s.cfg.Path, name)); err != nil || !queuedFile.unchanged(observeDroppedFile(info)) {
+			delete(s.queued, name)
+		} else {
+			s.archiveFile(ctx, name)
+		}
+	}
+}
+
+// moveFile moves a dropped file into the given subdirectory of the drop directory, removing its marker file. Only
+// failing to move the file itself is returned as an error.
+func (s *Daemon) moveFile(ctx context.Context, name string, subdirectory string) error {
+	var (
+		source      = filepath.Join(s.cfg.Path, name)
+		destination = filepath.Join(s.cfg.Path, subdirectory, time.Now().UTC().Format(movedFileTimeFormat)+"-"+name)
+	)
+
+	if err := os.Rename(source, destination); err != nil {
+		return err
+	} else if err := os.Remove(source + MarkerSuffix); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		slog.ErrorContext(ctx, fmt.Sprintf("Error removing marker file of drop directory file %s: %v", name, err))
+	}
+
+	return nil
+}
+
+func isCollectionFile(name string) bool {
+	extension := filepath.Ext(name)
+	return strings.EqualFold(extension, ".json") || strings.EqualFold(extension, ".zip")
+}
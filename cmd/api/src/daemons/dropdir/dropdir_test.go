@@ -0,0 +1,205 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dropdir
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/specterops/bloodhound/src/config"
+	dbMocks "github.com/specterops/bloodhound/src/database/mocks"
+	"github.com/specterops/bloodhound/src/model"
+	"github.com/specterops/bloodhound/src/services/ingest/storage"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const validCollectionFile = `{"meta": {"type": "domains", "version": 4, "count": 1}, "data": [{"domain": "example.com"}]}`
+
+func setupDropDirectory(t *testing.T, requireMarker bool) (*Daemon, *dbMocks.MockDatabase, string, storage.Store) {
+	var (
+		mockCtrl = gomock.NewController(t)
+		mockDB   = dbMocks.NewMockDatabase(mockCtrl)
+		dropDir  = t.TempDir()
+		store    = storage.NewFilesystemStore(t.TempDir())
+		daemon   = NewDaemon(config.DropDirectoryConfiguration{Path: dropDir, ServiceUser: "collector", RequireMarker: requireMarker}, mockDB, store)
+	)
+
+	for _, subdirectory := range []string{ArchiveDirectory, FailedDirectory} {
+		require.Nil(t, os.Mkdir(filepath.Join(dropDir, subdirectory), 0755))
+	}
+
+	return daemon, mockDB, dropDir, store
+}
+
+func expectIngestJob(mockDB *dbMocks.MockDatabase, status model.JobStatus) {
+	mockDB.EXPECT().LookupUser(gomock.Any(), "collector").Return(model.User{PrincipalName: "collector"}, nil)
+	mockDB.EXPECT().CreateIngestJob(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, job model.IngestJob) (model.IngestJob, error) {
+		job.ID = 1
+		return job, nil
+	})
+
+	if status == model.JobStatusIngesting {
+		mockDB.EXPECT().EndIngestJob(gomock.Any(), int64(1)).Return(true, nil)
+	} else {
+		mockDB.EXPECT().UpdateIngestJobStatus(gomock.Any(), int64(1), status, gomock.Any()).Return(true, nil)
+	}
+}
+
+func directoryNames(t *testing.T, path string) []string {
+	entries, err := os.ReadDir(path)
+	require.Nil(t, err)
+
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			names = append(names, entry.Name())
+		}
+	}
+
+	return names
+}
+
+func TestDaemon_Scan(t *testing.T) {
+	t.Run("Queues File Once Its Size Is Stable", func(t *testing.T) {
+		daemon, mockDB, dropDir, store := setupDropDirectory(t, false)
+
+		require.Nil(t, os.WriteFile(filepath.Join(dropDir, "domains.json"), []byte(validCollectionFile[:20]), 0644))
+		daemon.scan(context.Background())
+
+		// The file is still being written so it is not picked up while it grows
+		require.Nil(t, os.WriteFile(filepath.Join(dropDir, "domains.json"), []byte(validCollectionFile), 0644))
+		daemon.scan(context.Background())
+
+		expectIngestJob(mockDB, model.JobStatusIngesting)
+		mockDB.EXPECT().CreateIngestTask(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, task model.IngestTask) (model.IngestTask, error) {
+			require.Equal(t, int64(1), task.TaskID.Int64)
+			require.Equal(t, model.FileTypeJson, task.FileType)
+
+			content, err := os.ReadFile(task.FileName)
+			require.Nil(t, err)
+			require.Equal(t, validCollectionFile, string(content))
+			return task, nil
+		})
+
+		daemon.scan(context.Background())
+
+		require.Empty(t, directoryNames(t, dropDir))
+		require.Len(t, directoryNames(t, filepath.Join(dropDir, ArchiveDirectory)), 1)
+
+		objects, err := store.List(context.Background())
+		require.Nil(t, err)
+		require.Len(t, objects, 1)
+	})
+
+	t.Run("Moves Invalid File To Failed Directory", func(t *testing.T) {
+		daemon, mockDB, dropDir, store := setupDropDirectory(t, false)
+
+		require.Nil(t, os.WriteFile(filepath.Join(dropDir, "broken.zip"), []byte("not a zip"), 0644))
+		require.Nil(t, os.WriteFile(filepath.Join(dropDir, "notes.txt"), []byte("ignored"), 0644))
+		daemon.scan(context.Background())
+
+		expectIngestJob(mockDB, model.JobStatusFailed)
+		daemon.scan(context.Background())
+
+		require.Equal(t, []string{"notes.txt"}, directoryNames(t, dropDir))
+		require.Len(t, directoryNames(t, filepath.Join(dropDir, FailedDirectory)), 1)
+
+		objects, err := store.List(context.Background())
+		require.Nil(t, err)
+		require.Empty(t, objects)
+	})
+
+	t.Run("Waits For Marker File", func(t *testing.T) {
+		daemon, mockDB, dropDir, _ := setupDropDirectory(t, true)
+
+		require.Nil(t, os.WriteFile(filepath.Join(dropDir, "domains.json"), []byte(validCollectionFile), 0644))
+		daemon.scan(context.Background())
+		daemon.scan(context.Background())
+
+		require.Nil(t, os.WriteFile(filepath.Join(dropDir, "domains.json"+MarkerSuffix), nil, 0644))
+
+		expectIngestJob(mockDB, model.JobStatusIngesting)
+		mockDB.EXPECT().CreateIngestTask(gomock.Any(), gomock.Any()).Return(model.IngestTask{}, nil)
+
+		daemon.scan(context.Background())
+
+		require.Empty(t, directoryNames(t, dropDir))
+		require.Len(t, directoryNames(t, filepath.Join(dropDir, ArchiveDirectory)), 1)
+	})
+
+	t.Run("Leaves File In Place When Task Creation Fails", func(t *testing.T) {
+		daemon, mockDB, dropDir, store := setupDropDirectory(t, false)
+
+		require.Nil(t, os.WriteFile(filepath.Join(dropDir, "domains.json"), []byte(validCollectionFile), 0644))
+		daemon.scan(context.Background())
+
+		expectIngestJob(mockDB, model.JobStatusFailed)
+		mockDB.EXPECT().CreateIngestTask(gomock.Any(), gomock.Any()).Return(model.IngestTask{}, errors.New("database error"))
+
+		daemon.scan(context.Background())
+
+		require.Equal(t, []string{"domains.json"}, directoryNames(t, dropDir))
+
+		objects, err := store.List(context.Background())
+		require.Nil(t, err)
+		require.Empty(t, objects)
+	})
+
+	t.Run("Does Not Queue File Again When Archiving Fails", func(t *testing.T) {
+		daemon, mockDB, dropDir, store := setupDropDirectory(t, false)
+
+		require.Nil(t, os.Remove(filepath.Join(dropDir, ArchiveDirectory)))
+		require.Nil(t, os.WriteFile(filepath.Join(dropDir, "domains.json"), []byte(validCollectionFile), 0644))
+		daemon.scan(context.Background())
+
+		expectIngestJob(mockDB, model.JobStatusIngesting)
+		mockDB.EXPECT().CreateIngestTask(gomock.Any(), gomock.Any()).Return(model.IngestTask{}, nil).Times(1)
+
+		daemon.scan(context.Background())
+		require.Equal(t, []string{"domains.json"}, directoryNames(t, dropDir))
+
+		// Later scans only retry archiving the queued file
+		daemon.scan(context.Background())
+		daemon.scan(context.Background())
+
+		require.Nil(t, os.Mkdir(filepath.Join(dropDir, ArchiveDirectory), 0755))
+		daemon.scan(context.Background())
+
+		require.Empty(t, directoryNames(t, dropDir))
+		require.Len(t, directoryNames(t, filepath.Join(dropDir, ArchiveDirectory)), 1)
+
+		objects, err := store.List(context.Background())
+		require.Nil(t, err)
+		require.Len(t, objects, 1)
+	})
+
+	t.Run("Skips Scan When Service User Is Missing", func(t *testing.T) {
+		daemon, mockDB, dropDir, _ := setupDropDirectory(t, false)
+
+		require.Nil(t, os.WriteFile(filepath.Join(dropDir, "domains.json"), []byte(validCollectionFile), 0644))
+		daemon.scan(context.Background())
+
+		mockDB.EXPECT().LookupUser(gomock.Any(), "collector").Return(model.User{}, errors.New("entity not found"))
+		daemon.scan(context.Background())
+
+		require.Equal(t, []string{"domains.json"}, directoryNames(t, dropDir))
+	})
+}
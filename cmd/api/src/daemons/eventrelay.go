@@ -0,0 +1,169 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package daemons
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/specterops/bloodhound/src/events"
+)
+
+const (
+	// EventRelayRetryInterval is how long replicas wait before listening for relayed events again after losing their
+	// listening connection
+	EventRelayRetryInterval = 5 * time.Second
+
+	// maxRelayedEventSize is the largest relayed event payload that a PostgreSQL notification can carry
+	maxRelayedEventSize = 8000
+
+	relayBufferSize = 256
+)
+
+// ClusterEvents is the shared channel over which replicas relay events to each other
+type ClusterEvents interface {
+	NotifyClusterEvent(ctx context.Context, payload string) error
+	ListenClusterEvents(ctx context.Context, handler func(payload string)) error
+}
+
+// EventRelayDaemon relays the events published to the bus of this replica to every other replica and delivers the
+// events relayed by other replicas to the bus of this one. This lets any replica serve the event stream even though
+// only the leader runs the datapipe.
+type EventRelayDaemon struct {
+	channel       ClusterEvents
+	bus           *events.Bus
+	origin        string
+	retryInterval time.Duration
+	outC          chan events.Event
+	exitC         chan struct{}
+	doneC         chan struct{}
+}
+
+func NewEventRelayDaemon(nodeID string, channel ClusterEvents, bus *events.Bus, retryInterval time.Duration) *EventRelayDaemon {
+	// Events are relayed from an origin unique to this process so that replicas sharing a node ID do not ignore each
+	// other's events
+	origin := nodeID
+
+	if instanceID, err := uuid.NewV4(); err == nil {
+		origin = nodeID + "/" + instanceID.String()
+	}
+
+	return &EventRelayDaemon{
+		channel:       channel,
+		bus:           bus,
+		origin:        origin,
+		retryInterval: retryInterval,
+		outC:          make(chan events.Event, relayBufferSize),
+		exitC:         make(chan struct{}),
+		doneC:         make(chan struct{}),
+	}
+}
+
+func (s *EventRelayDaemon) Name() string {
+	return "Event Relay Daemon"
+}
+
+// Send queues an event published on this replica for relaying. Like subscribers of the bus, other replicas miss events
+// when the relay does not keep up.
+func (s *EventRelayDaemon) Send(event events.Event) {
+	select {
+	case s.outC <- event:
+	default:
+		slog.Warn(fmt.Sprintf("Event relay buffer is full; dropping %s event %d", event.Type, event.ID))
+	}
+}
+
+func (s *EventRelayDaemon) notify(ctx context.Context, event events.Event) {
+	if payload, err := events.EncodeRelayed(s.origin, event); err != nil {
+		slog.ErrorContext(ctx, fmt.Sprintf("Failed encoding %s event %d for relaying: %v", event.Type, event.ID, err))
+	} else if len(payload) > maxRelayedEventSize {
+		slog.WarnContext(ctx, fmt.Sprintf("Not relaying %s event %d as its payload of %d bytes is too large", event.Type, event.ID, len(payload)))
+	} else if err := s.channel.NotifyClusterEvent(ctx, string(payload)); err != nil {
+		slog.ErrorContext(ctx, fmt.Sprintf("Failed relaying %s event %d: %v", event.Type, event.ID, err))
+	}
+}
+
+// deliver publishes an event relayed by another replica to the bus of this one. Events relayed by this replica are
+// ignored as they have already been published to its bus.
+func (s *EventRelayDaemon) deliver(payload string) {
+	if origin, event, err := events.DecodeRelayed([]byte(payload)); err != nil {
+		slog.Error(fmt.Sprintf("Failed decoding relayed event: %v", err))
+	} else if origin != s.origin {
+		s.bus.Deliver(event)
+	}
+}
+
+func (s *EventRelayDaemon) listen(ctx context.Context) {
+	for {
+		if err := s.channel.ListenClusterEvents(ctx, s.deliver); ctx.Err() != nil {
+			return
+		} else {
+			slog.ErrorContext(ctx, fmt.Sprintf("Stopped listening for relayed events: %v", err))
+		}
+
+		select {
+		case <-time.After(s.retryInterval):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *EventRelayDaemon) Start(ctx context.Context) {
+	relayCtx, cancel := context.WithCancel(ctx)
+	listenDoneC := make(chan struct{})
+
+	defer close(s.doneC)
+	defer func() {
+		cancel()
+		<-listenDoneC
+	}()
+
+	go func() {
+		defer close(listenDoneC)
+		s.listen(relayCtx)
+	}()
+
+	for {
+		select {
+		case event := <-s.outC:
+			s.notify(relayCtx, event)
+		case <-s.exitC:
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *EventRelayDaemon) Stop(ctx context.Context) error {
+	select {
+	case <-s.exitC:
+	default:
+		close(s.exitC)
+	}
+
+	select {
+	case <-s.doneC:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package daemons_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/specterops/bloodhound/src/daemons"
+	"github.com/specterops/bloodhound/src/events"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClusterEvents delivers every notified payload to every listener, as PostgreSQL does for a notification channel
+type fakeClusterEvents struct {
+	lock      sync.Mutex
+	listeners []func(payload string)
+}
+
+func (s *fakeClusterEvents) NotifyClusterEvent(ctx context.Context, payload string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, listener := range s.listeners {
+		listener(payload)
+	}
+
+	return nil
+}
+
+func (s *fakeClusterEvents) ListenClusterEvents(ctx context.Context, handler func(payload string)) error {
+	s.lock.Lock()
+	s.listeners = append(s.listeners, handler)
+	s.lock.Unlock()
+
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func (s *fakeClusterEvents) numListeners() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return len(s.listeners)
+}
+
+func TestEventRelayDaemon(t *testing.T) {
+	var (
+		ctx, cancel   = context.WithCancel(context.Background())
+		clusterEvents = &fakeClusterEvents{}
+		leaderBus     = events.NewBus(events.DefaultHistorySize)
+		followerBus   = events.NewBus(events.DefaultHistorySize)
+		leaderRelay   = daemons.NewEventRelayDaemon("node", clusterEvents, leaderBus, testHeartbeatInterval)
+		followerRelay = daemons.NewEventRelayDaemon("node", clusterEvents, followerBus, testHeartbeatInterval)
+	)
+
+	defer cancel()
+
+	leaderBus.SetRelay(leaderRelay)
+	followerBus.SetRelay(followerRelay)
+
+	go leaderRelay.Start(ctx)
+	go followerRelay.Start(ctx)
+
+	require.Eventually(t, func() bool { return clusterEvents.numListeners() == 2 }, testTimeout, time.Millisecond)
+
+	var (
+		leaderEvents   = leaderBus.Subscribe(ctx, 0)
+		followerEvents = followerBus.Subscribe(ctx, 0)
+		published      = leaderBus.Publish(events.TypeIngestTaskStarted, events.IngestProgress{TaskID: 1, WorkspaceID: 2})
+	)
+
+	select {
+	case relayed := <-followerEvents:
+		require.Equal(t, published.Type, relayed.Type)
+		require.Equal(t, published.Data, relayed.Data)
+		require.True(t, relayed.InWorkspace(2))
+	case <-time.After(testTimeout):
+		require.Fail(t, "event was not relayed to the follower")
+	}
+
+	// Replicas sharing a node ID still relay to each other, but the publishing replica does not deliver its own
+	// events twice
+	require.Equal(t, published, <-leaderEvents)
+	require.Never(t, func() bool { return len(leaderEvents) > 0 }, 5*testHeartbeatInterval, testHeartbeatInterval)
+
+	require.Nil(t, leaderRelay.Stop(ctx))
+	require.Nil(t, followerRelay.Stop(ctx))
+}
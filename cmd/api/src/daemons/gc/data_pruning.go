@@ -49,9 +49,10 @@ func (s *Daemon) Start(ctx context.Context) {
 	defer close(s.exitC)
 	defer ticker.Stop()
 
-	// prune sessions and collections once when the daemon starts up
+	// prune sessions, collections and cluster nodes once when the daemon starts up
 	s.db.SweepSessions(ctx)
 	s.db.SweepAssetGroupCollections(ctx)
+	s.db.SweepClusterNodes(ctx)
 
 	// thereafter, prune conditionally once a day
 	for {
@@ -59,6 +60,7 @@ func (s *Daemon) Start(ctx context.Context) {
 		case <-ticker.C:
 			s.db.SweepSessions(ctx)
 			s.db.SweepAssetGroupCollections(ctx)
+			s.db.SweepClusterNodes(ctx)
 
 		case <-s.exitC:
 			return
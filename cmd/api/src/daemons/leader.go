@@ -0,0 +1,232 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package daemons
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/specterops/bloodhound/src/database"
+	"github.com/specterops/bloodhound/src/model"
+)
+
+const (
+	// LeaderHeartbeatInterval is how often replicas record a heartbeat and, unless they already lead, attempt to take
+	// the leader lock
+	LeaderHeartbeatInterval = 10 * time.Second
+
+	// ClusterNodeTimeout is how long a replica is considered alive after its last heartbeat
+	ClusterNodeTimeout = 3 * LeaderHeartbeatInterval
+)
+
+// LeaderElection is the shared store through which replicas compete for the leader lock and record their heartbeats
+type LeaderElection interface {
+	TryAcquireLeaderLock(ctx context.Context) (database.LeaderLock, bool, error)
+	UpsertClusterNode(ctx context.Context, node model.ClusterNode) error
+}
+
+// LeaderDaemonsFactory creates the daemons run by the leader. The daemons are created anew each time this replica
+// takes leadership and the given context is canceled when leadership is lost.
+type LeaderDaemonsFactory func(ctx context.Context) []Daemon
+
+// LeaderElectedDaemon runs a set of daemons only while this replica holds the leader lock. This allows any number of
+// replicas to serve the API while a single replica processes ingest tasks, runs analysis and prunes data.
+type LeaderElectedDaemon struct {
+	election          LeaderElection
+	factory           LeaderDaemonsFactory
+	node              model.ClusterNode
+	heartbeatInterval time.Duration
+	shutdownTimeout   time.Duration
+	exitC             chan struct{}
+	doneC             chan struct{}
+}
+
+func NewLeaderElectedDaemon(nodeID string, election LeaderElection, heartbeatInterval, shutdownTimeout time.Duration, factory LeaderDaemonsFactory) *LeaderElectedDaemon {
+	hostname, _ := os.Hostname()
+
+	return &LeaderElectedDaemon{
+		election: election,
+		factory:  factory,
+		node: model.ClusterNode{
+			NodeID:    nodeID,
+			Hostname:  hostname,
+			StartedAt: time.Now().UTC(),
+		},
+		heartbeatInterval: heartbeatInterval,
+		shutdownTimeout:   shutdownTimeout,
+		exitC:             make(chan struct{}),
+		doneC:             make(chan struct{}),
+	}
+}
+
+func (s *LeaderElectedDaemon) Name() string {
+	return "Leader Election Daemon"
+}
+
+// leaderTerm is a period during which this replica holds the leader lock and runs the leader daemons
+type leaderTerm struct {
+	lock    database.LeaderLock
+	manager *Manager
+	ctx     context.Context
+	cancel  context.CancelFunc
+}
+
+// verify pings the leader lock on the connection that holds it. The term is canceled the moment a ping fails so that
+// the leader daemons stop before another replica can take over.
+func (s *leaderTerm) verify(ctx context.Context) error {
+	if err := s.ctx.Err(); err != nil {
+		return fmt.Errorf("leader term ended: %w", err)
+	} else if err := s.lock.Ping(ctx); err != nil {
+		s.cancel()
+		return fmt.Errorf("leader lock lost: %w", err)
+	}
+
+	return nil
+}
+
+type leaderTermKey struct{}
+
+// VerifyLeadership checks that the leader lock is still held by the term that the given context belongs to. It should
+// be called before each unit of work that must only be performed by the leader. The term, and the given context along
+// with it, is canceled if the lock has been lost. Contexts that do not belong to a leader term are always verified.
+func VerifyLeadership(ctx context.Context) error {
+	if term, isLeaderCtx := ctx.Value(leaderTermKey{}).(*leaderTerm); isLeaderCtx {
+		return term.verify(ctx)
+	}
+
+	return nil
+}
+
+func (s *LeaderElectedDaemon) startTerm(ctx context.Context, lock database.LeaderLock) *leaderTerm {
+	var (
+		termCtx, cancel = context.WithCancel(ctx)
+		term            = &leaderTerm{
+			lock:    lock,
+			manager: NewManager(s.shutdownTimeout),
+			cancel:  cancel,
+		}
+	)
+
+	termCtx = context.WithValue(termCtx, leaderTermKey{}, term)
+	term.ctx = termCtx
+
+	slog.InfoContext(ctx, fmt.Sprintf("Node %s acquired the leader lock", s.node.NodeID))
+	term.manager.Start(termCtx, s.factory(termCtx)...)
+
+	return term
+}
+
+func (s *LeaderElectedDaemon) endTerm(term *leaderTerm) {
+	term.cancel()
+	term.manager.Stop()
+
+	releaseCtx, cancel := context.WithTimeout(context.Background(), s.shutdownTimeout)
+	defer cancel()
+
+	if err := term.lock.Release(releaseCtx); err != nil {
+		slog.Error(fmt.Sprintf("Failed releasing the leader lock: %v", err))
+	} else {
+		slog.Info(fmt.Sprintf("Node %s released the leader lock", s.node.NodeID))
+	}
+}
+
+// elect takes the leader lock if it is free, or verifies that it is still held if this replica already leads. It
+// returns the current leader term or nil if this replica does not lead.
+func (s *LeaderElectedDaemon) elect(ctx context.Context, term *leaderTerm) *leaderTerm {
+	if term != nil {
+		if err := term.verify(ctx); err != nil {
+			slog.ErrorContext(ctx, fmt.Sprintf("Node %s lost the leader lock: %v", s.node.NodeID, err))
+			s.endTerm(term)
+			return nil
+		}
+
+		return term
+	}
+
+	if lock, acquired, err := s.election.TryAcquireLeaderLock(ctx); err != nil {
+		slog.ErrorContext(ctx, fmt.Sprintf("Failed attempting to acquire the leader lock: %v", err))
+	} else if acquired {
+		return s.startTerm(ctx, lock)
+	}
+
+	return nil
+}
+
+func (s *LeaderElectedDaemon) heartbeat(ctx context.Context, isLeader bool) {
+	s.node.IsLeader = isLeader
+	s.node.LastHeartbeatAt = time.Now().UTC()
+
+	if err := s.election.UpsertClusterNode(ctx, s.node); err != nil {
+		slog.ErrorContext(ctx, fmt.Sprintf("Failed recording heartbeat for node %s: %v", s.node.NodeID, err))
+	}
+}
+
+func (s *LeaderElectedDaemon) Start(ctx context.Context) {
+	var (
+		ticker = time.NewTicker(s.heartbeatInterval)
+		term   *leaderTerm
+	)
+
+	defer close(s.doneC)
+	defer ticker.Stop()
+
+	for {
+		term = s.elect(ctx, term)
+		s.heartbeat(ctx, term != nil)
+
+		select {
+		case <-ticker.C:
+		case <-s.exitC:
+			s.shutdown(term)
+			return
+		case <-ctx.Done():
+			s.shutdown(term)
+			return
+		}
+	}
+}
+
+func (s *LeaderElectedDaemon) shutdown(term *leaderTerm) {
+	if term != nil {
+		s.endTerm(term)
+	}
+
+	// Record that this replica no longer leads so that the cluster status does not report a stopped leader
+	heartbeatCtx, cancel := context.WithTimeout(context.Background(), s.shutdownTimeout)
+	defer cancel()
+
+	s.heartbeat(heartbeatCtx, false)
+}
+
+// Stop ends the leader term, if any, and waits for the leader daemons to stop
+func (s *LeaderElectedDaemon) Stop(ctx context.Context) error {
+	select {
+	case <-s.exitC:
+	default:
+		close(s.exitC)
+	}
+
+	select {
+	case <-s.doneC:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
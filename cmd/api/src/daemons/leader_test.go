@@ -0,0 +1,272 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package daemons_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/specterops/bloodhound/src/daemons"
+	"github.com/specterops/bloodhound/src/database"
+	"github.com/specterops/bloodhound/src/model"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testHeartbeatInterval = 10 * time.Millisecond
+	testTimeout           = time.Second
+)
+
+type fakeLock struct {
+	lock     sync.Mutex
+	pingErr  error
+	released bool
+}
+
+func (s *fakeLock) Ping(ctx context.Context) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.pingErr
+}
+
+func (s *fakeLock) Release(ctx context.Context) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.released = true
+	return nil
+}
+
+func (s *fakeLock) lose() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.pingErr = errors.New("connection lost")
+}
+
+func (s *fakeLock) isReleased() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.released
+}
+
+type fakeElection struct {
+	lock       sync.Mutex
+	leaderLock *fakeLock
+	heldByPeer bool
+	heartbeats []model.ClusterNode
+}
+
+func (s *fakeElection) TryAcquireLeaderLock(ctx context.Context) (database.LeaderLock, bool, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.heldByPeer {
+		return nil, false, nil
+	}
+
+	s.leaderLock = &fakeLock{}
+	return s.leaderLock, true, nil
+}
+
+func (s *fakeElection) UpsertClusterNode(ctx context.Context, node model.ClusterNode) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.heartbeats = append(s.heartbeats, node)
+	return nil
+}
+
+func (s *fakeElection) currentLock() *fakeLock {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.leaderLock
+}
+
+func (s *fakeElection) lastHeartbeat() (model.ClusterNode, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if len(s.heartbeats) == 0 {
+		return model.ClusterNode{}, false
+	}
+
+	return s.heartbeats[len(s.heartbeats)-1], true
+}
+
+type recordingDaemon struct {
+	lock    sync.Mutex
+	started int
+	stopped int
+}
+
+func (s *recordingDaemon) Name() string {
+	return "Recording Daemon"
+}
+
+func (s *recordingDaemon) Start(ctx context.Context) {
+	s.lock.Lock()
+	s.started++
+	s.lock.Unlock()
+
+	<-ctx.Done()
+}
+
+func (s *recordingDaemon) Stop(ctx context.Context) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.stopped++
+	return nil
+}
+
+func (s *recordingDaemon) counts() (int, int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.started, s.stopped
+}
+
+func isLeader(election *fakeElection) func() bool {
+	return func() bool {
+		heartbeat, found := election.lastHeartbeat()
+		return found && heartbeat.IsLeader
+	}
+}
+
+func TestLeaderElectedDaemon(t *testing.T) {
+	t.Run("Leader Runs Daemons", func(t *testing.T) {
+		var (
+			election = &fakeElection{}
+			leader   = &recordingDaemon{}
+			daemon   = daemons.NewLeaderElectedDaemon("node-a", election, testHeartbeatInterval, testTimeout, func(ctx context.Context) []daemons.Daemon {
+				return []daemons.Daemon{leader}
+			})
+		)
+
+		go daemon.Start(context.Background())
+
+		require.Eventually(t, isLeader(election), testTimeout, testHeartbeatInterval)
+		require.Eventually(t, func() bool {
+			started, _ := leader.counts()
+			return started == 1
+		}, testTimeout, testHeartbeatInterval)
+
+		require.Nil(t, daemon.Stop(context.Background()))
+
+		started, stopped := leader.counts()
+		require.Equal(t, 1, started)
+		require.Equal(t, 1, stopped)
+		require.True(t, election.currentLock().isReleased())
+
+		// The final heartbeat records that the stopped replica no longer leads
+		heartbeat, _ := election.lastHeartbeat()
+		require.Equal(t, "node-a", heartbeat.NodeID)
+		require.False(t, heartbeat.IsLeader)
+	})
+
+	t.Run("Follower Does Not Run Daemons", func(t *testing.T) {
+		var (
+			election = &fakeElection{heldByPeer: true}
+			leader   = &recordingDaemon{}
+			daemon   = daemons.NewLeaderElectedDaemon("node-a", election, testHeartbeatInterval, testTimeout, func(ctx context.Context) []daemons.Daemon {
+				return []daemons.Daemon{leader}
+			})
+		)
+
+		go daemon.Start(context.Background())
+
+		require.Eventually(t, func() bool {
+			_, found := election.lastHeartbeat()
+			return found
+		}, testTimeout, testHeartbeatInterval)
+		require.Never(t, isLeader(election), 5*testHeartbeatInterval, testHeartbeatInterval)
+
+		require.Nil(t, daemon.Stop(context.Background()))
+
+		started, _ := leader.counts()
+		require.Zero(t, started)
+		require.Nil(t, election.currentLock())
+	})
+
+	t.Run("Lost Lock Stops Daemons", func(t *testing.T) {
+		var (
+			ctx, cancel = context.WithCancel(context.Background())
+			election    = &fakeElection{}
+			leader      = &recordingDaemon{}
+			daemon      = daemons.NewLeaderElectedDaemon("node-a", election, testHeartbeatInterval, testTimeout, func(ctx context.Context) []daemons.Daemon {
+				return []daemons.Daemon{leader}
+			})
+		)
+
+		defer cancel()
+		go daemon.Start(ctx)
+
+		require.Eventually(t, isLeader(election), testTimeout, testHeartbeatInterval)
+
+		// Another replica takes the lock once the session holding it is lost
+		lostLock := election.currentLock()
+
+		election.lock.Lock()
+		election.heldByPeer = true
+		election.lock.Unlock()
+
+		lostLock.lose()
+
+		require.Eventually(t, func() bool {
+			_, stopped := leader.counts()
+			return stopped == 1
+		}, testTimeout, testHeartbeatInterval)
+		require.True(t, lostLock.isReleased())
+		require.Eventually(t, func() bool {
+			return !isLeader(election)()
+		}, testTimeout, testHeartbeatInterval)
+	})
+	t.Run("Verifying Lost Lock Cancels Term", func(t *testing.T) {
+		var (
+			election  = &fakeElection{}
+			leaderCtx = make(chan context.Context, 1)
+			// Heartbeats are too far apart to notice the lost lock within the test
+			daemon = daemons.NewLeaderElectedDaemon("node-a", election, time.Hour, testTimeout, func(ctx context.Context) []daemons.Daemon {
+				leaderCtx <- ctx
+				return []daemons.Daemon{&recordingDaemon{}}
+			})
+		)
+
+		go daemon.Start(context.Background())
+
+		termCtx := <-leaderCtx
+		require.Nil(t, daemons.VerifyLeadership(termCtx))
+		require.Nil(t, termCtx.Err())
+
+		election.currentLock().lose()
+
+		require.NotNil(t, daemons.VerifyLeadership(termCtx))
+		require.NotNil(t, termCtx.Err())
+
+		// Contexts outside of a leader term are not verified
+		require.Nil(t, daemons.VerifyLeadership(context.Background()))
+
+		require.Nil(t, daemon.Stop(context.Background()))
+	})
+}
@@ -0,0 +1,60 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package database
+
+import (
+	"context"
+
+	"github.com/specterops/bloodhound/src/model"
+)
+
+type AnalysisRunData interface {
+	CreateAnalysisRun(ctx context.Context, run model.AnalysisRun) (model.AnalysisRun, error)
+	UpdateAnalysisRun(ctx context.Context, run model.AnalysisRun) error
+	GetAnalysisRuns(ctx context.Context, skip int, limit int, order string) (model.AnalysisRuns, int, error)
+}
+
+func (s *BloodhoundDB) CreateAnalysisRun(ctx context.Context, run model.AnalysisRun) (model.AnalysisRun, error) {
+	result := s.db.WithContext(ctx).Create(&run)
+	return run, CheckError(result)
+}
+
+func (s *BloodhoundDB) UpdateAnalysisRun(ctx context.Context, run model.AnalysisRun) error {
+	result := s.db.WithContext(ctx).Save(&run)
+	return CheckError(result)
+}
+
+// GetAnalysisRuns returns a page of analysis runs along with the total number of runs recorded. Runs are ordered by
+// when they started, most recent first, unless another order is given.
+func (s *BloodhoundDB) GetAnalysisRuns(ctx context.Context, skip int, limit int, order string) (model.AnalysisRuns, int, error) {
+	var (
+		runs  model.AnalysisRuns
+		count int64
+	)
+
+	if order == "" {
+		order = "started_at desc"
+	}
+
+	if result := s.db.Model(model.AnalysisRun{}).WithContext(ctx).Count(&count); result.Error != nil {
+		return nil, 0, CheckError(result)
+	} else if result := s.Scope(Paginate(skip, limit)).WithContext(ctx).Order(order).Find(&runs); result.Error != nil {
+		return nil, int(count), CheckError(result)
+	} else {
+		return runs, int(count), nil
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build integration
+// +build integration
+
+package database_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/specterops/bloodhound/src/database/types/null"
+	"github.com/specterops/bloodhound/src/model"
+	"github.com/specterops/bloodhound/src/test/integration"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatabase_AnalysisRuns(t *testing.T) {
+	var (
+		testCtx = context.Background()
+		dbInst  = integration.SetupDB(t)
+		now     = time.Now().UTC()
+	)
+
+	first, err := dbInst.CreateAnalysisRun(testCtx, model.AnalysisRun{
+		Trigger:   model.AnalysisRunTriggerIngest,
+		Status:    model.AnalysisRunStatusRunning,
+		StartedAt: now.Add(-time.Hour),
+	})
+	require.Nil(t, err)
+	require.NotZero(t, first.ID)
+
+	first.Status = model.AnalysisRunStatusPartiallyComplete
+	first.CompletedAt = null.TimeFrom(now)
+	first.Steps = model.AnalysisRunSteps{{Name: "ad_post_processing", StartedAt: now, DurationMS: 1500}}
+	first.EdgesCreated = model.AnalysisRunEdgeCounts{"ADCSESC1": 3}
+	first.Errors = model.AnalysisRunErrors{"error during azure post: failed"}
+	require.Nil(t, dbInst.UpdateAnalysisRun(testCtx, first))
+
+	_, err = dbInst.CreateAnalysisRun(testCtx, model.AnalysisRun{
+		Trigger:     model.AnalysisRunTriggerUserRequest,
+		RequestedBy: "user",
+		Status:      model.AnalysisRunStatusRunning,
+		StartedAt:   now,
+	})
+	require.Nil(t, err)
+
+	runs, count, err := dbInst.GetAnalysisRuns(testCtx, 0, 10, "")
+	require.Nil(t, err)
+	require.Equal(t, 2, count)
+	require.Len(t, runs, 2)
+
+	// The most recent run is returned first
+	require.Equal(t, model.AnalysisRunTriggerUserRequest, runs[0].Trigger)
+	require.Equal(t, "user", runs[0].RequestedBy)
+	require.Empty(t, runs[0].Steps)
+
+	require.Equal(t, first.ID, runs[1].ID)
+	require.Equal(t, model.AnalysisRunStatusPartiallyComplete, runs[1].Status)
+	require.True(t, runs[1].CompletedAt.Valid)
+	require.Equal(t, int64(1500), runs[1].Steps[0].DurationMS)
+	require.Equal(t, int64(3), runs[1].EdgesCreated["ADCSESC1"])
+	require.Equal(t, model.AnalysisRunErrors{"error during azure post: failed"}, runs[1].Errors)
+
+	runs, count, err = dbInst.GetAnalysisRuns(testCtx, 1, 10, "")
+	require.Nil(t, err)
+	require.Equal(t, 2, count)
+	require.Len(t, runs, 1)
+}
@@ -19,10 +19,13 @@ package database
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/specterops/bloodhound/src/database/types/null"
 	"github.com/specterops/bloodhound/src/model"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 const (
@@ -33,6 +36,11 @@ const (
 type AssetGroupTagData interface {
 	CreateAssetGroupTag(ctx context.Context, tagType model.AssetGroupTagType, userId string, name string, description string, position null.Int32, requireCertify null.Bool) (model.AssetGroupTag, error)
 	GetAssetGroupTag(ctx context.Context, assetGroupTagId int) (model.AssetGroupTag, error)
+	GetAssetGroupTags(ctx context.Context, sqlFilter model.SQLFilter) (model.AssetGroupTags, error)
+	UpdateAssetGroupTag(ctx context.Context, userId string, tag model.AssetGroupTag) (model.AssetGroupTag, error)
+	DeleteAssetGroupTag(ctx context.Context, userId string, tag model.AssetGroupTag) error
+	GetAssetGroupTagCertifications(ctx context.Context, assetGroupTagId int) (model.AssetGroupTagCertifications, error)
+	UpdateAssetGroupTagCertification(ctx context.Context, userId string, certification model.AssetGroupTagCertification) (model.AssetGroupTagCertification, error)
 }
 
 // AssetGroupTagSelectorData defines the methods required to interact with the asset_group_tag_selectors and asset_group_tag_selector_seeds tables
@@ -41,6 +49,9 @@ type AssetGroupTagSelectorData interface {
 	GetAssetGroupTagSelectorBySelectorId(ctx context.Context, assetGroupTagSelectorId int) (model.AssetGroupTagSelector, error)
 	UpdateAssetGroupTagSelector(ctx context.Context, userId string, selector model.AssetGroupTagSelector) (model.AssetGroupTagSelector, error)
 	GetAssetGroupTagSelectorsByTagId(ctx context.Context, assetGroupTagId int, selectorSqlFilter, selectorSeedSqlFilter model.SQLFilter) (model.AssetGroupTagSelectors, error)
+	GetSelectorSeedObjectIDs(ctx context.Context) ([]string, error)
+	GetAssetGroupTagSelectorNodes(ctx context.Context, selectorId int) ([]model.AssetGroupTagSelectorNode, error)
+	UpdateAssetGroupTagSelectorNodes(ctx context.Context, selectorId int, nodes []model.AssetGroupTagSelectorNode) error
 }
 
 func insertSelectorSeeds(tx *gorm.DB, selectorId int, seeds []model.SelectorSeed) ([]model.SelectorSeed, error) {
@@ -160,13 +171,15 @@ func (s *BloodhoundDB) UpdateAssetGroupTagSelector(ctx context.Context, userId s
 
 func (s *BloodhoundDB) GetAssetGroupTag(ctx context.Context, assetGroupTagId int) (model.AssetGroupTag, error) {
 	var tag model.AssetGroupTag
-	if result := s.db.WithContext(ctx).Raw(fmt.Sprintf("SELECT id, type, kind_id, name, description, created_at, created_by, updated_at, updated_by, position, require_certify FROM %s WHERE id = ? AND deleted_at IS NULL", tag.TableName()), assetGroupTagId).First(&tag); result.Error != nil {
+	if result := s.db.WithContext(ctx).Raw(fmt.Sprintf("SELECT %s FROM %s WHERE id = ? AND deleted_at IS NULL", assetGroupTagColumns, tag.TableName()), assetGroupTagId).First(&tag); result.Error != nil {
 		return model.AssetGroupTag{}, CheckError(result)
 	} else {
 		return tag, nil
 	}
 }
 
+// CreateAssetGroupTag creates a tag. A tier is placed at the end of the tier order unless a position is given, in which
+// case the tiers from that position onwards are shifted up.
 func (s *BloodhoundDB) CreateAssetGroupTag(ctx context.Context, tagType model.AssetGroupTagType, userId string, name string, description string, position null.Int32, requireCertify null.Bool) (model.AssetGroupTag, error) {
 	var (
 		tag = model.AssetGroupTag{
@@ -192,15 +205,32 @@ func (s *BloodhoundDB) CreateAssetGroupTag(ctx context.Context, tagType model.As
 	if err := s.AuditableTransaction(ctx, auditEntry, func(tx *gorm.DB) error {
 		bhdb := NewBloodhoundDB(tx, s.idResolver)
 
+		if tagType == model.AssetGroupTagTypeTier {
+			if maxPosition, err := lockAssetGroupTiers(tx); err != nil {
+				return err
+			} else if !position.Valid {
+				position = null.Int32From(maxPosition + 1)
+			} else if err := validateAssetGroupTierPosition(position.Int32, maxPosition+1); err != nil {
+				return err
+			}
+		}
+
+		// The kind of a deleted tag is reused by a new tag of the same name. Deleting a tag strips its kind from the
+		// graph so that the new tag does not inherit the members of the deleted one.
 		var kindId int
-		if result := tx.Raw(fmt.Sprintf("INSERT INTO %s (name) VALUES (?) RETURNING id", kindTable), tag.ToKind()).Scan(&kindId); result.Error != nil {
+		if result := tx.Raw(fmt.Sprintf("INSERT INTO %s (name) VALUES (?) ON CONFLICT (name) DO UPDATE SET name = EXCLUDED.name RETURNING id", kindTable), tag.ToKind()).Scan(&kindId); result.Error != nil {
 			return CheckError(result)
+		} else if err := moveAssetGroupTier(tx, null.Int32{}, position); err != nil {
+			return err
 		} else if result := tx.Raw(fmt.Sprintf(`
 			INSERT INTO %s (type, kind_id, name, description, created_at, created_by, updated_at, updated_by, position, require_certify)
 			VALUES (?, ?, ?, ?, NOW(), ?, NOW(), ?, ?, ?)
 			RETURNING id, type, kind_id, name, description, created_at, created_by, updated_at, updated_by, position, require_certify`,
 			tag.TableName()),
 			tagType, kindId, name, description, userId, userId, position, requireCertify).Scan(&tag); result.Error != nil {
+			if strings.Contains(result.Error.Error(), "duplicate key value violates unique constraint \"agl_name_unique_index\"") {
+				return fmt.Errorf("%w: %v", ErrDuplicateAGTagName, result.Error)
+			}
 			return CheckError(result)
 		} else if err := bhdb.CreateAssetGroupHistoryRecord(ctx, userId, name, model.AssetGroupHistoryActionCreateTag, tag.ID, null.String{}, null.String{}); err != nil {
 			return err
@@ -212,6 +242,247 @@ func (s *BloodhoundDB) CreateAssetGroupTag(ctx context.Context, tagType model.As
 	return tag, nil
 }
 
+const assetGroupTagColumns = "id, type, kind_id, name, description, created_at, created_by, updated_at, updated_by, position, require_certify"
+
+// lockAssetGroupTiers locks the tiers until the end of the transaction and returns the highest position held by a
+// tier, which serializes the transactions that move tiers. The highest position is read by a statement of its own so
+// that it observes the tiers created by a transaction that held the locks before this one. Tier Zero always holds the
+// first position.
+func lockAssetGroupTiers(tx *gorm.DB) (int32, error) {
+	var (
+		tableName   = model.AssetGroupTag{}.TableName()
+		maxPosition int32
+	)
+
+	if result := tx.Exec(fmt.Sprintf("SELECT id FROM %s WHERE type = ? AND deleted_at IS NULL ORDER BY id FOR UPDATE", tableName), model.AssetGroupTagTypeTier); result.Error != nil {
+		return 0, CheckError(result)
+	} else if result := tx.Raw(fmt.Sprintf("SELECT COALESCE(MAX(position), ?) FROM %s WHERE type = ? AND deleted_at IS NULL", tableName), model.AssetGroupTierZeroPosition, model.AssetGroupTagTypeTier).Scan(&maxPosition); result.Error != nil {
+		return 0, CheckError(result)
+	}
+
+	return maxPosition, nil
+}
+
+// validateAssetGroupTierPosition checks that a tier position is neither the reserved Tier Zero position nor beyond the
+// end of the tier order.
+func validateAssetGroupTierPosition(position, maxPosition int32) error {
+	if position <= model.AssetGroupTierZeroPosition {
+		return fmt.Errorf("%w: position must be greater than %d", ErrInvalidAssetGroupTierPosition, model.AssetGroupTierZeroPosition)
+	} else if position > maxPosition {
+		return fmt.Errorf("%w: position must not be greater than %d", ErrInvalidAssetGroupTierPosition, maxPosition)
+	}
+
+	return nil
+}
+
+// moveAssetGroupTier shifts the positions of the other tiers so that tier positions stay contiguous when a tier moves
+// from one position to another. A tier that is being inserted has no previous position and a tier that is being
+// removed has no next position.
+func moveAssetGroupTier(tx *gorm.DB, from, to null.Int32) error {
+	var (
+		tableName = model.AssetGroupTag{}.TableName()
+		result    *gorm.DB
+	)
+
+	switch {
+	case !from.Valid && !to.Valid, from.Valid && to.Valid && from.Int32 == to.Int32:
+		return nil
+
+	case !from.Valid:
+		result = tx.Exec(fmt.Sprintf("UPDATE %s SET position = position + 1 WHERE type = ? AND deleted_at IS NULL AND position >= ?", tableName), model.AssetGroupTagTypeTier, to)
+
+	case !to.Valid:
+		result = tx.Exec(fmt.Sprintf("UPDATE %s SET position = position - 1 WHERE type = ? AND deleted_at IS NULL AND position > ?", tableName), model.AssetGroupTagTypeTier, from)
+
+	case from.Int32 < to.Int32:
+		result = tx.Exec(fmt.Sprintf("UPDATE %s SET position = position - 1 WHERE type = ? AND deleted_at IS NULL AND position > ? AND position <= ?", tableName), model.AssetGroupTagTypeTier, from, to)
+
+	default:
+		result = tx.Exec(fmt.Sprintf("UPDATE %s SET position = position + 1 WHERE type = ? AND deleted_at IS NULL AND position >= ? AND position < ?", tableName), model.AssetGroupTagTypeTier, to, from)
+	}
+
+	return CheckError(result)
+}
+
+// GetAssetGroupTags returns the tags that have not been deleted, tiers first in order of their position.
+func (s *BloodhoundDB) GetAssetGroupTags(ctx context.Context, sqlFilter model.SQLFilter) (model.AssetGroupTags, error) {
+	var (
+		tags        = model.AssetGroupTags{}
+		whereClause = "deleted_at IS NULL"
+	)
+
+	if sqlFilter.SQLString != "" {
+		whereClause += " AND " + sqlFilter.SQLString
+	}
+
+	result := s.db.WithContext(ctx).Raw(fmt.Sprintf("SELECT %s FROM %s WHERE %s ORDER BY type, position NULLS LAST, name", assetGroupTagColumns, model.AssetGroupTag{}.TableName(), whereClause), sqlFilter.Params...).Find(&tags)
+	return tags, CheckError(result)
+}
+
+// UpdateAssetGroupTag updates the description, position and certification requirement of a tag. A tier without a
+// position keeps its current one. Moving a tier shifts the positions of the tiers between its previous and next
+// position.
+func (s *BloodhoundDB) UpdateAssetGroupTag(ctx context.Context, userId string, tag model.AssetGroupTag) (model.AssetGroupTag, error) {
+	var (
+		auditEntry = model.AuditEntry{
+			Action: model.AuditLogActionUpdateAssetGroupTag,
+			Model:  &tag, // Pointer is required to ensure success log contains updated fields after transaction
+		}
+	)
+
+	if tag.Type != model.AssetGroupTagTypeTier && (tag.Position.Valid || tag.RequireCertify.Valid) {
+		return model.AssetGroupTag{}, fmt.Errorf("position and require_certify are limited to tiers only")
+	}
+
+	if err := s.AuditableTransaction(ctx, auditEntry, func(tx *gorm.DB) error {
+		var (
+			bhdb        = NewBloodhoundDB(tx, s.idResolver)
+			current     model.AssetGroupTag
+			maxPosition int32
+			err         error
+		)
+
+		// Tiers are locked before the tag itself so that every transaction moving tiers takes its locks in the same order
+		if tag.Type == model.AssetGroupTagTypeTier {
+			if maxPosition, err = lockAssetGroupTiers(tx); err != nil {
+				return err
+			}
+		}
+
+		if result := tx.Raw(fmt.Sprintf("SELECT %s FROM %s WHERE id = ? AND deleted_at IS NULL FOR UPDATE", assetGroupTagColumns, tag.TableName()), tag.ID).First(&current); result.Error != nil {
+			return CheckError(result)
+		}
+
+		if !tag.Position.Valid {
+			tag.Position = current.Position
+		} else if tag.Position != current.Position {
+			// A tier without a position may also be moved to the end of the tier order
+			if !current.Position.Valid {
+				maxPosition++
+			}
+
+			if current.IsTierZero() {
+				return fmt.Errorf("%w: the position of tier zero can not be changed", ErrInvalidAssetGroupTierPosition)
+			} else if err := validateAssetGroupTierPosition(tag.Position.Int32, maxPosition); err != nil {
+				return err
+			}
+		}
+
+		if err := moveAssetGroupTier(tx, current.Position, tag.Position); err != nil {
+			return err
+		} else if result := tx.Raw(fmt.Sprintf(`
+			UPDATE %s SET updated_at = NOW(), updated_by = ?, description = ?, position = ?, require_certify = ?
+			WHERE id = ?
+			RETURNING %s`,
+			tag.TableName(), assetGroupTagColumns),
+			userId, tag.Description, tag.Position, tag.RequireCertify, tag.ID).Scan(&tag); result.Error != nil {
+			return CheckError(result)
+		} else if err := bhdb.CreateAssetGroupHistoryRecord(ctx, userId, tag.Name, model.AssetGroupHistoryActionUpdateTag, tag.ID, null.String{}, null.String{}); err != nil {
+			return err
+		}
+		return nil
+	}); err != nil {
+		return model.AssetGroupTag{}, err
+	}
+
+	return tag, nil
+}
+
+// DeleteAssetGroupTag soft deletes a tag. The positions of the tiers that follow a deleted tier are shifted down.
+func (s *BloodhoundDB) DeleteAssetGroupTag(ctx context.Context, userId string, tag model.AssetGroupTag) error {
+	var (
+		auditEntry = model.AuditEntry{
+			Action: model.AuditLogActionDeleteAssetGroupTag,
+			Model:  &tag, // Pointer is required to ensure success log contains updated fields after transaction
+		}
+	)
+
+	return s.AuditableTransaction(ctx, auditEntry, func(tx *gorm.DB) error {
+		var (
+			bhdb    = NewBloodhoundDB(tx, s.idResolver)
+			deleted model.AssetGroupTag
+		)
+
+		if tag.Type == model.AssetGroupTagTypeTier {
+			if _, err := lockAssetGroupTiers(tx); err != nil {
+				return err
+			}
+		}
+
+		// The position is read back as the tier may have moved since the given tag was read
+		if result := tx.Raw(fmt.Sprintf("UPDATE %s SET deleted_at = NOW(), deleted_by = ? WHERE id = ? AND deleted_at IS NULL RETURNING %s", tag.TableName(), assetGroupTagColumns), userId, tag.ID).Scan(&deleted); result.Error != nil {
+			return CheckError(result)
+		} else if result.RowsAffected == 0 {
+			return ErrNotFound
+		} else if deleted.Type == model.AssetGroupTagTypeTier {
+			if err := moveAssetGroupTier(tx, deleted.Position, null.Int32{}); err != nil {
+				return err
+			}
+		}
+
+		return bhdb.CreateAssetGroupHistoryRecord(ctx, userId, tag.Name, model.AssetGroupHistoryActionDeleteTag, tag.ID, null.String{}, null.String{})
+	})
+}
+
+// GetAssetGroupTagCertifications returns the certification records of the members of a tag ordered by object ID.
+func (s *BloodhoundDB) GetAssetGroupTagCertifications(ctx context.Context, assetGroupTagId int) (model.AssetGroupTagCertifications, error) {
+	var certifications = model.AssetGroupTagCertifications{}
+
+	result := s.db.WithContext(ctx).Raw(fmt.Sprintf(
+		"SELECT id, asset_group_tag_id, object_id, status, certified_by, certified_at, note, created_at, updated_at FROM %s WHERE asset_group_tag_id = ? ORDER BY object_id",
+		model.AssetGroupTagCertification{}.TableName()),
+		assetGroupTagId).Find(&certifications)
+
+	return certifications, CheckError(result)
+}
+
+// UpdateAssetGroupTagCertification sets the certification status of a member of a tag. Certifying or revoking a
+// member records the user as its certifier while resetting a member to pending clears the certifier.
+func (s *BloodhoundDB) UpdateAssetGroupTagCertification(ctx context.Context, userId string, certification model.AssetGroupTagCertification) (model.AssetGroupTagCertification, error) {
+	var (
+		historyAction model.AssetGroupHistoryAction
+		certifiedBy   = null.StringFrom(userId)
+
+		auditEntry = model.AuditEntry{
+			Action: model.AuditLogActionUpdateAssetGroupTagCertification,
+			Model:  &certification, // Pointer is required to ensure success log contains updated fields after transaction
+		}
+	)
+
+	switch certification.Status {
+	case model.AssetGroupCertificationStatusCertified:
+		historyAction = model.AssetGroupHistoryActionCertifyMember
+	case model.AssetGroupCertificationStatusRevoked:
+		historyAction = model.AssetGroupHistoryActionRevokeMemberCertification
+	case model.AssetGroupCertificationStatusPending:
+		historyAction = model.AssetGroupHistoryActionResetMemberCertification
+		certifiedBy = null.String{}
+	default:
+		return model.AssetGroupTagCertification{}, fmt.Errorf("invalid certification status %s", certification.Status)
+	}
+
+	if err := s.AuditableTransaction(ctx, auditEntry, func(tx *gorm.DB) error {
+		bhdb := NewBloodhoundDB(tx, s.idResolver)
+
+		if result := tx.Raw(fmt.Sprintf(`
+			INSERT INTO %s (asset_group_tag_id, object_id, status, certified_by, certified_at, note, created_at, updated_at)
+			VALUES (?, ?, ?, ?, CASE WHEN ?::text IS NULL THEN NULL ELSE NOW() END, ?, NOW(), NOW())
+			ON CONFLICT (asset_group_tag_id, object_id) DO UPDATE
+			SET status = EXCLUDED.status, certified_by = EXCLUDED.certified_by, certified_at = EXCLUDED.certified_at, note = EXCLUDED.note, updated_at = NOW()
+			RETURNING id, asset_group_tag_id, object_id, status, certified_by, certified_at, note, created_at, updated_at`,
+			certification.TableName()),
+			certification.AssetGroupTagId, certification.ObjectID, certification.Status, certifiedBy, certifiedBy, certification.Note).Scan(&certification); result.Error != nil {
+			return CheckError(result)
+		}
+
+		return bhdb.CreateAssetGroupHistoryRecord(ctx, userId, certification.ObjectID, historyAction, certification.AssetGroupTagId, null.String{}, certification.Note)
+	}); err != nil {
+		return model.AssetGroupTagCertification{}, err
+	}
+
+	return certification, nil
+}
+
 func (s *BloodhoundDB) GetAssetGroupTagSelectorsByTagId(ctx context.Context, assetGroupTagId int, selectorSqlFilter, selectorSeedSqlFilter model.SQLFilter) (model.AssetGroupTagSelectors, error) {
 	var results = model.AssetGroupTagSelectors{}
 
@@ -261,3 +532,51 @@ SELECT * FROM seeds JOIN selectors ON seeds.selector_id = selectors.id ORDER BY
 
 	return results, nil
 }
+
+// GetSelectorSeedObjectIDs returns the object IDs selected by asset group selectors and by the object ID seeds of asset
+// group tag selectors.
+func (s *BloodhoundDB) GetSelectorSeedObjectIDs(ctx context.Context) ([]string, error) {
+	var objectIDs []string
+
+	result := s.db.WithContext(ctx).Raw(fmt.Sprintf(
+		"SELECT selector FROM asset_group_selectors UNION SELECT value FROM %s WHERE type = ?",
+		model.SelectorSeed{}.TableName()),
+		model.SelectorTypeObjectId).Scan(&objectIDs)
+
+	return objectIDs, CheckError(result)
+}
+
+// GetAssetGroupTagSelectorNodes returns the nodes selected by a selector during the last analysis ordered by node ID.
+func (s *BloodhoundDB) GetAssetGroupTagSelectorNodes(ctx context.Context, selectorId int) ([]model.AssetGroupTagSelectorNode, error) {
+	var nodes []model.AssetGroupTagSelectorNode
+
+	result := s.db.WithContext(ctx).Where("selector_id = ?", selectorId).Order("node_id").Find(&nodes)
+	return nodes, CheckError(result)
+}
+
+// UpdateAssetGroupTagSelectorNodes replaces the nodes selected by a selector with the given nodes. Nodes that remain
+// selected keep the time at which they were first selected.
+func (s *BloodhoundDB) UpdateAssetGroupTagSelectorNodes(ctx context.Context, selectorId int, nodes []model.AssetGroupTagSelectorNode) error {
+	// Timestamps are stored with microsecond precision
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	for idx := range nodes {
+		nodes[idx].SelectorId = selectorId
+		nodes[idx].CreatedAt = now
+		nodes[idx].UpdatedAt = now
+	}
+
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if len(nodes) > 0 {
+			if result := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "selector_id"}, {Name: "node_id"}},
+				DoUpdates: clause.AssignmentColumns([]string{"object_id", "updated_at"}),
+			}).CreateInBatches(&nodes, 1000); result.Error != nil {
+				return CheckError(result)
+			}
+		}
+
+		// Nodes that were not selected again were not touched by the upsert above
+		return CheckError(tx.Where("selector_id = ? AND updated_at < ?", selectorId, now).Delete(&model.AssetGroupTagSelectorNode{}))
+	})
+}
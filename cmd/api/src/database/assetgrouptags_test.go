@@ -20,9 +20,11 @@ package database_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
+	"github.com/specterops/bloodhound/src/database"
 	"github.com/specterops/bloodhound/src/database/types/null"
 	"github.com/specterops/bloodhound/src/model"
 	"github.com/specterops/bloodhound/src/test/integration"
@@ -191,7 +193,7 @@ func TestDatabase_CreateAssetGroupTag(t *testing.T) {
 		require.Empty(t, tag.DeletedBy)
 		require.Equal(t, testName, tag.Name)
 		require.Equal(t, testDescription, tag.Description)
-		require.Equal(t, null.Int32{}, tag.Position)
+		require.Equal(t, null.Int32From(2), tag.Position)
 		require.Equal(t, null.Bool{}, tag.RequireCertify)
 
 		tag, err = dbInst.GetAssetGroupTag(testCtx, tag.ID)
@@ -205,7 +207,7 @@ func TestDatabase_CreateAssetGroupTag(t *testing.T) {
 		require.Empty(t, tag.DeletedBy)
 		require.Equal(t, testName, tag.Name)
 		require.Equal(t, testDescription, tag.Description)
-		require.Equal(t, null.Int32{}, tag.Position)
+		require.Equal(t, null.Int32From(2), tag.Position)
 		require.Equal(t, null.Bool{}, tag.RequireCertify)
 
 		// verify history record was also created
@@ -222,6 +224,254 @@ func TestDatabase_CreateAssetGroupTag(t *testing.T) {
 
 }
 
+func TestDatabase_AssetGroupTagTierPositions(t *testing.T) {
+	var (
+		dbInst    = integration.SetupDB(t)
+		testCtx   = context.Background()
+		testActor = "test_actor"
+	)
+
+	positions := func(t *testing.T) map[string]int32 {
+		tiers, err := dbInst.GetAssetGroupTags(testCtx, model.SQLFilter{SQLString: "type = ?", Params: []any{model.AssetGroupTagTypeTier}})
+		require.NoError(t, err)
+
+		result := make(map[string]int32, len(tiers))
+		for _, tier := range tiers {
+			result[tier.Name] = tier.Position.Int32
+		}
+		return result
+	}
+
+	tierOne, err := dbInst.CreateAssetGroupTag(testCtx, model.AssetGroupTagTypeTier, testActor, "tier one", "", null.Int32From(2), null.BoolFrom(false))
+	require.NoError(t, err)
+	tierTwo, err := dbInst.CreateAssetGroupTag(testCtx, model.AssetGroupTagTypeTier, testActor, "tier two", "", null.Int32From(3), null.BoolFrom(false))
+	require.NoError(t, err)
+	_, err = dbInst.CreateAssetGroupTag(testCtx, model.AssetGroupTagTypeLabel, testActor, "label", "", null.Int32{}, null.Bool{})
+	require.NoError(t, err)
+
+	t.Run("creating a tier at a position moves the tiers after it", func(t *testing.T) {
+		_, err := dbInst.CreateAssetGroupTag(testCtx, model.AssetGroupTagTypeTier, testActor, "tier half", "", null.Int32From(2), null.BoolFrom(false))
+		require.NoError(t, err)
+		require.Equal(t, map[string]int32{"Tier Zero": 1, "tier half": 2, "tier one": 3, "tier two": 4}, positions(t))
+	})
+
+	t.Run("moving a tier shifts the tiers in between", func(t *testing.T) {
+		tierTwo.Position = null.Int32From(2)
+		tierTwo.Description = "moved"
+		updated, err := dbInst.UpdateAssetGroupTag(testCtx, testActor, tierTwo)
+		require.NoError(t, err)
+		require.Equal(t, "moved", updated.Description)
+		require.Equal(t, map[string]int32{"Tier Zero": 1, "tier two": 2, "tier half": 3, "tier one": 4}, positions(t))
+
+		tierTwo.Position = null.Int32From(4)
+		_, err = dbInst.UpdateAssetGroupTag(testCtx, testActor, tierTwo)
+		require.NoError(t, err)
+		require.Equal(t, map[string]int32{"Tier Zero": 1, "tier half": 2, "tier one": 3, "tier two": 4}, positions(t))
+	})
+
+	t.Run("updating a tier without a position keeps its position", func(t *testing.T) {
+		tierTwo.Position = null.Int32{}
+		tierTwo.Description = "unmoved"
+		updated, err := dbInst.UpdateAssetGroupTag(testCtx, testActor, tierTwo)
+		require.NoError(t, err)
+		require.Equal(t, null.Int32From(4), updated.Position)
+		require.Equal(t, map[string]int32{"Tier Zero": 1, "tier half": 2, "tier one": 3, "tier two": 4}, positions(t))
+	})
+
+	t.Run("positions outside of the tier order are rejected", func(t *testing.T) {
+		_, err := dbInst.CreateAssetGroupTag(testCtx, model.AssetGroupTagTypeTier, testActor, "tier zero too", "", null.Int32From(1), null.BoolFrom(false))
+		require.ErrorIs(t, err, database.ErrInvalidAssetGroupTierPosition)
+
+		_, err = dbInst.CreateAssetGroupTag(testCtx, model.AssetGroupTagTypeTier, testActor, "tier far", "", null.Int32From(6), null.BoolFrom(false))
+		require.ErrorIs(t, err, database.ErrInvalidAssetGroupTierPosition)
+
+		tierTwo.Position = null.Int32From(5)
+		_, err = dbInst.UpdateAssetGroupTag(testCtx, testActor, tierTwo)
+		require.ErrorIs(t, err, database.ErrInvalidAssetGroupTierPosition)
+		require.Equal(t, map[string]int32{"Tier Zero": 1, "tier half": 2, "tier one": 3, "tier two": 4}, positions(t))
+	})
+
+	t.Run("deleting a tier moves the tiers after it", func(t *testing.T) {
+		tierOne, err := dbInst.GetAssetGroupTag(testCtx, tierOne.ID)
+		require.NoError(t, err)
+		require.NoError(t, dbInst.DeleteAssetGroupTag(testCtx, testActor, tierOne))
+		require.Equal(t, map[string]int32{"Tier Zero": 1, "tier half": 2, "tier two": 3}, positions(t))
+
+		_, err = dbInst.GetAssetGroupTag(testCtx, tierOne.ID)
+		require.ErrorIs(t, err, database.ErrNotFound)
+		require.ErrorIs(t, dbInst.DeleteAssetGroupTag(testCtx, testActor, tierOne), database.ErrNotFound)
+	})
+
+	t.Run("the name of a deleted tag can be reused", func(t *testing.T) {
+		_, err := dbInst.CreateAssetGroupTag(testCtx, model.AssetGroupTagTypeTier, testActor, "tier one", "", null.Int32{}, null.Bool{})
+		require.NoError(t, err)
+
+		_, err = dbInst.CreateAssetGroupTag(testCtx, model.AssetGroupTagTypeLabel, testActor, "label", "", null.Int32{}, null.Bool{})
+		require.ErrorIs(t, err, database.ErrDuplicateAGTagName)
+	})
+
+	t.Run("lists tags with a filter", func(t *testing.T) {
+		tags, err := dbInst.GetAssetGroupTags(testCtx, model.SQLFilter{SQLString: "type = ?", Params: []any{model.AssetGroupTagTypeLabel}})
+		require.NoError(t, err)
+		require.Len(t, tags, 1)
+		require.Equal(t, "label", tags[0].Name)
+	})
+}
+
+func TestDatabase_AssetGroupTagTierPositionsConcurrent(t *testing.T) {
+	var (
+		dbInst    = integration.SetupDB(t)
+		testCtx   = context.Background()
+		testActor = "test_actor"
+		numTiers  = 8
+		errs      = make(chan error, numTiers)
+	)
+
+	// Tiers created concurrently are each placed at the end of the tier order
+	for idx := 0; idx < numTiers; idx++ {
+		go func(idx int) {
+			_, err := dbInst.CreateAssetGroupTag(testCtx, model.AssetGroupTagTypeTier, testActor, fmt.Sprintf("tier %d", idx), "", null.Int32{}, null.BoolFrom(false))
+			errs <- err
+		}(idx)
+	}
+
+	for idx := 0; idx < numTiers; idx++ {
+		require.NoError(t, <-errs)
+	}
+
+	tiers, err := dbInst.GetAssetGroupTags(testCtx, model.SQLFilter{SQLString: "type = ?", Params: []any{model.AssetGroupTagTypeTier}})
+	require.NoError(t, err)
+	require.Len(t, tiers, numTiers+1)
+
+	for idx, tier := range tiers {
+		require.Equal(t, null.Int32From(int32(idx+1)), tier.Position)
+	}
+}
+
+func TestDatabase_UpdateAssetGroupTagCertification(t *testing.T) {
+	var (
+		dbInst    = integration.SetupDB(t)
+		testCtx   = context.Background()
+		testActor = "test_actor"
+	)
+
+	tag, err := dbInst.CreateAssetGroupTag(testCtx, model.AssetGroupTagTypeTier, testActor, "certified tier", "", null.Int32From(2), null.BoolFrom(true))
+	require.NoError(t, err)
+
+	t.Run("certifies a member", func(t *testing.T) {
+		certification, err := dbInst.UpdateAssetGroupTagCertification(testCtx, testActor, model.AssetGroupTagCertification{
+			AssetGroupTagId: tag.ID,
+			ObjectID:        "S-1-5-21-1",
+			Status:          model.AssetGroupCertificationStatusCertified,
+			Note:            null.StringFrom("reviewed"),
+		})
+		require.NoError(t, err)
+		require.Equal(t, model.AssetGroupCertificationStatusCertified, certification.Status)
+		require.Equal(t, null.StringFrom(testActor), certification.CertifiedBy)
+		require.True(t, certification.CertifiedAt.Valid)
+		require.Equal(t, null.StringFrom("reviewed"), certification.Note)
+	})
+
+	t.Run("revokes and resets the same member", func(t *testing.T) {
+		certification, err := dbInst.UpdateAssetGroupTagCertification(testCtx, "other_actor", model.AssetGroupTagCertification{
+			AssetGroupTagId: tag.ID,
+			ObjectID:        "S-1-5-21-1",
+			Status:          model.AssetGroupCertificationStatusRevoked,
+		})
+		require.NoError(t, err)
+		require.Equal(t, model.AssetGroupCertificationStatusRevoked, certification.Status)
+		require.Equal(t, null.StringFrom("other_actor"), certification.CertifiedBy)
+
+		certification, err = dbInst.UpdateAssetGroupTagCertification(testCtx, testActor, model.AssetGroupTagCertification{
+			AssetGroupTagId: tag.ID,
+			ObjectID:        "S-1-5-21-1",
+			Status:          model.AssetGroupCertificationStatusPending,
+		})
+		require.NoError(t, err)
+		require.Equal(t, model.AssetGroupCertificationStatusPending, certification.Status)
+		require.False(t, certification.CertifiedBy.Valid)
+		require.False(t, certification.CertifiedAt.Valid)
+
+		certifications, err := dbInst.GetAssetGroupTagCertifications(testCtx, tag.ID)
+		require.NoError(t, err)
+		require.Len(t, certifications, 1)
+	})
+
+	t.Run("records certification history", func(t *testing.T) {
+		history, err := dbInst.GetAssetGroupHistoryRecords(testCtx)
+		require.NoError(t, err)
+
+		var actions []model.AssetGroupHistoryAction
+		for _, record := range history {
+			actions = append(actions, record.Action)
+		}
+
+		require.Contains(t, actions, model.AssetGroupHistoryActionCertifyMember)
+		require.Contains(t, actions, model.AssetGroupHistoryActionRevokeMemberCertification)
+		require.Contains(t, actions, model.AssetGroupHistoryActionResetMemberCertification)
+	})
+
+	t.Run("rejects an invalid status", func(t *testing.T) {
+		_, err := dbInst.UpdateAssetGroupTagCertification(testCtx, testActor, model.AssetGroupTagCertification{
+			AssetGroupTagId: tag.ID,
+			ObjectID:        "S-1-5-21-1",
+			Status:          "approved",
+		})
+		require.Error(t, err)
+	})
+}
+
+func TestDatabase_UpdateAssetGroupTagSelectorNodes(t *testing.T) {
+	var (
+		dbInst    = integration.SetupDB(t)
+		testCtx   = context.Background()
+		testActor = "test_actor"
+	)
+
+	selector, err := dbInst.CreateAssetGroupTagSelector(testCtx, 1, testActor, "test selector", "", false, true, null.BoolFrom(false), []model.SelectorSeed{
+		{Type: model.SelectorTypeObjectId, Value: "S-1-5-21-1"},
+	})
+	require.NoError(t, err)
+
+	t.Run("records the selected nodes", func(t *testing.T) {
+		require.NoError(t, dbInst.UpdateAssetGroupTagSelectorNodes(testCtx, selector.ID, []model.AssetGroupTagSelectorNode{
+			{NodeId: 1, ObjectID: "S-1-5-21-1"},
+			{NodeId: 2, ObjectID: "S-1-5-21-2"},
+		}))
+
+		nodes, err := dbInst.GetAssetGroupTagSelectorNodes(testCtx, selector.ID)
+		require.NoError(t, err)
+		require.Len(t, nodes, 2)
+		require.Equal(t, "S-1-5-21-1", nodes[0].ObjectID)
+		require.Equal(t, "S-1-5-21-2", nodes[1].ObjectID)
+	})
+
+	t.Run("replaces the selected nodes", func(t *testing.T) {
+		previous, err := dbInst.GetAssetGroupTagSelectorNodes(testCtx, selector.ID)
+		require.NoError(t, err)
+
+		require.NoError(t, dbInst.UpdateAssetGroupTagSelectorNodes(testCtx, selector.ID, []model.AssetGroupTagSelectorNode{
+			{NodeId: 2, ObjectID: "S-1-5-21-2"},
+			{NodeId: 3, ObjectID: "S-1-5-21-3"},
+		}))
+
+		nodes, err := dbInst.GetAssetGroupTagSelectorNodes(testCtx, selector.ID)
+		require.NoError(t, err)
+		require.Len(t, nodes, 2)
+		require.Equal(t, "S-1-5-21-2", nodes[0].ObjectID)
+		require.Equal(t, previous[1].CreatedAt.UTC(), nodes[0].CreatedAt.UTC())
+		require.Equal(t, "S-1-5-21-3", nodes[1].ObjectID)
+	})
+
+	t.Run("clears the selected nodes", func(t *testing.T) {
+		require.NoError(t, dbInst.UpdateAssetGroupTagSelectorNodes(testCtx, selector.ID, nil))
+
+		nodes, err := dbInst.GetAssetGroupTagSelectorNodes(testCtx, selector.ID)
+		require.NoError(t, err)
+		require.Empty(t, nodes)
+	})
+}
+
 func TestDatabase_GetAssetGroupTagSelectors(t *testing.T) {
 	var (
 		dbInst        = integration.SetupDB(t)
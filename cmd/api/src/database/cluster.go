@@ -0,0 +1,179 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/specterops/bloodhound/src/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// leaderLockKey is the key of the PostgreSQL advisory lock held by the replica running the datapipe
+const leaderLockKey int64 = 7_400_001
+
+// clusterEventsChannel is the PostgreSQL notification channel over which replicas relay events to each other
+const clusterEventsChannel = "bloodhound_cluster_events"
+
+// clusterNodeRetention is how long a replica that stopped sending heartbeats is kept before it is swept
+const clusterNodeRetention = 24 * time.Hour
+
+// LeaderLock is a held leader lock. Releasing the lock allows another replica to acquire it.
+type LeaderLock interface {
+	// Ping verifies that the lock is still held. An error means the lock may have been lost and that another replica
+	// may already hold it.
+	Ping(ctx context.Context) error
+	Release(ctx context.Context) error
+}
+
+type ClusterData interface {
+	TryAcquireLeaderLock(ctx context.Context) (LeaderLock, bool, error)
+	UpsertClusterNode(ctx context.Context, node model.ClusterNode) error
+	GetClusterNodes(ctx context.Context, heartbeatSince time.Time) (model.ClusterNodes, error)
+	SweepClusterNodes(ctx context.Context)
+	NotifyClusterEvent(ctx context.Context, payload string) error
+	ListenClusterEvents(ctx context.Context, handler func(payload string)) error
+}
+
+// advisoryLock is a session-level PostgreSQL advisory lock. Advisory locks belong to the session that took them, so
+// the lock pins a pooled connection for as long as it is held and is lost along with that connection.
+type advisoryLock struct {
+	conn *sql.Conn
+	key  int64
+}
+
+func (s *advisoryLock) Ping(ctx context.Context) error {
+	return s.conn.PingContext(ctx)
+}
+
+func (s *advisoryLock) Release(ctx context.Context) error {
+	var unlocked bool
+
+	if err := s.conn.QueryRowContext(ctx, "SELECT pg_advisory_unlock($1)", s.key).Scan(&unlocked); err != nil || !unlocked {
+		// Discard the connection rather than returning it to the pool so that its session, and any lock it still
+		// holds, ends with it
+		_ = s.conn.Raw(func(any) error { return driver.ErrBadConn })
+
+		if err == nil {
+			err = errors.New("advisory lock was not held by this session")
+		}
+
+		_ = s.conn.Close()
+		return fmt.Errorf("error releasing advisory lock: %w", err)
+	}
+
+	return s.conn.Close()
+}
+
+// TryAcquireLeaderLock attempts to take the leader lock without waiting. It returns false if another replica holds
+// the lock.
+func (s *BloodhoundDB) TryAcquireLeaderLock(ctx context.Context) (LeaderLock, bool, error) {
+	var acquired bool
+
+	if sqlDB, err := s.db.WithContext(ctx).DB(); err != nil {
+		return nil, false, err
+	} else if conn, err := sqlDB.Conn(ctx); err != nil {
+		return nil, false, err
+	} else if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", leaderLockKey).Scan(&acquired); err != nil {
+		_ = conn.Close()
+		return nil, false, err
+	} else if !acquired {
+		return nil, false, conn.Close()
+	} else {
+		return &advisoryLock{conn: conn, key: leaderLockKey}, true, nil
+	}
+}
+
+// UpsertClusterNode records a heartbeat of the given node. A node recorded as the leader clears the leader flag of
+// every other node, since only one replica can hold the leader lock.
+func (s *BloodhoundDB) UpsertClusterNode(ctx context.Context, node model.ClusterNode) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if node.IsLeader {
+			if result := tx.Model(&model.ClusterNode{}).Where("node_id <> ? AND is_leader", node.NodeID).Update("is_leader", false); result.Error != nil {
+				return result.Error
+			}
+		}
+
+		return CheckError(tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "node_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"hostname", "is_leader", "started_at", "last_heartbeat_at"}),
+		}).Create(&node))
+	})
+}
+
+// GetClusterNodes returns the nodes that have sent a heartbeat since the given time, ordered by node ID
+func (s *BloodhoundDB) GetClusterNodes(ctx context.Context, heartbeatSince time.Time) (model.ClusterNodes, error) {
+	var nodes model.ClusterNodes
+
+	result := s.db.WithContext(ctx).Where("last_heartbeat_at >= ?", heartbeatSince).Order("node_id").Find(&nodes)
+	return nodes, CheckError(result)
+}
+
+// SweepClusterNodes deletes nodes that have not sent a heartbeat within the retention period
+func (s *BloodhoundDB) SweepClusterNodes(ctx context.Context) {
+	if result := s.db.WithContext(ctx).Where("last_heartbeat_at < ?", time.Now().UTC().Add(-clusterNodeRetention)).Delete(&model.ClusterNode{}); result.Error != nil {
+		slog.ErrorContext(ctx, fmt.Sprintf("Error sweeping cluster nodes: %v", result.Error))
+	}
+}
+
+// NotifyClusterEvent sends the given payload to every replica listening for cluster events, including this one.
+// Notifications are delivered only once the sending transaction commits and payloads are limited to 8000 bytes.
+func (s *BloodhoundDB) NotifyClusterEvent(ctx context.Context, payload string) error {
+	return CheckError(s.db.WithContext(ctx).Exec("SELECT pg_notify(?, ?);", clusterEventsChannel, payload))
+}
+
+// ListenClusterEvents calls the given handler with the payload of every cluster event notified by any replica. It
+// blocks until the given context is done or the listening connection is lost. Notifications sent while no connection
+// is listening are not delivered.
+func (s *BloodhoundDB) ListenClusterEvents(ctx context.Context, handler func(payload string)) error {
+	if sqlDB, err := s.db.WithContext(ctx).DB(); err != nil {
+		return err
+	} else if conn, err := sqlDB.Conn(ctx); err != nil {
+		return err
+	} else {
+		// The listening session pins its connection, which is discarded rather than returned to the pool so that it
+		// does not keep receiving notifications
+		defer func() {
+			_ = conn.Raw(func(any) error { return driver.ErrBadConn })
+			_ = conn.Close()
+		}()
+
+		return conn.Raw(func(driverConn any) error {
+			if stdlibConn, ok := driverConn.(*stdlib.Conn); !ok {
+				return fmt.Errorf("unexpected database driver connection type %T", driverConn)
+			} else if _, err := stdlibConn.Conn().Exec(ctx, "LISTEN "+clusterEventsChannel); err != nil {
+				return fmt.Errorf("error listening for cluster events: %w", err)
+			} else {
+				for {
+					if notification, err := stdlibConn.Conn().WaitForNotification(ctx); err != nil {
+						return err
+					} else {
+						handler(notification.Payload)
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build integration
+// +build integration
+
+package database_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/specterops/bloodhound/src/model"
+	"github.com/specterops/bloodhound/src/test/integration"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatabase_LeaderLock(t *testing.T) {
+	var (
+		testCtx = context.Background()
+		dbInst  = integration.SetupDB(t)
+	)
+
+	lock, acquired, err := dbInst.TryAcquireLeaderLock(testCtx)
+	require.Nil(t, err)
+	require.True(t, acquired)
+	require.Nil(t, lock.Ping(testCtx))
+
+	// The lock is held by the session of the first caller so that a second attempt fails
+	_, acquired, err = dbInst.TryAcquireLeaderLock(testCtx)
+	require.Nil(t, err)
+	require.False(t, acquired)
+
+	require.Nil(t, lock.Release(testCtx))
+
+	lock, acquired, err = dbInst.TryAcquireLeaderLock(testCtx)
+	require.Nil(t, err)
+	require.True(t, acquired)
+	require.Nil(t, lock.Release(testCtx))
+}
+
+func TestDatabase_ClusterNodes(t *testing.T) {
+	var (
+		testCtx = context.Background()
+		dbInst  = integration.SetupDB(t)
+		now     = time.Now().UTC()
+	)
+
+	require.Nil(t, dbInst.UpsertClusterNode(testCtx, model.ClusterNode{NodeID: "node-a", IsLeader: true, StartedAt: now, LastHeartbeatAt: now}))
+	require.Nil(t, dbInst.UpsertClusterNode(testCtx, model.ClusterNode{NodeID: "node-b", StartedAt: now, LastHeartbeatAt: now}))
+	require.Nil(t, dbInst.UpsertClusterNode(testCtx, model.ClusterNode{NodeID: "node-c", StartedAt: now, LastHeartbeatAt: now.Add(-48 * time.Hour)}))
+
+	nodes, err := dbInst.GetClusterNodes(testCtx, now.Add(-time.Minute))
+	require.Nil(t, err)
+	require.Len(t, nodes, 2)
+
+	leader, found := nodes.Leader()
+	require.True(t, found)
+	require.Equal(t, "node-a", leader.NodeID)
+
+	// A new leader clears the leader flag of the previous one
+	require.Nil(t, dbInst.UpsertClusterNode(testCtx, model.ClusterNode{NodeID: "node-b", IsLeader: true, StartedAt: now, LastHeartbeatAt: now.Add(time.Second)}))
+
+	nodes, err = dbInst.GetClusterNodes(testCtx, now.Add(-time.Minute))
+	require.Nil(t, err)
+
+	leader, found = nodes.Leader()
+	require.True(t, found)
+	require.Equal(t, "node-b", leader.NodeID)
+	require.False(t, nodes[0].IsLeader)
+
+	dbInst.SweepClusterNodes(testCtx)
+
+	nodes, err = dbInst.GetClusterNodes(testCtx, time.Time{})
+	require.Nil(t, err)
+	require.Len(t, nodes, 2)
+}
+
+func TestDatabase_ClusterEvents(t *testing.T) {
+	var (
+		testCtx, cancel = context.WithTimeout(context.Background(), 10*time.Second)
+		dbInst          = integration.SetupDB(t)
+		payloads        = make(chan string, 1)
+		listenErr       = make(chan error, 1)
+	)
+
+	defer cancel()
+
+	listenCtx, stopListening := context.WithCancel(testCtx)
+
+	go func() {
+		listenErr <- dbInst.ListenClusterEvents(listenCtx, func(payload string) {
+			payloads <- payload
+		})
+	}()
+
+	// Notifications sent before the listener has started are not delivered, so notify until one arrives
+	require.Eventually(t, func() bool {
+		require.Nil(t, dbInst.NotifyClusterEvent(testCtx, "event"))
+
+		select {
+		case payload := <-payloads:
+			return payload == "event"
+		case <-time.After(100 * time.Millisecond):
+			return false
+		}
+	}, 5*time.Second, 10*time.Millisecond)
+
+	stopListening()
+	require.NotNil(t, <-listenErr)
+}
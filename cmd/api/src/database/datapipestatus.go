@@ -18,40 +18,93 @@ package database
 
 import (
 	"context"
+	"fmt"
+	"log/slog"
 	"time"
 
+	"github.com/specterops/bloodhound/src/metrics"
 	"github.com/specterops/bloodhound/src/model"
 )
 
 type DatapipeStatusData interface {
 	SetDatapipeStatus(ctx context.Context, status model.DatapipeStatus, updateAnalysisTime bool) error
 	GetDatapipeStatus(ctx context.Context) (model.DatapipeStatusWrapper, error)
+	RequestAnalysisCancellation(ctx context.Context, requestedBy string) (bool, error)
+	IncrementAnalysisGeneration(ctx context.Context) (int64, error)
+	GetAnalysisGeneration(ctx context.Context) (int64, error)
 }
 
 func (s *BloodhoundDB) SetDatapipeStatus(ctx context.Context, status model.DatapipeStatus, updateAnalysisTime bool) error {
-	now := time.Now().UTC()
-	// All queries will update the status and table update time
-	updateSql := "UPDATE datapipe_status SET status = ?, updated_at = ?"
+	if changed, err := s.setDatapipeStatus(ctx, status, updateAnalysisTime); err != nil {
+		return err
+	} else if changed {
+		metrics.DatapipeStatusTransitions.WithLabelValues(string(status)).Inc()
+	}
+
+	return nil
+}
+
+// setDatapipeStatus updates the datapipe status and returns true if the stored status was changed by the update.
+func (s *BloodhoundDB) setDatapipeStatus(ctx context.Context, status model.DatapipeStatus, updateAnalysisTime bool) (bool, error) {
+	var (
+		now     = time.Now().UTC()
+		changed bool
+
+		// All queries will update the status and table update time. The previous status is read from the locked row so
+		// that the update reports whether the status actually changed.
+		updateSql = "WITH previous AS (SELECT status FROM datapipe_status FOR UPDATE) UPDATE datapipe_status SET status = ?, updated_at = ?"
+		params    = []any{status, now}
+	)
 
 	if status == model.DatapipeStatusAnalyzing {
-		// Updates last run anytime we start analysis
-		updateSql += ", last_analysis_run_at = ?;"
-		return s.db.WithContext(ctx).Exec(updateSql, status, now, now).Error
+		// Updates last run anytime we start analysis and clears any cancellation requested for the previous run
+		updateSql += ", last_analysis_run_at = ?, cancellation_requested_by = NULL, cancellation_requested_at = NULL"
+		params = append(params, now)
 	} else if updateAnalysisTime {
 		// Updates last completed when analysis is set to complete
-		updateSql += ", last_complete_analysis_at = ?;"
-		return s.db.WithContext(ctx).Exec(updateSql, status, now, now).Error
-	} else {
-		// Otherwise, only update status and last update to the table
-		updateSql += ";"
-		return s.db.WithContext(ctx).Exec(updateSql, status, now).Error
+		updateSql += ", last_complete_analysis_at = ?"
+		params = append(params, now)
 	}
+
+	updateSql += " RETURNING datapipe_status.status IS DISTINCT FROM (SELECT status FROM previous) AS changed;"
+
+	return changed, s.db.WithContext(ctx).Raw(updateSql, params...).Scan(&changed).Error
 }
 
 func (s *BloodhoundDB) GetDatapipeStatus(ctx context.Context) (model.DatapipeStatusWrapper, error) {
 	var datapipeStatus model.DatapipeStatusWrapper
 
-	tx := s.db.WithContext(ctx).Select("status, updated_at, last_complete_analysis_at, last_analysis_run_at").Table("datapipe_status").First(&datapipeStatus)
+	tx := s.db.WithContext(ctx).Select("status, updated_at, last_complete_analysis_at, last_analysis_run_at, cancellation_requested_by, cancellation_requested_at").Table("datapipe_status").First(&datapipeStatus)
 
 	return datapipeStatus, CheckError(tx)
 }
+
+// RequestAnalysisCancellation records a request to cancel the in-flight analysis. It returns false if the datapipe is
+// not analyzing, in which case nothing is recorded.
+func (s *BloodhoundDB) RequestAnalysisCancellation(ctx context.Context, requestedBy string) (bool, error) {
+	updateSql := "UPDATE datapipe_status SET cancellation_requested_by = ?, cancellation_requested_at = ? WHERE status = ?;"
+
+	if result := s.db.WithContext(ctx).Exec(updateSql, requestedBy, time.Now().UTC(), model.DatapipeStatusAnalyzing); result.Error != nil {
+		return false, result.Error
+	} else {
+		slog.InfoContext(ctx, fmt.Sprintf("Analysis cancellation requested by %s", requestedBy))
+		return result.RowsAffected > 0, nil
+	}
+}
+
+// IncrementAnalysisGeneration records that analysis has changed the graph and returns the new analysis generation.
+// Replicas compare the generation against the last one they have seen to know when to invalidate their caches.
+func (s *BloodhoundDB) IncrementAnalysisGeneration(ctx context.Context) (int64, error) {
+	var generation int64
+
+	result := s.db.WithContext(ctx).Raw("UPDATE datapipe_status SET analysis_generation = analysis_generation + 1 RETURNING analysis_generation;").Scan(&generation)
+	return generation, CheckError(result)
+}
+
+// GetAnalysisGeneration returns the current analysis generation
+func (s *BloodhoundDB) GetAnalysisGeneration(ctx context.Context) (int64, error) {
+	var generation int64
+
+	result := s.db.WithContext(ctx).Raw("SELECT analysis_generation FROM datapipe_status LIMIT 1;").Scan(&generation)
+	return generation, CheckError(result)
+}
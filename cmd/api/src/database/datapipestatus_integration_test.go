@@ -23,6 +23,8 @@ import (
 	"context"
 	"testing"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/specterops/bloodhound/src/metrics"
 	"github.com/specterops/bloodhound/src/model"
 	"github.com/specterops/bloodhound/src/test/integration"
 	"github.com/stretchr/testify/require"
@@ -57,3 +59,77 @@ func TestDatapipeStatus(t *testing.T) {
 	require.True(t, !status.LastCompleteAnalysisAt.IsZero())
 
 }
+
+func TestRequestAnalysisCancellation(t *testing.T) {
+	var (
+		testCtx = context.Background()
+		db      = integration.SetupDB(t)
+	)
+
+	// Nothing is recorded unless analysis is running
+	requested, err := db.RequestAnalysisCancellation(testCtx, "user")
+	require.Nil(t, err)
+	require.False(t, requested)
+
+	require.Nil(t, db.SetDatapipeStatus(testCtx, model.DatapipeStatusAnalyzing, false))
+
+	requested, err = db.RequestAnalysisCancellation(testCtx, "user")
+	require.Nil(t, err)
+	require.True(t, requested)
+
+	status, err := db.GetDatapipeStatus(testCtx)
+	require.Nil(t, err)
+	require.Equal(t, "user", status.CancellationRequestedBy.ValueOrZero())
+	require.True(t, status.CancellationRequestedAt.Valid)
+
+	// The next analysis run starts without a cancellation request
+	require.Nil(t, db.SetDatapipeStatus(testCtx, model.DatapipeStatusIdle, false))
+	require.Nil(t, db.SetDatapipeStatus(testCtx, model.DatapipeStatusAnalyzing, false))
+
+	status, err = db.GetDatapipeStatus(testCtx)
+	require.Nil(t, err)
+	require.False(t, status.CancellationRequestedBy.Valid)
+	require.False(t, status.CancellationRequestedAt.Valid)
+}
+
+func TestSetDatapipeStatus_CountsTransitions(t *testing.T) {
+	var (
+		testCtx     = context.Background()
+		db          = integration.SetupDB(t)
+		transitions = func(status model.DatapipeStatus) float64 {
+			return testutil.ToFloat64(metrics.DatapipeStatusTransitions.WithLabelValues(string(status)))
+		}
+		idleTransitions      = transitions(model.DatapipeStatusIdle)
+		analyzingTransitions = transitions(model.DatapipeStatusAnalyzing)
+	)
+
+	// Setting the status the datapipe is already in is not a transition
+	require.Nil(t, db.SetDatapipeStatus(testCtx, model.DatapipeStatusIdle, false))
+	require.Nil(t, db.SetDatapipeStatus(testCtx, model.DatapipeStatusIdle, false))
+	require.Equal(t, idleTransitions, transitions(model.DatapipeStatusIdle))
+
+	require.Nil(t, db.SetDatapipeStatus(testCtx, model.DatapipeStatusAnalyzing, false))
+	require.Nil(t, db.SetDatapipeStatus(testCtx, model.DatapipeStatusAnalyzing, false))
+	require.Equal(t, analyzingTransitions+1, transitions(model.DatapipeStatusAnalyzing))
+
+	require.Nil(t, db.SetDatapipeStatus(testCtx, model.DatapipeStatusIdle, true))
+	require.Equal(t, idleTransitions+1, transitions(model.DatapipeStatusIdle))
+}
+
+func TestAnalysisGeneration(t *testing.T) {
+	var (
+		testCtx = context.Background()
+		db      = integration.SetupDB(t)
+	)
+
+	initial, err := db.GetAnalysisGeneration(testCtx)
+	require.Nil(t, err)
+
+	generation, err := db.IncrementAnalysisGeneration(testCtx)
+	require.Nil(t, err)
+	require.Equal(t, initial+1, generation)
+
+	current, err := db.GetAnalysisGeneration(testCtx)
+	require.Nil(t, err)
+	require.Equal(t, generation, current)
+}
@@ -44,9 +44,15 @@ var (
 var (
 	ErrDuplicateAGName          = errors.New("duplicate asset group name")
 	ErrDuplicateAGTag           = errors.New("duplicate asset group tag")
+	ErrDuplicateAGTagName       = errors.New("duplicate asset group tag name")
 	ErrDuplicateSSOProviderName = errors.New("duplicate sso provider name")
 	ErrDuplicateUserPrincipal   = errors.New("duplicate user principal name")
 	ErrDuplicateEmail           = errors.New("duplicate user email address")
+	ErrDuplicateWorkspaceName   = errors.New("duplicate workspace name")
+)
+
+var (
+	ErrInvalidAssetGroupTierPosition = errors.New("invalid asset group tier position")
 )
 
 func IsUnexpectedDatabaseError(err error) bool {
@@ -70,6 +76,8 @@ type Database interface {
 	CountAllIngestTasks(ctx context.Context) (int64, error)
 	DeleteIngestTask(ctx context.Context, ingestTask model.IngestTask) error
 	GetIngestTasksForJob(ctx context.Context, jobID int64) (model.IngestTasks, error)
+	GetAllIngestUploadChunks(ctx context.Context) (model.IngestUploadChunks, error)
+	DeleteAbandonedIngestUploads(ctx context.Context) error
 
 	// Asset Groups
 	agi.AgiData
@@ -164,6 +172,18 @@ type Database interface {
 	AssetGroupHistoryData
 	AssetGroupTagData
 	AssetGroupTagSelectorData
+
+	// Workspaces
+	WorkspaceData
+
+	// Generic Kinds
+	GenericKindData
+
+	// Analysis Runs
+	AnalysisRunData
+
+	// Cluster
+	ClusterData
 }
 
 type BloodhoundDB struct {
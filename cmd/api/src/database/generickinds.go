@@ -0,0 +1,44 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package database
+
+import (
+	"context"
+
+	"github.com/specterops/bloodhound/src/model"
+	"gorm.io/gorm/clause"
+)
+
+type GenericKindData interface {
+	GetGenericKinds(ctx context.Context) (model.GenericKinds, error)
+	CreateGenericKinds(ctx context.Context, kinds model.GenericKinds) error
+}
+
+func (s *BloodhoundDB) GetGenericKinds(ctx context.Context) (model.GenericKinds, error) {
+	var kinds model.GenericKinds
+
+	return kinds, CheckError(s.db.WithContext(ctx).Order("name").Find(&kinds))
+}
+
+// CreateGenericKinds records the given kinds. Kinds that have already been recorded are left as they are.
+func (s *BloodhoundDB) CreateGenericKinds(ctx context.Context, kinds model.GenericKinds) error {
+	if len(kinds) == 0 {
+		return nil
+	}
+
+	return CheckError(s.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&kinds))
+}
@@ -0,0 +1,52 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build integration
+// +build integration
+
+package database_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/specterops/bloodhound/dawgs/graph"
+	"github.com/specterops/bloodhound/src/model"
+	"github.com/specterops/bloodhound/src/test/integration"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatabase_GenericKinds(t *testing.T) {
+	var (
+		testCtx = context.Background()
+		dbInst  = integration.SetupDB(t)
+	)
+
+	kinds, err := dbInst.GetGenericKinds(testCtx)
+	require.Nil(t, err)
+	require.Empty(t, kinds)
+
+	require.Nil(t, dbInst.CreateGenericKinds(testCtx, model.GenericKinds{{Name: "GHUser"}, {Name: "GHCanPush", IsEdge: true}}))
+
+	// Kinds that have already been recorded are left as they are
+	require.Nil(t, dbInst.CreateGenericKinds(testCtx, model.GenericKinds{{Name: "GHUser"}, {Name: "GHRepository"}}))
+
+	kinds, err = dbInst.GetGenericKinds(testCtx)
+	require.Nil(t, err)
+	require.Len(t, kinds, 3)
+	require.Equal(t, graph.Kinds{graph.StringKind("GHRepository"), graph.StringKind("GHUser")}, kinds.NodeKinds())
+	require.Equal(t, graph.Kinds{graph.StringKind("GHCanPush")}, kinds.EdgeKinds())
+}
@@ -18,6 +18,7 @@ package database
 
 import (
 	"context"
+	"time"
 
 	"github.com/specterops/bloodhound/src/model"
 	"gorm.io/gorm"
@@ -28,6 +29,75 @@ func (s *BloodhoundDB) UpdateIngestJob(ctx context.Context, job model.IngestJob)
 	return CheckError(result)
 }
 
+// UpdateIngestJobStatus moves the given ingest job to the given status. Canceled jobs are left canceled; false is
+// returned if the job has been canceled.
+func (s *BloodhoundDB) UpdateIngestJobStatus(ctx context.Context, id int64, status model.JobStatus, message string) (bool, error) {
+	updateSql := "UPDATE ingest_jobs SET status = ?, status_message = ?, end_time = ? WHERE id = ? AND status <> ?;"
+
+	if result := s.db.WithContext(ctx).Exec(updateSql, status, message, time.Now().UTC(), id, model.JobStatusCanceled); result.Error != nil {
+		return false, CheckError(result)
+	} else {
+		return result.RowsAffected > 0, nil
+	}
+}
+
+// CancelIngestJob cancels the given ingest job on behalf of the given requester if it is in one of the given statuses.
+// False is returned if the job was not in any of them.
+func (s *BloodhoundDB) CancelIngestJob(ctx context.Context, id int64, statuses []model.JobStatus, canceledBy string, message string) (bool, error) {
+	updateSql := "UPDATE ingest_jobs SET status = ?, status_message = ?, end_time = ?, canceled_by = ? WHERE id = ? AND status IN ?;"
+
+	if result := s.db.WithContext(ctx).Exec(updateSql, model.JobStatusCanceled, message, time.Now().UTC(), canceledBy, id, statuses); result.Error != nil {
+		return false, CheckError(result)
+	} else {
+		return result.RowsAffected > 0, nil
+	}
+}
+
+// EndIngestJob stops the given ingest job from accepting files. False is returned if the job was no longer running.
+func (s *BloodhoundDB) EndIngestJob(ctx context.Context, id int64) (bool, error) {
+	updateSql := "UPDATE ingest_jobs SET status = ? WHERE id = ? AND status = ?;"
+
+	if result := s.db.WithContext(ctx).Exec(updateSql, model.JobStatusIngesting, id, model.JobStatusRunning); result.Error != nil {
+		return false, CheckError(result)
+	} else {
+		return result.RowsAffected > 0, nil
+	}
+}
+
+// TouchIngestJobLastIngest records that the given ingest job has received a file
+func (s *BloodhoundDB) TouchIngestJobLastIngest(ctx context.Context, id int64) error {
+	return CheckError(s.db.WithContext(ctx).Exec("UPDATE ingest_jobs SET last_ingest = ? WHERE id = ?;", time.Now().UTC(), id))
+}
+
+// AddIngestJobFiles records the files of an ingested task against the given ingest job. Canceled jobs are not updated.
+func (s *BloodhoundDB) AddIngestJobFiles(ctx context.Context, id int64, totalFiles int, failedFiles int) error {
+	updateSql := "UPDATE ingest_jobs SET total_files = ?, failed_files = failed_files + ? WHERE id = ? AND status <> ?;"
+
+	return CheckError(s.db.WithContext(ctx).Exec(updateSql, totalFiles, failedFiles, id, model.JobStatusCanceled))
+}
+
+// RequestIngestJobRollback records a request, on behalf of the given requester, to roll back the given ingest job. False
+// is returned if the job has already been rolled back.
+func (s *BloodhoundDB) RequestIngestJobRollback(ctx context.Context, id int64, requestedBy string) (bool, error) {
+	updateSql := "UPDATE ingest_jobs SET rollback_requested_by = ? WHERE id = ? AND rolled_back_at IS NULL;"
+
+	if result := s.db.WithContext(ctx).Exec(updateSql, requestedBy, id); result.Error != nil {
+		return false, CheckError(result)
+	} else {
+		return result.RowsAffected > 0, nil
+	}
+}
+
+// SetIngestJobRolledBack records that the requested rollback of the given ingest job has been performed
+func (s *BloodhoundDB) SetIngestJobRolledBack(ctx context.Context, id int64) error {
+	return CheckError(s.db.WithContext(ctx).Exec("UPDATE ingest_jobs SET rolled_back_at = ? WHERE id = ?;", time.Now().UTC(), id))
+}
+
+// ClearIngestJobRollbackRequest clears the rollback request of the given ingest job so that it may be requested again
+func (s *BloodhoundDB) ClearIngestJobRollbackRequest(ctx context.Context, id int64) error {
+	return CheckError(s.db.WithContext(ctx).Exec("UPDATE ingest_jobs SET rollback_requested_by = NULL WHERE id = ? AND rolled_back_at IS NULL;", id))
+}
+
 func (s *BloodhoundDB) CreateIngestJob(ctx context.Context, job model.IngestJob) (model.IngestJob, error) {
 	result := s.db.WithContext(ctx).Create(&job)
 	return job, CheckError(result)
@@ -49,6 +119,14 @@ func (s *BloodhoundDB) GetIngestJobsWithStatus(ctx context.Context, status model
 	return jobs, CheckError(result)
 }
 
+// GetIngestJobsPendingRollback returns the ingest jobs whose rollback has been requested but not yet performed
+func (s *BloodhoundDB) GetIngestJobsPendingRollback(ctx context.Context) ([]model.IngestJob, error) {
+	var jobs model.IngestJobs
+	result := s.db.WithContext(ctx).Where("rollback_requested_by IS NOT NULL AND rolled_back_at IS NULL").Order("id").Find(&jobs)
+
+	return jobs, CheckError(result)
+}
+
 func (s *BloodhoundDB) CancelAllIngestJobs(ctx context.Context) error {
 	runningStates := []model.JobStatus{model.JobStatusAnalyzing, model.JobStatusRunning, model.JobStatusIngesting}
 	return CheckError(s.db.Model(model.IngestJob{}).WithContext(ctx).Where("status in ?", runningStates).Update("status", model.JobStatusCanceled))
@@ -0,0 +1,108 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build integration
+// +build integration
+
+package database_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/specterops/bloodhound/src/model"
+	"github.com/specterops/bloodhound/src/test/integration"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatabase_IngestJobUpdates(t *testing.T) {
+	var (
+		testCtx = context.Background()
+		dbInst  = integration.SetupDB(t)
+	)
+
+	job, err := dbInst.CreateIngestJob(testCtx, model.IngestJob{Status: model.JobStatusRunning})
+	require.Nil(t, err)
+
+	require.Nil(t, dbInst.AddIngestJobFiles(testCtx, job.ID, 2, 1))
+	require.Nil(t, dbInst.AddIngestJobFiles(testCtx, job.ID, 3, 1))
+
+	ended, err := dbInst.EndIngestJob(testCtx, job.ID)
+	require.Nil(t, err)
+	require.True(t, ended)
+
+	// A job that is no longer running can not be ended again
+	ended, err = dbInst.EndIngestJob(testCtx, job.ID)
+	require.Nil(t, err)
+	require.False(t, ended)
+
+	canceled, err := dbInst.CancelIngestJob(testCtx, job.ID, []model.JobStatus{model.JobStatusRunning, model.JobStatusIngesting}, "user", "Canceled")
+	require.Nil(t, err)
+	require.True(t, canceled)
+
+	// Updates from readers that have not observed the cancellation leave the job canceled
+	updated, err := dbInst.UpdateIngestJobStatus(testCtx, job.ID, model.JobStatusAnalyzing, "Analyzing")
+	require.Nil(t, err)
+	require.False(t, updated)
+	require.Nil(t, dbInst.AddIngestJobFiles(testCtx, job.ID, 4, 4))
+
+	canceled, err = dbInst.CancelIngestJob(testCtx, job.ID, []model.JobStatus{model.JobStatusRunning, model.JobStatusIngesting}, "other", "Canceled")
+	require.Nil(t, err)
+	require.False(t, canceled)
+
+	job, err = dbInst.GetIngestJob(testCtx, job.ID)
+	require.Nil(t, err)
+	require.Equal(t, model.JobStatusCanceled, job.Status)
+	require.Equal(t, "user", job.CanceledBy.ValueOrZero())
+	require.Equal(t, 3, job.TotalFiles)
+	require.Equal(t, 2, job.FailedFiles)
+}
+
+func TestDatabase_IngestJobRollback(t *testing.T) {
+	var (
+		testCtx = context.Background()
+		dbInst  = integration.SetupDB(t)
+	)
+
+	job, err := dbInst.CreateIngestJob(testCtx, model.IngestJob{Status: model.JobStatusComplete})
+	require.Nil(t, err)
+
+	requested, err := dbInst.RequestIngestJobRollback(testCtx, job.ID, "user")
+	require.Nil(t, err)
+	require.True(t, requested)
+
+	// A failed rollback clears the request so that it may be requested again
+	require.Nil(t, dbInst.ClearIngestJobRollbackRequest(testCtx, job.ID))
+
+	job, err = dbInst.GetIngestJob(testCtx, job.ID)
+	require.Nil(t, err)
+	require.False(t, job.RollbackRequestedBy.Valid)
+
+	requested, err = dbInst.RequestIngestJobRollback(testCtx, job.ID, "other")
+	require.Nil(t, err)
+	require.True(t, requested)
+	require.Nil(t, dbInst.SetIngestJobRolledBack(testCtx, job.ID))
+
+	// A job that has been rolled back can not be rolled back again
+	requested, err = dbInst.RequestIngestJobRollback(testCtx, job.ID, "user")
+	require.Nil(t, err)
+	require.False(t, requested)
+
+	job, err = dbInst.GetIngestJob(testCtx, job.ID)
+	require.Nil(t, err)
+	require.Equal(t, "other", job.RollbackRequestedBy.ValueOrZero())
+	require.True(t, job.RolledBackAt.Valid)
+}
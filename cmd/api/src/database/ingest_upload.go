@@ -0,0 +1,103 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package database
+
+import (
+	"context"
+
+	"github.com/specterops/bloodhound/src/model"
+	"github.com/specterops/bloodhound/src/services/ingest"
+	"gorm.io/gorm"
+)
+
+func (s *BloodhoundDB) CreateIngestUpload(ctx context.Context, upload model.IngestUpload) (model.IngestUpload, error) {
+	result := s.db.WithContext(ctx).Create(&upload)
+	return upload, CheckError(result)
+}
+
+func (s *BloodhoundDB) GetIngestUpload(ctx context.Context, id int64) (model.IngestUpload, error) {
+	var upload model.IngestUpload
+
+	result := s.db.WithContext(ctx).First(&upload, id)
+	return upload, CheckError(result)
+}
+
+// GetIngestUploadChunks returns the received chunks of the given upload in offset order
+func (s *BloodhoundDB) GetIngestUploadChunks(ctx context.Context, uploadID int64) (model.IngestUploadChunks, error) {
+	var chunks model.IngestUploadChunks
+
+	result := s.db.WithContext(ctx).Where("upload_id = ?", uploadID).Order("chunk_offset").Find(&chunks)
+	return chunks, CheckError(result)
+}
+
+// GetAllIngestUploadChunks returns the received chunks of every ingest upload that has not been assembled yet
+func (s *BloodhoundDB) GetAllIngestUploadChunks(ctx context.Context) (model.IngestUploadChunks, error) {
+	var chunks model.IngestUploadChunks
+
+	result := s.db.WithContext(ctx).Find(&chunks)
+	return chunks, CheckError(result)
+}
+
+// AppendIngestUploadChunk records a received chunk and advances the received size of its upload. The chunk must start
+// at the received size of the upload and fit within its total size, otherwise ingest.ErrUploadConflict is returned.
+func (s *BloodhoundDB) AppendIngestUploadChunk(ctx context.Context, chunk model.IngestUploadChunk) (model.IngestUpload, error) {
+	var upload model.IngestUpload
+
+	return upload, s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if result := tx.Model(&model.IngestUpload{}).
+			Where("id = ? AND received_size = ? AND received_size + ? <= total_size AND NOT completed", chunk.UploadID, chunk.Offset, chunk.Size).
+			Update("received_size", gorm.Expr("received_size + ?", chunk.Size)); result.Error != nil {
+			return result.Error
+		} else if result.RowsAffected == 0 {
+			return ingest.ErrUploadConflict
+		} else if err := tx.Create(&chunk).Error; err != nil {
+			return err
+		} else {
+			return CheckError(tx.First(&upload, chunk.UploadID))
+		}
+	})
+}
+
+// CompleteIngestUpload marks a fully received upload as completed, dropping its chunks and creating the ingest task
+// for its assembled file. ingest.ErrUploadConflict is returned if the upload was already completed.
+func (s *BloodhoundDB) CompleteIngestUpload(ctx context.Context, upload model.IngestUpload, task model.IngestTask) (model.IngestTask, error) {
+	return task, s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if result := tx.Model(&model.IngestUpload{}).
+			Where("id = ? AND received_size = total_size AND NOT completed", upload.ID).
+			Update("completed", true); result.Error != nil {
+			return result.Error
+		} else if result.RowsAffected == 0 {
+			return ingest.ErrUploadConflict
+		} else if err := tx.Where("upload_id = ?", upload.ID).Delete(&model.IngestUploadChunk{}).Error; err != nil {
+			return err
+		} else {
+			return tx.Create(&task).Error
+		}
+	})
+}
+
+func (s *BloodhoundDB) DeleteIngestUpload(ctx context.Context, id int64) error {
+	return CheckError(s.db.WithContext(ctx).Delete(&model.IngestUpload{}, id))
+}
+
+// DeleteAbandonedIngestUploads deletes the uploads that were not completed before their ingest job stopped accepting
+// files. The chunks of those uploads are left for the orphaned file sweeper to remove from the ingest store.
+func (s *BloodhoundDB) DeleteAbandonedIngestUploads(ctx context.Context) error {
+	return CheckError(s.db.WithContext(ctx).
+		Where("NOT completed AND ingest_job_id NOT IN (SELECT id FROM ingest_jobs WHERE status = ?)", model.JobStatusRunning).
+		Delete(&model.IngestUpload{}))
+}
@@ -0,0 +1,128 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build integration
+// +build integration
+
+package database_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/specterops/bloodhound/src/database"
+	"github.com/specterops/bloodhound/src/database/types/null"
+	"github.com/specterops/bloodhound/src/model"
+	"github.com/specterops/bloodhound/src/services/ingest"
+	"github.com/specterops/bloodhound/src/test/integration"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatabase_IngestUploads(t *testing.T) {
+	var (
+		testCtx = context.Background()
+		dbInst  = integration.SetupDB(t)
+	)
+
+	job, err := dbInst.CreateIngestJob(testCtx, model.IngestJob{Status: model.JobStatusRunning})
+	require.Nil(t, err)
+
+	upload, err := dbInst.CreateIngestUpload(testCtx, model.IngestUpload{IngestJobID: job.ID, FileType: model.FileTypeZip, TotalSize: 10})
+	require.Nil(t, err)
+
+	upload, err = dbInst.AppendIngestUploadChunk(testCtx, model.IngestUploadChunk{UploadID: upload.ID, Offset: 0, Size: 6, FileName: "chunk-0"})
+	require.Nil(t, err)
+	require.Equal(t, int64(6), upload.ReceivedSize)
+
+	// A chunk that does not start at the received size or overruns the total size is rejected
+	_, err = dbInst.AppendIngestUploadChunk(testCtx, model.IngestUploadChunk{UploadID: upload.ID, Offset: 0, Size: 6, FileName: "chunk-1"})
+	require.ErrorIs(t, err, ingest.ErrUploadConflict)
+
+	_, err = dbInst.AppendIngestUploadChunk(testCtx, model.IngestUploadChunk{UploadID: upload.ID, Offset: 6, Size: 5, FileName: "chunk-1"})
+	require.ErrorIs(t, err, ingest.ErrUploadConflict)
+
+	// The upload can only be completed once all of its chunks have been received
+	_, err = dbInst.CompleteIngestUpload(testCtx, upload, model.IngestTask{FileName: "assembled", TaskID: null.Int64From(job.ID)})
+	require.ErrorIs(t, err, ingest.ErrUploadConflict)
+
+	upload, err = dbInst.AppendIngestUploadChunk(testCtx, model.IngestUploadChunk{UploadID: upload.ID, Offset: 6, Size: 4, FileName: "chunk-1"})
+	require.Nil(t, err)
+	require.Equal(t, int64(10), upload.ReceivedSize)
+
+	chunks, err := dbInst.GetIngestUploadChunks(testCtx, upload.ID)
+	require.Nil(t, err)
+	require.Len(t, chunks, 2)
+	require.Equal(t, "chunk-0", chunks[0].FileName)
+	require.Equal(t, "chunk-1", chunks[1].FileName)
+
+	allChunks, err := dbInst.GetAllIngestUploadChunks(testCtx)
+	require.Nil(t, err)
+	require.Len(t, allChunks, 2)
+
+	task, err := dbInst.CompleteIngestUpload(testCtx, upload, model.IngestTask{FileName: "assembled", TaskID: null.Int64From(job.ID), FileType: model.FileTypeZip})
+	require.Nil(t, err)
+	require.NotZero(t, task.ID)
+
+	_, err = dbInst.CompleteIngestUpload(testCtx, upload, model.IngestTask{FileName: "assembled", TaskID: null.Int64From(job.ID)})
+	require.ErrorIs(t, err, ingest.ErrUploadConflict)
+
+	upload, err = dbInst.GetIngestUpload(testCtx, upload.ID)
+	require.Nil(t, err)
+	require.True(t, upload.Completed)
+
+	chunks, err = dbInst.GetIngestUploadChunks(testCtx, upload.ID)
+	require.Nil(t, err)
+	require.Empty(t, chunks)
+
+	tasks, err := dbInst.GetIngestTasksForJob(testCtx, job.ID)
+	require.Nil(t, err)
+	require.Len(t, tasks, 1)
+	require.Equal(t, "assembled", tasks[0].FileName)
+}
+
+func TestDatabase_DeleteAbandonedIngestUploads(t *testing.T) {
+	var (
+		testCtx = context.Background()
+		dbInst  = integration.SetupDB(t)
+	)
+
+	runningJob, err := dbInst.CreateIngestJob(testCtx, model.IngestJob{Status: model.JobStatusRunning})
+	require.Nil(t, err)
+
+	endedJob, err := dbInst.CreateIngestJob(testCtx, model.IngestJob{Status: model.JobStatusTimedOut})
+	require.Nil(t, err)
+
+	activeUpload, err := dbInst.CreateIngestUpload(testCtx, model.IngestUpload{IngestJobID: runningJob.ID, TotalSize: 10})
+	require.Nil(t, err)
+
+	abandonedUpload, err := dbInst.CreateIngestUpload(testCtx, model.IngestUpload{IngestJobID: endedJob.ID, TotalSize: 10})
+	require.Nil(t, err)
+
+	_, err = dbInst.AppendIngestUploadChunk(testCtx, model.IngestUploadChunk{UploadID: abandonedUpload.ID, Offset: 0, Size: 4, FileName: "abandoned"})
+	require.Nil(t, err)
+
+	require.Nil(t, dbInst.DeleteAbandonedIngestUploads(testCtx))
+
+	_, err = dbInst.GetIngestUpload(testCtx, activeUpload.ID)
+	require.Nil(t, err)
+
+	_, err = dbInst.GetIngestUpload(testCtx, abandonedUpload.ID)
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	chunks, err := dbInst.GetAllIngestUploadChunks(testCtx)
+	require.Nil(t, err)
+	require.Empty(t, chunks)
+}
@@ -0,0 +1,166 @@
+-- Copyright 2025 Specter Ops, Inc.
+--
+-- Licensed under the Apache License, Version 2.0
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--     http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- Add workspaces table. Each workspace is backed by its own graph namespace
+CREATE TABLE IF NOT EXISTS workspaces
+(
+  id          SERIAL NOT NULL,
+  name        TEXT   NOT NULL,
+  description TEXT   NOT NULL DEFAULT '',
+  created_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT current_timestamp,
+  updated_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT current_timestamp,
+  PRIMARY KEY (id),
+  UNIQUE (name)
+);
+
+-- Add workspace_users table granting users access to a workspace
+CREATE TABLE IF NOT EXISTS workspace_users
+(
+  workspace_id INTEGER NOT NULL REFERENCES workspaces (id) ON DELETE CASCADE,
+  user_id      TEXT    NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+  created_at   TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT current_timestamp,
+  PRIMARY KEY (workspace_id, user_id)
+);
+
+-- Ingest jobs without a workspace target the default graph
+ALTER TABLE IF EXISTS ingest_jobs
+  ADD COLUMN IF NOT EXISTS workspace_id INTEGER REFERENCES workspaces (id) ON DELETE CASCADE;
+
+-- Add prune enabled parameter. Expired graph data is only pruned during reconciliation once it has been enabled
+INSERT INTO parameters (key, name, description, value, created_at, updated_at)
+VALUES ('prune.enabled', 'Prune Enabled',
+        'This configuration parameter enables / disables the pruning of graph data older than the prune retention TTLs during reconciliation.',
+        '{"enabled": false}',
+        current_timestamp, current_timestamp)
+ON CONFLICT DO NOTHING;
+
+-- Add prune soft delete parameter. When enabled, reconciliation marks expired graph data as stale instead of deleting it
+INSERT INTO parameters (key, name, description, value, created_at, updated_at)
+VALUES ('prune.soft_delete', 'Prune Soft Delete',
+        'This configuration parameter marks graph data older than the prune retention TTLs as stale instead of deleting it during reconciliation.',
+        '{"enabled": false}',
+        current_timestamp, current_timestamp)
+ON CONFLICT DO NOTHING;
+
+-- Add asset_group_tag_certifications table tracking the certification of each member of a tier
+CREATE TABLE IF NOT EXISTS asset_group_tag_certifications
+(
+  id                 BIGSERIAL NOT NULL,
+  asset_group_tag_id INTEGER   NOT NULL REFERENCES asset_group_tags (id) ON DELETE CASCADE,
+  object_id          TEXT      NOT NULL,
+  status             TEXT      NOT NULL DEFAULT 'pending',
+  certified_by       TEXT,
+  certified_at       TIMESTAMP WITH TIME ZONE,
+  note               TEXT,
+  created_at         TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT current_timestamp,
+  updated_at         TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT current_timestamp,
+  PRIMARY KEY (id),
+  UNIQUE (asset_group_tag_id, object_id)
+);
+
+-- Add asset_group_tag_selector_nodes table recording the graph nodes selected by each asset group tag selector
+CREATE TABLE IF NOT EXISTS asset_group_tag_selector_nodes
+(
+  selector_id INTEGER NOT NULL REFERENCES asset_group_tag_selectors (id) ON DELETE CASCADE,
+  node_id     BIGINT  NOT NULL,
+  object_id   TEXT    NOT NULL DEFAULT '',
+  created_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT current_timestamp,
+  updated_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT current_timestamp,
+  PRIMARY KEY (selector_id, node_id)
+);
+
+-- Add generic_kinds table recording the node and edge kinds declared by generic ingest files
+CREATE TABLE IF NOT EXISTS generic_kinds
+(
+  name       TEXT    NOT NULL,
+  is_edge    BOOLEAN NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT current_timestamp,
+  PRIMARY KEY (name)
+);
+
+-- Record requests to cancel in-flight analysis and who canceled an ingest job
+ALTER TABLE IF EXISTS datapipe_status
+  ADD COLUMN IF NOT EXISTS cancellation_requested_by TEXT,
+  ADD COLUMN IF NOT EXISTS cancellation_requested_at TIMESTAMP WITH TIME ZONE;
+
+ALTER TABLE IF EXISTS ingest_jobs
+  ADD COLUMN IF NOT EXISTS canceled_by TEXT;
+
+-- Add analysis_runs table recording the outcome, step durations and post-processed relationship counts of each analysis run
+CREATE TABLE IF NOT EXISTS analysis_runs
+(
+  id            BIGSERIAL NOT NULL,
+  trigger       TEXT      NOT NULL,
+  requested_by  TEXT      NOT NULL DEFAULT '',
+  status        TEXT      NOT NULL,
+  started_at    TIMESTAMP WITH TIME ZONE NOT NULL,
+  completed_at  TIMESTAMP WITH TIME ZONE,
+  steps         JSONB     NOT NULL DEFAULT '[]',
+  edges_created JSONB     NOT NULL DEFAULT '{}',
+  edges_deleted JSONB     NOT NULL DEFAULT '{}',
+  errors        JSONB     NOT NULL DEFAULT '[]',
+  created_at    TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT current_timestamp,
+  updated_at    TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT current_timestamp,
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_analysis_runs_started_at ON analysis_runs (started_at);
+
+-- Add cluster_nodes table tracking the API replicas sharing this database and which of them holds the datapipe leader lock
+CREATE TABLE IF NOT EXISTS cluster_nodes
+(
+  node_id           TEXT    NOT NULL,
+  hostname          TEXT    NOT NULL DEFAULT '',
+  is_leader         BOOLEAN NOT NULL DEFAULT false,
+  started_at        TIMESTAMP WITH TIME ZONE NOT NULL,
+  last_heartbeat_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  PRIMARY KEY (node_id)
+);
+
+-- Add ingest_uploads and ingest_upload_chunks tables tracking files uploaded to an ingest job in resumable chunks
+CREATE TABLE IF NOT EXISTS ingest_uploads
+(
+  id            BIGSERIAL NOT NULL,
+  ingest_job_id BIGINT    NOT NULL REFERENCES ingest_jobs (id) ON DELETE CASCADE,
+  file_type     INTEGER   NOT NULL,
+  total_size    BIGINT    NOT NULL,
+  received_size BIGINT    NOT NULL DEFAULT 0,
+  completed     BOOLEAN   NOT NULL DEFAULT false,
+  created_at    TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT current_timestamp,
+  updated_at    TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT current_timestamp,
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_ingest_uploads_ingest_job_id ON ingest_uploads (ingest_job_id);
+
+CREATE TABLE IF NOT EXISTS ingest_upload_chunks
+(
+  upload_id    BIGINT NOT NULL REFERENCES ingest_uploads (id) ON DELETE CASCADE,
+  chunk_offset BIGINT NOT NULL,
+  size         BIGINT NOT NULL,
+  file_name    TEXT   NOT NULL,
+  sha256       TEXT   NOT NULL,
+  PRIMARY KEY (upload_id, chunk_offset)
+);
+
+-- Record rollback requests for ingest jobs whose graph additions should be removed
+ALTER TABLE IF EXISTS ingest_jobs
+  ADD COLUMN IF NOT EXISTS rollback_requested_by TEXT,
+  ADD COLUMN IF NOT EXISTS rolled_back_at TIMESTAMP WITH TIME ZONE;
+
+-- Count completed analysis runs so that every replica can tell when to invalidate its graph query cache
+ALTER TABLE IF EXISTS datapipe_status
+  ADD COLUMN IF NOT EXISTS analysis_generation BIGINT NOT NULL DEFAULT 0;
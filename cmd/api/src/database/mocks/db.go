@@ -56,6 +56,39 @@ func (m *MockDatabase) EXPECT() *MockDatabaseMockRecorder {
 	return m.recorder
 }
 
+// AddIngestJobFiles mocks base method.
+func (m *MockDatabase) AddIngestJobFiles(arg0 context.Context, arg1 int64, arg2, arg3 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddIngestJobFiles", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddIngestJobFiles indicates an expected call of AddIngestJobFiles.
+func (mr *MockDatabaseMockRecorder) AddIngestJobFiles(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddIngestJobFiles", reflect.TypeOf((*MockDatabase)(nil).AddIngestJobFiles), arg0, arg1, arg2, arg3)
+}
+
+// AddWorkspaceUsers mocks base method.
+func (m *MockDatabase) AddWorkspaceUsers(arg0 context.Context, arg1 int32, arg2 ...uuid.UUID) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddWorkspaceUsers", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddWorkspaceUsers indicates an expected call of AddWorkspaceUsers.
+func (mr *MockDatabaseMockRecorder) AddWorkspaceUsers(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkspaceUsers", reflect.TypeOf((*MockDatabase)(nil).AddWorkspaceUsers), varargs...)
+}
+
 // AppendAuditLog mocks base method.
 func (m *MockDatabase) AppendAuditLog(arg0 context.Context, arg1 model.AuditEntry) error {
 	m.ctrl.T.Helper()
@@ -70,6 +103,21 @@ func (mr *MockDatabaseMockRecorder) AppendAuditLog(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendAuditLog", reflect.TypeOf((*MockDatabase)(nil).AppendAuditLog), arg0, arg1)
 }
 
+// AppendIngestUploadChunk mocks base method.
+func (m *MockDatabase) AppendIngestUploadChunk(arg0 context.Context, arg1 model.IngestUploadChunk) (model.IngestUpload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendIngestUploadChunk", arg0, arg1)
+	ret0, _ := ret[0].(model.IngestUpload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppendIngestUploadChunk indicates an expected call of AppendIngestUploadChunk.
+func (mr *MockDatabaseMockRecorder) AppendIngestUploadChunk(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendIngestUploadChunk", reflect.TypeOf((*MockDatabase)(nil).AppendIngestUploadChunk), arg0, arg1)
+}
+
 // CancelAllIngestJobs mocks base method.
 func (m *MockDatabase) CancelAllIngestJobs(arg0 context.Context) error {
 	m.ctrl.T.Helper()
@@ -84,6 +132,35 @@ func (mr *MockDatabaseMockRecorder) CancelAllIngestJobs(arg0 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelAllIngestJobs", reflect.TypeOf((*MockDatabase)(nil).CancelAllIngestJobs), arg0)
 }
 
+// CancelIngestJob mocks base method.
+func (m *MockDatabase) CancelIngestJob(arg0 context.Context, arg1 int64, arg2 []model.JobStatus, arg3, arg4 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelIngestJob", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelIngestJob indicates an expected call of CancelIngestJob.
+func (mr *MockDatabaseMockRecorder) CancelIngestJob(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelIngestJob", reflect.TypeOf((*MockDatabase)(nil).CancelIngestJob), arg0, arg1, arg2, arg3, arg4)
+}
+
+// ClearIngestJobRollbackRequest mocks base method.
+func (m *MockDatabase) ClearIngestJobRollbackRequest(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearIngestJobRollbackRequest", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearIngestJobRollbackRequest indicates an expected call of ClearIngestJobRollbackRequest.
+func (mr *MockDatabaseMockRecorder) ClearIngestJobRollbackRequest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearIngestJobRollbackRequest", reflect.TypeOf((*MockDatabase)(nil).ClearIngestJobRollbackRequest), arg0, arg1)
+}
+
 // Close mocks base method.
 func (m *MockDatabase) Close(arg0 context.Context) {
 	m.ctrl.T.Helper()
@@ -96,6 +173,21 @@ func (mr *MockDatabaseMockRecorder) Close(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockDatabase)(nil).Close), arg0)
 }
 
+// CompleteIngestUpload mocks base method.
+func (m *MockDatabase) CompleteIngestUpload(arg0 context.Context, arg1 model.IngestUpload, arg2 model.IngestTask) (model.IngestTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteIngestUpload", arg0, arg1, arg2)
+	ret0, _ := ret[0].(model.IngestTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteIngestUpload indicates an expected call of CompleteIngestUpload.
+func (mr *MockDatabaseMockRecorder) CompleteIngestUpload(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteIngestUpload", reflect.TypeOf((*MockDatabase)(nil).CompleteIngestUpload), arg0, arg1, arg2)
+}
+
 // CountAllIngestTasks mocks base method.
 func (m *MockDatabase) CountAllIngestTasks(arg0 context.Context) (int64, error) {
 	m.ctrl.T.Helper()
@@ -141,6 +233,21 @@ func (mr *MockDatabaseMockRecorder) CreateADDataQualityStats(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateADDataQualityStats", reflect.TypeOf((*MockDatabase)(nil).CreateADDataQualityStats), arg0, arg1)
 }
 
+// CreateAnalysisRun mocks base method.
+func (m *MockDatabase) CreateAnalysisRun(arg0 context.Context, arg1 model.AnalysisRun) (model.AnalysisRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAnalysisRun", arg0, arg1)
+	ret0, _ := ret[0].(model.AnalysisRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAnalysisRun indicates an expected call of CreateAnalysisRun.
+func (mr *MockDatabaseMockRecorder) CreateAnalysisRun(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAnalysisRun", reflect.TypeOf((*MockDatabase)(nil).CreateAnalysisRun), arg0, arg1)
+}
+
 // CreateAssetGroup mocks base method.
 func (m *MockDatabase) CreateAssetGroup(arg0 context.Context, arg1, arg2 string, arg3 bool) (model.AssetGroup, error) {
 	m.ctrl.T.Helper()
@@ -304,6 +411,20 @@ func (mr *MockDatabaseMockRecorder) CreateCompositionInfo(arg0, arg1, arg2 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCompositionInfo", reflect.TypeOf((*MockDatabase)(nil).CreateCompositionInfo), arg0, arg1, arg2)
 }
 
+// CreateGenericKinds mocks base method.
+func (m *MockDatabase) CreateGenericKinds(arg0 context.Context, arg1 model.GenericKinds) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGenericKinds", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateGenericKinds indicates an expected call of CreateGenericKinds.
+func (mr *MockDatabaseMockRecorder) CreateGenericKinds(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGenericKinds", reflect.TypeOf((*MockDatabase)(nil).CreateGenericKinds), arg0, arg1)
+}
+
 // CreateIngestJob mocks base method.
 func (m *MockDatabase) CreateIngestJob(arg0 context.Context, arg1 model.IngestJob) (model.IngestJob, error) {
 	m.ctrl.T.Helper()
@@ -334,6 +455,21 @@ func (mr *MockDatabaseMockRecorder) CreateIngestTask(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIngestTask", reflect.TypeOf((*MockDatabase)(nil).CreateIngestTask), arg0, arg1)
 }
 
+// CreateIngestUpload mocks base method.
+func (m *MockDatabase) CreateIngestUpload(arg0 context.Context, arg1 model.IngestUpload) (model.IngestUpload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIngestUpload", arg0, arg1)
+	ret0, _ := ret[0].(model.IngestUpload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIngestUpload indicates an expected call of CreateIngestUpload.
+func (mr *MockDatabaseMockRecorder) CreateIngestUpload(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIngestUpload", reflect.TypeOf((*MockDatabase)(nil).CreateIngestUpload), arg0, arg1)
+}
+
 // CreateInstallation mocks base method.
 func (m *MockDatabase) CreateInstallation(arg0 context.Context) (model.Installation, error) {
 	m.ctrl.T.Helper()
@@ -474,6 +610,35 @@ func (mr *MockDatabaseMockRecorder) CreateUserSession(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserSession", reflect.TypeOf((*MockDatabase)(nil).CreateUserSession), arg0, arg1)
 }
 
+// CreateWorkspace mocks base method.
+func (m *MockDatabase) CreateWorkspace(arg0 context.Context, arg1, arg2 string) (model.Workspace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWorkspace", arg0, arg1, arg2)
+	ret0, _ := ret[0].(model.Workspace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWorkspace indicates an expected call of CreateWorkspace.
+func (mr *MockDatabaseMockRecorder) CreateWorkspace(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkspace", reflect.TypeOf((*MockDatabase)(nil).CreateWorkspace), arg0, arg1, arg2)
+}
+
+// DeleteAbandonedIngestUploads mocks base method.
+func (m *MockDatabase) DeleteAbandonedIngestUploads(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAbandonedIngestUploads", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAbandonedIngestUploads indicates an expected call of DeleteAbandonedIngestUploads.
+func (mr *MockDatabaseMockRecorder) DeleteAbandonedIngestUploads(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAbandonedIngestUploads", reflect.TypeOf((*MockDatabase)(nil).DeleteAbandonedIngestUploads), arg0)
+}
+
 // DeleteAllDataQuality mocks base method.
 func (m *MockDatabase) DeleteAllDataQuality(arg0 context.Context) error {
 	m.ctrl.T.Helper()
@@ -572,6 +737,20 @@ func (mr *MockDatabaseMockRecorder) DeleteAssetGroupSelectorsForAssetGroups(arg0
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAssetGroupSelectorsForAssetGroups", reflect.TypeOf((*MockDatabase)(nil).DeleteAssetGroupSelectorsForAssetGroups), arg0, arg1)
 }
 
+// DeleteAssetGroupTag mocks base method.
+func (m *MockDatabase) DeleteAssetGroupTag(arg0 context.Context, arg1 string, arg2 model.AssetGroupTag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAssetGroupTag", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAssetGroupTag indicates an expected call of DeleteAssetGroupTag.
+func (mr *MockDatabaseMockRecorder) DeleteAssetGroupTag(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAssetGroupTag", reflect.TypeOf((*MockDatabase)(nil).DeleteAssetGroupTag), arg0, arg1, arg2)
+}
+
 // DeleteAuthSecret mocks base method.
 func (m *MockDatabase) DeleteAuthSecret(arg0 context.Context, arg1 model.AuthSecret) error {
 	m.ctrl.T.Helper()
@@ -614,6 +793,20 @@ func (mr *MockDatabaseMockRecorder) DeleteIngestTask(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIngestTask", reflect.TypeOf((*MockDatabase)(nil).DeleteIngestTask), arg0, arg1)
 }
 
+// DeleteIngestUpload mocks base method.
+func (m *MockDatabase) DeleteIngestUpload(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIngestUpload", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIngestUpload indicates an expected call of DeleteIngestUpload.
+func (mr *MockDatabaseMockRecorder) DeleteIngestUpload(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIngestUpload", reflect.TypeOf((*MockDatabase)(nil).DeleteIngestUpload), arg0, arg1)
+}
+
 // DeleteSSOProvider mocks base method.
 func (m *MockDatabase) DeleteSSOProvider(arg0 context.Context, arg1 int) error {
 	m.ctrl.T.Helper()
@@ -675,6 +868,35 @@ func (mr *MockDatabaseMockRecorder) DeleteUser(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockDatabase)(nil).DeleteUser), arg0, arg1)
 }
 
+// DeleteWorkspace mocks base method.
+func (m *MockDatabase) DeleteWorkspace(arg0 context.Context, arg1 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkspace", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkspace indicates an expected call of DeleteWorkspace.
+func (mr *MockDatabaseMockRecorder) DeleteWorkspace(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkspace", reflect.TypeOf((*MockDatabase)(nil).DeleteWorkspace), arg0, arg1)
+}
+
+// EndIngestJob mocks base method.
+func (m *MockDatabase) EndIngestJob(arg0 context.Context, arg1 int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndIngestJob", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EndIngestJob indicates an expected call of EndIngestJob.
+func (mr *MockDatabaseMockRecorder) EndIngestJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndIngestJob", reflect.TypeOf((*MockDatabase)(nil).EndIngestJob), arg0, arg1)
+}
+
 // EndUserSession mocks base method.
 func (m *MockDatabase) EndUserSession(arg0 context.Context, arg1 model.UserSession) {
 	m.ctrl.T.Helper()
@@ -825,6 +1047,21 @@ func (mr *MockDatabaseMockRecorder) GetAllIngestTasks(arg0 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllIngestTasks", reflect.TypeOf((*MockDatabase)(nil).GetAllIngestTasks), arg0)
 }
 
+// GetAllIngestUploadChunks mocks base method.
+func (m *MockDatabase) GetAllIngestUploadChunks(arg0 context.Context) (model.IngestUploadChunks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllIngestUploadChunks", arg0)
+	ret0, _ := ret[0].(model.IngestUploadChunks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllIngestUploadChunks indicates an expected call of GetAllIngestUploadChunks.
+func (mr *MockDatabaseMockRecorder) GetAllIngestUploadChunks(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllIngestUploadChunks", reflect.TypeOf((*MockDatabase)(nil).GetAllIngestUploadChunks), arg0)
+}
+
 // GetAllPermissions mocks base method.
 func (m *MockDatabase) GetAllPermissions(arg0 context.Context, arg1 string, arg2 model.SQLFilter) (model.Permissions, error) {
 	m.ctrl.T.Helper()
@@ -900,6 +1137,36 @@ func (mr *MockDatabaseMockRecorder) GetAllUsers(arg0, arg1, arg2 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllUsers", reflect.TypeOf((*MockDatabase)(nil).GetAllUsers), arg0, arg1, arg2)
 }
 
+// GetAllWorkspaces mocks base method.
+func (m *MockDatabase) GetAllWorkspaces(arg0 context.Context) (model.Workspaces, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllWorkspaces", arg0)
+	ret0, _ := ret[0].(model.Workspaces)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllWorkspaces indicates an expected call of GetAllWorkspaces.
+func (mr *MockDatabaseMockRecorder) GetAllWorkspaces(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllWorkspaces", reflect.TypeOf((*MockDatabase)(nil).GetAllWorkspaces), arg0)
+}
+
+// GetAnalysisGeneration mocks base method.
+func (m *MockDatabase) GetAnalysisGeneration(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAnalysisGeneration", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAnalysisGeneration indicates an expected call of GetAnalysisGeneration.
+func (mr *MockDatabaseMockRecorder) GetAnalysisGeneration(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnalysisGeneration", reflect.TypeOf((*MockDatabase)(nil).GetAnalysisGeneration), arg0)
+}
+
 // GetAnalysisRequest mocks base method.
 func (m *MockDatabase) GetAnalysisRequest(arg0 context.Context) (model.AnalysisRequest, error) {
 	m.ctrl.T.Helper()
@@ -915,6 +1182,22 @@ func (mr *MockDatabaseMockRecorder) GetAnalysisRequest(arg0 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnalysisRequest", reflect.TypeOf((*MockDatabase)(nil).GetAnalysisRequest), arg0)
 }
 
+// GetAnalysisRuns mocks base method.
+func (m *MockDatabase) GetAnalysisRuns(arg0 context.Context, arg1, arg2 int, arg3 string) (model.AnalysisRuns, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAnalysisRuns", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(model.AnalysisRuns)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAnalysisRuns indicates an expected call of GetAnalysisRuns.
+func (mr *MockDatabaseMockRecorder) GetAnalysisRuns(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnalysisRuns", reflect.TypeOf((*MockDatabase)(nil).GetAnalysisRuns), arg0, arg1, arg2, arg3)
+}
+
 // GetAssetGroup mocks base method.
 func (m *MockDatabase) GetAssetGroup(arg0 context.Context, arg1 int32) (model.AssetGroup, error) {
 	m.ctrl.T.Helper()
@@ -990,6 +1273,21 @@ func (mr *MockDatabaseMockRecorder) GetAssetGroupTag(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssetGroupTag", reflect.TypeOf((*MockDatabase)(nil).GetAssetGroupTag), arg0, arg1)
 }
 
+// GetAssetGroupTagCertifications mocks base method.
+func (m *MockDatabase) GetAssetGroupTagCertifications(arg0 context.Context, arg1 int) (model.AssetGroupTagCertifications, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssetGroupTagCertifications", arg0, arg1)
+	ret0, _ := ret[0].(model.AssetGroupTagCertifications)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAssetGroupTagCertifications indicates an expected call of GetAssetGroupTagCertifications.
+func (mr *MockDatabaseMockRecorder) GetAssetGroupTagCertifications(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssetGroupTagCertifications", reflect.TypeOf((*MockDatabase)(nil).GetAssetGroupTagCertifications), arg0, arg1)
+}
+
 // GetAssetGroupTagSelectorBySelectorId mocks base method.
 func (m *MockDatabase) GetAssetGroupTagSelectorBySelectorId(arg0 context.Context, arg1 int) (model.AssetGroupTagSelector, error) {
 	m.ctrl.T.Helper()
@@ -1005,6 +1303,21 @@ func (mr *MockDatabaseMockRecorder) GetAssetGroupTagSelectorBySelectorId(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssetGroupTagSelectorBySelectorId", reflect.TypeOf((*MockDatabase)(nil).GetAssetGroupTagSelectorBySelectorId), arg0, arg1)
 }
 
+// GetAssetGroupTagSelectorNodes mocks base method.
+func (m *MockDatabase) GetAssetGroupTagSelectorNodes(arg0 context.Context, arg1 int) ([]model.AssetGroupTagSelectorNode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssetGroupTagSelectorNodes", arg0, arg1)
+	ret0, _ := ret[0].([]model.AssetGroupTagSelectorNode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAssetGroupTagSelectorNodes indicates an expected call of GetAssetGroupTagSelectorNodes.
+func (mr *MockDatabaseMockRecorder) GetAssetGroupTagSelectorNodes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssetGroupTagSelectorNodes", reflect.TypeOf((*MockDatabase)(nil).GetAssetGroupTagSelectorNodes), arg0, arg1)
+}
+
 // GetAssetGroupTagSelectorsByTagId mocks base method.
 func (m *MockDatabase) GetAssetGroupTagSelectorsByTagId(arg0 context.Context, arg1 int, arg2, arg3 model.SQLFilter) (model.AssetGroupTagSelectors, error) {
 	m.ctrl.T.Helper()
@@ -1020,6 +1333,21 @@ func (mr *MockDatabaseMockRecorder) GetAssetGroupTagSelectorsByTagId(arg0, arg1,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssetGroupTagSelectorsByTagId", reflect.TypeOf((*MockDatabase)(nil).GetAssetGroupTagSelectorsByTagId), arg0, arg1, arg2, arg3)
 }
 
+// GetAssetGroupTags mocks base method.
+func (m *MockDatabase) GetAssetGroupTags(arg0 context.Context, arg1 model.SQLFilter) (model.AssetGroupTags, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssetGroupTags", arg0, arg1)
+	ret0, _ := ret[0].(model.AssetGroupTags)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAssetGroupTags indicates an expected call of GetAssetGroupTags.
+func (mr *MockDatabaseMockRecorder) GetAssetGroupTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssetGroupTags", reflect.TypeOf((*MockDatabase)(nil).GetAssetGroupTags), arg0, arg1)
+}
+
 // GetAuthSecret mocks base method.
 func (m *MockDatabase) GetAuthSecret(arg0 context.Context, arg1 int32) (model.AuthSecret, error) {
 	m.ctrl.T.Helper()
@@ -1082,6 +1410,21 @@ func (mr *MockDatabaseMockRecorder) GetAzureDataQualityStats(arg0, arg1, arg2, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAzureDataQualityStats", reflect.TypeOf((*MockDatabase)(nil).GetAzureDataQualityStats), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
 }
 
+// GetClusterNodes mocks base method.
+func (m *MockDatabase) GetClusterNodes(arg0 context.Context, arg1 time.Time) (model.ClusterNodes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterNodes", arg0, arg1)
+	ret0, _ := ret[0].(model.ClusterNodes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClusterNodes indicates an expected call of GetClusterNodes.
+func (mr *MockDatabaseMockRecorder) GetClusterNodes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterNodes", reflect.TypeOf((*MockDatabase)(nil).GetClusterNodes), arg0, arg1)
+}
+
 // GetConfigurationParameter mocks base method.
 func (m *MockDatabase) GetConfigurationParameter(arg0 context.Context, arg1 string) (appcfg.Parameter, error) {
 	m.ctrl.T.Helper()
@@ -1142,6 +1485,21 @@ func (mr *MockDatabaseMockRecorder) GetFlagByKey(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFlagByKey", reflect.TypeOf((*MockDatabase)(nil).GetFlagByKey), arg0, arg1)
 }
 
+// GetGenericKinds mocks base method.
+func (m *MockDatabase) GetGenericKinds(arg0 context.Context) (model.GenericKinds, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGenericKinds", arg0)
+	ret0, _ := ret[0].(model.GenericKinds)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGenericKinds indicates an expected call of GetGenericKinds.
+func (mr *MockDatabaseMockRecorder) GetGenericKinds(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGenericKinds", reflect.TypeOf((*MockDatabase)(nil).GetGenericKinds), arg0)
+}
+
 // GetIngestJob mocks base method.
 func (m *MockDatabase) GetIngestJob(arg0 context.Context, arg1 int64) (model.IngestJob, error) {
 	m.ctrl.T.Helper()
@@ -1157,6 +1515,21 @@ func (mr *MockDatabaseMockRecorder) GetIngestJob(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIngestJob", reflect.TypeOf((*MockDatabase)(nil).GetIngestJob), arg0, arg1)
 }
 
+// GetIngestJobsPendingRollback mocks base method.
+func (m *MockDatabase) GetIngestJobsPendingRollback(arg0 context.Context) ([]model.IngestJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIngestJobsPendingRollback", arg0)
+	ret0, _ := ret[0].([]model.IngestJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIngestJobsPendingRollback indicates an expected call of GetIngestJobsPendingRollback.
+func (mr *MockDatabaseMockRecorder) GetIngestJobsPendingRollback(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIngestJobsPendingRollback", reflect.TypeOf((*MockDatabase)(nil).GetIngestJobsPendingRollback), arg0)
+}
+
 // GetIngestJobsWithStatus mocks base method.
 func (m *MockDatabase) GetIngestJobsWithStatus(arg0 context.Context, arg1 model.JobStatus) ([]model.IngestJob, error) {
 	m.ctrl.T.Helper()
@@ -1187,6 +1560,36 @@ func (mr *MockDatabaseMockRecorder) GetIngestTasksForJob(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIngestTasksForJob", reflect.TypeOf((*MockDatabase)(nil).GetIngestTasksForJob), arg0, arg1)
 }
 
+// GetIngestUpload mocks base method.
+func (m *MockDatabase) GetIngestUpload(arg0 context.Context, arg1 int64) (model.IngestUpload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIngestUpload", arg0, arg1)
+	ret0, _ := ret[0].(model.IngestUpload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIngestUpload indicates an expected call of GetIngestUpload.
+func (mr *MockDatabaseMockRecorder) GetIngestUpload(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIngestUpload", reflect.TypeOf((*MockDatabase)(nil).GetIngestUpload), arg0, arg1)
+}
+
+// GetIngestUploadChunks mocks base method.
+func (m *MockDatabase) GetIngestUploadChunks(arg0 context.Context, arg1 int64) (model.IngestUploadChunks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIngestUploadChunks", arg0, arg1)
+	ret0, _ := ret[0].(model.IngestUploadChunks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIngestUploadChunks indicates an expected call of GetIngestUploadChunks.
+func (mr *MockDatabaseMockRecorder) GetIngestUploadChunks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIngestUploadChunks", reflect.TypeOf((*MockDatabase)(nil).GetIngestUploadChunks), arg0, arg1)
+}
+
 // GetInstallation mocks base method.
 func (m *MockDatabase) GetInstallation(arg0 context.Context) (model.Installation, error) {
 	m.ctrl.T.Helper()
@@ -1382,6 +1785,21 @@ func (mr *MockDatabaseMockRecorder) GetScopeForSavedQuery(arg0, arg1, arg2 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScopeForSavedQuery", reflect.TypeOf((*MockDatabase)(nil).GetScopeForSavedQuery), arg0, arg1, arg2)
 }
 
+// GetSelectorSeedObjectIDs mocks base method.
+func (m *MockDatabase) GetSelectorSeedObjectIDs(arg0 context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSelectorSeedObjectIDs", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSelectorSeedObjectIDs indicates an expected call of GetSelectorSeedObjectIDs.
+func (mr *MockDatabaseMockRecorder) GetSelectorSeedObjectIDs(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSelectorSeedObjectIDs", reflect.TypeOf((*MockDatabase)(nil).GetSelectorSeedObjectIDs), arg0)
+}
+
 // GetSharedSavedQueries mocks base method.
 func (m *MockDatabase) GetSharedSavedQueries(arg0 context.Context, arg1 uuid.UUID) (model.SavedQueries, error) {
 	m.ctrl.T.Helper()
@@ -1457,6 +1875,51 @@ func (mr *MockDatabaseMockRecorder) GetUserToken(arg0, arg1, arg2 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserToken", reflect.TypeOf((*MockDatabase)(nil).GetUserToken), arg0, arg1, arg2)
 }
 
+// GetWorkspace mocks base method.
+func (m *MockDatabase) GetWorkspace(arg0 context.Context, arg1 int32) (model.Workspace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspace", arg0, arg1)
+	ret0, _ := ret[0].(model.Workspace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspace indicates an expected call of GetWorkspace.
+func (mr *MockDatabaseMockRecorder) GetWorkspace(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspace", reflect.TypeOf((*MockDatabase)(nil).GetWorkspace), arg0, arg1)
+}
+
+// GetWorkspaceUsers mocks base method.
+func (m *MockDatabase) GetWorkspaceUsers(arg0 context.Context, arg1 int32) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspaceUsers", arg0, arg1)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspaceUsers indicates an expected call of GetWorkspaceUsers.
+func (mr *MockDatabaseMockRecorder) GetWorkspaceUsers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceUsers", reflect.TypeOf((*MockDatabase)(nil).GetWorkspaceUsers), arg0, arg1)
+}
+
+// GetWorkspacesForUser mocks base method.
+func (m *MockDatabase) GetWorkspacesForUser(arg0 context.Context, arg1 uuid.UUID) (model.Workspaces, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspacesForUser", arg0, arg1)
+	ret0, _ := ret[0].(model.Workspaces)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspacesForUser indicates an expected call of GetWorkspacesForUser.
+func (mr *MockDatabaseMockRecorder) GetWorkspacesForUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspacesForUser", reflect.TypeOf((*MockDatabase)(nil).GetWorkspacesForUser), arg0, arg1)
+}
+
 // HasAnalysisRequest mocks base method.
 func (m *MockDatabase) HasAnalysisRequest(arg0 context.Context) bool {
 	m.ctrl.T.Helper()
@@ -1500,6 +1963,36 @@ func (mr *MockDatabaseMockRecorder) HasInstallation(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasInstallation", reflect.TypeOf((*MockDatabase)(nil).HasInstallation), arg0)
 }
 
+// HasWorkspaceAccess mocks base method.
+func (m *MockDatabase) HasWorkspaceAccess(arg0 context.Context, arg1 int32, arg2 uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasWorkspaceAccess", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasWorkspaceAccess indicates an expected call of HasWorkspaceAccess.
+func (mr *MockDatabaseMockRecorder) HasWorkspaceAccess(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasWorkspaceAccess", reflect.TypeOf((*MockDatabase)(nil).HasWorkspaceAccess), arg0, arg1, arg2)
+}
+
+// IncrementAnalysisGeneration mocks base method.
+func (m *MockDatabase) IncrementAnalysisGeneration(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementAnalysisGeneration", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementAnalysisGeneration indicates an expected call of IncrementAnalysisGeneration.
+func (mr *MockDatabaseMockRecorder) IncrementAnalysisGeneration(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementAnalysisGeneration", reflect.TypeOf((*MockDatabase)(nil).IncrementAnalysisGeneration), arg0)
+}
+
 // InitializeSecretAuth mocks base method.
 func (m *MockDatabase) InitializeSecretAuth(arg0 context.Context, arg1 model.User, arg2 model.AuthSecret) (model.Installation, error) {
 	m.ctrl.T.Helper()
@@ -1577,6 +2070,20 @@ func (mr *MockDatabaseMockRecorder) ListSavedQueries(arg0, arg1, arg2, arg3, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSavedQueries", reflect.TypeOf((*MockDatabase)(nil).ListSavedQueries), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
+// ListenClusterEvents mocks base method.
+func (m *MockDatabase) ListenClusterEvents(arg0 context.Context, arg1 func(string)) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListenClusterEvents", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListenClusterEvents indicates an expected call of ListenClusterEvents.
+func (mr *MockDatabaseMockRecorder) ListenClusterEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListenClusterEvents", reflect.TypeOf((*MockDatabase)(nil).ListenClusterEvents), arg0, arg1)
+}
+
 // LookupActiveSessionsByUser mocks base method.
 func (m *MockDatabase) LookupActiveSessionsByUser(arg0 context.Context, arg1 model.User) ([]model.UserSession, error) {
 	m.ctrl.T.Helper()
@@ -1621,6 +2128,39 @@ func (mr *MockDatabaseMockRecorder) Migrate(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Migrate", reflect.TypeOf((*MockDatabase)(nil).Migrate), arg0)
 }
 
+// NotifyClusterEvent mocks base method.
+func (m *MockDatabase) NotifyClusterEvent(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyClusterEvent", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyClusterEvent indicates an expected call of NotifyClusterEvent.
+func (mr *MockDatabaseMockRecorder) NotifyClusterEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyClusterEvent", reflect.TypeOf((*MockDatabase)(nil).NotifyClusterEvent), arg0, arg1)
+}
+
+// RemoveWorkspaceUsers mocks base method.
+func (m *MockDatabase) RemoveWorkspaceUsers(arg0 context.Context, arg1 int32, arg2 ...uuid.UUID) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveWorkspaceUsers", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveWorkspaceUsers indicates an expected call of RemoveWorkspaceUsers.
+func (mr *MockDatabaseMockRecorder) RemoveWorkspaceUsers(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveWorkspaceUsers", reflect.TypeOf((*MockDatabase)(nil).RemoveWorkspaceUsers), varargs...)
+}
+
 // RequestAnalysis mocks base method.
 func (m *MockDatabase) RequestAnalysis(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -1635,6 +2175,21 @@ func (mr *MockDatabaseMockRecorder) RequestAnalysis(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestAnalysis", reflect.TypeOf((*MockDatabase)(nil).RequestAnalysis), arg0, arg1)
 }
 
+// RequestAnalysisCancellation mocks base method.
+func (m *MockDatabase) RequestAnalysisCancellation(arg0 context.Context, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestAnalysisCancellation", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestAnalysisCancellation indicates an expected call of RequestAnalysisCancellation.
+func (mr *MockDatabaseMockRecorder) RequestAnalysisCancellation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestAnalysisCancellation", reflect.TypeOf((*MockDatabase)(nil).RequestAnalysisCancellation), arg0, arg1)
+}
+
 // RequestCollectedGraphDataDeletion mocks base method.
 func (m *MockDatabase) RequestCollectedGraphDataDeletion(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -1649,6 +2204,21 @@ func (mr *MockDatabaseMockRecorder) RequestCollectedGraphDataDeletion(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestCollectedGraphDataDeletion", reflect.TypeOf((*MockDatabase)(nil).RequestCollectedGraphDataDeletion), arg0, arg1)
 }
 
+// RequestIngestJobRollback mocks base method.
+func (m *MockDatabase) RequestIngestJobRollback(arg0 context.Context, arg1 int64, arg2 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestIngestJobRollback", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestIngestJobRollback indicates an expected call of RequestIngestJobRollback.
+func (mr *MockDatabaseMockRecorder) RequestIngestJobRollback(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestIngestJobRollback", reflect.TypeOf((*MockDatabase)(nil).RequestIngestJobRollback), arg0, arg1, arg2)
+}
+
 // SavedQueryBelongsToUser mocks base method.
 func (m *MockDatabase) SavedQueryBelongsToUser(arg0 context.Context, arg1 uuid.UUID, arg2 int64) (bool, error) {
 	m.ctrl.T.Helper()
@@ -1706,6 +2276,20 @@ func (mr *MockDatabaseMockRecorder) SetFlag(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFlag", reflect.TypeOf((*MockDatabase)(nil).SetFlag), arg0, arg1)
 }
 
+// SetIngestJobRolledBack mocks base method.
+func (m *MockDatabase) SetIngestJobRolledBack(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetIngestJobRolledBack", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetIngestJobRolledBack indicates an expected call of SetIngestJobRolledBack.
+func (mr *MockDatabaseMockRecorder) SetIngestJobRolledBack(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIngestJobRolledBack", reflect.TypeOf((*MockDatabase)(nil).SetIngestJobRolledBack), arg0, arg1)
+}
+
 // SetUserSessionFlag mocks base method.
 func (m *MockDatabase) SetUserSessionFlag(arg0 context.Context, arg1 *model.UserSession, arg2 model.SessionFlagKey, arg3 bool) error {
 	m.ctrl.T.Helper()
@@ -1732,6 +2316,18 @@ func (mr *MockDatabaseMockRecorder) SweepAssetGroupCollections(arg0 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SweepAssetGroupCollections", reflect.TypeOf((*MockDatabase)(nil).SweepAssetGroupCollections), arg0)
 }
 
+// SweepClusterNodes mocks base method.
+func (m *MockDatabase) SweepClusterNodes(arg0 context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SweepClusterNodes", arg0)
+}
+
+// SweepClusterNodes indicates an expected call of SweepClusterNodes.
+func (mr *MockDatabaseMockRecorder) SweepClusterNodes(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SweepClusterNodes", reflect.TypeOf((*MockDatabase)(nil).SweepClusterNodes), arg0)
+}
+
 // SweepSessions mocks base method.
 func (m *MockDatabase) SweepSessions(arg0 context.Context) {
 	m.ctrl.T.Helper()
@@ -1758,6 +2354,50 @@ func (mr *MockDatabaseMockRecorder) TerminateUserSessionsBySSOProvider(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateUserSessionsBySSOProvider", reflect.TypeOf((*MockDatabase)(nil).TerminateUserSessionsBySSOProvider), arg0, arg1)
 }
 
+// TouchIngestJobLastIngest mocks base method.
+func (m *MockDatabase) TouchIngestJobLastIngest(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchIngestJobLastIngest", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchIngestJobLastIngest indicates an expected call of TouchIngestJobLastIngest.
+func (mr *MockDatabaseMockRecorder) TouchIngestJobLastIngest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchIngestJobLastIngest", reflect.TypeOf((*MockDatabase)(nil).TouchIngestJobLastIngest), arg0, arg1)
+}
+
+// TryAcquireLeaderLock mocks base method.
+func (m *MockDatabase) TryAcquireLeaderLock(arg0 context.Context) (database.LeaderLock, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TryAcquireLeaderLock", arg0)
+	ret0, _ := ret[0].(database.LeaderLock)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// TryAcquireLeaderLock indicates an expected call of TryAcquireLeaderLock.
+func (mr *MockDatabaseMockRecorder) TryAcquireLeaderLock(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TryAcquireLeaderLock", reflect.TypeOf((*MockDatabase)(nil).TryAcquireLeaderLock), arg0)
+}
+
+// UpdateAnalysisRun mocks base method.
+func (m *MockDatabase) UpdateAnalysisRun(arg0 context.Context, arg1 model.AnalysisRun) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAnalysisRun", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAnalysisRun indicates an expected call of UpdateAnalysisRun.
+func (mr *MockDatabaseMockRecorder) UpdateAnalysisRun(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAnalysisRun", reflect.TypeOf((*MockDatabase)(nil).UpdateAnalysisRun), arg0, arg1)
+}
+
 // UpdateAssetGroup mocks base method.
 func (m *MockDatabase) UpdateAssetGroup(arg0 context.Context, arg1 model.AssetGroup) error {
 	m.ctrl.T.Helper()
@@ -1787,6 +2427,36 @@ func (mr *MockDatabaseMockRecorder) UpdateAssetGroupSelectors(arg0, arg1, arg2,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAssetGroupSelectors", reflect.TypeOf((*MockDatabase)(nil).UpdateAssetGroupSelectors), arg0, arg1, arg2, arg3)
 }
 
+// UpdateAssetGroupTag mocks base method.
+func (m *MockDatabase) UpdateAssetGroupTag(arg0 context.Context, arg1 string, arg2 model.AssetGroupTag) (model.AssetGroupTag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAssetGroupTag", arg0, arg1, arg2)
+	ret0, _ := ret[0].(model.AssetGroupTag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAssetGroupTag indicates an expected call of UpdateAssetGroupTag.
+func (mr *MockDatabaseMockRecorder) UpdateAssetGroupTag(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAssetGroupTag", reflect.TypeOf((*MockDatabase)(nil).UpdateAssetGroupTag), arg0, arg1, arg2)
+}
+
+// UpdateAssetGroupTagCertification mocks base method.
+func (m *MockDatabase) UpdateAssetGroupTagCertification(arg0 context.Context, arg1 string, arg2 model.AssetGroupTagCertification) (model.AssetGroupTagCertification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAssetGroupTagCertification", arg0, arg1, arg2)
+	ret0, _ := ret[0].(model.AssetGroupTagCertification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAssetGroupTagCertification indicates an expected call of UpdateAssetGroupTagCertification.
+func (mr *MockDatabaseMockRecorder) UpdateAssetGroupTagCertification(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAssetGroupTagCertification", reflect.TypeOf((*MockDatabase)(nil).UpdateAssetGroupTagCertification), arg0, arg1, arg2)
+}
+
 // UpdateAssetGroupTagSelector mocks base method.
 func (m *MockDatabase) UpdateAssetGroupTagSelector(arg0 context.Context, arg1 string, arg2 model.AssetGroupTagSelector) (model.AssetGroupTagSelector, error) {
 	m.ctrl.T.Helper()
@@ -1802,6 +2472,20 @@ func (mr *MockDatabaseMockRecorder) UpdateAssetGroupTagSelector(arg0, arg1, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAssetGroupTagSelector", reflect.TypeOf((*MockDatabase)(nil).UpdateAssetGroupTagSelector), arg0, arg1, arg2)
 }
 
+// UpdateAssetGroupTagSelectorNodes mocks base method.
+func (m *MockDatabase) UpdateAssetGroupTagSelectorNodes(arg0 context.Context, arg1 int, arg2 []model.AssetGroupTagSelectorNode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAssetGroupTagSelectorNodes", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAssetGroupTagSelectorNodes indicates an expected call of UpdateAssetGroupTagSelectorNodes.
+func (mr *MockDatabaseMockRecorder) UpdateAssetGroupTagSelectorNodes(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAssetGroupTagSelectorNodes", reflect.TypeOf((*MockDatabase)(nil).UpdateAssetGroupTagSelectorNodes), arg0, arg1, arg2)
+}
+
 // UpdateAuthSecret mocks base method.
 func (m *MockDatabase) UpdateAuthSecret(arg0 context.Context, arg1 model.AuthSecret) error {
 	m.ctrl.T.Helper()
@@ -1844,6 +2528,21 @@ func (mr *MockDatabaseMockRecorder) UpdateIngestJob(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIngestJob", reflect.TypeOf((*MockDatabase)(nil).UpdateIngestJob), arg0, arg1)
 }
 
+// UpdateIngestJobStatus mocks base method.
+func (m *MockDatabase) UpdateIngestJobStatus(arg0 context.Context, arg1 int64, arg2 model.JobStatus, arg3 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIngestJobStatus", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateIngestJobStatus indicates an expected call of UpdateIngestJobStatus.
+func (mr *MockDatabaseMockRecorder) UpdateIngestJobStatus(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIngestJobStatus", reflect.TypeOf((*MockDatabase)(nil).UpdateIngestJobStatus), arg0, arg1, arg2, arg3)
+}
+
 // UpdateOIDCProvider mocks base method.
 func (m *MockDatabase) UpdateOIDCProvider(arg0 context.Context, arg1 model.SSOProvider) (model.OIDCProvider, error) {
 	m.ctrl.T.Helper()
@@ -1918,6 +2617,20 @@ func (mr *MockDatabaseMockRecorder) UpdateUser(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockDatabase)(nil).UpdateUser), arg0, arg1)
 }
 
+// UpsertClusterNode mocks base method.
+func (m *MockDatabase) UpsertClusterNode(arg0 context.Context, arg1 model.ClusterNode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertClusterNode", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertClusterNode indicates an expected call of UpsertClusterNode.
+func (mr *MockDatabaseMockRecorder) UpsertClusterNode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertClusterNode", reflect.TypeOf((*MockDatabase)(nil).UpsertClusterNode), arg0, arg1)
+}
+
 // Wipe mocks base method.
 func (m *MockDatabase) Wipe(arg0 context.Context) error {
 	m.ctrl.T.Helper()
@@ -126,6 +126,20 @@ func TestParameters_GetConfigurationParameter(t *testing.T) {
 		require.Equal(t, expected.Name, parameter.Name)
 		require.Equal(t, expected.Description, parameter.Description)
 	})
+
+	t.Run("get prune enabled parameter", func(t *testing.T) {
+		parameter, err := dbInst.GetConfigurationParameter(testCtx, appcfg.PruneEnabledKey)
+		require.Nil(t, err)
+		expected := &appcfg.Parameter{
+			Key:         appcfg.PruneEnabledKey,
+			Name:        "Prune Enabled",
+			Description: "This configuration parameter enables / disables the pruning of graph data older than the prune retention TTLs during reconciliation.",
+		}
+		require.Equal(t, expected.Key, parameter.Key)
+		require.Equal(t, expected.Name, parameter.Name)
+		require.Equal(t, expected.Description, parameter.Description)
+		require.False(t, appcfg.GetPruneEnabledParameter(testCtx, dbInst))
+	})
 }
 
 func TestParameters_GetAllConfigurationParameter(t *testing.T) {
@@ -135,7 +149,7 @@ func TestParameters_GetAllConfigurationParameter(t *testing.T) {
 	)
 	parameters, err := dbInst.GetAllConfigurationParameters(testCtx)
 	require.Nil(t, err)
-	require.Len(t, parameters, 7)
+	require.Len(t, parameters, 9)
 	for _, parameter := range parameters {
 		if parameter.Key != appcfg.ScheduledAnalysis && parameter.Key != appcfg.TrustedProxiesConfig {
 			require.True(t, parameter.IsValidKey(parameter.Key))
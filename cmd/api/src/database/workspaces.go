@@ -0,0 +1,133 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"github.com/specterops/bloodhound/src/model"
+	"gorm.io/gorm/clause"
+)
+
+// WorkspaceData methods representing the database interactions pertaining to the workspaces and workspace_users models
+type WorkspaceData interface {
+	CreateWorkspace(ctx context.Context, name string, description string) (model.Workspace, error)
+	GetWorkspace(ctx context.Context, workspaceID int32) (model.Workspace, error)
+	GetAllWorkspaces(ctx context.Context) (model.Workspaces, error)
+	GetWorkspacesForUser(ctx context.Context, userID uuid.UUID) (model.Workspaces, error)
+	DeleteWorkspace(ctx context.Context, workspaceID int32) error
+	AddWorkspaceUsers(ctx context.Context, workspaceID int32, userIDs ...uuid.UUID) error
+	RemoveWorkspaceUsers(ctx context.Context, workspaceID int32, userIDs ...uuid.UUID) error
+	GetWorkspaceUsers(ctx context.Context, workspaceID int32) ([]uuid.UUID, error)
+	HasWorkspaceAccess(ctx context.Context, workspaceID int32, userID uuid.UUID) (bool, error)
+}
+
+func (s *BloodhoundDB) CreateWorkspace(ctx context.Context, name string, description string) (model.Workspace, error) {
+	workspace := model.Workspace{
+		Name:        name,
+		Description: description,
+	}
+
+	if err := CheckError(s.db.WithContext(ctx).Create(&workspace)); err != nil {
+		if strings.Contains(err.Error(), "duplicate key value violates unique constraint \"workspaces_name_key\"") {
+			return workspace, fmt.Errorf("%w: %v", ErrDuplicateWorkspaceName, err)
+		}
+
+		return workspace, err
+	}
+
+	return workspace, nil
+}
+
+func (s *BloodhoundDB) GetWorkspace(ctx context.Context, workspaceID int32) (model.Workspace, error) {
+	var workspace model.Workspace
+	return workspace, CheckError(s.db.WithContext(ctx).First(&workspace, workspaceID))
+}
+
+func (s *BloodhoundDB) GetAllWorkspaces(ctx context.Context) (model.Workspaces, error) {
+	var workspaces model.Workspaces
+	return workspaces, CheckError(s.db.WithContext(ctx).Order("id").Find(&workspaces))
+}
+
+// GetWorkspacesForUser returns the workspaces the given user has been granted access to
+func (s *BloodhoundDB) GetWorkspacesForUser(ctx context.Context, userID uuid.UUID) (model.Workspaces, error) {
+	var workspaces model.Workspaces
+
+	result := s.db.WithContext(ctx).
+		Joins("JOIN workspace_users ON workspace_users.workspace_id = workspaces.id").
+		Where("workspace_users.user_id = ?", userID).
+		Order("workspaces.id").
+		Find(&workspaces)
+
+	return workspaces, CheckError(result)
+}
+
+// DeleteWorkspace removes the workspace along with its user grants and ingest jobs. Graph data held in the workspace's
+// graph namespace must be removed separately.
+func (s *BloodhoundDB) DeleteWorkspace(ctx context.Context, workspaceID int32) error {
+	result := s.db.WithContext(ctx).Delete(&model.Workspace{}, workspaceID)
+
+	if err := CheckError(result); err != nil {
+		return err
+	} else if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (s *BloodhoundDB) AddWorkspaceUsers(ctx context.Context, workspaceID int32, userIDs ...uuid.UUID) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	workspaceUsers := make([]model.WorkspaceUser, len(userIDs))
+	for idx, userID := range userIDs {
+		workspaceUsers[idx] = model.WorkspaceUser{
+			WorkspaceID: workspaceID,
+			UserID:      userID,
+		}
+	}
+
+	return CheckError(s.db.WithContext(ctx).Clauses(clause.OnConflict{
+		DoNothing: true,
+	}).Create(&workspaceUsers))
+}
+
+func (s *BloodhoundDB) RemoveWorkspaceUsers(ctx context.Context, workspaceID int32, userIDs ...uuid.UUID) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	return CheckError(s.db.WithContext(ctx).Where("workspace_id = ? AND user_id IN ?", workspaceID, userIDs).Delete(&model.WorkspaceUser{}))
+}
+
+func (s *BloodhoundDB) GetWorkspaceUsers(ctx context.Context, workspaceID int32) ([]uuid.UUID, error) {
+	var userIDs []uuid.UUID
+	return userIDs, CheckError(s.db.WithContext(ctx).Model(&model.WorkspaceUser{}).Where("workspace_id = ?", workspaceID).Order("user_id").Pluck("user_id", &userIDs))
+}
+
+// HasWorkspaceAccess returns true or false whether the given user has been granted access to the given workspace
+func (s *BloodhoundDB) HasWorkspaceAccess(ctx context.Context, workspaceID int32, userID uuid.UUID) (bool, error) {
+	rows := int64(0)
+	result := s.db.WithContext(ctx).Model(&model.WorkspaceUser{}).Where("workspace_id = ? AND user_id = ?", workspaceID, userID).Count(&rows)
+
+	return rows > 0, CheckError(result)
+}
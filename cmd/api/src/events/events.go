@@ -0,0 +1,303 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package events defines the in-process event bus that the datapipe publishes ingest and analysis progress to.
+// Replicas sharing a database relay their events to each other so that every replica serves the events of the
+// datapipe leader.
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultHistorySize is the number of published events retained for subscribers that resume a stream
+	DefaultHistorySize = 512
+
+	subscriberBufferSize = 256
+)
+
+type Type string
+
+const (
+	TypeIngestTaskStarted   Type = "ingest.task.started"
+	TypeIngestFileStarted   Type = "ingest.file.started"
+	TypeIngestProgress      Type = "ingest.progress"
+	TypeIngestTaskCompleted Type = "ingest.task.completed"
+	TypeIngestTaskFailed    Type = "ingest.task.failed"
+	TypeIngestTaskCanceled  Type = "ingest.task.canceled"
+	TypeAnalysisStarted     Type = "analysis.started"
+	TypeAnalysisProgress    Type = "analysis.progress"
+	TypeAnalysisCompleted   Type = "analysis.completed"
+	TypeAnalysisFailed      Type = "analysis.failed"
+	TypeAnalysisCanceled    Type = "analysis.canceled"
+)
+
+// Event is a single published event. IDs are assigned by the bus and increase monotonically for the lifetime of the
+// process, so the same event relayed to several replicas has a different ID on each of them.
+type Event struct {
+	ID   uint64    `json:"id"`
+	Type Type      `json:"type"`
+	Time time.Time `json:"time"`
+	Data any       `json:"data,omitempty"`
+}
+
+// WorkspaceScoped is implemented by event data that describes the graph of one or more workspaces. A workspace ID of
+// zero is the default graph.
+type WorkspaceScoped interface {
+	InWorkspace(workspaceID int32) bool
+}
+
+// InWorkspace returns true if the event is visible to subscribers of the given workspace. Events whose data is not
+// workspace scoped are visible to every subscriber.
+func (s Event) InWorkspace(workspaceID int32) bool {
+	if scoped, isScoped := s.Data.(WorkspaceScoped); isScoped {
+		return scoped.InWorkspace(workspaceID)
+	}
+
+	return true
+}
+
+// IngestProgress is the data of ingest events. File is the name of the file being ingested, which for archives is
+// the name of the file within the archive.
+type IngestProgress struct {
+	TaskID         int64  `json:"task_id"`
+	JobID          int64  `json:"job_id"`
+	WorkspaceID    int32  `json:"workspace_id,omitempty"`
+	File           string `json:"file,omitempty"`
+	FileIndex      int    `json:"file_index,omitempty"`
+	FileCount      int    `json:"file_count,omitempty"`
+	ObjectsDecoded int64  `json:"objects_decoded"`
+	FailedFiles    int    `json:"failed_files,omitempty"`
+	Error          string `json:"error,omitempty"`
+}
+
+// InWorkspace returns true if the ingest job of the task writes to the graph of the given workspace.
+func (s IngestProgress) InWorkspace(workspaceID int32) bool {
+	return s.WorkspaceID == workspaceID
+}
+
+// AnalysisProgress is the data of analysis events. Step is the analysis step that is currently running and
+// WorkspaceID the workspace whose graph it runs against. Events describing the whole run instead list every workspace
+// analyzed by the run in WorkspaceIDs.
+type AnalysisProgress struct {
+	Step            string  `json:"step,omitempty"`
+	WorkspaceID     int32   `json:"workspace_id,omitempty"`
+	WorkspaceIDs    []int32 `json:"workspace_ids,omitempty"`
+	CompletedSteps  int     `json:"completed_steps"`
+	TotalSteps      int     `json:"total_steps"`
+	PercentComplete float64 `json:"percent_complete"`
+	Error           string  `json:"error,omitempty"`
+}
+
+// InWorkspace returns true if the event describes analysis of the graph of the given workspace.
+func (s AnalysisProgress) InWorkspace(workspaceID int32) bool {
+	if len(s.WorkspaceIDs) > 0 {
+		return slices.Contains(s.WorkspaceIDs, workspaceID)
+	}
+
+	return s.WorkspaceID == workspaceID
+}
+
+// Bus fans published events out to every subscriber. Publishing never blocks: a subscriber that does not keep up
+// misses events, which it can detect by a gap in event IDs.
+type Bus struct {
+	lock        sync.Mutex
+	lastID      uint64
+	history     []Event
+	historySize int
+	subscribers map[chan Event]struct{}
+	relay       Relay
+}
+
+func NewBus(historySize int) *Bus {
+	return &Bus{
+		historySize: historySize,
+		subscribers: map[chan Event]struct{}{},
+	}
+}
+
+// SetRelay sets the relay that every event published to the bus is sent to
+func (s *Bus) SetRelay(relay Relay) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.relay = relay
+}
+
+// Publish assigns the next event ID to an event of the given type and sends it to every subscriber and to the relay,
+// if any.
+func (s *Bus) Publish(eventType Type, data any) Event {
+	event := s.publish(Event{
+		Type: eventType,
+		Time: time.Now().UTC(),
+		Data: data,
+	})
+
+	s.lock.Lock()
+	relay := s.relay
+	s.lock.Unlock()
+
+	if relay != nil {
+		relay.Send(event)
+	}
+
+	return event
+}
+
+// Deliver assigns the next event ID to an event relayed from another replica and sends it to every subscriber. The
+// event is not sent to the relay again.
+func (s *Bus) Deliver(event Event) Event {
+	return s.publish(event)
+}
+
+func (s *Bus) publish(event Event) Event {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.lastID++
+	event.ID = s.lastID
+
+	if s.historySize > 0 {
+		if len(s.history) == s.historySize {
+			s.history = append(s.history[:0], s.history[1:]...)
+		}
+
+		s.history = append(s.history, event)
+	}
+
+	for subscriber := range s.subscribers {
+		select {
+		case subscriber <- event:
+		default:
+		}
+	}
+
+	return event
+}
+
+// Subscribe returns a channel that receives every event published after the event with the given ID, starting with
+// any retained events. Passing an ID of zero receives only events published from now on. The channel is closed once
+// the given context is done.
+func (s *Bus) Subscribe(ctx context.Context, lastEventID uint64) <-chan Event {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var replay []Event
+
+	if lastEventID > 0 {
+		for _, event := range s.history {
+			if event.ID > lastEventID {
+				replay = append(replay, event)
+			}
+		}
+	}
+
+	subscriber := make(chan Event, subscriberBufferSize+len(replay))
+
+	for _, event := range replay {
+		subscriber <- event
+	}
+
+	s.subscribers[subscriber] = struct{}{}
+
+	go func() {
+		<-ctx.Done()
+
+		s.lock.Lock()
+		defer s.lock.Unlock()
+
+		delete(s.subscribers, subscriber)
+		close(subscriber)
+	}()
+
+	return subscriber
+}
+
+// Relay carries the events published on one replica to the buses of the other replicas. Send must not block.
+type Relay interface {
+	Send(event Event)
+}
+
+// relayedEvent is the encoding of an event relayed between replicas. The ID of the event is not relayed as each bus
+// assigns its own.
+type relayedEvent struct {
+	Origin string          `json:"origin"`
+	Type   Type            `json:"type"`
+	Time   time.Time       `json:"time"`
+	Data   json.RawMessage `json:"data,omitempty"`
+}
+
+// EncodeRelayed encodes an event published on the given origin replica for relaying to other replicas
+func EncodeRelayed(origin string, event Event) ([]byte, error) {
+	if data, err := json.Marshal(event.Data); err != nil {
+		return nil, err
+	} else {
+		return json.Marshal(relayedEvent{
+			Origin: origin,
+			Type:   event.Type,
+			Time:   event.Time,
+			Data:   data,
+		})
+	}
+}
+
+// DecodeRelayed decodes an event encoded by EncodeRelayed and returns it along with the replica it was published on.
+// The data of ingest and analysis events is decoded to its type so that relayed events remain workspace scoped.
+func DecodeRelayed(payload []byte) (string, Event, error) {
+	var relayed relayedEvent
+
+	if err := json.Unmarshal(payload, &relayed); err != nil {
+		return "", Event{}, err
+	}
+
+	event := Event{
+		Type: relayed.Type,
+		Time: relayed.Time,
+	}
+
+	if len(relayed.Data) > 0 {
+		var err error
+
+		switch {
+		case strings.HasPrefix(string(relayed.Type), "ingest."):
+			event.Data, err = decodeData[IngestProgress](relayed.Data)
+		case strings.HasPrefix(string(relayed.Type), "analysis."):
+			event.Data, err = decodeData[AnalysisProgress](relayed.Data)
+		default:
+			event.Data, err = decodeData[any](relayed.Data)
+		}
+
+		if err != nil {
+			return "", Event{}, fmt.Errorf("error decoding data of %s event: %w", relayed.Type, err)
+		}
+	}
+
+	return relayed.Origin, event, nil
+}
+
+func decodeData[T any](raw json.RawMessage) (T, error) {
+	var data T
+
+	err := json.Unmarshal(raw, &data)
+	return data, err
+}
@@ -0,0 +1,144 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package events_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/specterops/bloodhound/src/events"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBus(t *testing.T) {
+	var (
+		bus         = events.NewBus(2)
+		ctx, cancel = context.WithCancel(context.Background())
+	)
+
+	defer cancel()
+
+	first := bus.Publish(events.TypeAnalysisStarted, nil)
+	require.Equal(t, uint64(1), first.ID)
+
+	subscription := bus.Subscribe(ctx, 0)
+
+	second := bus.Publish(events.TypeAnalysisProgress, events.AnalysisProgress{Step: "domain_associations"})
+	require.Equal(t, second, <-subscription)
+
+	t.Run("Resumed Subscriptions Replay Retained Events", func(t *testing.T) {
+		bus.Publish(events.TypeAnalysisCompleted, nil)
+
+		resumed := bus.Subscribe(ctx, first.ID)
+
+		require.Equal(t, events.TypeAnalysisProgress, (<-resumed).Type)
+		require.Equal(t, events.TypeAnalysisCompleted, (<-resumed).Type)
+	})
+
+	t.Run("Subscriptions Close With Their Context", func(t *testing.T) {
+		subscriptionCtx, subscriptionCancel := context.WithCancel(ctx)
+		closed := bus.Subscribe(subscriptionCtx, 0)
+
+		subscriptionCancel()
+
+		for range closed {
+		}
+	})
+}
+
+func TestEvent_InWorkspace(t *testing.T) {
+	var (
+		unscoped = events.Event{Type: events.TypeAnalysisStarted}
+		ingest   = events.Event{Type: events.TypeIngestProgress, Data: events.IngestProgress{WorkspaceID: 1}}
+		step     = events.Event{Type: events.TypeAnalysisProgress, Data: events.AnalysisProgress{WorkspaceID: 2}}
+		run      = events.Event{Type: events.TypeAnalysisCompleted, Data: events.AnalysisProgress{WorkspaceIDs: []int32{0, 2}}}
+	)
+
+	require.True(t, unscoped.InWorkspace(0))
+	require.True(t, unscoped.InWorkspace(1))
+
+	require.True(t, ingest.InWorkspace(1))
+	require.False(t, ingest.InWorkspace(0))
+
+	require.True(t, step.InWorkspace(2))
+	require.False(t, step.InWorkspace(0))
+
+	require.True(t, run.InWorkspace(0))
+	require.True(t, run.InWorkspace(2))
+	require.False(t, run.InWorkspace(1))
+}
+
+type recordingRelay struct {
+	sent []events.Event
+}
+
+func (s *recordingRelay) Send(event events.Event) {
+	s.sent = append(s.sent, event)
+}
+
+func TestBus_Relay(t *testing.T) {
+	var (
+		bus   = events.NewBus(events.DefaultHistorySize)
+		relay = &recordingRelay{}
+	)
+
+	bus.SetRelay(relay)
+
+	published := bus.Publish(events.TypeIngestTaskStarted, events.IngestProgress{TaskID: 1})
+	require.Equal(t, []events.Event{published}, relay.sent)
+
+	// Events relayed from other replicas are assigned the next local ID and are not relayed again
+	delivered := bus.Deliver(events.Event{ID: 41, Type: events.TypeAnalysisStarted})
+	require.Equal(t, published.ID+1, delivered.ID)
+	require.Len(t, relay.sent, 1)
+}
+
+func TestEncodeRelayed(t *testing.T) {
+	var (
+		ingestEvent = events.Event{
+			ID:   3,
+			Type: events.TypeIngestProgress,
+			Time: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			Data: events.IngestProgress{TaskID: 1, JobID: 2, WorkspaceID: 3, ObjectsDecoded: 100},
+		}
+		analysisEvent = events.Event{
+			ID:   4,
+			Type: events.TypeAnalysisCompleted,
+			Time: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			Data: events.AnalysisProgress{WorkspaceIDs: []int32{0, 3}, CompletedSteps: 10, TotalSteps: 10, PercentComplete: 100},
+		}
+	)
+
+	for _, event := range []events.Event{ingestEvent, analysisEvent} {
+		payload, err := events.EncodeRelayed("node-a", event)
+		require.Nil(t, err)
+
+		origin, decoded, err := events.DecodeRelayed(payload)
+		require.Nil(t, err)
+		require.Equal(t, "node-a", origin)
+
+		// Relayed events keep their data and remain scoped to the same workspaces but not their ID
+		event.ID = 0
+		require.Equal(t, event, decoded)
+		require.True(t, decoded.InWorkspace(3))
+		require.False(t, decoded.InWorkspace(5))
+	}
+
+	_, _, err := events.DecodeRelayed([]byte("not json"))
+	require.NotNil(t, err)
+}
@@ -0,0 +1,221 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package metrics defines the application metrics exposed on the tools API /metrics endpoint.
+package metrics
+
+import (
+	"errors"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/specterops/bloodhound/analysis"
+	"github.com/specterops/bloodhound/cache"
+	"github.com/specterops/bloodhound/dawgs/drivers/memory"
+	"github.com/specterops/bloodhound/dawgs/drivers/neo4j"
+	"github.com/specterops/bloodhound/dawgs/drivers/pg"
+	"github.com/specterops/bloodhound/dawgs/graph"
+)
+
+const (
+	namespace = "bloodhound"
+
+	StatusSuccess = "success"
+	StatusFailure = "failure"
+
+	// UnknownDataType labels ingest files whose metadata tag could not be validated
+	UnknownDataType = "unknown"
+)
+
+var (
+	IngestFilesProcessed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "ingest",
+		Name:      "files_processed_total",
+		Help:      "Number of ingest files processed by data type and status.",
+	}, []string{"data_type", "status"})
+
+	IngestObjectsProcessed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "ingest",
+		Name:      "objects_processed_total",
+		Help:      "Number of ingest objects decoded by data type.",
+	}, []string{"data_type"})
+
+	IngestDecodeErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "ingest",
+		Name:      "decode_errors_total",
+		Help:      "Number of ingest objects that failed to decode by data type.",
+	}, []string{"data_type"})
+
+	AnalysisStepDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "analysis",
+		Name:      "step_duration_seconds",
+		Help:      "Duration of each analysis step.",
+		Buckets:   prometheus.ExponentialBuckets(0.1, 2, 16),
+	}, []string{"step"})
+
+	AnalysisRelationshipsCreated = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "analysis",
+		Name:      "relationships_created_total",
+		Help:      "Number of relationships created by post-processing by kind.",
+	}, []string{"kind"})
+
+	AnalysisRelationshipsDeleted = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "analysis",
+		Name:      "relationships_deleted_total",
+		Help:      "Number of relationships deleted by post-processing by kind.",
+	}, []string{"kind"})
+
+	CypherQueryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "cypher",
+		Name:      "query_duration_seconds",
+		Help:      "Duration of user cypher queries by status.",
+		Buckets:   prometheus.ExponentialBuckets(0.01, 2, 16),
+	}, []string{"status"})
+
+	CypherQueryComplexity = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "cypher",
+		Name:      "query_complexity_weight",
+		Help:      "Complexity weight of user cypher queries.",
+		Buckets:   []float64{1, 5, 10, 25, 50, 100, 250, 500, 1000},
+	})
+
+	DatapipeStatusTransitions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "datapipe",
+		Name:      "status_transitions_total",
+		Help:      "Number of datapipe transitions into each status.",
+	}, []string{"status"})
+
+	GraphTransactions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "graph",
+		Name:      "transactions_total",
+		Help:      "Number of graph transactions and batch operations by driver, operation and status.",
+	}, []string{"driver", "operation", "status"})
+)
+
+// Status returns the status label value for the given error.
+func Status(err error) string {
+	if err != nil {
+		return StatusFailure
+	}
+
+	return StatusSuccess
+}
+
+// ObserveAnalysisStep starts timing the named analysis step. The returned function records the step's duration.
+func ObserveAnalysisStep(step string) func() {
+	started := time.Now()
+
+	return func() {
+		AnalysisStepDuration.WithLabelValues(step).Observe(time.Since(started).Seconds())
+	}
+}
+
+// ObservePostProcessingStats records the relationships created and deleted by a post-processing step.
+func ObservePostProcessingStats(stats *analysis.AtomicPostProcessingStats) {
+	for kind, numCreated := range stats.RelationshipsCreated {
+		AnalysisRelationshipsCreated.WithLabelValues(kind.String()).Add(float64(*numCreated))
+	}
+
+	for kind, numDeleted := range stats.RelationshipsDeleted {
+		AnalysisRelationshipsDeleted.WithLabelValues(kind.String()).Add(float64(*numDeleted))
+	}
+}
+
+func driverName(db graph.Database) string {
+	if _, isPostgreSQL := graph.AsDriver[*pg.Driver](db); isPostgreSQL {
+		return pg.DriverName
+	} else if _, isMemory := graph.AsDriver[*memory.Driver](db); isMemory {
+		return memory.DriverName
+	}
+
+	return neo4j.DriverName
+}
+
+// ObserveGraphTransaction is a graph.TransactionObserver that counts graph transactions by driver.
+func ObserveGraphTransaction(db graph.Database, operation graph.TransactionOperation, err error) {
+	GraphTransactions.WithLabelValues(driverName(db), string(operation), Status(err)).Inc()
+}
+
+// RegisterCache exposes the hit and miss counts of the given cache under the given name along with the number of entries
+// it has removed by reason. Registering the same name more than once is a no-op.
+func RegisterCache(name string, instance cache.Cache) error {
+	var (
+		lookups = map[string]func(stats cache.Stats) uint64{
+			"hit": func(stats cache.Stats) uint64 {
+				return stats.Hits
+			},
+			"miss": func(stats cache.Stats) uint64 {
+				return stats.Misses
+			},
+		}
+
+		removals = map[string]func(stats cache.Stats) uint64{
+			"eviction": func(stats cache.Stats) uint64 {
+				return stats.Evictions
+			},
+			"invalidation": func(stats cache.Stats) uint64 {
+				return stats.Invalidations
+			},
+		}
+	)
+
+	for result, counter := range lookups {
+		if err := registerCacheCounter(instance, counter, prometheus.CounterOpts{
+			Namespace:   namespace,
+			Subsystem:   "cache",
+			Name:        "lookups_total",
+			Help:        "Number of cache lookups by cache and result.",
+			ConstLabels: prometheus.Labels{"cache": name, "result": result},
+		}); err != nil {
+			return err
+		}
+	}
+
+	for reason, counter := range removals {
+		if err := registerCacheCounter(instance, counter, prometheus.CounterOpts{
+			Namespace:   namespace,
+			Subsystem:   "cache",
+			Name:        "removals_total",
+			Help:        "Number of cache entries removed by cache and reason.",
+			ConstLabels: prometheus.Labels{"cache": name, "reason": reason},
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func registerCacheCounter(instance cache.Cache, counter func(stats cache.Stats) uint64, opts prometheus.CounterOpts) error {
+	if err := prometheus.Register(prometheus.NewCounterFunc(opts, func() float64 {
+		return float64(counter(instance.Stats()))
+	})); err != nil && !errors.As(err, &prometheus.AlreadyRegisteredError{}) {
+		return err
+	}
+
+	return nil
+}
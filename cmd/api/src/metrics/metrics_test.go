@@ -0,0 +1,65 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/specterops/bloodhound/cache"
+	"github.com/specterops/bloodhound/src/metrics"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatus(t *testing.T) {
+	require.Equal(t, metrics.StatusSuccess, metrics.Status(nil))
+	require.Equal(t, metrics.StatusFailure, metrics.Status(errors.New("failed")))
+}
+
+func TestRegisterCache(t *testing.T) {
+	instance, err := cache.NewCache(cache.Config{MaxSize: 10})
+	require.Nil(t, err)
+
+	require.Nil(t, metrics.RegisterCache("metrics_test", instance))
+
+	// Registering the same cache twice is not an error
+	require.Nil(t, metrics.RegisterCache("metrics_test", instance))
+
+	instance.Set("key", "value")
+	var value string
+	instance.Get("key", &value)
+	instance.Get("key", &value)
+	instance.Get("missing", &value)
+	instance.SetTagged("tagged", "value", "tag")
+	instance.Invalidate("tag")
+
+	expected := `
+# HELP bloodhound_cache_lookups_total Number of cache lookups by cache and result.
+# TYPE bloodhound_cache_lookups_total counter
+bloodhound_cache_lookups_total{cache="metrics_test",result="hit"} 2
+bloodhound_cache_lookups_total{cache="metrics_test",result="miss"} 1
+# HELP bloodhound_cache_removals_total Number of cache entries removed by cache and reason.
+# TYPE bloodhound_cache_removals_total counter
+bloodhound_cache_removals_total{cache="metrics_test",reason="eviction"} 0
+bloodhound_cache_removals_total{cache="metrics_test",reason="invalidation"} 1
+`
+
+	require.Nil(t, testutil.GatherAndCompare(prometheus.DefaultGatherer, strings.NewReader(expected), "bloodhound_cache_lookups_total", "bloodhound_cache_removals_total"))
+}
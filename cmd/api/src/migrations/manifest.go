@@ -32,6 +32,7 @@ import (
 	"github.com/specterops/bloodhound/graphschema/ad"
 	"github.com/specterops/bloodhound/graphschema/azure"
 	"github.com/specterops/bloodhound/graphschema/common"
+	"github.com/specterops/bloodhound/src/model"
 	"github.com/specterops/bloodhound/src/version"
 )
 
@@ -47,6 +48,53 @@ func RequiresMigration(ctx context.Context, db graph.Database) (bool, error) {
 	}
 }
 
+// version740MigrationStride is the number of node or relationship IDs covered by each batch of Version_740_Migration
+const version740MigrationStride = 20_000
+
+// Version_740_Migration records objects that were in the graph before ingest jobs were recorded on the objects they
+// add as being of unknown origin, which excludes them from ingest job rollback. The graph is updated in ID-ranged batches
+// so that large graphs are not rewritten in a single transaction.
+func Version_740_Migration(ctx context.Context, db graph.Database) error {
+	defer measure.LogAndMeasure(slog.LevelInfo, "Migration to record the first ingest job of existing graph data as unknown")()
+
+	properties := graph.NewProperties()
+	properties.Set(common.FirstIngestJob.String(), model.UnknownIngestJobID)
+
+	if largestNodeID, err := ops.FetchLargestNodeID(ctx, db); err != nil && !errors.Is(err, graph.ErrNoResultsFound) {
+		return fmt.Errorf("unable to fetch the largest node ID: %w", err)
+	} else if err == nil {
+		for floor := graph.ID(0); floor <= largestNodeID; floor += version740MigrationStride {
+			if err := db.BatchOperation(ctx, func(batch graph.Batch) error {
+				return batch.Nodes().Filter(query.And(
+					query.GreaterThanOrEquals(query.NodeID(), floor),
+					query.LessThan(query.NodeID(), floor+version740MigrationStride),
+					query.Not(query.Exists(query.NodeProperty(common.FirstIngestJob.String()))),
+				)).Update(properties)
+			}); err != nil {
+				return fmt.Errorf("unable to update nodes with IDs from %d: %w", floor, err)
+			}
+		}
+	}
+
+	if largestRelationshipID, err := ops.FetchLargestRelationshipID(ctx, db); err != nil && !errors.Is(err, graph.ErrNoResultsFound) {
+		return fmt.Errorf("unable to fetch the largest relationship ID: %w", err)
+	} else if err == nil {
+		for floor := graph.ID(0); floor <= largestRelationshipID; floor += version740MigrationStride {
+			if err := db.BatchOperation(ctx, func(batch graph.Batch) error {
+				return batch.Relationships().Filter(query.And(
+					query.GreaterThanOrEquals(query.RelationshipID(), floor),
+					query.LessThan(query.RelationshipID(), floor+version740MigrationStride),
+					query.Not(query.Exists(query.RelationshipProperty(common.FirstIngestJob.String()))),
+				)).Update(properties)
+			}); err != nil {
+				return fmt.Errorf("unable to update relationships with IDs from %d: %w", floor, err)
+			}
+		}
+	}
+
+	return nil
+}
+
 // Version_620_Migration is intended to rename the RemoteInteractiveLogonPrivilege edge to RemoteInteractiveLogonRight
 // See: https://specterops.atlassian.net/browse/BED-4428
 func Version_620_Migration(ctx context.Context, db graph.Database) error {
@@ -335,6 +383,10 @@ var Manifest = []Migration{
 		Version: version.Version{Major: 6, Minor: 2, Patch: 0},
 		Execute: Version_620_Migration,
 	},
+	{
+		Version: version.Version{Major: 7, Minor: 4, Patch: 0},
+		Execute: Version_740_Migration,
+	},
 }
 
 func LatestGraphMigrationVersion() version.Version {
@@ -25,6 +25,9 @@ const (
 	AnalysisRequestDeletion AnalysisRequestType = "deletion"
 )
 
+// AnalysisRequesterSchedule is the requester of analysis requested by the analysis schedule.
+const AnalysisRequesterSchedule = "schedule"
+
 type AnalysisRequest struct {
 	RequestedBy string              `json:"requested_by"`
 	RequestType AnalysisRequestType `json:"request_type"`
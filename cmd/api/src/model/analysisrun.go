@@ -0,0 +1,162 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/specterops/bloodhound/src/database/types/null"
+)
+
+type AnalysisRunTrigger string
+
+const (
+	AnalysisRunTriggerIngest      AnalysisRunTrigger = "ingest"
+	AnalysisRunTriggerUserRequest AnalysisRunTrigger = "user_request"
+	AnalysisRunTriggerSchedule    AnalysisRunTrigger = "schedule"
+)
+
+// AnalysisRunTriggerFor returns the trigger of an analysis run started because of the given analysis request.
+func AnalysisRunTriggerFor(analysisRequest AnalysisRequest) AnalysisRunTrigger {
+	if analysisRequest.RequestedBy == AnalysisRequesterSchedule {
+		return AnalysisRunTriggerSchedule
+	}
+
+	return AnalysisRunTriggerUserRequest
+}
+
+type AnalysisRunStatus string
+
+const (
+	AnalysisRunStatusRunning           AnalysisRunStatus = "running"
+	AnalysisRunStatusComplete          AnalysisRunStatus = "complete"
+	AnalysisRunStatusPartiallyComplete AnalysisRunStatus = "partially_complete"
+	AnalysisRunStatusFailed            AnalysisRunStatus = "failed"
+	AnalysisRunStatusCanceled          AnalysisRunStatus = "canceled"
+)
+
+// AnalysisRunStep records the duration and outcome of a single step of an analysis run. Steps run against a workspace
+// graph carry the ID of the workspace.
+type AnalysisRunStep struct {
+	Name        string    `json:"name"`
+	WorkspaceID int32     `json:"workspace_id,omitempty"`
+	StartedAt   time.Time `json:"started_at"`
+	DurationMS  int64     `json:"duration_ms"`
+	Error       string    `json:"error,omitempty"`
+}
+
+type AnalysisRunSteps []AnalysisRunStep
+
+// Scan implements the sql.Scanner interface for the jsonb steps column
+func (s *AnalysisRunSteps) Scan(value any) error {
+	if value == nil {
+		*s = AnalysisRunSteps{}
+		return nil
+	} else if bytes, ok := value.([]byte); !ok {
+		return errors.New("type assertion to []byte failed for AnalysisRunSteps")
+	} else {
+		return json.Unmarshal(bytes, s)
+	}
+}
+
+// Value returns the json-marshaled value of the receiver
+func (s AnalysisRunSteps) Value() (driver.Value, error) {
+	if s == nil {
+		return json.Marshal(AnalysisRunSteps{})
+	}
+
+	return json.Marshal([]AnalysisRunStep(s))
+}
+
+// AnalysisRunEdgeCounts maps relationship kinds to the number of relationships of that kind.
+type AnalysisRunEdgeCounts map[string]int64
+
+// Scan implements the sql.Scanner interface for the jsonb edge count columns
+func (s *AnalysisRunEdgeCounts) Scan(value any) error {
+	if value == nil {
+		*s = AnalysisRunEdgeCounts{}
+		return nil
+	} else if bytes, ok := value.([]byte); !ok {
+		return errors.New("type assertion to []byte failed for AnalysisRunEdgeCounts")
+	} else {
+		return json.Unmarshal(bytes, s)
+	}
+}
+
+// Value returns the json-marshaled value of the receiver
+func (s AnalysisRunEdgeCounts) Value() (driver.Value, error) {
+	if s == nil {
+		return json.Marshal(AnalysisRunEdgeCounts{})
+	}
+
+	return json.Marshal(map[string]int64(s))
+}
+
+type AnalysisRunErrors []string
+
+// Scan implements the sql.Scanner interface for the jsonb errors column
+func (s *AnalysisRunErrors) Scan(value any) error {
+	if value == nil {
+		*s = AnalysisRunErrors{}
+		return nil
+	} else if bytes, ok := value.([]byte); !ok {
+		return errors.New("type assertion to []byte failed for AnalysisRunErrors")
+	} else {
+		return json.Unmarshal(bytes, s)
+	}
+}
+
+// Value returns the json-marshaled value of the receiver
+func (s AnalysisRunErrors) Value() (driver.Value, error) {
+	if s == nil {
+		return json.Marshal(AnalysisRunErrors{})
+	}
+
+	return json.Marshal([]string(s))
+}
+
+// AnalysisRun is the persisted record of a single analysis run, covering the default graph and every workspace graph.
+type AnalysisRun struct {
+	Trigger      AnalysisRunTrigger    `json:"trigger"`
+	RequestedBy  string                `json:"requested_by"`
+	Status       AnalysisRunStatus     `json:"status"`
+	StartedAt    time.Time             `json:"started_at"`
+	CompletedAt  null.Time             `json:"completed_at"`
+	Steps        AnalysisRunSteps      `json:"steps" gorm:"type:jsonb"`
+	EdgesCreated AnalysisRunEdgeCounts `json:"edges_created" gorm:"type:jsonb"`
+	EdgesDeleted AnalysisRunEdgeCounts `json:"edges_deleted" gorm:"type:jsonb"`
+	Errors       AnalysisRunErrors     `json:"errors" gorm:"type:jsonb"`
+
+	BigSerial
+}
+
+type AnalysisRuns []AnalysisRun
+
+func (s AnalysisRuns) IsSortable(column string) bool {
+	switch column {
+	case "trigger",
+		"status",
+		"started_at",
+		"completed_at":
+		return true
+	default:
+		return false
+	}
+}
@@ -43,6 +43,8 @@ const (
 	PruneTTL                      = "prune.ttl"
 	DefaultPruneBaseTTL           = time.Hour * 24 * 7
 	DefaultPruneHasSessionEdgeTTL = time.Hour * 24 * 3
+	PruneSoftDeleteKey            = "prune.soft_delete"
+	PruneEnabledKey               = "prune.enabled"
 
 	ReconciliationKey = "analysis.reconciliation"
 	ScheduledAnalysis = "analysis.scheduled" //This key is not intended to be user updateable, so should not be added to IsValidKey
@@ -75,6 +77,8 @@ func (s *Parameter) IsValidKey(parameterKey string) bool {
 		PruneTTL:                 true,
 		CitrixRDPSupportKey:      true,
 		ReconciliationKey:        true,
+		PruneSoftDeleteKey:       true,
+		PruneEnabledKey:          true,
 	}
 
 	return validKeys[parameterKey]
@@ -103,6 +107,10 @@ func (s *Parameter) Validate() utils.Errors {
 		v = &CitrixRDPSupport{}
 	case ReconciliationKey:
 		v = &ReconciliationParameter{}
+	case PruneSoftDeleteKey:
+		v = &PruneSoftDeleteParameter{}
+	case PruneEnabledKey:
+		v = &PruneEnabledParameter{}
 	default:
 		return utils.Errors{errors.New("invalid key")}
 	}
@@ -270,6 +278,45 @@ func GetPruneTTLParameters(ctx context.Context, service ParameterService) PruneT
 	return result
 }
 
+// PruneSoftDelete
+
+// PruneSoftDeleteParameter controls whether reconciliation marks expired graph data as stale instead of deleting it.
+type PruneSoftDeleteParameter struct {
+	Enabled bool `json:"enabled,omitempty"`
+}
+
+func GetPruneSoftDeleteParameter(ctx context.Context, service ParameterService) bool {
+	var result PruneSoftDeleteParameter
+
+	if cfg, err := service.GetConfigurationParameter(ctx, PruneSoftDeleteKey); err != nil {
+		slog.WarnContext(ctx, "Failed to fetch prune soft delete configuration; returning default values")
+	} else if err := cfg.Map(&result); err != nil {
+		slog.WarnContext(ctx, fmt.Sprintf("Invalid prune soft delete configuration supplied, %v. returning default values.", err))
+	}
+
+	return result.Enabled
+}
+
+// PruneEnabled
+
+// PruneEnabledParameter controls whether reconciliation prunes graph data older than the prune TTLs. Pruning is disabled
+// unless explicitly enabled.
+type PruneEnabledParameter struct {
+	Enabled bool `json:"enabled,omitempty"`
+}
+
+func GetPruneEnabledParameter(ctx context.Context, service ParameterService) bool {
+	var result PruneEnabledParameter
+
+	if cfg, err := service.GetConfigurationParameter(ctx, PruneEnabledKey); err != nil {
+		slog.WarnContext(ctx, "Failed to fetch prune enabled configuration; returning default values")
+	} else if err := cfg.Map(&result); err != nil {
+		slog.WarnContext(ctx, fmt.Sprintf("Invalid prune enabled configuration supplied, %v. returning default values.", err))
+	}
+
+	return result.Enabled
+}
+
 // Reconciliation
 
 type ReconciliationParameter struct {
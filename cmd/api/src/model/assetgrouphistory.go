@@ -32,6 +32,10 @@ const (
 	AssetGroupHistoryActionCreateSelector AssetGroupHistoryAction = "CreateSelector"
 	AssetGroupHistoryActionUpdateSelector AssetGroupHistoryAction = "UpdateSelector"
 	AssetGroupHistoryActionDeleteSelector AssetGroupHistoryAction = "DeleteSelector"
+
+	AssetGroupHistoryActionCertifyMember             AssetGroupHistoryAction = "CertifyMember"
+	AssetGroupHistoryActionRevokeMemberCertification AssetGroupHistoryAction = "RevokeMemberCertification"
+	AssetGroupHistoryActionResetMemberCertification  AssetGroupHistoryAction = "ResetMemberCertification"
 )
 
 // AssetGroupHistory is the record of CRUD changes associated with v2 of the asset groups feature
@@ -17,7 +17,6 @@
 package model
 
 import (
-	"fmt"
 	"strings"
 	"time"
 
@@ -27,6 +26,12 @@ import (
 
 const (
 	AssetGroupActorSystem = "SYSTEM"
+
+	// AssetGroupTierZeroPosition is the position of the Tier Zero tier, which is reserved and can not be changed
+	AssetGroupTierZeroPosition = 1
+
+	// AssetGroupTagKindPrefix prefixes the graph kinds applied to the members of asset group tags
+	AssetGroupTagKindPrefix = "Tag_"
 )
 
 type SelectorType int
@@ -43,6 +48,19 @@ const (
 	AssetGroupTagTypeLabel AssetGroupTagType = 2
 )
 
+type AssetGroupTags []AssetGroupTag
+
+// ToKinds returns the graph kinds that mark the members of the tags.
+func (s AssetGroupTags) ToKinds() graph.Kinds {
+	kinds := make(graph.Kinds, 0, len(s))
+
+	for _, tag := range s {
+		kinds = append(kinds, tag.ToKind())
+	}
+
+	return kinds
+}
+
 type AssetGroupTag struct {
 	ID             int               `json:"id"`
 	Type           AssetGroupTagType `json:"type"`
@@ -75,8 +93,31 @@ func (s AssetGroupTag) AuditData() AuditData {
 	}
 }
 
+func (s AssetGroupTag) IsStringColumn(filter string) bool {
+	return filter == "name" || filter == "description"
+}
+
+func (s AssetGroupTag) ValidFilters() map[string][]FilterOperator {
+	return map[string][]FilterOperator{
+		"type":            {Equals, NotEquals},
+		"name":            {Equals, NotEquals, ApproximatelyEquals},
+		"description":     {Equals, NotEquals, ApproximatelyEquals},
+		"position":        {Equals, GreaterThan, GreaterThanOrEquals, LessThan, LessThanOrEquals, NotEquals},
+		"require_certify": {Equals, NotEquals},
+		"created_at":      {Equals, GreaterThan, GreaterThanOrEquals, LessThan, LessThanOrEquals, NotEquals},
+		"created_by":      {Equals, NotEquals},
+		"updated_at":      {Equals, GreaterThan, GreaterThanOrEquals, LessThan, LessThanOrEquals, NotEquals},
+		"updated_by":      {Equals, NotEquals},
+	}
+}
+
+// IsTierZero returns true if the tag is the reserved Tier Zero tier.
+func (s AssetGroupTag) IsTierZero() bool {
+	return s.Type == AssetGroupTagTypeTier && s.Position.Valid && s.Position.Int32 == AssetGroupTierZeroPosition
+}
+
 func (s AssetGroupTag) ToKind() graph.Kind {
-	return graph.StringKind(fmt.Sprintf("Tag_%s", strings.ReplaceAll(s.Name, " ", "_")))
+	return graph.StringKind(AssetGroupTagKindPrefix + strings.ReplaceAll(s.Name, " ", "_"))
 }
 
 type SelectorSeed struct {
@@ -154,6 +195,78 @@ func (s AssetGroupTagSelector) ValidFilters() map[string][]FilterOperator {
 	}
 }
 
+// AssetGroupTagSelectorNode is a graph node selected by an asset group tag selector during analysis. CreatedAt records
+// when the node was first selected and UpdatedAt when it was last selected.
+type AssetGroupTagSelectorNode struct {
+	SelectorId int       `json:"selector_id"`
+	NodeId     graph.ID  `json:"node_id"`
+	ObjectID   string    `json:"object_id"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+func (AssetGroupTagSelectorNode) TableName() string {
+	return "asset_group_tag_selector_nodes"
+}
+
+type AssetGroupCertificationStatus string
+
+const (
+	AssetGroupCertificationStatusPending   AssetGroupCertificationStatus = "pending"
+	AssetGroupCertificationStatusCertified AssetGroupCertificationStatus = "certified"
+	AssetGroupCertificationStatusRevoked   AssetGroupCertificationStatus = "revoked"
+)
+
+func (s AssetGroupCertificationStatus) IsValid() bool {
+	switch s {
+	case AssetGroupCertificationStatusPending, AssetGroupCertificationStatusCertified, AssetGroupCertificationStatusRevoked:
+		return true
+	default:
+		return false
+	}
+}
+
+type AssetGroupTagCertifications []AssetGroupTagCertification
+
+// AssetGroupTagCertification is the certification of a single member of an asset group tag. CertifiedBy and
+// CertifiedAt record the user that certified or revoked the member and when they did so; both are cleared when the
+// member is reset to pending. Members without a certification record are pending.
+type AssetGroupTagCertification struct {
+	ID              int64                         `json:"id"`
+	AssetGroupTagId int                           `json:"asset_group_tag_id"`
+	ObjectID        string                        `json:"object_id"`
+	Status          AssetGroupCertificationStatus `json:"status"`
+	CertifiedBy     null.String                   `json:"certified_by"`
+	CertifiedAt     null.Time                     `json:"certified_at"`
+	Note            null.String                   `json:"note"`
+	CreatedAt       time.Time                     `json:"created_at"`
+	UpdatedAt       time.Time                     `json:"updated_at"`
+}
+
+func (AssetGroupTagCertification) TableName() string {
+	return "asset_group_tag_certifications"
+}
+
+func (s AssetGroupTagCertification) AuditData() AuditData {
+	return AuditData{
+		"asset_group_tag_id": s.AssetGroupTagId,
+		"object_id":          s.ObjectID,
+		"status":             s.Status,
+		"note":               s.Note,
+	}
+}
+
+// ByObjectID returns the certifications keyed by the object ID of the certified member.
+func (s AssetGroupTagCertifications) ByObjectID() map[string]AssetGroupTagCertification {
+	certifications := make(map[string]AssetGroupTagCertification, len(s))
+
+	for _, certification := range s {
+		certifications[certification.ObjectID] = certification
+	}
+
+	return certifications
+}
+
 type ListSelectorsResponse struct {
 	Selectors AssetGroupTagSelectors `json:"selectors"`
 }
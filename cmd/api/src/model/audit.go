@@ -82,9 +82,16 @@ const (
 
 	AuditLogActionUpdateParameter AuditLogAction = "UpdateParameter"
 
-	AuditLogActionCreateAssetGroupTag         AuditLogAction = "CreateAssetGroupTag"
-	AuditLogActionCreateAssetGroupTagSelector AuditLogAction = "CreateAssetGroupTagSelector"
-	AuditLogActionUpdateAssetGroupTagSelector AuditLogAction = "UpdateAssetGroupTagSelector"
+	AuditLogActionPruneGraph AuditLogAction = "PruneGraph"
+
+	AuditLogActionRollbackIngestJob AuditLogAction = "RollbackIngestJob"
+
+	AuditLogActionCreateAssetGroupTag              AuditLogAction = "CreateAssetGroupTag"
+	AuditLogActionUpdateAssetGroupTag              AuditLogAction = "UpdateAssetGroupTag"
+	AuditLogActionDeleteAssetGroupTag              AuditLogAction = "DeleteAssetGroupTag"
+	AuditLogActionCreateAssetGroupTagSelector      AuditLogAction = "CreateAssetGroupTagSelector"
+	AuditLogActionUpdateAssetGroupTagSelector      AuditLogAction = "UpdateAssetGroupTagSelector"
+	AuditLogActionUpdateAssetGroupTagCertification AuditLogAction = "UpdateAssetGroupTagCertification"
 )
 
 // TODO embed Basic into this struct instead of declaring the ID and CreatedAt fields. This will require a migration
@@ -0,0 +1,42 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package model
+
+import "time"
+
+// ClusterNode is an API replica sharing the application database with the other replicas of a deployment. Every
+// replica serves the API while only the leader runs the datapipe and data pruning daemons.
+type ClusterNode struct {
+	NodeID          string    `json:"node_id" gorm:"primaryKey"`
+	Hostname        string    `json:"hostname"`
+	IsLeader        bool      `json:"is_leader"`
+	StartedAt       time.Time `json:"started_at"`
+	LastHeartbeatAt time.Time `json:"last_heartbeat_at"`
+}
+
+type ClusterNodes []ClusterNode
+
+// Leader returns the node holding the leader lock, if any.
+func (s ClusterNodes) Leader() (ClusterNode, bool) {
+	for _, node := range s {
+		if node.IsLeader {
+			return node, true
+		}
+	}
+
+	return ClusterNode{}, false
+}
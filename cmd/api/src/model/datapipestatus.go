@@ -16,7 +16,11 @@
 
 package model
 
-import "time"
+import (
+	"time"
+
+	"github.com/specterops/bloodhound/src/database/types/null"
+)
 
 type DatapipeStatus string
 
@@ -32,4 +36,8 @@ type DatapipeStatusWrapper struct {
 	UpdatedAt              time.Time      `json:"updated_at"`
 	LastCompleteAnalysisAt time.Time      `json:"last_complete_analysis_at"`
 	LastAnalysisRunAt      time.Time      `json:"last_analysis_run_at"`
+
+	// Set when cancellation of the in-flight analysis is requested and cleared once the next analysis starts
+	CancellationRequestedBy null.String `json:"cancellation_requested_by"`
+	CancellationRequestedAt null.Time   `json:"cancellation_requested_at"`
 }
@@ -0,0 +1,58 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package model
+
+import (
+	"time"
+
+	"github.com/specterops/bloodhound/dawgs/graph"
+)
+
+// GenericKind is a node or edge kind that was declared by a generic ingest file.
+type GenericKind struct {
+	Name      string    `json:"name" gorm:"primaryKey"`
+	IsEdge    bool      `json:"is_edge"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (GenericKind) TableName() string {
+	return "generic_kinds"
+}
+
+type GenericKinds []GenericKind
+
+// NodeKinds returns the declared node kinds in the set.
+func (s GenericKinds) NodeKinds() graph.Kinds {
+	return s.kinds(false)
+}
+
+// EdgeKinds returns the declared edge kinds in the set.
+func (s GenericKinds) EdgeKinds() graph.Kinds {
+	return s.kinds(true)
+}
+
+func (s GenericKinds) kinds(isEdge bool) graph.Kinds {
+	kinds := make(graph.Kinds, 0, len(s))
+
+	for _, kind := range s {
+		if kind.IsEdge == isEdge {
+			kinds = append(kinds, graph.StringKind(kind.Name))
+		}
+	}
+
+	return kinds
+}
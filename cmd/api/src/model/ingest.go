@@ -20,6 +20,10 @@ import (
 	"github.com/specterops/bloodhound/src/database/types/null"
 )
 
+// UnknownIngestJobID is recorded as the first ingest job of graph objects that were in the graph before the ingest jobs
+// that write objects were recorded on them
+const UnknownIngestJobID int64 = 0
+
 type IngestTask struct {
 	FileName    string     `json:"file_name"`
 	RequestGUID string     `json:"request_guid"`
@@ -38,3 +42,35 @@ const (
 	FileTypeJson FileType = iota
 	FileTypeZip
 )
+
+// IngestUpload is a file uploaded to an ingest job in chunks so that an interrupted upload can be resumed from the
+// received size rather than restarted. The file is assembled and validated once all of its chunks have been received.
+type IngestUpload struct {
+	IngestJobID  int64    `json:"ingest_job_id"`
+	FileType     FileType `json:"file_type"`
+	TotalSize    int64    `json:"total_size"`
+	ReceivedSize int64    `json:"received_size"`
+	Completed    bool     `json:"completed"`
+
+	BigSerial
+}
+
+// IngestUploadChunk is a received chunk of an ingest upload, held in the ingest store until the upload is assembled
+type IngestUploadChunk struct {
+	UploadID int64  `json:"upload_id" gorm:"primaryKey"`
+	Offset   int64  `json:"offset" gorm:"column:chunk_offset;primaryKey"`
+	Size     int64  `json:"size"`
+	FileName string `json:"file_name"`
+	SHA256   string `json:"sha256" gorm:"column:sha256"`
+}
+
+type IngestUploadChunks []IngestUploadChunk
+
+// IngestJobObjects is a page of the nodes and relationships that an ingest job last wrote to the graph, along with the
+// total number of each.
+type IngestJobObjects struct {
+	NodeCount         int64 `json:"node_count"`
+	RelationshipCount int64 `json:"edge_count"`
+
+	UnifiedGraph
+}
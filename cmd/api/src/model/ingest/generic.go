@@ -0,0 +1,61 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ingest
+
+// GenericKindSchema declares the node and edge kinds that the data of a generic ingest file may use.
+type GenericKindSchema struct {
+	Nodes []string `json:"nodes"`
+	Edges []string `json:"edges"`
+}
+
+type GenericMatchBy string
+
+const (
+	GenericMatchByID       GenericMatchBy = "id"
+	GenericMatchByProperty GenericMatchBy = "property"
+)
+
+// GenericNode is a node of a generic ingest file. The node's ID is written to the graph as its objectid.
+type GenericNode struct {
+	ID         string         `json:"id"`
+	Kinds      []string       `json:"kinds"`
+	Properties map[string]any `json:"properties"`
+}
+
+// GenericEdgeEndpoint identifies the start or end node of a generic edge. Endpoints are matched by node ID unless
+// MatchBy is set to property, in which case Value is compared against the node property named by Property. The
+// optional Kind restricts the match to nodes of that kind and must be set to address nodes of a built-in kind.
+type GenericEdgeEndpoint struct {
+	MatchBy  GenericMatchBy `json:"match_by"`
+	Property string         `json:"property"`
+	Value    string         `json:"value"`
+	Kind     string         `json:"kind"`
+}
+
+// GenericEdge is an edge of a generic ingest file.
+type GenericEdge struct {
+	Kind       string              `json:"kind"`
+	Start      GenericEdgeEndpoint `json:"start"`
+	End        GenericEdgeEndpoint `json:"end"`
+	Properties map[string]any      `json:"properties"`
+}
+
+// GenericGraph is a single entry of the data list of a generic ingest file.
+type GenericGraph struct {
+	Nodes []GenericNode `json:"nodes"`
+	Edges []GenericEdge `json:"edges"`
+}
@@ -34,9 +34,10 @@ var AllowedZipFileUploadTypes = []string{
 var AllowedFileUploadTypes = append([]string{mediatypes.ApplicationJson.String()}, AllowedZipFileUploadTypes...)
 
 type Metadata struct {
-	Type    DataType         `json:"type"`
-	Methods CollectionMethod `json:"methods"`
-	Version int              `json:"version"`
+	Type    DataType          `json:"type"`
+	Methods CollectionMethod  `json:"methods"`
+	Version int               `json:"version"`
+	Kinds   GenericKindSchema `json:"kinds"`
 }
 
 func (s Metadata) MatchKind() (graph.Kind, bool) {
@@ -103,6 +104,7 @@ const (
 	DataTypeCertTemplate   DataType = "certtemplates"
 	DataTypeAzure          DataType = "azure"
 	DataTypeIssuancePolicy DataType = "issuancepolicies"
+	DataTypeGeneric        DataType = "generic"
 )
 
 func AllIngestDataTypes() []DataType {
@@ -124,6 +126,7 @@ func AllIngestDataTypes() []DataType {
 		DataTypeCertTemplate,
 		DataTypeAzure,
 		DataTypeIssuancePolicy,
+		DataTypeGeneric,
 	}
 }
 
@@ -221,4 +224,6 @@ var (
 	ErrInvalidDataTag      = errors.New("invalid data tag found")
 	ErrJSONDecoderInternal = errors.New("json decoder internal error")
 	ErrInvalidZipFile      = errors.New("failed to find zip file header")
+	ErrInvalidGenericKind  = errors.New("invalid generic kind")
+	ErrInvalidGenericData  = errors.New("invalid generic data")
 )
@@ -26,16 +26,20 @@ import (
 )
 
 type IngestJob struct {
-	UserID           uuid.UUID   `json:"user_id"`
-	UserEmailAddress null.String `json:"user_email_address"`
-	User             User        `json:"-"`
-	Status           JobStatus   `json:"status"`
-	StatusMessage    string      `json:"status_message"`
-	StartTime        time.Time   `json:"start_time"`
-	EndTime          time.Time   `json:"end_time"`
-	LastIngest       time.Time   `json:"last_ingest"`
-	TotalFiles       int         `json:"total_files"`
-	FailedFiles      int         `json:"failed_files"`
+	UserID              uuid.UUID   `json:"user_id"`
+	UserEmailAddress    null.String `json:"user_email_address"`
+	User                User        `json:"-"`
+	Status              JobStatus   `json:"status"`
+	StatusMessage       string      `json:"status_message"`
+	StartTime           time.Time   `json:"start_time"`
+	EndTime             time.Time   `json:"end_time"`
+	LastIngest          time.Time   `json:"last_ingest"`
+	TotalFiles          int         `json:"total_files"`
+	FailedFiles         int         `json:"failed_files"`
+	WorkspaceID         null.Int32  `json:"workspace_id"`
+	CanceledBy          null.String `json:"canceled_by"`
+	RollbackRequestedBy null.String `json:"rollback_requested_by"`
+	RolledBackAt        null.Time   `json:"rolled_back_at"`
 	BigSerial
 }
 
@@ -93,9 +93,11 @@ const (
 )
 
 type SearchResult struct {
-	ObjectID          string `json:"objectid"`
-	Type              string `json:"type"`
-	Name              string `json:"name"`
-	DistinguishedName string `json:"distinguishedname"`
-	SystemTags        string `json:"system_tags"`
+	ObjectID          string            `json:"objectid"`
+	Type              string            `json:"type"`
+	Name              string            `json:"name"`
+	DistinguishedName string            `json:"distinguishedname"`
+	SystemTags        string            `json:"system_tags"`
+	Score             float64           `json:"score,omitempty"`
+	Highlights        map[string]string `json:"highlights,omitempty"`
 }
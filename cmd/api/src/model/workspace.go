@@ -0,0 +1,45 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package model
+
+import (
+	"fmt"
+
+	"github.com/gofrs/uuid"
+)
+
+// Workspace is an isolated graph namespace. Graph data ingested into or analyzed within a workspace is only
+// reachable through requests that select the workspace.
+type Workspace struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+
+	Serial
+}
+
+// GraphName returns the name of the graph namespace backing this workspace.
+func (s Workspace) GraphName() string {
+	return fmt.Sprintf("workspace_%d", s.ID)
+}
+
+type Workspaces []Workspace
+
+// WorkspaceUser grants a user access to a workspace
+type WorkspaceUser struct {
+	WorkspaceID int32     `json:"workspace_id" gorm:"primaryKey"`
+	UserID      uuid.UUID `json:"user_id" gorm:"primaryKey"`
+}
@@ -34,6 +34,7 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/specterops/bloodhound/analysis"
+	"github.com/specterops/bloodhound/analysis/simulation"
 	"github.com/specterops/bloodhound/bhlog/measure"
 	"github.com/specterops/bloodhound/cache"
 	"github.com/specterops/bloodhound/cypher/analyzer"
@@ -48,8 +49,10 @@ import (
 	"github.com/specterops/bloodhound/graphschema/azure"
 	"github.com/specterops/bloodhound/graphschema/common"
 	"github.com/specterops/bloodhound/src/api/bloodhoundgraph"
+	"github.com/specterops/bloodhound/src/api/graphexport"
 	"github.com/specterops/bloodhound/src/config"
 	bhCtx "github.com/specterops/bloodhound/src/ctx"
+	"github.com/specterops/bloodhound/src/metrics"
 	"github.com/specterops/bloodhound/src/model"
 	"github.com/specterops/bloodhound/src/services/agi"
 	"github.com/specterops/bloodhound/src/utils"
@@ -134,11 +137,14 @@ type Graph interface {
 	GetAssetGroupNodes(ctx context.Context, assetGroupTag string, isSystemGroup bool) (graph.NodeSet, error)
 	GetAllShortestPaths(ctx context.Context, startNodeID string, endNodeID string, filter graph.Criteria) (graph.PathSet, error)
 	SearchNodesByName(ctx context.Context, nodeKinds graph.Kinds, nameQuery string, skip int, limit int) ([]model.SearchResult, error)
+	SearchNodes(ctx context.Context, nodeKinds graph.Kinds, searchQuery string, skip int, limit int) ([]model.SearchResult, int, error)
 	SearchByNameOrObjectID(ctx context.Context, searchValue string, searchType string) (graph.NodeSet, error)
 	GetADEntityQueryResult(ctx context.Context, params EntityQueryParameters, cacheEnabled bool) (any, int, error)
+	GetADEntityQueryExport(ctx context.Context, params EntityQueryParameters, cacheEnabled bool) (graphexport.Graph, error)
 	GetEntityByObjectId(ctx context.Context, objectID string, kinds ...graph.Kind) (*graph.Node, error)
 	GetEntityCountResults(ctx context.Context, node *graph.Node, delegates map[string]any) map[string]any
 	GetNodesByKind(ctx context.Context, kinds ...graph.Kind) (graph.NodeSet, error)
+	RemoveNodeKind(ctx context.Context, kind graph.Kind) error
 	GetPrimaryNodeKindCounts(ctx context.Context, kinds ...graph.Kind) (map[string]int, error)
 	CountNodesByKind(ctx context.Context, kinds ...graph.Kind) (int64, error)
 	GetFilteredAndSortedNodes(orderCriteria model.OrderCriteria, filterCriteria graph.Criteria) (graph.NodeSet, error)
@@ -147,8 +153,11 @@ type Graph interface {
 	ValidateOUs(ctx context.Context, ous []string) ([]string, error)
 	BatchNodeUpdate(ctx context.Context, nodeUpdate graph.NodeUpdate) error
 	RawCypherQuery(ctx context.Context, pQuery PreparedQuery, includeProperties bool) (model.UnifiedGraph, error)
+	RawCypherQueryPaths(ctx context.Context, pQuery PreparedQuery) (graph.PathSet, error)
 	PrepareCypherQuery(rawCypher string, queryComplexityLimit int64) (PreparedQuery, error)
 	UpdateSelectorTags(ctx context.Context, db agi.AgiData, selectors model.UpdatedAssetGroupSelectors) error
+	SimulateRemediation(ctx context.Context, changes simulation.Changes) (simulation.Report, error)
+	GetIngestJobObjects(ctx context.Context, jobID int64, skip int, limit int) (model.IngestJobObjects, error)
 }
 
 type GraphQuery struct {
@@ -274,6 +283,55 @@ func (s *GraphQuery) GetAllShortestPaths(ctx context.Context, startNodeID string
 	})
 }
 
+// SimulateRemediation reports the effect of the given hypothetical changes on tier zero reachability without writing
+// to the graph.
+func (s *GraphQuery) SimulateRemediation(ctx context.Context, changes simulation.Changes) (simulation.Report, error) {
+	return simulation.Simulate(ctx, s.Graph, changes)
+}
+
+// GetIngestJobObjects returns the nodes and relationships that the given ingest job last wrote to the graph. The skip
+// and limit are applied to the nodes and to the relationships separately.
+func (s *GraphQuery) GetIngestJobObjects(ctx context.Context, jobID int64, skip int, limit int) (model.IngestJobObjects, error) {
+	var (
+		objects = model.IngestJobObjects{
+			UnifiedGraph: model.NewUnifiedGraph(),
+		}
+		nodeFilter         = query.Equals(query.NodeProperty(common.LastIngestJob.String()), jobID)
+		relationshipFilter = query.Equals(query.RelationshipProperty(common.LastIngestJob.String()), jobID)
+	)
+
+	err := s.Graph.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		if nodeCount, err := tx.Nodes().Filter(nodeFilter).Count(); err != nil {
+			return err
+		} else if relationshipCount, err := tx.Relationships().Filter(relationshipFilter).Count(); err != nil {
+			return err
+		} else {
+			objects.NodeCount = nodeCount
+			objects.RelationshipCount = relationshipCount
+		}
+
+		if err := tx.Nodes().Filter(nodeFilter).Offset(skip).Limit(limit).Fetch(func(cursor graph.Cursor[*graph.Node]) error {
+			for node := range cursor.Chan() {
+				objects.AddNode(node, true)
+			}
+
+			return cursor.Error()
+		}); err != nil {
+			return err
+		}
+
+		return tx.Relationships().Filter(relationshipFilter).Offset(skip).Limit(limit).Fetch(func(cursor graph.Cursor[*graph.Relationship]) error {
+			for relationship := range cursor.Chan() {
+				objects.AddRelationship(relationship, true)
+			}
+
+			return cursor.Error()
+		})
+	})
+
+	return objects, err
+}
+
 // the following negation clause matches nodes that have both ADLocalGroup and Group labels, but excludes nodes that only have the ADLocalGroup label.
 // equivalent cypher: MATCH (n) WHERE NOT (n:ADLocalGroup AND NOT n:Group)
 var groupFilter = query.Not(
@@ -426,18 +484,28 @@ func (s *GraphQuery) PrepareCypherQuery(rawCypher string, queryComplexityLimit i
 }
 
 func (s *GraphQuery) RawCypherQuery(ctx context.Context, pQuery PreparedQuery, includeProperties bool) (model.UnifiedGraph, error) {
+	graphResponse := model.NewUnifiedGraph()
+
+	pathSet, err := s.RawCypherQueryPaths(ctx, pQuery)
+	graphResponse.AddPathSet(pathSet, includeProperties)
+
+	return graphResponse, err
+}
+
+// RawCypherQueryPaths runs the prepared query and returns the paths it matched.
+func (s *GraphQuery) RawCypherQueryPaths(ctx context.Context, pQuery PreparedQuery) (graph.PathSet, error) {
 	var (
 		err error
 
-		graphResponse = model.NewUnifiedGraph()
-		bhCtxInst     = bhCtx.Get(ctx)
+		pathSet   graph.PathSet
+		bhCtxInst = bhCtx.Get(ctx)
 	)
 
 	txDelegate := func(tx graph.Transaction) error {
-		if pathSet, err := ops.FetchPathSetByQuery(tx, pQuery.query); err != nil {
+		if fetchedPathSet, err := ops.FetchPathSetByQuery(tx, pQuery.query); err != nil {
 			return err
 		} else {
-			graphResponse.AddPathSet(pathSet, includeProperties)
+			pathSet = fetchedPathSet
 		}
 
 		return nil
@@ -489,6 +557,9 @@ func (s *GraphQuery) RawCypherQuery(ctx context.Context, pQuery PreparedQuery, i
 
 	runtime := time.Since(start)
 
+	metrics.CypherQueryDuration.WithLabelValues(metrics.Status(err)).Observe(runtime.Seconds())
+	metrics.CypherQueryComplexity.Observe(float64(pQuery.complexity.Weight))
+
 	slog.Info(
 		fmt.Sprintf("Executed user cypher query with cost %d in %.2f seconds", pQuery.complexity.Weight, runtime.Seconds()),
 		"query", pQuery.StrippedQuery,
@@ -505,10 +576,10 @@ func (s *GraphQuery) RawCypherQuery(ctx context.Context, pQuery PreparedQuery, i
 		} else {
 			slog.WarnContext(ctx, fmt.Sprintf("RawCypherQuery failed: %v", err))
 		}
-		return graphResponse, err
+		return nil, err
 	}
 
-	return graphResponse, nil
+	return pathSet, nil
 }
 
 func applyTimeoutReduction(queryWeight int64, availableRuntime time.Duration) (time.Duration, int64) {
@@ -557,7 +628,7 @@ func nodesToSearchResult(nodes ...*graph.Node) []model.SearchResult {
 func (s *GraphQuery) SearchByNameOrObjectID(ctx context.Context, searchValue string, searchType SearchType) (graph.NodeSet, error) {
 	var nodes = graph.NewNodeSet()
 
-	for _, kind := range []graph.Kind{ad.Entity, azure.Entity} {
+	for _, kind := range []graph.Kind{ad.Entity, azure.Entity, common.GenericEntity} {
 		if err := s.Graph.ReadTransaction(ctx, func(tx graph.Transaction) error {
 			if fetchedNodes, err := ops.FetchNodeSet(tx.Nodes().Filterf(func() graph.Criteria {
 				if searchType == SearchTypeExact {
@@ -591,13 +662,21 @@ func (s *GraphQuery) SearchByNameOrObjectID(ctx context.Context, searchValue str
 	return nodes, nil
 }
 
-func (s *GraphQuery) GetADEntityQueryResult(ctx context.Context, params EntityQueryParameters, cacheEnabled bool) (any, int, error) {
+func validateEntityQueryParams(params EntityQueryParameters) error {
 	if params.RequestedType == model.DataTypeGraph && params.PathDelegate == nil {
-		return nil, 0, ErrGraphUnsupported
+		return ErrGraphUnsupported
 	}
 
 	if params.RequestedType == model.DataTypeCount || params.RequestedType == model.DataTypeList && params.ListDelegate == nil {
-		return nil, 0, ErrUnsupportedDataType
+		return ErrUnsupportedDataType
+	}
+
+	return nil
+}
+
+func (s *GraphQuery) GetADEntityQueryResult(ctx context.Context, params EntityQueryParameters, cacheEnabled bool) (any, int, error) {
+	if err := validateEntityQueryParams(params); err != nil {
+		return nil, 0, err
 	}
 
 	if node, err := s.GetEntityByObjectId(ctx, params.ObjectID, ad.Entity); err != nil {
@@ -607,6 +686,30 @@ func (s *GraphQuery) GetADEntityQueryResult(ctx context.Context, params EntityQu
 	}
 }
 
+// GetADEntityQueryExport returns the result of an entity query for export to external graph tooling. Graph requests
+// export the paths found by the query's path delegate while list requests export the requested page of related nodes.
+func (s *GraphQuery) GetADEntityQueryExport(ctx context.Context, params EntityQueryParameters, cacheEnabled bool) (graphexport.Graph, error) {
+	if err := validateEntityQueryParams(params); err != nil {
+		return graphexport.Graph{}, err
+	}
+
+	if node, err := s.GetEntityByObjectId(ctx, params.ObjectID, ad.Entity); err != nil {
+		return graphexport.Graph{}, fmt.Errorf("error getting entity node: %w", err)
+	} else if params.RequestedType == model.DataTypeGraph {
+		if result, err := fetchPathQuery(ctx, s.Graph, node, params.PathDelegate); err != nil {
+			return graphexport.Graph{}, err
+		} else {
+			return graphexport.FromPathSet(result), nil
+		}
+	} else if result, err := s.runMaybeCachedEntityQuery(ctx, node, params, cacheEnabled); err != nil {
+		return graphexport.Graph{}, err
+	} else if page, err := pageEntityQueryResult(result, params.Skip, params.Limit); err != nil {
+		return graphexport.Graph{}, err
+	} else {
+		return graphexport.FromNodeSet(graph.NewNodeSet(page...)), nil
+	}
+}
+
 func (s *GraphQuery) GetEntityByObjectId(ctx context.Context, objectID string, kinds ...graph.Kind) (*graph.Node, error) {
 	var (
 		node *graph.Node
@@ -707,6 +810,25 @@ func (s *GraphQuery) GetNodesByKind(ctx context.Context, kinds ...graph.Kind) (g
 	})
 }
 
+// RemoveNodeKind removes the given kind from every node that has it.
+func (s *GraphQuery) RemoveNodeKind(ctx context.Context, kind graph.Kind) error {
+	return s.Graph.WriteTransaction(ctx, func(tx graph.Transaction) error {
+		if nodes, err := ops.FetchNodeSet(tx.Nodes().Filter(query.Kind(query.Node(), kind))); err != nil {
+			return err
+		} else {
+			for _, node := range nodes {
+				node.DeleteKinds(kind)
+
+				if err := tx.UpdateNode(node); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
 func (s *GraphQuery) GetFilteredAndSortedNodes(orderCriteria model.OrderCriteria, filterCriteria graph.Criteria) (graph.NodeSet, error) {
 	var nodes graph.NodeSet
 
@@ -833,23 +955,90 @@ func nodeSetToOrderedSlice(nodeSet graph.NodeSet) []*graph.Node {
 	return nodes
 }
 
-func (s *GraphQuery) cacheQueryResult(queryStart time.Time, cacheKey string, result graph.NodeSet) {
+func (s *GraphQuery) cacheQueryResult(ctx context.Context, queryStart time.Time, cacheKey string, node *graph.Node, params EntityQueryParameters, result graph.NodeSet) {
 	queryTime := time.Since(queryStart).Milliseconds()
 
 	// Only cache the result if it matches our criteria, including having a valid query name
 	if queryTime > s.SlowQueryThreshold {
 		// Using GuardedSet here even though it isn't necessary because it allows us to collect information on how often
 		// we run these queries in parallel
-		if set, sizeInBytes, err := s.Cache.GuardedSet(cacheKey, result); err != nil {
+		if set, sizeInBytes, err := s.Cache.GuardedSetTagged(cacheKey, result, entityQueryResultCacheTags(ctx, node, result)...); err != nil {
 			slog.Error(fmt.Sprintf("[Entity Results Cache] Failed to write results to cache for key: %s", cacheKey))
 		} else if !set {
 			slog.Warn(fmt.Sprintf("[Entity Results Cache] Cache entry for query %s not set because it already exists", cacheKey))
 		} else {
+			s.Cache.SetLoader(cacheKey, s.entityQueryCacheLoader(ctx, node, params))
 			slog.Info(fmt.Sprintf("[Entity Results Cache] Cached slow query %s (%d bytes) because it took %dms", cacheKey, sizeInBytes, queryTime))
 		}
 	}
 }
 
+// entityQueryCacheLoader returns a cache loader that reruns the given entity query against the graph the query was
+// originally directed at. The node is fetched again by its object ID since it may have been replaced by ingest.
+func (s *GraphQuery) entityQueryCacheLoader(ctx context.Context, node *graph.Node, params EntityQueryParameters) cache.Loader {
+	target, hasTarget := graph.GraphTargetFromContext(ctx)
+
+	return func(ctx context.Context) (any, []string, error) {
+		if hasTarget {
+			ctx = graph.WithGraphTarget(ctx, target)
+		}
+
+		if node, err := s.GetEntityByObjectId(ctx, params.ObjectID, node.Kinds...); err != nil {
+			return nil, nil, fmt.Errorf("error getting entity node: %w", err)
+		} else if result, err := runEntityQuery(ctx, s.Graph, params.ListDelegate, node, 0, 0); err != nil {
+			return nil, nil, err
+		} else {
+			return result, entityQueryResultCacheTags(ctx, node, result), nil
+		}
+	}
+}
+
+// entityQueryCacheTag formats a cache tag for entity query results. Tags are prefixed by the graph name the same way
+// cache keys are so that invalidating one graph never evicts the results of another.
+func entityQueryCacheTag(ctx context.Context, tag string) string {
+	if target, hasTarget := graph.GraphTargetFromContext(ctx); hasTarget {
+		return target.Name + "_" + tag
+	}
+
+	return tag
+}
+
+// EntityQueryCacheTags returns the cache tags that entity query results depending on the given nodes are tagged with.
+// Nodes are tagged by the domain or tenant they belong to; nodes that belong to neither are tagged by their object ID.
+func EntityQueryCacheTags(ctx context.Context, nodes ...*graph.Node) []string {
+	var (
+		seen = map[string]struct{}{}
+		tags []string
+	)
+
+	for _, node := range nodes {
+		var tag string
+
+		if node.Properties == nil {
+			continue
+		} else if domainSID, err := node.Properties.Get(ad.DomainSID.String()).String(); err == nil && domainSID != "" {
+			tag = entityQueryCacheTag(ctx, "entity-query-scope_"+domainSID)
+		} else if tenantID, err := node.Properties.Get(azure.TenantID.String()).String(); err == nil && tenantID != "" {
+			tag = entityQueryCacheTag(ctx, "entity-query-scope_"+tenantID)
+		} else if objectID, err := node.Properties.Get(common.ObjectID.String()).String(); err == nil && objectID != "" {
+			tag = entityQueryCacheTag(ctx, "entity-query-object_"+objectID)
+		} else {
+			continue
+		}
+
+		if _, isSeen := seen[tag]; !isSeen {
+			seen[tag] = struct{}{}
+			tags = append(tags, tag)
+		}
+	}
+
+	return tags
+}
+
+func entityQueryResultCacheTags(ctx context.Context, node *graph.Node, result graph.NodeSet) []string {
+	return EntityQueryCacheTags(ctx, append(result.Slice(), node)...)
+}
+
 func runEntityQuery(ctx context.Context, db graph.Database, delegate any, node *graph.Node, skip, limit int) (graph.NodeSet, error) {
 	var result graph.NodeSet
 
@@ -881,10 +1070,22 @@ func runEntityQuery(ctx context.Context, db graph.Database, delegate any, node *
 	return result, nil
 }
 
+// entityQueryCacheKey formats the cache key for an entity query. Queries directed at a graph other than the default graph
+// are keyed by the graph name so that cached results are never shared between graphs.
+func entityQueryCacheKey(ctx context.Context, params EntityQueryParameters) string {
+	cacheKey := fmt.Sprintf("ad-entity-query_%s_%s_%d", params.QueryName, params.ObjectID, params.RequestedType)
+
+	if target, hasTarget := graph.GraphTargetFromContext(ctx); hasTarget {
+		return target.Name + "_" + cacheKey
+	}
+
+	return cacheKey
+}
+
 func (s *GraphQuery) runMaybeCachedEntityQuery(ctx context.Context, node *graph.Node, params EntityQueryParameters, cacheEnabled bool) (graph.NodeSet, error) {
 	var (
 		queryStart = time.Now()
-		cacheKey   = fmt.Sprintf("ad-entity-query_%s_%s_%d", params.QueryName, params.ObjectID, params.RequestedType)
+		cacheKey   = entityQueryCacheKey(ctx, params)
 
 		foundResultInCache = false
 
@@ -908,7 +1109,7 @@ func (s *GraphQuery) runMaybeCachedEntityQuery(ctx context.Context, node *graph.
 	}
 
 	if params.QueryName != "" && cacheEnabled && !foundResultInCache {
-		s.cacheQueryResult(queryStart, cacheKey, result)
+		s.cacheQueryResult(ctx, queryStart, cacheKey, node, params, result)
 	}
 
 	return result, nil
@@ -922,15 +1123,24 @@ func (s *GraphQuery) runListQuery(ctx context.Context, node *graph.Node, params
 
 	if result, err := s.runMaybeCachedEntityQuery(ctx, node, params, cacheEnabled); err != nil {
 		return nil, 0, err
-	} else if skip > result.Len() {
-		return nil, 0, fmt.Errorf(utils.ErrorInvalidSkip, skip)
+	} else if page, err := pageEntityQueryResult(result, skip, limit); err != nil {
+		return nil, 0, err
 	} else {
-		if skip+limit > result.Len() {
-			limit = result.Len() - skip
-		}
+		return fromGraphNodes(graph.NewNodeSet(page...)), result.Len(), nil
+	}
+}
 
-		return fromGraphNodes(graph.NewNodeSet(nodeSetToOrderedSlice(result)[skip : skip+limit]...)), result.Len(), nil
+// pageEntityQueryResult returns the requested page of an entity query result in a stable order.
+func pageEntityQueryResult(result graph.NodeSet, skip, limit int) ([]*graph.Node, error) {
+	if skip > result.Len() {
+		return nil, fmt.Errorf(utils.ErrorInvalidSkip, skip)
 	}
+
+	if skip+limit > result.Len() {
+		limit = result.Len() - skip
+	}
+
+	return nodeSetToOrderedSlice(result)[skip : skip+limit], nil
 }
 
 func (s *GraphQuery) runCountQuery(ctx context.Context, node *graph.Node, params EntityQueryParameters, cacheEnabled bool) (any, int, error) {
@@ -939,6 +1149,14 @@ func (s *GraphQuery) runCountQuery(ctx context.Context, node *graph.Node, params
 }
 
 func runPathQuery(ctx context.Context, db graph.Database, node *graph.Node, pathDelegate any) (map[string]any, int, error) {
+	if result, err := fetchPathQuery(ctx, db, node, pathDelegate); err != nil {
+		return nil, 0, err
+	} else {
+		return bloodhoundgraph.PathSetToBloodHoundGraph(result), result.Len(), nil
+	}
+}
+
+func fetchPathQuery(ctx context.Context, db graph.Database, node *graph.Node, pathDelegate any) (graph.PathSet, error) {
 	var (
 		result graph.PathSet
 		err    error
@@ -961,11 +1179,7 @@ func runPathQuery(ctx context.Context, db graph.Database, node *graph.Node, path
 		err = fmt.Errorf("unsupported path delegate type %T", typedDelegate)
 	}
 
-	if err != nil {
-		return nil, 0, err
-	} else {
-		return bloodhoundgraph.PathSetToBloodHoundGraph(result), result.Len(), nil
-	}
+	return result, err
 }
 
 func (s *GraphQuery) GetEntityResults(ctx context.Context, node *graph.Node, params EntityQueryParameters, cacheEnabled bool) (any, int, error) {
@@ -26,6 +26,9 @@ import (
 	"github.com/specterops/bloodhound/cache"
 	"github.com/specterops/bloodhound/dawgs/graph"
 	graph_mocks "github.com/specterops/bloodhound/dawgs/graph/mocks"
+	"github.com/specterops/bloodhound/graphschema/ad"
+	"github.com/specterops/bloodhound/graphschema/azure"
+	"github.com/specterops/bloodhound/graphschema/common"
 	"github.com/specterops/bloodhound/src/model"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -150,7 +153,9 @@ func Test_cacheQueryResult(t *testing.T) {
 	var (
 		mockCtrl = gomock.NewController(t)
 		mockDB   = graph_mocks.NewMockDatabase(mockCtrl)
-		result   = graph.NodeSet{}
+		node     = graph.NewNode(1, graph.NewProperties().Set(common.ObjectID.String(), "USER").Set(ad.DomainSID.String(), "DOMAIN"), ad.Entity, ad.User)
+		result   = graph.NewNodeSet(graph.NewNode(2, graph.NewProperties().Set(common.ObjectID.String(), "GROUP"), ad.Entity, ad.Group))
+		params   = EntityQueryParameters{QueryName: "test", ObjectID: "USER"}
 	)
 
 	cacheInstance, err := cache.NewCache(cache.Config{MaxSize: 100})
@@ -163,16 +168,39 @@ func Test_cacheQueryResult(t *testing.T) {
 	}
 
 	// Happy path rejection for queries that run quick enough
-	graphQuery.cacheQueryResult(time.Now().Add(-time.Second), cacheKey, result)
+	graphQuery.cacheQueryResult(context.Background(), time.Now().Add(-time.Second), cacheKey, node, params, result)
 
 	// Happy path setting for queries that are slow enough
-	graphQuery.cacheQueryResult(time.Now().Add(-time.Hour), cacheKey, result)
+	graphQuery.cacheQueryResult(context.Background(), time.Now().Add(-time.Hour), cacheKey, node, params, result)
 
 	// Force test for the error case
-	graphQuery.cacheQueryResult(time.Now().Add(-time.Hour), cacheKey, result)
+	graphQuery.cacheQueryResult(context.Background(), time.Now().Add(-time.Hour), cacheKey, node, params, result)
 
 	// Force test for when the cache key is already set
-	graphQuery.cacheQueryResult(time.Now().Add(-time.Hour), cacheKey, result)
+	graphQuery.cacheQueryResult(context.Background(), time.Now().Add(-time.Hour), cacheKey, node, params, result)
+
+	// The cached result is tagged by the domain of the queried node and by the object ID of the scopeless result node
+	require.Equal(t, 1, cacheInstance.Invalidate("entity-query-object_GROUP"))
+	require.Equal(t, 0, cacheInstance.Invalidate("entity-query-scope_DOMAIN"))
+}
+
+func Test_EntityQueryCacheTags(t *testing.T) {
+	var (
+		user   = graph.NewNode(1, graph.NewProperties().Set(common.ObjectID.String(), "USER").Set(ad.DomainSID.String(), "DOMAIN"), ad.Entity, ad.User)
+		group  = graph.NewNode(2, graph.NewProperties().Set(common.ObjectID.String(), "GROUP").Set(ad.DomainSID.String(), "DOMAIN"), ad.Entity, ad.Group)
+		azUser = graph.NewNode(3, graph.NewProperties().Set(common.ObjectID.String(), "AZUSER").Set(azure.TenantID.String(), "TENANT"), azure.Entity, azure.User)
+		meta   = graph.NewNode(4, graph.NewProperties().Set(common.ObjectID.String(), "META"), ad.Entity)
+	)
+
+	require.Equal(t, []string{
+		"entity-query-scope_DOMAIN",
+		"entity-query-scope_TENANT",
+		"entity-query-object_META",
+	}, EntityQueryCacheTags(context.Background(), user, group, azUser, meta))
+
+	// Tags of queries directed at a graph other than the default graph are prefixed by the graph name
+	targetCtx := graph.WithGraphTarget(context.Background(), graph.Graph{Name: "workspace_1"})
+	require.Equal(t, []string{"workspace_1_entity-query-scope_DOMAIN"}, EntityQueryCacheTags(targetCtx, user))
 }
 
 func Test_formatSearchResults_sorting(t *testing.T) {
@@ -24,7 +24,9 @@ import (
 	context "context"
 	reflect "reflect"
 
+	simulation "github.com/specterops/bloodhound/analysis/simulation"
 	graph "github.com/specterops/bloodhound/dawgs/graph"
+	graphexport "github.com/specterops/bloodhound/src/api/graphexport"
 	model "github.com/specterops/bloodhound/src/model"
 	queries "github.com/specterops/bloodhound/src/queries"
 	agi "github.com/specterops/bloodhound/src/services/agi"
@@ -128,6 +130,21 @@ func (mr *MockGraphMockRecorder) FetchNodesByObjectIDsAndKinds(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchNodesByObjectIDsAndKinds", reflect.TypeOf((*MockGraph)(nil).FetchNodesByObjectIDsAndKinds), varargs...)
 }
 
+// GetADEntityQueryExport mocks base method.
+func (m *MockGraph) GetADEntityQueryExport(arg0 context.Context, arg1 queries.EntityQueryParameters, arg2 bool) (graphexport.Graph, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetADEntityQueryExport", arg0, arg1, arg2)
+	ret0, _ := ret[0].(graphexport.Graph)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetADEntityQueryExport indicates an expected call of GetADEntityQueryExport.
+func (mr *MockGraphMockRecorder) GetADEntityQueryExport(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetADEntityQueryExport", reflect.TypeOf((*MockGraph)(nil).GetADEntityQueryExport), arg0, arg1, arg2)
+}
+
 // GetADEntityQueryResult mocks base method.
 func (m *MockGraph) GetADEntityQueryResult(arg0 context.Context, arg1 queries.EntityQueryParameters, arg2 bool) (interface{}, int, error) {
 	m.ctrl.T.Helper()
@@ -238,6 +255,21 @@ func (mr *MockGraphMockRecorder) GetFilteredAndSortedNodes(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilteredAndSortedNodes", reflect.TypeOf((*MockGraph)(nil).GetFilteredAndSortedNodes), arg0, arg1)
 }
 
+// GetIngestJobObjects mocks base method.
+func (m *MockGraph) GetIngestJobObjects(arg0 context.Context, arg1 int64, arg2, arg3 int) (model.IngestJobObjects, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIngestJobObjects", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(model.IngestJobObjects)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIngestJobObjects indicates an expected call of GetIngestJobObjects.
+func (mr *MockGraphMockRecorder) GetIngestJobObjects(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIngestJobObjects", reflect.TypeOf((*MockGraph)(nil).GetIngestJobObjects), arg0, arg1, arg2, arg3)
+}
+
 // GetNodesByKind mocks base method.
 func (m *MockGraph) GetNodesByKind(arg0 context.Context, arg1 ...graph.Kind) (graph.NodeSet, error) {
 	m.ctrl.T.Helper()
@@ -308,6 +340,35 @@ func (mr *MockGraphMockRecorder) RawCypherQuery(arg0, arg1, arg2 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RawCypherQuery", reflect.TypeOf((*MockGraph)(nil).RawCypherQuery), arg0, arg1, arg2)
 }
 
+// RawCypherQueryPaths mocks base method.
+func (m *MockGraph) RawCypherQueryPaths(arg0 context.Context, arg1 queries.PreparedQuery) (graph.PathSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RawCypherQueryPaths", arg0, arg1)
+	ret0, _ := ret[0].(graph.PathSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RawCypherQueryPaths indicates an expected call of RawCypherQueryPaths.
+func (mr *MockGraphMockRecorder) RawCypherQueryPaths(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RawCypherQueryPaths", reflect.TypeOf((*MockGraph)(nil).RawCypherQueryPaths), arg0, arg1)
+}
+
+// RemoveNodeKind mocks base method.
+func (m *MockGraph) RemoveNodeKind(arg0 context.Context, arg1 graph.Kind) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveNodeKind", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveNodeKind indicates an expected call of RemoveNodeKind.
+func (mr *MockGraphMockRecorder) RemoveNodeKind(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveNodeKind", reflect.TypeOf((*MockGraph)(nil).RemoveNodeKind), arg0, arg1)
+}
+
 // SearchByNameOrObjectID mocks base method.
 func (m *MockGraph) SearchByNameOrObjectID(arg0 context.Context, arg1, arg2 string) (graph.NodeSet, error) {
 	m.ctrl.T.Helper()
@@ -323,6 +384,22 @@ func (mr *MockGraphMockRecorder) SearchByNameOrObjectID(arg0, arg1, arg2 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchByNameOrObjectID", reflect.TypeOf((*MockGraph)(nil).SearchByNameOrObjectID), arg0, arg1, arg2)
 }
 
+// SearchNodes mocks base method.
+func (m *MockGraph) SearchNodes(arg0 context.Context, arg1 graph.Kinds, arg2 string, arg3, arg4 int) ([]model.SearchResult, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchNodes", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]model.SearchResult)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchNodes indicates an expected call of SearchNodes.
+func (mr *MockGraphMockRecorder) SearchNodes(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchNodes", reflect.TypeOf((*MockGraph)(nil).SearchNodes), arg0, arg1, arg2, arg3, arg4)
+}
+
 // SearchNodesByName mocks base method.
 func (m *MockGraph) SearchNodesByName(arg0 context.Context, arg1 graph.Kinds, arg2 string, arg3, arg4 int) ([]model.SearchResult, error) {
 	m.ctrl.T.Helper()
@@ -338,6 +415,21 @@ func (mr *MockGraphMockRecorder) SearchNodesByName(arg0, arg1, arg2, arg3, arg4
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchNodesByName", reflect.TypeOf((*MockGraph)(nil).SearchNodesByName), arg0, arg1, arg2, arg3, arg4)
 }
 
+// SimulateRemediation mocks base method.
+func (m *MockGraph) SimulateRemediation(arg0 context.Context, arg1 simulation.Changes) (simulation.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SimulateRemediation", arg0, arg1)
+	ret0, _ := ret[0].(simulation.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SimulateRemediation indicates an expected call of SimulateRemediation.
+func (mr *MockGraphMockRecorder) SimulateRemediation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SimulateRemediation", reflect.TypeOf((*MockGraph)(nil).SimulateRemediation), arg0, arg1)
+}
+
 // UpdateSelectorTags mocks base method.
 func (m *MockGraph) UpdateSelectorTags(arg0 context.Context, arg1 agi.AgiData, arg2 model.UpdatedAssetGroupSelectors) error {
 	m.ctrl.T.Helper()
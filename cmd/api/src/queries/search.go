@@ -0,0 +1,164 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package queries
+
+import (
+	"context"
+	"errors"
+	"html"
+	"math"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/specterops/bloodhound/dawgs/graph"
+	"github.com/specterops/bloodhound/graphschema"
+	"github.com/specterops/bloodhound/graphschema/ad"
+	"github.com/specterops/bloodhound/src/model"
+)
+
+const (
+	highlightStart = "<mark>"
+	highlightEnd   = "</mark>"
+)
+
+// newHighlightPattern returns a case-insensitive pattern that matches any of the given search terms, preferring the
+// longest term at each position.
+func newHighlightPattern(terms []string) *regexp.Regexp {
+	quotedTerms := make([]string, 0, len(terms))
+
+	for _, term := range terms {
+		quotedTerms = append(quotedTerms, regexp.QuoteMeta(term))
+	}
+
+	slices.SortFunc(quotedTerms, func(a, b string) int {
+		return len(b) - len(a)
+	})
+
+	return regexp.MustCompile("(?i)" + strings.Join(quotedTerms, "|"))
+}
+
+// highlight HTML escapes the given value and wraps each match of the pattern in mark tags. Returns false if the value
+// contains no matches.
+func highlight(pattern *regexp.Regexp, value string) (string, bool) {
+	var (
+		matches = pattern.FindAllStringIndex(value, -1)
+		builder = strings.Builder{}
+		cursor  = 0
+	)
+
+	if len(matches) == 0 {
+		return "", false
+	}
+
+	for _, match := range matches {
+		builder.WriteString(html.EscapeString(value[cursor:match[0]]))
+		builder.WriteString(highlightStart)
+		builder.WriteString(html.EscapeString(value[match[0]:match[1]]))
+		builder.WriteString(highlightEnd)
+
+		cursor = match[1]
+	}
+
+	builder.WriteString(html.EscapeString(value[cursor:]))
+	return builder.String(), true
+}
+
+// highlightSearchTerms returns the highlighted values of the given node properties that contain a search term keyed
+// by property name. Only the matching elements of string list properties are included.
+func highlightSearchTerms(node *graph.Node, fields []string, terms []string) map[string]string {
+	var (
+		pattern    = newHighlightPattern(terms)
+		highlights = map[string]string{}
+	)
+
+	for _, field := range fields {
+		var values []string
+
+		switch typedValue := node.Properties.Get(field).Any().(type) {
+		case string:
+			values = []string{typedValue}
+
+		case []string:
+			values = typedValue
+
+		case []any:
+			for _, element := range typedValue {
+				if value, isString := element.(string); isString {
+					values = append(values, value)
+				}
+			}
+		}
+
+		var highlightedValues []string
+
+		for _, value := range values {
+			if highlightedValue, matched := highlight(pattern, value); matched {
+				highlightedValues = append(highlightedValues, highlightedValue)
+			}
+		}
+
+		if len(highlightedValues) > 0 {
+			highlights[field] = strings.Join(highlightedValues, ", ")
+		}
+	}
+
+	return highlights
+}
+
+// SearchNodes runs a relevance ranked search of the node search index for nodes of the given kinds. Every term of the
+// search query must match at least one indexed property. Results carry their relevance score and the highlighted
+// properties that matched. The total number of matching nodes is returned alongside the requested page of results.
+//
+// Graph databases that do not support full-text search fall back to searching node names and object IDs.
+func (s *GraphQuery) SearchNodes(ctx context.Context, nodeKinds graph.Kinds, searchQuery string, skip int, limit int) ([]model.SearchResult, int, error) {
+	search := graph.TextSearch{
+		Index: graphschema.NodeSearchIndex(),
+		Query: searchQuery,
+		Kinds: nodeKinds,
+
+		// Mirror the name search by omitting local groups that are not also domain groups
+		ExcludedKinds: graph.Kinds{ad.LocalGroup},
+		ExemptKinds:   graph.Kinds{ad.Group},
+
+		Skip:  skip,
+		Limit: limit,
+	}
+
+	if textSearchResults, total, err := graph.SearchText(ctx, s.Graph, search); errors.Is(err, graph.ErrUnsupportedDatabaseOperation) {
+		if searchResults, err := s.SearchNodesByName(ctx, nodeKinds, searchQuery, 0, math.MaxInt); err != nil {
+			return nil, 0, err
+		} else {
+			return searchResults[min(skip, len(searchResults)):min(skip+limit, len(searchResults))], len(searchResults), nil
+		}
+	} else if err != nil {
+		return nil, 0, err
+	} else {
+		var (
+			terms         = search.Terms()
+			searchResults = make([]model.SearchResult, len(textSearchResults))
+		)
+
+		for idx, textSearchResult := range textSearchResults {
+			searchResults[idx] = nodeToSearchResult(textSearchResult.Node)
+			searchResults[idx].Score = textSearchResult.Score
+			searchResults[idx].Highlights = highlightSearchTerms(textSearchResult.Node, search.Index.Fields, terms)
+		}
+
+		return searchResults, total, nil
+	}
+}
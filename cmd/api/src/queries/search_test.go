@@ -0,0 +1,93 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package queries_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/specterops/bloodhound/cache"
+	"github.com/specterops/bloodhound/dawgs"
+	"github.com/specterops/bloodhound/dawgs/drivers/memory"
+	"github.com/specterops/bloodhound/dawgs/graph"
+	"github.com/specterops/bloodhound/graphschema/ad"
+	"github.com/specterops/bloodhound/graphschema/azure"
+	"github.com/specterops/bloodhound/graphschema/common"
+	"github.com/specterops/bloodhound/src/config"
+	"github.com/specterops/bloodhound/src/queries"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraphQuery_SearchNodes(t *testing.T) {
+	var (
+		ctx          = context.Background()
+		graphDB, err = dawgs.Open(ctx, memory.DriverName, dawgs.Config{})
+		gq           = queries.NewGraphQuery(graphDB, cache.Cache{}, config.Configuration{})
+		entityKinds  = graph.Kinds{ad.Entity, azure.Entity}
+	)
+
+	require.Nil(t, err)
+	require.Nil(t, graphDB.WriteTransaction(ctx, func(tx graph.Transaction) error {
+		for _, node := range []struct {
+			properties map[string]any
+			kinds      graph.Kinds
+		}{{
+			properties: map[string]any{common.Name.String(): "SVC_SQL@CORP.LOCAL", common.ObjectID.String(): "S-1-5-21-1", common.Email.String(): "sql.admin@corp.local"},
+			kinds:      graph.Kinds{ad.Entity, ad.User},
+		}, {
+			properties: map[string]any{common.Name.String(): "SQL ADMINS@CORP.LOCAL", common.ObjectID.String(): "S-1-5-21-2"},
+			kinds:      graph.Kinds{ad.Entity, ad.Group, ad.LocalGroup},
+		}, {
+			properties: map[string]any{common.Name.String(): "SQL ADMINS@WS01.CORP.LOCAL", common.ObjectID.String(): "S-1-5-21-3"},
+			kinds:      graph.Kinds{ad.Entity, ad.LocalGroup},
+		}} {
+			if _, err := tx.CreateNode(graph.AsProperties(node.properties), node.kinds...); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}))
+
+	// Local groups that are not also domain groups are omitted
+	results, total, err := gq.SearchNodes(ctx, entityKinds, "sql admin", 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, 2, total)
+	require.Len(t, results, 2)
+
+	// Terms may match any indexed property and matching properties are highlighted
+	results, total, err = gq.SearchNodes(ctx, entityKinds, "svc <admin>", 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, 0, total)
+	require.Empty(t, results)
+
+	results, total, err = gq.SearchNodes(ctx, entityKinds, "svc admin", 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, 1, total)
+	require.Equal(t, "S-1-5-21-1", results[0].ObjectID)
+	require.Greater(t, results[0].Score, float64(0))
+	require.Equal(t, map[string]string{
+		common.Name.String():  "<mark>SVC</mark>_SQL@CORP.LOCAL",
+		common.Email.String(): "sql.<mark>admin</mark>@corp.local",
+	}, results[0].Highlights)
+
+	// Results are paginated while reporting the total number of matches
+	results, total, err = gq.SearchNodes(ctx, graph.Kinds{ad.Group}, "sql", 1, 10)
+	require.Nil(t, err)
+	require.Equal(t, 1, total)
+	require.Empty(t, results)
+}
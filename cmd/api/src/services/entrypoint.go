@@ -22,6 +22,7 @@ import (
 	"log/slog"
 	"time"
 
+	"github.com/specterops/bloodhound/analysis"
 	"github.com/specterops/bloodhound/cache"
 	"github.com/specterops/bloodhound/dawgs/graph"
 	schema "github.com/specterops/bloodhound/graphschema"
@@ -35,10 +36,14 @@ import (
 	"github.com/specterops/bloodhound/src/daemons/api/bhapi"
 	"github.com/specterops/bloodhound/src/daemons/api/toolapi"
 	"github.com/specterops/bloodhound/src/daemons/datapipe"
+	"github.com/specterops/bloodhound/src/daemons/dropdir"
 	"github.com/specterops/bloodhound/src/daemons/gc"
 	"github.com/specterops/bloodhound/src/database"
+	"github.com/specterops/bloodhound/src/events"
+	"github.com/specterops/bloodhound/src/metrics"
 	"github.com/specterops/bloodhound/src/model/appcfg"
 	"github.com/specterops/bloodhound/src/queries"
+	"github.com/specterops/bloodhound/src/services/ingest/storage"
 )
 
 // ConnectPostgres initializes a connection to PG, and returns errors if any
@@ -94,24 +99,41 @@ func Entrypoint(ctx context.Context, cfg config.Configuration, connections boots
 		}
 	}
 
+	// Register any declarative post-processing rules so that they run alongside the built-in post-processing
+	if cfg.PostProcessingRulesPath != "" {
+		if err := analysis.RegisteredPostProcessors().RegisterCypherRules(cfg.PostProcessingRulesPath); err != nil {
+			return nil, fmt.Errorf("failed to register post-processing rules: %w", err)
+		}
+	}
+
 	if apiCache, err := cache.NewCache(cache.Config{MaxSize: cfg.MaxAPICacheSize}); err != nil {
 		return nil, fmt.Errorf("failed to create in-memory cache for API: %w", err)
 	} else if graphQueryCache, err := cache.NewCache(cache.Config{MaxSize: cfg.MaxAPICacheSize}); err != nil {
 		return nil, fmt.Errorf("failed to create in-memory cache for graph queries: %w", err)
+	} else if err := metrics.RegisterCache("api", apiCache); err != nil {
+		return nil, fmt.Errorf("failed to register API cache metrics: %w", err)
+	} else if err := metrics.RegisterCache("graph_query", graphQueryCache); err != nil {
+		return nil, fmt.Errorf("failed to register graph query cache metrics: %w", err)
 	} else if collectorManifests, err := cfg.SaveCollectorManifests(); err != nil {
 		return nil, fmt.Errorf("failed to save collector manifests: %w", err)
+	} else if ingestStore, err := storage.New(cfg); err != nil {
+		return nil, fmt.Errorf("failed to create ingest file storage: %w", err)
+	} else if cfg.Ingest.DropDirectory.Path != "" && cfg.Ingest.DropDirectory.ServiceUser == "" {
+		return nil, fmt.Errorf("a service user must be configured to ingest files from the drop directory")
 	} else {
 		var (
 			graphQuery     = queries.NewGraphQuery(connections.Graph, graphQueryCache, cfg)
 			authorizer     = auth.NewAuthorizer(connections.RDMS)
-			datapipeDaemon = datapipe.NewDaemon(ctx, cfg, connections, graphQueryCache, time.Duration(cfg.DatapipeInterval)*time.Second)
+			eventBus       = events.NewBus(events.DefaultHistorySize)
 			routerInst     = router.NewRouter(cfg, authorizer, bootstrap.ContentSecurityPolicy)
 			ctxInitializer = database.NewContextInitializer(connections.RDMS)
 			authenticator  = api.NewAuthenticator(cfg, connections.RDMS, ctxInitializer)
 		)
 
+		slog.InfoContext(ctx, fmt.Sprintf("Storing ingest files in %s", ingestStore.Location()))
+
 		registration.RegisterFossGlobalMiddleware(&routerInst, cfg, auth.NewIdentityResolver(), authenticator)
-		registration.RegisterFossRoutes(&routerInst, cfg, connections.RDMS, connections.Graph, graphQuery, apiCache, collectorManifests, authenticator, authorizer)
+		registration.RegisterFossRoutes(&routerInst, cfg, connections.RDMS, connections.Graph, graphQuery, apiCache, collectorManifests, authenticator, authorizer, eventBus, ingestStore)
 
 		// Set neo4j batch and flush sizes
 		neo4jParameters := appcfg.GetNeo4jParameters(ctx, connections.RDMS)
@@ -123,10 +145,35 @@ func Entrypoint(ctx context.Context, cfg config.Configuration, connections boots
 			slog.WarnContext(ctx, fmt.Sprintf("failed to request init analysis: %v", err))
 		}
 
+		// Every replica serves the API while only the replica holding the leader lock processes ingest, runs analysis,
+		// prunes data and watches the drop directory. The caches and event buses of the replicas are kept in step
+		// through the database: every replica resets its graph query cache once analysis on the leader changes the
+		// graph, and relays the events published to its bus to every other replica.
+		var (
+			cacheSync  = daemons.NewCacheSyncDaemon(connections.RDMS, graphQueryCache, daemons.CacheSyncInterval)
+			eventRelay = daemons.NewEventRelayDaemon(cfg.ClusterNodeID(), connections.RDMS, eventBus, daemons.EventRelayRetryInterval)
+		)
+
+		eventBus.SetRelay(eventRelay)
+
+		leaderDaemons := func(leaderCtx context.Context) []daemons.Daemon {
+			runDaemons := []daemons.Daemon{
+				gc.NewDataPruningDaemon(connections.RDMS),
+				datapipe.NewDaemon(leaderCtx, cfg, connections, graphQueryCache, cacheSync, time.Duration(cfg.DatapipeInterval)*time.Second, eventBus, ingestStore),
+			}
+
+			if cfg.Ingest.DropDirectory.Path != "" {
+				runDaemons = append(runDaemons, dropdir.NewDaemon(cfg.Ingest.DropDirectory, connections.RDMS, ingestStore))
+			}
+
+			return runDaemons
+		}
+
 		return []daemons.Daemon{
 			bhapi.NewDaemon(cfg, routerInst.Handler()),
-			gc.NewDataPruningDaemon(connections.RDMS),
-			datapipeDaemon,
+			cacheSync,
+			eventRelay,
+			daemons.NewLeaderElectedDaemon(cfg.ClusterNodeID(), connections.RDMS, daemons.LeaderHeartbeatInterval, bootstrap.DefaultServerShutdownTimeout, leaderDaemons),
 		}, nil
 	}
 }
@@ -30,9 +30,25 @@ type IngestData interface {
 
 	CreateIngestJob(ctx context.Context, job model.IngestJob) (model.IngestJob, error)
 	UpdateIngestJob(ctx context.Context, job model.IngestJob) error
+	UpdateIngestJobStatus(ctx context.Context, id int64, status model.JobStatus, message string) (bool, error)
+	CancelIngestJob(ctx context.Context, id int64, statuses []model.JobStatus, canceledBy string, message string) (bool, error)
+	EndIngestJob(ctx context.Context, id int64) (bool, error)
+	TouchIngestJobLastIngest(ctx context.Context, id int64) error
+	AddIngestJobFiles(ctx context.Context, id int64, totalFiles int, failedFiles int) error
+	RequestIngestJobRollback(ctx context.Context, id int64, requestedBy string) (bool, error)
+	SetIngestJobRolledBack(ctx context.Context, id int64) error
+	ClearIngestJobRollbackRequest(ctx context.Context, id int64) error
 	GetIngestJob(ctx context.Context, id int64) (model.IngestJob, error)
 	GetAllIngestJobs(ctx context.Context, skip int, limit int, order string, filter model.SQLFilter) ([]model.IngestJob, int, error)
 	GetIngestJobsWithStatus(ctx context.Context, status model.JobStatus) ([]model.IngestJob, error)
+	GetIngestJobsPendingRollback(ctx context.Context) ([]model.IngestJob, error)
 	DeleteAllIngestJobs(ctx context.Context) error
 	CancelAllIngestJobs(ctx context.Context) error
+
+	CreateIngestUpload(ctx context.Context, upload model.IngestUpload) (model.IngestUpload, error)
+	GetIngestUpload(ctx context.Context, id int64) (model.IngestUpload, error)
+	GetIngestUploadChunks(ctx context.Context, uploadID int64) (model.IngestUploadChunks, error)
+	AppendIngestUploadChunk(ctx context.Context, chunk model.IngestUploadChunk) (model.IngestUpload, error)
+	CompleteIngestUpload(ctx context.Context, upload model.IngestUpload, task model.IngestTask) (model.IngestTask, error)
+	DeleteIngestUpload(ctx context.Context, id int64) error
 }
@@ -24,20 +24,26 @@ import (
 	"io"
 	"log/slog"
 	"net/http"
-	"os"
+	"slices"
 	"time"
 
 	"github.com/specterops/bloodhound/bomenc"
 	"github.com/specterops/bloodhound/headers"
 	"github.com/specterops/bloodhound/mediatypes"
+	"github.com/specterops/bloodhound/src/database/types/null"
 	"github.com/specterops/bloodhound/src/model"
 	"github.com/specterops/bloodhound/src/model/ingest"
+	"github.com/specterops/bloodhound/src/services/ingest/storage"
 	"github.com/specterops/bloodhound/src/utils"
 )
 
 const jobActivityTimeout = time.Minute * 20
 
-var ErrInvalidJSON = errors.New("file is not valid json")
+var (
+	ErrInvalidJSON            = errors.New("file is not valid json")
+	ErrInvalidIngestFile      = errors.New("file is not a valid ingest file")
+	ErrIngestJobStatusChanged = errors.New("ingest job status has changed")
+)
 
 // ProcessStaleIngestJobs fetches all runnings ingest jobs and transitions them to a timed out state if the job has been inactive for too long.
 func ProcessStaleIngestJobs(ctx context.Context, db IngestData) {
@@ -74,13 +80,16 @@ func GetAllIngestJobs(ctx context.Context, db IngestData, skip int, limit int, o
 	return db.GetAllIngestJobs(ctx, skip, limit, order, filter)
 }
 
-func StartIngestJob(ctx context.Context, db IngestData, user model.User) (model.IngestJob, error) {
+// StartIngestJob creates a new running ingest job for the given user. Files uploaded to the job are ingested into the
+// given workspace or into the default graph if no workspace is given.
+func StartIngestJob(ctx context.Context, db IngestData, user model.User, workspaceID null.Int32) (model.IngestJob, error) {
 	job := model.IngestJob{
-		UserID:     user.ID,
-		User:       user,
-		Status:     model.JobStatusRunning,
-		StartTime:  time.Now().UTC(),
-		LastIngest: time.Now().UTC(),
+		UserID:      user.ID,
+		User:        user,
+		Status:      model.JobStatusRunning,
+		StartTime:   time.Now().UTC(),
+		LastIngest:  time.Now().UTC(),
+		WorkspaceID: workspaceID,
 	}
 	return db.CreateIngestJob(ctx, job)
 }
@@ -104,72 +113,197 @@ func WriteAndValidateJSON(src io.Reader, dst io.Writer) error {
 	return err
 }
 
-func SaveIngestFile(location string, request *http.Request) (string, model.FileType, error) {
+// SaveIngestFile validates the body of the request while writing it to the ingest store, returning the name of the
+// stored file
+func SaveIngestFile(ctx context.Context, store storage.Store, request *http.Request) (string, model.FileType, error) {
 	fileData := request.Body
-	tempFile, err := os.CreateTemp(location, "bh")
+	writer, err := store.Create(ctx)
 	if err != nil {
 		return "", model.FileTypeJson, fmt.Errorf("error creating ingest file: %w", err)
 	}
 
 	if utils.HeaderMatches(request.Header, headers.ContentType.String(), mediatypes.ApplicationJson.String()) {
-		return tempFile.Name(), model.FileTypeJson, WriteAndValidateFile(fileData, tempFile, WriteAndValidateJSON)
+		return writer.Name(), model.FileTypeJson, WriteAndValidateFile(fileData, writer, WriteAndValidateJSON)
 	} else if utils.HeaderMatches(request.Header, headers.ContentType.String(), ingest.AllowedZipFileUploadTypes...) {
-		return tempFile.Name(), model.FileTypeZip, WriteAndValidateFile(fileData, tempFile, WriteAndValidateZip)
+		return writer.Name(), model.FileTypeZip, WriteAndValidateFile(fileData, writer, WriteAndValidateZip)
 	} else {
 		// We should never get here since this is checked a level above
+		if err := writer.Abort(); err != nil {
+			slog.ErrorContext(ctx, fmt.Sprintf("Error discarding ingest file %s: %v", writer.Name(), err))
+		}
+
 		return "", model.FileTypeJson, fmt.Errorf("invalid content type for ingest file")
 	}
 }
 
+// StoreIngestFile validates the file data while writing it to the ingest store, returning the name of the stored file.
+// A file that fails validation returns an error wrapping ErrInvalidIngestFile, which tells it apart from an error
+// reading the file data or writing to the store that may succeed on a retry.
+func StoreIngestFile(ctx context.Context, store storage.Store, fileType model.FileType, fileData io.Reader) (string, error) {
+	validate := WriteAndValidateJSON
+	if fileType == model.FileTypeZip {
+		validate = WriteAndValidateZip
+	}
+
+	writer, err := store.Create(ctx)
+	if err != nil {
+		return "", fmt.Errorf("error creating ingest file: %w", err)
+	}
+
+	var (
+		source = &recordingReader{reader: fileData}
+		sink   = &recordingWriter{writer: writer}
+	)
+
+	if err := validate(source, sink); err != nil {
+		if err := writer.Abort(); err != nil {
+			slog.ErrorContext(ctx, fmt.Sprintf("Error discarding ingest file %s: %v", writer.Name(), err))
+		}
+
+		if source.err != nil || sink.err != nil {
+			return "", fmt.Errorf("error storing ingest file: %w", err)
+		}
+
+		return "", fmt.Errorf("%w: %w", ErrInvalidIngestFile, err)
+	} else if err := writer.Close(); err != nil {
+		return "", fmt.Errorf("error storing ingest file %s: %w", writer.Name(), err)
+	} else {
+		return writer.Name(), nil
+	}
+}
+
 type FileValidator func(src io.Reader, dst io.Writer) error
 
-func WriteAndValidateFile(fileData io.ReadCloser, tempFile *os.File, validationFunc FileValidator) error {
-	if err := validationFunc(fileData, tempFile); err != nil {
-		if err := tempFile.Close(); err != nil {
-			slog.Error(fmt.Sprintf("Error closing temp file %s with failed validation: %v", tempFile.Name(), err))
-		} else if err := os.Remove(tempFile.Name()); err != nil {
-			slog.Error(fmt.Sprintf("Error deleting temp file %s: %v", tempFile.Name(), err))
+// WriteAndValidateFile writes the file data to the writer, discarding the file if it fails validation. The file is
+// only complete once the writer is closed, so an error closing the writer is returned.
+func WriteAndValidateFile(fileData io.ReadCloser, writer storage.Writer, validationFunc FileValidator) error {
+	if err := validationFunc(fileData, writer); err != nil {
+		if err := writer.Abort(); err != nil {
+			slog.Error(fmt.Sprintf("Error deleting ingest file %s with failed validation: %v", writer.Name(), err))
 		}
 		return err
+	} else if err := writer.Close(); err != nil {
+		return fmt.Errorf("error storing ingest file %s: %w", writer.Name(), err)
 	} else {
-		if err := tempFile.Close(); err != nil {
-			slog.Error(fmt.Sprintf("Error closing temp file with successful validation %s: %v", tempFile.Name(), err))
-		}
 		return nil
 	}
 }
 
 func TouchIngestJobLastIngest(ctx context.Context, db IngestData, job model.IngestJob) error {
-	job.LastIngest = time.Now().UTC()
-	return db.UpdateIngestJob(ctx, job)
+	return db.TouchIngestJobLastIngest(ctx, job.ID)
 }
 
+// EndIngestJob stops the given ingest job from accepting files. ErrIngestJobStatusChanged is returned if the job is no
+// longer running.
 func EndIngestJob(ctx context.Context, db IngestData, job model.IngestJob) error {
-	job.Status = model.JobStatusIngesting
-
-	if err := db.UpdateIngestJob(ctx, job); err != nil {
+	if ended, err := db.EndIngestJob(ctx, job.ID); err != nil {
 		return fmt.Errorf("error ending ingest job: %w", err)
+	} else if !ended {
+		return ErrIngestJobStatusChanged
 	}
 
 	return nil
 }
 
-func UpdateIngestJobStatus(ctx context.Context, db IngestData, job model.IngestJob, status model.JobStatus, message string) error {
-	job.Status = status
-	job.StatusMessage = message
-	job.EndTime = time.Now().UTC()
+// cancelableStatuses are the statuses of ingest jobs that are still accepting or ingesting files
+var cancelableStatuses = []model.JobStatus{model.JobStatusRunning, model.JobStatusIngesting}
 
-	return db.UpdateIngestJob(ctx, job)
+// CancelIngestJob cancels the given ingest job on behalf of the given requester. Any of its files still being ingested
+// are abandoned by the datapipe once it observes the cancellation. ErrIngestJobStatusChanged is returned if the job is
+// no longer cancelable.
+func CancelIngestJob(ctx context.Context, db IngestData, job model.IngestJob, canceledBy string) error {
+	if canceled, err := db.CancelIngestJob(ctx, job.ID, cancelableStatuses, canceledBy, "Canceled"); err != nil {
+		return fmt.Errorf("error canceling ingest job: %w", err)
+	} else if !canceled {
+		return ErrIngestJobStatusChanged
+	}
+
+	return nil
+}
+
+// CancelAnalyzedIngestJob cancels the given ingest job under analysis on behalf of the given requester after analysis
+// is canceled
+func CancelAnalyzedIngestJob(ctx context.Context, db IngestData, job model.IngestJob, canceledBy string) error {
+	if _, err := db.CancelIngestJob(ctx, job.ID, []model.JobStatus{model.JobStatusAnalyzing}, canceledBy, "Analysis canceled"); err != nil {
+		return fmt.Errorf("error canceling ingest job: %w", err)
+	}
+
+	return nil
+}
+
+// IsCancelable returns true if the given ingest job is still accepting or ingesting files.
+func IsCancelable(job model.IngestJob) bool {
+	return slices.Contains(cancelableStatuses, job.Status)
+}
+
+// AddIngestJobFiles records the number of files of an ingested task, and how many of them failed, against the given
+// ingest job
+func AddIngestJobFiles(ctx context.Context, db IngestData, job model.IngestJob, totalFiles int, failedFiles int) error {
+	return db.AddIngestJobFiles(ctx, job.ID, totalFiles, failedFiles)
+}
+
+// RequestIngestJobRollback requests, on behalf of the given requester, that the datapipe remove the nodes and
+// relationships that the given ingest job was the only source of. ErrIngestJobStatusChanged is returned if the job has
+// already been rolled back.
+func RequestIngestJobRollback(ctx context.Context, db IngestData, job model.IngestJob, requestedBy string) error {
+	if requested, err := db.RequestIngestJobRollback(ctx, job.ID, requestedBy); err != nil {
+		return fmt.Errorf("error requesting ingest job rollback: %w", err)
+	} else if !requested {
+		return ErrIngestJobStatusChanged
+	}
+
+	return nil
+}
+
+// IsRollbackable returns true if the given ingest job is no longer accepting or ingesting files and its rollback has not
+// already been requested.
+func IsRollbackable(job model.IngestJob) bool {
+	switch job.Status {
+	case model.JobStatusComplete, model.JobStatusPartiallyComplete, model.JobStatusFailed, model.JobStatusCanceled, model.JobStatusTimedOut, model.JobStatusAnalyzing:
+		return !job.RollbackRequestedBy.Valid
+	default:
+		return false
+	}
+}
+
+// UpdateIngestJobStatus moves the given ingest job to the given status. Only the status columns are written so that a
+// cancellation or rollback request recorded since the job was read is kept. Canceled jobs are left canceled.
+func UpdateIngestJobStatus(ctx context.Context, db IngestData, job model.IngestJob, status model.JobStatus, message string) error {
+	_, err := db.UpdateIngestJobStatus(ctx, job.ID, status, message)
+	return err
 }
 
 func TimeOutIngestJob(ctx context.Context, db IngestData, jobID int64, message string) error {
-	if job, err := db.GetIngestJob(ctx, jobID); err != nil {
-		return err
-	} else {
-		job.Status = model.JobStatusTimedOut
-		job.StatusMessage = message
-		job.EndTime = time.Now().UTC()
+	_, err := db.UpdateIngestJobStatus(ctx, jobID, model.JobStatusTimedOut, message)
+	return err
+}
+
+// recordingReader records the first error other than io.EOF returned by the reader it wraps
+type recordingReader struct {
+	reader io.Reader
+	err    error
+}
 
-		return db.UpdateIngestJob(ctx, job)
+func (s *recordingReader) Read(p []byte) (int, error) {
+	read, err := s.reader.Read(p)
+	if err != nil && !errors.Is(err, io.EOF) && s.err == nil {
+		s.err = err
 	}
+
+	return read, err
+}
+
+// recordingWriter records the first error returned by the writer it wraps
+type recordingWriter struct {
+	writer io.Writer
+	err    error
+}
+
+func (s *recordingWriter) Write(p []byte) (int, error) {
+	written, err := s.writer.Write(p)
+	if err != nil && s.err == nil {
+		s.err = err
+	}
+
+	return written, err
 }
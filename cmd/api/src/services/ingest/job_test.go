@@ -24,6 +24,8 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/specterops/bloodhound/src/database/types/null"
+	"github.com/specterops/bloodhound/src/model"
 	"github.com/specterops/bloodhound/src/model/ingest"
 	"github.com/stretchr/testify/assert"
 )
@@ -132,3 +134,11 @@ type ErrorReader struct {
 func (er *ErrorReader) Read(p []byte) (n int, err error) {
 	return 0, er.err
 }
+
+func TestIsRollbackable(t *testing.T) {
+	assert.True(t, IsRollbackable(model.IngestJob{Status: model.JobStatusComplete}))
+	assert.True(t, IsRollbackable(model.IngestJob{Status: model.JobStatusCanceled}))
+	assert.False(t, IsRollbackable(model.IngestJob{Status: model.JobStatusRunning}))
+	assert.False(t, IsRollbackable(model.IngestJob{Status: model.JobStatusIngesting}))
+	assert.False(t, IsRollbackable(model.IngestJob{Status: model.JobStatusComplete, RollbackRequestedBy: null.StringFrom("user")}))
+}
@@ -51,6 +51,35 @@ func (m *MockIngestData) EXPECT() *MockIngestDataMockRecorder {
 	return m.recorder
 }
 
+// AddIngestJobFiles mocks base method.
+func (m *MockIngestData) AddIngestJobFiles(arg0 context.Context, arg1 int64, arg2, arg3 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddIngestJobFiles", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddIngestJobFiles indicates an expected call of AddIngestJobFiles.
+func (mr *MockIngestDataMockRecorder) AddIngestJobFiles(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddIngestJobFiles", reflect.TypeOf((*MockIngestData)(nil).AddIngestJobFiles), arg0, arg1, arg2, arg3)
+}
+
+// AppendIngestUploadChunk mocks base method.
+func (m *MockIngestData) AppendIngestUploadChunk(arg0 context.Context, arg1 model.IngestUploadChunk) (model.IngestUpload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendIngestUploadChunk", arg0, arg1)
+	ret0, _ := ret[0].(model.IngestUpload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppendIngestUploadChunk indicates an expected call of AppendIngestUploadChunk.
+func (mr *MockIngestDataMockRecorder) AppendIngestUploadChunk(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendIngestUploadChunk", reflect.TypeOf((*MockIngestData)(nil).AppendIngestUploadChunk), arg0, arg1)
+}
+
 // CancelAllIngestJobs mocks base method.
 func (m *MockIngestData) CancelAllIngestJobs(arg0 context.Context) error {
 	m.ctrl.T.Helper()
@@ -65,6 +94,50 @@ func (mr *MockIngestDataMockRecorder) CancelAllIngestJobs(arg0 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelAllIngestJobs", reflect.TypeOf((*MockIngestData)(nil).CancelAllIngestJobs), arg0)
 }
 
+// CancelIngestJob mocks base method.
+func (m *MockIngestData) CancelIngestJob(arg0 context.Context, arg1 int64, arg2 []model.JobStatus, arg3, arg4 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelIngestJob", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelIngestJob indicates an expected call of CancelIngestJob.
+func (mr *MockIngestDataMockRecorder) CancelIngestJob(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelIngestJob", reflect.TypeOf((*MockIngestData)(nil).CancelIngestJob), arg0, arg1, arg2, arg3, arg4)
+}
+
+// ClearIngestJobRollbackRequest mocks base method.
+func (m *MockIngestData) ClearIngestJobRollbackRequest(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearIngestJobRollbackRequest", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearIngestJobRollbackRequest indicates an expected call of ClearIngestJobRollbackRequest.
+func (mr *MockIngestDataMockRecorder) ClearIngestJobRollbackRequest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearIngestJobRollbackRequest", reflect.TypeOf((*MockIngestData)(nil).ClearIngestJobRollbackRequest), arg0, arg1)
+}
+
+// CompleteIngestUpload mocks base method.
+func (m *MockIngestData) CompleteIngestUpload(arg0 context.Context, arg1 model.IngestUpload, arg2 model.IngestTask) (model.IngestTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteIngestUpload", arg0, arg1, arg2)
+	ret0, _ := ret[0].(model.IngestTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteIngestUpload indicates an expected call of CompleteIngestUpload.
+func (mr *MockIngestDataMockRecorder) CompleteIngestUpload(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteIngestUpload", reflect.TypeOf((*MockIngestData)(nil).CompleteIngestUpload), arg0, arg1, arg2)
+}
+
 // CreateCompositionInfo mocks base method.
 func (m *MockIngestData) CreateCompositionInfo(arg0 context.Context, arg1 model.EdgeCompositionNodes, arg2 model.EdgeCompositionEdges) (model.EdgeCompositionNodes, model.EdgeCompositionEdges, error) {
 	m.ctrl.T.Helper()
@@ -111,6 +184,21 @@ func (mr *MockIngestDataMockRecorder) CreateIngestTask(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIngestTask", reflect.TypeOf((*MockIngestData)(nil).CreateIngestTask), arg0, arg1)
 }
 
+// CreateIngestUpload mocks base method.
+func (m *MockIngestData) CreateIngestUpload(arg0 context.Context, arg1 model.IngestUpload) (model.IngestUpload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIngestUpload", arg0, arg1)
+	ret0, _ := ret[0].(model.IngestUpload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIngestUpload indicates an expected call of CreateIngestUpload.
+func (mr *MockIngestDataMockRecorder) CreateIngestUpload(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIngestUpload", reflect.TypeOf((*MockIngestData)(nil).CreateIngestUpload), arg0, arg1)
+}
+
 // DeleteAllIngestJobs mocks base method.
 func (m *MockIngestData) DeleteAllIngestJobs(arg0 context.Context) error {
 	m.ctrl.T.Helper()
@@ -139,6 +227,35 @@ func (mr *MockIngestDataMockRecorder) DeleteAllIngestTasks(arg0 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllIngestTasks", reflect.TypeOf((*MockIngestData)(nil).DeleteAllIngestTasks), arg0)
 }
 
+// DeleteIngestUpload mocks base method.
+func (m *MockIngestData) DeleteIngestUpload(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIngestUpload", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIngestUpload indicates an expected call of DeleteIngestUpload.
+func (mr *MockIngestDataMockRecorder) DeleteIngestUpload(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIngestUpload", reflect.TypeOf((*MockIngestData)(nil).DeleteIngestUpload), arg0, arg1)
+}
+
+// EndIngestJob mocks base method.
+func (m *MockIngestData) EndIngestJob(arg0 context.Context, arg1 int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndIngestJob", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EndIngestJob indicates an expected call of EndIngestJob.
+func (mr *MockIngestDataMockRecorder) EndIngestJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndIngestJob", reflect.TypeOf((*MockIngestData)(nil).EndIngestJob), arg0, arg1)
+}
+
 // GetAllIngestJobs mocks base method.
 func (m *MockIngestData) GetAllIngestJobs(arg0 context.Context, arg1, arg2 int, arg3 string, arg4 model.SQLFilter) ([]model.IngestJob, int, error) {
 	m.ctrl.T.Helper()
@@ -170,6 +287,21 @@ func (mr *MockIngestDataMockRecorder) GetIngestJob(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIngestJob", reflect.TypeOf((*MockIngestData)(nil).GetIngestJob), arg0, arg1)
 }
 
+// GetIngestJobsPendingRollback mocks base method.
+func (m *MockIngestData) GetIngestJobsPendingRollback(arg0 context.Context) ([]model.IngestJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIngestJobsPendingRollback", arg0)
+	ret0, _ := ret[0].([]model.IngestJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIngestJobsPendingRollback indicates an expected call of GetIngestJobsPendingRollback.
+func (mr *MockIngestDataMockRecorder) GetIngestJobsPendingRollback(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIngestJobsPendingRollback", reflect.TypeOf((*MockIngestData)(nil).GetIngestJobsPendingRollback), arg0)
+}
+
 // GetIngestJobsWithStatus mocks base method.
 func (m *MockIngestData) GetIngestJobsWithStatus(arg0 context.Context, arg1 model.JobStatus) ([]model.IngestJob, error) {
 	m.ctrl.T.Helper()
@@ -185,6 +317,79 @@ func (mr *MockIngestDataMockRecorder) GetIngestJobsWithStatus(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIngestJobsWithStatus", reflect.TypeOf((*MockIngestData)(nil).GetIngestJobsWithStatus), arg0, arg1)
 }
 
+// GetIngestUpload mocks base method.
+func (m *MockIngestData) GetIngestUpload(arg0 context.Context, arg1 int64) (model.IngestUpload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIngestUpload", arg0, arg1)
+	ret0, _ := ret[0].(model.IngestUpload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIngestUpload indicates an expected call of GetIngestUpload.
+func (mr *MockIngestDataMockRecorder) GetIngestUpload(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIngestUpload", reflect.TypeOf((*MockIngestData)(nil).GetIngestUpload), arg0, arg1)
+}
+
+// GetIngestUploadChunks mocks base method.
+func (m *MockIngestData) GetIngestUploadChunks(arg0 context.Context, arg1 int64) (model.IngestUploadChunks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIngestUploadChunks", arg0, arg1)
+	ret0, _ := ret[0].(model.IngestUploadChunks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIngestUploadChunks indicates an expected call of GetIngestUploadChunks.
+func (mr *MockIngestDataMockRecorder) GetIngestUploadChunks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIngestUploadChunks", reflect.TypeOf((*MockIngestData)(nil).GetIngestUploadChunks), arg0, arg1)
+}
+
+// RequestIngestJobRollback mocks base method.
+func (m *MockIngestData) RequestIngestJobRollback(arg0 context.Context, arg1 int64, arg2 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestIngestJobRollback", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestIngestJobRollback indicates an expected call of RequestIngestJobRollback.
+func (mr *MockIngestDataMockRecorder) RequestIngestJobRollback(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestIngestJobRollback", reflect.TypeOf((*MockIngestData)(nil).RequestIngestJobRollback), arg0, arg1, arg2)
+}
+
+// SetIngestJobRolledBack mocks base method.
+func (m *MockIngestData) SetIngestJobRolledBack(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetIngestJobRolledBack", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetIngestJobRolledBack indicates an expected call of SetIngestJobRolledBack.
+func (mr *MockIngestDataMockRecorder) SetIngestJobRolledBack(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIngestJobRolledBack", reflect.TypeOf((*MockIngestData)(nil).SetIngestJobRolledBack), arg0, arg1)
+}
+
+// TouchIngestJobLastIngest mocks base method.
+func (m *MockIngestData) TouchIngestJobLastIngest(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchIngestJobLastIngest", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchIngestJobLastIngest indicates an expected call of TouchIngestJobLastIngest.
+func (mr *MockIngestDataMockRecorder) TouchIngestJobLastIngest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchIngestJobLastIngest", reflect.TypeOf((*MockIngestData)(nil).TouchIngestJobLastIngest), arg0, arg1)
+}
+
 // UpdateIngestJob mocks base method.
 func (m *MockIngestData) UpdateIngestJob(arg0 context.Context, arg1 model.IngestJob) error {
 	m.ctrl.T.Helper()
@@ -198,3 +403,18 @@ func (mr *MockIngestDataMockRecorder) UpdateIngestJob(arg0, arg1 interface{}) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIngestJob", reflect.TypeOf((*MockIngestData)(nil).UpdateIngestJob), arg0, arg1)
 }
+
+// UpdateIngestJobStatus mocks base method.
+func (m *MockIngestData) UpdateIngestJobStatus(arg0 context.Context, arg1 int64, arg2 model.JobStatus, arg3 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIngestJobStatus", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateIngestJobStatus indicates an expected call of UpdateIngestJobStatus.
+func (mr *MockIngestDataMockRecorder) UpdateIngestJobStatus(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIngestJobStatus", reflect.TypeOf((*MockIngestData)(nil).UpdateIngestJobStatus), arg0, arg1, arg2, arg3)
+}
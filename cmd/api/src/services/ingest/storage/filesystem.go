@@ -0,0 +1,129 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// FilesystemStore holds files in a local directory, which may be a volume shared by the replicas of a deployment. The
+// name of a file is its path. Names of files outside of the directory are rejected so that a name can never open or
+// remove anything else on disk.
+type FilesystemStore struct {
+	root string
+}
+
+func NewFilesystemStore(root string) *FilesystemStore {
+	return &FilesystemStore{
+		root: root,
+	}
+}
+
+func (s *FilesystemStore) Location() string {
+	return s.root
+}
+
+func (s *FilesystemStore) Create(ctx context.Context) (Writer, error) {
+	if file, err := os.CreateTemp(s.root, "bh"); err != nil {
+		return nil, fmt.Errorf("error creating ingest file: %w", err)
+	} else {
+		return filesystemWriter{File: file}, nil
+	}
+}
+
+// path returns the path of the file with the given name. Names are rejected unless they are a file name, or a path to
+// a file directly within the directory of the store, without any parent directory references.
+func (s *FilesystemStore) path(operation string, name string) (string, error) {
+	dir, base := filepath.Split(name)
+
+	if base == "" || base == "." || slices.Contains(strings.Split(filepath.ToSlash(name), "/"), "..") {
+		return "", &fs.PathError{Op: operation, Path: name, Err: fs.ErrInvalid}
+	} else if dir != "" && filepath.Clean(dir) != filepath.Clean(s.root) {
+		return "", &fs.PathError{Op: operation, Path: name, Err: fs.ErrInvalid}
+	} else {
+		return filepath.Join(s.root, base), nil
+	}
+}
+
+func (s *FilesystemStore) Open(ctx context.Context, name string) (File, error) {
+	if path, err := s.path("open", name); err != nil {
+		return nil, err
+	} else if file, err := os.Open(path); err != nil {
+		return nil, err
+	} else if info, err := file.Stat(); err != nil {
+		_ = file.Close()
+		return nil, err
+	} else {
+		return filesystemFile{File: file, size: info.Size()}, nil
+	}
+}
+
+func (s *FilesystemStore) Remove(ctx context.Context, name string) error {
+	if path, err := s.path("remove", name); err != nil {
+		return err
+	} else if _, err := os.Lstat(path); err != nil {
+		return err
+	} else {
+		return os.RemoveAll(path)
+	}
+}
+
+func (s *FilesystemStore) List(ctx context.Context) ([]Object, error) {
+	if entries, err := os.ReadDir(s.root); err != nil {
+		return nil, err
+	} else {
+		objects := make([]Object, 0, len(entries))
+
+		for _, entry := range entries {
+			// Entries removed since the directory was read are skipped
+			if info, err := entry.Info(); err == nil {
+				objects = append(objects, Object{
+					Name:    filepath.Join(s.root, entry.Name()),
+					ModTime: info.ModTime(),
+				})
+			}
+		}
+
+		return objects, nil
+	}
+}
+
+type filesystemFile struct {
+	*os.File
+
+	size int64
+}
+
+func (s filesystemFile) Size() int64 {
+	return s.size
+}
+
+type filesystemWriter struct {
+	*os.File
+}
+
+func (s filesystemWriter) Abort() error {
+	// Closing an already closed file only returns an error that there is nothing to do with
+	_ = s.File.Close()
+	return os.Remove(s.File.Name())
+}
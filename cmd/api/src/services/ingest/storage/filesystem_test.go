@@ -0,0 +1,122 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage_test
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/specterops/bloodhound/src/config"
+	"github.com/specterops/bloodhound/src/services/ingest/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilesystemStore(t *testing.T) {
+	var (
+		root  = t.TempDir()
+		store = storage.NewFilesystemStore(root)
+	)
+
+	name := writeFile(t, store, []byte(`{"meta": {}}`))
+	require.Equal(t, root, filepath.Dir(name))
+
+	file, err := store.Open(context.Background(), name)
+	require.Nil(t, err)
+	require.Equal(t, int64(12), file.Size())
+
+	content, err := io.ReadAll(file)
+	require.Nil(t, err)
+	require.Equal(t, `{"meta": {}}`, string(content))
+	require.Nil(t, file.Close())
+
+	aborted, err := store.Create(context.Background())
+	require.Nil(t, err)
+	require.Nil(t, aborted.Abort())
+
+	_, err = os.Stat(aborted.Name())
+	require.ErrorIs(t, err, fs.ErrNotExist)
+
+	objects, err := store.List(context.Background())
+	require.Nil(t, err)
+	require.Len(t, objects, 1)
+	require.Equal(t, name, objects[0].Name)
+
+	require.Nil(t, store.Remove(context.Background(), name))
+	require.ErrorIs(t, store.Remove(context.Background(), name), fs.ErrNotExist)
+
+	_, err = store.Open(context.Background(), name)
+	require.ErrorIs(t, err, fs.ErrNotExist)
+}
+
+func TestFilesystemStore_InvalidNames(t *testing.T) {
+	var (
+		parent  = t.TempDir()
+		root    = filepath.Join(parent, "work")
+		outside = filepath.Join(parent, "outside")
+		store   = storage.NewFilesystemStore(root)
+	)
+
+	require.Nil(t, os.Mkdir(root, 0755))
+	require.Nil(t, os.WriteFile(outside, []byte("keep"), 0644))
+
+	for _, name := range []string{
+		outside,
+		"../outside",
+		filepath.Join(root, "..", "outside"),
+		filepath.Join(root, "nested", "file"),
+		root + string(filepath.Separator),
+		"..",
+	} {
+		require.ErrorIs(t, store.Remove(context.Background(), name), fs.ErrInvalid, name)
+
+		_, err := store.Open(context.Background(), name)
+		require.ErrorIs(t, err, fs.ErrInvalid, name)
+	}
+
+	_, err := os.Stat(outside)
+	require.Nil(t, err)
+
+	// Bare file names refer to files within the directory of the store
+	name := writeFile(t, store, []byte(`{"meta": {}}`))
+	require.Nil(t, store.Remove(context.Background(), filepath.Base(name)))
+}
+
+func TestNew(t *testing.T) {
+	cfg := config.Configuration{WorkDir: t.TempDir()}
+
+	store, err := storage.New(cfg)
+	require.Nil(t, err)
+	require.Equal(t, cfg.TempDirectory(), store.Location())
+
+	cfg.Ingest.Storage = config.IngestStorageConfiguration{
+		Backend: storage.BackendS3,
+		S3:      config.S3StorageConfiguration{Endpoint: "http://minio:9000", Bucket: "bucket", Prefix: "ingest"},
+	}
+
+	store, err = storage.New(cfg)
+	require.Nil(t, err)
+	require.Equal(t, "s3://bucket/ingest/", store.Location())
+
+	cfg.Ingest.Storage.Backend = "tape"
+
+	_, err = storage.New(cfg)
+	require.ErrorContains(t, err, "unknown ingest storage backend tape")
+}
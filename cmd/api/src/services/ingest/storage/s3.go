@@ -0,0 +1,353 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/specterops/bloodhound/src/config"
+)
+
+const (
+	// minPartSize is the smallest part, other than the last, that S3 accepts in a multipart upload
+	minPartSize = 5 * 1024 * 1024
+
+	// maxRangeStreams is the number of ranged reads kept open per file. Archive entries are extracted concurrently and
+	// each is read sequentially, so keeping a stream per reader avoids a request for every read.
+	maxRangeStreams = 8
+
+	// s3DialTimeout and s3ResponseHeaderTimeout bound how long a request waits to connect to the store and for the
+	// store to respond. The client sets no overall timeout as response bodies of ranged reads are streamed for as long
+	// as archive entries take to extract.
+	s3DialTimeout           = 10 * time.Second
+	s3ResponseHeaderTimeout = 60 * time.Second
+)
+
+// S3Store holds files as objects of an S3-compatible store. Uploads are streamed in parts and files are read with
+// ranged requests, so files are never staged on local disk. The name of a file is its object key.
+type S3Store struct {
+	client   *s3Client
+	prefix   string
+	partSize int
+}
+
+func NewS3Store(cfg config.S3StorageConfiguration) (*S3Store, error) {
+	if cfg.Endpoint == "" || cfg.Bucket == "" {
+		return nil, errors.New("s3 ingest storage requires an endpoint and a bucket")
+	} else if endpoint, err := url.Parse(cfg.Endpoint); err != nil {
+		return nil, fmt.Errorf("invalid s3 endpoint %s: %w", cfg.Endpoint, err)
+	} else if endpoint.Scheme != "http" && endpoint.Scheme != "https" {
+		return nil, fmt.Errorf("invalid s3 endpoint %s: scheme must be http or https", cfg.Endpoint)
+	} else {
+		prefix := strings.Trim(cfg.Prefix, "/")
+
+		if prefix != "" {
+			prefix += "/"
+		}
+
+		return &S3Store{
+			client: &s3Client{
+				httpClient:      newS3HTTPClient(),
+				endpoint:        endpoint,
+				region:          cfg.Region,
+				bucket:          cfg.Bucket,
+				accessKeyID:     cfg.AccessKeyID,
+				secretAccessKey: cfg.SecretAccessKey,
+				now:             time.Now,
+			},
+			prefix:   prefix,
+			partSize: max(cfg.PartSizeMB*1024*1024, minPartSize),
+		}, nil
+	}
+}
+
+func newS3HTTPClient() *http.Client {
+	return &http.Client{
+		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+			DialContext: (&net.Dialer{
+				Timeout:   s3DialTimeout,
+				KeepAlive: 30 * time.Second,
+			}).DialContext,
+			ForceAttemptHTTP2:     true,
+			MaxIdleConns:          100,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ResponseHeaderTimeout: s3ResponseHeaderTimeout,
+			ExpectContinueTimeout: 1 * time.Second,
+		},
+	}
+}
+
+func (s *S3Store) Location() string {
+	return fmt.Sprintf("s3://%s/%s", s.client.bucket, s.prefix)
+}
+
+func (s *S3Store) Create(ctx context.Context) (Writer, error) {
+	suffix := make([]byte, 16)
+
+	if _, err := rand.Read(suffix); err != nil {
+		return nil, fmt.Errorf("error creating ingest file name: %w", err)
+	}
+
+	return &s3Writer{
+		ctx:      ctx,
+		client:   s.client,
+		key:      s.prefix + "bh" + hex.EncodeToString(suffix),
+		partSize: s.partSize,
+	}, nil
+}
+
+func (s *S3Store) Open(ctx context.Context, name string) (File, error) {
+	if size, err := s.client.headObject(ctx, name); err != nil {
+		return nil, fmt.Errorf("error opening %s: %w", name, err)
+	} else {
+		return &s3File{
+			ctx:    ctx,
+			client: s.client,
+			key:    name,
+			size:   size,
+		}, nil
+	}
+}
+
+func (s *S3Store) Remove(ctx context.Context, name string) error {
+	// Deleting a missing object succeeds, so existence is checked first to report files that do not exist
+	if _, err := s.client.headObject(ctx, name); err != nil {
+		return fmt.Errorf("error removing %s: %w", name, err)
+	}
+
+	return s.client.deleteObject(ctx, name)
+}
+
+func (s *S3Store) List(ctx context.Context) ([]Object, error) {
+	return s.client.listObjects(ctx, s.prefix)
+}
+
+// s3Writer buffers a part at a time. Files smaller than a part are stored with a single request while larger files
+// are streamed as a multipart upload.
+type s3Writer struct {
+	ctx      context.Context
+	client   *s3Client
+	key      string
+	partSize int
+	buffer   bytes.Buffer
+	uploadID string
+	parts    []completedPart
+}
+
+func (s *s3Writer) Name() string {
+	return s.key
+}
+
+func (s *s3Writer) Write(p []byte) (int, error) {
+	written := 0
+
+	for len(p) > 0 {
+		chunk := min(len(p), s.partSize-s.buffer.Len())
+		s.buffer.Write(p[:chunk])
+
+		written += chunk
+		p = p[chunk:]
+
+		if s.buffer.Len() == s.partSize {
+			if err := s.flushPart(); err != nil {
+				return written, err
+			}
+		}
+	}
+
+	return written, nil
+}
+
+func (s *s3Writer) flushPart() error {
+	if s.uploadID == "" {
+		if uploadID, err := s.client.createMultipartUpload(s.ctx, s.key); err != nil {
+			return fmt.Errorf("error starting upload of %s: %w", s.key, err)
+		} else {
+			s.uploadID = uploadID
+		}
+	}
+
+	if part, err := s.client.uploadPart(s.ctx, s.key, s.uploadID, len(s.parts)+1, s.buffer.Bytes()); err != nil {
+		return fmt.Errorf("error uploading part %d of %s: %w", len(s.parts)+1, s.key, err)
+	} else {
+		s.parts = append(s.parts, part)
+		s.buffer.Reset()
+
+		return nil
+	}
+}
+
+func (s *s3Writer) Close() error {
+	if s.uploadID == "" {
+		return s.client.putObject(s.ctx, s.key, s.buffer.Bytes())
+	}
+
+	if s.buffer.Len() > 0 {
+		if err := s.flushPart(); err != nil {
+			return err
+		}
+	}
+
+	return s.client.completeMultipartUpload(s.ctx, s.key, s.uploadID, s.parts)
+}
+
+func (s *s3Writer) Abort() error {
+	s.buffer.Reset()
+
+	if s.uploadID != "" {
+		return s.client.abortMultipartUpload(s.ctx, s.key, s.uploadID)
+	}
+
+	return nil
+}
+
+// rangeStream is an open ranged read positioned at offset
+type rangeStream struct {
+	body   io.ReadCloser
+	offset int64
+}
+
+// s3File reads an object with ranged requests. Reads that continue where a previous read stopped reuse its stream.
+type s3File struct {
+	ctx     context.Context
+	client  *s3Client
+	key     string
+	size    int64
+	offset  int64
+	lock    sync.Mutex
+	streams []*rangeStream
+}
+
+func (s *s3File) Size() int64 {
+	return s.size
+}
+
+// takeStream removes and returns the open stream positioned at the given offset, opening one if there is none
+func (s *s3File) takeStream(offset int64) (*rangeStream, error) {
+	s.lock.Lock()
+
+	for idx, stream := range s.streams {
+		if stream.offset == offset {
+			s.streams = append(s.streams[:idx], s.streams[idx+1:]...)
+			s.lock.Unlock()
+
+			return stream, nil
+		}
+	}
+
+	s.lock.Unlock()
+
+	if body, err := s.client.getObject(s.ctx, s.key, offset); err != nil {
+		return nil, fmt.Errorf("error reading %s: %w", s.key, err)
+	} else {
+		return &rangeStream{body: body, offset: offset}, nil
+	}
+}
+
+// returnStream keeps the stream for the next read, closing the least recently used stream if too many are open
+func (s *s3File) returnStream(stream *rangeStream) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.streams = append(s.streams, stream)
+
+	if len(s.streams) > maxRangeStreams {
+		s.streams[0].body.Close()
+		s.streams = s.streams[1:]
+	}
+}
+
+func (s *s3File) ReadAt(p []byte, offset int64) (int, error) {
+	if offset >= s.size {
+		return 0, io.EOF
+	}
+
+	expected := int(min(int64(len(p)), s.size-offset))
+
+	if stream, err := s.takeStream(offset); err != nil {
+		return 0, err
+	} else if read, err := io.ReadFull(stream.body, p[:expected]); err != nil {
+		stream.body.Close()
+		return read, fmt.Errorf("error reading %s: %w", s.key, err)
+	} else {
+		stream.offset += int64(read)
+
+		if stream.offset < s.size {
+			s.returnStream(stream)
+		} else {
+			stream.body.Close()
+		}
+
+		if read < len(p) {
+			return read, io.EOF
+		}
+
+		return read, nil
+	}
+}
+
+func (s *s3File) Read(p []byte) (int, error) {
+	read, err := s.ReadAt(p, s.offset)
+	s.offset += int64(read)
+
+	return read, err
+}
+
+func (s *s3File) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += s.offset
+	case io.SeekEnd:
+		offset += s.size
+	default:
+		return 0, errors.New("invalid whence")
+	}
+
+	if offset < 0 {
+		return 0, errors.New("negative position")
+	}
+
+	s.offset = offset
+	return offset, nil
+}
+
+func (s *s3File) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, stream := range s.streams {
+		stream.body.Close()
+	}
+
+	s.streams = nil
+	return nil
+}
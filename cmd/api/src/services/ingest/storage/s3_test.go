@@ -0,0 +1,428 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/specterops/bloodhound/src/config"
+	"github.com/specterops/bloodhound/src/services/ingest/storage"
+	"github.com/stretchr/testify/require"
+)
+
+const testBucket = "ingest-bucket"
+
+// fakeS3 is an in-memory stand-in for an S3-compatible store such as MinIO. It implements the object, multipart
+// upload and listing operations used by the S3 store.
+type fakeS3 struct {
+	lock        sync.Mutex
+	objects     map[string][]byte
+	modified    map[string]time.Time
+	uploads     map[string]map[int][]byte
+	nextUpload  int
+	maxKeys     int
+	objectReads int
+}
+
+func newFakeS3(t *testing.T) (*fakeS3, config.S3StorageConfiguration) {
+	var (
+		fake = &fakeS3{
+			objects:  map[string][]byte{},
+			modified: map[string]time.Time{},
+			uploads:  map[string]map[int][]byte{},
+			maxKeys:  2,
+		}
+		server = httptest.NewServer(fake)
+	)
+
+	t.Cleanup(server.Close)
+
+	return fake, config.S3StorageConfiguration{
+		Endpoint:        server.URL,
+		Region:          "us-east-1",
+		Bucket:          testBucket,
+		Prefix:          "/ingest/",
+		AccessKeyID:     "access",
+		SecretAccessKey: "secret",
+		PartSizeMB:      5,
+	}
+}
+
+func (s *fakeS3) writeError(response http.ResponseWriter, status int, code string) {
+	response.WriteHeader(status)
+	fmt.Fprintf(response, "<Error><Code>%s</Code><Message>%s</Message></Error>", code, code)
+}
+
+func (s *fakeS3) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var (
+		query   = request.URL.Query()
+		path    = strings.TrimPrefix(request.URL.Path, "/"+testBucket)
+		key     = strings.TrimPrefix(path, "/")
+		body, _ = io.ReadAll(request.Body)
+	)
+
+	if !strings.HasPrefix(request.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=access/") {
+		s.writeError(response, http.StatusForbidden, "AccessDenied")
+		return
+	}
+
+	switch {
+	case request.Method == http.MethodGet && key == "":
+		s.list(response, query.Get("prefix"), query.Get("continuation-token"))
+
+	case request.Method == http.MethodHead:
+		if content, found := s.objects[key]; !found {
+			response.WriteHeader(http.StatusNotFound)
+		} else {
+			response.Header().Set("Content-Length", strconv.Itoa(len(content)))
+		}
+
+	case request.Method == http.MethodGet:
+		var offset int
+
+		if content, found := s.objects[key]; !found {
+			s.writeError(response, http.StatusNotFound, "NoSuchKey")
+		} else if _, err := fmt.Sscanf(request.Header.Get("Range"), "bytes=%d-", &offset); err == nil {
+			s.objectReads++
+			response.WriteHeader(http.StatusPartialContent)
+			response.Write(content[offset:])
+		} else {
+			s.objectReads++
+			response.Write(content)
+		}
+
+	case request.Method == http.MethodPut && query.Has("uploadId"):
+		partNumber, _ := strconv.Atoi(query.Get("partNumber"))
+		s.uploads[query.Get("uploadId")][partNumber] = body
+		response.Header().Set("ETag", fmt.Sprintf(`"%d"`, partNumber))
+
+	case request.Method == http.MethodPut:
+		s.objects[key] = body
+		s.modified[key] = time.Now().UTC()
+
+	case request.Method == http.MethodPost && query.Has("uploads"):
+		s.nextUpload++
+		uploadID := strconv.Itoa(s.nextUpload)
+		s.uploads[uploadID] = map[int][]byte{}
+		fmt.Fprintf(response, "<InitiateMultipartUploadResult><UploadId>%s</UploadId></InitiateMultipartUploadResult>", uploadID)
+
+	case request.Method == http.MethodPost && query.Has("uploadId"):
+		var (
+			completion struct {
+				Parts []struct {
+					PartNumber int
+				} `xml:"Part"`
+			}
+			content []byte
+			parts   = s.uploads[query.Get("uploadId")]
+		)
+
+		if err := xml.Unmarshal(body, &completion); err != nil {
+			s.writeError(response, http.StatusBadRequest, "MalformedXML")
+			return
+		}
+
+		for idx, part := range completion.Parts {
+			if idx < len(completion.Parts)-1 && len(parts[part.PartNumber]) < 5*1024*1024 {
+				// S3 reports this failure with a successful status
+				s.writeError(response, http.StatusOK, "EntityTooSmall")
+				return
+			}
+
+			content = append(content, parts[part.PartNumber]...)
+		}
+
+		delete(s.uploads, query.Get("uploadId"))
+		s.objects[key] = content
+		s.modified[key] = time.Now().UTC()
+
+	case request.Method == http.MethodDelete && query.Has("uploadId"):
+		delete(s.uploads, query.Get("uploadId"))
+		response.WriteHeader(http.StatusNoContent)
+
+	case request.Method == http.MethodDelete:
+		delete(s.objects, key)
+		response.WriteHeader(http.StatusNoContent)
+
+	default:
+		s.writeError(response, http.StatusMethodNotAllowed, "MethodNotAllowed")
+	}
+}
+
+func (s *fakeS3) list(response http.ResponseWriter, prefix, continuationToken string) {
+	var (
+		keys  []string
+		start = 0
+	)
+
+	for key := range s.objects {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+
+	if continuationToken != "" {
+		start, _ = strconv.Atoi(continuationToken)
+	}
+
+	end := min(start+s.maxKeys, len(keys))
+
+	fmt.Fprint(response, "<ListBucketResult>")
+	fmt.Fprintf(response, "<IsTruncated>%t</IsTruncated>", end < len(keys))
+
+	if end < len(keys) {
+		fmt.Fprintf(response, "<NextContinuationToken>%d</NextContinuationToken>", end)
+	}
+
+	for _, key := range keys[start:end] {
+		fmt.Fprintf(response, "<Contents><Key>%s</Key><LastModified>%s</LastModified></Contents>", key, s.modified[key].Format(time.RFC3339))
+	}
+
+	fmt.Fprint(response, "</ListBucketResult>")
+}
+
+func (s *fakeS3) object(key string) ([]byte, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	content, found := s.objects[key]
+	return content, found
+}
+
+func (s *fakeS3) reads() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.objectReads
+}
+
+func (s *fakeS3) pendingUploads() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return len(s.uploads)
+}
+
+func writeFile(t *testing.T, store storage.Store, content []byte) string {
+	writer, err := store.Create(context.Background())
+	require.Nil(t, err)
+
+	_, err = writer.Write(content)
+	require.Nil(t, err)
+	require.Nil(t, writer.Close())
+
+	return writer.Name()
+}
+
+func TestS3Store(t *testing.T) {
+	t.Run("Small File", func(t *testing.T) {
+		var (
+			fake, cfg  = newFakeS3(t)
+			store, err = storage.NewS3Store(cfg)
+		)
+
+		require.Nil(t, err)
+
+		name := writeFile(t, store, []byte(`{"meta": {}}`))
+		require.True(t, strings.HasPrefix(name, "ingest/bh"))
+
+		content, found := fake.object(name)
+		require.True(t, found)
+		require.Equal(t, `{"meta": {}}`, string(content))
+
+		file, err := store.Open(context.Background(), name)
+		require.Nil(t, err)
+		require.Equal(t, int64(12), file.Size())
+
+		read, err := io.ReadAll(file)
+		require.Nil(t, err)
+		require.Equal(t, `{"meta": {}}`, string(read))
+
+		// Decoders seek back to the start for a second pass
+		_, err = file.Seek(0, io.SeekStart)
+		require.Nil(t, err)
+
+		read, err = io.ReadAll(file)
+		require.Nil(t, err)
+		require.Equal(t, `{"meta": {}}`, string(read))
+		require.Nil(t, file.Close())
+	})
+
+	t.Run("Multipart Upload", func(t *testing.T) {
+		var (
+			fake, cfg  = newFakeS3(t)
+			store, err = storage.NewS3Store(cfg)
+			content    = bytes.Repeat([]byte("0123456789"), 1200*1024)
+		)
+
+		require.Nil(t, err)
+
+		writer, err := store.Create(context.Background())
+		require.Nil(t, err)
+
+		// Write in uneven chunks so that parts are filled across writes
+		for remaining := content; len(remaining) > 0; {
+			chunk := min(len(remaining), 777_777)
+
+			_, err := writer.Write(remaining[:chunk])
+			require.Nil(t, err)
+
+			remaining = remaining[chunk:]
+		}
+
+		require.Nil(t, writer.Close())
+
+		stored, found := fake.object(writer.Name())
+		require.True(t, found)
+		require.Equal(t, content, stored)
+		require.Zero(t, fake.pendingUploads())
+
+		file, err := store.Open(context.Background(), writer.Name())
+		require.Nil(t, err)
+
+		read, err := io.ReadAll(file)
+		require.Nil(t, err)
+		require.Equal(t, content, read)
+
+		// Sequential reads continue a single ranged stream
+		require.Equal(t, 1, fake.reads())
+		require.Nil(t, file.Close())
+	})
+
+	t.Run("Aborted Upload", func(t *testing.T) {
+		var (
+			fake, cfg  = newFakeS3(t)
+			store, err = storage.NewS3Store(cfg)
+		)
+
+		require.Nil(t, err)
+
+		writer, err := store.Create(context.Background())
+		require.Nil(t, err)
+
+		_, err = writer.Write(bytes.Repeat([]byte{'a'}, 6*1024*1024))
+		require.Nil(t, err)
+		require.Equal(t, 1, fake.pendingUploads())
+
+		require.Nil(t, writer.Abort())
+		require.Zero(t, fake.pendingUploads())
+
+		_, found := fake.object(writer.Name())
+		require.False(t, found)
+	})
+
+	t.Run("Read Archive In Place", func(t *testing.T) {
+		var (
+			_, cfg     = newFakeS3(t)
+			store, err = storage.NewS3Store(cfg)
+			archive    = &bytes.Buffer{}
+			zipWriter  = zip.NewWriter(archive)
+		)
+
+		require.Nil(t, err)
+
+		for _, name := range []string{"computers.json", "users.json"} {
+			entry, err := zipWriter.Create(name)
+			require.Nil(t, err)
+
+			_, err = entry.Write([]byte(strings.Repeat(name, 1000)))
+			require.Nil(t, err)
+		}
+
+		require.Nil(t, zipWriter.Close())
+
+		file, err := store.Open(context.Background(), writeFile(t, store, archive.Bytes()))
+		require.Nil(t, err)
+
+		defer file.Close()
+
+		zipReader, err := zip.NewReader(file, file.Size())
+		require.Nil(t, err)
+		require.Len(t, zipReader.File, 2)
+
+		for _, entry := range zipReader.File {
+			entryReader, err := entry.Open()
+			require.Nil(t, err)
+
+			content, err := io.ReadAll(entryReader)
+			require.Nil(t, err)
+			require.Equal(t, strings.Repeat(entry.Name, 1000), string(content))
+			require.Nil(t, entryReader.Close())
+		}
+	})
+
+	t.Run("List And Remove", func(t *testing.T) {
+		var (
+			_, cfg     = newFakeS3(t)
+			store, err = storage.NewS3Store(cfg)
+			names      []string
+		)
+
+		require.Nil(t, err)
+
+		// More files than the fake returns per listing page
+		for idx := 0; idx < 5; idx++ {
+			names = append(names, writeFile(t, store, []byte(strconv.Itoa(idx))))
+		}
+
+		objects, err := store.List(context.Background())
+		require.Nil(t, err)
+		require.Len(t, objects, 5)
+
+		for _, object := range objects {
+			require.Contains(t, names, object.Name)
+			require.False(t, object.ModTime.IsZero())
+		}
+
+		require.Nil(t, store.Remove(context.Background(), names[0]))
+		require.ErrorIs(t, store.Remove(context.Background(), names[0]), fs.ErrNotExist)
+
+		_, err = store.Open(context.Background(), names[0])
+		require.ErrorIs(t, err, fs.ErrNotExist)
+
+		objects, err = store.List(context.Background())
+		require.Nil(t, err)
+		require.Len(t, objects, 4)
+	})
+
+	t.Run("Invalid Configuration", func(t *testing.T) {
+		_, err := storage.NewS3Store(config.S3StorageConfiguration{Bucket: testBucket})
+		require.ErrorContains(t, err, "requires an endpoint and a bucket")
+
+		_, err = storage.NewS3Store(config.S3StorageConfiguration{Endpoint: "ftp://minio:9000", Bucket: testBucket})
+		require.ErrorContains(t, err, "scheme must be http or https")
+	})
+}
@@ -0,0 +1,385 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	amzDateFormat    = "20060102T150405Z"
+	signingAlgorithm = "AWS4-HMAC-SHA256"
+)
+
+// emptyPayloadHash is the SHA-256 hash of an empty request body
+var emptyPayloadHash = hashHex(nil)
+
+// s3Error is the error document returned by S3-compatible stores
+type s3Error struct {
+	StatusCode int    `xml:"-"`
+	Code       string `xml:"Code"`
+	Message    string `xml:"Message"`
+}
+
+func (s s3Error) Error() string {
+	return fmt.Sprintf("s3 request failed with status %d: %s %s", s.StatusCode, s.Code, s.Message)
+}
+
+// s3Client makes requests to an S3-compatible store signed with AWS Signature Version 4. Only the operations needed to
+// hold ingest files are implemented.
+type s3Client struct {
+	httpClient      *http.Client
+	endpoint        *url.URL
+	region          string
+	bucket          string
+	accessKeyID     string
+	secretAccessKey string
+	now             func() time.Time
+}
+
+// objectURL returns the path-style URL of the given key, or of the bucket if the key is empty
+func (s *s3Client) objectURL(key string, query url.Values) *url.URL {
+	var (
+		objectURL = *s.endpoint
+		path      = strings.TrimSuffix(objectURL.Path, "/") + "/" + s.bucket
+	)
+
+	if key != "" {
+		path += "/" + key
+	}
+
+	objectURL.Path = path
+	objectURL.RawPath = uriEscape(path, false)
+	objectURL.RawQuery = canonicalQuery(query)
+
+	return &objectURL
+}
+
+func (s *s3Client) do(ctx context.Context, method, key string, query url.Values, header http.Header, body []byte) (*http.Response, error) {
+	var bodyReader io.Reader
+
+	if body != nil {
+		bodyReader = bytes.NewReader(body)
+	}
+
+	if request, err := http.NewRequestWithContext(ctx, method, s.objectURL(key, query).String(), bodyReader); err != nil {
+		return nil, err
+	} else {
+		for name, values := range header {
+			request.Header[name] = values
+		}
+
+		s.sign(request, hashHex(body))
+
+		if response, err := s.httpClient.Do(request); err != nil {
+			return nil, err
+		} else if response.StatusCode >= http.StatusMultipleChoices {
+			defer response.Body.Close()
+			return nil, readS3Error(response)
+		} else {
+			return response, nil
+		}
+	}
+}
+
+// doAndRead makes a request and returns the response body in full. S3 may report a failure of some requests, such as
+// completing a multipart upload, with a successful status and an error document.
+func (s *s3Client) doAndRead(ctx context.Context, method, key string, query url.Values, header http.Header, body []byte) (http.Header, []byte, error) {
+	if response, err := s.do(ctx, method, key, query, header, body); err != nil {
+		return nil, nil, err
+	} else {
+		defer response.Body.Close()
+
+		if content, err := io.ReadAll(response.Body); err != nil {
+			return nil, nil, err
+		} else if bytes.Contains(content, []byte("<Error>")) {
+			errDocument := s3Error{StatusCode: response.StatusCode}
+
+			if err := xml.Unmarshal(content, &errDocument); err != nil {
+				return nil, nil, fmt.Errorf("error decoding s3 error: %w", err)
+			}
+
+			return nil, nil, errDocument
+		} else {
+			return response.Header, content, nil
+		}
+	}
+}
+
+func readS3Error(response *http.Response) error {
+	errDocument := s3Error{StatusCode: response.StatusCode}
+
+	// Responses to HEAD requests have no body, so the error document is optional
+	if content, err := io.ReadAll(io.LimitReader(response.Body, 64*1024)); err == nil && len(content) > 0 {
+		_ = xml.Unmarshal(content, &errDocument)
+	}
+
+	if response.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%w: %w", fs.ErrNotExist, errDocument)
+	}
+
+	return errDocument
+}
+
+func (s *s3Client) headObject(ctx context.Context, key string) (int64, error) {
+	if response, err := s.do(ctx, http.MethodHead, key, nil, nil, nil); err != nil {
+		return 0, err
+	} else {
+		response.Body.Close()
+		return response.ContentLength, nil
+	}
+}
+
+// getObject returns the contents of the given key from the given offset onwards
+func (s *s3Client) getObject(ctx context.Context, key string, offset int64) (io.ReadCloser, error) {
+	header := http.Header{}
+
+	if offset > 0 {
+		header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	if response, err := s.do(ctx, http.MethodGet, key, nil, header, nil); err != nil {
+		return nil, err
+	} else {
+		return response.Body, nil
+	}
+}
+
+func (s *s3Client) putObject(ctx context.Context, key string, body []byte) error {
+	_, _, err := s.doAndRead(ctx, http.MethodPut, key, nil, nil, body)
+	return err
+}
+
+func (s *s3Client) deleteObject(ctx context.Context, key string) error {
+	_, _, err := s.doAndRead(ctx, http.MethodDelete, key, nil, nil, nil)
+	return err
+}
+
+type listBucketResult struct {
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+	Contents              []struct {
+		Key          string    `xml:"Key"`
+		LastModified time.Time `xml:"LastModified"`
+	} `xml:"Contents"`
+}
+
+// listObjects returns every object with the given key prefix
+func (s *s3Client) listObjects(ctx context.Context, prefix string) ([]Object, error) {
+	var (
+		objects []Object
+		query   = url.Values{
+			"list-type": {"2"},
+			"prefix":    {prefix},
+		}
+	)
+
+	for {
+		var result listBucketResult
+
+		if _, content, err := s.doAndRead(ctx, http.MethodGet, "", query, nil, nil); err != nil {
+			return nil, err
+		} else if err := xml.Unmarshal(content, &result); err != nil {
+			return nil, fmt.Errorf("error decoding object listing: %w", err)
+		}
+
+		for _, content := range result.Contents {
+			objects = append(objects, Object{
+				Name:    content.Key,
+				ModTime: content.LastModified,
+			})
+		}
+
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return objects, nil
+		}
+
+		query.Set("continuation-token", result.NextContinuationToken)
+	}
+}
+
+type initiateMultipartUploadResult struct {
+	UploadID string `xml:"UploadId"`
+}
+
+type completedPart struct {
+	PartNumber int    `xml:"PartNumber"`
+	ETag       string `xml:"ETag"`
+}
+
+type completeMultipartUpload struct {
+	XMLName xml.Name        `xml:"CompleteMultipartUpload"`
+	Parts   []completedPart `xml:"Part"`
+}
+
+func (s *s3Client) createMultipartUpload(ctx context.Context, key string) (string, error) {
+	var result initiateMultipartUploadResult
+
+	if _, content, err := s.doAndRead(ctx, http.MethodPost, key, url.Values{"uploads": {""}}, nil, []byte{}); err != nil {
+		return "", err
+	} else if err := xml.Unmarshal(content, &result); err != nil {
+		return "", fmt.Errorf("error decoding multipart upload: %w", err)
+	} else if result.UploadID == "" {
+		return "", errors.New("multipart upload was created without an upload ID")
+	} else {
+		return result.UploadID, nil
+	}
+}
+
+func (s *s3Client) uploadPart(ctx context.Context, key, uploadID string, partNumber int, body []byte) (completedPart, error) {
+	query := url.Values{
+		"partNumber": {strconv.Itoa(partNumber)},
+		"uploadId":   {uploadID},
+	}
+
+	if header, _, err := s.doAndRead(ctx, http.MethodPut, key, query, nil, body); err != nil {
+		return completedPart{}, err
+	} else {
+		return completedPart{PartNumber: partNumber, ETag: header.Get("ETag")}, nil
+	}
+}
+
+func (s *s3Client) completeMultipartUpload(ctx context.Context, key, uploadID string, parts []completedPart) error {
+	if body, err := xml.Marshal(completeMultipartUpload{Parts: parts}); err != nil {
+		return err
+	} else {
+		_, _, err := s.doAndRead(ctx, http.MethodPost, key, url.Values{"uploadId": {uploadID}}, nil, body)
+		return err
+	}
+}
+
+func (s *s3Client) abortMultipartUpload(ctx context.Context, key, uploadID string) error {
+	_, _, err := s.doAndRead(ctx, http.MethodDelete, key, url.Values{"uploadId": {uploadID}}, nil, nil)
+	return err
+}
+
+// sign adds an AWS Signature Version 4 authorization header to the request. The host and every header already set on
+// the request are signed.
+func (s *s3Client) sign(request *http.Request, payloadHash string) {
+	var (
+		now       = s.now().UTC()
+		amzDate   = now.Format(amzDateFormat)
+		shortDate = amzDate[:8]
+		scope     = strings.Join([]string{shortDate, s.region, "s3", "aws4_request"}, "/")
+	)
+
+	request.Header.Set("X-Amz-Date", amzDate)
+	request.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	var (
+		headerNames      = []string{"host"}
+		canonicalHeaders = map[string]string{"host": request.URL.Host}
+	)
+
+	for name, values := range request.Header {
+		lowerName := strings.ToLower(name)
+		headerNames = append(headerNames, lowerName)
+		canonicalHeaders[lowerName] = strings.TrimSpace(strings.Join(values, ","))
+	}
+
+	sort.Strings(headerNames)
+
+	var headerBuilder strings.Builder
+
+	for _, name := range headerNames {
+		headerBuilder.WriteString(name + ":" + canonicalHeaders[name] + "\n")
+	}
+
+	var (
+		signedHeaders    = strings.Join(headerNames, ";")
+		canonicalRequest = strings.Join([]string{
+			request.Method,
+			request.URL.EscapedPath(),
+			request.URL.RawQuery,
+			headerBuilder.String(),
+			signedHeaders,
+			payloadHash,
+		}, "\n")
+		stringToSign = strings.Join([]string{signingAlgorithm, amzDate, scope, hashHex([]byte(canonicalRequest))}, "\n")
+		signingKey   = hmacSHA256(hmacSHA256(hmacSHA256(hmacSHA256([]byte("AWS4"+s.secretAccessKey), shortDate), s.region), "s3"), "aws4_request")
+		signature    = hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+	)
+
+	request.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s", signingAlgorithm, s.accessKeyID, scope, signedHeaders, signature))
+}
+
+func hashHex(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, content string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(content))
+	return mac.Sum(nil)
+}
+
+// canonicalQuery encodes the query sorted by key as required for signing
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+
+	for key := range query {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	var parts []string
+
+	for _, key := range keys {
+		for _, value := range query[key] {
+			parts = append(parts, uriEscape(key, true)+"="+uriEscape(value, true))
+		}
+	}
+
+	return strings.Join(parts, "&")
+}
+
+// uriEscape percent-encodes every byte other than the unreserved characters of RFC 3986, optionally leaving slashes
+// as they are
+func uriEscape(value string, escapeSlash bool) string {
+	var builder strings.Builder
+
+	for _, char := range []byte(value) {
+		switch {
+		case 'A' <= char && char <= 'Z', 'a' <= char && char <= 'z', '0' <= char && char <= '9',
+			char == '-', char == '_', char == '.', char == '~':
+			builder.WriteByte(char)
+		case char == '/' && !escapeSlash:
+			builder.WriteByte(char)
+		default:
+			fmt.Fprintf(&builder, "%%%02X", char)
+		}
+	}
+
+	return builder.String()
+}
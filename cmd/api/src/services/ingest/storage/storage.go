@@ -0,0 +1,84 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate go run go.uber.org/mock/mockgen -copyright_file=../../../../../../LICENSE.header -destination=./mocks/storage.go -package=mocks . Store
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/specterops/bloodhound/src/config"
+)
+
+const (
+	BackendFilesystem = "filesystem"
+	BackendS3         = "s3"
+)
+
+// Object describes a file held by a Store.
+type Object struct {
+	Name    string
+	ModTime time.Time
+}
+
+// File is an open file of a Store. Files support random access so that archives can be read in place and so that
+// decoders can make a second pass over a file.
+type File interface {
+	io.ReadSeekCloser
+	io.ReaderAt
+
+	Size() int64
+}
+
+// Writer writes a new file to a Store. The file is complete once Close returns without error while Abort discards it.
+type Writer interface {
+	io.Writer
+
+	Name() string
+	Close() error
+	Abort() error
+}
+
+// Store holds ingest files from the time they are uploaded until the datapipe has ingested them. Files are addressed by
+// the name the Store assigned them when they were created. Opening or removing a file that does not exist returns an
+// error wrapping fs.ErrNotExist.
+type Store interface {
+	Create(ctx context.Context) (Writer, error)
+	Open(ctx context.Context, name string) (File, error)
+	Remove(ctx context.Context, name string) error
+	List(ctx context.Context) ([]Object, error)
+
+	// Location describes where the Store holds files for logging purposes
+	Location() string
+}
+
+// New returns the Store selected by the ingest storage configuration. Files are held in the temp directory of the work
+// directory unless an S3-compatible backend is configured.
+func New(cfg config.Configuration) (Store, error) {
+	switch cfg.Ingest.Storage.Backend {
+	case "", BackendFilesystem:
+		return NewFilesystemStore(cfg.TempDirectory()), nil
+
+	case BackendS3:
+		return NewS3Store(cfg.Ingest.Storage.S3)
+
+	default:
+		return nil, fmt.Errorf("unknown ingest storage backend %s", cfg.Ingest.Storage.Backend)
+	}
+}
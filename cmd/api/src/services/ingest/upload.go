@@ -0,0 +1,238 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ingest
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"mime"
+	"slices"
+	"strings"
+
+	"github.com/specterops/bloodhound/mediatypes"
+	"github.com/specterops/bloodhound/src/database/types/null"
+	"github.com/specterops/bloodhound/src/model"
+	"github.com/specterops/bloodhound/src/model/ingest"
+	"github.com/specterops/bloodhound/src/services/ingest/storage"
+)
+
+var (
+	// ErrUploadConflict is returned when an upload was changed by another request, either by receiving the chunk at
+	// the same offset first or by completing the upload
+	ErrUploadConflict = errors.New("upload was changed by another request")
+
+	ErrUploadOffsetMismatch   = errors.New("chunk offset does not match the received size of the upload")
+	ErrUploadSizeExceeded     = errors.New("chunk exceeds the total size of the upload")
+	ErrUploadChecksumMismatch = errors.New("chunk checksum does not match")
+	ErrUploadCompleted        = errors.New("upload has already been completed")
+	ErrUploadInvalid          = errors.New("assembled upload is not a valid ingest file")
+)
+
+// FileTypeForContentType returns the ingest file type of the given content type, or false if files of that content
+// type cannot be uploaded
+func FileTypeForContentType(contentType string) (model.FileType, bool) {
+	if parsed, _, err := mime.ParseMediaType(contentType); err != nil {
+		return model.FileTypeJson, false
+	} else if strings.EqualFold(parsed, mediatypes.ApplicationJson.String()) {
+		return model.FileTypeJson, true
+	} else if slices.Contains(ingest.AllowedZipFileUploadTypes, strings.ToLower(parsed)) {
+		return model.FileTypeZip, true
+	} else {
+		return model.FileTypeJson, false
+	}
+}
+
+// StartIngestUpload begins a resumable upload of a file of the given type and total size to the given ingest job
+func StartIngestUpload(ctx context.Context, db IngestData, job model.IngestJob, fileType model.FileType, totalSize int64) (model.IngestUpload, error) {
+	return db.CreateIngestUpload(ctx, model.IngestUpload{
+		IngestJobID: job.ID,
+		FileType:    fileType,
+		TotalSize:   totalSize,
+	})
+}
+
+// WriteIngestUploadChunk stores a chunk of the given upload that starts at the given offset and returns the upload
+// with its received size advanced. If a checksum is given the chunk is rejected unless its SHA-256 digest matches.
+// An empty chunk is not recorded.
+func WriteIngestUploadChunk(ctx context.Context, db IngestData, store storage.Store, upload model.IngestUpload, offset int64, checksum []byte, chunkData io.Reader) (model.IngestUpload, error) {
+	if upload.Completed {
+		return upload, ErrUploadCompleted
+	} else if offset != upload.ReceivedSize {
+		return upload, ErrUploadOffsetMismatch
+	}
+
+	writer, err := store.Create(ctx)
+	if err != nil {
+		return upload, fmt.Errorf("error creating upload chunk: %w", err)
+	}
+
+	var (
+		digest    = sha256.New()
+		remaining = upload.TotalSize - upload.ReceivedSize
+	)
+
+	if size, err := io.Copy(writer, io.TeeReader(io.LimitReader(chunkData, remaining+1), digest)); err != nil {
+		abortUploadFile(ctx, writer)
+		return upload, fmt.Errorf("error writing upload chunk: %w", err)
+	} else if size > remaining {
+		abortUploadFile(ctx, writer)
+		return upload, ErrUploadSizeExceeded
+	} else if sum := digest.Sum(nil); checksum != nil && !bytes.Equal(sum, checksum) {
+		abortUploadFile(ctx, writer)
+		return upload, ErrUploadChecksumMismatch
+	} else if size == 0 {
+		abortUploadFile(ctx, writer)
+		return upload, nil
+	} else if err := writer.Close(); err != nil {
+		return upload, fmt.Errorf("error storing upload chunk: %w", err)
+	} else if updated, err := db.AppendIngestUploadChunk(ctx, model.IngestUploadChunk{
+		UploadID: upload.ID,
+		Offset:   offset,
+		Size:     size,
+		FileName: writer.Name(),
+		SHA256:   hex.EncodeToString(sum),
+	}); err != nil {
+		removeUploadFile(ctx, store, writer.Name())
+		return upload, err
+	} else {
+		return updated, nil
+	}
+}
+
+// AssembleIngestUpload joins the chunks of a fully received upload into a single ingest file, validating it the same
+// way as a file uploaded in a single request, and creates the ingest task for it. An upload that fails validation is
+// discarded and ErrUploadInvalid is returned.
+func AssembleIngestUpload(ctx context.Context, db IngestData, store storage.Store, upload model.IngestUpload, requestID string) (model.IngestTask, error) {
+	if upload.Completed {
+		return model.IngestTask{}, ErrUploadCompleted
+	} else if upload.ReceivedSize != upload.TotalSize {
+		return model.IngestTask{}, fmt.Errorf("upload has received %d of %d bytes", upload.ReceivedSize, upload.TotalSize)
+	} else if chunks, err := db.GetIngestUploadChunks(ctx, upload.ID); err != nil {
+		return model.IngestTask{}, err
+	} else {
+		reader := &chunkReader{ctx: ctx, store: store, chunks: chunks}
+		fileName, err := StoreIngestFile(ctx, store, upload.FileType, reader)
+		reader.Close()
+
+		if errors.Is(err, ErrInvalidIngestFile) {
+			if err := DiscardIngestUpload(ctx, db, store, upload); err != nil {
+				slog.ErrorContext(ctx, fmt.Sprintf("Error discarding invalid upload %d: %v", upload.ID, err))
+			}
+
+			return model.IngestTask{}, fmt.Errorf("%w: %w", ErrUploadInvalid, err)
+		} else if err != nil {
+			return model.IngestTask{}, fmt.Errorf("error assembling upload: %w", err)
+		} else if task, err := db.CompleteIngestUpload(ctx, upload, model.IngestTask{
+			FileName: fileName,
+			TaskID:   null.Int64From(upload.IngestJobID),
+			FileType: upload.FileType,
+			// The request that completed the upload identifies the task like the request of a single file upload
+			RequestGUID: requestID,
+		}); err != nil {
+			removeUploadFile(ctx, store, fileName)
+			return task, err
+		} else {
+			for _, chunk := range chunks {
+				removeUploadFile(ctx, store, chunk.FileName)
+			}
+
+			return task, nil
+		}
+	}
+}
+
+// DiscardIngestUpload deletes an upload along with the chunks it has received
+func DiscardIngestUpload(ctx context.Context, db IngestData, store storage.Store, upload model.IngestUpload) error {
+	if chunks, err := db.GetIngestUploadChunks(ctx, upload.ID); err != nil {
+		return err
+	} else if err := db.DeleteIngestUpload(ctx, upload.ID); err != nil {
+		return err
+	} else {
+		for _, chunk := range chunks {
+			removeUploadFile(ctx, store, chunk.FileName)
+		}
+
+		return nil
+	}
+}
+
+func abortUploadFile(ctx context.Context, writer storage.Writer) {
+	if err := writer.Abort(); err != nil {
+		slog.ErrorContext(ctx, fmt.Sprintf("Error discarding upload file %s: %v", writer.Name(), err))
+	}
+}
+
+func removeUploadFile(ctx context.Context, store storage.Store, name string) {
+	if err := store.Remove(ctx, name); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		slog.ErrorContext(ctx, fmt.Sprintf("Error removing upload file %s: %v", name, err))
+	}
+}
+
+// chunkReader reads the chunks of an upload from the ingest store as a single stream, opening each chunk only once the
+// previous one has been read. Once reading from the store fails every further read returns the same error.
+type chunkReader struct {
+	ctx     context.Context
+	store   storage.Store
+	chunks  model.IngestUploadChunks
+	current storage.File
+	err     error
+}
+
+func (s *chunkReader) Read(p []byte) (int, error) {
+	for {
+		if s.err != nil {
+			return 0, s.err
+		} else if s.current == nil {
+			if len(s.chunks) == 0 {
+				return 0, io.EOF
+			} else if file, err := s.store.Open(s.ctx, s.chunks[0].FileName); err != nil {
+				s.err = fmt.Errorf("error opening upload chunk at offset %d: %w", s.chunks[0].Offset, err)
+			} else {
+				s.current = file
+			}
+		} else if read, err := s.current.Read(p); errors.Is(err, io.EOF) {
+			s.Close()
+			s.chunks = s.chunks[1:]
+
+			if read > 0 {
+				return read, nil
+			}
+		} else if err != nil {
+			s.err = fmt.Errorf("error reading upload chunk at offset %d: %w", s.chunks[0].Offset, err)
+			return read, s.err
+		} else {
+			return read, nil
+		}
+	}
+}
+
+func (s *chunkReader) Close() error {
+	if s.current != nil {
+		err := s.current.Close()
+		s.current = nil
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,293 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ingest_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/specterops/bloodhound/src/model"
+	"github.com/specterops/bloodhound/src/services/ingest"
+	"github.com/specterops/bloodhound/src/services/ingest/mocks"
+	"github.com/specterops/bloodhound/src/services/ingest/storage"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const uploadTestJSON = `{"meta": {"type": "domains", "version": 4, "count": 1}, "data": [{"domain": "example.com"}]}`
+
+// writeUploadChunks stores the given chunks and returns them as they would be recorded for an upload
+func writeUploadChunks(t *testing.T, store storage.Store, uploadID int64, chunks ...string) model.IngestUploadChunks {
+	var (
+		offset int64
+		result model.IngestUploadChunks
+	)
+
+	for _, chunk := range chunks {
+		writer, err := store.Create(context.Background())
+		require.Nil(t, err)
+
+		_, err = writer.Write([]byte(chunk))
+		require.Nil(t, err)
+		require.Nil(t, writer.Close())
+
+		result = append(result, model.IngestUploadChunk{UploadID: uploadID, Offset: offset, Size: int64(len(chunk)), FileName: writer.Name()})
+		offset += int64(len(chunk))
+	}
+
+	return result
+}
+
+func storedFiles(t *testing.T, store storage.Store) []string {
+	objects, err := store.List(context.Background())
+	require.Nil(t, err)
+
+	names := make([]string, len(objects))
+	for idx, object := range objects {
+		names[idx] = object.Name
+	}
+
+	return names
+}
+
+func TestFileTypeForContentType(t *testing.T) {
+	fileType, valid := ingest.FileTypeForContentType("application/json; charset=utf-8")
+	require.True(t, valid)
+	require.Equal(t, model.FileTypeJson, fileType)
+
+	fileType, valid = ingest.FileTypeForContentType("application/x-zip-compressed")
+	require.True(t, valid)
+	require.Equal(t, model.FileTypeZip, fileType)
+
+	_, valid = ingest.FileTypeForContentType("text/plain")
+	require.False(t, valid)
+
+	_, valid = ingest.FileTypeForContentType("")
+	require.False(t, valid)
+}
+
+func TestWriteIngestUploadChunk(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		upload = model.IngestUpload{IngestJobID: 1, TotalSize: 10, ReceivedSize: 4, BigSerial: model.BigSerial{ID: 7}}
+	)
+
+	t.Run("Appends Chunk", func(t *testing.T) {
+		var (
+			mockCtrl = gomock.NewController(t)
+			mockDB   = mocks.NewMockIngestData(mockCtrl)
+			store    = storage.NewFilesystemStore(t.TempDir())
+			checksum = sha256.Sum256([]byte("abcd"))
+		)
+
+		mockDB.EXPECT().AppendIngestUploadChunk(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, chunk model.IngestUploadChunk) (model.IngestUpload, error) {
+			require.Equal(t, int64(7), chunk.UploadID)
+			require.Equal(t, int64(4), chunk.Offset)
+			require.Equal(t, int64(4), chunk.Size)
+
+			content, err := os.ReadFile(chunk.FileName)
+			require.Nil(t, err)
+			require.Equal(t, "abcd", string(content))
+
+			appended := upload
+			appended.ReceivedSize += chunk.Size
+			return appended, nil
+		})
+
+		updated, err := ingest.WriteIngestUploadChunk(ctx, mockDB, store, upload, 4, checksum[:], strings.NewReader("abcd"))
+		require.Nil(t, err)
+		require.Equal(t, int64(8), updated.ReceivedSize)
+	})
+
+	t.Run("Rejects Offset Mismatch", func(t *testing.T) {
+		var (
+			mockCtrl = gomock.NewController(t)
+			mockDB   = mocks.NewMockIngestData(mockCtrl)
+			store    = storage.NewFilesystemStore(t.TempDir())
+		)
+
+		_, err := ingest.WriteIngestUploadChunk(ctx, mockDB, store, upload, 0, nil, strings.NewReader("abcd"))
+		require.ErrorIs(t, err, ingest.ErrUploadOffsetMismatch)
+		require.Empty(t, storedFiles(t, store))
+	})
+
+	t.Run("Rejects Chunk Exceeding Total Size", func(t *testing.T) {
+		var (
+			mockCtrl = gomock.NewController(t)
+			mockDB   = mocks.NewMockIngestData(mockCtrl)
+			store    = storage.NewFilesystemStore(t.TempDir())
+		)
+
+		_, err := ingest.WriteIngestUploadChunk(ctx, mockDB, store, upload, 4, nil, strings.NewReader("abcdefg"))
+		require.ErrorIs(t, err, ingest.ErrUploadSizeExceeded)
+		require.Empty(t, storedFiles(t, store))
+	})
+
+	t.Run("Rejects Checksum Mismatch", func(t *testing.T) {
+		var (
+			mockCtrl = gomock.NewController(t)
+			mockDB   = mocks.NewMockIngestData(mockCtrl)
+			store    = storage.NewFilesystemStore(t.TempDir())
+			checksum = sha256.Sum256([]byte("abce"))
+		)
+
+		_, err := ingest.WriteIngestUploadChunk(ctx, mockDB, store, upload, 4, checksum[:], strings.NewReader("abcd"))
+		require.ErrorIs(t, err, ingest.ErrUploadChecksumMismatch)
+		require.Empty(t, storedFiles(t, store))
+	})
+
+	t.Run("Removes Chunk On Conflict", func(t *testing.T) {
+		var (
+			mockCtrl = gomock.NewController(t)
+			mockDB   = mocks.NewMockIngestData(mockCtrl)
+			store    = storage.NewFilesystemStore(t.TempDir())
+		)
+
+		mockDB.EXPECT().AppendIngestUploadChunk(gomock.Any(), gomock.Any()).Return(model.IngestUpload{}, ingest.ErrUploadConflict)
+
+		_, err := ingest.WriteIngestUploadChunk(ctx, mockDB, store, upload, 4, nil, strings.NewReader("abcd"))
+		require.ErrorIs(t, err, ingest.ErrUploadConflict)
+		require.Empty(t, storedFiles(t, store))
+	})
+
+	t.Run("Ignores Empty Chunk", func(t *testing.T) {
+		var (
+			mockCtrl = gomock.NewController(t)
+			mockDB   = mocks.NewMockIngestData(mockCtrl)
+			store    = storage.NewFilesystemStore(t.TempDir())
+		)
+
+		updated, err := ingest.WriteIngestUploadChunk(ctx, mockDB, store, upload, 4, nil, strings.NewReader(""))
+		require.Nil(t, err)
+		require.Equal(t, upload, updated)
+		require.Empty(t, storedFiles(t, store))
+	})
+}
+
+func TestAssembleIngestUpload(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Assembles JSON Chunks", func(t *testing.T) {
+		var (
+			mockCtrl = gomock.NewController(t)
+			mockDB   = mocks.NewMockIngestData(mockCtrl)
+			store    = storage.NewFilesystemStore(t.TempDir())
+			chunks   = writeUploadChunks(t, store, 3, uploadTestJSON[:10], uploadTestJSON[10:11], uploadTestJSON[11:])
+			upload   = model.IngestUpload{IngestJobID: 1, FileType: model.FileTypeJson, TotalSize: int64(len(uploadTestJSON)), ReceivedSize: int64(len(uploadTestJSON)), BigSerial: model.BigSerial{ID: 3}}
+		)
+
+		mockDB.EXPECT().GetIngestUploadChunks(gomock.Any(), int64(3)).Return(chunks, nil)
+		mockDB.EXPECT().CompleteIngestUpload(gomock.Any(), upload, gomock.Any()).DoAndReturn(func(_ context.Context, _ model.IngestUpload, task model.IngestTask) (model.IngestTask, error) {
+			require.Equal(t, int64(1), task.TaskID.Int64)
+			require.Equal(t, "request", task.RequestGUID)
+			require.Equal(t, model.FileTypeJson, task.FileType)
+			return task, nil
+		})
+
+		task, err := ingest.AssembleIngestUpload(ctx, mockDB, store, upload, "request")
+		require.Nil(t, err)
+		require.Equal(t, []string{task.FileName}, storedFiles(t, store))
+
+		content, err := os.ReadFile(task.FileName)
+		require.Nil(t, err)
+		require.Equal(t, uploadTestJSON, string(content))
+	})
+
+	t.Run("Assembles Zip With Header Across Chunks", func(t *testing.T) {
+		var (
+			mockCtrl = gomock.NewController(t)
+			mockDB   = mocks.NewMockIngestData(mockCtrl)
+			store    = storage.NewFilesystemStore(t.TempDir())
+			archive  = bytes.Buffer{}
+			zipper   = zip.NewWriter(&archive)
+		)
+
+		entry, err := zipper.Create("domains.json")
+		require.Nil(t, err)
+		_, err = io.WriteString(entry, uploadTestJSON)
+		require.Nil(t, err)
+		require.Nil(t, zipper.Close())
+
+		var (
+			content = archive.String()
+			chunks  = writeUploadChunks(t, store, 4, content[:2], content[2:])
+			upload  = model.IngestUpload{IngestJobID: 1, FileType: model.FileTypeZip, TotalSize: int64(len(content)), ReceivedSize: int64(len(content)), BigSerial: model.BigSerial{ID: 4}}
+		)
+
+		mockDB.EXPECT().GetIngestUploadChunks(gomock.Any(), int64(4)).Return(chunks, nil)
+		mockDB.EXPECT().CompleteIngestUpload(gomock.Any(), upload, gomock.Any()).DoAndReturn(func(_ context.Context, _ model.IngestUpload, task model.IngestTask) (model.IngestTask, error) {
+			return task, nil
+		})
+
+		task, err := ingest.AssembleIngestUpload(ctx, mockDB, store, upload, "request")
+		require.Nil(t, err)
+		require.Equal(t, model.FileTypeZip, task.FileType)
+		require.Equal(t, []string{task.FileName}, storedFiles(t, store))
+	})
+
+	t.Run("Discards Invalid Upload", func(t *testing.T) {
+		var (
+			mockCtrl = gomock.NewController(t)
+			mockDB   = mocks.NewMockIngestData(mockCtrl)
+			store    = storage.NewFilesystemStore(t.TempDir())
+			chunks   = writeUploadChunks(t, store, 5, "not a ", "zip")
+			upload   = model.IngestUpload{IngestJobID: 1, FileType: model.FileTypeZip, TotalSize: 9, ReceivedSize: 9, BigSerial: model.BigSerial{ID: 5}}
+		)
+
+		mockDB.EXPECT().GetIngestUploadChunks(gomock.Any(), int64(5)).Return(chunks, nil).Times(2)
+		mockDB.EXPECT().DeleteIngestUpload(gomock.Any(), int64(5)).Return(nil)
+
+		_, err := ingest.AssembleIngestUpload(ctx, mockDB, store, upload, "request")
+		require.ErrorIs(t, err, ingest.ErrUploadInvalid)
+		require.Empty(t, storedFiles(t, store))
+	})
+
+	t.Run("Keeps Chunks When Completion Conflicts", func(t *testing.T) {
+		var (
+			mockCtrl = gomock.NewController(t)
+			mockDB   = mocks.NewMockIngestData(mockCtrl)
+			store    = storage.NewFilesystemStore(t.TempDir())
+			chunks   = writeUploadChunks(t, store, 6, uploadTestJSON)
+			upload   = model.IngestUpload{IngestJobID: 1, FileType: model.FileTypeJson, TotalSize: int64(len(uploadTestJSON)), ReceivedSize: int64(len(uploadTestJSON)), BigSerial: model.BigSerial{ID: 6}}
+		)
+
+		mockDB.EXPECT().GetIngestUploadChunks(gomock.Any(), int64(6)).Return(chunks, nil)
+		mockDB.EXPECT().CompleteIngestUpload(gomock.Any(), upload, gomock.Any()).Return(model.IngestTask{}, ingest.ErrUploadConflict)
+
+		_, err := ingest.AssembleIngestUpload(ctx, mockDB, store, upload, "request")
+		require.ErrorIs(t, err, ingest.ErrUploadConflict)
+		require.Equal(t, []string{chunks[0].FileName}, storedFiles(t, store))
+	})
+
+	t.Run("Rejects Incomplete Upload", func(t *testing.T) {
+		var (
+			mockCtrl = gomock.NewController(t)
+			mockDB   = mocks.NewMockIngestData(mockCtrl)
+			store    = storage.NewFilesystemStore(t.TempDir())
+			upload   = model.IngestUpload{IngestJobID: 1, TotalSize: 10, ReceivedSize: 4}
+		)
+
+		_, err := ingest.AssembleIngestUpload(ctx, mockDB, store, upload, "request")
+		require.NotNil(t, err)
+	})
+}
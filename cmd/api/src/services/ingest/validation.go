@@ -100,10 +100,11 @@ func ValidateMetaTag(reader io.Reader, readToEnd bool) (ingest.Metadata, error)
 
 func ValidateZipFile(reader io.Reader) error {
 	bytes := make([]byte, 4)
-	if readBytes, err := reader.Read(bytes); err != nil {
-		return err
-	} else if readBytes < 4 {
+	// The header may span several reads of a stream assembled from chunks
+	if _, err := io.ReadFull(reader, bytes); errors.Is(err, io.ErrUnexpectedEOF) {
 		return ingest.ErrInvalidZipFile
+	} else if err != nil {
+		return err
 	} else {
 		for i := 0; i < 4; i++ {
 			if bytes[i] != ZipMagicBytes[i] {
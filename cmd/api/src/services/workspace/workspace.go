@@ -0,0 +1,80 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package workspace scopes graph operations to the isolated graph namespace backing a model.Workspace.
+package workspace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/specterops/bloodhound/dawgs/drivers/memory"
+	"github.com/specterops/bloodhound/dawgs/drivers/pg"
+	"github.com/specterops/bloodhound/dawgs/graph"
+	"github.com/specterops/bloodhound/graphschema"
+	"github.com/specterops/bloodhound/src/model"
+)
+
+var (
+	ErrUnsupportedGraphDriver = errors.New("the current graph driver does not support workspaces")
+)
+
+// IsSupported returns true if the current graph driver isolates named graphs from one another. The Neo4j driver holds a
+// single graph namespace and can not back a workspace.
+func IsSupported(graphDB graph.Database) bool {
+	return pg.IsPostgreSQLGraph(graphDB) || graph.IsDriver[*memory.Driver](graphDB)
+}
+
+// Graph returns the graph schema of the namespace backing the given workspace.
+func Graph(workspace model.Workspace) graph.Graph {
+	return graphschema.CombinedGraphSchema(workspace.GraphName())
+}
+
+// WithTarget directs graph operations issued with the returned context at the given workspace's graph namespace.
+func WithTarget(ctx context.Context, workspace model.Workspace) context.Context {
+	return graph.WithGraphTarget(ctx, Graph(workspace))
+}
+
+// Initialize creates the graph namespace backing the given workspace.
+func Initialize(ctx context.Context, graphDB graph.Database, workspace model.Workspace) error {
+	if !IsSupported(graphDB) {
+		return ErrUnsupportedGraphDriver
+	}
+
+	// Targeting a graph in a write transaction asserts its schema and storage
+	return graphDB.WriteTransaction(WithTarget(ctx, workspace), func(tx graph.Transaction) error {
+		_, err := tx.Nodes().Count()
+		return err
+	})
+}
+
+// DeleteGraphData removes all nodes and relationships held in the given workspace's graph namespace.
+func DeleteGraphData(ctx context.Context, graphDB graph.Database, workspace model.Workspace) error {
+	if !IsSupported(graphDB) {
+		return ErrUnsupportedGraphDriver
+	}
+
+	return graphDB.WriteTransaction(WithTarget(ctx, workspace), func(tx graph.Transaction) error {
+		if err := tx.Relationships().Delete(); err != nil {
+			return fmt.Errorf("error deleting workspace relationships: %w", err)
+		} else if err := tx.Nodes().Delete(); err != nil {
+			return fmt.Errorf("error deleting workspace nodes: %w", err)
+		}
+
+		return nil
+	})
+}
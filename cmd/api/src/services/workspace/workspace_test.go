@@ -0,0 +1,71 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/specterops/bloodhound/dawgs"
+	"github.com/specterops/bloodhound/dawgs/drivers/memory"
+	"github.com/specterops/bloodhound/dawgs/graph"
+	"github.com/specterops/bloodhound/dawgs/ops"
+	"github.com/specterops/bloodhound/graphschema/ad"
+	"github.com/specterops/bloodhound/src/model"
+	"github.com/specterops/bloodhound/src/services/workspace"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkspaceIsolation(t *testing.T) {
+	var (
+		testCtx     = context.Background()
+		memoryDB, _ = dawgs.Open(testCtx, memory.DriverName, dawgs.Config{})
+		graphDB     = graph.NewDatabaseSwitch(testCtx, memoryDB)
+		first       = model.Workspace{Name: "first", Serial: model.Serial{ID: 1}}
+		second      = model.Workspace{Name: "second", Serial: model.Serial{ID: 2}}
+		countNodes  = func(ctx context.Context) int64 {
+			count, err := ops.CountNodes(ctx, graphDB)
+			require.Nil(t, err)
+
+			return count
+		}
+	)
+
+	require.True(t, workspace.IsSupported(graphDB))
+	require.Nil(t, workspace.Initialize(testCtx, graphDB, first))
+	require.Nil(t, workspace.Initialize(testCtx, graphDB, second))
+
+	require.Nil(t, graphDB.WriteTransaction(workspace.WithTarget(testCtx, first), func(tx graph.Transaction) error {
+		_, err := tx.CreateNode(graph.NewProperties(), ad.Entity, ad.User)
+		return err
+	}))
+
+	require.Equal(t, int64(0), countNodes(testCtx))
+	require.Equal(t, int64(1), countNodes(workspace.WithTarget(testCtx, first)))
+	require.Equal(t, int64(0), countNodes(workspace.WithTarget(testCtx, second)))
+
+	require.Nil(t, workspace.DeleteGraphData(testCtx, graphDB, first))
+	require.Equal(t, int64(0), countNodes(workspace.WithTarget(testCtx, first)))
+}
+
+func TestIsSupported(t *testing.T) {
+	memoryDB, _ := dawgs.Open(context.Background(), memory.DriverName, dawgs.Config{})
+
+	// Driver types are only resolved through a database switch
+	require.False(t, workspace.IsSupported(memoryDB))
+	require.ErrorIs(t, workspace.Initialize(context.Background(), memoryDB, model.Workspace{}), workspace.ErrUnsupportedGraphDriver)
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 
 	"github.com/specterops/bloodhound/dawgs"
+	"github.com/specterops/bloodhound/dawgs/drivers/memory"
 	"github.com/specterops/bloodhound/dawgs/drivers/neo4j"
 	"github.com/specterops/bloodhound/dawgs/drivers/pg"
 	"github.com/specterops/bloodhound/dawgs/graph"
@@ -38,14 +39,30 @@ func LoadConfiguration(testCtrl test.Controller) config.Configuration {
 	return cfg
 }
 
+// loadGraphConfiguration loads the integration test configuration. Without one, graph tests fall back to the
+// in-memory graph driver so that they may be run with a plain go test invocation.
+func loadGraphConfiguration(testCtrl test.Controller) config.Configuration {
+	if !utils.HasIntegrationTestConfig() {
+		return config.Configuration{
+			GraphDriver: memory.DriverName,
+		}
+	}
+
+	return LoadConfiguration(testCtrl)
+}
+
 func OpenGraphDB(testCtrl test.Controller, schema graph.Schema) graph.Database {
 	var (
-		cfg           = LoadConfiguration(testCtrl)
+		cfg           = loadGraphConfiguration(testCtrl)
 		graphDatabase graph.Database
 		err           error
 	)
 
 	switch cfg.GraphDriver {
+	case memory.DriverName:
+		graphDatabase, err = dawgs.Open(context.TODO(), cfg.GraphDriver, dawgs.Config{})
+		test.RequireNilErrf(testCtrl, err, "Failed opening graph database: %v", err)
+
 	case pg.DriverName:
 		pool, err := pg.NewPool(cfg.Database.PostgreSQLConnectionString())
 		test.RequireNilErrf(testCtrl, err, "Failed to create new pgx pool: %v", err)
@@ -0,0 +1,64 @@
+// Copyright 2023 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build integration
+// +build integration
+
+package integration_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/specterops/bloodhound/dawgs/graph"
+	"github.com/specterops/bloodhound/dawgs/ops"
+	schema "github.com/specterops/bloodhound/graphschema"
+	"github.com/specterops/bloodhound/src/test/integration"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraphTestContext_DatabaseTestWithSetup(t *testing.T) {
+	var (
+		testContext = integration.NewGraphTestContext(t, schema.DefaultGraphSchema())
+		done        = make(chan struct{})
+	)
+
+	// Harness setup creates nodes from within a write transaction, which must not block the graph driver
+	go func() {
+		defer close(done)
+
+		testContext.DatabaseTestWithSetup(func(harness *integration.HarnessDetails) error {
+			harness.RDPB.Setup(testContext)
+			return nil
+		}, func(harness integration.HarnessDetails, db graph.Database) {
+			count, err := ops.CountNodes(context.Background(), db)
+			require.Nil(t, err)
+			require.Equal(t, int64(6), count)
+
+			require.Nil(t, db.ReadTransaction(context.Background(), func(tx graph.Transaction) error {
+				_, err := ops.FetchNode(tx, harness.RDPB.Computer.ID)
+				return err
+			}))
+		})
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(30 * time.Second):
+		t.Fatal("harness setup blocked on the graph driver")
+	}
+}
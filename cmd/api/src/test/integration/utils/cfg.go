@@ -35,3 +35,8 @@ func LoadIntegrationTestConfig() (config.Configuration, error) {
 		return config.Configuration{}, fmt.Errorf("required environment variable %s not found", integrationTestConfigEnvironmentVarName)
 	}
 }
+
+// HasIntegrationTestConfig returns true if an integration test configuration file has been specified.
+func HasIntegrationTestConfig() bool {
+	return os.Getenv(integrationTestConfigEnvironmentVarName) != ""
+}
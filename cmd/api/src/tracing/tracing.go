@@ -0,0 +1,92 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package tracing configures the export of OpenTelemetry traces and provides the helpers used to start spans for API
+// requests, ingest tasks and analysis steps. Graph transactions and driver queries are traced by dawgs.
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/specterops/bloodhound/src/config"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const InstrumentationName = "github.com/specterops/bloodhound/src"
+
+// ShutdownFunc flushes any spans that have not yet been exported and stops the tracer provider.
+type ShutdownFunc func(ctx context.Context) error
+
+// NewTracerProvider creates a tracer provider that batches spans to the OTLP/HTTP collector at the configured endpoint.
+func NewTracerProvider(ctx context.Context, cfg config.TracingConfiguration) (*sdktrace.TracerProvider, error) {
+	if exporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(cfg.Endpoint)); err != nil {
+		return nil, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+	} else {
+		return sdktrace.NewTracerProvider(
+			sdktrace.WithBatcher(exporter),
+			sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+			sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", cfg.ServiceName))),
+		), nil
+	}
+}
+
+// Initialize installs the global tracer provider and W3C trace context propagation when tracing is enabled. The
+// returned ShutdownFunc must be called before the process exits so that buffered spans are exported.
+func Initialize(ctx context.Context, cfg config.TracingConfiguration) (ShutdownFunc, error) {
+	if !cfg.Enabled() {
+		return func(ctx context.Context) error {
+			return nil
+		}, nil
+	}
+
+	if provider, err := NewTracerProvider(ctx, cfg); err != nil {
+		return nil, err
+	} else {
+		otel.SetTracerProvider(provider)
+		otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+		return provider.Shutdown, nil
+	}
+}
+
+// Start starts a span from the global tracer provider.
+func Start(ctx context.Context, name string, options ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(InstrumentationName).Start(ctx, name, options...)
+}
+
+// SetError records the error on the span and marks the span as failed.
+func SetError(span trace.Span, err error) {
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// End records the given error, if any, on the span and ends it. The error is returned unchanged.
+func End(span trace.Span, err error) error {
+	if err != nil {
+		SetError(span, err)
+	}
+
+	span.End()
+	return err
+}
@@ -0,0 +1,121 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/specterops/bloodhound/dawgs/drivers/memory"
+	"github.com/specterops/bloodhound/dawgs/graph"
+	"github.com/specterops/bloodhound/dawgs/util/size"
+	"github.com/specterops/bloodhound/src/config"
+	"github.com/specterops/bloodhound/src/tracing"
+	"github.com/stretchr/testify/require"
+	collectortrace "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// collector is an in-process stand-in for an OTLP/HTTP collector that keeps every span exported to it.
+type collector struct {
+	lock  sync.Mutex
+	spans []*tracepb.Span
+}
+
+func (s *collector) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	var exportRequest collectortrace.ExportTraceServiceRequest
+
+	if request.URL.Path != "/v1/traces" {
+		response.WriteHeader(http.StatusNotFound)
+	} else if body, err := io.ReadAll(request.Body); err != nil {
+		response.WriteHeader(http.StatusBadRequest)
+	} else if err := proto.Unmarshal(body, &exportRequest); err != nil {
+		response.WriteHeader(http.StatusBadRequest)
+	} else {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+
+		for _, resourceSpans := range exportRequest.ResourceSpans {
+			for _, scopeSpans := range resourceSpans.ScopeSpans {
+				s.spans = append(s.spans, scopeSpans.Spans...)
+			}
+		}
+
+		response.Header().Set("Content-Type", "application/x-protobuf")
+		response.WriteHeader(http.StatusOK)
+	}
+}
+
+func (s *collector) span(name string) (*tracepb.Span, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, span := range s.spans {
+		if span.Name == name {
+			return span, true
+		}
+	}
+
+	return nil, false
+}
+
+func TestInitialize_Disabled(t *testing.T) {
+	shutdown, err := tracing.Initialize(context.Background(), config.TracingConfiguration{})
+	require.Nil(t, err)
+	require.Nil(t, shutdown(context.Background()))
+}
+
+func TestInitialize_ExportsGraphTransactionSpans(t *testing.T) {
+	var (
+		ctx             = context.Background()
+		spanCollector   = &collector{}
+		collectorServer = httptest.NewServer(spanCollector)
+	)
+
+	defer collectorServer.Close()
+
+	shutdown, err := tracing.Initialize(ctx, config.TracingConfiguration{
+		Endpoint:    collectorServer.URL,
+		ServiceName: "bloodhound-test",
+		SampleRatio: 1,
+	})
+	require.Nil(t, err)
+
+	graphDB := graph.NewDatabaseSwitch(ctx, memory.NewDriver(size.Gibibyte))
+
+	operationCtx, span := tracing.Start(ctx, "test.operation")
+	require.Nil(t, graphDB.ReadTransaction(operationCtx, func(tx graph.Transaction) error {
+		return nil
+	}))
+	tracing.End(span, nil)
+
+	// Shutting down flushes the spans buffered by the batch span processor to the collector
+	require.Nil(t, shutdown(ctx))
+
+	operationSpan, found := spanCollector.span("test.operation")
+	require.True(t, found)
+
+	transactionSpan, found := spanCollector.span("dawgs.read")
+	require.True(t, found)
+	require.Equal(t, operationSpan.TraceId, transactionSpan.TraceId)
+	require.Equal(t, operationSpan.SpanId, transactionSpan.ParentSpanId)
+}
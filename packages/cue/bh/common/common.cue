@@ -101,6 +101,13 @@ LastSeen: types.#StringEnum & {
 	representation: "lastseen"
 }
 
+Stale: types.#StringEnum & {
+	symbol:         "Stale"
+	schema:         "common"
+	name:           "Not Seen Within Retention Period"
+	representation: "stale"
+}
+
 WhenCreated: types.#StringEnum & {
 	symbol:         "WhenCreated"
 	schema:         "common"
@@ -150,6 +157,34 @@ CompositionID: types.#StringEnum & {
 	representation: "compositionid"
 }
 
+FirstIngestJob: types.#StringEnum & {
+	symbol:         "FirstIngestJob"
+	schema:         "common"
+	name:           "First Ingest Job"
+	representation: "firstingestjob"
+}
+
+LastIngestJob: types.#StringEnum & {
+	symbol:         "LastIngestJob"
+	schema:         "common"
+	name:           "Last Ingest Job"
+	representation: "lastingestjob"
+}
+
+IngestSourceType: types.#StringEnum & {
+	symbol:         "IngestSourceType"
+	schema:         "common"
+	name:           "Ingest Source Type"
+	representation: "ingestsourcetype"
+}
+
+IngestSourceVersion: types.#StringEnum & {
+	symbol:         "IngestSourceVersion"
+	schema:         "common"
+	name:           "Ingest Source Version"
+	representation: "ingestsourceversion"
+}
+
 Properties: [
 	ObjectID,
 	Name,
@@ -161,13 +196,18 @@ Properties: [
 	SystemTags,
 	UserTags,
 	LastSeen,
+	Stale,
 	WhenCreated,
 	Enabled,
 	PasswordLastSet,
 	Title,
 	Email,
 	IsInherited,
-	CompositionID
+	CompositionID,
+	FirstIngestJob,
+	LastIngestJob,
+	IngestSourceType,
+	IngestSourceVersion
 ]
 
 // Kinds
@@ -177,8 +217,15 @@ MigrationData: types.#Kind & {
 	representation: "MigrationData"
 }
 
+GenericEntity: types.#Kind & {
+	symbol:         "GenericEntity"
+	schema:         "common"
+	representation: "GenericEntity"
+}
+
 NodeKinds: [
 	MigrationData,
+	GenericEntity,
 ]
 
 RelationshipKinds: [
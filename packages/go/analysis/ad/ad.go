@@ -38,8 +38,10 @@ import (
 )
 
 var (
-	AdminGroupSuffix = "-544"
-	RDPGroupSuffix   = "-555"
+	AdminGroupSuffix    = "-544"
+	RDPGroupSuffix      = "-555"
+	PSRemoteGroupSuffix = "-580"
+	DCOMGroupSuffix     = "-562"
 )
 
 const (
@@ -236,12 +236,6 @@ func getLAPSComputersForDomain(tx graph.Transaction, domain *graph.Node) ([]grap
 }
 
 func PostLocalGroups(ctx context.Context, db graph.Database, localGroupExpansions impact.PathAggregator, enforceURA bool, citrixEnabled bool) (*analysis.AtomicPostProcessingStats, error) {
-	var (
-		adminGroupSuffix    = "-544"
-		psRemoteGroupSuffix = "-580"
-		dcomGroupSuffix     = "-562"
-	)
-
 	if computers, err := FetchComputers(ctx, db); err != nil {
 		return &analysis.AtomicPostProcessingStats{}, err
 	} else {
@@ -258,7 +252,7 @@ func PostLocalGroups(ctx context.Context, db graph.Database, localGroupExpansion
 			}
 
 			if err := operation.Operation.SubmitReader(func(ctx context.Context, tx graph.Transaction, outC chan<- analysis.CreatePostRelationshipJob) error {
-				if entities, err := FetchLocalGroupBitmapForComputer(tx, computerID, dcomGroupSuffix); err != nil {
+				if entities, err := FetchLocalGroupBitmapForComputer(tx, computerID, DCOMGroupSuffix); err != nil {
 					return err
 				} else {
 					for _, admin := range entities.Slice() {
@@ -280,7 +274,7 @@ func PostLocalGroups(ctx context.Context, db graph.Database, localGroupExpansion
 			}
 
 			if err := operation.Operation.SubmitReader(func(ctx context.Context, tx graph.Transaction, outC chan<- analysis.CreatePostRelationshipJob) error {
-				if entities, err := FetchLocalGroupBitmapForComputer(tx, computerID, psRemoteGroupSuffix); err != nil {
+				if entities, err := FetchLocalGroupBitmapForComputer(tx, computerID, PSRemoteGroupSuffix); err != nil {
 					return err
 				} else {
 					for _, admin := range entities.Slice() {
@@ -302,7 +296,7 @@ func PostLocalGroups(ctx context.Context, db graph.Database, localGroupExpansion
 			}
 
 			if err := operation.Operation.SubmitReader(func(ctx context.Context, tx graph.Transaction, outC chan<- analysis.CreatePostRelationshipJob) error {
-				if entities, err := FetchLocalGroupBitmapForComputer(tx, computerID, adminGroupSuffix); err != nil {
+				if entities, err := FetchLocalGroupBitmapForComputer(tx, computerID, AdminGroupSuffix); err != nil {
 					return err
 				} else {
 					for _, admin := range entities.Slice() {
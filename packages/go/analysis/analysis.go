@@ -133,7 +133,15 @@ func FetchNodeByObjectID(tx graph.Transaction, objectID string) (*graph.Node, er
 		return node, nil
 	}
 
-	return tx.Nodes().Filter(nodeByIndexedKindProperty(common.ObjectID.String(), objectID, azure.Entity)).First()
+	if node, err := tx.Nodes().Filter(nodeByIndexedKindProperty(common.ObjectID.String(), objectID, azure.Entity)).First(); err != nil {
+		if !graph.IsErrNotFound(err) {
+			return nil, err
+		}
+	} else {
+		return node, nil
+	}
+
+	return tx.Nodes().Filter(nodeByIndexedKindProperty(common.ObjectID.String(), objectID, common.GenericEntity)).First()
 }
 
 func FetchEdgeByStartAndEnd(ctx context.Context, graphDB graph.Database, start, end graph.ID, edgeKind graph.Kind) (*graph.Relationship, error) {
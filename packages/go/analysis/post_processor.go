@@ -0,0 +1,251 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analysis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"sync"
+
+	"github.com/specterops/bloodhound/bhlog/measure"
+	"github.com/specterops/bloodhound/dawgs/graph"
+	"github.com/specterops/bloodhound/dawgs/util/channels"
+	"github.com/specterops/bloodhound/graphschema/ad"
+	"github.com/specterops/bloodhound/graphschema/azure"
+	"github.com/specterops/bloodhound/graphschema/common"
+)
+
+var (
+	ErrInvalidPostProcessor   = errors.New("invalid post-processor")
+	ErrDuplicatePostProcessor = errors.New("duplicate post-processor")
+	ErrReservedKind           = errors.New("post-processor relationship kind is reserved")
+	ErrClaimedKind            = errors.New("post-processor relationship kind is claimed by another post-processor")
+)
+
+// reservedKinds returns the relationship kinds of the graph schema, which include the relationship kinds of the
+// built-in post-processing. Relationships of these kinds are collected or derived by BloodHound and may not be
+// declared by a PostProcessor as they would be deleted on every analysis.
+func reservedKinds() graph.Kinds {
+	var kinds graph.Kinds
+
+	return kinds.Add(ad.Relationships()...).Add(azure.Relationships()...).Add(common.Relationships()...)
+}
+
+// PostProcessor is a named post-processing step that derives relationships of its own kinds. Relationships of the
+// kinds a PostProcessor declares are deleted before it runs, in the same manner as the built-in post-processed
+// relationships.
+type PostProcessor interface {
+	Name() string
+	Kinds() graph.Kinds
+	Run(ctx context.Context, db graph.Database) (*AtomicPostProcessingStats, error)
+}
+
+// PostRelationshipDelegate reads the graph and submits the relationships it derives to the given channel.
+type PostRelationshipDelegate func(ctx context.Context, tx graph.Transaction, outC chan<- CreatePostRelationshipJob) error
+
+type postProcessor struct {
+	name     string
+	kinds    graph.Kinds
+	delegate PostRelationshipDelegate
+}
+
+// NewPostProcessor returns a PostProcessor that creates the relationships submitted by the given delegate. Submitting
+// a relationship of a kind not given in kinds fails the post-processor.
+func NewPostProcessor(name string, kinds graph.Kinds, delegate PostRelationshipDelegate) PostProcessor {
+	return postProcessor{
+		name:     name,
+		kinds:    kinds,
+		delegate: delegate,
+	}
+}
+
+func (s postProcessor) Name() string {
+	return s.name
+}
+
+func (s postProcessor) Kinds() graph.Kinds {
+	return s.kinds
+}
+
+func (s postProcessor) checkKind(job CreatePostRelationshipJob) (CreatePostRelationshipJob, error) {
+	if !s.kinds.ContainsOneOf(job.Kind) {
+		return job, fmt.Errorf("post-processor %s submitted undeclared relationship kind %s", s.name, job.Kind)
+	}
+
+	return job, nil
+}
+
+func (s postProcessor) Run(ctx context.Context, db graph.Database) (*AtomicPostProcessingStats, error) {
+	operation := NewPostRelationshipOperation(ctx, db, fmt.Sprintf("%s Post Processing", s.name))
+
+	operation.Operation.SubmitReader(func(ctx context.Context, tx graph.Transaction, outC chan<- CreatePostRelationshipJob) error {
+		var (
+			delegateCtx, done = context.WithCancel(ctx)
+			delegateC         = make(chan CreatePostRelationshipJob)
+			delegateErr       error
+			waitGroup         = &sync.WaitGroup{}
+		)
+
+		defer done()
+		waitGroup.Add(1)
+
+		go func() {
+			defer waitGroup.Done()
+			defer close(delegateC)
+
+			delegateErr = s.delegate(delegateCtx, tx, delegateC)
+		}()
+
+		relayErr := channels.PipelineAll(ctx, delegateC, outC, s.checkKind)
+
+		// Stop the delegate and drain whatever it submits until it exits
+		done()
+
+		for range delegateC {
+		}
+
+		waitGroup.Wait()
+
+		if relayErr != nil {
+			return relayErr
+		}
+
+		return delegateErr
+	})
+
+	return &operation.Stats, operation.Done()
+}
+
+// PostProcessorRegistry holds the post-processors run after the built-in post-processing. Post-processors run in
+// order of name.
+type PostProcessorRegistry struct {
+	lock           *sync.RWMutex
+	postProcessors map[string]PostProcessor
+}
+
+func NewPostProcessorRegistry() *PostProcessorRegistry {
+	return &PostProcessorRegistry{
+		lock:           &sync.RWMutex{},
+		postProcessors: map[string]PostProcessor{},
+	}
+}
+
+// Register adds the given post-processor to the registry. Post-processor names must be unique and each
+// post-processor must declare at least one relationship kind. Declared kinds may neither be a relationship kind of
+// the graph schema nor a kind declared by another registered post-processor.
+func (s *PostProcessorRegistry) Register(postProcessor PostProcessor) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if postProcessor.Name() == "" {
+		return fmt.Errorf("%w: name is empty", ErrInvalidPostProcessor)
+	} else if len(postProcessor.Kinds()) == 0 {
+		return fmt.Errorf("%w: %s declares no relationship kinds", ErrInvalidPostProcessor, postProcessor.Name())
+	} else if _, exists := s.postProcessors[postProcessor.Name()]; exists {
+		return fmt.Errorf("%w: %s", ErrDuplicatePostProcessor, postProcessor.Name())
+	}
+
+	reserved := reservedKinds()
+
+	for _, kind := range postProcessor.Kinds() {
+		if reserved.ContainsOneOf(kind) {
+			return fmt.Errorf("%w: %s declares %s", ErrReservedKind, postProcessor.Name(), kind)
+		}
+
+		for _, registered := range s.postProcessors {
+			if registered.Kinds().ContainsOneOf(kind) {
+				return fmt.Errorf("%w: %s declares %s which is declared by %s", ErrClaimedKind, postProcessor.Name(), kind, registered.Name())
+			}
+		}
+	}
+
+	s.postProcessors[postProcessor.Name()] = postProcessor
+	return nil
+}
+
+// PostProcessors returns the registered post-processors in the order they are run.
+func (s *PostProcessorRegistry) PostProcessors() []PostProcessor {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	postProcessors := make([]PostProcessor, 0, len(s.postProcessors))
+
+	for _, postProcessor := range s.postProcessors {
+		postProcessors = append(postProcessors, postProcessor)
+	}
+
+	sort.Slice(postProcessors, func(i, j int) bool {
+		return postProcessors[i].Name() < postProcessors[j].Name()
+	})
+
+	return postProcessors
+}
+
+// PostProcessedRelationships returns the relationship kinds declared by all registered post-processors.
+func (s *PostProcessorRegistry) PostProcessedRelationships() graph.Kinds {
+	var kinds graph.Kinds
+
+	for _, postProcessor := range s.PostProcessors() {
+		kinds = kinds.Add(postProcessor.Kinds()...)
+	}
+
+	return kinds
+}
+
+// Run deletes the relationships of all registered post-processors between nodes of the given base kinds and then runs
+// each post-processor in turn. Cancellation of the given context is checked between post-processors.
+func (s *PostProcessorRegistry) Run(ctx context.Context, db graph.Database, baseKinds graph.Kinds) (*AtomicPostProcessingStats, error) {
+	aggregateStats := NewAtomicPostProcessingStats()
+
+	if postProcessedRelationships := s.PostProcessedRelationships(); len(postProcessedRelationships) == 0 {
+		return &aggregateStats, nil
+	} else if stats, err := DeleteTransitEdges(ctx, db, baseKinds, postProcessedRelationships...); err != nil {
+		return &aggregateStats, err
+	} else {
+		aggregateStats.Merge(stats)
+	}
+
+	defer measure.ContextMeasure(ctx, slog.LevelInfo, "Finished running registered post-processors")()
+
+	for _, postProcessor := range s.PostProcessors() {
+		if err := context.Cause(ctx); err != nil {
+			return &aggregateStats, err
+		} else if stats, err := postProcessor.Run(ctx, db); err != nil {
+			return &aggregateStats, fmt.Errorf("post-processor %s failed: %w", postProcessor.Name(), err)
+		} else {
+			aggregateStats.Merge(stats)
+		}
+	}
+
+	return &aggregateStats, nil
+}
+
+var defaultPostProcessors = NewPostProcessorRegistry()
+
+// RegisterPostProcessor adds the given post-processor to the default registry. External packages typically call this
+// from an init function.
+func RegisterPostProcessor(postProcessor PostProcessor) error {
+	return defaultPostProcessors.Register(postProcessor)
+}
+
+// RegisteredPostProcessors returns the default post-processor registry.
+func RegisteredPostProcessors() *PostProcessorRegistry {
+	return defaultPostProcessors
+}
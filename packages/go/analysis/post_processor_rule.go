@@ -0,0 +1,131 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analysis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/specterops/bloodhound/dawgs/graph"
+)
+
+const CypherRuleFileExtension = ".json"
+
+// CypherRule declares a post-processor that derives relationships of a single kind from a Cypher query. The query must
+// return the IDs of the start and end node of each relationship to create, in that order:
+//
+//	MATCH (s:User)-[:MemberOf]->(:Group {name: $name}) MATCH (t:Computer) RETURN id(s), id(t)
+type CypherRule struct {
+	Name       string         `json:"name"`
+	Kind       string         `json:"kind"`
+	Query      string         `json:"query"`
+	Parameters map[string]any `json:"parameters"`
+}
+
+// NewCypherPostProcessor returns a PostProcessor that creates a relationship of the rule's kind for each row returned
+// by the rule's query.
+func NewCypherPostProcessor(rule CypherRule) (PostProcessor, error) {
+	if strings.TrimSpace(rule.Name) == "" {
+		return nil, fmt.Errorf("%w: cypher rule name is empty", ErrInvalidPostProcessor)
+	} else if strings.TrimSpace(rule.Kind) == "" {
+		return nil, fmt.Errorf("%w: cypher rule %s has no relationship kind", ErrInvalidPostProcessor, rule.Name)
+	} else if strings.TrimSpace(rule.Query) == "" {
+		return nil, fmt.Errorf("%w: cypher rule %s has no query", ErrInvalidPostProcessor, rule.Name)
+	}
+
+	kind := graph.StringKind(rule.Kind)
+
+	return NewPostProcessor(rule.Name, graph.Kinds{kind}, func(ctx context.Context, tx graph.Transaction, outC chan<- CreatePostRelationshipJob) error {
+		var (
+			result       = tx.Query(rule.Query, rule.Parameters)
+			fromID, toID graph.ID
+		)
+
+		defer result.Close()
+
+		for result.Next() {
+			if err := result.Scan(&fromID, &toID); err != nil {
+				return fmt.Errorf("cypher rule %s returned an unexpected row: %w", rule.Name, err)
+			}
+
+			select {
+			case outC <- CreatePostRelationshipJob{
+				FromID: fromID,
+				ToID:   toID,
+				Kind:   kind,
+			}:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		return result.Error()
+	}), nil
+}
+
+// LoadCypherRules reads every rule file in the given directory. Each rule file contains a JSON array of CypherRule
+// objects.
+func LoadCypherRules(dir string) ([]CypherRule, error) {
+	var rules []CypherRule
+
+	if entries, err := os.ReadDir(dir); err != nil {
+		return nil, fmt.Errorf("failed to read cypher rule directory %s: %w", dir, err)
+	} else {
+		for _, entry := range entries {
+			if entry.IsDir() || filepath.Ext(entry.Name()) != CypherRuleFileExtension {
+				continue
+			}
+
+			var (
+				path      = filepath.Join(dir, entry.Name())
+				fileRules []CypherRule
+			)
+
+			if content, err := os.ReadFile(path); err != nil {
+				return nil, fmt.Errorf("failed to read cypher rule file %s: %w", path, err)
+			} else if err := json.Unmarshal(content, &fileRules); err != nil {
+				return nil, fmt.Errorf("failed to parse cypher rule file %s: %w", path, err)
+			}
+
+			rules = append(rules, fileRules...)
+		}
+	}
+
+	return rules, nil
+}
+
+// RegisterCypherRules loads the rule files in the given directory and adds a post-processor for each rule to the
+// registry.
+func (s *PostProcessorRegistry) RegisterCypherRules(dir string) error {
+	if rules, err := LoadCypherRules(dir); err != nil {
+		return err
+	} else {
+		for _, rule := range rules {
+			if postProcessor, err := NewCypherPostProcessor(rule); err != nil {
+				return err
+			} else if err := s.Register(postProcessor); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,208 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analysis_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/specterops/bloodhound/analysis"
+	adAnalysis "github.com/specterops/bloodhound/analysis/ad"
+	azureAnalysis "github.com/specterops/bloodhound/analysis/azure"
+	"github.com/specterops/bloodhound/dawgs"
+	"github.com/specterops/bloodhound/dawgs/drivers/memory"
+	"github.com/specterops/bloodhound/dawgs/graph"
+	"github.com/specterops/bloodhound/dawgs/ops"
+	"github.com/specterops/bloodhound/dawgs/query"
+	"github.com/specterops/bloodhound/graphschema/ad"
+	"github.com/specterops/bloodhound/graphschema/azure"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testBaseKind = graph.StringKind("Base")
+	testUser     = graph.StringKind("User")
+	testComputer = graph.StringKind("Computer")
+	testCanPwn   = graph.StringKind("CanPwn")
+	testCanOwn   = graph.StringKind("CanOwn")
+)
+
+func newPostProcessorTestGraph(t *testing.T) graph.Database {
+	var (
+		ctx     = context.Background()
+		db, err = dawgs.Open(ctx, memory.DriverName, dawgs.Config{})
+	)
+
+	require.Nil(t, err)
+	require.Nil(t, db.WriteTransaction(ctx, func(tx graph.Transaction) error {
+		if _, err := tx.CreateNode(graph.AsProperties(map[string]any{"name": "alice"}), testBaseKind, testUser); err != nil {
+			return err
+		} else if _, err := tx.CreateNode(graph.AsProperties(map[string]any{"name": "bob"}), testBaseKind, testUser); err != nil {
+			return err
+		} else {
+			_, err := tx.CreateNode(graph.AsProperties(map[string]any{"name": "ws01"}), testBaseKind, testComputer)
+			return err
+		}
+	}))
+
+	return db
+}
+
+func countRelationships(t *testing.T, db graph.Database, kind graph.Kind) int {
+	var count int
+
+	require.Nil(t, db.ReadTransaction(context.Background(), func(tx graph.Transaction) error {
+		relationships, err := ops.FetchRelationships(tx.Relationships().Filter(query.Kind(query.Relationship(), kind)))
+		count = len(relationships)
+		return err
+	}))
+
+	return count
+}
+
+func TestPostProcessorRegistry_Register(t *testing.T) {
+	var (
+		registry = analysis.NewPostProcessorRegistry()
+		noop     = func(ctx context.Context, tx graph.Transaction, outC chan<- analysis.CreatePostRelationshipJob) error {
+			return nil
+		}
+	)
+
+	require.ErrorIs(t, registry.Register(analysis.NewPostProcessor("", graph.Kinds{testCanPwn}, noop)), analysis.ErrInvalidPostProcessor)
+	require.ErrorIs(t, registry.Register(analysis.NewPostProcessor("b", nil, noop)), analysis.ErrInvalidPostProcessor)
+	require.Nil(t, registry.Register(analysis.NewPostProcessor("b", graph.Kinds{testCanPwn}, noop)))
+	require.Nil(t, registry.Register(analysis.NewPostProcessor("a", graph.Kinds{testCanOwn}, noop)))
+	require.ErrorIs(t, registry.Register(analysis.NewPostProcessor("a", graph.Kinds{testCanOwn}, noop)), analysis.ErrDuplicatePostProcessor)
+
+	postProcessors := registry.PostProcessors()
+	require.Len(t, postProcessors, 2)
+	require.Equal(t, "a", postProcessors[0].Name())
+	require.Equal(t, "b", postProcessors[1].Name())
+	require.Equal(t, graph.Kinds{testCanOwn, testCanPwn}, registry.PostProcessedRelationships())
+}
+
+func TestPostProcessorRegistry_RegisterReservedKind(t *testing.T) {
+	var (
+		registry = analysis.NewPostProcessorRegistry()
+		noop     = func(ctx context.Context, tx graph.Transaction, outC chan<- analysis.CreatePostRelationshipJob) error {
+			return nil
+		}
+	)
+
+	for _, kind := range []graph.Kind{ad.MemberOf, ad.AdminTo, ad.HasSession, azure.Contains} {
+		require.ErrorIs(t, registry.Register(analysis.NewPostProcessor("reserved", graph.Kinds{testCanPwn, kind}, noop)), analysis.ErrReservedKind)
+	}
+
+	for _, kind := range append(adAnalysis.PostProcessedRelationships(), azureAnalysis.PostProcessedRelationships()...) {
+		require.ErrorIs(t, registry.Register(analysis.NewPostProcessor("built-in", graph.Kinds{kind}, noop)), analysis.ErrReservedKind)
+	}
+
+	require.Empty(t, registry.PostProcessors())
+}
+
+func TestPostProcessorRegistry_RegisterClaimedKind(t *testing.T) {
+	var (
+		registry = analysis.NewPostProcessorRegistry()
+		noop     = func(ctx context.Context, tx graph.Transaction, outC chan<- analysis.CreatePostRelationshipJob) error {
+			return nil
+		}
+	)
+
+	require.Nil(t, registry.Register(analysis.NewPostProcessor("a", graph.Kinds{testCanPwn}, noop)))
+	require.ErrorIs(t, registry.Register(analysis.NewPostProcessor("b", graph.Kinds{testCanOwn, testCanPwn}, noop)), analysis.ErrClaimedKind)
+
+	postProcessors := registry.PostProcessors()
+	require.Len(t, postProcessors, 1)
+	require.Equal(t, "a", postProcessors[0].Name())
+}
+
+func TestPostProcessorRegistry_Run(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		db       = newPostProcessorTestGraph(t)
+		registry = analysis.NewPostProcessorRegistry()
+	)
+
+	postProcessor, err := analysis.NewCypherPostProcessor(analysis.CypherRule{
+		Name:       "users can pwn computers",
+		Kind:       testCanPwn.String(),
+		Query:      "match (s:User), (t:Computer {name: $name}) return id(s), id(t)",
+		Parameters: map[string]any{"name": "ws01"},
+	})
+
+	require.Nil(t, err)
+	require.Nil(t, registry.Register(postProcessor))
+
+	stats, err := registry.Run(ctx, db, graph.Kinds{testBaseKind})
+	require.Nil(t, err)
+	require.Equal(t, int32(2), *stats.RelationshipsCreated[testCanPwn])
+	require.Equal(t, 2, countRelationships(t, db, testCanPwn))
+
+	// Running again must replace the previously derived relationships rather than add to them
+	stats, err = registry.Run(ctx, db, graph.Kinds{testBaseKind})
+	require.Nil(t, err)
+	require.Equal(t, int32(2), *stats.RelationshipsDeleted[testCanPwn])
+	require.Equal(t, 2, countRelationships(t, db, testCanPwn))
+}
+
+func TestPostProcessorRegistry_RunUndeclaredKind(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		db       = newPostProcessorTestGraph(t)
+		registry = analysis.NewPostProcessorRegistry()
+	)
+
+	require.Nil(t, registry.Register(analysis.NewPostProcessor("undeclared", graph.Kinds{testCanPwn}, func(ctx context.Context, tx graph.Transaction, outC chan<- analysis.CreatePostRelationshipJob) error {
+		nodes, err := ops.FetchNodes(tx.Nodes())
+		if err != nil {
+			return err
+		}
+
+		for _, node := range nodes {
+			outC <- analysis.CreatePostRelationshipJob{
+				FromID: node.ID,
+				ToID:   node.ID,
+				Kind:   graph.StringKind("Undeclared"),
+			}
+		}
+
+		return nil
+	})))
+
+	_, err := registry.Run(ctx, db, graph.Kinds{testBaseKind})
+	require.ErrorContains(t, err, "undeclared relationship kind")
+}
+
+func TestLoadCypherRules(t *testing.T) {
+	dir := t.TempDir()
+
+	require.Nil(t, os.WriteFile(filepath.Join(dir, "rules.json"), []byte(`[{"name": "rule", "kind": "CanPwn", "query": "match (s), (t) return id(s), id(t)"}]`), 0644))
+	require.Nil(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("ignored"), 0644))
+
+	rules, err := analysis.LoadCypherRules(dir)
+	require.Nil(t, err)
+	require.Equal(t, []analysis.CypherRule{{Name: "rule", Kind: "CanPwn", Query: "match (s), (t) return id(s), id(t)"}}, rules)
+
+	registry := analysis.NewPostProcessorRegistry()
+	require.Nil(t, registry.RegisterCypherRules(dir))
+	require.Equal(t, graph.Kinds{testCanPwn}, registry.PostProcessedRelationships())
+
+	require.Nil(t, os.WriteFile(filepath.Join(dir, "invalid.json"), []byte(`[{"name": "invalid"}]`), 0644))
+	require.ErrorIs(t, analysis.NewPostProcessorRegistry().RegisterCypherRules(dir), analysis.ErrInvalidPostProcessor)
+}
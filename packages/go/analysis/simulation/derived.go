@@ -0,0 +1,260 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulation
+
+import (
+	"strings"
+
+	"github.com/specterops/bloodhound/analysis"
+	adAnalysis "github.com/specterops/bloodhound/analysis/ad"
+	"github.com/specterops/bloodhound/dawgs/cardinality"
+	"github.com/specterops/bloodhound/dawgs/graph"
+	"github.com/specterops/bloodhound/dawgs/ops"
+	"github.com/specterops/bloodhound/dawgs/query"
+	"github.com/specterops/bloodhound/graphschema/ad"
+	"github.com/specterops/bloodhound/graphschema/common"
+)
+
+// localGroupDerivations maps the object ID suffix of a computer's local group to the post-processed relationship that
+// local group post-processing creates from each direct member of the group to the computer.
+var localGroupDerivations = map[string]graph.Kind{
+	adAnalysis.AdminGroupSuffix:    ad.AdminTo,
+	adAnalysis.RDPGroupSuffix:      ad.CanRDP,
+	adAnalysis.PSRemoteGroupSuffix: ad.CanPSRemote,
+	adAnalysis.DCOMGroupSuffix:     ad.ExecuteDCOM,
+}
+
+// DeriveRemovals re-derives the post-processed relationships that depend on the relationships removed by the overlay.
+// Post-processed relationships that no longer hold are removed from the overlay and their IDs are returned.
+//
+// Two derivations are re-run: local group post-processing for removed MemberOfLocalGroup relationships and DCSync
+// post-processing for removed MemberOf, GetChanges and GetChangesAll relationships.
+func DeriveRemovals(tx graph.Transaction, overlay *Overlay) ([]graph.ID, error) {
+	var (
+		derived        = cardinality.NewBitmap64()
+		rederiveDCSync = false
+	)
+
+	for _, relationshipID := range overlay.RemovedRelationships() {
+		if relationship, err := ops.FetchRelationship(tx, relationshipID); err != nil {
+			return nil, err
+		} else if relationship.Kind.Is(ad.MemberOfLocalGroup) {
+			if localGroupRemovals, err := deriveLocalGroupRemovals(tx, overlay, relationship); err != nil {
+				return nil, err
+			} else {
+				derived.Or(localGroupRemovals)
+			}
+		} else if relationship.Kind.Is(ad.MemberOf, ad.GetChanges, ad.GetChangesAll) {
+			rederiveDCSync = true
+		}
+	}
+
+	if rederiveDCSync {
+		if dcSyncRemovals, err := deriveDCSyncRemovals(tx, overlay); err != nil {
+			return nil, err
+		} else {
+			derived.Or(dcSyncRemovals)
+		}
+	}
+
+	var derivedIDs []graph.ID
+
+	derived.Each(func(value uint64) bool {
+		if id := graph.ID(value); !overlay.IsRemoved(id) {
+			overlay.RemoveRelationship(id)
+			derivedIDs = append(derivedIDs, id)
+		}
+
+		return true
+	})
+
+	return derivedIDs, nil
+}
+
+// deriveLocalGroupRemovals returns the post-processed relationships granted by the given local group membership. A
+// relationship is kept when its principal remains a member of the local group, directly or through nested groups,
+// once the overlay is applied.
+func deriveLocalGroupRemovals(tx graph.Transaction, overlay *Overlay, membership *graph.Relationship) (cardinality.Duplex[uint64], error) {
+	removals := cardinality.NewBitmap64()
+
+	if localToComputer, err := tx.Relationships().Filterf(func() graph.Criteria {
+		return query.And(
+			query.Equals(query.StartID(), membership.EndID),
+			query.Kind(query.Relationship(), ad.LocalToComputer),
+		)
+	}).First(); err != nil {
+		if graph.IsErrNotFound(err) {
+			return removals, nil
+		}
+
+		return nil, err
+	} else if localGroup, err := ops.FetchNode(tx, membership.EndID); err != nil {
+		return nil, err
+	} else if objectID, err := localGroup.Properties.Get(common.ObjectID.String()).String(); err != nil {
+		return removals, nil
+	} else if remainingMembers, err := expandLocalGroupMembership(overlay.Transaction(tx), localGroup); err != nil {
+		return nil, err
+	} else if remainingMembers.Contains(membership.StartID.Uint64()) {
+		return removals, nil
+	} else {
+		for suffix, derivedKind := range localGroupDerivations {
+			if !strings.HasSuffix(objectID, suffix) {
+				continue
+			}
+
+			if derivedIDs, err := ops.FetchRelationshipIDs(tx.Relationships().Filterf(func() graph.Criteria {
+				return query.And(
+					query.Equals(query.StartID(), membership.StartID),
+					query.Equals(query.EndID(), localToComputer.EndID),
+					query.Kind(query.Relationship(), derivedKind),
+				)
+			})); err != nil {
+				return nil, err
+			} else {
+				for _, derivedID := range derivedIDs {
+					removals.Add(derivedID.Uint64())
+				}
+			}
+		}
+
+		return removals, nil
+	}
+}
+
+// expandLocalGroupMembership returns the IDs of all principals that are members of the given local group, either
+// directly or through nested group membership.
+func expandLocalGroupMembership(tx graph.Transaction, localGroup *graph.Node) (cardinality.Duplex[uint64], error) {
+	members := cardinality.NewBitmap64()
+
+	if membershipPaths, err := ops.TraversePaths(tx, ops.TraversalPlan{
+		Root:      localGroup,
+		Direction: graph.DirectionInbound,
+		BranchQuery: func() graph.Criteria {
+			return query.KindIn(query.Relationship(), ad.MemberOf, ad.MemberOfLocalGroup)
+		},
+	}); err != nil {
+		return nil, err
+	} else {
+		for _, node := range membershipPaths.AllNodes() {
+			if node.ID != localGroup.ID {
+				members.Add(node.ID.Uint64())
+			}
+		}
+	}
+
+	return members, nil
+}
+
+// deriveDCSyncRemovals re-runs the DCSync cross product for every domain with inbound DCSync relationships against the
+// overlay and returns the DCSync relationships that are no longer granted.
+func deriveDCSyncRemovals(tx graph.Transaction, overlay *Overlay) (cardinality.Duplex[uint64], error) {
+	var (
+		overlayTx      = overlay.Transaction(tx)
+		removals       = cardinality.NewBitmap64()
+		dcSyncByDomain = map[graph.ID][]graph.RelationshipTripleResult{}
+	)
+
+	if err := tx.Relationships().Filterf(func() graph.Criteria {
+		return query.Kind(query.Relationship(), ad.DCSync)
+	}).FetchTriples(func(cursor graph.Cursor[graph.RelationshipTripleResult]) error {
+		for next := range cursor.Chan() {
+			dcSyncByDomain[next.EndID] = append(dcSyncByDomain[next.EndID], next)
+		}
+
+		return cursor.Error()
+	}); err != nil {
+		return nil, err
+	}
+
+	if len(dcSyncByDomain) == 0 {
+		return removals, nil
+	}
+
+	specialGroups, err := adAnalysis.FetchAuthUsersAndEveryoneGroups(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	for domainID, dcSyncRelationships := range dcSyncByDomain {
+		domain := graph.NewNode(domainID, graph.NewProperties(), ad.Domain)
+
+		if syncers, constrained, err := unrollCrossProduct(overlayTx, specialGroups, domain, ad.GetChanges, ad.GetChangesAll); err != nil {
+			return nil, err
+		} else if constrained {
+			for _, dcSync := range dcSyncRelationships {
+				if !syncers.Contains(dcSync.StartID.Uint64()) {
+					removals.Add(dcSync.ID.Uint64())
+				}
+			}
+		}
+	}
+
+	return removals, nil
+}
+
+// unrollCrossProduct computes the principals that hold every one of the given relationship kinds against the target,
+// either directly or through group membership. Mirroring adAnalysis.CalculateCrossProductNodeSets, sets that contain
+// the Authenticated Users or Everyone groups do not constrain the result. The returned bool is false when no set
+// constrains the result.
+func unrollCrossProduct(tx graph.Transaction, specialGroups graph.NodeSet, target *graph.Node, kinds ...graph.Kind) (cardinality.Duplex[uint64], bool, error) {
+	var (
+		result      cardinality.Duplex[uint64]
+		constrained = false
+	)
+
+	for _, kind := range kinds {
+		unrolled := cardinality.NewBitmap64()
+
+		if startNodes, err := ops.FetchStartNodes(analysis.FromEntityToEntityWithRelationshipKind(tx, target, kind)); err != nil {
+			return nil, false, err
+		} else {
+			for _, startNode := range startNodes {
+				unrolled.Add(startNode.ID.Uint64())
+
+				if startNode.Kinds.ContainsOneOf(ad.Group, ad.LocalGroup) {
+					if members, err := adAnalysis.ExpandGroupMembershipIDBitmap(tx, startNode); err != nil {
+						return nil, false, err
+					} else {
+						unrolled.Add(members.ToArray()...)
+					}
+				}
+			}
+		}
+
+		hasSpecialGroup := false
+
+		for _, specialGroup := range specialGroups {
+			if unrolled.Contains(specialGroup.ID.Uint64()) {
+				hasSpecialGroup = true
+				break
+			}
+		}
+
+		if hasSpecialGroup {
+			continue
+		}
+
+		if !constrained {
+			result = unrolled
+			constrained = true
+		} else {
+			result.And(unrolled)
+		}
+	}
+
+	return result, constrained, nil
+}
@@ -0,0 +1,154 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/specterops/bloodhound/analysis/simulation"
+	"github.com/specterops/bloodhound/dawgs"
+	"github.com/specterops/bloodhound/dawgs/cardinality"
+	"github.com/specterops/bloodhound/dawgs/drivers/memory"
+	"github.com/specterops/bloodhound/dawgs/graph"
+	"github.com/specterops/bloodhound/graphschema/ad"
+	"github.com/specterops/bloodhound/graphschema/common"
+	"github.com/stretchr/testify/require"
+)
+
+type localAdminGraph struct {
+	db               graph.Database
+	userMembership   *graph.Relationship
+	userAdminTo      *graph.Relationship
+	nestedMembership *graph.Relationship
+}
+
+// newLocalAdminGraph creates a computer whose local administrators group has a user as a direct member. When nested is
+// true the user is also a member of a domain group that is itself a member of the local administrators group.
+func newLocalAdminGraph(t *testing.T, nested bool) localAdminGraph {
+	var (
+		ctx        = context.Background()
+		db, err    = dawgs.Open(ctx, memory.DriverName, dawgs.Config{})
+		adminGraph = localAdminGraph{
+			db: db,
+		}
+	)
+
+	require.Nil(t, err)
+	require.Nil(t, db.WriteTransaction(ctx, func(tx graph.Transaction) error {
+		newNode := func(objectID string, kinds ...graph.Kind) *graph.Node {
+			node, err := tx.CreateNode(graph.AsProperties(map[string]any{common.ObjectID.String(): objectID}), kinds...)
+			require.Nil(t, err)
+
+			return node
+		}
+
+		newRelationship := func(start, end *graph.Node, kind graph.Kind) *graph.Relationship {
+			relationship, err := tx.CreateRelationshipByIDs(start.ID, end.ID, kind, graph.NewProperties())
+			require.Nil(t, err)
+
+			return relationship
+		}
+
+		var (
+			user       = newNode("S-1-5-21-1-1000", ad.Entity, ad.User)
+			computer   = newNode("S-1-5-21-1-2000", ad.Entity, ad.Computer)
+			localGroup = newNode("S-1-5-21-1-2000-544", ad.Entity, ad.LocalGroup)
+		)
+
+		newRelationship(localGroup, computer, ad.LocalToComputer)
+		adminGraph.userMembership = newRelationship(user, localGroup, ad.MemberOfLocalGroup)
+		adminGraph.userAdminTo = newRelationship(user, computer, ad.AdminTo)
+
+		if nested {
+			group := newNode("S-1-5-21-1-3000", ad.Entity, ad.Group)
+
+			newRelationship(user, group, ad.MemberOf)
+			adminGraph.nestedMembership = newRelationship(group, localGroup, ad.MemberOfLocalGroup)
+			newRelationship(group, computer, ad.AdminTo)
+		}
+
+		return nil
+	}))
+
+	return adminGraph
+}
+
+func TestDeriveRemovals_LocalGroup(t *testing.T) {
+	var (
+		ctx        = context.Background()
+		adminGraph = newLocalAdminGraph(t, false)
+		overlay    = simulation.NewOverlay()
+	)
+
+	overlay.RemoveRelationship(adminGraph.userMembership.ID)
+
+	require.Nil(t, adminGraph.db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		derived, err := simulation.DeriveRemovals(tx, overlay)
+		require.Nil(t, err)
+		require.Equal(t, []graph.ID{adminGraph.userAdminTo.ID}, derived)
+		require.True(t, overlay.IsRemoved(adminGraph.userAdminTo.ID))
+
+		return nil
+	}))
+}
+
+func TestDeriveRemovals_LocalGroupRemainingNestedMembership(t *testing.T) {
+	var (
+		ctx        = context.Background()
+		adminGraph = newLocalAdminGraph(t, true)
+		overlay    = simulation.NewOverlay()
+	)
+
+	overlay.RemoveRelationship(adminGraph.userMembership.ID)
+
+	require.Nil(t, adminGraph.db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		// The user remains a member of the local administrators group through the nested domain group
+		derived, err := simulation.DeriveRemovals(tx, overlay)
+		require.Nil(t, err)
+		require.Empty(t, derived)
+		require.False(t, overlay.IsRemoved(adminGraph.userAdminTo.ID))
+
+		return nil
+	}))
+
+	overlay.RemoveRelationship(adminGraph.nestedMembership.ID)
+
+	require.Nil(t, adminGraph.db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		derived, err := simulation.DeriveRemovals(tx, overlay)
+		require.Nil(t, err)
+		require.Contains(t, derived, adminGraph.userAdminTo.ID)
+
+		return nil
+	}))
+}
+
+func TestOverlay_Disabled(t *testing.T) {
+	var (
+		overlay = simulation.NewOverlay()
+		current = cardinality.NewBitmap64()
+	)
+
+	current.Add(1, 2)
+
+	overlay.SetNodeProperty(2, common.Enabled.String(), true)
+	overlay.SetNodeProperty(3, common.Enabled.String(), false)
+	overlay.SetNodeProperty(4, common.Name.String(), "unrelated")
+
+	require.Equal(t, []uint64{1, 3}, overlay.Disabled(current).Slice())
+	require.Equal(t, []uint64{1, 2}, current.Slice())
+}
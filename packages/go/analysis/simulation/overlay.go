@@ -0,0 +1,448 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulation
+
+import (
+	"context"
+	"errors"
+
+	"github.com/specterops/bloodhound/dawgs/cardinality"
+	"github.com/specterops/bloodhound/dawgs/graph"
+	"github.com/specterops/bloodhound/dawgs/util/channels"
+	"github.com/specterops/bloodhound/dawgs/util/size"
+	"github.com/specterops/bloodhound/graphschema/common"
+)
+
+var (
+	ErrOverlayReadOnly    = errors.New("simulation overlay is read-only")
+	ErrOverlayUnsupported = errors.New("operation is not supported by the simulation overlay")
+)
+
+// Overlay is an in-memory set of hypothetical graph changes. An Overlay is applied on top of the reads of an existing
+// graph.Transaction: removed relationships are hidden from relationship queries and traversals while node property
+// changes are merged into every node fetched through the overlay. The underlying database is never written to.
+type Overlay struct {
+	removedRelationships cardinality.Duplex[uint64]
+	nodeProperties       map[graph.ID]*graph.Properties
+}
+
+func NewOverlay() *Overlay {
+	return &Overlay{
+		removedRelationships: cardinality.NewBitmap64(),
+		nodeProperties:       map[graph.ID]*graph.Properties{},
+	}
+}
+
+// RemoveRelationship hides the relationship with the given ID from all reads made through the overlay.
+func (s *Overlay) RemoveRelationship(id graph.ID) {
+	s.removedRelationships.Add(id.Uint64())
+}
+
+// IsRemoved returns true if the relationship with the given ID is hidden by the overlay.
+func (s *Overlay) IsRemoved(id graph.ID) bool {
+	return s.removedRelationships.Contains(id.Uint64())
+}
+
+// RemovedRelationships returns the IDs of all relationships hidden by the overlay.
+func (s *Overlay) RemovedRelationships() []graph.ID {
+	var ids = make([]graph.ID, 0, s.removedRelationships.Cardinality())
+
+	s.removedRelationships.Each(func(value uint64) bool {
+		ids = append(ids, graph.ID(value))
+		return true
+	})
+
+	return ids
+}
+
+// SetNodeProperty records a hypothetical property value for the node with the given ID.
+func (s *Overlay) SetNodeProperty(id graph.ID, key string, value any) {
+	if properties, hasProperties := s.nodeProperties[id]; hasProperties {
+		properties.Set(key, value)
+	} else {
+		s.nodeProperties[id] = graph.NewProperties().Set(key, value)
+	}
+}
+
+// Disabled returns the IDs of all nodes that are disabled once the overlay is applied to a graph in which the given
+// nodes are disabled. Nodes that the overlay enables are removed from the result.
+func (s *Overlay) Disabled(current cardinality.Duplex[uint64]) cardinality.Duplex[uint64] {
+	disabled := current.Clone()
+
+	for id, properties := range s.nodeProperties {
+		if enabled, err := properties.Get(common.Enabled.String()).Bool(); err != nil {
+			continue
+		} else if enabled {
+			disabled.Remove(id.Uint64())
+		} else {
+			disabled.Add(id.Uint64())
+		}
+	}
+
+	return disabled
+}
+
+func (s *Overlay) applyNode(node *graph.Node) *graph.Node {
+	if node == nil {
+		return nil
+	}
+
+	if changes, hasChanges := s.nodeProperties[node.ID]; hasChanges {
+		var properties *graph.Properties
+
+		if node.Properties != nil {
+			properties = node.Properties.Clone()
+		} else {
+			properties = graph.NewProperties()
+		}
+
+		for key, value := range changes.Map {
+			properties.Set(key, value)
+		}
+
+		return graph.NewNode(node.ID, properties, node.Kinds...)
+	}
+
+	return node
+}
+
+func (s *Overlay) applyPath(path graph.Path) (graph.Path, bool) {
+	for _, edge := range path.Edges {
+		if s.IsRemoved(edge.ID) {
+			return path, false
+		}
+	}
+
+	for idx, node := range path.Nodes {
+		path.Nodes[idx] = s.applyNode(node)
+	}
+
+	return path, true
+}
+
+// Transaction wraps the given transaction so that all reads made through it observe the overlay.
+func (s *Overlay) Transaction(tx graph.Transaction) graph.Transaction {
+	return &overlayTransaction{
+		overlay: s,
+		tx:      tx,
+	}
+}
+
+type overlayTransaction struct {
+	overlay *Overlay
+	tx      graph.Transaction
+}
+
+func (s *overlayTransaction) WithGraph(graphSchema graph.Graph) graph.Transaction {
+	return s.overlay.Transaction(s.tx.WithGraph(graphSchema))
+}
+
+func (s *overlayTransaction) CreateNode(properties *graph.Properties, kinds ...graph.Kind) (*graph.Node, error) {
+	return nil, ErrOverlayReadOnly
+}
+
+func (s *overlayTransaction) UpdateNode(node *graph.Node) error {
+	return ErrOverlayReadOnly
+}
+
+func (s *overlayTransaction) Nodes() graph.NodeQuery {
+	return &overlayNodeQuery{
+		overlay: s.overlay,
+		query:   s.tx.Nodes(),
+	}
+}
+
+func (s *overlayTransaction) CreateRelationshipByIDs(startNodeID, endNodeID graph.ID, kind graph.Kind, properties *graph.Properties) (*graph.Relationship, error) {
+	return nil, ErrOverlayReadOnly
+}
+
+func (s *overlayTransaction) UpdateRelationship(relationship *graph.Relationship) error {
+	return ErrOverlayReadOnly
+}
+
+func (s *overlayTransaction) Relationships() graph.RelationshipQuery {
+	return &overlayRelationshipQuery{
+		overlay: s.overlay,
+		query:   s.tx.Relationships(),
+	}
+}
+
+// Raw is not supported by the overlay since the results of raw queries can not be reliably filtered.
+func (s *overlayTransaction) Raw(query string, parameters map[string]any) graph.Result {
+	return graph.NewErrorResult(ErrOverlayUnsupported)
+}
+
+// Query is not supported by the overlay since the results of cypher queries can not be reliably filtered.
+func (s *overlayTransaction) Query(query string, parameters map[string]any) graph.Result {
+	return graph.NewErrorResult(ErrOverlayUnsupported)
+}
+
+func (s *overlayTransaction) Commit() error {
+	return ErrOverlayReadOnly
+}
+
+func (s *overlayTransaction) GraphQueryMemoryLimit() size.Size {
+	return s.tx.GraphQueryMemoryLimit()
+}
+
+type overlayNodeQuery struct {
+	overlay *Overlay
+	query   graph.NodeQuery
+}
+
+func (s *overlayNodeQuery) Filter(criteria graph.Criteria) graph.NodeQuery {
+	s.query = s.query.Filter(criteria)
+	return s
+}
+
+func (s *overlayNodeQuery) Filterf(criteriaDelegate graph.CriteriaProvider) graph.NodeQuery {
+	s.query = s.query.Filterf(criteriaDelegate)
+	return s
+}
+
+// Query hands the raw, unfiltered result of the query to the delegate. Node property changes are not applied.
+func (s *overlayNodeQuery) Query(delegate func(results graph.Result) error, finalCriteria ...graph.Criteria) error {
+	return s.query.Query(delegate, finalCriteria...)
+}
+
+func (s *overlayNodeQuery) Delete() error {
+	return ErrOverlayReadOnly
+}
+
+func (s *overlayNodeQuery) Update(properties *graph.Properties) error {
+	return ErrOverlayReadOnly
+}
+
+func (s *overlayNodeQuery) OrderBy(criteria ...graph.Criteria) graph.NodeQuery {
+	s.query = s.query.OrderBy(criteria...)
+	return s
+}
+
+func (s *overlayNodeQuery) Offset(skip int) graph.NodeQuery {
+	s.query = s.query.Offset(skip)
+	return s
+}
+
+func (s *overlayNodeQuery) Limit(limit int) graph.NodeQuery {
+	s.query = s.query.Limit(limit)
+	return s
+}
+
+func (s *overlayNodeQuery) Count() (int64, error) {
+	return s.query.Count()
+}
+
+func (s *overlayNodeQuery) First() (*graph.Node, error) {
+	if node, err := s.query.First(); err != nil {
+		return nil, err
+	} else {
+		return s.overlay.applyNode(node), nil
+	}
+}
+
+func (s *overlayNodeQuery) Fetch(delegate func(cursor graph.Cursor[*graph.Node]) error) error {
+	return s.query.Fetch(func(cursor graph.Cursor[*graph.Node]) error {
+		return relayCursor(cursor, delegate, func(node *graph.Node) (*graph.Node, bool) {
+			return s.overlay.applyNode(node), true
+		})
+	})
+}
+
+func (s *overlayNodeQuery) FetchIDs(delegate func(cursor graph.Cursor[graph.ID]) error) error {
+	return s.query.FetchIDs(delegate)
+}
+
+func (s *overlayNodeQuery) FetchKinds(delegate func(cursor graph.Cursor[graph.KindsResult]) error) error {
+	return s.query.FetchKinds(delegate)
+}
+
+type overlayRelationshipQuery struct {
+	overlay *Overlay
+	query   graph.RelationshipQuery
+}
+
+func (s *overlayRelationshipQuery) Filter(criteria graph.Criteria) graph.RelationshipQuery {
+	s.query = s.query.Filter(criteria)
+	return s
+}
+
+func (s *overlayRelationshipQuery) Filterf(criteriaDelegate graph.CriteriaProvider) graph.RelationshipQuery {
+	s.query = s.query.Filterf(criteriaDelegate)
+	return s
+}
+
+func (s *overlayRelationshipQuery) Update(properties *graph.Properties) error {
+	return ErrOverlayReadOnly
+}
+
+func (s *overlayRelationshipQuery) Delete() error {
+	return ErrOverlayReadOnly
+}
+
+func (s *overlayRelationshipQuery) OrderBy(criteria ...graph.Criteria) graph.RelationshipQuery {
+	s.query = s.query.OrderBy(criteria...)
+	return s
+}
+
+// Offset is applied by the underlying database before the overlay filters removed relationships. Paging through an
+// overlay may therefore return fewer results per page than requested.
+func (s *overlayRelationshipQuery) Offset(skip int) graph.RelationshipQuery {
+	s.query = s.query.Offset(skip)
+	return s
+}
+
+// Limit is applied by the underlying database before the overlay filters removed relationships. Paging through an
+// overlay may therefore return fewer results per page than requested.
+func (s *overlayRelationshipQuery) Limit(limit int) graph.RelationshipQuery {
+	s.query = s.query.Limit(limit)
+	return s
+}
+
+func (s *overlayRelationshipQuery) Count() (int64, error) {
+	var count int64
+
+	return count, s.FetchIDs(func(cursor graph.Cursor[graph.ID]) error {
+		for range cursor.Chan() {
+			count++
+		}
+
+		return cursor.Error()
+	})
+}
+
+func (s *overlayRelationshipQuery) First() (*graph.Relationship, error) {
+	var first *graph.Relationship
+
+	if err := s.Fetch(func(cursor graph.Cursor[*graph.Relationship]) error {
+		if next, hasNext := <-cursor.Chan(); hasNext {
+			first = next
+		}
+
+		return cursor.Error()
+	}); err != nil {
+		return nil, err
+	} else if first == nil {
+		return nil, graph.ErrNoResultsFound
+	}
+
+	return first, nil
+}
+
+// Query hands the raw, unfiltered result of the query to the delegate. Removed relationships are not filtered.
+func (s *overlayRelationshipQuery) Query(delegate func(results graph.Result) error, finalCriteria ...graph.Criteria) error {
+	return s.query.Query(delegate, finalCriteria...)
+}
+
+func (s *overlayRelationshipQuery) Fetch(delegate func(cursor graph.Cursor[*graph.Relationship]) error) error {
+	return s.query.Fetch(func(cursor graph.Cursor[*graph.Relationship]) error {
+		return relayCursor(cursor, delegate, func(relationship *graph.Relationship) (*graph.Relationship, bool) {
+			return relationship, !s.overlay.IsRemoved(relationship.ID)
+		})
+	})
+}
+
+func (s *overlayRelationshipQuery) FetchDirection(direction graph.Direction, delegate func(cursor graph.Cursor[graph.DirectionalResult]) error) error {
+	return s.query.FetchDirection(direction, func(cursor graph.Cursor[graph.DirectionalResult]) error {
+		return relayCursor(cursor, delegate, func(result graph.DirectionalResult) (graph.DirectionalResult, bool) {
+			if s.overlay.IsRemoved(result.Relationship.ID) {
+				return result, false
+			}
+
+			result.Node = s.overlay.applyNode(result.Node)
+			return result, true
+		})
+	})
+}
+
+func (s *overlayRelationshipQuery) FetchIDs(delegate func(cursor graph.Cursor[graph.ID]) error) error {
+	return s.query.FetchIDs(func(cursor graph.Cursor[graph.ID]) error {
+		return relayCursor(cursor, delegate, func(id graph.ID) (graph.ID, bool) {
+			return id, !s.overlay.IsRemoved(id)
+		})
+	})
+}
+
+func (s *overlayRelationshipQuery) FetchTriples(delegate func(cursor graph.Cursor[graph.RelationshipTripleResult]) error) error {
+	return s.query.FetchTriples(func(cursor graph.Cursor[graph.RelationshipTripleResult]) error {
+		return relayCursor(cursor, delegate, func(triple graph.RelationshipTripleResult) (graph.RelationshipTripleResult, bool) {
+			return triple, !s.overlay.IsRemoved(triple.ID)
+		})
+	})
+}
+
+// FetchAllShortestPaths drops any shortest path that contains a removed relationship. Longer paths that would
+// become the new shortest paths once the relationship is removed are not discovered.
+func (s *overlayRelationshipQuery) FetchAllShortestPaths(delegate func(cursor graph.Cursor[graph.Path]) error) error {
+	return s.query.FetchAllShortestPaths(func(cursor graph.Cursor[graph.Path]) error {
+		return relayCursor(cursor, delegate, s.overlay.applyPath)
+	})
+}
+
+func (s *overlayRelationshipQuery) FetchKinds(delegate func(cursor graph.Cursor[graph.RelationshipKindsResult]) error) error {
+	return s.query.FetchKinds(func(cursor graph.Cursor[graph.RelationshipKindsResult]) error {
+		return relayCursor(cursor, delegate, func(result graph.RelationshipKindsResult) (graph.RelationshipKindsResult, bool) {
+			return result, !s.overlay.IsRemoved(result.ID)
+		})
+	})
+}
+
+// overlayCursor relays the values of a database cursor through a delegate that may rewrite or drop each value.
+type overlayCursor[T any] struct {
+	cursor     graph.Cursor[T]
+	valueC     chan T
+	cancelFunc func()
+}
+
+// relayCursor hands an overlayCursor wrapping the given cursor to the delegate. The relay is stopped once the delegate
+// returns so that delegates that do not exhaust the cursor do not leak the relay.
+func relayCursor[T any](cursor graph.Cursor[T], delegate func(cursor graph.Cursor[T]) error, filter func(value T) (T, bool)) error {
+	var (
+		ctx, cancelFunc = context.WithCancel(context.Background())
+		overlay         = &overlayCursor[T]{
+			cursor:     cursor,
+			valueC:     make(chan T),
+			cancelFunc: cancelFunc,
+		}
+	)
+
+	defer cancelFunc()
+
+	go func() {
+		defer close(overlay.valueC)
+
+		for next := range cursor.Chan() {
+			if value, include := filter(next); include && !channels.Submit(ctx, overlay.valueC, value) {
+				return
+			}
+		}
+	}()
+
+	return delegate(overlay)
+}
+
+func (s *overlayCursor[T]) Error() error {
+	return s.cursor.Error()
+}
+
+func (s *overlayCursor[T]) Close() {
+	s.cancelFunc()
+	s.cursor.Close()
+}
+
+func (s *overlayCursor[T]) Chan() chan T {
+	return s.valueC
+}
@@ -0,0 +1,183 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulation_test
+
+import (
+	"testing"
+
+	"github.com/specterops/bloodhound/analysis/simulation"
+	"github.com/specterops/bloodhound/dawgs/cardinality"
+	"github.com/specterops/bloodhound/dawgs/graph"
+	graph_mocks "github.com/specterops/bloodhound/dawgs/graph/mocks"
+	"github.com/specterops/bloodhound/graphschema/ad"
+	"github.com/specterops/bloodhound/graphschema/common"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func cursorOf[T any](ctrl *gomock.Controller, values ...T) graph.Cursor[T] {
+	var (
+		cursor = graph_mocks.NewMockCursor[T](ctrl)
+		valueC = make(chan T, len(values))
+	)
+
+	for _, value := range values {
+		valueC <- value
+	}
+
+	close(valueC)
+
+	cursor.EXPECT().Chan().Return(valueC).AnyTimes()
+	cursor.EXPECT().Error().Return(nil).AnyTimes()
+	cursor.EXPECT().Close().AnyTimes()
+
+	return cursor
+}
+
+func TestOverlay_RelationshipsHidesRemoved(t *testing.T) {
+	var (
+		mockCtrl  = gomock.NewController(t)
+		mockTx    = graph_mocks.NewMockTransaction(mockCtrl)
+		mockQuery = graph_mocks.NewMockRelationshipQuery(mockCtrl)
+		overlay   = simulation.NewOverlay()
+	)
+
+	overlay.RemoveRelationship(2)
+
+	mockTx.EXPECT().Relationships().Return(mockQuery).AnyTimes()
+	mockQuery.EXPECT().FetchTriples(gomock.Any()).DoAndReturn(func(delegate func(cursor graph.Cursor[graph.RelationshipTripleResult]) error) error {
+		return delegate(cursorOf(mockCtrl,
+			graph.RelationshipTripleResult{ID: 1, StartID: 10, EndID: 20},
+			graph.RelationshipTripleResult{ID: 2, StartID: 11, EndID: 20},
+			graph.RelationshipTripleResult{ID: 3, StartID: 12, EndID: 20},
+		))
+	})
+
+	var seen []graph.ID
+
+	require.Nil(t, overlay.Transaction(mockTx).Relationships().FetchTriples(func(cursor graph.Cursor[graph.RelationshipTripleResult]) error {
+		for next := range cursor.Chan() {
+			seen = append(seen, next.ID)
+		}
+
+		return cursor.Error()
+	}))
+
+	require.Equal(t, []graph.ID{1, 3}, seen)
+}
+
+func TestOverlay_RelationshipsFirstSkipsRemoved(t *testing.T) {
+	var (
+		mockCtrl  = gomock.NewController(t)
+		mockTx    = graph_mocks.NewMockTransaction(mockCtrl)
+		mockQuery = graph_mocks.NewMockRelationshipQuery(mockCtrl)
+		overlay   = simulation.NewOverlay()
+	)
+
+	overlay.RemoveRelationship(1)
+
+	mockTx.EXPECT().Relationships().Return(mockQuery).AnyTimes()
+	mockQuery.EXPECT().Fetch(gomock.Any()).DoAndReturn(func(delegate func(cursor graph.Cursor[*graph.Relationship]) error) error {
+		return delegate(cursorOf(mockCtrl, graph.NewRelationship(1, 10, 20, nil, ad.MemberOf)))
+	})
+
+	_, err := overlay.Transaction(mockTx).Relationships().First()
+	require.ErrorIs(t, err, graph.ErrNoResultsFound)
+}
+
+func TestOverlay_NodesApplyPropertyChanges(t *testing.T) {
+	var (
+		mockCtrl  = gomock.NewController(t)
+		mockTx    = graph_mocks.NewMockTransaction(mockCtrl)
+		mockQuery = graph_mocks.NewMockNodeQuery(mockCtrl)
+		overlay   = simulation.NewOverlay()
+		original  = graph.NewNode(7, graph.NewProperties().Set(common.Enabled.String(), true), ad.Entity, ad.User)
+	)
+
+	overlay.SetNodeProperty(7, common.Enabled.String(), false)
+
+	mockTx.EXPECT().Nodes().Return(mockQuery)
+	mockQuery.EXPECT().First().Return(original, nil)
+
+	node, err := overlay.Transaction(mockTx).Nodes().First()
+	require.Nil(t, err)
+
+	enabled, err := node.Properties.Get(common.Enabled.String()).Bool()
+	require.Nil(t, err)
+	require.False(t, enabled)
+
+	originalEnabled, err := original.Properties.Get(common.Enabled.String()).Bool()
+	require.Nil(t, err)
+	require.True(t, originalEnabled, "the fetched node must not be mutated")
+
+	require.True(t, overlay.Disabled(cardinality.NewBitmap64()).Contains(7))
+}
+
+func TestOverlay_ReadOnly(t *testing.T) {
+	var (
+		mockCtrl = gomock.NewController(t)
+		mockTx   = graph_mocks.NewMockTransaction(mockCtrl)
+		tx       = simulation.NewOverlay().Transaction(mockTx)
+	)
+
+	_, err := tx.CreateNode(graph.NewProperties(), ad.User)
+	require.ErrorIs(t, err, simulation.ErrOverlayReadOnly)
+	require.ErrorIs(t, tx.UpdateNode(graph.NewNode(1, graph.NewProperties())), simulation.ErrOverlayReadOnly)
+	require.ErrorIs(t, tx.Raw("match (n) return n", nil).Error(), simulation.ErrOverlayUnsupported)
+}
+
+func TestPrincipalsWithPathTo(t *testing.T) {
+	var (
+		mockCtrl       = gomock.NewController(t)
+		mockTx         = graph_mocks.NewMockTransaction(mockCtrl)
+		mockRelQuery   = graph_mocks.NewMockRelationshipQuery(mockCtrl)
+		mockNodeQuery  = graph_mocks.NewMockNodeQuery(mockCtrl)
+		relationshipsC = [][]graph.RelationshipTripleResult{
+			// Depth 1: 2 and 3 have an edge to tier zero node 1
+			{{ID: 100, StartID: 2, EndID: 1}, {ID: 101, StartID: 3, EndID: 1}},
+			// Depth 2: 4 reaches 2, 5 reaches 3 but is blocked
+			{{ID: 102, StartID: 4, EndID: 2}, {ID: 103, StartID: 5, EndID: 3}},
+			// Depth 3: nothing further
+			{},
+		}
+		blocked = simulation.NewOverlay()
+	)
+
+	blocked.SetNodeProperty(5, common.Enabled.String(), false)
+
+	mockTx.EXPECT().Relationships().Return(mockRelQuery).Times(3)
+	mockRelQuery.EXPECT().Filterf(gomock.Any()).Return(mockRelQuery).Times(3)
+
+	depth := 0
+	mockRelQuery.EXPECT().FetchTriples(gomock.Any()).Times(3).DoAndReturn(func(delegate func(cursor graph.Cursor[graph.RelationshipTripleResult]) error) error {
+		next := relationshipsC[depth]
+		depth++
+
+		return delegate(cursorOf(mockCtrl, next...))
+	})
+
+	mockTx.EXPECT().Nodes().Return(mockNodeQuery)
+	mockNodeQuery.EXPECT().Filterf(gomock.Any()).Return(mockNodeQuery)
+	mockNodeQuery.EXPECT().FetchIDs(gomock.Any()).DoAndReturn(func(delegate func(cursor graph.Cursor[graph.ID]) error) error {
+		// Node 3 is not a principal
+		return delegate(cursorOf[graph.ID](mockCtrl, 2, 4))
+	})
+
+	principals, err := simulation.PrincipalsWithPathTo(mockTx, []graph.ID{1}, blocked.Disabled(cardinality.NewBitmap64()))
+	require.Nil(t, err)
+	require.Equal(t, []uint64{2, 4}, principals.Slice())
+}
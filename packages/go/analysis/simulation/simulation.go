@@ -0,0 +1,327 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/specterops/bloodhound/analysis"
+	adAnalysis "github.com/specterops/bloodhound/analysis/ad"
+	"github.com/specterops/bloodhound/bhlog/measure"
+	"github.com/specterops/bloodhound/dawgs/cardinality"
+	"github.com/specterops/bloodhound/dawgs/graph"
+	"github.com/specterops/bloodhound/dawgs/ops"
+	"github.com/specterops/bloodhound/dawgs/query"
+	"github.com/specterops/bloodhound/graphschema/ad"
+	"github.com/specterops/bloodhound/graphschema/azure"
+	"github.com/specterops/bloodhound/graphschema/common"
+	"github.com/specterops/bloodhound/slicesext"
+)
+
+const (
+	// DefaultReportLimit is the maximum number of principals listed in a Report when the Changes do not specify one.
+	DefaultReportLimit = 100
+
+	// frontierBatchSize bounds the number of node IDs sent to the database in a single traversal expansion query.
+	frontierBatchSize = 1000
+)
+
+var (
+	ErrNodeNotFound         = errors.New("simulation node not found")
+	ErrRelationshipNotFound = errors.New("simulation relationship not found")
+	ErrNoChanges            = errors.New("simulation requires at least one change")
+)
+
+// PrincipalKinds are the node kinds counted as principals when measuring the reachability of tier zero.
+func PrincipalKinds() graph.Kinds {
+	return graph.Kinds{ad.User, ad.Computer, ad.Group, azure.User, azure.Group, azure.ServicePrincipal}
+}
+
+// TraversalKinds are the relationship kinds followed when measuring the reachability of tier zero.
+func TraversalKinds() graph.Kinds {
+	return slicesext.Concat(ad.PathfindingRelationships(), azure.PathfindingRelationships())
+}
+
+// RelationshipRemoval identifies a relationship to remove by the object IDs of its start and end nodes and its kind.
+type RelationshipRemoval struct {
+	StartObjectID string
+	EndObjectID   string
+	Kind          graph.Kind
+}
+
+// NodeChange describes hypothetical property values for the node identified by ObjectID. Setting the enabled
+// property to false disables the node: it may no longer act on its outbound relationships.
+type NodeChange struct {
+	ObjectID   string
+	Properties map[string]any
+}
+
+// Changes is the set of hypothetical graph changes to simulate.
+type Changes struct {
+	RemovedRelationships []RelationshipRemoval
+	NodeChanges          []NodeChange
+	ReportLimit          int
+}
+
+// Report summarizes the effect of simulated Changes on the reachability of tier zero.
+type Report struct {
+	// RemovedRelationships are the IDs of the relationships explicitly removed by the simulated changes.
+	RemovedRelationships []graph.ID
+
+	// DerivedRelationships are the IDs of post-processed relationships that no longer hold once the simulated
+	// changes are applied.
+	DerivedRelationships []graph.ID
+
+	// PrincipalsWithPathBefore is the number of principals with a path to tier zero in the current graph.
+	PrincipalsWithPathBefore int
+
+	// PrincipalsWithPathAfter is the number of principals with a path to tier zero once the changes are applied.
+	PrincipalsWithPathAfter int
+
+	// PrincipalsLosingPath is the number of principals whose paths to tier zero are all cut by the changes.
+	PrincipalsLosingPath int
+
+	// LostPrincipals lists up to the report limit of the principals counted by PrincipalsLosingPath.
+	LostPrincipals graph.NodeSet
+}
+
+// Simulate applies the given changes to an in-memory overlay of the graph and reports how many principals lose their
+// paths to tier zero as a result. Post-processed relationships that are composed from removed relationships are
+// re-derived against the overlay. The graph database is only read from.
+func Simulate(ctx context.Context, db graph.Database, changes Changes) (Report, error) {
+	defer measure.ContextMeasure(ctx, slog.LevelInfo, "Remediation simulation")()
+
+	if len(changes.RemovedRelationships) == 0 && len(changes.NodeChanges) == 0 {
+		return Report{}, ErrNoChanges
+	}
+
+	reportLimit := changes.ReportLimit
+	if reportLimit <= 0 {
+		reportLimit = DefaultReportLimit
+	}
+
+	var report Report
+
+	return report, db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		overlay := NewOverlay()
+
+		if removed, err := applyChanges(tx, overlay, changes); err != nil {
+			return err
+		} else if derived, err := DeriveRemovals(tx, overlay); err != nil {
+			return fmt.Errorf("failed re-deriving post-processed relationships: %w", err)
+		} else if tierZero, err := fetchTierZeroNodeIDs(tx); err != nil {
+			return fmt.Errorf("failed fetching tier zero nodes: %w", err)
+		} else if disabled, err := fetchDisabledNodeIDs(tx); err != nil {
+			return fmt.Errorf("failed fetching disabled principals: %w", err)
+		} else if before, err := PrincipalsWithPathTo(tx, tierZero, disabled); err != nil {
+			return fmt.Errorf("failed measuring current tier zero reachability: %w", err)
+		} else if after, err := PrincipalsWithPathTo(overlay.Transaction(tx), tierZero, overlay.Disabled(disabled)); err != nil {
+			return fmt.Errorf("failed measuring simulated tier zero reachability: %w", err)
+		} else {
+			lost := before.Clone()
+			lost.AndNot(after)
+
+			report.RemovedRelationships = removed
+			report.DerivedRelationships = derived
+			report.PrincipalsWithPathBefore = int(before.Cardinality())
+			report.PrincipalsWithPathAfter = int(after.Cardinality())
+			report.PrincipalsLosingPath = int(lost.Cardinality())
+
+			lostIDs := lost.Slice()
+			if len(lostIDs) > reportLimit {
+				lostIDs = lostIDs[:reportLimit]
+			}
+
+			if len(lostIDs) == 0 {
+				report.LostPrincipals = graph.NewNodeSet()
+			} else if lostPrincipals, err := ops.FetchNodeSet(tx.Nodes().Filterf(func() graph.Criteria {
+				return query.InIDs(query.NodeID(), adAnalysis.Uint64ToIDSlice(lostIDs)...)
+			})); err != nil {
+				return fmt.Errorf("failed fetching principals losing paths: %w", err)
+			} else {
+				report.LostPrincipals = lostPrincipals
+			}
+
+			return nil
+		}
+	})
+}
+
+// applyChanges resolves the object IDs referenced by the given changes and records them in the overlay. The IDs of the
+// removed relationships are returned.
+func applyChanges(tx graph.Transaction, overlay *Overlay, changes Changes) ([]graph.ID, error) {
+	var removed []graph.ID
+
+	for _, removal := range changes.RemovedRelationships {
+		if start, err := analysis.FetchNodeByObjectID(tx, removal.StartObjectID); err != nil {
+			return nil, resolveError(ErrNodeNotFound, removal.StartObjectID, err)
+		} else if end, err := analysis.FetchNodeByObjectID(tx, removal.EndObjectID); err != nil {
+			return nil, resolveError(ErrNodeNotFound, removal.EndObjectID, err)
+		} else if relationshipIDs, err := ops.FetchRelationshipIDs(tx.Relationships().Filterf(func() graph.Criteria {
+			return query.And(
+				query.Equals(query.StartID(), start.ID),
+				query.Equals(query.EndID(), end.ID),
+				query.Kind(query.Relationship(), removal.Kind),
+			)
+		})); err != nil {
+			return nil, err
+		} else if len(relationshipIDs) == 0 {
+			return nil, fmt.Errorf("%w: (%s)-[%s]->(%s)", ErrRelationshipNotFound, removal.StartObjectID, removal.Kind, removal.EndObjectID)
+		} else {
+			for _, relationshipID := range relationshipIDs {
+				overlay.RemoveRelationship(relationshipID)
+			}
+
+			removed = append(removed, relationshipIDs...)
+		}
+	}
+
+	for _, change := range changes.NodeChanges {
+		if node, err := analysis.FetchNodeByObjectID(tx, change.ObjectID); err != nil {
+			return nil, resolveError(ErrNodeNotFound, change.ObjectID, err)
+		} else {
+			for key, value := range change.Properties {
+				overlay.SetNodeProperty(node.ID, key, value)
+			}
+		}
+	}
+
+	return removed, nil
+}
+
+func resolveError(sentinel error, objectID string, err error) error {
+	if graph.IsErrNotFound(err) {
+		return fmt.Errorf("%w: %s", sentinel, objectID)
+	}
+
+	return err
+}
+
+func fetchTierZeroNodeIDs(tx graph.Transaction) ([]graph.ID, error) {
+	return ops.FetchNodeIDs(tx.Nodes().Filterf(func() graph.Criteria {
+		return query.And(
+			query.KindIn(query.Node(), ad.Entity, azure.Entity),
+			query.StringContains(query.NodeProperty(common.SystemTags.String()), ad.AdminTierZero),
+		)
+	}))
+}
+
+// fetchDisabledNodeIDs returns the IDs of the principals that are already disabled in the graph.
+func fetchDisabledNodeIDs(tx graph.Transaction) (cardinality.Duplex[uint64], error) {
+	disabled := cardinality.NewBitmap64()
+
+	if err := tx.Nodes().Filterf(func() graph.Criteria {
+		return query.And(
+			query.KindIn(query.Node(), PrincipalKinds()...),
+			query.Equals(query.NodeProperty(common.Enabled.String()), false),
+		)
+	}).FetchIDs(func(cursor graph.Cursor[graph.ID]) error {
+		for next := range cursor.Chan() {
+			disabled.Add(next.Uint64())
+		}
+
+		return cursor.Error()
+	}); err != nil {
+		return nil, err
+	}
+
+	return disabled, nil
+}
+
+// PrincipalsWithPathTo returns the IDs of all principals, excluding the targets themselves, that have a path to any of
+// the given targets. Nodes contained in blocked may not act on their outbound relationships and are therefore neither
+// counted nor traversed through.
+func PrincipalsWithPathTo(tx graph.Transaction, targets []graph.ID, blocked cardinality.Duplex[uint64]) (cardinality.Duplex[uint64], error) {
+	var (
+		traversalKinds = TraversalKinds()
+		visited        = cardinality.NewBitmap64()
+		reached        = cardinality.NewBitmap64()
+		frontier       = make([]graph.ID, 0, len(targets))
+	)
+
+	for _, target := range targets {
+		if visited.CheckedAdd(target.Uint64()) {
+			frontier = append(frontier, target)
+		}
+	}
+
+	for len(frontier) > 0 {
+		var nextFrontier []graph.ID
+
+		for batchStart := 0; batchStart < len(frontier); batchStart += frontierBatchSize {
+			batch := frontier[batchStart:min(batchStart+frontierBatchSize, len(frontier))]
+
+			if err := tx.Relationships().Filterf(func() graph.Criteria {
+				return query.And(
+					query.InIDs(query.EndID(), batch...),
+					query.KindIn(query.Relationship(), traversalKinds...),
+				)
+			}).FetchTriples(func(cursor graph.Cursor[graph.RelationshipTripleResult]) error {
+				for next := range cursor.Chan() {
+					if blocked != nil && blocked.Contains(next.StartID.Uint64()) {
+						continue
+					}
+
+					if visited.CheckedAdd(next.StartID.Uint64()) {
+						reached.Add(next.StartID.Uint64())
+						nextFrontier = append(nextFrontier, next.StartID)
+					}
+				}
+
+				return cursor.Error()
+			}); err != nil {
+				return nil, err
+			}
+		}
+
+		frontier = nextFrontier
+	}
+
+	return filterPrincipals(tx, reached)
+}
+
+func filterPrincipals(tx graph.Transaction, candidates cardinality.Duplex[uint64]) (cardinality.Duplex[uint64], error) {
+	var (
+		principalKinds = PrincipalKinds()
+		principals     = cardinality.NewBitmap64()
+		candidateIDs   = adAnalysis.Uint64ToIDSlice(candidates.Slice())
+	)
+
+	for batchStart := 0; batchStart < len(candidateIDs); batchStart += frontierBatchSize {
+		batch := candidateIDs[batchStart:min(batchStart+frontierBatchSize, len(candidateIDs))]
+
+		if err := tx.Nodes().Filterf(func() graph.Criteria {
+			return query.And(
+				query.InIDs(query.NodeID(), batch...),
+				query.KindIn(query.Node(), principalKinds...),
+			)
+		}).FetchIDs(func(cursor graph.Cursor[graph.ID]) error {
+			for next := range cursor.Chan() {
+				principals.Add(next.Uint64())
+			}
+
+			return cursor.Error()
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return principals, nil
+}
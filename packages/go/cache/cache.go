@@ -20,6 +20,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"sync"
+	"sync/atomic"
 
 	lru "github.com/hashicorp/golang-lru"
 )
@@ -48,9 +50,96 @@ type Config struct {
 	MaxSize int // Max size of cache in number of items
 }
 
+// Stats counts the lookups made against a Cache along with the entries it has removed. Evictions counts entries removed
+// to make room for new entries while Invalidations counts entries removed by Invalidate.
+type Stats struct {
+	Hits          uint64
+	Misses        uint64
+	Evictions     uint64
+	Invalidations uint64
+}
+
+// tagIndex maps the tags of cache entries to their keys. Changes to the underlying cache are made while holding the
+// index lock so that the eviction callback, which runs on the goroutine that changed the cache, may update the index
+// without locking. The index also records the keys whose entries were removed by Invalidate or Reset and have not been
+// set since, as only those keys are warmed.
+type tagIndex struct {
+	lock        sync.Mutex
+	keysByTag   map[string]map[string]struct{}
+	tagsByKey   map[string][]string
+	invalidated map[string]struct{}
+}
+
+func newTagIndex() *tagIndex {
+	return &tagIndex{
+		keysByTag:   map[string]map[string]struct{}{},
+		tagsByKey:   map[string][]string{},
+		invalidated: map[string]struct{}{},
+	}
+}
+
+// tag replaces the tags of the given key. The index lock must be held.
+func (s *tagIndex) tag(key string, tags []string) {
+	s.untag(key)
+
+	if len(tags) == 0 {
+		return
+	}
+
+	for _, tag := range tags {
+		if keys, found := s.keysByTag[tag]; found {
+			keys[key] = struct{}{}
+		} else {
+			s.keysByTag[tag] = map[string]struct{}{key: {}}
+		}
+	}
+
+	s.tagsByKey[key] = tags
+}
+
+// untag removes the given key from the index. The index lock must be held.
+func (s *tagIndex) untag(key string) {
+	for _, tag := range s.tagsByKey[key] {
+		if keys, found := s.keysByTag[tag]; found {
+			delete(keys, key)
+
+			if len(keys) == 0 {
+				delete(s.keysByTag, tag)
+			}
+		}
+	}
+
+	delete(s.tagsByKey, key)
+}
+
+// keys returns the keys of the entries that carry any of the given tags. The index lock must be held.
+func (s *tagIndex) keys(tags []string) []string {
+	var (
+		seen = map[string]struct{}{}
+		keys []string
+	)
+
+	for _, tag := range tags {
+		for key := range s.keysByTag[tag] {
+			if _, isSeen := seen[key]; !isSeen {
+				seen[key] = struct{}{}
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	return keys
+}
+
 // Cache wraps our underlying cache implementation.
 type Cache struct {
-	lru *lru.Cache
+	lru           *lru.Cache
+	index         *tagIndex
+	warmers       *lru.Cache
+	hits          *atomic.Uint64
+	misses        *atomic.Uint64
+	evictions     *atomic.Uint64
+	invalidations *atomic.Uint64
 }
 
 func get(cache *lru.Cache, key string, value any) (bool, error) {
@@ -63,11 +152,21 @@ func get(cache *lru.Cache, key string, value any) (bool, error) {
 	}
 }
 
-func set(cache *lru.Cache, key string, value any) (int, bool, error) {
+func (s Cache) set(key string, value any, tags []string) (int, bool, error) {
 	if cachedJSON, err := json.Marshal(value); err != nil {
 		return 0, false, fmt.Errorf("error marshalling value: %w", err)
 	} else {
-		eviction := cache.Add(key, cachedJSON)
+		s.index.lock.Lock()
+		defer s.index.lock.Unlock()
+
+		eviction := s.lru.Add(key, cachedJSON)
+		s.index.tag(key, tags)
+		delete(s.index.invalidated, key)
+
+		if eviction {
+			s.evictions.Add(1)
+		}
+
 		// Return the size of the cached value to aid in logging
 		return len(cachedJSON), eviction, nil
 	}
@@ -80,8 +179,40 @@ func set(cache *lru.Cache, key string, value any) (int, bool, error) {
 func (s Cache) Get(key string, value any) (bool, error) {
 	if rv := reflect.ValueOf(value); rv.Kind() != reflect.Pointer || rv.IsNil() {
 		return false, &InvalidValueError{rv.Type()}
+	} else if found, err := get(s.lru, key, value); err != nil {
+		return false, err
 	} else {
-		return get(s.lru, key, value)
+		s.recordLookup(key, found)
+		return found, nil
+	}
+}
+
+func (s Cache) recordLookup(key string, found bool) {
+	if s.hits == nil {
+		return
+	}
+
+	if found {
+		s.hits.Add(1)
+	} else {
+		s.misses.Add(1)
+	}
+
+	s.recordRequest(key)
+}
+
+// Stats returns the number of lookups made with Get that found, or did not find, an entry along with the number of
+// entries that were evicted or invalidated.
+func (s Cache) Stats() Stats {
+	if s.hits == nil {
+		return Stats{}
+	}
+
+	return Stats{
+		Hits:          s.hits.Load(),
+		Misses:        s.misses.Load(),
+		Evictions:     s.evictions.Load(),
+		Invalidations: s.invalidations.Load(),
 	}
 }
 
@@ -90,7 +221,13 @@ func (s Cache) Get(key string, value any) (bool, error) {
 // error if the underlying cache returns an error during setting the value or if
 // the value couldn't be marshalled.
 func (s Cache) Set(key string, value any) (int, bool, error) {
-	return set(s.lru, key, value)
+	return s.set(key, value, nil)
+}
+
+// SetTagged behaves like Set but tags the entry so that it may be removed by a call to Invalidate with any of the
+// given tags. Setting an existing key replaces its tags.
+func (s Cache) SetTagged(key string, value any, tags ...string) (int, bool, error) {
+	return s.set(key, value, tags)
 }
 
 // GuardedSet takes a key and a value and sets the value in the cache if it cannot
@@ -98,6 +235,16 @@ func (s Cache) Set(key string, value any) (int, bool, error) {
 // written. Returns an error if the underlying cache returns an error during setting
 // the value or if the value couldn't be marshalled.
 func (s Cache) GuardedSet(key string, value any) (bool, int, error) {
+	return s.guardedSet(key, value, nil)
+}
+
+// GuardedSetTagged behaves like GuardedSet but tags the entry it sets so that it may be removed by a call to Invalidate
+// with any of the given tags.
+func (s Cache) GuardedSetTagged(key string, value any, tags ...string) (bool, int, error) {
+	return s.guardedSet(key, value, tags)
+}
+
+func (s Cache) guardedSet(key string, value any, tags []string) (bool, int, error) {
 	if ok, err := get(s.lru, key, value); err != nil {
 		return false, 0, fmt.Errorf("error checking cache entry exists: %w", err)
 	} else if ok {
@@ -105,19 +252,56 @@ func (s Cache) GuardedSet(key string, value any) (bool, int, error) {
 	} else {
 		// Currently we don't need to know about evictions with GuardedSet so ignoring
 		// to keep interface sane
-		bytesWritten, _, err := set(s.lru, key, value)
+		bytesWritten, _, err := s.set(key, value, tags)
 		return true, bytesWritten, err
 	}
 }
 
+// Invalidate removes every entry tagged with any of the given tags. Returns the number of entries removed.
+func (s Cache) Invalidate(tags ...string) int {
+	s.index.lock.Lock()
+	defer s.index.lock.Unlock()
+
+	removed := 0
+
+	for _, key := range s.index.keys(tags) {
+		if s.lru.Remove(key) {
+			s.markInvalidated(key)
+			removed++
+		}
+
+		// Keys whose entries were already evicted are removed from the index by the eviction callback, however the
+		// index is cleaned here as well in case the callback did not run for this key
+		s.index.untag(key)
+	}
+
+	s.invalidations.Add(uint64(removed))
+	return removed
+}
+
 // Len returns the length of the current cache
 func (s Cache) Len() int {
 	return s.lru.Len()
 }
 
+// markInvalidated records that the entry of the given key was invalidated so that Warm may load it again. Only keys
+// with a loader are recorded. The caller must hold the index lock.
+func (s Cache) markInvalidated(key string) {
+	if s.warmers.Contains(key) {
+		s.index.invalidated[key] = struct{}{}
+	}
+}
+
 // Reset attempts to reset the underlying cache. Returns an error if the underlying
 // cache returns an error during reset.
 func (s Cache) Reset() error {
+	s.index.lock.Lock()
+	defer s.index.lock.Unlock()
+
+	for _, key := range s.lru.Keys() {
+		s.markInvalidated(key.(string))
+	}
+
 	s.lru.Purge()
 	// This is to provide backwards compatibility for our interface
 	return nil
@@ -126,9 +310,24 @@ func (s Cache) Reset() error {
 // NewCache takes a cache config. Returns a new Cache instance and an error if the underlying
 // cache returns an error during configuration.
 func NewCache(config Config) (Cache, error) {
-	if cache, err := lru.New(config.MaxSize); err != nil {
+	index := newTagIndex()
+
+	// The eviction callback runs on the goroutine that changed the cache while it holds the index lock
+	if cache, err := lru.NewWithEvict(config.MaxSize, func(key, _ any) {
+		index.untag(key.(string))
+	}); err != nil {
 		return Cache{}, fmt.Errorf("error creating cache: %w", err)
+	} else if warmers, err := lru.New(config.MaxSize); err != nil {
+		return Cache{}, fmt.Errorf("error creating cache warmers: %w", err)
 	} else {
-		return Cache{lru: cache}, nil
+		return Cache{
+			lru:           cache,
+			index:         index,
+			warmers:       warmers,
+			hits:          &atomic.Uint64{},
+			misses:        &atomic.Uint64{},
+			evictions:     &atomic.Uint64{},
+			invalidations: &atomic.Uint64{},
+		}, nil
 	}
 }
@@ -17,7 +17,9 @@
 package cache_test
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
 	"testing"
@@ -196,6 +198,186 @@ func TestCache_Get(t *testing.T) {
 	})
 }
 
+func TestCache_Stats(t *testing.T) {
+	instance, err := getPopulatedInstance(cacheEntries)
+	require.Nil(t, err)
+
+	_, err = instance.Get(testCacheKey1, &outputValue)
+	require.Nil(t, err)
+
+	_, err = instance.Get(unusedTestCacheKey, &outputValue)
+	require.Nil(t, err)
+
+	_, _, err = instance.GuardedSet(testCacheKey2, validInputValue2)
+	require.Nil(t, err)
+
+	require.Equal(t, cache.Stats{Hits: 1, Misses: 1}, instance.Stats())
+}
+
+func TestCache_Invalidate(t *testing.T) {
+	t.Run("Invalidate removes entries with any of the given tags", func(t *testing.T) {
+		instance, err := cache.NewCache(cache.Config{MaxSize: 4})
+		require.Nil(t, err)
+
+		_, _, err = instance.SetTagged(testCacheKey1, validInputValue1, "domain-a", "object-1")
+		require.Nil(t, err)
+
+		_, _, err = instance.SetTagged(testCacheKey2, validInputValue2, "domain-b")
+		require.Nil(t, err)
+
+		_, _, err = instance.Set(unusedTestCacheKey, validInputValue2)
+		require.Nil(t, err)
+
+		require.Equal(t, 1, instance.Invalidate("object-1", "domain-c"))
+
+		ok, err := instance.Get(testCacheKey1, &outputValue)
+		require.Nil(t, err)
+		require.False(t, ok)
+
+		ok, err = instance.Get(testCacheKey2, &outputValue)
+		require.Nil(t, err)
+		require.True(t, ok)
+
+		ok, err = instance.Get(unusedTestCacheKey, &outputValue)
+		require.Nil(t, err)
+		require.True(t, ok)
+
+		// The tags of removed entries are forgotten
+		require.Equal(t, 0, instance.Invalidate("domain-a"))
+		require.Equal(t, uint64(1), instance.Stats().Invalidations)
+	})
+
+	t.Run("Setting an existing key replaces its tags", func(t *testing.T) {
+		instance, err := cache.NewCache(cache.Config{MaxSize: 1})
+		require.Nil(t, err)
+
+		_, _, err = instance.SetTagged(testCacheKey1, validInputValue1, "domain-a")
+		require.Nil(t, err)
+
+		_, _, err = instance.SetTagged(testCacheKey1, validInputValue2, "domain-b")
+		require.Nil(t, err)
+
+		require.Equal(t, 0, instance.Invalidate("domain-a"))
+		require.Equal(t, 1, instance.Invalidate("domain-b"))
+	})
+
+	t.Run("Evicted entries are not invalidated", func(t *testing.T) {
+		instance, err := cache.NewCache(cache.Config{MaxSize: 1})
+		require.Nil(t, err)
+
+		_, _, err = instance.SetTagged(testCacheKey1, validInputValue1, "domain-a")
+		require.Nil(t, err)
+
+		_, eviction, err := instance.SetTagged(testCacheKey2, validInputValue2, "domain-b")
+		require.Nil(t, err)
+		require.True(t, eviction)
+
+		require.Equal(t, 0, instance.Invalidate("domain-a"))
+		require.Equal(t, cache.Stats{Evictions: 1}, instance.Stats())
+	})
+}
+
+func TestCache_Warm(t *testing.T) {
+	var (
+		loaded    []string
+		newLoader = func(key string, value testStruct, err error) cache.Loader {
+			return func(ctx context.Context) (any, []string, error) {
+				loaded = append(loaded, key)
+				return value, []string{"domain-a"}, err
+			}
+		}
+	)
+
+	instance, err := cache.NewCache(cache.Config{MaxSize: 4})
+	require.Nil(t, err)
+
+	for _, key := range []string{testCacheKey1, testCacheKey2, unusedTestCacheKey} {
+		_, _, err = instance.SetTagged(key, validInputValue1, "domain-a")
+		require.Nil(t, err)
+	}
+
+	instance.SetLoader(testCacheKey1, newLoader(testCacheKey1, validInputValue1, nil))
+	instance.SetLoader(testCacheKey2, newLoader(testCacheKey2, validInputValue2, nil))
+	instance.SetLoader(unusedTestCacheKey, newLoader(unusedTestCacheKey, validInputValue2, errors.New("load failed")))
+
+	// Request the second key more often than the others so that it is warmed first
+	for range 3 {
+		_, err = instance.Get(testCacheKey2, &outputValue)
+		require.Nil(t, err)
+	}
+
+	_, err = instance.Get(testCacheKey1, &outputValue)
+	require.Nil(t, err)
+
+	require.Equal(t, 3, instance.Invalidate("domain-a"))
+
+	t.Run("Warm loads the most requested entries first", func(t *testing.T) {
+		warmed, err := instance.Warm(context.Background(), 1)
+		require.Nil(t, err)
+		require.Equal(t, 1, warmed)
+		require.Equal(t, []string{testCacheKey2}, loaded)
+
+		ok, err := instance.Get(testCacheKey2, &outputValue)
+		require.Nil(t, err)
+		require.True(t, ok)
+		require.Equal(t, validInputValue2, outputValue)
+	})
+
+	t.Run("Warm skips cached entries and reports loader errors", func(t *testing.T) {
+		loaded = nil
+
+		warmed, err := instance.Warm(context.Background(), 3)
+		require.ErrorContains(t, err, "load failed")
+		require.Equal(t, 1, warmed)
+		require.ElementsMatch(t, []string{testCacheKey1, unusedTestCacheKey}, loaded)
+
+		// Warmed entries are tagged with the tags returned by their loader
+		require.Equal(t, 2, instance.Invalidate("domain-a"))
+	})
+
+	t.Run("Warm skips evicted entries", func(t *testing.T) {
+		loaded = nil
+
+		instance, err := cache.NewCache(cache.Config{MaxSize: 1})
+		require.Nil(t, err)
+
+		_, _, err = instance.SetTagged(testCacheKey1, validInputValue1, "domain-a")
+		require.Nil(t, err)
+		instance.SetLoader(testCacheKey1, newLoader(testCacheKey1, validInputValue1, nil))
+
+		_, eviction, err := instance.SetTagged(testCacheKey2, validInputValue2, "domain-b")
+		require.Nil(t, err)
+		require.True(t, eviction)
+
+		warmed, err := instance.Warm(context.Background(), 1)
+		require.Nil(t, err)
+		require.Zero(t, warmed)
+		require.Empty(t, loaded)
+	})
+
+	t.Run("Warm loads entries removed by Reset", func(t *testing.T) {
+		loaded = nil
+
+		instance, err := cache.NewCache(cache.Config{MaxSize: 2})
+		require.Nil(t, err)
+
+		_, _, err = instance.SetTagged(testCacheKey1, validInputValue1, "domain-a")
+		require.Nil(t, err)
+		instance.SetLoader(testCacheKey1, newLoader(testCacheKey1, validInputValue1, nil))
+		require.Nil(t, instance.Reset())
+
+		warmed, err := instance.Warm(context.Background(), 1)
+		require.Nil(t, err)
+		require.Equal(t, 1, warmed)
+		require.Equal(t, []string{testCacheKey1}, loaded)
+
+		// Warmed keys are no longer tracked as invalidated
+		warmed, err = instance.Warm(context.Background(), 1)
+		require.Nil(t, err)
+		require.Zero(t, warmed)
+	})
+}
+
 func TestCache_Reset(t *testing.T) {
 	instance, err := getPopulatedInstance(cacheEntries)
 	require.Nil(t, err)
@@ -0,0 +1,114 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync/atomic"
+)
+
+// Loader computes the value of a cache entry along with the tags to set it with. Loaders allow entries that were
+// invalidated to be warmed before they are next requested.
+type Loader func(ctx context.Context) (any, []string, error)
+
+type warmer struct {
+	load     Loader
+	requests *atomic.Uint64
+}
+
+// SetLoader registers the loader for the entry with the given key. Lookups made with Get for the key are counted from
+// then on so that Warm can load the most requested entries first. The cache keeps at most as many loaders as it keeps
+// entries, dropping the least recently requested loaders first.
+func (s Cache) SetLoader(key string, load Loader) {
+	requests := &atomic.Uint64{}
+
+	if existing, found := s.warmers.Peek(key); found {
+		requests = existing.(warmer).requests
+	}
+
+	s.warmers.Add(key, warmer{
+		load:     load,
+		requests: requests,
+	})
+}
+
+func (s Cache) recordRequest(key string) {
+	if existing, found := s.warmers.Get(key); found {
+		existing.(warmer).requests.Add(1)
+	}
+}
+
+// Warm loads the entries of up to limit of the most requested keys that have a loader and whose entries were removed
+// by Invalidate or Reset. Entries evicted to make room for others are not warmed, as loading them would only evict
+// other entries in turn. Returns the number of entries loaded. Keys whose loader fails are skipped and their errors
+// are joined into the returned error.
+func (s Cache) Warm(ctx context.Context, limit int) (int, error) {
+	type candidate struct {
+		key      string
+		load     Loader
+		requests uint64
+	}
+
+	var (
+		candidates []candidate
+		warmed     int
+		errs       []error
+	)
+
+	s.index.lock.Lock()
+
+	for key := range s.index.invalidated {
+		if existing, found := s.warmers.Peek(key); !found || s.lru.Contains(key) {
+			// Keys that can not be warmed, or that were set again, no longer need to be tracked
+			delete(s.index.invalidated, key)
+		} else {
+			candidates = append(candidates, candidate{
+				key:      key,
+				load:     existing.(warmer).load,
+				requests: existing.(warmer).requests.Load(),
+			})
+		}
+	}
+
+	s.index.lock.Unlock()
+
+	slices.SortStableFunc(candidates, func(a, b candidate) int {
+		return cmp.Compare(b.requests, a.requests)
+	})
+
+	if len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+
+	for _, next := range candidates {
+		if err := ctx.Err(); err != nil {
+			return warmed, errors.Join(append(errs, err)...)
+		} else if value, tags, err := next.load(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("error loading cache entry %s: %w", next.key, err))
+		} else if set, _, err := s.GuardedSetTagged(next.key, value, tags...); err != nil {
+			errs = append(errs, fmt.Errorf("error warming cache entry %s: %w", next.key, err))
+		} else if set {
+			warmed++
+		}
+	}
+
+	return warmed, errors.Join(errs...)
+}
@@ -21,6 +21,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/specterops/bloodhound/cypher/models"
 	"github.com/specterops/bloodhound/cypher/models/pgsql"
 )
 
@@ -28,6 +29,7 @@ type OutputBuilder struct {
 	MaterializeParameters bool
 	StripLiterals         bool
 	parameters            map[string]any
+	tableMapper           pgsql.TableMapper
 	builder               *strings.Builder
 }
 
@@ -44,6 +46,13 @@ func (s *OutputBuilder) WithMaterializedParameters(parameters map[string]any) *O
 	return s
 }
 
+// WithTableMapper rewrites table references that the given mapper recognizes. A rewritten reference without a binding
+// is bound to its original name so that qualified column references remain valid.
+func (s *OutputBuilder) WithTableMapper(tableMapper pgsql.TableMapper) *OutputBuilder {
+	s.tableMapper = tableMapper
+	return s
+}
+
 func (s *OutputBuilder) HasOutput() bool {
 	return s.builder.Len() != 0
 }
@@ -67,6 +76,22 @@ func (s *OutputBuilder) Build() string {
 	return s.builder.String()
 }
 
+func mapTableReference(builder *OutputBuilder, tableReference pgsql.TableReference) pgsql.TableReference {
+	if builder.tableMapper == nil || len(tableReference.Name) != 1 {
+		return tableReference
+	}
+
+	if mappedTable, isMapped := builder.tableMapper.MapTable(tableReference.Name[0]); isMapped {
+		if !tableReference.Binding.Set {
+			tableReference.Binding = models.ValueOptional(tableReference.Name[0])
+		}
+
+		tableReference.Name = pgsql.CompoundIdentifier{mappedTable}
+	}
+
+	return tableReference
+}
+
 func formatSlice[T any, TS []T](builder *OutputBuilder, slice TS, dataType pgsql.DataType) error {
 	builder.Write("array [")
 
@@ -274,11 +299,13 @@ func formatNode(builder *OutputBuilder, rootExpr pgsql.SyntaxNode) error {
 			)
 
 		case pgsql.TableReference:
-			if typedNextExpr.Binding.Set {
-				exprStack = append(exprStack, typedNextExpr.Binding.Value, pgsql.FormattingLiteral(" "))
+			tableReference := mapTableReference(builder, typedNextExpr)
+
+			if tableReference.Binding.Set {
+				exprStack = append(exprStack, tableReference.Binding.Value, pgsql.FormattingLiteral(" "))
 			}
 
-			exprStack = append(exprStack, typedNextExpr.Name)
+			exprStack = append(exprStack, tableReference.Name)
 
 		case pgsql.Assignment:
 			exprStack = append(exprStack,
@@ -33,6 +33,12 @@ type KindMapper interface {
 	AssertKinds(ctx context.Context, kinds graph.Kinds) ([]int16, error)
 }
 
+// TableMapper is an optional extension of KindMapper that redirects references to graph tables such as TableNode and
+// TableEdge. Drivers use it to scope a translation to the partition tables of a single graph.
+type TableMapper interface {
+	MapTable(table Identifier) (Identifier, bool)
+}
+
 // FormattingLiteral is a syntax node that is used as a transparent formatting syntax node. The formatter will
 // take the string value and emit it as-is.
 type FormattingLiteral string
@@ -17,6 +17,7 @@
 package test
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"runtime/debug"
@@ -24,8 +25,11 @@ import (
 	"testing"
 
 	"github.com/specterops/bloodhound/dawgs/drivers/pg/pgutil"
+	"github.com/stretchr/testify/require"
 
+	"github.com/specterops/bloodhound/cypher/frontend"
 	"github.com/specterops/bloodhound/cypher/models/pgsql"
+	"github.com/specterops/bloodhound/cypher/models/pgsql/translate"
 	"github.com/specterops/bloodhound/dawgs/graph"
 )
 
@@ -81,3 +85,54 @@ func TestTranslate(t *testing.T) {
 
 	fmt.Printf("Ran %d test cases\n", casesRun)
 }
+
+type partitionKindMapper struct {
+	pgsql.KindMapper
+}
+
+func (s partitionKindMapper) MapTable(table pgsql.Identifier) (pgsql.Identifier, bool) {
+	switch table {
+	case pgsql.TableNode, pgsql.TableEdge:
+		return table + "_7", true
+	default:
+		return "", false
+	}
+}
+
+func TestTranslateWithTableMapper(t *testing.T) {
+	kindMapper := partitionKindMapper{
+		KindMapper: newKindMapper(),
+	}
+
+	for _, cypherQuery := range []string{
+		"match (n:NodeKind1)-[r:EdgeKind1]->(m) return n, r, m",
+		"match p = allShortestPaths((s:NodeKind1)-[:EdgeKind1*..]->(e:NodeKind2)) return p",
+		"match (n:NodeKind1) set n.name = 'n' return n",
+		"match ()-[r:EdgeKind1]->() delete r",
+	} {
+		t.Run(cypherQuery, func(t *testing.T) {
+			regularQuery, err := frontend.ParseCypher(frontend.NewContext(), cypherQuery)
+			require.Nil(t, err)
+
+			translation, err := translate.Translate(context.Background(), regularQuery, kindMapper, nil)
+			require.Nil(t, err)
+
+			sqlQuery, err := translate.Translated(translation)
+			require.Nil(t, err)
+
+			fragments := []string{sqlQuery}
+
+			for _, value := range translation.Parameters {
+				if fragment, isString := value.(string); isString {
+					fragments = append(fragments, fragment)
+				}
+			}
+
+			for _, fragment := range fragments {
+				require.NotRegexp(t, `(from|join|update) (node|edge)( |$)`, fragment)
+			}
+
+			require.Regexp(t, `(node|edge)_7`, sqlQuery)
+		})
+	}
+}
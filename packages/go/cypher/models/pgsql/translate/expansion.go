@@ -56,17 +56,19 @@ type ExpansionBuilder struct {
 	ProjectionStatement pgsql.Select
 
 	queryParameters map[string]any
+	tableMapper     pgsql.TableMapper
 	traversalStep   *TraversalStep
 	model           *Expansion
 }
 
-func NewExpansionBuilder(queryParameters map[string]any, traversalStep *TraversalStep) (*ExpansionBuilder, error) {
+func NewExpansionBuilder(queryParameters map[string]any, tableMapper pgsql.TableMapper, traversalStep *TraversalStep) (*ExpansionBuilder, error) {
 	if !traversalStep.Expansion.Set {
 		return nil, errors.New("traversal step must have expansion set")
 	}
 
 	return &ExpansionBuilder{
 		queryParameters: queryParameters,
+		tableMapper:     tableMapper,
 		traversalStep:   traversalStep,
 		model:           traversalStep.Expansion.Value,
 	}, nil
@@ -590,7 +592,7 @@ func (s *ExpansionBuilder) allShortestPathsParameters(expansionModel *Expansion,
 		formatFragment    = func(query pgsql.Select) (string, error) {
 			return format.Statement(
 				nextFrontInsert(query),
-				format.NewOutputBuilder().WithMaterializedParameters(s.queryParameters))
+				newOutputBuilder(s.tableMapper).WithMaterializedParameters(s.queryParameters))
 		}
 	)
 
@@ -626,7 +628,7 @@ func (s *ExpansionBuilder) bidirectionalAllShortestPathsParameters(expansionMode
 		formatFragment    = func(query pgsql.Select) (string, error) {
 			return format.Statement(
 				nextFrontInsert(query),
-				format.NewOutputBuilder().WithMaterializedParameters(s.queryParameters))
+				newOutputBuilder(s.tableMapper).WithMaterializedParameters(s.queryParameters))
 		}
 	)
 
@@ -26,8 +26,18 @@ import (
 	"github.com/specterops/bloodhound/cypher/models/pgsql/format"
 )
 
+func newOutputBuilder(tableMapper pgsql.TableMapper) *format.OutputBuilder {
+	builder := format.NewOutputBuilder()
+
+	if tableMapper != nil {
+		builder.WithTableMapper(tableMapper)
+	}
+
+	return builder
+}
+
 func Translated(translation Result) (string, error) {
-	return format.Statement(translation.Statement, format.NewOutputBuilder())
+	return format.Statement(translation.Statement, newOutputBuilder(translation.tableMapper))
 }
 
 func FromCypher(ctx context.Context, regularQuery *cypher.RegularQuery, kindMapper pgsql.KindMapper, stripLiterals bool) (format.Formatted, error) {
@@ -46,7 +56,7 @@ func FromCypher(ctx context.Context, regularQuery *cypher.RegularQuery, kindMapp
 
 	if translation, err := Translate(ctx, regularQuery, kindMapper, nil); err != nil {
 		return format.Formatted{}, err
-	} else if sqlQuery, err := format.Statement(translation.Statement, format.NewOutputBuilder()); err != nil {
+	} else if sqlQuery, err := format.Statement(translation.Statement, newOutputBuilder(translation.tableMapper)); err != nil {
 		return format.Formatted{}, err
 	} else {
 		output.WriteString(sqlQuery)
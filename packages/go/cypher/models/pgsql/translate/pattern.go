@@ -181,7 +181,7 @@ func (s *Translator) buildTraversalPatternPart(part *PatternPart) error {
 		isRootStep := idx == 0
 
 		if traversalStep.Expansion.Set {
-			if expansion, err := NewExpansionBuilder(s.translation.Parameters, traversalStep); err != nil {
+			if expansion, err := NewExpansionBuilder(s.translation.Parameters, s.translation.tableMapper, traversalStep); err != nil {
 				return err
 			} else if part.ShortestPath || part.AllShortestPaths {
 				if err := s.buildShortestPathsExpansionPattern(traversalStep, expansion, isRootStep); err != nil {
@@ -42,10 +42,14 @@ func NewTranslator(ctx context.Context, kindMapper pgsql.KindMapper, parameters
 		parameters = map[string]any{}
 	}
 
+	// Kind mappers may optionally scope the translation to a specific set of graph tables
+	tableMapper, _ := kindMapper.(pgsql.TableMapper)
+
 	return &Translator{
 		HierarchicalVisitor: walk.NewComposableHierarchicalVisitor[cypher.SyntaxNode](),
 		translation: Result{
-			Parameters: parameters,
+			Parameters:  parameters,
+			tableMapper: tableMapper,
 		},
 		ctx:            ctx,
 		kindMapper:     kindMapper,
@@ -439,6 +443,8 @@ func (s *Translator) Exit(expression cypher.SyntaxNode) {
 type Result struct {
 	Statement  pgsql.Statement
 	Parameters map[string]any
+
+	tableMapper pgsql.TableMapper
 }
 
 func Translate(ctx context.Context, cypherQuery *cypher.RegularQuery, kindMapper pgsql.KindMapper, parameters map[string]any) (Result, error) {
@@ -0,0 +1,257 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"bytes"
+	"context"
+	"runtime"
+	"strconv"
+	"sync"
+
+	"github.com/specterops/bloodhound/dawgs/graph"
+	"github.com/specterops/bloodhound/dawgs/util/size"
+)
+
+// Driver is an in-memory graph.Database. Write transactions are serialized and hold the driver lock for their
+// lifetime. Writes are applied to the graph as they are issued and undone if the transaction delegate returns an
+// error. Read transactions and batch operations take the driver lock for each query or write they issue, so a read
+// transaction observes writes committed between its queries and a batch keeps the writes applied before a failure.
+//
+// Transactions and batch operations opened by the goroutine running a write transaction, such as test harnesses that
+// create nodes from within a write, join that transaction rather than waiting on the driver lock it holds.
+//
+// Each named graph is held in its own store. Transactions operate on the default graph unless directed at another
+// graph with WithGraph.
+type Driver struct {
+	lock                  *sync.RWMutex
+	graphsLock            *sync.Mutex
+	graphs                map[string]*store
+	defaultGraph          string
+	graphQueryMemoryLimit size.Size
+
+	writerLock      *sync.Mutex
+	writer          *transaction
+	writerGoroutine uint64
+}
+
+func NewDriver(graphQueryMemoryLimit size.Size) *Driver {
+	return &Driver{
+		lock:       &sync.RWMutex{},
+		graphsLock: &sync.Mutex{},
+		graphs: map[string]*store{
+			"": newStore(),
+		},
+		graphQueryMemoryLimit: graphQueryMemoryLimit,
+		writerLock:            &sync.Mutex{},
+	}
+}
+
+// goroutineID returns the ID of the calling goroutine as given by the header of its stack trace.
+func goroutineID() uint64 {
+	var (
+		buffer [64]byte
+		header = bytes.TrimPrefix(buffer[:runtime.Stack(buffer[:], false)], []byte("goroutine "))
+	)
+
+	if end := bytes.IndexByte(header, ' '); end > 0 {
+		if id, err := strconv.ParseUint(string(header[:end]), 10, 64); err == nil {
+			return id
+		}
+	}
+
+	return 0
+}
+
+// activeWriter returns the write transaction run by the calling goroutine, if any.
+func (s *Driver) activeWriter() *transaction {
+	s.writerLock.Lock()
+	defer s.writerLock.Unlock()
+
+	if s.writer != nil && s.writerGoroutine == goroutineID() {
+		return s.writer
+	}
+
+	return nil
+}
+
+func (s *Driver) setWriter(writer *transaction) {
+	s.writerLock.Lock()
+	defer s.writerLock.Unlock()
+
+	s.writer = writer
+
+	if writer != nil {
+		s.writerGoroutine = goroutineID()
+	}
+}
+
+// graphStore returns the store for the named graph, creating it if it does not yet exist.
+func (s *Driver) graphStore(name string) *store {
+	s.graphsLock.Lock()
+	defer s.graphsLock.Unlock()
+
+	if graphStore, exists := s.graphs[name]; exists {
+		return graphStore
+	}
+
+	graphStore := newStore()
+	s.graphs[name] = graphStore
+
+	return graphStore
+}
+
+func (s *Driver) defaultStore() *store {
+	s.graphsLock.Lock()
+	defaultGraph := s.defaultGraph
+	s.graphsLock.Unlock()
+
+	return s.graphStore(defaultGraph)
+}
+
+func (s *Driver) setDefaultGraph(name string) {
+	s.graphsLock.Lock()
+	defer s.graphsLock.Unlock()
+
+	// Contents written before the default graph was named carry over to it
+	if _, exists := s.graphs[name]; !exists {
+		s.graphs[name] = s.graphs[s.defaultGraph]
+	}
+
+	s.defaultGraph = name
+}
+
+func (s *Driver) SetWriteFlushSize(size int) {
+	// This is a no-op function since writes are applied directly to memory
+}
+
+func (s *Driver) SetBatchWriteSize(size int) {
+	// This is a no-op function since writes are applied directly to memory
+}
+
+func (s *Driver) ReadTransaction(ctx context.Context, txDelegate graph.TransactionDelegate, options ...graph.TransactionOption) error {
+	if ctx.Err() != nil {
+		return graph.ErrContextTimedOut
+	}
+
+	if writer := s.activeWriter(); writer != nil {
+		return txDelegate(writer.nested(ctx, false))
+	}
+
+	// Read transactions take the driver lock per query rather than for the lifetime of the delegate so that a reader
+	// streaming results to a concurrent writer can not block it
+	tx := newTransaction(ctx, s, false)
+	tx.locking = true
+
+	return txDelegate(tx)
+}
+
+func (s *Driver) WriteTransaction(ctx context.Context, txDelegate graph.TransactionDelegate, options ...graph.TransactionOption) error {
+	if ctx.Err() != nil {
+		return graph.ErrContextTimedOut
+	}
+
+	if writer := s.activeWriter(); writer != nil {
+		tx := writer.nested(ctx, true)
+
+		if err := txDelegate(tx); err != nil {
+			tx.rollback()
+			return err
+		}
+
+		return nil
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	tx := newTransaction(ctx, s, true)
+
+	s.setWriter(tx)
+	defer s.setWriter(nil)
+
+	if err := txDelegate(tx); err != nil {
+		tx.rollback()
+		return err
+	}
+
+	tx.commit()
+	return nil
+}
+
+func (s *Driver) BatchOperation(ctx context.Context, batchDelegate graph.BatchDelegate) error {
+	if ctx.Err() != nil {
+		return graph.ErrContextTimedOut
+	}
+
+	// Batch writes take the driver lock one operation at a time so that readers, such as the post-processing readers
+	// feeding a batch writer, are not blocked for the lifetime of the batch. As with the flushed batches of the other
+	// drivers, writes already applied are kept when the delegate fails.
+	if writer := s.activeWriter(); writer != nil {
+		return batchDelegate(newBatch(writer.nested(ctx, true)))
+	}
+
+	return batchDelegate(newBatch(newTransaction(ctx, s, true)))
+}
+
+func (s *Driver) AssertSchema(ctx context.Context, schema graph.Schema) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if schema.DefaultGraph.Name != "" {
+		s.setDefaultGraph(schema.DefaultGraph.Name)
+	}
+
+	for _, graphSchema := range append(schema.Graphs, schema.DefaultGraph) {
+		graphStore := s.graphStore(graphSchema.Name)
+		graphStore.assertKinds(graphSchema.Nodes...)
+		graphStore.assertKinds(graphSchema.Edges...)
+
+		// Kind assertions are not transactional
+		graphStore.commit()
+	}
+
+	return nil
+}
+
+func (s *Driver) SetDefaultGraph(ctx context.Context, graphSchema graph.Graph) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.setDefaultGraph(graphSchema.Name)
+	return nil
+}
+
+func (s *Driver) Run(ctx context.Context, query string, parameters map[string]any) error {
+	return s.WriteTransaction(ctx, func(tx graph.Transaction) error {
+		result := tx.Raw(query, parameters)
+		defer result.Close()
+
+		return result.Error()
+	})
+}
+
+func (s *Driver) Close(ctx context.Context) error {
+	return nil
+}
+
+func (s *Driver) FetchKinds(ctx context.Context) (graph.Kinds, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.defaultStore().fetchKinds(), nil
+}
@@ -0,0 +1,1150 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/specterops/bloodhound/cypher/models/cypher"
+	"github.com/specterops/bloodhound/dawgs/graph"
+)
+
+// executor interprets a cypher query model against the store. Each clause of the query consumes the rows produced
+// by the clause before it and produces a new set of rows.
+type executor struct {
+	ctx        context.Context
+	store      *store
+	writable   bool
+	parameters map[string]any
+}
+
+func newExecutor(ctx context.Context, store *store, writable bool, parameters map[string]any) *executor {
+	return &executor{
+		ctx:        ctx,
+		store:      store,
+		writable:   writable,
+		parameters: parameters,
+	}
+}
+
+func (s *executor) execute(regularQuery *cypher.RegularQuery) (*queryResult, error) {
+	if regularQuery == nil || regularQuery.SingleQuery == nil {
+		return nil, fmt.Errorf("query is empty")
+	}
+
+	var (
+		rows            = []bindings{{}}
+		singlePartQuery = regularQuery.SingleQuery.SinglePartQuery
+		err             error
+	)
+
+	if multiPartQuery := regularQuery.SingleQuery.MultiPartQuery; multiPartQuery != nil {
+		for _, part := range multiPartQuery.Parts {
+			if rows, err = s.readingClauses(rows, part.ReadingClauses); err != nil {
+				return nil, err
+			}
+
+			for _, updatingClause := range part.UpdatingClauses {
+				if rows, err = s.updatingClause(rows, updatingClause); err != nil {
+					return nil, err
+				}
+			}
+
+			if part.With != nil {
+				if rows, _, err = s.project(rows, part.With.Projection); err != nil {
+					return nil, err
+				} else if part.With.Where != nil {
+					if rows, err = s.filter(rows, part.With.Where.Expressions); err != nil {
+						return nil, err
+					}
+				}
+			}
+		}
+
+		singlePartQuery = multiPartQuery.SinglePartQuery
+	}
+
+	if singlePartQuery == nil {
+		return newQueryResult(nil), nil
+	}
+
+	if rows, err = s.readingClauses(rows, singlePartQuery.ReadingClauses); err != nil {
+		return nil, err
+	}
+
+	for _, expression := range singlePartQuery.UpdatingClauses {
+		if updatingClause, typeOK := expression.(*cypher.UpdatingClause); !typeOK {
+			return nil, fmt.Errorf("unexpected type for updating clause: %T", expression)
+		} else if rows, err = s.updatingClause(rows, updatingClause); err != nil {
+			return nil, err
+		}
+	}
+
+	if singlePartQuery.Return == nil {
+		return newQueryResult(nil), nil
+	}
+
+	projectedRows, columns, err := s.project(rows, singlePartQuery.Return.Projection)
+	if err != nil {
+		return nil, err
+	}
+
+	resultRows := make([][]any, len(projectedRows))
+
+	for rowIdx, projectedRow := range projectedRows {
+		resultRow := make([]any, len(columns))
+
+		for columnIdx, column := range columns {
+			resultRow[columnIdx] = projectedRow[column]
+		}
+
+		resultRows[rowIdx] = resultRow
+	}
+
+	return newQueryResult(resultRows), nil
+}
+
+func (s *executor) filter(rows []bindings, expressions []cypher.Expression) ([]bindings, error) {
+	if len(expressions) == 0 {
+		return rows, nil
+	}
+
+	var filtered []bindings
+
+	for _, row := range rows {
+		if value, err := s.conjunction(rowContext(row), expressions); err != nil {
+			return nil, err
+		} else if value == true {
+			filtered = append(filtered, row)
+		}
+	}
+
+	return filtered, nil
+}
+
+func (s *executor) readingClauses(rows []bindings, readingClauses []*cypher.ReadingClause) ([]bindings, error) {
+	var err error
+
+	for _, readingClause := range readingClauses {
+		if readingClause.Match != nil {
+			rows, err = s.match(rows, readingClause.Match)
+		} else if readingClause.Unwind != nil {
+			rows, err = s.unwind(rows, readingClause.Unwind)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rows, nil
+}
+
+func (s *executor) unwind(rows []bindings, unwind *cypher.Unwind) ([]bindings, error) {
+	var unwound []bindings
+
+	for _, row := range rows {
+		if value, err := s.evaluate(rowContext(row), unwind.Expression); err != nil {
+			return nil, err
+		} else if elements, isList := value.([]any); isList {
+			for _, element := range elements {
+				unwound = append(unwound, rowContext(row).with(unwind.Binding.Symbol, element).row)
+			}
+		} else if value != nil {
+			unwound = append(unwound, rowContext(row).with(unwind.Binding.Symbol, value).row)
+		}
+	}
+
+	return unwound, nil
+}
+
+// conjuncts flattens the top-level conjunction of the given expression into its terms.
+func conjuncts(expression cypher.Expression) []cypher.Expression {
+	switch typedExpression := expression.(type) {
+	case *cypher.Where:
+		var terms []cypher.Expression
+
+		for _, term := range typedExpression.Expressions {
+			terms = append(terms, conjuncts(term)...)
+		}
+
+		return terms
+
+	case *cypher.Conjunction:
+		var terms []cypher.Expression
+
+		for _, term := range typedExpression.Expressions {
+			terms = append(terms, conjuncts(term)...)
+		}
+
+		return terms
+
+	case *cypher.Parenthetical:
+		if _, isConjunction := typedExpression.Expression.(*cypher.Conjunction); isConjunction {
+			return conjuncts(typedExpression.Expression)
+		}
+	}
+
+	return []cypher.Expression{expression}
+}
+
+// identityHint recognizes terms of the form id(n) = $value and id(n) in $values.
+func (s *executor) identityHint(term cypher.Expression) (string, []graph.ID, bool) {
+	comparison, isComparison := term.(*cypher.Comparison)
+
+	if !isComparison || len(comparison.Partials) != 1 {
+		return "", nil, false
+	}
+
+	function, isFunction := comparison.Left.(*cypher.FunctionInvocation)
+
+	if !isFunction || strings.ToLower(function.Name) != cypher.IdentityFunction || len(function.Arguments) != 1 {
+		return "", nil, false
+	}
+
+	symbol := symbolOf(function.Arguments[0])
+
+	if symbol == "" {
+		return "", nil, false
+	}
+
+	// The right operand may only be evaluated if it does not depend on the row
+	value, err := s.evaluate(rowContext(bindings{}), comparison.Partials[0].Right)
+
+	if err != nil {
+		return "", nil, false
+	}
+
+	switch comparison.Partials[0].Operator {
+	case cypher.OperatorEquals:
+		if id, isInt := value.(int64); isInt {
+			return symbol, []graph.ID{graph.ID(id)}, true
+		}
+
+	case cypher.OperatorIn:
+		if elements, isList := value.([]any); isList {
+			ids := make([]graph.ID, 0, len(elements))
+
+			for _, element := range elements {
+				if id, isInt := element.(int64); isInt {
+					ids = append(ids, graph.ID(id))
+				}
+			}
+
+			return symbol, ids, true
+		}
+	}
+
+	return "", nil, false
+}
+
+// edgeLocal returns true if the given expression refers to nothing but properties and the type of the given
+// relationship variable. Such expressions may be evaluated against each relationship of a variable length pattern.
+func edgeLocal(expression cypher.Expression, symbol string) bool {
+	referenced := false
+
+	var visit func(expression cypher.Expression) bool
+
+	visitAll := func(expressions []cypher.Expression) bool {
+		for _, expression := range expressions {
+			if !visit(expression) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	visit = func(expression cypher.Expression) bool {
+		switch typedExpression := expression.(type) {
+		case *cypher.Literal, *cypher.Parameter:
+			return true
+
+		case *cypher.KindMatcher:
+			referenced = referenced || symbolOf(typedExpression.Reference) == symbol
+			return symbolOf(typedExpression.Reference) == symbol
+
+		case *cypher.PropertyLookup:
+			referenced = referenced || symbolOf(typedExpression.Atom) == symbol
+			return symbolOf(typedExpression.Atom) == symbol
+
+		case *cypher.FunctionInvocation:
+			if strings.ToLower(typedExpression.Name) == cypher.EdgeTypeFunction && len(typedExpression.Arguments) == 1 && symbolOf(typedExpression.Arguments[0]) == symbol {
+				referenced = true
+				return true
+			} else if isAggregate(typedExpression) {
+				return false
+			}
+
+			return visitAll(typedExpression.Arguments)
+
+		case *cypher.ListLiteral:
+			return visitAll(*typedExpression)
+
+		case *cypher.Comparison:
+			if !visit(typedExpression.Left) {
+				return false
+			}
+
+			for _, partial := range typedExpression.Partials {
+				if !visit(partial.Right) {
+					return false
+				}
+			}
+
+			return true
+
+		case *cypher.ArithmeticExpression:
+			if !visit(typedExpression.Left) {
+				return false
+			}
+
+			for _, partial := range typedExpression.Partials {
+				if !visit(partial.Right) {
+					return false
+				}
+			}
+
+			return true
+
+		case *cypher.Negation:
+			return visit(typedExpression.Expression)
+
+		case *cypher.Parenthetical:
+			return visit(typedExpression.Expression)
+
+		case *cypher.Conjunction:
+			return visitAll(typedExpression.Expressions)
+
+		case *cypher.Disjunction:
+			return visitAll(typedExpression.Expressions)
+
+		default:
+			return false
+		}
+	}
+
+	return visit(expression) && referenced
+}
+
+// patternSymbols returns the node, relationship and path variables declared by the given pattern parts along with the
+// subset of relationship variables that bind more than a single relationship.
+func patternSymbols(patternParts []*cypher.PatternPart) ([]string, map[string]struct{}, map[string]struct{}, map[string]struct{}) {
+	var (
+		symbols               []string
+		nodeSymbols           = map[string]struct{}{}
+		relationshipSymbols   = map[string]struct{}{}
+		multiRelationshipSyms = map[string]struct{}{}
+	)
+
+	for _, patternPart := range patternParts {
+		if symbol := symbolOf(patternPart.Binding); symbol != "" {
+			symbols = append(symbols, symbol)
+		}
+
+		for _, element := range patternPart.PatternElements {
+			if nodePattern, isNodePattern := element.AsNodePattern(); isNodePattern {
+				if symbol := symbolOf(nodePattern.Binding); symbol != "" {
+					symbols = append(symbols, symbol)
+					nodeSymbols[symbol] = struct{}{}
+				}
+			} else if relationshipPattern, isRelationshipPattern := element.AsRelationshipPattern(); isRelationshipPattern {
+				if symbol := symbolOf(relationshipPattern.Binding); symbol != "" {
+					symbols = append(symbols, symbol)
+
+					if relationshipPattern.Range != nil || patternPart.ShortestPathPattern || patternPart.AllShortestPathsPattern {
+						multiRelationshipSyms[symbol] = struct{}{}
+					} else {
+						relationshipSymbols[symbol] = struct{}{}
+					}
+				}
+			}
+		}
+	}
+
+	return symbols, nodeSymbols, relationshipSymbols, multiRelationshipSyms
+}
+
+func (s *executor) match(rows []bindings, match *cypher.Match) ([]bindings, error) {
+	var (
+		symbols, nodeSymbols, relationshipSymbols, multiRelationshipSymbols = patternSymbols(match.Pattern)
+
+		hints = patternHints{
+			nodeIDs:         map[string][]graph.ID{},
+			relationshipIDs: map[string][]graph.ID{},
+			edgeFilters:     map[string][]cypher.Expression{},
+		}
+
+		filters []cypher.Expression
+		matched []bindings
+	)
+
+	if match.Where != nil {
+		for _, term := range conjuncts(match.Where) {
+			if symbol, ids, isHint := s.identityHint(term); isHint {
+				if _, isNodeSymbol := nodeSymbols[symbol]; isNodeSymbol {
+					if _, hinted := hints.nodeIDs[symbol]; !hinted {
+						hints.nodeIDs[symbol] = ids
+					}
+				} else if _, isRelationshipSymbol := relationshipSymbols[symbol]; isRelationshipSymbol {
+					if _, hinted := hints.relationshipIDs[symbol]; !hinted {
+						hints.relationshipIDs[symbol] = ids
+					}
+				}
+			}
+
+			isEdgeFilter := false
+
+			for symbol := range multiRelationshipSymbols {
+				if edgeLocal(term, symbol) {
+					hints.edgeFilters[symbol] = append(hints.edgeFilters[symbol], term)
+					isEdgeFilter = true
+					break
+				}
+			}
+
+			if !isEdgeFilter {
+				filters = append(filters, term)
+			}
+		}
+	}
+
+	for _, row := range rows {
+		matches := []patternMatch{newPatternMatch(row)}
+
+		for _, patternPart := range match.Pattern {
+			var nextMatches []patternMatch
+
+			for _, nextMatch := range matches {
+				if partMatches, err := s.matchPart(patternPart, nextMatch, hints); err != nil {
+					return nil, err
+				} else {
+					nextMatches = append(nextMatches, partMatches...)
+				}
+			}
+
+			matches = nextMatches
+		}
+
+		numMatched := 0
+
+		for _, nextMatch := range matches {
+			if value, err := s.conjunction(rowContext(nextMatch.row), filters); err != nil {
+				return nil, err
+			} else if value == true {
+				matched = append(matched, nextMatch.row)
+				numMatched++
+			}
+		}
+
+		if numMatched == 0 && match.Optional {
+			nullRow := row.copy()
+
+			for _, symbol := range symbols {
+				if _, bound := nullRow[symbol]; !bound {
+					nullRow[symbol] = nil
+				}
+			}
+
+			matched = append(matched, nullRow)
+		}
+	}
+
+	return matched, nil
+}
+
+func (s *executor) updatingClause(rows []bindings, updatingClause *cypher.UpdatingClause) ([]bindings, error) {
+	if !s.writable {
+		return nil, ErrReadOnlyTransaction
+	}
+
+	switch typedClause := updatingClause.Clause.(type) {
+	case *cypher.Create:
+		updated := make([]bindings, 0, len(rows))
+
+		for _, row := range rows {
+			row = row.copy()
+
+			for _, patternPart := range typedClause.Pattern {
+				if err := s.create(row, patternPart); err != nil {
+					return nil, err
+				}
+			}
+
+			updated = append(updated, row)
+		}
+
+		return updated, nil
+
+	case *cypher.Set:
+		for _, row := range rows {
+			if err := s.set(row, typedClause.Items); err != nil {
+				return nil, err
+			}
+		}
+
+		return rows, nil
+
+	case *cypher.Remove:
+		for _, row := range rows {
+			if err := s.remove(row, typedClause.Items); err != nil {
+				return nil, err
+			}
+		}
+
+		return rows, nil
+
+	case *cypher.Delete:
+		return rows, s.delete(rows, typedClause)
+
+	case *cypher.Merge:
+		return s.merge(rows, typedClause)
+
+	default:
+		return nil, fmt.Errorf("%w: updating clause type %T", ErrUnsupportedQuery, updatingClause.Clause)
+	}
+}
+
+func (s *executor) create(row bindings, patternPart *cypher.PatternPart) error {
+	chains, err := newChains(patternPart.PatternElements)
+	if err != nil {
+		return err
+	}
+
+	for _, nextChain := range chains {
+		state := chainState{
+			patternMatch: newPatternMatch(row),
+			nodes:        make([]*graph.Node, len(nextChain.nodes)),
+			segments:     make([][]*graph.Relationship, len(nextChain.relationships)),
+		}
+
+		for idx, nodePattern := range nextChain.nodes {
+			symbol := symbolOf(nodePattern.Binding)
+
+			if boundValue, bound := row[symbol]; bound && symbol != "" {
+				if boundNode, typeOK := boundValue.(*graph.Node); !typeOK {
+					return fmt.Errorf("variable %s is not bound to a node", symbol)
+				} else {
+					state.nodes[idx] = boundNode
+				}
+			} else if properties, err := s.propertyConstraints(row, nodePattern.Properties); err != nil {
+				return err
+			} else if node, err := s.store.createNode(0, graph.AsProperties(propertyMap(properties)), nodePattern.Kinds); err != nil {
+				return err
+			} else {
+				state.nodes[idx] = node
+
+				if symbol != "" {
+					row[symbol] = node
+				}
+			}
+		}
+
+		for idx, relationshipPattern := range nextChain.relationships {
+			var (
+				symbol    = symbolOf(relationshipPattern.Binding)
+				startNode = state.nodes[idx]
+				endNode   = state.nodes[idx+1]
+			)
+
+			if len(relationshipPattern.Kinds) != 1 {
+				return fmt.Errorf("relationships must be created with exactly one type")
+			} else if relationshipPattern.Range != nil {
+				return fmt.Errorf("variable length relationships can not be created")
+			}
+
+			switch relationshipPattern.Direction {
+			case graph.DirectionInbound:
+				startNode, endNode = endNode, startNode
+			case graph.DirectionBoth:
+				return fmt.Errorf("relationships must be created with a direction")
+			}
+
+			if properties, err := s.propertyConstraints(row, relationshipPattern.Properties); err != nil {
+				return err
+			} else if relationship, err := s.store.createRelationship(startNode.ID, endNode.ID, relationshipPattern.Kinds[0], graph.AsProperties(propertyMap(properties))); err != nil {
+				return err
+			} else {
+				state.segments[idx] = []*graph.Relationship{relationship}
+
+				if symbol != "" {
+					row[symbol] = relationship
+				}
+			}
+		}
+
+		if symbol := symbolOf(patternPart.Binding); symbol != "" {
+			row[symbol] = s.buildPath(state)
+		}
+	}
+
+	return nil
+}
+
+// propertyMap drops null values from the given map since null properties are not stored.
+func propertyMap(properties map[string]any) map[string]any {
+	stored := make(map[string]any, len(properties))
+
+	for key, value := range properties {
+		if value != nil {
+			stored[key] = value
+		}
+	}
+
+	return stored
+}
+
+func (s *executor) setProperty(target any, key string, value any) error {
+	switch typedTarget := target.(type) {
+	case nil:
+		return nil
+
+	case *graph.Node:
+		properties := maps.Clone(typedTarget.Properties.Map)
+
+		if value == nil {
+			delete(properties, key)
+		} else {
+			properties[key] = value
+		}
+
+		s.store.replaceNode(typedTarget, typedTarget.Kinds, properties)
+		return nil
+
+	case *graph.Relationship:
+		properties := maps.Clone(typedTarget.Properties.Map)
+
+		if value == nil {
+			delete(properties, key)
+		} else {
+			properties[key] = value
+		}
+
+		s.store.replaceRelationshipProperties(typedTarget, properties)
+		return nil
+
+	default:
+		return fmt.Errorf("unable to set property %s on type %T", key, target)
+	}
+}
+
+func (s *executor) replaceProperties(target any, properties map[string]any, merge bool) error {
+	var existing map[string]any
+
+	switch typedTarget := target.(type) {
+	case nil:
+		return nil
+	case *graph.Node:
+		existing = typedTarget.Properties.Map
+	case *graph.Relationship:
+		existing = typedTarget.Properties.Map
+	default:
+		return fmt.Errorf("unable to set properties on type %T", target)
+	}
+
+	replacement := map[string]any{}
+
+	if merge {
+		replacement = maps.Clone(existing)
+	}
+
+	for key, value := range properties {
+		if value == nil {
+			delete(replacement, key)
+		} else {
+			replacement[key] = value
+		}
+	}
+
+	switch typedTarget := target.(type) {
+	case *graph.Node:
+		s.store.replaceNode(typedTarget, typedTarget.Kinds, replacement)
+	case *graph.Relationship:
+		s.store.replaceRelationshipProperties(typedTarget, replacement)
+	}
+
+	return nil
+}
+
+func (s *executor) set(row bindings, items []*cypher.SetItem) error {
+	for _, item := range items {
+		switch typedLeft := item.Left.(type) {
+		case *cypher.PropertyLookup:
+			if len(typedLeft.Symbols) != 1 {
+				return fmt.Errorf("%w: nested property assignment", ErrUnsupportedQuery)
+			} else if target, err := s.evaluate(rowContext(row), typedLeft.Atom); err != nil {
+				return err
+			} else if value, err := s.evaluate(rowContext(row), item.Right); err != nil {
+				return err
+			} else if err := s.setProperty(target, typedLeft.Symbols[0], value); err != nil {
+				return err
+			}
+
+		case *cypher.Variable:
+			target, err := s.evaluate(rowContext(row), typedLeft)
+			if err != nil {
+				return err
+			}
+
+			if item.Operator == cypher.OperatorLabelAssignment {
+				if kinds, typeOK := item.Right.(graph.Kinds); !typeOK {
+					return fmt.Errorf("expected kinds for label assignment but got %T", item.Right)
+				} else if node, isNode := target.(*graph.Node); isNode {
+					s.store.replaceNode(node, node.Kinds.Copy().Add(kinds...), node.Properties.Map)
+				} else if target != nil {
+					return fmt.Errorf("unable to add labels to type %T", target)
+				}
+
+				continue
+			}
+
+			if properties, err := s.propertyConstraints(row, item.Right); err != nil {
+				return err
+			} else if err := s.replaceProperties(target, properties, item.Operator == cypher.OperatorAdditionAssignment); err != nil {
+				return err
+			}
+
+		default:
+			return fmt.Errorf("%w: set item target type %T", ErrUnsupportedQuery, item.Left)
+		}
+	}
+
+	return nil
+}
+
+func (s *executor) remove(row bindings, items []*cypher.RemoveItem) error {
+	for _, item := range items {
+		if item.KindMatcher != nil {
+			if target, err := s.evaluate(rowContext(row), item.KindMatcher.Reference); err != nil {
+				return err
+			} else if node, isNode := target.(*graph.Node); isNode {
+				kinds := node.Kinds.Copy()
+
+				for _, kind := range item.KindMatcher.Kinds {
+					kinds = kinds.Remove(kind)
+				}
+
+				s.store.replaceNode(node, kinds, node.Properties.Map)
+			} else if target != nil {
+				return fmt.Errorf("unable to remove labels from type %T", target)
+			}
+		} else if item.Property != nil {
+			if len(item.Property.Symbols) != 1 {
+				return fmt.Errorf("%w: nested property removal", ErrUnsupportedQuery)
+			} else if target, err := s.evaluate(rowContext(row), item.Property.Atom); err != nil {
+				return err
+			} else if err := s.setProperty(target, item.Property.Symbols[0], nil); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// delete removes all entities referenced by the clause across all rows. Relationships are removed before nodes so
+// that deleting a path or a node along with its relationships does not require detaching.
+func (s *executor) delete(rows []bindings, deleteClause *cypher.Delete) error {
+	var (
+		nodeIDs         []graph.ID
+		relationshipIDs []graph.ID
+		collect         func(value any) error
+	)
+
+	collect = func(value any) error {
+		switch typedValue := value.(type) {
+		case nil:
+		case *graph.Node:
+			nodeIDs = append(nodeIDs, typedValue.ID)
+		case *graph.Relationship:
+			relationshipIDs = append(relationshipIDs, typedValue.ID)
+		case *graph.Path:
+			for _, edge := range typedValue.Edges {
+				relationshipIDs = append(relationshipIDs, edge.ID)
+			}
+
+			for _, node := range typedValue.Nodes {
+				nodeIDs = append(nodeIDs, node.ID)
+			}
+		case []any:
+			for _, element := range typedValue {
+				if err := collect(element); err != nil {
+					return err
+				}
+			}
+		default:
+			return fmt.Errorf("unable to delete type %T", value)
+		}
+
+		return nil
+	}
+
+	for _, row := range rows {
+		for _, expression := range deleteClause.Expressions {
+			if value, err := s.evaluate(rowContext(row), expression); err != nil {
+				return err
+			} else if err := collect(value); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, relationshipID := range relationshipIDs {
+		s.store.deleteRelationship(relationshipID)
+	}
+
+	for _, nodeID := range nodeIDs {
+		if err := s.store.deleteNode(nodeID, deleteClause.Detach); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *executor) merge(rows []bindings, merge *cypher.Merge) ([]bindings, error) {
+	var merged []bindings
+
+	for _, row := range rows {
+		if matches, err := s.matchPatternPart(merge.PatternPart, row, patternHints{}); err != nil {
+			return nil, err
+		} else if len(matches) > 0 {
+			for _, nextMatch := range matches {
+				for _, action := range merge.MergeActions {
+					if action.OnMatch && action.Set != nil {
+						if err := s.set(nextMatch.row, action.Set.Items); err != nil {
+							return nil, err
+						}
+					}
+				}
+
+				merged = append(merged, nextMatch.row)
+			}
+		} else {
+			createdRow := row.copy()
+
+			if err := s.create(createdRow, merge.PatternPart); err != nil {
+				return nil, err
+			}
+
+			for _, action := range merge.MergeActions {
+				if action.OnCreate && action.Set != nil {
+					if err := s.set(createdRow, action.Set.Items); err != nil {
+						return nil, err
+					}
+				}
+			}
+
+			merged = append(merged, createdRow)
+		}
+	}
+
+	return merged, nil
+}
+
+type projectionItem struct {
+	name       string
+	expression cypher.Expression
+	aggregate  bool
+}
+
+func projectionItemName(expression, binding cypher.Expression, idx int) string {
+	if symbol := symbolOf(binding); symbol != "" {
+		return symbol
+	} else if symbol := symbolOf(expression); symbol != "" {
+		return symbol
+	} else if propertyLookup, isPropertyLookup := expression.(*cypher.PropertyLookup); isPropertyLookup && symbolOf(propertyLookup.Atom) != "" {
+		return symbolOf(propertyLookup.Atom) + "." + strings.Join(propertyLookup.Symbols, ".")
+	}
+
+	return fmt.Sprintf("column%d", idx)
+}
+
+func (s *executor) projectionItems(rows []bindings, projection *cypher.Projection) []projectionItem {
+	var items []projectionItem
+
+	expandGreedy := func() {
+		symbols := map[string]struct{}{}
+
+		for _, row := range rows {
+			for symbol := range row {
+				symbols[symbol] = struct{}{}
+			}
+		}
+
+		for _, symbol := range slices.Sorted(maps.Keys(symbols)) {
+			items = append(items, projectionItem{
+				name:       symbol,
+				expression: cypher.NewVariableWithSymbol(symbol),
+			})
+		}
+	}
+
+	if projection.All {
+		expandGreedy()
+	}
+
+	for idx, item := range projection.Items {
+		var (
+			expression = item
+			binding    cypher.Expression
+		)
+
+		if projectionItem, isProjectionItem := item.(*cypher.ProjectionItem); isProjectionItem {
+			expression = projectionItem.Expression
+			binding = projectionItem.Binding
+		}
+
+		if symbolOf(expression) == cypher.TokenLiteralAsterisk {
+			expandGreedy()
+			continue
+		}
+
+		items = append(items, projectionItem{
+			name:       projectionItemName(expression, binding, idx),
+			expression: expression,
+			aggregate:  containsAggregate(expression),
+		})
+	}
+
+	return items
+}
+
+// projectedRow is a row produced by a projection along with the scope that sort expressions are evaluated in.
+type projectedRow struct {
+	values bindings
+	scope  evaluationContext
+}
+
+// project applies a RETURN or WITH projection to the given rows. The projected rows contain only the projected
+// columns, named in the returned order.
+func (s *executor) project(rows []bindings, projection *cypher.Projection) ([]bindings, []string, error) {
+	var (
+		items     = s.projectionItems(rows, projection)
+		columns   = make([]string, len(items))
+		projected []projectedRow
+		aggregate = false
+	)
+
+	for idx, item := range items {
+		columns[idx] = item.name
+		aggregate = aggregate || item.aggregate
+	}
+
+	if aggregate {
+		var (
+			groupKeys []string
+			groups    = map[string][]bindings{}
+		)
+
+		for _, row := range rows {
+			var keyBuilder strings.Builder
+
+			for _, item := range items {
+				if !item.aggregate {
+					if value, err := s.evaluate(rowContext(row), item.expression); err != nil {
+						return nil, nil, err
+					} else {
+						writeValueKey(&keyBuilder, value)
+						keyBuilder.WriteString(";")
+					}
+				}
+			}
+
+			groupKey := keyBuilder.String()
+
+			if _, exists := groups[groupKey]; !exists {
+				groupKeys = append(groupKeys, groupKey)
+			}
+
+			groups[groupKey] = append(groups[groupKey], row)
+		}
+
+		// Aggregating over nothing produces a single row unless there are grouping keys to aggregate by
+		if len(rows) == 0 && !slices.ContainsFunc(items, func(item projectionItem) bool { return !item.aggregate }) {
+			groupKeys = append(groupKeys, "")
+			groups[""] = []bindings{}
+		}
+
+		for _, groupKey := range groupKeys {
+			var (
+				group = groups[groupKey]
+				scope = evaluationContext{
+					row:   bindings{},
+					group: group,
+				}
+			)
+
+			if len(group) > 0 {
+				scope.row = group[0]
+			}
+
+			if row, err := s.projectRow(scope, items); err != nil {
+				return nil, nil, err
+			} else {
+				projected = append(projected, row)
+			}
+		}
+	} else {
+		for _, row := range rows {
+			if row, err := s.projectRow(rowContext(row), items); err != nil {
+				return nil, nil, err
+			} else {
+				projected = append(projected, row)
+			}
+		}
+	}
+
+	if projection.Distinct {
+		var (
+			seen     = map[string]struct{}{}
+			distinct []projectedRow
+		)
+
+		for _, row := range projected {
+			var keyBuilder strings.Builder
+
+			for _, column := range columns {
+				writeValueKey(&keyBuilder, row.values[column])
+				keyBuilder.WriteString(";")
+			}
+
+			if _, exists := seen[keyBuilder.String()]; !exists {
+				seen[keyBuilder.String()] = struct{}{}
+				distinct = append(distinct, row)
+			}
+		}
+
+		projected = distinct
+	}
+
+	if projection.Order != nil && len(projection.Order.Items) > 0 {
+		if err := s.order(projected, projection.Order.Items); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if projection.Skip != nil {
+		if skip, err := s.evaluateCount(projection.Skip.Value); err != nil {
+			return nil, nil, err
+		} else {
+			projected = projected[min(skip, len(projected)):]
+		}
+	}
+
+	if projection.Limit != nil {
+		if limit, err := s.evaluateCount(projection.Limit.Value); err != nil {
+			return nil, nil, err
+		} else {
+			projected = projected[:min(limit, len(projected))]
+		}
+	}
+
+	projectedRows := make([]bindings, len(projected))
+
+	for idx, row := range projected {
+		projectedRows[idx] = row.values
+	}
+
+	return projectedRows, columns, nil
+}
+
+func (s *executor) projectRow(scope evaluationContext, items []projectionItem) (projectedRow, error) {
+	values := make(bindings, len(items))
+
+	for _, item := range items {
+		if value, err := s.evaluate(scope, item.expression); err != nil {
+			return projectedRow{}, err
+		} else {
+			values[item.name] = value
+		}
+	}
+
+	// Sort expressions may refer to both the projected columns and the variables in scope before the projection
+	sortScope := evaluationContext{
+		row:   scope.row.copy(),
+		group: scope.group,
+	}
+
+	for name, value := range values {
+		sortScope.row[name] = value
+	}
+
+	return projectedRow{
+		values: values,
+		scope:  sortScope,
+	}, nil
+}
+
+func (s *executor) order(rows []projectedRow, sortItems []*cypher.SortItem) error {
+	sortKeys := make([][]any, len(rows))
+
+	for rowIdx, row := range rows {
+		sortKeys[rowIdx] = make([]any, len(sortItems))
+
+		for itemIdx, sortItem := range sortItems {
+			if value, err := s.evaluate(row.scope, sortItem.Expression); err != nil {
+				return err
+			} else {
+				sortKeys[rowIdx][itemIdx] = value
+			}
+		}
+	}
+
+	indices := make([]int, len(rows))
+
+	for idx := range indices {
+		indices[idx] = idx
+	}
+
+	slices.SortStableFunc(indices, func(left, right int) int {
+		for itemIdx, sortItem := range sortItems {
+			if ordering := sortOrder(sortKeys[left][itemIdx], sortKeys[right][itemIdx]); ordering != 0 {
+				if sortItem.Ascending {
+					return ordering
+				}
+
+				return -ordering
+			}
+		}
+
+		return 0
+	})
+
+	sorted := make([]projectedRow, len(rows))
+
+	for idx, rowIdx := range indices {
+		sorted[idx] = rows[rowIdx]
+	}
+
+	copy(rows, sorted)
+	return nil
+}
+
+func (s *executor) evaluateCount(expression cypher.Expression) (int, error) {
+	if value, err := s.evaluate(rowContext(bindings{}), expression); err != nil {
+		return 0, err
+	} else if count, isInt := value.(int64); !isInt || count < 0 {
+		return 0, fmt.Errorf("expected a non-negative integer but got %v", value)
+	} else {
+		return int(count), nil
+	}
+}
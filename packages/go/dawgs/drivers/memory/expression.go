@@ -0,0 +1,1068 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/specterops/bloodhound/cypher/models/cypher"
+	"github.com/specterops/bloodhound/dawgs/graph"
+)
+
+// bindings maps query variables to their values for a single row of a query.
+type bindings map[string]any
+
+func (s bindings) copy() bindings {
+	bindingsCopy := make(bindings, len(s)+1)
+
+	for key, value := range s {
+		bindingsCopy[key] = value
+	}
+
+	return bindingsCopy
+}
+
+// evaluationContext is the scope expressions are evaluated in. When group is set, aggregate functions are computed
+// over the rows of the group while all other references resolve against row.
+type evaluationContext struct {
+	row   bindings
+	group []bindings
+}
+
+func rowContext(row bindings) evaluationContext {
+	return evaluationContext{
+		row: row,
+	}
+}
+
+func (s evaluationContext) with(symbol string, value any) evaluationContext {
+	row := s.row.copy()
+	row[symbol] = value
+
+	return evaluationContext{
+		row:   row,
+		group: s.group,
+	}
+}
+
+// normalize converts Go values into the reduced set of types the interpreter operates on: nil, bool, int64, float64,
+// string, time.Time, []any, map[string]any and entity references.
+func normalize(value any) any {
+	switch typedValue := value.(type) {
+	case nil, bool, int64, float64, string, time.Time, *graph.Node, *graph.Relationship, *graph.Path:
+		return value
+	case int:
+		return int64(typedValue)
+	case int8:
+		return int64(typedValue)
+	case int16:
+		return int64(typedValue)
+	case int32:
+		return int64(typedValue)
+	case uint:
+		return int64(typedValue)
+	case uint8:
+		return int64(typedValue)
+	case uint16:
+		return int64(typedValue)
+	case uint32:
+		return int64(typedValue)
+	case uint64:
+		return int64(typedValue)
+	case graph.ID:
+		return typedValue.Int64()
+	case float32:
+		return float64(typedValue)
+	case graph.Kind:
+		return typedValue.String()
+	case graph.Kinds:
+		return normalize(typedValue.Strings())
+	case []any:
+		normalized := make([]any, len(typedValue))
+
+		for idx, element := range typedValue {
+			normalized[idx] = normalize(element)
+		}
+
+		return normalized
+	case map[string]any:
+		normalized := make(map[string]any, len(typedValue))
+
+		for key, element := range typedValue {
+			normalized[key] = normalize(element)
+		}
+
+		return normalized
+	case *time.Time:
+		if typedValue == nil {
+			return nil
+		}
+
+		return *typedValue
+	}
+
+	reflectValue := reflect.ValueOf(value)
+
+	switch reflectValue.Kind() {
+	case reflect.Slice, reflect.Array:
+		normalized := make([]any, reflectValue.Len())
+
+		for idx := 0; idx < reflectValue.Len(); idx++ {
+			normalized[idx] = normalize(reflectValue.Index(idx).Interface())
+		}
+
+		return normalized
+
+	case reflect.String:
+		return reflectValue.String()
+
+	case reflect.Pointer:
+		if reflectValue.IsNil() {
+			return nil
+		}
+
+		return normalize(reflectValue.Elem().Interface())
+	}
+
+	return value
+}
+
+// unquote strips the quotes the cypher parser leaves on string literals.
+func unquote(value string) string {
+	if len(value) >= 2 && (value[0] == '\'' || value[0] == '"') && value[len(value)-1] == value[0] {
+		return strings.NewReplacer(`\\`, `\`, `\'`, `'`, `\"`, `"`, `\n`, "\n", `\t`, "\t").Replace(value[1 : len(value)-1])
+	}
+
+	return value
+}
+
+func truthy(value any) (bool, error) {
+	switch typedValue := value.(type) {
+	case nil:
+		return false, nil
+	case bool:
+		return typedValue, nil
+	default:
+		return false, fmt.Errorf("expected a boolean value but got %T", value)
+	}
+}
+
+func (s *executor) evaluateAll(ctx evaluationContext, expressions []cypher.Expression) ([]any, error) {
+	values := make([]any, len(expressions))
+
+	for idx, expression := range expressions {
+		if value, err := s.evaluate(ctx, expression); err != nil {
+			return nil, err
+		} else {
+			values[idx] = value
+		}
+	}
+
+	return values, nil
+}
+
+func (s *executor) parameter(parameter *cypher.Parameter) any {
+	if parameter.Symbol != "" {
+		if value, found := s.parameters[parameter.Symbol]; found {
+			return normalize(value)
+		}
+	}
+
+	return normalize(parameter.Value)
+}
+
+func (s *executor) evaluate(ctx evaluationContext, expression cypher.Expression) (any, error) {
+	switch typedExpression := expression.(type) {
+	case nil:
+		return nil, nil
+
+	case *cypher.Variable:
+		if value, bound := ctx.row[typedExpression.Symbol]; !bound {
+			return nil, fmt.Errorf("variable %s is not defined", typedExpression.Symbol)
+		} else {
+			return value, nil
+		}
+
+	case *cypher.Parameter:
+		return s.parameter(typedExpression), nil
+
+	case *cypher.Literal:
+		if typedExpression.Null {
+			return nil, nil
+		}
+
+		switch literalValue := typedExpression.Value.(type) {
+		case string:
+			return unquote(literalValue), nil
+
+		case cypher.MapLiteral, *cypher.ListLiteral:
+			return s.evaluate(ctx, literalValue)
+
+		default:
+			return normalize(literalValue), nil
+		}
+
+	case *cypher.ListLiteral:
+		return s.evaluateAll(ctx, *typedExpression)
+
+	case cypher.MapLiteral:
+		return s.evaluateMap(ctx, typedExpression)
+
+	case *cypher.MapLiteral:
+		return s.evaluateMap(ctx, *typedExpression)
+
+	case *cypher.Parenthetical:
+		return s.evaluate(ctx, typedExpression.Expression)
+
+	case *cypher.Negation:
+		if value, err := s.evaluate(ctx, typedExpression.Expression); err != nil {
+			return nil, err
+		} else if value == nil {
+			return nil, nil
+		} else if boolValue, err := truthy(value); err != nil {
+			return nil, err
+		} else {
+			return !boolValue, nil
+		}
+
+	case *cypher.Where:
+		return s.conjunction(ctx, typedExpression.Expressions)
+
+	case *cypher.Conjunction:
+		return s.conjunction(ctx, typedExpression.Expressions)
+
+	case *cypher.Disjunction:
+		return s.disjunction(ctx, typedExpression.Expressions)
+
+	case *cypher.ExclusiveDisjunction:
+		var result any = false
+
+		for _, operand := range typedExpression.Expressions {
+			if value, err := s.evaluate(ctx, operand); err != nil {
+				return nil, err
+			} else if value == nil || result == nil {
+				result = nil
+			} else if boolValue, err := truthy(value); err != nil {
+				return nil, err
+			} else {
+				result = result.(bool) != boolValue
+			}
+		}
+
+		return result, nil
+
+	case *cypher.Comparison:
+		return s.comparison(ctx, typedExpression)
+
+	case *cypher.PropertyLookup:
+		if value, err := s.evaluate(ctx, typedExpression.Atom); err != nil {
+			return nil, err
+		} else {
+			for _, symbol := range typedExpression.Symbols {
+				if value, err = lookupProperty(value, symbol); err != nil {
+					return nil, err
+				}
+			}
+
+			return value, nil
+		}
+
+	case *cypher.KindMatcher:
+		if value, err := s.evaluate(ctx, typedExpression.Reference); err != nil {
+			return nil, err
+		} else {
+			return matchKinds(value, typedExpression.Kinds)
+		}
+
+	case *cypher.FunctionInvocation:
+		return s.function(ctx, typedExpression)
+
+	case *cypher.ArithmeticExpression:
+		if value, err := s.evaluate(ctx, typedExpression.Left); err != nil {
+			return nil, err
+		} else {
+			for _, partial := range typedExpression.Partials {
+				if right, err := s.evaluate(ctx, partial.Right); err != nil {
+					return nil, err
+				} else if value, err = arithmetic(partial.Operator, value, right); err != nil {
+					return nil, err
+				}
+			}
+
+			return value, nil
+		}
+
+	case *cypher.UnaryAddOrSubtractExpression:
+		if value, err := s.evaluate(ctx, typedExpression.Right); err != nil {
+			return nil, err
+		} else if typedExpression.Operator == cypher.OperatorSubtract {
+			return arithmetic(cypher.OperatorMultiply, value, int64(-1))
+		} else {
+			return value, nil
+		}
+
+	case *cypher.Quantifier:
+		return s.quantifier(ctx, typedExpression)
+
+	case *cypher.PatternPredicate:
+		if matches, err := s.matchPatternPart(&cypher.PatternPart{PatternElements: typedExpression.PatternElements}, ctx.row, patternHints{}); err != nil {
+			return nil, err
+		} else {
+			return len(matches) > 0, nil
+		}
+
+	default:
+		return nil, fmt.Errorf("%w: expression type %T", ErrUnsupportedQuery, expression)
+	}
+}
+
+func (s *executor) evaluateMap(ctx evaluationContext, mapLiteral cypher.MapLiteral) (map[string]any, error) {
+	values := make(map[string]any, len(mapLiteral))
+
+	for key, expression := range mapLiteral {
+		if value, err := s.evaluate(ctx, expression); err != nil {
+			return nil, err
+		} else {
+			values[key] = value
+		}
+	}
+
+	return values, nil
+}
+
+func (s *executor) conjunction(ctx evaluationContext, operands []cypher.Expression) (any, error) {
+	var result any = true
+
+	for _, operand := range operands {
+		if value, err := s.evaluate(ctx, operand); err != nil {
+			return nil, err
+		} else if value == nil {
+			result = nil
+		} else if boolValue, err := truthy(value); err != nil {
+			return nil, err
+		} else if !boolValue {
+			return false, nil
+		}
+	}
+
+	return result, nil
+}
+
+func (s *executor) disjunction(ctx evaluationContext, operands []cypher.Expression) (any, error) {
+	var result any = false
+
+	for _, operand := range operands {
+		if value, err := s.evaluate(ctx, operand); err != nil {
+			return nil, err
+		} else if value == nil {
+			result = nil
+		} else if boolValue, err := truthy(value); err != nil {
+			return nil, err
+		} else if boolValue {
+			return true, nil
+		}
+	}
+
+	return result, nil
+}
+
+func (s *executor) quantifier(ctx evaluationContext, quantifier *cypher.Quantifier) (any, error) {
+	if quantifier.Filter == nil || quantifier.Filter.Specifier == nil || quantifier.Filter.Specifier.Variable == nil {
+		return nil, fmt.Errorf("%w: quantifier without a filter", ErrUnsupportedQuery)
+	}
+
+	collection, err := s.evaluate(ctx, quantifier.Filter.Specifier.Expression)
+	if err != nil || collection == nil {
+		return nil, err
+	}
+
+	elements, isList := collection.([]any)
+	if !isList {
+		return nil, fmt.Errorf("expected a list for quantifier %s but got %T", quantifier.Type, collection)
+	}
+
+	var (
+		numMatched = 0
+		sawNull    = false
+	)
+
+	for _, element := range elements {
+		var value any = true
+
+		if quantifier.Filter.Where != nil {
+			if value, err = s.evaluate(ctx.with(quantifier.Filter.Specifier.Variable.Symbol, element), quantifier.Filter.Where); err != nil {
+				return nil, err
+			}
+		}
+
+		if value == nil {
+			sawNull = true
+		} else if matched, err := truthy(value); err != nil {
+			return nil, err
+		} else if matched {
+			numMatched++
+		}
+	}
+
+	switch quantifier.Type {
+	case cypher.QuantifierTypeAny:
+		if numMatched > 0 {
+			return true, nil
+		}
+
+	case cypher.QuantifierTypeAll:
+		if numMatched+boolToInt(sawNull) < len(elements) {
+			return false, nil
+		} else if !sawNull {
+			return true, nil
+		}
+
+	case cypher.QuantifierTypeNone:
+		if numMatched > 0 {
+			return false, nil
+		} else if !sawNull {
+			return true, nil
+		}
+
+	case cypher.QuantifierTypeSingle:
+		if numMatched > 1 {
+			return false, nil
+		} else if !sawNull {
+			return numMatched == 1, nil
+		}
+
+	default:
+		return nil, fmt.Errorf("%w: quantifier type %s", ErrUnsupportedQuery, quantifier.Type)
+	}
+
+	if sawNull {
+		return nil, nil
+	}
+
+	return false, nil
+}
+
+func boolToInt(value bool) int {
+	if value {
+		return 1
+	}
+
+	return 0
+}
+
+func lookupProperty(value any, symbol string) (any, error) {
+	switch typedValue := value.(type) {
+	case nil:
+		return nil, nil
+
+	case *graph.Node:
+		return normalize(typedValue.Properties.Map[symbol]), nil
+
+	case *graph.Relationship:
+		return normalize(typedValue.Properties.Map[symbol]), nil
+
+	case map[string]any:
+		return typedValue[symbol], nil
+
+	default:
+		return nil, fmt.Errorf("unable to look up property %s on type %T", symbol, value)
+	}
+}
+
+// matchKinds returns true if the given entity has any of the given kinds. Relationship lists, as bound by variable
+// length patterns, match if all relationships in the list match.
+func matchKinds(value any, kinds graph.Kinds) (any, error) {
+	switch typedValue := value.(type) {
+	case nil:
+		return nil, nil
+
+	case *graph.Node:
+		return typedValue.Kinds.ContainsOneOf(kinds...), nil
+
+	case *graph.Relationship:
+		return typedValue.Kind.Is(kinds...), nil
+
+	case []any:
+		for _, element := range typedValue {
+			if matched, err := matchKinds(element, kinds); err != nil || matched != true {
+				return matched, err
+			}
+		}
+
+		return true, nil
+
+	default:
+		return nil, fmt.Errorf("unable to match kinds on type %T", value)
+	}
+}
+
+func (s *executor) comparison(ctx evaluationContext, comparison *cypher.Comparison) (any, error) {
+	left, err := s.evaluate(ctx, comparison.Left)
+	if err != nil {
+		return nil, err
+	}
+
+	var result any = true
+
+	for _, partial := range comparison.Partials {
+		if right, err := s.evaluate(ctx, partial.Right); err != nil {
+			return nil, err
+		} else if value, err := compare(partial.Operator, left, right); err != nil {
+			return nil, err
+		} else {
+			if value == nil {
+				result = nil
+			} else if value == false {
+				return false, nil
+			}
+
+			left = right
+		}
+	}
+
+	return result, nil
+}
+
+func compare(operator cypher.Operator, left, right any) (any, error) {
+	switch operator {
+	case cypher.OperatorIs:
+		return left == nil, nil
+
+	case cypher.OperatorIsNot:
+		return left != nil, nil
+
+	case cypher.OperatorEquals:
+		return equals(left, right), nil
+
+	case cypher.OperatorNotEquals:
+		if value := equals(left, right); value == nil {
+			return nil, nil
+		} else {
+			return !value.(bool), nil
+		}
+
+	case cypher.OperatorIn:
+		if left == nil || right == nil {
+			return nil, nil
+		} else if elements, isList := right.([]any); !isList {
+			return nil, fmt.Errorf("expected a list for the right operand of IN but got %T", right)
+		} else {
+			var result any = false
+
+			for _, element := range elements {
+				if value := equals(left, element); value == true {
+					return true, nil
+				} else if value == nil {
+					result = nil
+				}
+			}
+
+			return result, nil
+		}
+
+	case cypher.OperatorLessThan, cypher.OperatorLessThanOrEqualTo, cypher.OperatorGreaterThan, cypher.OperatorGreaterThanOrEqualTo:
+		if ordering, comparable := order(left, right); !comparable {
+			return nil, nil
+		} else {
+			switch operator {
+			case cypher.OperatorLessThan:
+				return ordering < 0, nil
+			case cypher.OperatorLessThanOrEqualTo:
+				return ordering <= 0, nil
+			case cypher.OperatorGreaterThan:
+				return ordering > 0, nil
+			default:
+				return ordering >= 0, nil
+			}
+		}
+
+	case cypher.OperatorStartsWith, cypher.OperatorEndsWith, cypher.OperatorContains, cypher.OperatorRegexMatch:
+		leftString, leftIsString := left.(string)
+		rightString, rightIsString := right.(string)
+
+		if !leftIsString || !rightIsString {
+			return nil, nil
+		}
+
+		switch operator {
+		case cypher.OperatorStartsWith:
+			return strings.HasPrefix(leftString, rightString), nil
+		case cypher.OperatorEndsWith:
+			return strings.HasSuffix(leftString, rightString), nil
+		case cypher.OperatorContains:
+			return strings.Contains(leftString, rightString), nil
+		default:
+			if expression, err := regexp.Compile("^(?:" + rightString + ")$"); err != nil {
+				return nil, err
+			} else {
+				return expression.MatchString(leftString), nil
+			}
+		}
+
+	default:
+		return nil, fmt.Errorf("%w: comparison operator %s", ErrUnsupportedQuery, operator)
+	}
+}
+
+func asFloat(value any) (float64, bool) {
+	switch typedValue := value.(type) {
+	case int64:
+		return float64(typedValue), true
+	case float64:
+		return typedValue, true
+	default:
+		return 0, false
+	}
+}
+
+func asTime(value any) (time.Time, bool) {
+	switch typedValue := value.(type) {
+	case time.Time:
+		return typedValue, true
+	case string:
+		if parsed, err := time.Parse(time.RFC3339Nano, typedValue); err == nil {
+			return parsed, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+func entityID(value any) (string, graph.ID, bool) {
+	switch typedValue := value.(type) {
+	case *graph.Node:
+		return "node", typedValue.ID, true
+	case *graph.Relationship:
+		return "relationship", typedValue.ID, true
+	default:
+		return "", 0, false
+	}
+}
+
+// equals implements cypher equality. The result is nil if either operand is null.
+func equals(left, right any) any {
+	if left == nil || right == nil {
+		return nil
+	}
+
+	if leftKind, leftID, isEntity := entityID(left); isEntity {
+		rightKind, rightID, rightIsEntity := entityID(right)
+		return rightIsEntity && leftKind == rightKind && leftID == rightID
+	}
+
+	switch typedLeft := left.(type) {
+	case int64, float64:
+		if leftNumber, _ := asFloat(typedLeft); true {
+			if rightNumber, isNumber := asFloat(right); isNumber {
+				if leftInt, isInt := typedLeft.(int64); isInt {
+					if rightInt, rightIsInt := right.(int64); rightIsInt {
+						return leftInt == rightInt
+					}
+				}
+
+				return leftNumber == rightNumber
+			}
+		}
+
+		return false
+
+	case time.Time:
+		if rightTime, isTime := asTime(right); isTime {
+			return typedLeft.Equal(rightTime)
+		}
+
+		return false
+
+	case string:
+		if rightTime, isTime := right.(time.Time); isTime {
+			if leftTime, leftIsTime := asTime(typedLeft); leftIsTime {
+				return leftTime.Equal(rightTime)
+			}
+		}
+
+		return left == right
+
+	case []any:
+		if rightList, isList := right.([]any); !isList || len(rightList) != len(typedLeft) {
+			return false
+		} else {
+			var result any = true
+
+			for idx := range typedLeft {
+				if value := equals(typedLeft[idx], rightList[idx]); value == false {
+					return false
+				} else if value == nil {
+					result = nil
+				}
+			}
+
+			return result
+		}
+
+	case map[string]any:
+		if rightMap, isMap := right.(map[string]any); !isMap || len(rightMap) != len(typedLeft) {
+			return false
+		} else {
+			for key, value := range typedLeft {
+				if rightValue, found := rightMap[key]; !found || equals(value, rightValue) != true {
+					return false
+				}
+			}
+
+			return true
+		}
+
+	case *graph.Path:
+		if rightPath, isPath := right.(*graph.Path); !isPath || len(rightPath.Edges) != len(typedLeft.Edges) || len(rightPath.Nodes) != len(typedLeft.Nodes) {
+			return false
+		} else {
+			for idx := range typedLeft.Nodes {
+				if typedLeft.Nodes[idx].ID != rightPath.Nodes[idx].ID {
+					return false
+				}
+			}
+
+			for idx := range typedLeft.Edges {
+				if typedLeft.Edges[idx].ID != rightPath.Edges[idx].ID {
+					return false
+				}
+			}
+
+			return true
+		}
+
+	default:
+		return left == right
+	}
+}
+
+// order compares two values of comparable types. The second return value is false if the values can not be ordered
+// against each other.
+func order(left, right any) (int, bool) {
+	if leftNumber, isNumber := asFloat(left); isNumber {
+		if rightNumber, rightIsNumber := asFloat(right); rightIsNumber {
+			if leftInt, isInt := left.(int64); isInt {
+				if rightInt, rightIsInt := right.(int64); rightIsInt {
+					return compareInts(leftInt, rightInt), true
+				}
+			}
+
+			return compareFloats(leftNumber, rightNumber), true
+		}
+
+		return 0, false
+	}
+
+	if leftTime, isTime := left.(time.Time); isTime {
+		if rightTime, rightIsTime := asTime(right); rightIsTime {
+			return leftTime.Compare(rightTime), true
+		}
+
+		return 0, false
+	}
+
+	if rightTime, rightIsTime := right.(time.Time); rightIsTime {
+		if leftTime, isTime := asTime(left); isTime {
+			return leftTime.Compare(rightTime), true
+		}
+
+		return 0, false
+	}
+
+	switch typedLeft := left.(type) {
+	case string:
+		if rightString, isString := right.(string); isString {
+			return compareStrings(typedLeft, rightString), true
+		}
+
+	case bool:
+		if rightBool, isBool := right.(bool); isBool {
+			return boolToInt(typedLeft) - boolToInt(rightBool), true
+		}
+
+	case []any:
+		if rightList, isList := right.([]any); isList {
+			for idx := 0; idx < len(typedLeft) && idx < len(rightList); idx++ {
+				if ordering, comparable := order(typedLeft[idx], rightList[idx]); !comparable {
+					return 0, false
+				} else if ordering != 0 {
+					return ordering, true
+				}
+			}
+
+			return compareInts(int64(len(typedLeft)), int64(len(rightList))), true
+		}
+	}
+
+	if leftKind, leftID, isEntity := entityID(left); isEntity {
+		if rightKind, rightID, rightIsEntity := entityID(right); rightIsEntity && leftKind == rightKind {
+			return compareInts(leftID.Int64(), rightID.Int64()), true
+		}
+	}
+
+	return 0, false
+}
+
+func compareInts(left, right int64) int {
+	if left < right {
+		return -1
+	} else if left > right {
+		return 1
+	}
+
+	return 0
+}
+
+func compareFloats(left, right float64) int {
+	if left < right {
+		return -1
+	} else if left > right {
+		return 1
+	}
+
+	return 0
+}
+
+func compareStrings(left, right string) int {
+	return strings.Compare(left, right)
+}
+
+// typeRank orders values of different types for sorting. Nulls sort last in ascending order.
+func typeRank(value any) int {
+	switch value.(type) {
+	case map[string]any:
+		return 0
+	case *graph.Node:
+		return 1
+	case *graph.Relationship:
+		return 2
+	case []any:
+		return 3
+	case *graph.Path:
+		return 4
+	case string:
+		return 5
+	case bool:
+		return 6
+	case int64, float64:
+		return 7
+	case time.Time:
+		return 8
+	case nil:
+		return 10
+	default:
+		return 9
+	}
+}
+
+// sortOrder provides a total order over all values for ORDER BY.
+func sortOrder(left, right any) int {
+	if ordering, comparable := order(left, right); comparable {
+		return ordering
+	}
+
+	return typeRank(left) - typeRank(right)
+}
+
+func arithmetic(operator cypher.Operator, left, right any) (any, error) {
+	if left == nil || right == nil {
+		return nil, nil
+	}
+
+	if operator == cypher.OperatorAdd {
+		switch typedLeft := left.(type) {
+		case string:
+			if rightString, isString := right.(string); isString {
+				return typedLeft + rightString, nil
+			} else if _, isNumber := asFloat(right); isNumber {
+				return typedLeft + toString(right), nil
+			}
+
+		case []any:
+			if rightList, isList := right.([]any); isList {
+				return append(slices.Clone(typedLeft), rightList...), nil
+			}
+
+			return append(slices.Clone(typedLeft), right), nil
+		}
+
+		if rightString, isString := right.(string); isString {
+			if _, isNumber := asFloat(left); isNumber {
+				return toString(left) + rightString, nil
+			}
+		}
+
+		if rightList, isList := right.([]any); isList {
+			return append([]any{left}, rightList...), nil
+		}
+	}
+
+	leftInt, leftIsInt := left.(int64)
+	rightInt, rightIsInt := right.(int64)
+
+	if leftIsInt && rightIsInt && operator != cypher.OperatorPowerOf {
+		switch operator {
+		case cypher.OperatorAdd:
+			return leftInt + rightInt, nil
+		case cypher.OperatorSubtract:
+			return leftInt - rightInt, nil
+		case cypher.OperatorMultiply:
+			return leftInt * rightInt, nil
+		case cypher.OperatorDivide, cypher.OperatorModulo:
+			if rightInt == 0 {
+				return nil, fmt.Errorf("division by zero")
+			} else if operator == cypher.OperatorDivide {
+				return leftInt / rightInt, nil
+			}
+
+			return leftInt % rightInt, nil
+		}
+	}
+
+	leftFloat, leftIsNumber := asFloat(left)
+	rightFloat, rightIsNumber := asFloat(right)
+
+	if !leftIsNumber || !rightIsNumber {
+		return nil, fmt.Errorf("unsupported operand types for %s: %T and %T", operator, left, right)
+	}
+
+	switch operator {
+	case cypher.OperatorAdd:
+		return leftFloat + rightFloat, nil
+	case cypher.OperatorSubtract:
+		return leftFloat - rightFloat, nil
+	case cypher.OperatorMultiply:
+		return leftFloat * rightFloat, nil
+	case cypher.OperatorDivide:
+		return leftFloat / rightFloat, nil
+	case cypher.OperatorModulo:
+		return math.Mod(leftFloat, rightFloat), nil
+	case cypher.OperatorPowerOf:
+		return math.Pow(leftFloat, rightFloat), nil
+	default:
+		return nil, fmt.Errorf("%w: arithmetic operator %s", ErrUnsupportedQuery, operator)
+	}
+}
+
+func toString(value any) string {
+	switch typedValue := value.(type) {
+	case string:
+		return typedValue
+	case int64:
+		return strconv.FormatInt(typedValue, 10)
+	case float64:
+		return strconv.FormatFloat(typedValue, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(typedValue)
+	case time.Time:
+		return typedValue.Format(time.RFC3339Nano)
+	default:
+		return fmt.Sprintf("%v", value)
+	}
+}
+
+// valueKey renders a value into a string key that is equal for values that are equal. It is used for grouping and
+// DISTINCT projections.
+func valueKey(value any) string {
+	builder := &strings.Builder{}
+	writeValueKey(builder, value)
+
+	return builder.String()
+}
+
+func writeValueKey(builder *strings.Builder, value any) {
+	switch typedValue := value.(type) {
+	case nil:
+		builder.WriteString("null")
+
+	case *graph.Node:
+		builder.WriteString("n")
+		builder.WriteString(typedValue.ID.String())
+
+	case *graph.Relationship:
+		builder.WriteString("r")
+		builder.WriteString(typedValue.ID.String())
+
+	case *graph.Path:
+		builder.WriteString("p[")
+
+		for _, node := range typedValue.Nodes {
+			builder.WriteString(node.ID.String())
+			builder.WriteString(",")
+		}
+
+		builder.WriteString("|")
+
+		for _, edge := range typedValue.Edges {
+			builder.WriteString(edge.ID.String())
+			builder.WriteString(",")
+		}
+
+		builder.WriteString("]")
+
+	case []any:
+		builder.WriteString("[")
+
+		for _, element := range typedValue {
+			writeValueKey(builder, element)
+			builder.WriteString(",")
+		}
+
+		builder.WriteString("]")
+
+	case map[string]any:
+		keys := make([]string, 0, len(typedValue))
+
+		for key := range typedValue {
+			keys = append(keys, key)
+		}
+
+		slices.Sort(keys)
+		builder.WriteString("{")
+
+		for _, key := range keys {
+			builder.WriteString(strconv.Quote(key))
+			builder.WriteString(":")
+			writeValueKey(builder, typedValue[key])
+			builder.WriteString(",")
+		}
+
+		builder.WriteString("}")
+
+	case string:
+		builder.WriteString(strconv.Quote(typedValue))
+
+	case int64:
+		// Integers and floats of equal value group together
+		builder.WriteString("#")
+		builder.WriteString(strconv.FormatFloat(float64(typedValue), 'g', -1, 64))
+
+	case float64:
+		builder.WriteString("#")
+		builder.WriteString(strconv.FormatFloat(typedValue, 'g', -1, 64))
+
+	default:
+		builder.WriteString(fmt.Sprintf("%T:%v", value, value))
+	}
+}
@@ -0,0 +1,464 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/specterops/bloodhound/cypher/models/cypher"
+	"github.com/specterops/bloodhound/dawgs/graph"
+)
+
+const (
+	sumFunction     = "sum"
+	minFunction     = "min"
+	maxFunction     = "max"
+	avgFunction     = "avg"
+	existsFunction  = "exists"
+	lengthFunction  = "length"
+	nodesFunction   = "nodes"
+	relsFunction    = "relationships"
+	startFunction   = "startnode"
+	endFunction     = "endnode"
+	keysFunction    = "keys"
+	propsFunction   = "properties"
+	headFunction    = "head"
+	lastFunction    = "last"
+	absFunction     = "abs"
+	toFloatFunction = "tofloat"
+	toIntegerFunc   = "tointeger"
+	toBoolFunction  = "toboolean"
+	trimFunction    = "trim"
+	lTrimFunction   = "ltrim"
+	rTrimFunction   = "rtrim"
+	replaceFunction = "replace"
+	substrFunction  = "substring"
+)
+
+func isAggregateName(name string) bool {
+	switch strings.ToLower(name) {
+	case cypher.CountFunction, cypher.CollectFunction, sumFunction, minFunction, maxFunction, avgFunction:
+		return true
+	default:
+		return false
+	}
+}
+
+func isAggregate(function *cypher.FunctionInvocation) bool {
+	return len(function.Namespace) == 0 && isAggregateName(function.Name)
+}
+
+// containsAggregate returns true if the given expression contains an aggregate function invocation.
+func containsAggregate(expression cypher.Expression) bool {
+	found := false
+
+	cypher.Walk(expression, cypher.NewVisitor(func(stack *cypher.WalkStack, element cypher.Expression) error {
+		if function, isFunction := element.(*cypher.FunctionInvocation); isFunction && isAggregate(function) {
+			found = true
+		}
+
+		return nil
+	}, nil))
+
+	return found
+}
+
+func (s *executor) aggregate(ctx evaluationContext, function *cypher.FunctionInvocation) (any, error) {
+	if ctx.group == nil {
+		return nil, fmt.Errorf("aggregate function %s used outside of an aggregating projection", function.Name)
+	} else if len(function.Arguments) != 1 {
+		return nil, fmt.Errorf("aggregate function %s expects exactly one argument", function.Name)
+	}
+
+	name := strings.ToLower(function.Name)
+
+	if _, isCountAll := function.Arguments[0].(*cypher.RangeQuantifier); isCountAll && name == cypher.CountFunction {
+		return int64(len(ctx.group)), nil
+	}
+
+	var (
+		values []any
+		seen   = map[string]struct{}{}
+	)
+
+	for _, row := range ctx.group {
+		if value, err := s.evaluate(rowContext(row), function.Arguments[0]); err != nil {
+			return nil, err
+		} else if value != nil {
+			if function.Distinct {
+				key := valueKey(value)
+
+				if _, exists := seen[key]; exists {
+					continue
+				}
+
+				seen[key] = struct{}{}
+			}
+
+			values = append(values, value)
+		}
+	}
+
+	switch name {
+	case cypher.CountFunction:
+		return int64(len(values)), nil
+
+	case cypher.CollectFunction:
+		if values == nil {
+			return []any{}, nil
+		}
+
+		return values, nil
+
+	case sumFunction, avgFunction:
+		var sum any = int64(0)
+
+		for _, value := range values {
+			if _, isNumber := asFloat(value); !isNumber {
+				return nil, fmt.Errorf("%s expects numeric values but got %T", name, value)
+			} else if next, err := arithmetic(cypher.OperatorAdd, sum, value); err != nil {
+				return nil, err
+			} else {
+				sum = next
+			}
+		}
+
+		if name == sumFunction {
+			return sum, nil
+		} else if len(values) == 0 {
+			return nil, nil
+		}
+
+		total, _ := asFloat(sum)
+		return total / float64(len(values)), nil
+
+	default:
+		var selected any
+
+		for _, value := range values {
+			if selected == nil {
+				selected = value
+			} else if ordering := sortOrder(value, selected); (name == minFunction && ordering < 0) || (name == maxFunction && ordering > 0) {
+				selected = value
+			}
+		}
+
+		return selected, nil
+	}
+}
+
+func parseTime(value any) (any, error) {
+	switch typedValue := value.(type) {
+	case nil:
+		return nil, nil
+
+	case time.Time:
+		return typedValue, nil
+
+	case string:
+		for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05.999999999", "2006-01-02"} {
+			if parsed, err := time.Parse(layout, typedValue); err == nil {
+				return parsed, nil
+			}
+		}
+
+		return nil, fmt.Errorf("unable to parse %q as a temporal value", typedValue)
+
+	default:
+		return nil, fmt.Errorf("unable to convert type %T to a temporal value", value)
+	}
+}
+
+func (s *executor) function(ctx evaluationContext, function *cypher.FunctionInvocation) (any, error) {
+	if len(function.Namespace) > 0 {
+		return nil, fmt.Errorf("%w: function %s.%s", ErrUnsupportedQuery, strings.Join(function.Namespace, "."), function.Name)
+	}
+
+	if isAggregate(function) {
+		return s.aggregate(ctx, function)
+	}
+
+	name := strings.ToLower(function.Name)
+
+	arguments, err := s.evaluateAll(ctx, function.Arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	// Functions without arguments
+	switch name {
+	case cypher.DateTimeFunction, cypher.LocalDateTimeFunction, cypher.DateFunction:
+		if len(arguments) == 0 {
+			now := time.Now().UTC()
+
+			if name == cypher.DateFunction {
+				return now.Truncate(24 * time.Hour), nil
+			}
+
+			return now, nil
+		}
+	}
+
+	if name == cypher.CoalesceFunction {
+		for _, argument := range arguments {
+			if argument != nil {
+				return argument, nil
+			}
+		}
+
+		return nil, nil
+	}
+
+	if len(arguments) == 0 {
+		return nil, fmt.Errorf("function %s expects at least one argument", function.Name)
+	}
+
+	argument := arguments[0]
+
+	if argument == nil && name != existsFunction {
+		return nil, nil
+	}
+
+	switch name {
+	case cypher.IdentityFunction:
+		if _, id, isEntity := entityID(argument); isEntity {
+			return id.Int64(), nil
+		}
+
+	case cypher.NodeLabelsFunction:
+		if node, isNode := argument.(*graph.Node); isNode {
+			return normalize(node.Kinds.Strings()), nil
+		}
+
+	case cypher.EdgeTypeFunction:
+		if relationship, isRelationship := argument.(*graph.Relationship); isRelationship {
+			return relationship.Kind.String(), nil
+		}
+
+	case startFunction, endFunction:
+		if relationship, isRelationship := argument.(*graph.Relationship); isRelationship {
+			nodeID := relationship.StartID
+
+			if name == endFunction {
+				nodeID = relationship.EndID
+			}
+
+			if node, exists := s.store.nodes[nodeID]; exists {
+				return node, nil
+			}
+
+			return nil, nil
+		}
+
+	case nodesFunction, relsFunction:
+		if path, isPath := argument.(*graph.Path); isPath {
+			var elements []any
+
+			if name == nodesFunction {
+				for _, node := range path.Nodes {
+					elements = append(elements, node)
+				}
+			} else {
+				for _, edge := range path.Edges {
+					elements = append(elements, edge)
+				}
+			}
+
+			if elements == nil {
+				return []any{}, nil
+			}
+
+			return elements, nil
+		}
+
+	case lengthFunction:
+		if path, isPath := argument.(*graph.Path); isPath {
+			return int64(len(path.Edges)), nil
+		} else if stringValue, isString := argument.(string); isString {
+			return int64(len([]rune(stringValue))), nil
+		}
+
+	case cypher.ListSizeFunction:
+		switch typedArgument := argument.(type) {
+		case []any:
+			return int64(len(typedArgument)), nil
+		case string:
+			return int64(len([]rune(typedArgument))), nil
+		}
+
+	case headFunction, lastFunction:
+		if elements, isList := argument.([]any); isList {
+			if len(elements) == 0 {
+				return nil, nil
+			} else if name == headFunction {
+				return elements[0], nil
+			}
+
+			return elements[len(elements)-1], nil
+		}
+
+	case keysFunction, propsFunction:
+		var properties map[string]any
+
+		switch typedArgument := argument.(type) {
+		case *graph.Node:
+			properties = normalize(typedArgument.Properties.Map).(map[string]any)
+		case *graph.Relationship:
+			properties = normalize(typedArgument.Properties.Map).(map[string]any)
+		case map[string]any:
+			properties = typedArgument
+		default:
+			return nil, fmt.Errorf("function %s does not support type %T", function.Name, argument)
+		}
+
+		if name == propsFunction {
+			return properties, nil
+		}
+
+		keys := make([]any, 0, len(properties))
+
+		for _, key := range slices.Sorted(maps.Keys(properties)) {
+			keys = append(keys, key)
+		}
+
+		return keys, nil
+
+	case existsFunction:
+		return argument != nil, nil
+
+	case cypher.ToLowerFunction, cypher.ToUpperFunction, trimFunction, lTrimFunction, rTrimFunction:
+		if stringValue, isString := argument.(string); isString {
+			switch name {
+			case cypher.ToLowerFunction:
+				return strings.ToLower(stringValue), nil
+			case cypher.ToUpperFunction:
+				return strings.ToUpper(stringValue), nil
+			case trimFunction:
+				return strings.TrimSpace(stringValue), nil
+			case lTrimFunction:
+				return strings.TrimLeft(stringValue, " \t\r\n"), nil
+			default:
+				return strings.TrimRight(stringValue, " \t\r\n"), nil
+			}
+		}
+
+	case replaceFunction:
+		if len(arguments) == 3 {
+			if stringValue, isString := argument.(string); isString {
+				if search, isString := arguments[1].(string); isString {
+					if replacement, isString := arguments[2].(string); isString {
+						return strings.ReplaceAll(stringValue, search, replacement), nil
+					}
+				}
+			}
+		}
+
+	case substrFunction:
+		if stringValue, isString := argument.(string); isString && len(arguments) >= 2 {
+			runes := []rune(stringValue)
+
+			if start, isInt := arguments[1].(int64); isInt {
+				start = min(max(start, 0), int64(len(runes)))
+				end := int64(len(runes))
+
+				if len(arguments) == 3 {
+					if length, isInt := arguments[2].(int64); isInt {
+						end = min(start+max(length, 0), end)
+					}
+				}
+
+				return string(runes[start:end]), nil
+			}
+		}
+
+	case cypher.StringSplitToArrayFunction:
+		if stringValue, isString := argument.(string); isString && len(arguments) == 2 {
+			if delimiter, isString := arguments[1].(string); isString {
+				return normalize(strings.Split(stringValue, delimiter)), nil
+			}
+		}
+
+	case cypher.ToStringFunction:
+		return toString(argument), nil
+
+	case cypher.ToIntegerFunction, toIntegerFunc:
+		switch typedArgument := argument.(type) {
+		case int64:
+			return typedArgument, nil
+		case float64:
+			return int64(typedArgument), nil
+		case string:
+			if parsed, err := strconv.ParseFloat(strings.TrimSpace(typedArgument), 64); err == nil {
+				return int64(parsed), nil
+			}
+
+			return nil, nil
+		}
+
+	case toFloatFunction:
+		switch typedArgument := argument.(type) {
+		case int64:
+			return float64(typedArgument), nil
+		case float64:
+			return typedArgument, nil
+		case string:
+			if parsed, err := strconv.ParseFloat(strings.TrimSpace(typedArgument), 64); err == nil {
+				return parsed, nil
+			}
+
+			return nil, nil
+		}
+
+	case toBoolFunction:
+		switch typedArgument := argument.(type) {
+		case bool:
+			return typedArgument, nil
+		case string:
+			if parsed, err := strconv.ParseBool(strings.TrimSpace(typedArgument)); err == nil {
+				return parsed, nil
+			}
+
+			return nil, nil
+		}
+
+	case absFunction:
+		switch typedArgument := argument.(type) {
+		case int64:
+			if typedArgument < 0 {
+				return -typedArgument, nil
+			}
+
+			return typedArgument, nil
+		case float64:
+			return math.Abs(typedArgument), nil
+		}
+
+	case cypher.DateTimeFunction, cypher.LocalDateTimeFunction, cypher.DateFunction:
+		return parseTime(argument)
+
+	default:
+		return nil, fmt.Errorf("%w: function %s", ErrUnsupportedQuery, function.Name)
+	}
+
+	return nil, fmt.Errorf("function %s does not support type %T", function.Name, argument)
+}
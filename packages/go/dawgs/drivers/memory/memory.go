@@ -0,0 +1,43 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package memory implements a DAWGS driver that keeps the entire graph in process memory. The driver requires no
+// external database server and is intended for tests and ephemeral analysis. Cypher queries, both raw and those built
+// with the query package, are interpreted directly against the in-memory graph.
+package memory
+
+import (
+	"context"
+	"errors"
+
+	"github.com/specterops/bloodhound/dawgs"
+	"github.com/specterops/bloodhound/dawgs/graph"
+)
+
+const (
+	DriverName = "memory"
+)
+
+var (
+	ErrReadOnlyTransaction = errors.New("write operation attempted in a read-only transaction")
+	ErrUnsupportedQuery    = errors.New("unsupported query construct")
+)
+
+func init() {
+	dawgs.Register(DriverName, func(ctx context.Context, cfg dawgs.Config) (graph.Database, error) {
+		return NewDriver(cfg.GraphQueryMemoryLimit), nil
+	})
+}
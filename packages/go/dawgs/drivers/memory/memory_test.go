@@ -0,0 +1,688 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package memory_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/specterops/bloodhound/dawgs"
+	"github.com/specterops/bloodhound/dawgs/drivers/memory"
+	"github.com/specterops/bloodhound/dawgs/graph"
+	"github.com/specterops/bloodhound/dawgs/ops"
+	"github.com/specterops/bloodhound/dawgs/query"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	User     = graph.StringKind("User")
+	Group    = graph.StringKind("Group")
+	Computer = graph.StringKind("Computer")
+	MemberOf = graph.StringKind("MemberOf")
+	AdminTo  = graph.StringKind("AdminTo")
+)
+
+type testGraph struct {
+	db       graph.Database
+	user     *graph.Node
+	group    *graph.Node
+	computer *graph.Node
+}
+
+// newTestGraph creates the graph (user)-[:MemberOf]->(group)-[:AdminTo]->(computer) with an additional
+// (user)-[:AdminTo]->(computer) shortcut.
+func newTestGraph(t *testing.T) testGraph {
+	var (
+		ctx       = context.Background()
+		db, err   = dawgs.Open(ctx, memory.DriverName, dawgs.Config{})
+		testGraph = testGraph{
+			db: db,
+		}
+	)
+
+	require.Nil(t, err)
+	require.Nil(t, db.WriteTransaction(ctx, func(tx graph.Transaction) error {
+		if testGraph.user, err = tx.CreateNode(graph.AsProperties(map[string]any{"name": "alice", "enabled": true}), User); err != nil {
+			return err
+		} else if testGraph.group, err = tx.CreateNode(graph.AsProperties(map[string]any{"name": "admins"}), Group); err != nil {
+			return err
+		} else if testGraph.computer, err = tx.CreateNode(graph.AsProperties(map[string]any{"name": "ws01", "rank": 5}), Computer); err != nil {
+			return err
+		} else if _, err := tx.CreateRelationshipByIDs(testGraph.user.ID, testGraph.group.ID, MemberOf, graph.NewProperties()); err != nil {
+			return err
+		} else if _, err := tx.CreateRelationshipByIDs(testGraph.group.ID, testGraph.computer.ID, AdminTo, graph.NewProperties()); err != nil {
+			return err
+		} else {
+			_, err := tx.CreateRelationshipByIDs(testGraph.user.ID, testGraph.computer.ID, AdminTo, graph.NewProperties())
+			return err
+		}
+	}))
+
+	return testGraph
+}
+
+func TestNodeQuery(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		testGraph = newTestGraph(t)
+	)
+
+	require.Nil(t, testGraph.db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		count, err := tx.Nodes().Count()
+		require.Nil(t, err)
+		require.Equal(t, int64(3), count)
+
+		node, err := tx.Nodes().Filter(query.Kind(query.Node(), Group)).First()
+		require.Nil(t, err)
+		require.Equal(t, testGraph.group.ID, node.ID)
+
+		nodes, err := ops.FetchNodes(tx.Nodes().Filter(query.StringStartsWith(query.NodeProperty("name"), "ws")))
+		require.Nil(t, err)
+		require.Len(t, nodes, 1)
+		require.Equal(t, testGraph.computer.ID, nodes[0].ID)
+
+		nodeIDs, err := ops.FetchNodeIDs(tx.Nodes().Filter(query.InIDs(query.NodeID(), testGraph.user.ID, testGraph.computer.ID)))
+		require.Nil(t, err)
+		require.ElementsMatch(t, []graph.ID{testGraph.user.ID, testGraph.computer.ID}, nodeIDs)
+
+		nodeIDs, err = ops.FetchNodeIDs(tx.Nodes().Filter(query.GreaterThan(query.NodeProperty("rank"), 1)))
+		require.Nil(t, err)
+		require.Equal(t, []graph.ID{testGraph.computer.ID}, nodeIDs)
+
+		_, err = tx.Nodes().Filter(query.Kind(query.Node(), graph.StringKind("Missing"))).First()
+		require.ErrorIs(t, err, graph.ErrNoResultsFound)
+
+		return nil
+	}))
+}
+
+func TestNodeQuery_Update(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		testGraph = newTestGraph(t)
+	)
+
+	require.Nil(t, testGraph.db.WriteTransaction(ctx, func(tx graph.Transaction) error {
+		properties := graph.NewProperties()
+		properties.Set("enabled", false)
+		properties.Delete("name")
+
+		return tx.Nodes().Filter(query.Equals(query.NodeID(), testGraph.user.ID)).Update(properties)
+	}))
+
+	require.Nil(t, testGraph.db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		node, err := ops.FetchNode(tx, testGraph.user.ID)
+		require.Nil(t, err)
+
+		enabled, err := node.Properties.Get("enabled").Bool()
+		require.Nil(t, err)
+		require.False(t, enabled)
+		require.False(t, node.Properties.Exists("name"))
+
+		return nil
+	}))
+}
+
+func TestRelationshipQuery(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		testGraph = newTestGraph(t)
+	)
+
+	require.Nil(t, testGraph.db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		count, err := tx.Relationships().Filter(query.Kind(query.Relationship(), AdminTo)).Count()
+		require.Nil(t, err)
+		require.Equal(t, int64(2), count)
+
+		startIDs, err := ops.FetchStartNodeIDs(tx.Relationships().Filter(query.And(
+			query.Kind(query.Relationship(), AdminTo),
+			query.Equals(query.EndID(), testGraph.computer.ID),
+		)))
+		require.Nil(t, err)
+		require.ElementsMatch(t, []graph.ID{testGraph.user.ID, testGraph.group.ID}, startIDs)
+
+		endNodes, err := ops.FetchEndNodes(tx.Relationships().Filter(query.Equals(query.StartID(), testGraph.user.ID)))
+		require.Nil(t, err)
+		require.ElementsMatch(t, []graph.ID{testGraph.group.ID, testGraph.computer.ID}, endNodes.IDs())
+
+		return tx.Relationships().Filter(query.Kind(query.Relationship(), MemberOf)).FetchTriples(func(cursor graph.Cursor[graph.RelationshipTripleResult]) error {
+			var triples []graph.RelationshipTripleResult
+
+			for triple := range cursor.Chan() {
+				triples = append(triples, triple)
+			}
+
+			require.Len(t, triples, 1)
+			require.Equal(t, testGraph.user.ID, triples[0].StartID)
+			require.Equal(t, testGraph.group.ID, triples[0].EndID)
+
+			return cursor.Error()
+		})
+	}))
+}
+
+func TestRelationshipQuery_FetchAllShortestPaths(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		testGraph = newTestGraph(t)
+	)
+
+	require.Nil(t, testGraph.db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		var paths []graph.Path
+
+		require.Nil(t, tx.Relationships().Filter(query.And(
+			query.Equals(query.StartID(), testGraph.user.ID),
+			query.Equals(query.EndID(), testGraph.computer.ID),
+		)).FetchAllShortestPaths(func(cursor graph.Cursor[graph.Path]) error {
+			for path := range cursor.Chan() {
+				paths = append(paths, path)
+			}
+
+			return cursor.Error()
+		}))
+
+		require.Len(t, paths, 1)
+		require.Len(t, paths[0].Edges, 1)
+		require.Equal(t, testGraph.user.ID, paths[0].Root().ID)
+		require.Equal(t, testGraph.computer.ID, paths[0].Terminal().ID)
+
+		// Restricting traversal to MemberOf edges leaves no path to the computer
+		paths = nil
+
+		require.Nil(t, tx.Relationships().Filter(query.And(
+			query.Equals(query.StartID(), testGraph.user.ID),
+			query.Equals(query.EndID(), testGraph.group.ID),
+			query.Kind(query.Relationship(), MemberOf),
+		)).FetchAllShortestPaths(func(cursor graph.Cursor[graph.Path]) error {
+			for path := range cursor.Chan() {
+				paths = append(paths, path)
+			}
+
+			return cursor.Error()
+		}))
+
+		require.Len(t, paths, 1)
+		require.Equal(t, MemberOf, paths[0].Edges[0].Kind)
+
+		return nil
+	}))
+}
+
+func TestBatchOperation_Upsert(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		db, err = dawgs.Open(ctx, memory.DriverName, dawgs.Config{})
+	)
+
+	require.Nil(t, err)
+
+	for _, name := range []string{"first", "second"} {
+		require.Nil(t, db.BatchOperation(ctx, func(batch graph.Batch) error {
+			return batch.UpdateRelationshipBy(graph.RelationshipUpdate{
+				Relationship:            graph.PrepareRelationship(graph.AsProperties(map[string]any{"name": name}), MemberOf),
+				Start:                   graph.PrepareNode(graph.AsProperties(map[string]any{"objectid": "user-1"}), User),
+				StartIdentityKind:       User,
+				StartIdentityProperties: []string{"objectid"},
+				End:                     graph.PrepareNode(graph.AsProperties(map[string]any{"objectid": "group-1"}), Group),
+				EndIdentityKind:         Group,
+				EndIdentityProperties:   []string{"objectid"},
+			})
+		}))
+	}
+
+	require.Nil(t, db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		nodeCount, err := tx.Nodes().Count()
+		require.Nil(t, err)
+		require.Equal(t, int64(2), nodeCount)
+
+		relationships, err := ops.FetchRelationships(tx.Relationships())
+		require.Nil(t, err)
+		require.Len(t, relationships, 1)
+
+		name, err := relationships[0].Properties.Get("name").String()
+		require.Nil(t, err)
+		require.Equal(t, "second", name)
+
+		return nil
+	}))
+}
+
+func TestBatchOperation_UpsertCreateOnlyProperties(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		db, err = dawgs.Open(ctx, memory.DriverName, dawgs.Config{})
+	)
+
+	require.Nil(t, err)
+
+	for _, name := range []string{"first", "second"} {
+		require.Nil(t, db.BatchOperation(ctx, func(batch graph.Batch) error {
+			return batch.UpdateRelationshipBy(graph.RelationshipUpdate{
+				Relationship:            graph.PrepareRelationship(graph.AsProperties(map[string]any{"name": name, "created": name}), MemberOf),
+				Start:                   graph.PrepareNode(graph.AsProperties(map[string]any{"objectid": "user-1", "name": name, "created": name}), User),
+				StartIdentityKind:       User,
+				StartIdentityProperties: []string{"objectid"},
+				End:                     graph.PrepareNode(graph.AsProperties(map[string]any{"objectid": "group-1", "name": name, "created": name}), Group),
+				EndIdentityKind:         Group,
+				EndIdentityProperties:   []string{"objectid"},
+				CreateOnlyProperties:    []string{"created"},
+			})
+		}))
+	}
+
+	// Create-only properties keep the value written when the objects were created while other properties are updated
+	require.Nil(t, db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		nodes, err := ops.FetchNodes(tx.Nodes())
+		require.Nil(t, err)
+		require.Len(t, nodes, 2)
+
+		relationships, err := ops.FetchRelationships(tx.Relationships())
+		require.Nil(t, err)
+		require.Len(t, relationships, 1)
+
+		for _, properties := range []*graph.Properties{nodes[0].Properties, nodes[1].Properties, relationships[0].Properties} {
+			name, err := properties.Get("name").String()
+			require.Nil(t, err)
+			require.Equal(t, "second", name)
+
+			created, err := properties.Get("created").String()
+			require.Nil(t, err)
+			require.Equal(t, "first", created)
+		}
+
+		return nil
+	}))
+}
+
+func TestBatchOperation_ConcurrentRead(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		db, err = dawgs.Open(ctx, memory.DriverName, dawgs.Config{})
+	)
+
+	require.Nil(t, err)
+	require.Nil(t, db.BatchOperation(ctx, func(batch graph.Batch) error {
+		if err := batch.CreateNode(graph.NewNode(0, graph.AsProperties(map[string]any{"name": "alice"}), User)); err != nil {
+			return err
+		}
+
+		// A reader feeding the batch must not be blocked by the open batch and must observe its applied writes
+		readResult := make(chan int64, 1)
+
+		go func() {
+			count, err := ops.CountNodes(ctx, db)
+			require.Nil(t, err)
+
+			readResult <- count
+		}()
+
+		select {
+		case count := <-readResult:
+			require.Equal(t, int64(1), count)
+		case <-time.After(5 * time.Second):
+			t.Fatal("read transaction blocked by open batch operation")
+		}
+
+		return nil
+	}))
+}
+
+func TestBatchOperation_KeepsAppliedWrites(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		errBatch = errors.New("batch")
+		db, err  = dawgs.Open(ctx, memory.DriverName, dawgs.Config{})
+	)
+
+	require.Nil(t, err)
+	require.ErrorIs(t, db.BatchOperation(ctx, func(batch graph.Batch) error {
+		if err := batch.CreateNode(graph.NewNode(0, graph.NewProperties(), User)); err != nil {
+			return err
+		}
+
+		return errBatch
+	}), errBatch)
+
+	count, err := ops.CountNodes(ctx, db)
+	require.Nil(t, err)
+	require.Equal(t, int64(1), count)
+}
+
+func TestRawCypher(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		testGraph = newTestGraph(t)
+	)
+
+	require.Nil(t, testGraph.db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		var (
+			names []string
+			name  string
+		)
+
+		result := tx.Raw("match (n)-[:MemberOf|AdminTo*1..]->(c:Computer) where n.name <> 'ws01' return distinct n.name order by n.name desc", nil)
+		defer result.Close()
+
+		for result.Next() {
+			require.Nil(t, result.Scan(&name))
+			names = append(names, name)
+		}
+
+		require.Nil(t, result.Error())
+		require.Equal(t, []string{"alice", "admins"}, names)
+
+		var (
+			kind  string
+			count int64
+		)
+
+		result = tx.Raw("match ()-[r]->() return type(r), count(*) order by type(r) limit 1", nil)
+		require.True(t, result.Next())
+		require.Nil(t, result.Scan(&kind, &count))
+		require.Equal(t, AdminTo.String(), kind)
+		require.Equal(t, int64(2), count)
+		require.False(t, result.Next())
+		result.Close()
+
+		var groupNames []string
+
+		result = tx.Raw("match (u:User {name: $name}) optional match (u)-[:MemberOf]->(g:Group) with u, collect(g.name) as groups return groups", map[string]any{
+			"name": "alice",
+		})
+		require.True(t, result.Next())
+		require.Nil(t, result.Scan(&groupNames))
+		require.Equal(t, []string{"admins"}, groupNames)
+		result.Close()
+
+		return nil
+	}))
+}
+
+func TestRawCypher_Updates(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		testGraph = newTestGraph(t)
+	)
+
+	require.Nil(t, testGraph.db.WriteTransaction(ctx, func(tx graph.Transaction) error {
+		for _, statement := range []string{
+			"create (n:Computer {name: 'ws02'})",
+			"match (u:User), (c:Computer {name: 'ws02'}) create (u)-[:AdminTo]->(c)",
+			"match (c:Computer) set c.owned = true, c:Group",
+			"merge (n:User {name: 'bob'}) on create set n.created = true",
+			"merge (n:User {name: 'bob'}) on match set n.matched = true",
+			"match (n:Computer {name: 'ws01'}) detach delete n",
+		} {
+			result := tx.Raw(statement, nil)
+			require.Nil(t, result.Error(), statement)
+			result.Close()
+		}
+
+		return nil
+	}))
+
+	require.Nil(t, testGraph.db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		computers, err := ops.FetchNodes(tx.Nodes().Filter(query.Kind(query.Node(), Computer)))
+		require.Nil(t, err)
+		require.Len(t, computers, 1)
+		require.True(t, computers[0].Kinds.ContainsOneOf(Group))
+
+		owned, err := computers[0].Properties.Get("owned").Bool()
+		require.Nil(t, err)
+		require.True(t, owned)
+
+		bob, err := tx.Nodes().Filter(query.Equals(query.NodeProperty("name"), "bob")).First()
+		require.Nil(t, err)
+		require.True(t, bob.Properties.Exists("created"))
+		require.True(t, bob.Properties.Exists("matched"))
+
+		count, err := tx.Relationships().Filter(query.Kind(query.Relationship(), AdminTo)).Count()
+		require.Nil(t, err)
+		require.Equal(t, int64(1), count)
+
+		return nil
+	}))
+}
+
+func TestWriteTransaction_Rollback(t *testing.T) {
+	var (
+		ctx         = context.Background()
+		testGraph   = newTestGraph(t)
+		errRollback = errors.New("rollback")
+		countNodes  = func() int64 {
+			count, err := ops.CountNodes(ctx, testGraph.db)
+			require.Nil(t, err)
+
+			return count
+		}
+	)
+
+	require.ErrorIs(t, testGraph.db.WriteTransaction(ctx, func(tx graph.Transaction) error {
+		if _, err := tx.CreateNode(graph.NewProperties(), User); err != nil {
+			return err
+		} else if err := tx.Nodes().Filter(query.Equals(query.NodeID(), testGraph.group.ID)).Delete(); err != nil {
+			return err
+		}
+
+		return errRollback
+	}), errRollback)
+
+	require.Equal(t, int64(3), countNodes())
+
+	require.Nil(t, testGraph.db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		count, err := tx.Relationships().Count()
+		require.Nil(t, err)
+		require.Equal(t, int64(3), count)
+
+		return nil
+	}))
+}
+
+func TestWriteTransaction_Nested(t *testing.T) {
+	var (
+		ctx         = context.Background()
+		testGraph   = newTestGraph(t)
+		errRollback = errors.New("rollback")
+		result      = make(chan error, 1)
+	)
+
+	go func() {
+		result <- testGraph.db.WriteTransaction(ctx, func(tx graph.Transaction) error {
+			if _, err := tx.CreateNode(graph.NewProperties(), User); err != nil {
+				return err
+			}
+
+			// Writes, reads and batches opened from within the write join it rather than waiting on its lock
+			if err := testGraph.db.WriteTransaction(ctx, func(tx graph.Transaction) error {
+				_, err := tx.CreateNode(graph.NewProperties(), User)
+				return err
+			}); err != nil {
+				return err
+			} else if err := testGraph.db.BatchOperation(ctx, func(batch graph.Batch) error {
+				return batch.CreateNode(graph.NewNode(0, graph.NewProperties(), User))
+			}); err != nil {
+				return err
+			}
+
+			// A failed nested write rolls back only its own writes
+			require.ErrorIs(t, testGraph.db.WriteTransaction(ctx, func(tx graph.Transaction) error {
+				if _, err := tx.CreateNode(graph.NewProperties(), User); err != nil {
+					return err
+				}
+
+				return errRollback
+			}), errRollback)
+
+			count, err := ops.CountNodes(ctx, testGraph.db)
+			require.Nil(t, err)
+			require.Equal(t, int64(6), count)
+
+			return nil
+		})
+	}()
+
+	select {
+	case err := <-result:
+		require.Nil(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("nested write transaction blocked on the driver lock")
+	}
+
+	count, err := ops.CountNodes(ctx, testGraph.db)
+	require.Nil(t, err)
+	require.Equal(t, int64(6), count)
+
+	// The nested writes roll back with the write transaction they joined
+	require.ErrorIs(t, testGraph.db.WriteTransaction(ctx, func(tx graph.Transaction) error {
+		if err := testGraph.db.WriteTransaction(ctx, func(tx graph.Transaction) error {
+			_, err := tx.CreateNode(graph.NewProperties(), User)
+			return err
+		}); err != nil {
+			return err
+		}
+
+		return errRollback
+	}), errRollback)
+
+	count, err = ops.CountNodes(ctx, testGraph.db)
+	require.Nil(t, err)
+	require.Equal(t, int64(6), count)
+}
+
+func TestReadTransaction_RejectsWrites(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		testGraph = newTestGraph(t)
+	)
+
+	require.ErrorIs(t, testGraph.db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		_, err := tx.CreateNode(graph.NewProperties(), User)
+		return err
+	}), memory.ErrReadOnlyTransaction)
+
+	require.ErrorIs(t, testGraph.db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		return tx.Raw("match (n) detach delete n", nil).Error()
+	}), memory.ErrReadOnlyTransaction)
+}
+
+func TestWithGraph_Isolation(t *testing.T) {
+	var (
+		ctx         = context.Background()
+		testGraph   = newTestGraph(t)
+		workspace   = graph.Graph{Name: "workspace"}
+		errRollback = errors.New("rollback")
+		countNodes  = func(ctx context.Context) int64 {
+			count, err := ops.CountNodes(ctx, graph.NewDatabaseSwitch(ctx, testGraph.db))
+			require.Nil(t, err)
+
+			return count
+		}
+	)
+
+	require.Nil(t, testGraph.db.WriteTransaction(ctx, func(tx graph.Transaction) error {
+		_, err := tx.WithGraph(workspace).CreateNode(graph.NewProperties(), Computer)
+		return err
+	}))
+
+	// Writes issued against a graph target are only visible through that target
+	require.Equal(t, int64(3), countNodes(ctx))
+	require.Equal(t, int64(1), countNodes(graph.WithGraphTarget(ctx, workspace)))
+
+	// Rollbacks apply to every graph a transaction touched
+	require.ErrorIs(t, testGraph.db.WriteTransaction(ctx, func(tx graph.Transaction) error {
+		if _, err := tx.CreateNode(graph.NewProperties(), User); err != nil {
+			return err
+		} else if _, err := tx.WithGraph(workspace).CreateNode(graph.NewProperties(), User); err != nil {
+			return err
+		}
+
+		return errRollback
+	}), errRollback)
+
+	require.Equal(t, int64(3), countNodes(ctx))
+	require.Equal(t, int64(1), countNodes(graph.WithGraphTarget(ctx, workspace)))
+}
+
+func TestSearchText(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		testGraph = newTestGraph(t)
+		index     = graph.FullTextIndex{
+			Name:   "node_search",
+			Fields: []string{"name", "description"},
+		}
+	)
+
+	require.Nil(t, testGraph.db.WriteTransaction(ctx, func(tx graph.Transaction) error {
+		if _, err := tx.CreateNode(graph.AsProperties(map[string]any{"name": "admins-backup"}), Group); err != nil {
+			return err
+		}
+
+		_, err := tx.CreateNode(graph.AsProperties(map[string]any{"name": "bob", "description": "Domain ADMINS delegate"}), User)
+		return err
+	}))
+
+	// Exact matches rank ahead of prefix matches which rank ahead of contained matches
+	results, total, err := graph.SearchText(ctx, testGraph.db, graph.TextSearch{
+		Index: index,
+		Query: "ADMINS",
+	})
+
+	require.Nil(t, err)
+	require.Equal(t, 3, total)
+	require.Len(t, results, 3)
+	require.Equal(t, testGraph.group.ID, results[0].Node.ID)
+	require.Equal(t, "admins-backup", results[1].Node.Properties.Get("name").Any())
+	require.Equal(t, "bob", results[2].Node.Properties.Get("name").Any())
+	require.Greater(t, results[0].Score, results[1].Score)
+
+	// Every term must match and results may be filtered by kind and paginated
+	results, total, err = graph.SearchText(ctx, testGraph.db, graph.TextSearch{
+		Index: index,
+		Query: "admins delegate",
+	})
+
+	require.Nil(t, err)
+	require.Equal(t, 1, total)
+	require.Equal(t, "bob", results[0].Node.Properties.Get("name").Any())
+
+	results, total, err = graph.SearchText(ctx, testGraph.db, graph.TextSearch{
+		Index: index,
+		Query: "admins",
+		Kinds: graph.Kinds{Group},
+		Skip:  1,
+		Limit: 1,
+	})
+
+	require.Nil(t, err)
+	require.Equal(t, 2, total)
+	require.Len(t, results, 1)
+	require.Equal(t, "admins-backup", results[0].Node.Properties.Get("name").Any())
+
+	// Nodes with an excluded kind are omitted unless they also have an exempt kind
+	results, total, err = graph.SearchText(ctx, testGraph.db, graph.TextSearch{
+		Index:         index,
+		Query:         "admins",
+		ExcludedKinds: graph.Kinds{Group},
+	})
+
+	require.Nil(t, err)
+	require.Equal(t, 1, total)
+	require.Equal(t, "bob", results[0].Node.Properties.Get("name").Any())
+}
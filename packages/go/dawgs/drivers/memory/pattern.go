@@ -0,0 +1,767 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/specterops/bloodhound/cypher/models/cypher"
+	"github.com/specterops/bloodhound/dawgs/graph"
+)
+
+// patternHints carry constraints lifted out of a match's where clause that narrow the search space of the pattern
+// matcher. Node and relationship ID hints are optimizations only; the where clause is still applied to every match.
+// Edge filters are applied to each relationship traversed by a variable length pattern and are removed from the where
+// clause.
+type patternHints struct {
+	nodeIDs         map[string][]graph.ID
+	relationshipIDs map[string][]graph.ID
+	edgeFilters     map[string][]cypher.Expression
+}
+
+// patternMatch is a partial result of matching the pattern parts of a single match clause. Relationships may only be
+// bound once across all parts of a match clause.
+type patternMatch struct {
+	row  bindings
+	used map[graph.ID]struct{}
+}
+
+func newPatternMatch(row bindings) patternMatch {
+	return patternMatch{
+		row:  row,
+		used: map[graph.ID]struct{}{},
+	}
+}
+
+func symbolOf(expression cypher.Expression) string {
+	if variable, typeOK := expression.(*cypher.Variable); typeOK && variable != nil {
+		return variable.Symbol
+	}
+
+	return ""
+}
+
+// chain is a linear sequence of node patterns joined by relationship patterns. A chain always has one more node
+// pattern than it has relationship patterns.
+type chain struct {
+	nodes         []*cypher.NodePattern
+	relationships []*cypher.RelationshipPattern
+}
+
+// newChains splits the elements of a pattern part into chains. The query package may emit adjacent node patterns
+// without a relationship pattern between them which are matched as a cartesian product.
+func newChains(elements []*cypher.PatternElement) ([]chain, error) {
+	var (
+		chains  []chain
+		current chain
+	)
+
+	for _, element := range elements {
+		if nodePattern, isNodePattern := element.AsNodePattern(); isNodePattern {
+			if len(current.nodes) > len(current.relationships) {
+				chains = append(chains, current)
+				current = chain{}
+			}
+
+			current.nodes = append(current.nodes, nodePattern)
+		} else if relationshipPattern, isRelationshipPattern := element.AsRelationshipPattern(); isRelationshipPattern {
+			if len(current.nodes) != len(current.relationships)+1 {
+				return nil, fmt.Errorf("relationship pattern must follow a node pattern")
+			}
+
+			current.relationships = append(current.relationships, relationshipPattern)
+		} else {
+			return nil, fmt.Errorf("%w: pattern element type %T", ErrUnsupportedQuery, element.Element)
+		}
+	}
+
+	if len(current.nodes) > 0 {
+		if len(current.nodes) != len(current.relationships)+1 {
+			return nil, fmt.Errorf("relationship pattern must be followed by a node pattern")
+		}
+
+		chains = append(chains, current)
+	}
+
+	return chains, nil
+}
+
+// chainState tracks the entities bound to each element of a chain while it is being matched.
+type chainState struct {
+	patternMatch
+
+	nodes    []*graph.Node
+	segments [][]*graph.Relationship
+}
+
+func (s chainState) copy() chainState {
+	used := make(map[graph.ID]struct{}, len(s.used))
+
+	for id := range s.used {
+		used[id] = struct{}{}
+	}
+
+	return chainState{
+		patternMatch: patternMatch{
+			row:  s.row.copy(),
+			used: used,
+		},
+		nodes:    slices.Clone(s.nodes),
+		segments: slices.Clone(s.segments),
+	}
+}
+
+func relationshipRange(relationshipPattern *cypher.RelationshipPattern) (int64, int64) {
+	if relationshipPattern.Range == nil {
+		return 1, 1
+	}
+
+	var (
+		minDepth int64 = 1
+		maxDepth int64 = -1
+	)
+
+	if relationshipPattern.Range.StartIndex != nil {
+		minDepth = *relationshipPattern.Range.StartIndex
+	}
+
+	if relationshipPattern.Range.EndIndex != nil {
+		maxDepth = *relationshipPattern.Range.EndIndex
+	}
+
+	return minDepth, maxDepth
+}
+
+func otherNodeID(relationship *graph.Relationship, nodeID graph.ID) graph.ID {
+	if relationship.StartID == nodeID {
+		return relationship.EndID
+	}
+
+	return relationship.StartID
+}
+
+func (s *executor) propertyConstraints(row bindings, expression cypher.Expression) (map[string]any, error) {
+	var (
+		value any
+		err   error
+	)
+
+	switch typedExpression := expression.(type) {
+	case nil:
+		return nil, nil
+
+	case *cypher.Properties:
+		if typedExpression == nil {
+			return nil, nil
+		} else if typedExpression.Parameter != nil {
+			value = s.parameter(typedExpression.Parameter)
+		} else if value, err = s.evaluateMap(rowContext(row), typedExpression.Map); err != nil {
+			return nil, err
+		}
+
+	default:
+		if value, err = s.evaluate(rowContext(row), expression); err != nil {
+			return nil, err
+		}
+	}
+
+	switch typedValue := value.(type) {
+	case nil:
+		return nil, nil
+	case map[string]any:
+		return typedValue, nil
+	case *graph.Node:
+		return normalize(typedValue.Properties.Map).(map[string]any), nil
+	case *graph.Relationship:
+		return normalize(typedValue.Properties.Map).(map[string]any), nil
+	default:
+		return nil, fmt.Errorf("expected a map of properties but got %T", value)
+	}
+}
+
+func propertiesSatisfy(properties *graph.Properties, constraints map[string]any) bool {
+	for key, value := range constraints {
+		if equals(normalize(properties.Map[key]), value) != true {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *executor) nodeSatisfies(row bindings, nodePattern *cypher.NodePattern, node *graph.Node) (bool, error) {
+	if len(nodePattern.Kinds) > 0 && !node.Kinds.ContainsOneOf(nodePattern.Kinds...) {
+		return false, nil
+	}
+
+	if constraints, err := s.propertyConstraints(row, nodePattern.Properties); err != nil {
+		return false, err
+	} else {
+		return propertiesSatisfy(node.Properties, constraints), nil
+	}
+}
+
+func (s *executor) relationshipSatisfies(row bindings, relationshipPattern *cypher.RelationshipPattern, relationship *graph.Relationship, hints patternHints) (bool, error) {
+	if len(relationshipPattern.Kinds) > 0 && !relationship.Kind.Is(relationshipPattern.Kinds...) {
+		return false, nil
+	}
+
+	symbol := symbolOf(relationshipPattern.Binding)
+
+	if hintedIDs, hasHint := hints.relationshipIDs[symbol]; hasHint && relationshipPattern.Range == nil && !slices.Contains(hintedIDs, relationship.ID) {
+		return false, nil
+	}
+
+	if constraints, err := s.propertyConstraints(row, relationshipPattern.Properties); err != nil {
+		return false, err
+	} else if !propertiesSatisfy(relationship.Properties, constraints) {
+		return false, nil
+	}
+
+	for _, filter := range hints.edgeFilters[symbol] {
+		if value, err := s.evaluate(rowContext(row).with(symbol, relationship), filter); err != nil {
+			return false, err
+		} else if value != true {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// bindNode binds the given node to the node pattern at the given index of the chain.
+func (s *executor) bindNode(state chainState, nodePattern *cypher.NodePattern, idx int, node *graph.Node) (chainState, bool, error) {
+	if matched, err := s.nodeSatisfies(state.row, nodePattern, node); err != nil || !matched {
+		return state, false, err
+	}
+
+	if symbol := symbolOf(nodePattern.Binding); symbol != "" {
+		if boundValue, bound := state.row[symbol]; bound {
+			if boundNode, typeOK := boundValue.(*graph.Node); !typeOK || boundNode.ID != node.ID {
+				return state, false, nil
+			}
+		}
+
+		state = state.copy()
+		state.row[symbol] = node
+	} else {
+		state = state.copy()
+	}
+
+	state.nodes[idx] = node
+	return state, true, nil
+}
+
+// bindSegment binds the given relationships to the relationship pattern at the given index of the chain. Segments are
+// always given in the left-to-right order of the pattern.
+func (s *executor) bindSegment(state chainState, relationshipPattern *cypher.RelationshipPattern, idx int, segment []*graph.Relationship) (chainState, bool) {
+	var value any
+
+	if relationshipPattern.Range == nil {
+		value = segment[0]
+	} else {
+		relationships := make([]any, len(segment))
+
+		for segmentIdx, relationship := range segment {
+			relationships[segmentIdx] = relationship
+		}
+
+		value = relationships
+	}
+
+	if symbol := symbolOf(relationshipPattern.Binding); symbol != "" {
+		if boundValue, bound := state.row[symbol]; bound && equals(boundValue, value) != true {
+			return state, false
+		}
+
+		state = state.copy()
+		state.row[symbol] = value
+	} else {
+		state = state.copy()
+	}
+
+	for _, relationship := range segment {
+		state.used[relationship.ID] = struct{}{}
+	}
+
+	state.segments[idx] = segment
+	return state, true
+}
+
+// nodeCandidates returns the nodes that the node pattern at the given index of the chain may be bound to before
+// any relationship of the chain has been traversed.
+func (s *executor) nodeCandidates(row bindings, chain chain, idx int, hints patternHints) []*graph.Node {
+	var (
+		symbol       = symbolOf(chain.nodes[idx].Binding)
+		candidateIDs []graph.ID
+	)
+
+	if boundValue, bound := row[symbol]; bound && symbol != "" {
+		if boundNode, typeOK := boundValue.(*graph.Node); typeOK {
+			if storedNode, exists := s.store.nodes[boundNode.ID]; exists {
+				return []*graph.Node{storedNode}
+			}
+		}
+
+		return nil
+	} else if hintedIDs, hasHint := hints.nodeIDs[symbol]; hasHint && symbol != "" {
+		candidateIDs = hintedIDs
+	} else if relationshipIDs, hasHint := s.adjacentRelationshipHint(chain, idx, hints); hasHint {
+		for _, relationshipID := range relationshipIDs {
+			if relationship, exists := s.store.relationships[relationshipID]; exists {
+				candidateIDs = append(candidateIDs, relationship.StartID, relationship.EndID)
+			}
+		}
+
+		slices.Sort(candidateIDs)
+		candidateIDs = slices.Compact(candidateIDs)
+	} else {
+		candidateIDs = s.store.nodeIDs()
+	}
+
+	candidates := make([]*graph.Node, 0, len(candidateIDs))
+
+	for _, candidateID := range candidateIDs {
+		if node, exists := s.store.nodes[candidateID]; exists {
+			candidates = append(candidates, node)
+		}
+	}
+
+	return candidates
+}
+
+func (s *executor) adjacentRelationshipHint(chain chain, nodeIdx int, hints patternHints) ([]graph.ID, bool) {
+	for _, relationshipIdx := range []int{nodeIdx, nodeIdx - 1} {
+		if relationshipIdx >= 0 && relationshipIdx < len(chain.relationships) && chain.relationships[relationshipIdx].Range == nil {
+			if relationshipIDs, hasHint := hints.relationshipIDs[symbolOf(chain.relationships[relationshipIdx].Binding)]; hasHint {
+				return relationshipIDs, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// anchor selects the node pattern of the chain to begin matching from. Bound variables are preferred, then hinted
+// variables and finally the first node of the chain.
+func anchor(row bindings, chain chain, hints patternHints) int {
+	for idx, nodePattern := range chain.nodes {
+		if symbol := symbolOf(nodePattern.Binding); symbol != "" {
+			if _, bound := row[symbol]; bound {
+				return idx
+			}
+		}
+	}
+
+	for idx, nodePattern := range chain.nodes {
+		if _, hasHint := hints.nodeIDs[symbolOf(nodePattern.Binding)]; hasHint {
+			return idx
+		}
+	}
+
+	for idx, relationshipPattern := range chain.relationships {
+		if _, hasHint := hints.relationshipIDs[symbolOf(relationshipPattern.Binding)]; hasHint && relationshipPattern.Range == nil {
+			return idx
+		}
+	}
+
+	return 0
+}
+
+// matchPatternPart returns all matches of the given pattern part that are consistent with the given row.
+func (s *executor) matchPatternPart(part *cypher.PatternPart, row bindings, hints patternHints) ([]patternMatch, error) {
+	return s.matchPart(part, newPatternMatch(row), hints)
+}
+
+func (s *executor) matchPart(part *cypher.PatternPart, match patternMatch, hints patternHints) ([]patternMatch, error) {
+	chains, err := newChains(part.PatternElements)
+	if err != nil {
+		return nil, err
+	}
+
+	states := []chainState{{
+		patternMatch: match,
+	}}
+
+	for _, nextChain := range chains {
+		var nextStates []chainState
+
+		for _, state := range states {
+			if err := s.ctx.Err(); err != nil {
+				return nil, err
+			}
+
+			state.nodes = make([]*graph.Node, len(nextChain.nodes))
+			state.segments = make([][]*graph.Relationship, len(nextChain.relationships))
+
+			var chainMatches []chainState
+
+			if part.ShortestPathPattern || part.AllShortestPathsPattern {
+				chainMatches, err = s.matchShortestPaths(nextChain, state, hints, part.AllShortestPathsPattern)
+			} else {
+				chainMatches, err = s.matchChain(nextChain, state, hints)
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			for _, chainMatch := range chainMatches {
+				if symbol := symbolOf(part.Binding); symbol != "" {
+					if len(chains) > 1 {
+						return nil, fmt.Errorf("%w: path binding of a disconnected pattern", ErrUnsupportedQuery)
+					}
+
+					chainMatch.row[symbol] = s.buildPath(chainMatch)
+				}
+
+				nextStates = append(nextStates, chainMatch)
+			}
+		}
+
+		states = nextStates
+	}
+
+	matches := make([]patternMatch, len(states))
+
+	for idx, state := range states {
+		matches[idx] = state.patternMatch
+	}
+
+	return matches, nil
+}
+
+// buildPath assembles the path traversed by a fully matched chain.
+func (s *executor) buildPath(state chainState) *graph.Path {
+	var (
+		path    = &graph.Path{}
+		current = state.nodes[0]
+	)
+
+	path.Nodes = append(path.Nodes, current)
+
+	for _, segment := range state.segments {
+		for _, relationship := range segment {
+			nextID := otherNodeID(relationship, current.ID)
+
+			if nextNode, exists := s.store.nodes[nextID]; exists {
+				current = nextNode
+			}
+
+			path.Nodes = append(path.Nodes, current)
+			path.Edges = append(path.Edges, relationship)
+		}
+	}
+
+	return path
+}
+
+type chainStep struct {
+	relationshipIdx int
+	fromIdx         int
+	toIdx           int
+	direction       graph.Direction
+	reversed        bool
+}
+
+func (s *executor) matchChain(chain chain, state chainState, hints patternHints) ([]chainState, error) {
+	var (
+		anchorIdx = anchor(state.row, chain, hints)
+		steps     []chainStep
+		matches   []chainState
+	)
+
+	for idx := anchorIdx; idx < len(chain.relationships); idx++ {
+		steps = append(steps, chainStep{
+			relationshipIdx: idx,
+			fromIdx:         idx,
+			toIdx:           idx + 1,
+			direction:       chain.relationships[idx].Direction,
+		})
+	}
+
+	for idx := anchorIdx - 1; idx >= 0; idx-- {
+		direction := chain.relationships[idx].Direction
+
+		if direction != graph.DirectionBoth {
+			if reversed, err := direction.Reverse(); err != nil {
+				return nil, err
+			} else {
+				direction = reversed
+			}
+		}
+
+		steps = append(steps, chainStep{
+			relationshipIdx: idx,
+			fromIdx:         idx + 1,
+			toIdx:           idx,
+			direction:       direction,
+			reversed:        true,
+		})
+	}
+
+	for _, candidate := range s.nodeCandidates(state.row, chain, anchorIdx, hints) {
+		if err := s.ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if anchoredState, matched, err := s.bindNode(state, chain.nodes[anchorIdx], anchorIdx, candidate); err != nil {
+			return nil, err
+		} else if matched {
+			if err := s.matchSteps(chain, anchoredState, steps, hints, func(matchedState chainState) {
+				matches = append(matches, matchedState)
+			}); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return matches, nil
+}
+
+func (s *executor) matchSteps(chain chain, state chainState, steps []chainStep, hints patternHints, emit func(state chainState)) error {
+	if len(steps) == 0 {
+		emit(state)
+		return nil
+	}
+
+	var (
+		step                = steps[0]
+		relationshipPattern = chain.relationships[step.relationshipIdx]
+		minDepth, maxDepth  = relationshipRange(relationshipPattern)
+	)
+
+	return s.expand(state, state.nodes[step.fromIdx], relationshipPattern, step.direction, minDepth, maxDepth, nil, hints, func(endNode *graph.Node, traversed []*graph.Relationship) error {
+		segment := slices.Clone(traversed)
+
+		if step.reversed {
+			slices.Reverse(segment)
+		}
+
+		if relationshipPattern.Range == nil && len(segment) != 1 {
+			return nil
+		}
+
+		if nextState, matched := s.bindSegment(state, relationshipPattern, step.relationshipIdx, segment); !matched {
+			return nil
+		} else if nextState, matched, err := s.bindNode(nextState, chain.nodes[step.toIdx], step.toIdx, endNode); err != nil || !matched {
+			return err
+		} else {
+			return s.matchSteps(chain, nextState, steps[1:], hints, emit)
+		}
+	})
+}
+
+// expand walks all relationship traversals from the given node that satisfy the relationship pattern and have a depth
+// within the given bounds. A negative maximum depth is unbounded. Relationships are never traversed twice.
+func (s *executor) expand(state chainState, from *graph.Node, relationshipPattern *cypher.RelationshipPattern, direction graph.Direction, minDepth, maxDepth int64, traversed []*graph.Relationship, hints patternHints, emit func(endNode *graph.Node, traversed []*graph.Relationship) error) error {
+	depth := int64(len(traversed))
+
+	if depth >= minDepth {
+		if err := emit(from, traversed); err != nil {
+			return err
+		}
+	}
+
+	if maxDepth >= 0 && depth >= maxDepth {
+		return nil
+	}
+
+	if err := s.ctx.Err(); err != nil {
+		return err
+	}
+
+	for _, relationship := range s.store.adjacent(from.ID, direction) {
+		if _, used := state.used[relationship.ID]; used || slices.Contains(traversed, relationship) {
+			continue
+		}
+
+		if matched, err := s.relationshipSatisfies(state.row, relationshipPattern, relationship, hints); err != nil {
+			return err
+		} else if !matched {
+			continue
+		}
+
+		if nextNode, exists := s.store.nodes[otherNodeID(relationship, from.ID)]; exists {
+			if err := s.expand(state, nextNode, relationshipPattern, direction, minDepth, maxDepth, append(traversed, relationship), hints, emit); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// matchShortestPaths matches a two node chain by finding the shortest paths between each pair of nodes that satisfy
+// the chain's node patterns.
+func (s *executor) matchShortestPaths(chain chain, state chainState, hints patternHints, allShortestPaths bool) ([]chainState, error) {
+	if len(chain.relationships) != 1 {
+		return nil, fmt.Errorf("%w: shortest path patterns must contain exactly one relationship pattern", ErrUnsupportedQuery)
+	}
+
+	var (
+		relationshipPattern = chain.relationships[0]
+		minDepth, maxDepth  = relationshipRange(relationshipPattern)
+		endSymbol           = symbolOf(chain.nodes[1].Binding)
+		matches             []chainState
+		endTargets          map[graph.ID]struct{}
+	)
+
+	// If the end of the path is already known the search may stop as soon as all end nodes have been reached
+	if boundValue, bound := state.row[endSymbol]; bound && endSymbol != "" {
+		endTargets = map[graph.ID]struct{}{}
+
+		if boundNode, typeOK := boundValue.(*graph.Node); typeOK {
+			endTargets[boundNode.ID] = struct{}{}
+		}
+	} else if hintedIDs, hasHint := hints.nodeIDs[endSymbol]; hasHint && endSymbol != "" {
+		endTargets = map[graph.ID]struct{}{}
+
+		for _, hintedID := range hintedIDs {
+			endTargets[hintedID] = struct{}{}
+		}
+	}
+
+	for _, start := range s.nodeCandidates(state.row, chain, 0, hints) {
+		startState, matched, err := s.bindNode(state, chain.nodes[0], 0, start)
+
+		if err != nil {
+			return nil, err
+		} else if !matched {
+			continue
+		}
+
+		depths, parents, err := s.breadthFirstSearch(startState, start, relationshipPattern, maxDepth, endTargets, hints)
+		if err != nil {
+			return nil, err
+		}
+
+		endIDs := make([]graph.ID, 0, len(depths))
+
+		for nodeID, depth := range depths {
+			if depth >= max(minDepth, 1) {
+				if _, isTarget := endTargets[nodeID]; endTargets == nil || isTarget {
+					endIDs = append(endIDs, nodeID)
+				}
+			}
+		}
+
+		slices.Sort(endIDs)
+
+		for _, endID := range endIDs {
+			endState, matched, err := s.bindNode(startState, chain.nodes[1], 1, s.store.nodes[endID])
+
+			if err != nil {
+				return nil, err
+			} else if !matched {
+				continue
+			}
+
+			for _, segment := range shortestPaths(start.ID, endID, parents, !allShortestPaths) {
+				if pathState, matched := s.bindSegment(endState, relationshipPattern, 0, segment); matched {
+					matches = append(matches, pathState)
+
+					if !allShortestPaths {
+						break
+					}
+				}
+			}
+		}
+	}
+
+	return matches, nil
+}
+
+// breadthFirstSearch returns the depth at which each node reachable from the given start node was first seen along
+// with the relationships that reach each node from the previous depth.
+func (s *executor) breadthFirstSearch(state chainState, start *graph.Node, relationshipPattern *cypher.RelationshipPattern, maxDepth int64, endTargets map[graph.ID]struct{}, hints patternHints) (map[graph.ID]int64, map[graph.ID][]*graph.Relationship, error) {
+	var (
+		depths   = map[graph.ID]int64{start.ID: 0}
+		parents  = map[graph.ID][]*graph.Relationship{}
+		frontier = []graph.ID{start.ID}
+		depth    int64
+		found    int
+	)
+
+	for len(frontier) > 0 && (maxDepth < 0 || depth < maxDepth) {
+		if err := s.ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+
+		var nextFrontier []graph.ID
+		depth++
+
+		for _, nodeID := range frontier {
+			for _, relationship := range s.store.adjacent(nodeID, relationshipPattern.Direction) {
+				if _, used := state.used[relationship.ID]; used {
+					continue
+				}
+
+				if matched, err := s.relationshipSatisfies(state.row, relationshipPattern, relationship, hints); err != nil {
+					return nil, nil, err
+				} else if !matched {
+					continue
+				}
+
+				nextID := otherNodeID(relationship, nodeID)
+
+				if seenDepth, seen := depths[nextID]; !seen {
+					depths[nextID] = depth
+					parents[nextID] = []*graph.Relationship{relationship}
+					nextFrontier = append(nextFrontier, nextID)
+
+					if _, isTarget := endTargets[nextID]; isTarget {
+						found++
+					}
+				} else if seenDepth == depth {
+					parents[nextID] = append(parents[nextID], relationship)
+				}
+			}
+		}
+
+		if endTargets != nil && found >= len(endTargets) {
+			break
+		}
+
+		frontier = nextFrontier
+	}
+
+	return depths, parents, nil
+}
+
+// shortestPaths enumerates the relationship sequences from the start node to the end node using the parent
+// relationships recorded by a breadth first search.
+func shortestPaths(startID, endID graph.ID, parents map[graph.ID][]*graph.Relationship, firstOnly bool) [][]*graph.Relationship {
+	if startID == endID {
+		return [][]*graph.Relationship{{}}
+	}
+
+	var paths [][]*graph.Relationship
+
+	for _, relationship := range parents[endID] {
+		for _, prefix := range shortestPaths(startID, otherNodeID(relationship, endID), parents, firstOnly) {
+			paths = append(paths, append(prefix, relationship))
+
+			if firstOnly {
+				return paths
+			}
+		}
+	}
+
+	return paths
+}
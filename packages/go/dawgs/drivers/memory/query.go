@@ -0,0 +1,463 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/specterops/bloodhound/cypher/models/cypher"
+	"github.com/specterops/bloodhound/dawgs/graph"
+	"github.com/specterops/bloodhound/dawgs/query"
+)
+
+type liveQuery struct {
+	ctx          context.Context
+	tx           *transaction
+	queryBuilder *query.Builder
+}
+
+func newLiveQuery(ctx context.Context, tx *transaction) liveQuery {
+	return liveQuery{
+		ctx:          ctx,
+		tx:           tx,
+		queryBuilder: query.NewBuilder(nil),
+	}
+}
+
+func (s *liveQuery) runRegularQuery(allShortestPaths bool) graph.Result {
+	if regularQuery, err := s.queryBuilder.Build(allShortestPaths); err != nil {
+		return graph.NewErrorResult(err)
+	} else if err := modelErrors(regularQuery); err != nil {
+		return graph.NewErrorResult(err)
+	} else {
+		return s.tx.execute(regularQuery, nil)
+	}
+}
+
+func (s *liveQuery) Query(delegate func(results graph.Result) error, finalCriteria ...graph.Criteria) error {
+	for _, criteria := range finalCriteria {
+		s.queryBuilder.Apply(criteria)
+	}
+
+	if result := s.runRegularQuery(false); result.Error() != nil {
+		return result.Error()
+	} else {
+		defer result.Close()
+		return delegate(result)
+	}
+}
+
+func (s *liveQuery) QueryAllShortestPaths(delegate func(results graph.Result) error, finalCriteria ...graph.Criteria) error {
+	for _, criteria := range finalCriteria {
+		s.queryBuilder.Apply(criteria)
+	}
+
+	if result := s.runRegularQuery(true); result.Error() != nil {
+		return result.Error()
+	} else {
+		defer result.Close()
+		return delegate(result)
+	}
+}
+
+func (s *liveQuery) exec(finalCriteria ...graph.Criteria) error {
+	return s.Query(func(results graph.Result) error {
+		return results.Error()
+	}, finalCriteria...)
+}
+
+// modelErrors collects any errors attached to the given query model by the query package's criteria constructors.
+func modelErrors(regularQuery *cypher.RegularQuery) error {
+	var errs []error
+
+	if err := cypher.Walk(regularQuery, cypher.NewVisitor(func(stack *cypher.WalkStack, element cypher.Expression) error {
+		if fallible, typeOK := element.(cypher.Fallible); typeOK {
+			errs = append(errs, fallible.Errors()...)
+		}
+
+		return nil
+	}, nil)); err != nil {
+		return err
+	}
+
+	return errors.Join(errs...)
+}
+
+type nodeQuery struct {
+	liveQuery
+}
+
+func (s *nodeQuery) Filter(criteria graph.Criteria) graph.NodeQuery {
+	s.queryBuilder.Apply(query.Where(criteria))
+	return s
+}
+
+func (s *nodeQuery) Filterf(criteriaDelegate graph.CriteriaProvider) graph.NodeQuery {
+	return s.Filter(criteriaDelegate())
+}
+
+func (s *nodeQuery) Delete() error {
+	return s.exec(query.Delete(
+		query.Node(),
+	))
+}
+
+func (s *nodeQuery) Update(properties *graph.Properties) error {
+	return s.exec(query.Updatef(func() graph.Criteria {
+		var updateStatements []graph.Criteria
+
+		if modifiedProperties := properties.ModifiedProperties(); len(modifiedProperties) > 0 {
+			updateStatements = append(updateStatements, query.SetProperties(query.Node(), modifiedProperties))
+		}
+
+		if deletedProperties := properties.DeletedProperties(); len(deletedProperties) > 0 {
+			updateStatements = append(updateStatements, query.DeleteProperties(query.Node(), deletedProperties...))
+		}
+
+		return updateStatements
+	}))
+}
+
+func (s *nodeQuery) OrderBy(criteria ...graph.Criteria) graph.NodeQuery {
+	s.queryBuilder.Apply(query.OrderBy(criteria...))
+	return s
+}
+
+func (s *nodeQuery) Offset(offset int) graph.NodeQuery {
+	s.queryBuilder.Apply(query.Offset(offset))
+	return s
+}
+
+func (s *nodeQuery) Limit(limit int) graph.NodeQuery {
+	s.queryBuilder.Apply(query.Limit(limit))
+	return s
+}
+
+func (s *nodeQuery) Count() (int64, error) {
+	var count int64
+
+	return count, s.Query(func(results graph.Result) error {
+		if !results.Next() {
+			return graph.ErrNoResultsFound
+		}
+
+		return results.Scan(&count)
+	}, query.Returning(
+		query.Count(query.Node()),
+	))
+}
+
+func (s *nodeQuery) First() (*graph.Node, error) {
+	var node graph.Node
+
+	return &node, s.Query(
+		func(results graph.Result) error {
+			if !results.Next() {
+				return graph.ErrNoResultsFound
+			}
+
+			return results.Scan(&node)
+		},
+		query.Returning(
+			query.Node(),
+		),
+		query.Limit(1),
+	)
+}
+
+func (s *nodeQuery) Fetch(delegate func(cursor graph.Cursor[*graph.Node]) error) error {
+	return s.Query(func(result graph.Result) error {
+		cursor := graph.NewResultIterator(s.ctx, result, func(scanner graph.Scanner) (*graph.Node, error) {
+			var node graph.Node
+			return &node, scanner.Scan(&node)
+		})
+
+		defer cursor.Close()
+		return delegate(cursor)
+	}, query.Returning(
+		query.Node(),
+	))
+}
+
+func (s *nodeQuery) FetchIDs(delegate func(cursor graph.Cursor[graph.ID]) error) error {
+	return s.Query(func(result graph.Result) error {
+		cursor := graph.NewResultIterator(s.ctx, result, func(scanner graph.Scanner) (graph.ID, error) {
+			var nodeID graph.ID
+			return nodeID, scanner.Scan(&nodeID)
+		})
+
+		defer cursor.Close()
+		return delegate(cursor)
+	}, query.Returning(
+		query.NodeID(),
+	))
+}
+
+func (s *nodeQuery) FetchKinds(delegate func(cursor graph.Cursor[graph.KindsResult]) error) error {
+	return s.Query(func(result graph.Result) error {
+		cursor := graph.NewResultIterator(s.ctx, result, func(scanner graph.Scanner) (graph.KindsResult, error) {
+			var (
+				nodeID    graph.ID
+				nodeKinds graph.Kinds
+				err       = scanner.Scan(&nodeID, &nodeKinds)
+			)
+
+			return graph.KindsResult{
+				ID:    nodeID,
+				Kinds: nodeKinds,
+			}, err
+		})
+
+		defer cursor.Close()
+		return delegate(cursor)
+	}, query.Returning(
+		query.NodeID(),
+		query.KindsOf(query.Node()),
+	))
+}
+
+func directionToReturnCriteria(direction graph.Direction) (graph.Criteria, error) {
+	switch direction {
+	case graph.DirectionInbound:
+		// Select the relationship and the end node
+		return query.Returning(
+			query.Relationship(),
+			query.End(),
+		), nil
+
+	case graph.DirectionOutbound:
+		// Select the relationship and the start node
+		return query.Returning(
+			query.Relationship(),
+			query.Start(),
+		), nil
+
+	default:
+		return nil, fmt.Errorf("bad direction: %d", direction)
+	}
+}
+
+type relationshipQuery struct {
+	liveQuery
+}
+
+func (s *relationshipQuery) Filter(criteria graph.Criteria) graph.RelationshipQuery {
+	s.queryBuilder.Apply(query.Where(criteria))
+	return s
+}
+
+func (s *relationshipQuery) Filterf(criteriaDelegate graph.CriteriaProvider) graph.RelationshipQuery {
+	return s.Filter(criteriaDelegate())
+}
+
+func (s *relationshipQuery) Delete() error {
+	return s.exec(query.Delete(
+		query.Relationship(),
+	))
+}
+
+func (s *relationshipQuery) Update(properties *graph.Properties) error {
+	return s.exec(query.Updatef(func() graph.Criteria {
+		var updateStatements []graph.Criteria
+
+		if modifiedProperties := properties.ModifiedProperties(); len(modifiedProperties) > 0 {
+			updateStatements = append(updateStatements, query.SetProperties(query.Relationship(), modifiedProperties))
+		}
+
+		if deletedProperties := properties.DeletedProperties(); len(deletedProperties) > 0 {
+			updateStatements = append(updateStatements, query.DeleteProperties(query.Relationship(), deletedProperties...))
+		}
+
+		return updateStatements
+	}))
+}
+
+func (s *relationshipQuery) OrderBy(criteria ...graph.Criteria) graph.RelationshipQuery {
+	s.queryBuilder.Apply(query.OrderBy(criteria...))
+	return s
+}
+
+func (s *relationshipQuery) Offset(offset int) graph.RelationshipQuery {
+	s.queryBuilder.Apply(query.Offset(offset))
+	return s
+}
+
+func (s *relationshipQuery) Limit(limit int) graph.RelationshipQuery {
+	s.queryBuilder.Apply(query.Limit(limit))
+	return s
+}
+
+func (s *relationshipQuery) Count() (int64, error) {
+	var count int64
+
+	return count, s.Query(func(results graph.Result) error {
+		if !results.Next() {
+			return graph.ErrNoResultsFound
+		}
+
+		return results.Scan(&count)
+	}, query.Returning(
+		query.Count(query.Relationship()),
+	))
+}
+
+func (s *relationshipQuery) FetchAllShortestPaths(delegate func(cursor graph.Cursor[graph.Path]) error) error {
+	return s.QueryAllShortestPaths(func(results graph.Result) error {
+		cursor := graph.NewResultIterator(s.ctx, results, func(scanner graph.Scanner) (graph.Path, error) {
+			var path graph.Path
+			return path, scanner.Scan(&path)
+		})
+
+		defer cursor.Close()
+		return delegate(cursor)
+	}, query.Returning(
+		query.Path(),
+	))
+}
+
+func (s *relationshipQuery) FetchTriples(delegate func(cursor graph.Cursor[graph.RelationshipTripleResult]) error) error {
+	return s.Query(func(result graph.Result) error {
+		cursor := graph.NewResultIterator(s.ctx, result, func(scanner graph.Scanner) (graph.RelationshipTripleResult, error) {
+			var (
+				startID        graph.ID
+				relationshipID graph.ID
+				endID          graph.ID
+				err            = scanner.Scan(&startID, &relationshipID, &endID)
+			)
+
+			return graph.RelationshipTripleResult{
+				ID:      relationshipID,
+				StartID: startID,
+				EndID:   endID,
+			}, err
+		})
+
+		defer cursor.Close()
+		return delegate(cursor)
+	}, query.ReturningDistinct(
+		query.StartID(),
+		query.RelationshipID(),
+		query.EndID(),
+	))
+}
+
+func (s *relationshipQuery) FetchKinds(delegate func(cursor graph.Cursor[graph.RelationshipKindsResult]) error) error {
+	return s.Query(func(result graph.Result) error {
+		cursor := graph.NewResultIterator(s.ctx, result, func(scanner graph.Scanner) (graph.RelationshipKindsResult, error) {
+			var (
+				startID          graph.ID
+				relationshipID   graph.ID
+				relationshipKind graph.Kind
+				endID            graph.ID
+				err              = scanner.Scan(&startID, &relationshipID, &relationshipKind, &endID)
+			)
+
+			return graph.RelationshipKindsResult{
+				RelationshipTripleResult: graph.RelationshipTripleResult{
+					ID:      relationshipID,
+					StartID: startID,
+					EndID:   endID,
+				},
+				Kind: relationshipKind,
+			}, err
+		})
+
+		defer cursor.Close()
+		return delegate(cursor)
+	}, query.Returning(
+		query.StartID(),
+		query.RelationshipID(),
+		query.KindsOf(query.Relationship()),
+		query.EndID(),
+	))
+}
+
+func (s *relationshipQuery) First() (*graph.Relationship, error) {
+	var relationship graph.Relationship
+
+	return &relationship, s.Query(
+		func(results graph.Result) error {
+			if !results.Next() {
+				return graph.ErrNoResultsFound
+			}
+
+			return results.Scan(&relationship)
+		},
+		query.Returning(
+			query.Relationship(),
+		),
+		query.Limit(1),
+	)
+}
+
+func (s *relationshipQuery) Fetch(delegate func(cursor graph.Cursor[*graph.Relationship]) error) error {
+	return s.Query(func(result graph.Result) error {
+		cursor := graph.NewResultIterator(s.ctx, result, func(scanner graph.Scanner) (*graph.Relationship, error) {
+			var relationship graph.Relationship
+			return &relationship, scanner.Scan(&relationship)
+		})
+
+		defer cursor.Close()
+		return delegate(cursor)
+	}, query.Returning(
+		query.Relationship(),
+	))
+}
+
+func (s *relationshipQuery) FetchDirection(direction graph.Direction, delegate func(cursor graph.Cursor[graph.DirectionalResult]) error) error {
+	if returnCriteria, err := directionToReturnCriteria(direction); err != nil {
+		return err
+	} else {
+		return s.Query(func(result graph.Result) error {
+			cursor := graph.NewResultIterator(s.ctx, result, func(scanner graph.Scanner) (graph.DirectionalResult, error) {
+				var (
+					relationship graph.Relationship
+					node         graph.Node
+				)
+
+				if err := scanner.Scan(&relationship, &node); err != nil {
+					return graph.DirectionalResult{}, err
+				}
+
+				return graph.DirectionalResult{
+					Direction:    direction,
+					Relationship: &relationship,
+					Node:         &node,
+				}, nil
+			})
+
+			defer cursor.Close()
+			return delegate(cursor)
+		}, returnCriteria)
+	}
+}
+
+func (s *relationshipQuery) FetchIDs(delegate func(cursor graph.Cursor[graph.ID]) error) error {
+	return s.Query(func(result graph.Result) error {
+		cursor := graph.NewResultIterator(s.ctx, result, func(scanner graph.Scanner) (graph.ID, error) {
+			var relationshipID graph.ID
+			return relationshipID, scanner.Scan(&relationshipID)
+		})
+
+		defer cursor.Close()
+		return delegate(cursor)
+	}, query.Returning(
+		query.RelationshipID(),
+	))
+}
@@ -0,0 +1,138 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"fmt"
+
+	"github.com/specterops/bloodhound/dawgs/graph"
+)
+
+// queryResult is a fully materialized result set. Entities in the result set are detached copies so that callers may
+// freely mutate them.
+type queryResult struct {
+	rows [][]any
+	next []any
+	idx  int
+}
+
+func newQueryResult(rows [][]any) *queryResult {
+	for _, row := range rows {
+		for idx, value := range row {
+			row[idx] = detach(value)
+		}
+	}
+
+	return &queryResult{
+		rows: rows,
+	}
+}
+
+func detach(value any) any {
+	switch typedValue := value.(type) {
+	case *graph.Node:
+		return copyNode(typedValue)
+
+	case *graph.Relationship:
+		return copyRelationship(typedValue)
+
+	case *graph.Path:
+		pathCopy := copyPath(*typedValue)
+		return &pathCopy
+
+	case []any:
+		detached := make([]any, len(typedValue))
+
+		for idx, element := range typedValue {
+			detached[idx] = detach(element)
+		}
+
+		return detached
+
+	case map[string]any:
+		detached := make(map[string]any, len(typedValue))
+
+		for key, element := range typedValue {
+			detached[key] = detach(element)
+		}
+
+		return detached
+
+	default:
+		return value
+	}
+}
+
+func (s *queryResult) Next() bool {
+	if s.idx >= len(s.rows) {
+		s.next = nil
+		return false
+	}
+
+	s.next = s.rows[s.idx]
+	s.idx++
+
+	return true
+}
+
+func (s *queryResult) Values() (graph.ValueMapper, error) {
+	if s.next == nil {
+		return nil, fmt.Errorf("no result row available")
+	}
+
+	return graph.NewValueMapper(s.next, mapValue), nil
+}
+
+func (s *queryResult) Scan(targets ...any) error {
+	if values, err := s.Values(); err != nil {
+		return err
+	} else {
+		return values.Scan(targets...)
+	}
+}
+
+func (s *queryResult) Error() error {
+	return nil
+}
+
+func (s *queryResult) Close() {
+	s.idx = len(s.rows)
+}
+
+func mapValue(rawValue, target any) (bool, error) {
+	switch typedTarget := target.(type) {
+	case *graph.Node:
+		if node, typeOK := rawValue.(*graph.Node); typeOK {
+			*typedTarget = *copyNode(node)
+			return true, nil
+		}
+
+	case *graph.Relationship:
+		if relationship, typeOK := rawValue.(*graph.Relationship); typeOK {
+			*typedTarget = *copyRelationship(relationship)
+			return true, nil
+		}
+
+	case *graph.Path:
+		if path, typeOK := rawValue.(*graph.Path); typeOK {
+			*typedTarget = copyPath(*path)
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
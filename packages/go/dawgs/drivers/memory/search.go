@@ -0,0 +1,158 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+
+	"github.com/specterops/bloodhound/dawgs/graph"
+)
+
+const (
+	termContainedScore = 1
+	termPrefixScore    = 2
+	termEqualScore     = 3
+)
+
+// indexedValues returns the lower-cased string values of the given node property. String list properties contribute
+// each of their string elements.
+func indexedValues(properties *graph.Properties, field string) []string {
+	switch typedValue := properties.Get(field).Any().(type) {
+	case string:
+		return []string{strings.ToLower(typedValue)}
+
+	case []string:
+		values := make([]string, 0, len(typedValue))
+
+		for _, value := range typedValue {
+			values = append(values, strings.ToLower(value))
+		}
+
+		return values
+
+	case []any:
+		values := make([]string, 0, len(typedValue))
+
+		for _, element := range typedValue {
+			if value, isString := element.(string); isString {
+				values = append(values, strings.ToLower(value))
+			}
+		}
+
+		return values
+
+	default:
+		return nil
+	}
+}
+
+// scoreTerm returns the best score of the given term against the given values or 0 if the term matches none of them.
+func scoreTerm(term string, values []string) int {
+	bestScore := 0
+
+	for _, value := range values {
+		if value == term {
+			return termEqualScore
+		} else if strings.HasPrefix(value, term) {
+			bestScore = max(bestScore, termPrefixScore)
+		} else if strings.Contains(value, term) {
+			bestScore = max(bestScore, termContainedScore)
+		}
+	}
+
+	return bestScore
+}
+
+// scoreNode sums the scores of the given terms against the node's indexed fields. A node matches only if every term
+// matches at least one field.
+func scoreNode(node *graph.Node, fields []string, terms []string) (float64, bool) {
+	var values []string
+
+	for _, field := range fields {
+		values = append(values, indexedValues(node.Properties, field)...)
+	}
+
+	score := 0
+
+	for _, term := range terms {
+		if termScore := scoreTerm(term, values); termScore == 0 {
+			return 0, false
+		} else {
+			score += termScore
+		}
+	}
+
+	return float64(score), true
+}
+
+// SearchText matches each search term as a case-insensitive substring of the index's fields. Terms that equal a field
+// value score higher than terms that prefix one, which in turn score higher than terms contained within one. Nodes
+// with equal scores are ordered by ID.
+func (s *transaction) SearchText(search graph.TextSearch) ([]graph.TextSearchResult, int, error) {
+	if s.ctx.Err() != nil {
+		return nil, 0, graph.ErrContextTimedOut
+	}
+
+	terms := search.Terms()
+
+	if len(terms) == 0 {
+		return nil, 0, nil
+	}
+
+	if s.locking {
+		s.driver.lock.RLock()
+		defer s.driver.lock.RUnlock()
+	}
+
+	var matches []graph.TextSearchResult
+
+	for _, id := range s.store.nodeIDs() {
+		node := s.store.nodes[id]
+
+		if !search.Matches(node.Kinds) {
+			continue
+		}
+
+		if score, matched := scoreNode(node, search.Index.Fields, terms); matched {
+			matches = append(matches, graph.TextSearchResult{
+				Node:  copyNode(node),
+				Score: score,
+			})
+		}
+	}
+
+	// Node IDs are visited in ascending order so a stable sort keeps ties ordered by ID
+	slices.SortStableFunc(matches, func(a, b graph.TextSearchResult) int {
+		return cmp.Compare(b.Score, a.Score)
+	})
+
+	total := len(matches)
+
+	if search.Skip >= total {
+		return nil, total, nil
+	}
+
+	matches = matches[search.Skip:]
+
+	if search.Limit > 0 && search.Limit < len(matches) {
+		matches = matches[:search.Limit]
+	}
+
+	return matches, total, nil
+}
@@ -0,0 +1,378 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/specterops/bloodhound/dawgs/graph"
+)
+
+// store holds the nodes and relationships of an in-memory graph. The store is not safe for concurrent use; access is
+// serialized by the Driver. Every mutation records an undo operation in the journal so that a failed write
+// transaction may be rolled back.
+type store struct {
+	nodes              map[graph.ID]*graph.Node
+	relationships      map[graph.ID]*graph.Relationship
+	outbound           map[graph.ID]map[graph.ID]struct{}
+	inbound            map[graph.ID]map[graph.ID]struct{}
+	kinds              map[string]graph.Kind
+	lastNodeID         graph.ID
+	lastRelationshipID graph.ID
+	journal            []func()
+}
+
+func newStore() *store {
+	return &store{
+		nodes:         map[graph.ID]*graph.Node{},
+		relationships: map[graph.ID]*graph.Relationship{},
+		outbound:      map[graph.ID]map[graph.ID]struct{}{},
+		inbound:       map[graph.ID]map[graph.ID]struct{}{},
+		kinds:         map[string]graph.Kind{},
+	}
+}
+
+func (s *store) record(undo func()) {
+	s.journal = append(s.journal, undo)
+}
+
+// commit discards the undo journal, making all mutations since the last commit or rollback permanent.
+func (s *store) commit() {
+	s.journal = nil
+}
+
+// rollback undoes all mutations since the last commit or rollback.
+func (s *store) rollback() {
+	for idx := len(s.journal) - 1; idx >= 0; idx-- {
+		s.journal[idx]()
+	}
+
+	s.journal = nil
+}
+
+// savepoint returns a marker for the mutations recorded so far that rollbackTo may undo back to.
+func (s *store) savepoint() int {
+	return len(s.journal)
+}
+
+// rollbackTo undoes the mutations recorded since the given savepoint.
+func (s *store) rollbackTo(savepoint int) {
+	for idx := len(s.journal) - 1; idx >= savepoint; idx-- {
+		s.journal[idx]()
+	}
+
+	s.journal = s.journal[:savepoint]
+}
+
+func (s *store) assertKinds(kinds ...graph.Kind) {
+	for _, kind := range kinds {
+		if _, found := s.kinds[kind.String()]; !found {
+			s.kinds[kind.String()] = kind
+		}
+	}
+}
+
+func (s *store) fetchKinds() graph.Kinds {
+	kinds := make(graph.Kinds, 0, len(s.kinds))
+
+	for _, kind := range s.kinds {
+		kinds = append(kinds, kind)
+	}
+
+	slices.SortFunc(kinds, func(a, b graph.Kind) int {
+		return compareStrings(a.String(), b.String())
+	})
+
+	return kinds
+}
+
+func (s *store) nodeIDs() []graph.ID {
+	ids := make([]graph.ID, 0, len(s.nodes))
+
+	for id := range s.nodes {
+		ids = append(ids, id)
+	}
+
+	slices.Sort(ids)
+	return ids
+}
+
+func (s *store) relationshipIDs() []graph.ID {
+	ids := make([]graph.ID, 0, len(s.relationships))
+
+	for id := range s.relationships {
+		ids = append(ids, id)
+	}
+
+	slices.Sort(ids)
+	return ids
+}
+
+// adjacent returns the relationships attached to the given node in the given direction ordered by ID.
+func (s *store) adjacent(nodeID graph.ID, direction graph.Direction) []*graph.Relationship {
+	var ids []graph.ID
+
+	if direction == graph.DirectionOutbound || direction == graph.DirectionBoth {
+		for id := range s.outbound[nodeID] {
+			ids = append(ids, id)
+		}
+	}
+
+	if direction == graph.DirectionInbound || direction == graph.DirectionBoth {
+		for id := range s.inbound[nodeID] {
+			// Self-referencing relationships are already present in the outbound set
+			if direction != graph.DirectionBoth || s.relationships[id].StartID != s.relationships[id].EndID {
+				ids = append(ids, id)
+			}
+		}
+	}
+
+	slices.Sort(ids)
+
+	relationships := make([]*graph.Relationship, len(ids))
+
+	for idx, id := range ids {
+		relationships[idx] = s.relationships[id]
+	}
+
+	return relationships
+}
+
+func (s *store) degree(nodeID graph.ID) int {
+	return len(s.outbound[nodeID]) + len(s.inbound[nodeID])
+}
+
+func (s *store) createNode(id graph.ID, properties *graph.Properties, kinds graph.Kinds) (*graph.Node, error) {
+	if id == 0 || id == graph.UnregisteredNodeID {
+		id = s.lastNodeID + 1
+	} else if _, exists := s.nodes[id]; exists {
+		return nil, fmt.Errorf("node %d already exists", id)
+	}
+
+	node := &graph.Node{
+		ID:         id,
+		Kinds:      slices.Clone(kinds),
+		Properties: storedProperties(properties),
+	}
+
+	previousLastNodeID := s.lastNodeID
+
+	if id > s.lastNodeID {
+		s.lastNodeID = id
+	}
+
+	s.assertKinds(kinds...)
+	s.nodes[id] = node
+
+	s.record(func() {
+		delete(s.nodes, id)
+		s.lastNodeID = previousLastNodeID
+	})
+
+	return node, nil
+}
+
+// replaceNode swaps the kinds and properties of an existing node for the given values.
+func (s *store) replaceNode(node *graph.Node, kinds graph.Kinds, properties map[string]any) {
+	var (
+		previousKinds      = node.Kinds
+		previousProperties = node.Properties
+	)
+
+	s.assertKinds(kinds...)
+
+	node.Kinds = kinds
+	node.Properties = &graph.Properties{
+		Map: properties,
+	}
+
+	s.record(func() {
+		node.Kinds = previousKinds
+		node.Properties = previousProperties
+	})
+}
+
+func (s *store) deleteNode(id graph.ID, detach bool) error {
+	node, exists := s.nodes[id]
+
+	if !exists {
+		return nil
+	}
+
+	if s.degree(id) > 0 {
+		if !detach {
+			return fmt.Errorf("node %d still has relationships and can not be deleted without detaching them", id)
+		}
+
+		for _, relationship := range s.adjacent(id, graph.DirectionBoth) {
+			s.deleteRelationship(relationship.ID)
+		}
+	}
+
+	delete(s.nodes, id)
+
+	s.record(func() {
+		s.nodes[id] = node
+	})
+
+	return nil
+}
+
+func (s *store) createRelationship(startID, endID graph.ID, kind graph.Kind, properties *graph.Properties) (*graph.Relationship, error) {
+	if _, exists := s.nodes[startID]; !exists {
+		return nil, fmt.Errorf("start node %d does not exist", startID)
+	} else if _, exists := s.nodes[endID]; !exists {
+		return nil, fmt.Errorf("end node %d does not exist", endID)
+	}
+
+	var (
+		previousLastRelationshipID = s.lastRelationshipID
+		id                         = s.lastRelationshipID + 1
+		relationship               = &graph.Relationship{
+			ID:         id,
+			StartID:    startID,
+			EndID:      endID,
+			Kind:       kind,
+			Properties: storedProperties(properties),
+		}
+	)
+
+	s.lastRelationshipID = id
+	s.assertKinds(kind)
+	s.relationships[id] = relationship
+	s.link(relationship)
+
+	s.record(func() {
+		s.unlink(relationship)
+		delete(s.relationships, id)
+		s.lastRelationshipID = previousLastRelationshipID
+	})
+
+	return relationship, nil
+}
+
+// replaceRelationshipProperties swaps the properties of an existing relationship for the given values.
+func (s *store) replaceRelationshipProperties(relationship *graph.Relationship, properties map[string]any) {
+	previousProperties := relationship.Properties
+
+	relationship.Properties = &graph.Properties{
+		Map: properties,
+	}
+
+	s.record(func() {
+		relationship.Properties = previousProperties
+	})
+}
+
+func (s *store) deleteRelationship(id graph.ID) {
+	relationship, exists := s.relationships[id]
+
+	if !exists {
+		return
+	}
+
+	s.unlink(relationship)
+	delete(s.relationships, id)
+
+	s.record(func() {
+		s.relationships[id] = relationship
+		s.link(relationship)
+	})
+}
+
+func (s *store) link(relationship *graph.Relationship) {
+	if outbound, found := s.outbound[relationship.StartID]; found {
+		outbound[relationship.ID] = struct{}{}
+	} else {
+		s.outbound[relationship.StartID] = map[graph.ID]struct{}{relationship.ID: {}}
+	}
+
+	if inbound, found := s.inbound[relationship.EndID]; found {
+		inbound[relationship.ID] = struct{}{}
+	} else {
+		s.inbound[relationship.EndID] = map[graph.ID]struct{}{relationship.ID: {}}
+	}
+}
+
+func (s *store) unlink(relationship *graph.Relationship) {
+	delete(s.outbound[relationship.StartID], relationship.ID)
+	delete(s.inbound[relationship.EndID], relationship.ID)
+
+	if len(s.outbound[relationship.StartID]) == 0 {
+		delete(s.outbound, relationship.StartID)
+	}
+
+	if len(s.inbound[relationship.EndID]) == 0 {
+		delete(s.inbound, relationship.EndID)
+	}
+}
+
+// storedProperties copies the given properties into a new instance that carries no modification or deletion
+// tracking state.
+func storedProperties(properties *graph.Properties) *graph.Properties {
+	stored := &graph.Properties{
+		Map: map[string]any{},
+	}
+
+	if properties != nil {
+		for key, value := range properties.Map {
+			stored.Map[key] = value
+		}
+
+		for key := range properties.Deleted {
+			delete(stored.Map, key)
+		}
+	}
+
+	return stored
+}
+
+func copyNode(node *graph.Node) *graph.Node {
+	return &graph.Node{
+		ID:         node.ID,
+		Kinds:      slices.Clone(node.Kinds),
+		Properties: storedProperties(node.Properties),
+	}
+}
+
+func copyRelationship(relationship *graph.Relationship) *graph.Relationship {
+	return &graph.Relationship{
+		ID:         relationship.ID,
+		StartID:    relationship.StartID,
+		EndID:      relationship.EndID,
+		Kind:       relationship.Kind,
+		Properties: storedProperties(relationship.Properties),
+	}
+}
+
+func copyPath(path graph.Path) graph.Path {
+	pathCopy := graph.Path{
+		Nodes: make([]*graph.Node, len(path.Nodes)),
+		Edges: make([]*graph.Relationship, len(path.Edges)),
+	}
+
+	for idx, node := range path.Nodes {
+		pathCopy.Nodes[idx] = copyNode(node)
+	}
+
+	for idx, edge := range path.Edges {
+		pathCopy.Edges[idx] = copyRelationship(edge)
+	}
+
+	return pathCopy
+}
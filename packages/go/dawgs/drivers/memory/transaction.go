@@ -0,0 +1,488 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/specterops/bloodhound/cypher/frontend"
+	"github.com/specterops/bloodhound/cypher/models/cypher"
+	"github.com/specterops/bloodhound/dawgs/graph"
+	"github.com/specterops/bloodhound/dawgs/util/size"
+)
+
+type transaction struct {
+	ctx                   context.Context
+	driver                *Driver
+	store                 *store
+	stores                []*store
+	writable              bool
+	locking               bool
+	graphQueryMemoryLimit size.Size
+
+	// parent is the write transaction that a transaction nested within it on the same goroutine joins. Nested
+	// transactions leave committing to their parent and roll back only to the savepoints they started from.
+	parent     *transaction
+	savepoints map[*store]int
+}
+
+func newTransaction(ctx context.Context, driver *Driver, writable bool) *transaction {
+	defaultStore := driver.defaultStore()
+
+	return &transaction{
+		ctx:                   ctx,
+		driver:                driver,
+		store:                 defaultStore,
+		stores:                []*store{defaultStore},
+		writable:              writable,
+		graphQueryMemoryLimit: driver.graphQueryMemoryLimit,
+	}
+}
+
+// nested returns a transaction that joins this write transaction.
+func (s *transaction) nested(ctx context.Context, writable bool) *transaction {
+	tx := &transaction{
+		ctx:                   ctx,
+		driver:                s.driver,
+		store:                 s.driver.defaultStore(),
+		writable:              writable,
+		graphQueryMemoryLimit: s.graphQueryMemoryLimit,
+		parent:                s,
+		savepoints:            map[*store]int{},
+	}
+
+	tx.track(tx.store)
+	return tx
+}
+
+// track records the given store as one this transaction may write to so that it is committed or rolled back along
+// with the transaction.
+func (s *transaction) track(graphStore *store) {
+	if s.parent != nil {
+		if _, tracked := s.savepoints[graphStore]; !tracked {
+			s.savepoints[graphStore] = graphStore.savepoint()
+		}
+
+		s.parent.track(graphStore)
+	} else if !slices.Contains(s.stores, graphStore) {
+		s.stores = append(s.stores, graphStore)
+	}
+}
+
+func (s *transaction) GraphQueryMemoryLimit() size.Size {
+	return s.graphQueryMemoryLimit
+}
+
+func (s *transaction) WithGraph(graphSchema graph.Graph) graph.Transaction {
+	s.store = s.driver.graphStore(graphSchema.Name)
+
+	// Track every store this transaction may have written to so that all of them are committed or rolled back
+	s.track(s.store)
+
+	return s
+}
+
+func (s *transaction) commit() {
+	if s.parent != nil {
+		return
+	}
+
+	for _, graphStore := range s.stores {
+		graphStore.commit()
+	}
+}
+
+func (s *transaction) rollback() {
+	if s.parent != nil {
+		for graphStore, savepoint := range s.savepoints {
+			graphStore.rollbackTo(savepoint)
+		}
+
+		return
+	}
+
+	for _, graphStore := range s.stores {
+		graphStore.rollback()
+	}
+}
+
+func (s *transaction) checkWritable() error {
+	if !s.writable {
+		return ErrReadOnlyTransaction
+	}
+
+	return nil
+}
+
+func (s *transaction) CreateNode(properties *graph.Properties, kinds ...graph.Kind) (*graph.Node, error) {
+	if err := s.checkWritable(); err != nil {
+		return nil, err
+	} else if node, err := s.store.createNode(0, properties, kinds); err != nil {
+		return nil, err
+	} else {
+		return copyNode(node), nil
+	}
+}
+
+func (s *transaction) UpdateNode(node *graph.Node) error {
+	if err := s.checkWritable(); err != nil {
+		return err
+	} else if storedNode, found := s.store.nodes[node.ID]; !found {
+		return fmt.Errorf("node %d does not exist", node.ID)
+	} else {
+		kinds := storedNode.Kinds.Copy().Add(node.AddedKinds...)
+
+		for _, deletedKind := range node.DeletedKinds {
+			kinds = kinds.Remove(deletedKind)
+		}
+
+		s.store.replaceNode(storedNode, kinds, mergeProperties(storedNode.Properties, node.Properties))
+		return nil
+	}
+}
+
+func (s *transaction) Nodes() graph.NodeQuery {
+	return &nodeQuery{
+		liveQuery: newLiveQuery(s.ctx, s),
+	}
+}
+
+func (s *transaction) CreateRelationshipByIDs(startNodeID, endNodeID graph.ID, kind graph.Kind, properties *graph.Properties) (*graph.Relationship, error) {
+	if err := s.checkWritable(); err != nil {
+		return nil, err
+	} else if relationship, err := s.store.createRelationship(startNodeID, endNodeID, kind, properties); err != nil {
+		return nil, err
+	} else {
+		return copyRelationship(relationship), nil
+	}
+}
+
+func (s *transaction) UpdateRelationship(relationship *graph.Relationship) error {
+	if err := s.checkWritable(); err != nil {
+		return err
+	} else if storedRelationship, found := s.store.relationships[relationship.ID]; !found {
+		return fmt.Errorf("relationship %d does not exist", relationship.ID)
+	} else {
+		s.store.replaceRelationshipProperties(storedRelationship, mergeProperties(storedRelationship.Properties, relationship.Properties))
+		return nil
+	}
+}
+
+func (s *transaction) Relationships() graph.RelationshipQuery {
+	return &relationshipQuery{
+		liveQuery: newLiveQuery(s.ctx, s),
+	}
+}
+
+// Raw parses the given cypher query and executes it against the in-memory graph. There is no underlying database
+// query language for this driver so Raw and Query are equivalent.
+func (s *transaction) Raw(query string, parameters map[string]any) graph.Result {
+	if parsedQuery, err := frontend.ParseCypher(frontend.NewContext(), query); err != nil {
+		return graph.NewErrorResult(err)
+	} else {
+		return s.execute(parsedQuery, parameters)
+	}
+}
+
+func (s *transaction) Query(query string, parameters map[string]any) graph.Result {
+	return s.Raw(query, parameters)
+}
+
+func (s *transaction) execute(regularQuery *cypher.RegularQuery, parameters map[string]any) graph.Result {
+	if s.ctx.Err() != nil {
+		return graph.NewErrorResult(graph.ErrContextTimedOut)
+	}
+
+	// Query results are fully materialized, so locking transactions hold the driver lock only while a query executes
+	if s.locking && s.writable {
+		s.driver.lock.Lock()
+		defer s.driver.lock.Unlock()
+		defer s.commit()
+	} else if s.locking {
+		s.driver.lock.RLock()
+		defer s.driver.lock.RUnlock()
+	}
+
+	executor := newExecutor(s.ctx, s.store, s.writable, parameters)
+
+	if result, err := executor.execute(regularQuery); err != nil {
+		return graph.NewErrorResult(err)
+	} else {
+		return result
+	}
+}
+
+func (s *transaction) Commit() error {
+	if s.writable {
+		s.commit()
+	}
+
+	return nil
+}
+
+// mergeProperties applies the modified and deleted properties of the given update to a copy of the stored properties.
+func mergeProperties(stored, update *graph.Properties) map[string]any {
+	merged := make(map[string]any, len(stored.Map))
+
+	for key, value := range stored.Map {
+		merged[key] = value
+	}
+
+	if update != nil {
+		for key, value := range update.ModifiedProperties() {
+			merged[key] = value
+		}
+
+		for _, key := range update.DeletedProperties() {
+			delete(merged, key)
+		}
+	}
+
+	return merged
+}
+
+// upsertProperties applies all properties of the given update except the given create-only properties to a copy of the
+// stored properties. This mirrors the JSONB concatenation used by the PostgreSQL driver for batch upserts.
+func upsertProperties(stored, update *graph.Properties, createOnlyProperties []string) map[string]any {
+	merged := make(map[string]any, len(stored.Map))
+
+	for key, value := range stored.Map {
+		merged[key] = value
+	}
+
+	if update != nil {
+		for key, value := range update.Map {
+			if !slices.Contains(createOnlyProperties, key) {
+				merged[key] = value
+			}
+		}
+
+		for key := range update.Deleted {
+			delete(merged, key)
+		}
+	}
+
+	return merged
+}
+
+type identityIndex struct {
+	kind       graph.Kind
+	properties []string
+	nodes      map[string]graph.ID
+}
+
+func (s *identityIndex) add(node *graph.Node) {
+	if node.Kinds.ContainsOneOf(s.kind) {
+		if key, err := (graph.NodeUpdate{Node: node, IdentityProperties: s.properties}).Key(); err == nil {
+			s.nodes[key] = node.ID
+		}
+	}
+}
+
+type batch struct {
+	innerTransaction *transaction
+	identityIndexes  map[string]*identityIndex
+}
+
+func newBatch(tx *transaction) *batch {
+	// Queries issued through the batch take the driver lock themselves unless the batch joins a write transaction
+	// that already holds it
+	tx.locking = tx.parent == nil
+
+	return &batch{
+		innerTransaction: tx,
+		identityIndexes:  map[string]*identityIndex{},
+	}
+}
+
+// write applies the given batch operation under the driver lock, committing it on success and rolling it back on
+// failure.
+func (s *batch) write(delegate func() error) error {
+	if s.innerTransaction.locking {
+		s.innerTransaction.driver.lock.Lock()
+		defer s.innerTransaction.driver.lock.Unlock()
+	}
+
+	var (
+		graphStore = s.innerTransaction.store
+		savepoint  = graphStore.savepoint()
+	)
+
+	if err := delegate(); err != nil {
+		graphStore.rollbackTo(savepoint)
+		return err
+	}
+
+	s.innerTransaction.commit()
+	return nil
+}
+
+func (s *batch) WithGraph(graphSchema graph.Graph) graph.Batch {
+	s.innerTransaction.WithGraph(graphSchema)
+
+	// Identity indexes are built against the previously targeted graph
+	clear(s.identityIndexes)
+	return s
+}
+
+func (s *batch) CreateNode(node *graph.Node) error {
+	return s.write(func() error {
+		if createdNode, err := s.innerTransaction.store.createNode(node.ID, node.Properties, node.Kinds); err != nil {
+			return err
+		} else {
+			s.indexNode(createdNode)
+			return nil
+		}
+	})
+}
+
+func (s *batch) DeleteNode(id graph.ID) error {
+	return s.write(func() error {
+		return s.innerTransaction.store.deleteNode(id, true)
+	})
+}
+
+func (s *batch) Nodes() graph.NodeQuery {
+	return s.innerTransaction.Nodes()
+}
+
+func (s *batch) Relationships() graph.RelationshipQuery {
+	return s.innerTransaction.Relationships()
+}
+
+// identityIndex returns an index of identity keys to node IDs for all nodes of the given kind. The index is built on
+// first use and kept current by the batch as it creates nodes.
+func (s *batch) identityIndex(identityKind graph.Kind, identityProperties []string) *identityIndex {
+	sortedProperties := slices.Sorted(slices.Values(identityProperties))
+	indexKey := identityKind.String() + "\x00" + strings.Join(sortedProperties, "\x00")
+
+	if index, found := s.identityIndexes[indexKey]; found {
+		return index
+	}
+
+	index := &identityIndex{
+		kind:       identityKind,
+		properties: sortedProperties,
+		nodes:      map[string]graph.ID{},
+	}
+
+	for _, nodeID := range s.innerTransaction.store.nodeIDs() {
+		index.add(s.innerTransaction.store.nodes[nodeID])
+	}
+
+	s.identityIndexes[indexKey] = index
+	return index
+}
+
+func (s *batch) indexNode(node *graph.Node) {
+	for _, index := range s.identityIndexes {
+		index.add(node)
+	}
+}
+
+func (s *batch) existingNode(identityKind graph.Kind, identityProperties []string, key string) (*graph.Node, bool) {
+	if existingID, found := s.identityIndex(identityKind, identityProperties).nodes[key]; !found {
+		return nil, false
+	} else {
+		// The node may have been deleted since it was indexed
+		existingNode, exists := s.innerTransaction.store.nodes[existingID]
+		return existingNode, exists
+	}
+}
+
+func (s *batch) upsertNode(update graph.NodeUpdate) (graph.ID, error) {
+	update.Node.AddKinds(update.IdentityKind)
+
+	if key, err := update.Key(); err != nil {
+		return 0, err
+	} else if existingNode, found := s.existingNode(update.IdentityKind, update.IdentityProperties, key); found {
+		s.innerTransaction.store.replaceNode(existingNode, existingNode.Kinds.Copy().Add(update.Node.Kinds...), upsertProperties(existingNode.Properties, update.Node.Properties, update.CreateOnlyProperties))
+		s.indexNode(existingNode)
+
+		return existingNode.ID, nil
+	} else if createdNode, err := s.innerTransaction.store.createNode(0, update.Node.Properties, update.Node.Kinds); err != nil {
+		return 0, err
+	} else {
+		s.indexNode(createdNode)
+		return createdNode.ID, nil
+	}
+}
+
+func (s *batch) UpdateNodeBy(update graph.NodeUpdate) error {
+	return s.write(func() error {
+		_, err := s.upsertNode(update)
+		return err
+	})
+}
+
+// upsertRelationship creates the relationship or, if a relationship of the same kind already exists between the given
+// nodes, merges the given properties into it. The given create-only properties are only written if the relationship is
+// created.
+func (s *batch) upsertRelationship(startID, endID graph.ID, kind graph.Kind, properties *graph.Properties, createOnlyProperties []string) error {
+	for _, existing := range s.innerTransaction.store.adjacent(startID, graph.DirectionOutbound) {
+		if existing.EndID == endID && existing.Kind.Is(kind) {
+			s.innerTransaction.store.replaceRelationshipProperties(existing, upsertProperties(existing.Properties, properties, createOnlyProperties))
+			return nil
+		}
+	}
+
+	_, err := s.innerTransaction.store.createRelationship(startID, endID, kind, properties)
+	return err
+}
+
+func (s *batch) CreateRelationship(relationship *graph.Relationship) error {
+	return s.write(func() error {
+		return s.upsertRelationship(relationship.StartID, relationship.EndID, relationship.Kind, relationship.Properties, nil)
+	})
+}
+
+func (s *batch) CreateRelationshipByIDs(startNodeID, endNodeID graph.ID, kind graph.Kind, properties *graph.Properties) error {
+	return s.write(func() error {
+		return s.upsertRelationship(startNodeID, endNodeID, kind, properties, nil)
+	})
+}
+
+func (s *batch) DeleteRelationship(id graph.ID) error {
+	return s.write(func() error {
+		s.innerTransaction.store.deleteRelationship(id)
+		return nil
+	})
+}
+
+func (s *batch) UpdateRelationshipBy(update graph.RelationshipUpdate) error {
+	return s.write(func() error {
+		return s.upsertRelationshipBy(update)
+	})
+}
+
+func (s *batch) upsertRelationshipBy(update graph.RelationshipUpdate) error {
+	if startID, err := s.upsertNode(update.StartNodeUpdate()); err != nil {
+		return err
+	} else if endID, err := s.upsertNode(update.EndNodeUpdate()); err != nil {
+		return err
+	} else {
+		return s.upsertRelationship(startID, endID, update.Relationship.Kind, update.Relationship.Properties, update.CreateOnlyProperties)
+	}
+}
+
+// Commit is a no-op as each batch operation is committed as it is applied.
+func (s *batch) Commit() error {
+	return nil
+}
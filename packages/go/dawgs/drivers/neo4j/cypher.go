@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"fmt"
 	"log/slog"
+	"slices"
 	"sort"
 	"strings"
 
@@ -48,7 +49,17 @@ func relUpdateKey(update graph.RelationshipUpdate) string {
 		newUpdateKey(update.EndIdentityKind, update.EndIdentityProperties, update.End.Kinds),
 	}
 
-	return strings.Join(keys, "")
+	return strings.Join(keys, "") + createOnlyUpdateKey(update.CreateOnlyProperties)
+}
+
+// createOnlyUpdateKey returns the part of an update key that separates updates by their create-only properties, since
+// these change the shape of the generated query
+func createOnlyUpdateKey(createOnlyProperties []string) string {
+	if len(createOnlyProperties) == 0 {
+		return ""
+	}
+
+	return "\x00" + strings.Join(slices.Sorted(slices.Values(createOnlyProperties)), "\x00")
 }
 
 type relUpdates struct {
@@ -60,6 +71,7 @@ type relUpdates struct {
 	endIdentityKind         graph.Kind
 	endIdentityProperties   []string
 	endNodeKindsToAdd       graph.Kinds
+	createOnly              bool
 	properties              []map[string]any
 }
 
@@ -75,6 +87,13 @@ func (s relUpdateByMap) add(update graph.RelationshipUpdate) {
 		}
 	)
 
+	// Create-only properties are set separately when the merge creates the relationship or its nodes
+	if len(update.CreateOnlyProperties) > 0 {
+		updateProperties["r"], updateProperties["rc"] = graph.SplitCreateOnlyProperties(update.Relationship.Properties, update.CreateOnlyProperties)
+		updateProperties["s"], updateProperties["sc"] = graph.SplitCreateOnlyProperties(update.Start.Properties, update.CreateOnlyProperties)
+		updateProperties["e"], updateProperties["ec"] = graph.SplitCreateOnlyProperties(update.End.Properties, update.CreateOnlyProperties)
+	}
+
 	if updates, hasUpdates := s[updateKey]; hasUpdates {
 		updates.properties = append(updates.properties, updateProperties)
 	} else {
@@ -87,6 +106,7 @@ func (s relUpdateByMap) add(update graph.RelationshipUpdate) {
 			endIdentityKind:         update.EndIdentityKind,
 			endIdentityProperties:   update.EndIdentityProperties,
 			endNodeKindsToAdd:       update.End.Kinds,
+			createOnly:              len(update.CreateOnlyProperties) > 0,
 			properties: []map[string]any{
 				updateProperties,
 			},
@@ -130,7 +150,13 @@ func cypherBuildRelationshipUpdateQueryBatch(updates []graph.RelationshipUpdate)
 			output.WriteString("}")
 		}
 
-		output.WriteString(") merge (e:")
+		output.WriteString(")")
+
+		if batch.createOnly {
+			output.WriteString(" on create set s += p.sc")
+		}
+
+		output.WriteString(" merge (e:")
 		output.WriteString(batch.endIdentityKind.String())
 
 		if len(batch.endIdentityProperties) > 0 {
@@ -152,7 +178,13 @@ func cypherBuildRelationshipUpdateQueryBatch(updates []graph.RelationshipUpdate)
 			output.WriteString("}")
 		}
 
-		output.WriteString(") merge (s)-[r:")
+		output.WriteString(")")
+
+		if batch.createOnly {
+			output.WriteString(" on create set e += p.ec")
+		}
+
+		output.WriteString(" merge (s)-[r:")
 		output.WriteString(batch.identityKind.String())
 
 		if len(batch.identityProperties) > 0 {
@@ -174,7 +206,13 @@ func cypherBuildRelationshipUpdateQueryBatch(updates []graph.RelationshipUpdate)
 			output.WriteString("}")
 		}
 
-		output.WriteString("]->(e) set s += p.s, e += p.e, r += p.r")
+		output.WriteString("]->(e)")
+
+		if batch.createOnly {
+			output.WriteString(" on create set r += p.rc")
+		}
+
+		output.WriteString(" set s += p.s, e += p.e, r += p.r")
 
 		if len(batch.startNodeKindsToAdd) > 0 {
 			for _, kindToAdd := range batch.startNodeKindsToAdd {
@@ -207,24 +245,40 @@ type nodeUpdates struct {
 	identityProperties []string
 	nodeKindsToAdd     graph.Kinds
 	nodeKindsToRemove  graph.Kinds
+	createOnly         bool
 	properties         []map[string]any
 }
 
 type nodeUpdateByMap map[string]*nodeUpdates
 
 func (s nodeUpdateByMap) add(update graph.NodeUpdate) {
-	updateKey := newUpdateKey(update.IdentityKind, update.IdentityProperties, update.Node.Kinds)
+	var (
+		updateKey        = newUpdateKey(update.IdentityKind, update.IdentityProperties, update.Node.Kinds) + createOnlyUpdateKey(update.CreateOnlyProperties)
+		updateProperties = update.Node.Properties.Map
+	)
+
+	// Create-only properties are set separately when the merge creates the node. The properties of the update are
+	// nested so that they are kept apart from the create-only properties.
+	if len(update.CreateOnlyProperties) > 0 {
+		updated, created := graph.SplitCreateOnlyProperties(update.Node.Properties, update.CreateOnlyProperties)
+
+		updateProperties = map[string]any{
+			"u": updated,
+			"c": created,
+		}
+	}
 
 	if updates, hasUpdates := s[updateKey]; hasUpdates {
-		updates.properties = append(updates.properties, update.Node.Properties.Map)
+		updates.properties = append(updates.properties, updateProperties)
 	} else {
 		s[updateKey] = &nodeUpdates{
 			identityKind:       update.IdentityKind,
 			identityProperties: update.IdentityProperties,
 			nodeKindsToAdd:     update.Node.Kinds,
 			nodeKindsToRemove:  update.Node.DeletedKinds,
+			createOnly:         len(update.CreateOnlyProperties) > 0,
 			properties: []map[string]any{
-				update.Node.Properties.Map,
+				updateProperties,
 			},
 		}
 	}
@@ -244,6 +298,12 @@ func cypherBuildNodeUpdateQueryBatch(updates []graph.NodeUpdate) ([]string, []ma
 	}
 
 	for _, batch := range batchedUpdates {
+		updatedProperties := "p"
+
+		if batch.createOnly {
+			updatedProperties = "p.u"
+		}
+
 		output.WriteString("unwind $p as p merge (n:")
 		output.WriteString(batch.identityKind.String())
 
@@ -259,14 +319,23 @@ func cypherBuildNodeUpdateQueryBatch(updates []graph.NodeUpdate) ([]string, []ma
 				}
 
 				output.WriteString(identityProperty)
-				output.WriteString(":p.")
+				output.WriteString(":")
+				output.WriteString(updatedProperties)
+				output.WriteString(".")
 				output.WriteString(identityProperty)
 			}
 
 			output.WriteString("}")
 		}
 
-		output.WriteString(") set n += p")
+		output.WriteString(")")
+
+		if batch.createOnly {
+			output.WriteString(" on create set n += p.c")
+		}
+
+		output.WriteString(" set n += ")
+		output.WriteString(updatedProperties)
 
 		if len(batch.nodeKindsToAdd) > 0 {
 			for _, kindToAdd := range batch.nodeKindsToAdd {
@@ -93,6 +93,59 @@ func Test_relUpdateKey(t *testing.T) {
 	require.Equal(t, "BaseUserobjectidGenericAllBaseGroupobjectid", updateKey)
 }
 
+func Test_cypherBuildNodeUpdateQueryBatch_CreateOnlyProperties(t *testing.T) {
+	queries, parameters := cypherBuildNodeUpdateQueryBatch([]graph.NodeUpdate{{
+		Node: &graph.Node{
+			Kinds: graph.Kinds{graph.StringKind("User")},
+			Properties: graph.AsProperties(map[string]any{
+				"objectid":       "OID-1",
+				"firstingestjob": 1,
+			}),
+		},
+		IdentityKind:         graph.StringKind("Base"),
+		IdentityProperties:   []string{"objectid"},
+		CreateOnlyProperties: []string{"firstingestjob"},
+	}})
+
+	require.Equal(t, []string{"unwind $p as p merge (n:Base {objectid:p.u.objectid}) on create set n += p.c set n += p.u, n:User;"}, queries)
+	require.Equal(t, []map[string]any{{
+		"p": []map[string]any{{
+			"u": map[string]any{"objectid": "OID-1"},
+			"c": map[string]any{"firstingestjob": 1},
+		}},
+	}}, parameters)
+}
+
+func Test_cypherBuildRelationshipUpdateQueryBatch_CreateOnlyProperties(t *testing.T) {
+	queries, parameters := cypherBuildRelationshipUpdateQueryBatch([]graph.RelationshipUpdate{{
+		Relationship: &graph.Relationship{
+			Kind:       graph.StringKind("MemberOf"),
+			Properties: graph.AsProperties(map[string]any{"firstingestjob": 1}),
+		},
+		Start: &graph.Node{
+			Properties: graph.AsProperties(map[string]any{"objectid": "OID-1", "firstingestjob": 1}),
+		},
+		StartIdentityKind:       graph.StringKind("Base"),
+		StartIdentityProperties: []string{"objectid"},
+		End: &graph.Node{
+			Properties: graph.AsProperties(map[string]any{"objectid": "OID-2"}),
+		},
+		EndIdentityKind:       graph.StringKind("Base"),
+		EndIdentityProperties: []string{"objectid"},
+		CreateOnlyProperties:  []string{"firstingestjob"},
+	}})
+
+	require.Equal(t, []string{"unwind $p as p merge (s:Base {objectid:p.s.objectid}) on create set s += p.sc merge (e:Base {objectid:p.e.objectid}) on create set e += p.ec merge (s)-[r:MemberOf]->(e) on create set r += p.rc set s += p.s, e += p.e, r += p.r, s.lastseen = datetime({timezone: 'UTC'}), e.lastseen = datetime({timezone: 'UTC'});"}, queries)
+	require.Equal(t, [][]map[string]any{{{
+		"r":  map[string]any{},
+		"rc": map[string]any{"firstingestjob": 1},
+		"s":  map[string]any{"objectid": "OID-1"},
+		"sc": map[string]any{"firstingestjob": 1},
+		"e":  map[string]any{"objectid": "OID-2"},
+		"ec": map[string]any{},
+	}}}, parameters)
+}
+
 func Test_StripCypher(t *testing.T) {
 	var (
 		query = "match (u1:User {domain: \"DOMAIN1\"}), (u2:User {domain: \"DOMAIN2\"}) where u1.samaccountname <> \"krbtgt\" and u1.samaccountname = u2.samaccountname with u2 match p1 = (u2)-[*1..]->(g:Group) with p1 match p2 = (u2)-[*1..]->(g:Group) return p1, p2"
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strings"
 
 	"github.com/specterops/bloodhound/dawgs/graph"
@@ -33,6 +34,8 @@ const (
 	dropPropertyConstraintStatement   = "drop constraint $name;"
 	createPropertyIndexStatement      = "call db.createIndex($name, $labels, $properties, $provider);"
 	createPropertyConstraintStatement = "call db.createUniquePropertyConstraint($name, $labels, $properties, $provider);"
+
+	fullTextIndexType = "FULLTEXT"
 )
 
 type neo4jIndex struct {
@@ -47,15 +50,70 @@ type neo4jConstraint struct {
 	kind graph.Kind
 }
 
+// neo4jFullTextIndex is a full-text index that spans the given node labels. Neo4j full-text indexes are named
+// database-wide so the labels of every graph declaring the same full-text index are merged into one index.
+type neo4jFullTextIndex struct {
+	graph.FullTextIndex
+
+	kinds graph.Kinds
+}
+
+func (s neo4jFullTextIndex) equals(other neo4jFullTextIndex) bool {
+	var (
+		labels      = s.kinds.Strings()
+		otherLabels = other.kinds.Strings()
+	)
+
+	slices.Sort(labels)
+	slices.Sort(otherLabels)
+
+	return slices.Equal(s.Fields, other.Fields) && slices.Equal(labels, otherLabels)
+}
+
+func formatCreateFullTextIndex(index neo4jFullTextIndex) string {
+	builder := strings.Builder{}
+
+	builder.WriteString("create fulltext index ")
+	builder.WriteString(index.Name)
+	builder.WriteString(" for (n:")
+
+	for idx, kind := range index.kinds {
+		if idx > 0 {
+			builder.WriteString("|")
+		}
+
+		builder.WriteString("`")
+		builder.WriteString(kind.String())
+		builder.WriteString("`")
+	}
+
+	builder.WriteString(") on each [")
+
+	for idx, field := range index.Fields {
+		if idx > 0 {
+			builder.WriteString(", ")
+		}
+
+		builder.WriteString("n.`")
+		builder.WriteString(field)
+		builder.WriteString("`")
+	}
+
+	builder.WriteString("];")
+	return builder.String()
+}
+
 type neo4jSchema struct {
-	Indexes     map[string]neo4jIndex
-	Constraints map[string]neo4jConstraint
+	Indexes         map[string]neo4jIndex
+	Constraints     map[string]neo4jConstraint
+	FullTextIndexes map[string]neo4jFullTextIndex
 }
 
 func newNeo4jSchema() neo4jSchema {
 	return neo4jSchema{
-		Indexes:     map[string]neo4jIndex{},
-		Constraints: map[string]neo4jConstraint{},
+		Indexes:         map[string]neo4jIndex{},
+		Constraints:     map[string]neo4jConstraint{},
+		FullTextIndexes: map[string]neo4jFullTextIndex{},
 	}
 }
 
@@ -92,6 +150,15 @@ func toNeo4jSchema(dbSchema graph.Schema) neo4jSchema {
 				}
 			}
 		}
+
+		for _, fullTextIndex := range graphSchema.NodeFullTextIndexes {
+			existing := neo4jSchemaInst.FullTextIndexes[fullTextIndex.Name]
+
+			neo4jSchemaInst.FullTextIndexes[fullTextIndex.Name] = neo4jFullTextIndex{
+				FullTextIndex: fullTextIndex,
+				kinds:         existing.kinds.Add(graphSchema.Nodes...),
+			}
+		}
 	}
 
 	return neo4jSchemaInst
@@ -155,6 +222,26 @@ func assertIndexes(ctx context.Context, db graph.Database, indexesToRemove []str
 	})
 }
 
+func assertFullTextIndexes(ctx context.Context, db graph.Database, indexesToRemove []string, indexesToAdd map[string]neo4jFullTextIndex) error {
+	for _, indexToRemove := range indexesToRemove {
+		slog.InfoContext(ctx, fmt.Sprintf("Removing full-text index %s", indexToRemove))
+
+		if err := db.Run(ctx, strings.Replace(dropPropertyIndexStatement, "$name", indexToRemove, 1), nil); err != nil {
+			return err
+		}
+	}
+
+	for indexName, indexToAdd := range indexesToAdd {
+		slog.InfoContext(ctx, fmt.Sprintf("Adding full-text index %s on properties %s", indexName, strings.Join(indexToAdd.Fields, ", ")))
+
+		if err := db.Run(ctx, formatCreateFullTextIndex(indexToAdd), nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func assertConstraints(ctx context.Context, db graph.Database, constraintsToRemove []string, constraintsToAdd map[string]neo4jConstraint) error {
 	for _, constraintToRemove := range constraintsToRemove {
 		if err := db.Run(ctx, strings.Replace(dropPropertyConstraintStatement, "$name", constraintToRemove, 1), nil); err != nil {
@@ -180,13 +267,14 @@ func fetchPresentSchema(ctx context.Context, db graph.Database) (neo4jSchema, er
 	presentSchema := newNeo4jSchema()
 
 	return presentSchema, db.ReadTransaction(ctx, func(tx graph.Transaction) error {
-		if result := tx.Raw("call db.indexes() yield name, uniqueness, provider, labelsOrTypes, properties;", nil); result.Error() != nil {
+		if result := tx.Raw("call db.indexes() yield name, type, uniqueness, provider, labelsOrTypes, properties;", nil); result.Error() != nil {
 			return result.Error()
 		} else {
 			defer result.Close()
 
 			var (
 				name       string
+				indexType  string
 				uniqueness string
 				provider   string
 				labels     []string
@@ -194,10 +282,29 @@ func fetchPresentSchema(ctx context.Context, db graph.Database) (neo4jSchema, er
 			)
 
 			for result.Next() {
-				if err := result.Scan(&name, &uniqueness, &provider, &labels, &properties); err != nil {
+				if err := result.Scan(&name, &indexType, &uniqueness, &provider, &labels, &properties); err != nil {
 					return err
 				}
 
+				// Full-text indexes span multiple labels and properties and are tracked separately
+				if indexType == fullTextIndexType {
+					kinds := make(graph.Kinds, 0, len(labels))
+
+					for _, label := range labels {
+						kinds = append(kinds, graph.StringKind(label))
+					}
+
+					presentSchema.FullTextIndexes[name] = neo4jFullTextIndex{
+						FullTextIndex: graph.FullTextIndex{
+							Name:   name,
+							Fields: properties,
+						},
+						kinds: kinds,
+					}
+
+					continue
+				}
+
 				// Need this for neo4j 4.4+ which creates a weird index by default
 				if len(labels) == 0 {
 					continue
@@ -240,10 +347,12 @@ func assertSchema(ctx context.Context, db graph.Database, required graph.Schema)
 		return err
 	} else {
 		var (
-			indexesToRemove     []string
-			constraintsToRemove []string
-			indexesToAdd        = map[string]neo4jIndex{}
-			constraintsToAdd    = map[string]neo4jConstraint{}
+			indexesToRemove         []string
+			constraintsToRemove     []string
+			fullTextIndexesToRemove []string
+			indexesToAdd            = map[string]neo4jIndex{}
+			constraintsToAdd        = map[string]neo4jConstraint{}
+			fullTextIndexesToAdd    = map[string]neo4jFullTextIndex{}
 		)
 
 		for presentIndexName := range presentNeo4jSchema.Indexes {
@@ -276,8 +385,25 @@ func assertSchema(ctx context.Context, db graph.Database, required graph.Schema)
 			}
 		}
 
+		for presentFullTextIndexName := range presentNeo4jSchema.FullTextIndexes {
+			if _, hasMatchingDefinition := requiredNeo4jSchema.FullTextIndexes[presentFullTextIndexName]; !hasMatchingDefinition {
+				fullTextIndexesToRemove = append(fullTextIndexesToRemove, presentFullTextIndexName)
+			}
+		}
+
+		for requiredFullTextIndexName, requiredFullTextIndex := range requiredNeo4jSchema.FullTextIndexes {
+			if presentFullTextIndex, hasMatchingDefinition := presentNeo4jSchema.FullTextIndexes[requiredFullTextIndexName]; !hasMatchingDefinition {
+				fullTextIndexesToAdd[requiredFullTextIndexName] = requiredFullTextIndex
+			} else if !requiredFullTextIndex.equals(presentFullTextIndex) {
+				fullTextIndexesToRemove = append(fullTextIndexesToRemove, requiredFullTextIndexName)
+				fullTextIndexesToAdd[requiredFullTextIndexName] = requiredFullTextIndex
+			}
+		}
+
 		if err := assertConstraints(ctx, db, constraintsToRemove, constraintsToAdd); err != nil {
 			return err
+		} else if err := assertFullTextIndexes(ctx, db, fullTextIndexesToRemove, fullTextIndexesToAdd); err != nil {
+			return err
 		}
 
 		return assertIndexes(ctx, db, indexesToRemove, indexesToAdd)
@@ -0,0 +1,58 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package neo4j
+
+import (
+	"testing"
+
+	"github.com/specterops/bloodhound/dawgs/graph"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_formatLuceneQuery(t *testing.T) {
+	require.Equal(t, `admin* AND svc\-sql\/db01*`, formatLuceneQuery([]string{"admin", "svc-sql/db01"}))
+	require.Equal(t, `a\:b\*\"c*`, formatLuceneQuery([]string{`a:b*"c`}))
+}
+
+func Test_toNeo4jSchema_FullTextIndexes(t *testing.T) {
+	var (
+		searchIndex = graph.FullTextIndex{
+			Name:   "node_search",
+			Fields: []string{"name", "email"},
+		}
+		schema = toNeo4jSchema(graph.Schema{
+			Graphs: []graph.Graph{{
+				Nodes:               graph.Kinds{graph.StringKind("User"), graph.StringKind("Group")},
+				NodeFullTextIndexes: []graph.FullTextIndex{searchIndex},
+			}, {
+				Nodes:               graph.Kinds{graph.StringKind("AZUser"), graph.StringKind("User")},
+				NodeFullTextIndexes: []graph.FullTextIndex{searchIndex},
+			}},
+		})
+	)
+
+	// Labels of every graph declaring the same full-text index are merged into a single index
+	require.Len(t, schema.FullTextIndexes, 1)
+	require.Equal(t, "create fulltext index node_search for (n:`User`|`Group`|`AZUser`) on each [n.`name`, n.`email`];", formatCreateFullTextIndex(schema.FullTextIndexes["node_search"]))
+
+	// Label order does not matter when comparing against the present definition
+	require.True(t, schema.FullTextIndexes["node_search"].equals(neo4jFullTextIndex{
+		FullTextIndex: searchIndex,
+		kinds:         graph.Kinds{graph.StringKind("AZUser"), graph.StringKind("Group"), graph.StringKind("User")},
+	}))
+}
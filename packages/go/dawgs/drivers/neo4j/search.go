@@ -0,0 +1,119 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package neo4j
+
+import (
+	"strings"
+
+	"github.com/specterops/bloodhound/dawgs/graph"
+)
+
+const (
+	textSearchFilter = "call db.index.fulltext.queryNodes($index, $query) yield node, score " +
+		"where (size($kinds) = 0 or any(label in labels(node) where label in $kinds)) " +
+		"and not (any(label in labels(node) where label in $excluded_kinds) and not any(label in labels(node) where label in $exempt_kinds)) "
+	textSearchCountStatement = textSearchFilter + "return count(node);"
+	textSearchStatement      = textSearchFilter + "return node, score order by score desc, id(node) skip $skip"
+)
+
+// luceneEscaper escapes the characters that carry meaning in the Lucene query syntax.
+var luceneEscaper = strings.NewReplacer(
+	`\`, `\\`, `+`, `\+`, `-`, `\-`, `&`, `\&`, `|`, `\|`, `!`, `\!`, `(`, `\(`, `)`, `\)`, `{`, `\{`, `}`, `\}`,
+	`[`, `\[`, `]`, `\]`, `^`, `\^`, `"`, `\"`, `~`, `\~`, `*`, `\*`, `?`, `\?`, `:`, `\:`, `/`, `\/`,
+)
+
+// formatLuceneQuery requires every term to prefix a token of the indexed properties.
+func formatLuceneQuery(terms []string) string {
+	clauses := make([]string, 0, len(terms))
+
+	for _, term := range terms {
+		clauses = append(clauses, luceneEscaper.Replace(term)+"*")
+	}
+
+	return strings.Join(clauses, " AND ")
+}
+
+// SearchText queries the named full-text index. Each search term must prefix a token of one of the index's fields.
+// Nodes are ranked by their Lucene relevance score.
+func (s *neo4jTransaction) SearchText(search graph.TextSearch) ([]graph.TextSearchResult, int, error) {
+	var (
+		terms = search.Terms()
+		total int
+	)
+
+	if len(terms) == 0 {
+		return nil, 0, nil
+	}
+
+	var (
+		parameters = map[string]any{
+			"index":          search.Index.Name,
+			"query":          formatLuceneQuery(terms),
+			"kinds":          search.Kinds.Strings(),
+			"excluded_kinds": search.ExcludedKinds.Strings(),
+			"exempt_kinds":   search.ExemptKinds.Strings(),
+			"skip":           search.Skip,
+			"limit":          search.Limit,
+		}
+		countResult = s.Raw(textSearchCountStatement, parameters)
+	)
+
+	defer countResult.Close()
+
+	if !countResult.Next() {
+		return nil, 0, countResult.Error()
+	} else if err := countResult.Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	countResult.Close()
+
+	if total <= search.Skip {
+		return nil, total, nil
+	}
+
+	statement := textSearchStatement
+
+	if search.Limit > 0 {
+		statement += " limit $limit"
+	}
+
+	var (
+		results      []graph.TextSearchResult
+		searchResult = s.Raw(statement, parameters)
+	)
+
+	defer searchResult.Close()
+
+	for searchResult.Next() {
+		var (
+			node  graph.Node
+			score float64
+		)
+
+		if err := searchResult.Scan(&node, &score); err != nil {
+			return nil, 0, err
+		}
+
+		results = append(results, graph.TextSearchResult{
+			Node:  &node,
+			Score: score,
+		})
+	}
+
+	return results, total, searchResult.Error()
+}
@@ -26,6 +26,7 @@ import (
 
 	"github.com/specterops/bloodhound/dawgs/drivers"
 	"github.com/specterops/bloodhound/dawgs/query/neo4j"
+	"github.com/specterops/bloodhound/dawgs/tracing"
 	"github.com/specterops/bloodhound/dawgs/util/size"
 
 	neo4j_core "github.com/neo4j/neo4j-go-driver/v5/neo4j"
@@ -338,8 +339,10 @@ func (s *neo4jTransaction) Raw(stmt string, params map[string]any) graph.Result
 		slog.Info(fmt.Sprintf("%s - %s", stmt, prettyParameters.String()), "dawgs_db_driver", DriverName)
 	}
 
+	_, span := tracing.StartQuery(s.ctx, DriverName, stmt)
+
 	driverResult, err := s.currentTx().Run(stmt, params)
-	return NewResult(stmt, err, driverResult)
+	return NewResult(stmt, tracing.End(span, err), driverResult)
 }
 
 func (s *neo4jTransaction) Nodes() graph.NodeQuery {
@@ -82,6 +82,14 @@ func newBatch(ctx context.Context, conn *pgxpool.Conn, schemaManager *SchemaMana
 	}
 }
 
+// driver returns the connection batch statements are sent through. Batch statements are traced but are not sent
+// through the query inspector.
+func (s *batch) driver() driver {
+	return tracingDriver{
+		upstreamDriver: s.innerTransaction.conn,
+	}
+}
+
 func (s *batch) WithGraph(schema graph.Graph) graph.Batch {
 	s.innerTransaction.WithGraph(schema)
 	return s
@@ -106,7 +114,7 @@ func (s *batch) UpdateNodeBy(update graph.NodeUpdate) error {
 }
 
 func (s *batch) flushNodeDeleteBuffer() error {
-	if _, err := s.innerTransaction.conn.Exec(s.ctx, deleteNodeWithIDStatement, s.nodeDeletionBuffer); err != nil {
+	if _, err := s.driver().Exec(s.ctx, deleteNodeWithIDStatement, s.nodeDeletionBuffer); err != nil {
 		return err
 	}
 
@@ -115,7 +123,7 @@ func (s *batch) flushNodeDeleteBuffer() error {
 }
 
 func (s *batch) flushRelationshipDeleteBuffer() error {
-	if _, err := s.innerTransaction.conn.Exec(s.ctx, deleteEdgeWithIDStatement, s.relationshipDeletionBuffer); err != nil {
+	if _, err := s.driver().Exec(s.ctx, deleteEdgeWithIDStatement, s.relationshipDeletionBuffer); err != nil {
 		return err
 	}
 
@@ -177,7 +185,7 @@ func (s *batch) flushNodeCreateBufferWithIDs() error {
 
 	if graphTarget, err := s.innerTransaction.getTargetGraph(); err != nil {
 		return err
-	} else if _, err := s.innerTransaction.conn.Exec(s.ctx, createNodeWithIDBatchStatement, graphTarget.ID, nodeIDs, kindIDSlices, properties); err != nil {
+	} else if _, err := s.driver().Exec(s.ctx, createNodeWithIDBatchStatement, graphTarget.ID, nodeIDs, kindIDSlices, properties); err != nil {
 		return err
 	}
 
@@ -211,7 +219,7 @@ func (s *batch) flushNodeCreateBufferWithoutIDs() error {
 
 	if graphTarget, err := s.innerTransaction.getTargetGraph(); err != nil {
 		return err
-	} else if _, err := s.innerTransaction.conn.Exec(s.ctx, createNodeWithoutIDBatchStatement, graphTarget.ID, kindIDSlices, properties); err != nil {
+	} else if _, err := s.driver().Exec(s.ctx, createNodeWithoutIDBatchStatement, graphTarget.ID, kindIDSlices, properties); err != nil {
 		return err
 	}
 
@@ -229,9 +237,16 @@ func (s *batch) flushNodeUpsertBatch(updates *sql.NodeUpdateBatch) error {
 	if graphTarget, err := s.innerTransaction.getTargetGraph(); err != nil {
 		return err
 	} else {
-		query := sql.FormatNodeUpsert(graphTarget, updates.IdentityProperties)
+		var (
+			query           = sql.FormatNodeUpsert(graphTarget, updates.IdentityProperties, updates.CreateOnlyProperties)
+			queryParameters = parameters.Format(graphTarget)
+		)
 
-		if rows, err := s.innerTransaction.conn.Query(s.ctx, query, parameters.Format(graphTarget)...); err != nil {
+		if len(updates.CreateOnlyProperties) > 0 {
+			queryParameters = append(queryParameters, updates.CreateOnlyProperties)
+		}
+
+		if rows, err := s.driver().Query(s.ctx, query, queryParameters...); err != nil {
 			return err
 		} else {
 			defer rows.Close()
@@ -380,9 +395,16 @@ func (s *batch) flushRelationshipUpdateByBuffer(updates *sql.RelationshipUpdateB
 	if graphTarget, err := s.innerTransaction.getTargetGraph(); err != nil {
 		return err
 	} else {
-		query := sql.FormatRelationshipPartitionUpsert(graphTarget, updates.IdentityProperties)
+		var (
+			query           = sql.FormatRelationshipPartitionUpsert(graphTarget, updates.IdentityProperties, updates.CreateOnlyProperties)
+			queryParameters = parameters.Format(graphTarget)
+		)
+
+		if len(updates.CreateOnlyProperties) > 0 {
+			queryParameters = append(queryParameters, updates.CreateOnlyProperties)
+		}
 
-		if _, err := s.innerTransaction.conn.Exec(s.ctx, query, parameters.Format(graphTarget)...); err != nil {
+		if _, err := s.driver().Exec(s.ctx, query, queryParameters...); err != nil {
 			return err
 		}
 	}
@@ -501,7 +523,7 @@ func (s *batch) flushRelationshipCreateBuffer() error {
 		return err
 	} else if graphTarget, err := s.innerTransaction.getTargetGraph(); err != nil {
 		return err
-	} else if _, err := s.innerTransaction.conn.Exec(s.ctx, createEdgeBatchStatement, graphTarget.ID, createBatch.startIDs, createBatch.endIDs, createBatch.edgeKindIDs, createBatch.edgePropertyBags); err != nil {
+	} else if _, err := s.driver().Exec(s.ctx, createEdgeBatchStatement, graphTarget.ID, createBatch.startIDs, createBatch.endIDs, createBatch.edgeKindIDs, createBatch.edgePropertyBags); err != nil {
 		slog.Info(fmt.Sprintf("Num merged property bags: %d - Num edge keys: %d - StartID batch size: %d", len(batchBuilder.edgePropertiesIndex), len(batchBuilder.keyToEdgeID), len(batchBuilder.relationshipUpdateBatch.startIDs)))
 		return err
 	}
@@ -51,6 +51,17 @@ func IndexName(table string, index graph.Index) string {
 	return stringBuilder.String()
 }
 
+func FullTextIndexName(table string, index graph.FullTextIndex) string {
+	stringBuilder := strings.Builder{}
+
+	stringBuilder.WriteString(table)
+	stringBuilder.WriteString("_")
+	stringBuilder.WriteString(index.Name)
+	stringBuilder.WriteString("_fulltext_index")
+
+	return stringBuilder.String()
+}
+
 func ConstraintName(table string, constraint graph.Constraint) string {
 	stringBuilder := strings.Builder{}
 
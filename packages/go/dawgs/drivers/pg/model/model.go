@@ -21,44 +21,50 @@ import (
 )
 
 type IndexChangeSet struct {
-	NodeIndexesToRemove     []string
-	EdgeIndexesToRemove     []string
-	NodeConstraintsToRemove []string
-	EdgeConstraintsToRemove []string
-	NodeIndexesToAdd        map[string]graph.Index
-	EdgeIndexesToAdd        map[string]graph.Index
-	NodeConstraintsToAdd    map[string]graph.Constraint
-	EdgeConstraintsToAdd    map[string]graph.Constraint
+	NodeIndexesToRemove         []string
+	EdgeIndexesToRemove         []string
+	NodeConstraintsToRemove     []string
+	EdgeConstraintsToRemove     []string
+	NodeIndexesToAdd            map[string]graph.Index
+	EdgeIndexesToAdd            map[string]graph.Index
+	NodeConstraintsToAdd        map[string]graph.Constraint
+	EdgeConstraintsToAdd        map[string]graph.Constraint
+	NodeFullTextIndexesToRemove []string
+	NodeFullTextIndexesToAdd    map[string]graph.FullTextIndex
 }
 
 func NewIndexChangeSet() IndexChangeSet {
 	return IndexChangeSet{
-		NodeIndexesToAdd:     map[string]graph.Index{},
-		NodeConstraintsToAdd: map[string]graph.Constraint{},
-		EdgeIndexesToAdd:     map[string]graph.Index{},
-		EdgeConstraintsToAdd: map[string]graph.Constraint{},
+		NodeIndexesToAdd:         map[string]graph.Index{},
+		NodeConstraintsToAdd:     map[string]graph.Constraint{},
+		EdgeIndexesToAdd:         map[string]graph.Index{},
+		EdgeConstraintsToAdd:     map[string]graph.Constraint{},
+		NodeFullTextIndexesToAdd: map[string]graph.FullTextIndex{},
 	}
 }
 
 type GraphPartition struct {
-	Name        string
-	Indexes     map[string]graph.Index
-	Constraints map[string]graph.Constraint
+	Name            string
+	Indexes         map[string]graph.Index
+	Constraints     map[string]graph.Constraint
+	FullTextIndexes map[string]graph.FullTextIndex
 }
 
 func NewGraphPartition(name string) GraphPartition {
 	return GraphPartition{
-		Name:        name,
-		Indexes:     map[string]graph.Index{},
-		Constraints: map[string]graph.Constraint{},
+		Name:            name,
+		Indexes:         map[string]graph.Index{},
+		Constraints:     map[string]graph.Constraint{},
+		FullTextIndexes: map[string]graph.FullTextIndex{},
 	}
 }
 
 func NewGraphPartitionFromSchema(name string, indexes []graph.Index, constraints []graph.Constraint) GraphPartition {
 	graphPartition := GraphPartition{
-		Name:        name,
-		Indexes:     make(map[string]graph.Index, len(indexes)),
-		Constraints: make(map[string]graph.Constraint, len(constraints)),
+		Name:            name,
+		Indexes:         make(map[string]graph.Index, len(indexes)),
+		Constraints:     make(map[string]graph.Constraint, len(constraints)),
+		FullTextIndexes: map[string]graph.FullTextIndex{},
 	}
 
 	for _, index := range indexes {
@@ -72,6 +78,15 @@ func NewGraphPartitionFromSchema(name string, indexes []graph.Index, constraints
 	return graphPartition
 }
 
+// WithFullTextIndexes adds the given full-text index definitions to the partition.
+func (s GraphPartition) WithFullTextIndexes(fullTextIndexes []graph.FullTextIndex) GraphPartition {
+	for _, fullTextIndex := range fullTextIndexes {
+		s.FullTextIndexes[FullTextIndexName(s.Name, fullTextIndex)] = fullTextIndex
+	}
+
+	return s
+}
+
 type GraphPartitions struct {
 	Node GraphPartition
 	Edge GraphPartition
@@ -32,6 +32,9 @@ import (
 const (
 	DriverName = "pg"
 
+	// dbSystem is the OpenTelemetry db.system value recorded on query spans
+	dbSystem = "postgresql"
+
 	// defaultBatchWriteSize is currently set to 2k. This is meant to strike a balance between the cost of thousands
 	// of round-trips against the cost of locking tables for too long.
 	defaultBatchWriteSize = 2_000
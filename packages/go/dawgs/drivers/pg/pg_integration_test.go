@@ -129,3 +129,35 @@ func TestInt64SequenceIDs(t *testing.T) {
 		_         = graphTestContext.NewRelationship(chuckNode, steveNode, ad.GenericAll)
 	)
 }
+
+func TestSearchText(t *testing.T) {
+	var (
+		// We don't need the reference to the DB but this will ensure that the canonical DB wipe method is called
+		_                = integration.SetupDB(t)
+		graphTestContext = integration.NewGraphTestContext(t, graphschema.DefaultGraphSchema())
+		ctx              = context.Background()
+	)
+
+	if pg.IsPostgreSQLGraph(graphTestContext.Graph.Database) {
+		require.Nil(t, graphTestContext.Graph.Database.WriteTransaction(ctx, func(tx graph.Transaction) error {
+			if _, err := tx.CreateNode(graph.AsProperties(map[string]any{"name": "SVC_SQL@CORP.LOCAL", "email": "sql@corp.local"}), ad.User); err != nil {
+				return err
+			}
+
+			_, err := tx.CreateNode(graph.AsProperties(map[string]any{"name": "SQL ADMINS@CORP.LOCAL"}), ad.Group)
+			return err
+		}))
+
+		results, total, err := graph.SearchText(ctx, graphTestContext.Graph.Database, graph.TextSearch{
+			Index: graphschema.NodeSearchIndex(),
+			Query: "sql corp",
+			Kinds: graph.Kinds{ad.User},
+			Limit: 10,
+		})
+
+		require.Nil(t, err)
+		require.Equal(t, 1, total)
+		require.Len(t, results, 1)
+		require.Equal(t, "SVC_SQL@CORP.LOCAL", results[0].Node.Properties.Get("name").Any())
+	}
+}
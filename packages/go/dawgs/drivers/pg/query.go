@@ -29,6 +29,7 @@ type liveQuery struct {
 	tx           graph.Transaction
 	kindMapper   KindMapper
 	queryBuilder *query.Builder
+	err          error
 }
 
 func newLiveQuery(ctx context.Context, tx graph.Transaction, kindMapper KindMapper) liveQuery {
@@ -41,7 +42,9 @@ func newLiveQuery(ctx context.Context, tx graph.Transaction, kindMapper KindMapp
 }
 
 func (s *liveQuery) runRegularQuery(allShortestPaths bool) graph.Result {
-	if regularQuery, err := s.queryBuilder.Build(allShortestPaths); err != nil {
+	if s.err != nil {
+		return graph.NewErrorResult(s.err)
+	} else if regularQuery, err := s.queryBuilder.Build(allShortestPaths); err != nil {
 		return graph.NewErrorResult(err)
 	} else if translation, err := translate.FromCypher(s.ctx, regularQuery, s.kindMapper, false); err != nil {
 		return graph.NewErrorResult(err)
@@ -21,6 +21,10 @@ import "regexp"
 var (
 	pgPropertyIndexRegex = regexp.MustCompile(`(?i)^create\s+(unique)?(?:\s+)?index\s+([^ ]+)\s+on\s+\S+\s+using\s+([^ ]+)\s+\(+properties\s+->>\s+'([^:]+)::.+$`)
 	pgColumnIndexRegex   = regexp.MustCompile(`(?i)^create\s+(unique)?(?:\s+)?index\s+([^ ]+)\s+on\s+\S+\s+using\s+([^ ]+)\s+\(([^)]+)\)$`)
+
+	// Full-text indexes are tri-gram indexes over the lower-cased concatenation of one or more node properties
+	pgFullTextIndexRegex      = regexp.MustCompile(`(?i)^create\s+index\s+([^ ]+)\s+on\s+\S+\s+using\s+gin\s+\(lower\(.+gin_trgm_ops\)$`)
+	pgFullTextIndexFieldRegex = regexp.MustCompile(`properties\s+->>\s+'([^']+)'`)
 )
 
 const (
@@ -30,6 +34,9 @@ const (
 	pgIndexRegexGroupFields       = 4
 	pgIndexRegexNumExpectedGroups = 5
 
+	pgFullTextIndexRegexGroupName  = 1
+	pgFullTextIndexFieldRegexGroup = 1
+
 	pgIndexTypeBTree   = "btree"
 	pgIndexTypeGIN     = "gin"
 	pgIndexUniqueStr   = "unique"
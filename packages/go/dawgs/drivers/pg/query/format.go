@@ -18,6 +18,7 @@ package query
 
 import (
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -82,6 +83,33 @@ func formatCreatePropertyIndex(indexName, tableName, fieldName string, indexType
 	}
 }
 
+// formatFullTextDocument returns the lower-cased concatenation of the given properties that full-text indexes are built
+// over. Searches must match against the same expression for the planner to consider the index.
+func formatFullTextDocument(propertiesColumn string, fields []string) string {
+	builder := strings.Builder{}
+	builder.WriteString("lower(")
+
+	for idx, field := range fields {
+		if idx > 0 {
+			builder.WriteString(" || ' ' || ")
+		}
+
+		builder.WriteString("coalesce(")
+		builder.WriteString(propertiesColumn)
+		builder.WriteString(" ->> '")
+		builder.WriteString(field)
+		builder.WriteString("', '')")
+	}
+
+	builder.WriteString(")")
+	return builder.String()
+}
+
+func formatCreateFullTextIndex(indexName, tableName string, fields []string) string {
+	return join("create index ", indexName, " on ", tableName, " using ", pgIndexTypeGIN, " (",
+		formatFullTextDocument(pgPropertiesColumn, fields), " gin_trgm_ops);")
+}
+
 func formatCreatePartitionTable(name, parent string, graphID int32) string {
 	builder := strings.Builder{}
 
@@ -118,23 +146,37 @@ func formatConflictMatcher(propertyNames []string, defaultOnConflict string) str
 	return builder.String()
 }
 
-func FormatNodeUpsert(graphTarget model.Graph, identityProperties []string) string {
+// formatUpsertedProperties returns the expression of the properties of an upserted row that already exists. Create-only
+// properties are passed as the text array parameter with the given index and are removed from the upserted properties.
+func formatUpsertedProperties(alias string, createOnlyParameter int, hasCreateOnlyProperties bool) string {
+	if hasCreateOnlyProperties {
+		return join(alias, ".properties || (excluded.properties - $", strconv.Itoa(createOnlyParameter), "::text[])")
+	}
+
+	return join(alias, ".properties || excluded.properties")
+}
+
+// FormatNodeUpsert formats the batch upsert of nodes. If the batch has create-only properties, they are passed as the
+// fourth parameter.
+func FormatNodeUpsert(graphTarget model.Graph, identityProperties, createOnlyProperties []string) string {
 	return join(
 		"insert into ", graphTarget.Partitions.Node.Name, " as n ",
 		"(graph_id, kind_ids, properties) ",
 		"select $1, unnest($2::text[])::int2[], unnest($3::jsonb[]) ",
 		formatConflictMatcher(identityProperties, "id, graph_id"),
-		"do update set properties = n.properties || excluded.properties, kind_ids = uniq(sort(n.kind_ids || excluded.kind_ids)) ",
+		"do update set properties = ", formatUpsertedProperties("n", 4, len(createOnlyProperties) > 0), ", kind_ids = uniq(sort(n.kind_ids || excluded.kind_ids)) ",
 		"returning id;",
 	)
 }
 
-func FormatRelationshipPartitionUpsert(graphTarget model.Graph, identityProperties []string) string {
+// FormatRelationshipPartitionUpsert formats the batch upsert of relationships. If the batch has create-only properties,
+// they are passed as the sixth parameter.
+func FormatRelationshipPartitionUpsert(graphTarget model.Graph, identityProperties, createOnlyProperties []string) string {
 	return join("insert into ", graphTarget.Partitions.Edge.Name, " as e ",
 		"(graph_id, start_id, end_id, kind_id, properties) ",
 		"select $1, unnest($2::int8[]), unnest($3::int8[]), unnest($4::int2[]), unnest($5::jsonb[]) ",
 		formatConflictMatcher(identityProperties, "graph_id, start_id, end_id, kind_id"),
-		"do update set properties = e.properties || excluded.properties;",
+		"do update set properties = ", formatUpsertedProperties("e", 6, len(createOnlyProperties) > 0), ";",
 	)
 }
 
@@ -150,8 +192,9 @@ type NodeUpdate struct {
 // Some assumptions were made here regarding identity kind matching since this data model does not directly require the
 // kind of a node to enforce a constraint
 type NodeUpdateBatch struct {
-	IdentityProperties []string
-	Updates            map[string]*NodeUpdate
+	IdentityProperties   []string
+	CreateOnlyProperties []string
+	Updates              map[string]*NodeUpdate
 }
 
 func NewNodeUpdateBatch() *NodeUpdateBatch {
@@ -180,6 +223,11 @@ func (s *NodeUpdateBatch) Add(update graph.NodeUpdate) (*Future[graph.ID], error
 		}
 	}
 
+	// Create-only properties are removed from the upserted properties of the whole batch
+	if len(s.Updates) > 0 && !sameProperties(s.CreateOnlyProperties, update.CreateOnlyProperties) {
+		return nil, fmt.Errorf("node update mixes create-only properties with pre-existing updates")
+	}
+
 	if key, err := update.Key(); err != nil {
 		return nil, err
 	} else {
@@ -190,6 +238,10 @@ func (s *NodeUpdateBatch) Add(update graph.NodeUpdate) (*Future[graph.ID], error
 			copy(s.IdentityProperties, update.IdentityProperties)
 		}
 
+		if len(s.Updates) == 0 {
+			s.CreateOnlyProperties = slices.Clone(update.CreateOnlyProperties)
+		}
+
 		if existingUpdate, hasExisting := s.Updates[key]; hasExisting {
 			existingUpdate.Node.Merge(update.Node)
 			return existingUpdate.IDFuture, nil
@@ -207,6 +259,21 @@ func (s *NodeUpdateBatch) Add(update graph.NodeUpdate) (*Future[graph.ID], error
 	}
 }
 
+// sameProperties returns true if both slices name the same properties in any order
+func sameProperties(expected, actual []string) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+
+	for _, property := range actual {
+		if !slices.Contains(expected, property) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func ValidateNodeUpdateByBatch(updates []graph.NodeUpdate) (*NodeUpdateBatch, error) {
 	updateBatch := NewNodeUpdateBatch()
 
@@ -236,9 +303,10 @@ type RelationshipUpdate struct {
 }
 
 type RelationshipUpdateBatch struct {
-	NodeUpdates        *NodeUpdateBatch
-	IdentityProperties []string
-	Updates            map[string]*RelationshipUpdate
+	NodeUpdates          *NodeUpdateBatch
+	IdentityProperties   []string
+	CreateOnlyProperties []string
+	Updates              map[string]*RelationshipUpdate
 }
 
 func NewRelationshipUpdateBatch() *RelationshipUpdateBatch {
@@ -268,17 +336,13 @@ func (s *RelationshipUpdateBatch) Add(update graph.RelationshipUpdate) error {
 		}
 	}
 
-	if startNodeID, err := s.NodeUpdates.Add(graph.NodeUpdate{
-		Node:               update.Start,
-		IdentityKind:       update.StartIdentityKind,
-		IdentityProperties: update.StartIdentityProperties,
-	}); err != nil {
+	if len(s.Updates) > 0 && !sameProperties(s.CreateOnlyProperties, update.CreateOnlyProperties) {
+		return fmt.Errorf("relationship update mixes create-only properties with pre-existing updates")
+	}
+
+	if startNodeID, err := s.NodeUpdates.Add(update.StartNodeUpdate()); err != nil {
 		return err
-	} else if endNodeID, err := s.NodeUpdates.Add(graph.NodeUpdate{
-		Node:               update.End,
-		IdentityKind:       update.EndIdentityKind,
-		IdentityProperties: update.EndIdentityProperties,
-	}); err != nil {
+	} else if endNodeID, err := s.NodeUpdates.Add(update.EndNodeUpdate()); err != nil {
 		return err
 	} else if key, err := update.Key(); err != nil {
 		return err
@@ -288,6 +352,10 @@ func (s *RelationshipUpdateBatch) Add(update graph.RelationshipUpdate) error {
 			copy(s.IdentityProperties, update.IdentityProperties)
 		}
 
+		if len(s.Updates) == 0 {
+			s.CreateOnlyProperties = slices.Clone(update.CreateOnlyProperties)
+		}
+
 		if existingUpdate, hasExisting := s.Updates[key]; hasExisting {
 			existingUpdate.Relationship.Merge(update.Relationship)
 		} else {
@@ -317,3 +385,88 @@ func ValidateRelationshipUpdateByBatch(updates []graph.RelationshipUpdate) (*Rel
 
 	return updateBatch, nil
 }
+
+// escapeLikePattern escapes the wildcard and escape characters of a LIKE pattern.
+func escapeLikePattern(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
+
+// NodeTextSearch is a full-text search of the nodes of a graph with search kinds mapped to their IDs.
+type NodeTextSearch struct {
+	Fields          []string
+	Terms           []string
+	KindIDs         []int16
+	ExcludedKindIDs []int16
+	ExemptKindIDs   []int16
+	Skip            int
+	Limit           int
+}
+
+// Parameters returns the named parameters of the statements formatted by FormatNodeTextSearchCount and
+// FormatNodeTextSearch.
+func (s NodeTextSearch) Parameters() map[string]any {
+	parameters := map[string]any{
+		"query":             strings.Join(s.Terms, " "),
+		"kind_ids":          s.KindIDs,
+		"excluded_kind_ids": s.ExcludedKindIDs,
+		"exempt_kind_ids":   s.ExemptKindIDs,
+		"skip":              s.Skip,
+		"limit":             s.Limit,
+	}
+
+	for idx, term := range s.Terms {
+		parameters["term_"+strconv.Itoa(idx)] = "%" + escapeLikePattern(term) + "%"
+	}
+
+	return parameters
+}
+
+func formatNodeTextSearchFilter(search NodeTextSearch) string {
+	var (
+		document = formatFullTextDocument("n."+pgPropertiesColumn, search.Fields)
+		builder  = strings.Builder{}
+	)
+
+	builder.WriteString(" where ")
+
+	for idx := range search.Terms {
+		if idx > 0 {
+			builder.WriteString(" and ")
+		}
+
+		builder.WriteString(document)
+		builder.WriteString(" like @term_")
+		builder.WriteString(strconv.Itoa(idx))
+	}
+
+	if len(search.KindIDs) > 0 {
+		builder.WriteString(" and n.kind_ids operator (pg_catalog.&&) @kind_ids::int2[]")
+	}
+
+	if len(search.ExcludedKindIDs) > 0 {
+		builder.WriteString(" and not (n.kind_ids operator (pg_catalog.&&) @excluded_kind_ids::int2[] and not n.kind_ids operator (pg_catalog.&&) @exempt_kind_ids::int2[])")
+	}
+
+	return builder.String()
+}
+
+// FormatNodeTextSearchCount formats a statement that counts the nodes of the given graph that contain every search
+// term within the searched fields.
+func FormatNodeTextSearchCount(graphTarget model.Graph, search NodeTextSearch) string {
+	return join("select count(*) from ", graphTarget.Partitions.Node.Name, " n", formatNodeTextSearchFilter(search), ";")
+}
+
+// FormatNodeTextSearch formats a statement that selects a page of the nodes of the given graph that contain every
+// search term within the searched fields. Nodes are ranked by the word similarity of the search query to the searched
+// fields.
+func FormatNodeTextSearch(graphTarget model.Graph, search NodeTextSearch) string {
+	statement := join("select (n.id, n.kind_ids, n.properties)::nodecomposite, word_similarity(@query, ",
+		formatFullTextDocument("n."+pgPropertiesColumn, search.Fields), ")::float8 as score from ", graphTarget.Partitions.Node.Name, " n",
+		formatNodeTextSearchFilter(search), " order by score desc, n.id offset @skip")
+
+	if search.Limit > 0 {
+		return join(statement, " limit @limit;")
+	}
+
+	return join(statement, ";")
+}
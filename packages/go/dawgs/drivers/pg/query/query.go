@@ -19,6 +19,7 @@ package query
 import (
 	_ "embed"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/jackc/pgx/v5"
@@ -50,7 +51,21 @@ func (s Query) describeGraphPartition(name string) (model.GraphPartition, error)
 		return graphPartition, err
 	} else {
 		for _, tableIndexDefinition := range tableIndexDefinitions {
-			if captureGroups := pgPropertyIndexRegex.FindStringSubmatch(tableIndexDefinition); captureGroups == nil {
+			if captureGroups := pgFullTextIndexRegex.FindStringSubmatch(tableIndexDefinition); captureGroups != nil {
+				var (
+					indexName = captureGroups[pgFullTextIndexRegexGroupName]
+					fields    []string
+				)
+
+				for _, fieldCaptureGroups := range pgFullTextIndexFieldRegex.FindAllStringSubmatch(tableIndexDefinition, -1) {
+					fields = append(fields, fieldCaptureGroups[pgFullTextIndexFieldRegexGroup])
+				}
+
+				graphPartition.FullTextIndexes[indexName] = graph.FullTextIndex{
+					Name:   indexName,
+					Fields: fields,
+				}
+			} else if captureGroups := pgPropertyIndexRegex.FindStringSubmatch(tableIndexDefinition); captureGroups == nil {
 				// If this index does not match our expected column index format then report it as a potential error
 				if !pgColumnIndexRegex.MatchString(tableIndexDefinition) {
 					return graphPartition, fmt.Errorf("regex mis-match on schema definition: %s", tableIndexDefinition)
@@ -209,6 +224,10 @@ func (s Query) CreatePropertyConstraint(indexName, tableName, fieldName string,
 	return s.exec(formatCreatePropertyConstraint(indexName, tableName, fieldName, indexType), nil)
 }
 
+func (s Query) CreateFullTextIndex(indexName, tableName string, fields []string) error {
+	return s.exec(formatCreateFullTextIndex(indexName, tableName, fields), nil)
+}
+
 func (s Query) DropIndex(indexName string) error {
 	return s.exec(formatDropPropertyIndex(indexName), nil)
 }
@@ -318,6 +337,12 @@ func (s Query) assertGraphPartitionIndexes(partitions model.GraphPartitions, ind
 		}
 	}
 
+	for _, fullTextIndexToRemove := range indexChanges.NodeFullTextIndexesToRemove {
+		if err := s.DropIndex(fullTextIndexToRemove); err != nil {
+			return err
+		}
+	}
+
 	for _, constraintToRemove := range append(indexChanges.NodeConstraintsToRemove, indexChanges.EdgeConstraintsToRemove...) {
 		if err := s.DropConstraint(constraintToRemove); err != nil {
 			return err
@@ -336,6 +361,12 @@ func (s Query) assertGraphPartitionIndexes(partitions model.GraphPartitions, ind
 		}
 	}
 
+	for indexName, fullTextIndex := range indexChanges.NodeFullTextIndexesToAdd {
+		if err := s.CreateFullTextIndex(indexName, partitions.Node.Name, fullTextIndex.Fields); err != nil {
+			return err
+		}
+	}
+
 	for indexName, index := range indexChanges.EdgeIndexesToAdd {
 		if err := s.CreatePropertyIndex(indexName, partitions.Edge.Name, index.Field, index.Type); err != nil {
 			return err
@@ -353,7 +384,7 @@ func (s Query) assertGraphPartitionIndexes(partitions model.GraphPartitions, ind
 
 func (s Query) AssertGraph(schema graph.Graph, definition model.Graph) (model.Graph, error) {
 	var (
-		requiredNodePartition = model.NewGraphPartitionFromSchema(definition.Partitions.Node.Name, schema.NodeIndexes, schema.NodeConstraints)
+		requiredNodePartition = model.NewGraphPartitionFromSchema(definition.Partitions.Node.Name, schema.NodeIndexes, schema.NodeConstraints).WithFullTextIndexes(schema.NodeFullTextIndexes)
 		requiredEdgePartition = model.NewGraphPartitionFromSchema(definition.Partitions.Edge.Name, schema.EdgeIndexes, schema.EdgeConstraints)
 		indexChangeSet        = model.NewIndexChangeSet()
 	)
@@ -390,6 +421,21 @@ func (s Query) AssertGraph(schema graph.Graph, definition model.Graph) (model.Gr
 				indexChangeSet.NodeConstraintsToAdd[requiredNodeConstraintName] = requiredNodeConstraint
 			}
 		}
+
+		for presentNodeFullTextIndexName := range presentNodePartition.FullTextIndexes {
+			if _, hasMatchingDefinition := requiredNodePartition.FullTextIndexes[presentNodeFullTextIndexName]; !hasMatchingDefinition {
+				indexChangeSet.NodeFullTextIndexesToRemove = append(indexChangeSet.NodeFullTextIndexesToRemove, presentNodeFullTextIndexName)
+			}
+		}
+
+		for requiredNodeFullTextIndexName, requiredNodeFullTextIndex := range requiredNodePartition.FullTextIndexes {
+			if presentNodeFullTextIndex, hasMatchingDefinition := presentNodePartition.FullTextIndexes[requiredNodeFullTextIndexName]; !hasMatchingDefinition {
+				indexChangeSet.NodeFullTextIndexesToAdd[requiredNodeFullTextIndexName] = requiredNodeFullTextIndex
+			} else if !slices.Equal(requiredNodeFullTextIndex.Fields, presentNodeFullTextIndex.Fields) {
+				indexChangeSet.NodeFullTextIndexesToRemove = append(indexChangeSet.NodeFullTextIndexesToRemove, requiredNodeFullTextIndexName)
+				indexChangeSet.NodeFullTextIndexesToAdd[requiredNodeFullTextIndexName] = requiredNodeFullTextIndex
+			}
+		}
 	}
 
 	if presentEdgePartition, err := s.describeGraphPartition(definition.Partitions.Edge.Name); err != nil {
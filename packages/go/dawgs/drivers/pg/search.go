@@ -0,0 +1,112 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pg
+
+import (
+	"github.com/specterops/bloodhound/dawgs/drivers/pg/query"
+	"github.com/specterops/bloodhound/dawgs/graph"
+)
+
+// mapSearchKinds maps the given kinds to their IDs. Kinds that are not defined can not be present on any node and are
+// omitted. The returned slice is never nil so that it is never bound as a null array.
+func (s *transaction) mapSearchKinds(kinds graph.Kinds) []int16 {
+	if len(kinds) == 0 {
+		return []int16{}
+	} else if kindIDs, err := s.schemaManager.MapKinds(s.ctx, kinds); err == nil {
+		return kindIDs
+	}
+
+	s.schemaManager.lock.RLock()
+	defer s.schemaManager.lock.RUnlock()
+
+	kindIDs, _ := s.schemaManager.mapKinds(kinds)
+	return kindIDs
+}
+
+// SearchText matches each search term as a case-insensitive substring of the index's fields using the tri-gram
+// full-text index of the target graph's node partition. Nodes are ranked by the word similarity of the search query
+// to the indexed fields.
+func (s *transaction) SearchText(search graph.TextSearch) ([]graph.TextSearchResult, int, error) {
+	var (
+		nodeSearch = query.NodeTextSearch{
+			Fields:          search.Index.Fields,
+			Terms:           search.Terms(),
+			KindIDs:         s.mapSearchKinds(search.Kinds),
+			ExcludedKindIDs: s.mapSearchKinds(search.ExcludedKinds),
+			ExemptKindIDs:   s.mapSearchKinds(search.ExemptKinds),
+			Skip:            search.Skip,
+			Limit:           search.Limit,
+		}
+		total int
+	)
+
+	if len(nodeSearch.Terms) == 0 {
+		return nil, 0, nil
+	} else if len(search.Kinds) > 0 && len(nodeSearch.KindIDs) == 0 {
+		// None of the searched kinds are defined so no node can match
+		return nil, 0, nil
+	}
+
+	targetGraph, err := s.getTargetGraph()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var (
+		parameters  = nodeSearch.Parameters()
+		countResult = s.Raw(query.FormatNodeTextSearchCount(targetGraph, nodeSearch), parameters)
+	)
+
+	defer countResult.Close()
+
+	if !countResult.Next() {
+		return nil, 0, countResult.Error()
+	} else if err := countResult.Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	countResult.Close()
+
+	if total <= search.Skip {
+		return nil, total, nil
+	}
+
+	var (
+		results      []graph.TextSearchResult
+		searchResult = s.Raw(query.FormatNodeTextSearch(targetGraph, nodeSearch), parameters)
+	)
+
+	defer searchResult.Close()
+
+	for searchResult.Next() {
+		var (
+			node  graph.Node
+			score float64
+		)
+
+		if err := searchResult.Scan(&node, &score); err != nil {
+			return nil, 0, err
+		}
+
+		results = append(results, graph.TextSearchResult{
+			Node:  &node,
+			Score: score,
+		})
+	}
+
+	return results, total, searchResult.Error()
+}
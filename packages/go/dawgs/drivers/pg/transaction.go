@@ -30,6 +30,7 @@ import (
 	"github.com/specterops/bloodhound/dawgs/drivers/pg/model"
 	"github.com/specterops/bloodhound/dawgs/graph"
 	"github.com/specterops/bloodhound/dawgs/query"
+	"github.com/specterops/bloodhound/dawgs/tracing"
 	"github.com/specterops/bloodhound/dawgs/util/size"
 )
 
@@ -58,6 +59,33 @@ func (s inspectingDriver) QueryRow(ctx context.Context, sql string, arguments ..
 	return s.upstreamDriver.QueryRow(ctx, sql, arguments...)
 }
 
+// tracingDriver starts a span for each statement sent to PostgreSQL. Query spans end once the statement has been sent
+// and do not cover the time spent reading its rows.
+type tracingDriver struct {
+	upstreamDriver driver
+}
+
+func (s tracingDriver) Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error) {
+	spanCtx, span := tracing.StartQuery(ctx, dbSystem, sql)
+
+	commandTag, err := s.upstreamDriver.Exec(spanCtx, sql, arguments...)
+	return commandTag, tracing.End(span, err)
+}
+
+func (s tracingDriver) Query(ctx context.Context, sql string, arguments ...any) (pgx.Rows, error) {
+	spanCtx, span := tracing.StartQuery(ctx, dbSystem, sql)
+
+	rows, err := s.upstreamDriver.Query(spanCtx, sql, arguments...)
+	return rows, tracing.End(span, err)
+}
+
+func (s tracingDriver) QueryRow(ctx context.Context, sql string, arguments ...any) pgx.Row {
+	spanCtx, span := tracing.StartQuery(ctx, dbSystem, sql)
+	defer span.End()
+
+	return s.upstreamDriver.QueryRow(spanCtx, sql, arguments...)
+}
+
 type transaction struct {
 	schemaManager      *SchemaManager
 	queryExecMode      pgx.QueryExecMode
@@ -93,12 +121,16 @@ func newTransactionWrapper(ctx context.Context, conn *pgxpool.Conn, schemaManage
 func (s *transaction) driver() driver {
 	if s.tx != nil {
 		return inspectingDriver{
-			upstreamDriver: s.tx,
+			upstreamDriver: tracingDriver{
+				upstreamDriver: s.tx,
+			},
 		}
 	}
 
 	return inspectingDriver{
-		upstreamDriver: s.conn,
+		upstreamDriver: tracingDriver{
+			upstreamDriver: s.conn,
+		},
 	}
 }
 
@@ -133,6 +165,53 @@ func (s *transaction) getTargetGraph() (model.Graph, error) {
 	return s.schemaManager.AssertGraph(s, s.targetSchema)
 }
 
+// graphKindMapper scopes query translation to the node and edge partitions of a single graph so that queries can not
+// observe the contents of any other graph.
+type graphKindMapper struct {
+	*SchemaManager
+	graph model.Graph
+}
+
+func (s graphKindMapper) MapTable(table pgsql.Identifier) (pgsql.Identifier, bool) {
+	switch table {
+	case pgsql.TableNode:
+		return pgsql.Identifier(model.NodePartitionTableName(s.graph.ID)), true
+
+	case pgsql.TableEdge:
+		return pgsql.Identifier(model.EdgePartitionTableName(s.graph.ID)), true
+
+	default:
+		return "", false
+	}
+}
+
+func (s *transaction) queryKindMapper() (KindMapper, error) {
+	if !s.targetSchemaSet {
+		if _, hasDefaultGraph := s.schemaManager.DefaultGraph(); !hasDefaultGraph {
+			// Without a graph target queries run against the parent tables and span every graph
+			return s.schemaManager, nil
+		}
+	}
+
+	if graphTarget, err := s.getTargetGraph(); err != nil {
+		return nil, err
+	} else {
+		return graphKindMapper{
+			SchemaManager: s.schemaManager,
+			graph:         graphTarget,
+		}, nil
+	}
+}
+
+func (s *transaction) newLiveQuery() liveQuery {
+	kindMapper, err := s.queryKindMapper()
+
+	liveQuery := newLiveQuery(s.ctx, s, kindMapper)
+	liveQuery.err = err
+
+	return liveQuery
+}
+
 func (s *transaction) CreateNode(properties *graph.Properties, kinds ...graph.Kind) (*graph.Node, error) {
 	if graphTarget, err := s.getTargetGraph(); err != nil {
 		return nil, err
@@ -190,7 +269,7 @@ func (s *transaction) UpdateNode(node *graph.Node) error {
 
 func (s *transaction) Nodes() graph.NodeQuery {
 	return &nodeQuery{
-		liveQuery: newLiveQuery(s.ctx, s, s.schemaManager),
+		liveQuery: s.newLiveQuery(),
 	}
 }
 
@@ -268,7 +347,7 @@ func (s *transaction) UpdateRelationship(relationship *graph.Relationship) error
 
 func (s *transaction) Relationships() graph.RelationshipQuery {
 	return &relationshipQuery{
-		liveQuery: newLiveQuery(s.ctx, s, s.schemaManager),
+		liveQuery: s.newLiveQuery(),
 	}
 }
 
@@ -285,7 +364,9 @@ func (s *transaction) query(query string, parameters map[string]any) (pgx.Rows,
 func (s *transaction) Query(query string, parameters map[string]any) graph.Result {
 	if parsedQuery, err := frontend.ParseCypher(frontend.NewContext(), query); err != nil {
 		return graph.NewErrorResult(err)
-	} else if translated, err := translate.Translate(s.ctx, parsedQuery, s.schemaManager, parameters); err != nil {
+	} else if kindMapper, err := s.queryKindMapper(); err != nil {
+		return graph.NewErrorResult(err)
+	} else if translated, err := translate.Translate(s.ctx, parsedQuery, kindMapper, parameters); err != nil {
 		return graph.NewErrorResult(err)
 	} else if sqlQuery, err := translate.Translated(translated); err != nil {
 		return graph.NewErrorResult(err)
@@ -0,0 +1,128 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dump
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/specterops/bloodhound/dawgs/graph"
+)
+
+type encoder struct {
+	json  *json.Encoder
+	stats Stats
+}
+
+func (s *encoder) write(record Record) error {
+	return s.json.Encode(record)
+}
+
+func (s *encoder) writeNode(node *graph.Node) error {
+	s.stats.Nodes++
+
+	return s.write(Record{
+		Type:       RecordTypeNode,
+		ID:         node.ID.Uint64(),
+		Kinds:      node.Kinds.Strings(),
+		Properties: encodeProperties(node.Properties.MapOrEmpty()),
+	})
+}
+
+func (s *encoder) writeRelationship(relationship *graph.Relationship) error {
+	s.stats.Relationships++
+
+	return s.write(Record{
+		Type:       RecordTypeRelationship,
+		ID:         relationship.ID.Uint64(),
+		StartID:    relationship.StartID.Uint64(),
+		EndID:      relationship.EndID.Uint64(),
+		Kind:       relationship.Kind.String(),
+		Properties: encodeProperties(relationship.Properties.MapOrEmpty()),
+	})
+}
+
+// Dump streams the entire contents of the given database to the writer. Nodes and relationships are read within a
+// single read transaction and written as they are fetched so that memory use does not grow with the size of the graph.
+func Dump(ctx context.Context, db graph.Database, writer io.Writer) (Stats, error) {
+	var (
+		now        = time.Now().UTC()
+		compressor = gzip.NewWriter(writer)
+		output     = &encoder{
+			json: json.NewEncoder(compressor),
+		}
+	)
+
+	if kinds, err := db.FetchKinds(ctx); err != nil {
+		return output.stats, fmt.Errorf("failed fetching kinds: %w", err)
+	} else if err := output.write(Record{
+		Type:      RecordTypeHeader,
+		Version:   FormatVersion,
+		CreatedAt: &now,
+	}); err != nil {
+		return output.stats, err
+	} else if err := output.write(Record{
+		Type:  RecordTypeKinds,
+		Kinds: kinds.Strings(),
+	}); err != nil {
+		return output.stats, err
+	}
+
+	if err := db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		if err := tx.Nodes().Fetch(func(cursor graph.Cursor[*graph.Node]) error {
+			for node := range cursor.Chan() {
+				if err := output.writeNode(node); err != nil {
+					return err
+				}
+			}
+
+			return cursor.Error()
+		}); err != nil {
+			return fmt.Errorf("failed dumping nodes: %w", err)
+		}
+
+		if err := tx.Relationships().Fetch(func(cursor graph.Cursor[*graph.Relationship]) error {
+			for relationship := range cursor.Chan() {
+				if err := output.writeRelationship(relationship); err != nil {
+					return err
+				}
+			}
+
+			return cursor.Error()
+		}); err != nil {
+			return fmt.Errorf("failed dumping relationships: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return output.stats, err
+	}
+
+	if err := output.write(Record{
+		Type:          RecordTypeTrailer,
+		Nodes:         output.stats.Nodes,
+		Relationships: output.stats.Relationships,
+	}); err != nil {
+		return output.stats, err
+	}
+
+	return output.stats, compressor.Close()
+}
@@ -0,0 +1,165 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dump_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/specterops/bloodhound/dawgs"
+	"github.com/specterops/bloodhound/dawgs/drivers/memory"
+	"github.com/specterops/bloodhound/dawgs/dump"
+	"github.com/specterops/bloodhound/dawgs/graph"
+	"github.com/specterops/bloodhound/dawgs/ops"
+	"github.com/specterops/bloodhound/dawgs/query"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	User     = graph.StringKind("User")
+	Computer = graph.StringKind("Computer")
+	AdminTo  = graph.StringKind("AdminTo")
+)
+
+func openDatabase(t *testing.T) graph.Database {
+	db, err := dawgs.Open(context.Background(), memory.DriverName, dawgs.Config{})
+	require.Nil(t, err)
+
+	return db
+}
+
+func TestDumpRestore(t *testing.T) {
+	var (
+		ctx         = context.Background()
+		source      = openDatabase(t)
+		destination = openDatabase(t)
+		lastSeen    = time.Date(2025, 3, 1, 12, 30, 0, 0, time.UTC)
+		buffer      = &bytes.Buffer{}
+	)
+
+	require.Nil(t, source.WriteTransaction(ctx, func(tx graph.Transaction) error {
+		if user, err := tx.CreateNode(graph.AsProperties(map[string]any{"name": "alice", "enabled": true, "lastseen": lastSeen}), User); err != nil {
+			return err
+		} else if computer, err := tx.CreateNode(graph.AsProperties(map[string]any{"name": "ws01", "rank": int64(5), "score": 1.5, "spns": []any{"a", "b"}}), Computer); err != nil {
+			return err
+		} else {
+			_, err := tx.CreateRelationshipByIDs(user.ID, computer.ID, AdminTo, graph.AsProperties(map[string]any{"weight": int64(3)}))
+			return err
+		}
+	}))
+
+	// Occupy the first identifiers of the destination so that restored nodes must be remapped
+	require.Nil(t, destination.WriteTransaction(ctx, func(tx graph.Transaction) error {
+		_, err := tx.CreateNode(graph.AsProperties(map[string]any{"name": "existing"}), Computer)
+		return err
+	}))
+
+	stats, err := dump.Dump(ctx, source, buffer)
+	require.Nil(t, err)
+	require.Equal(t, dump.Stats{Nodes: 2, Relationships: 1}, stats)
+
+	stats, err = dump.Restore(ctx, destination, bytes.NewReader(buffer.Bytes()))
+	require.Nil(t, err)
+	require.Equal(t, dump.Stats{Nodes: 2, Relationships: 1}, stats)
+
+	require.Nil(t, destination.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		if nodes, err := ops.FetchNodes(tx.Nodes().Filter(query.Kind(query.Node(), User))); err != nil {
+			return err
+		} else {
+			require.Len(t, nodes, 1)
+
+			name, _ := nodes[0].Properties.Get("name").String()
+			require.Equal(t, "alice", name)
+
+			restoredLastSeen, err := nodes[0].Properties.Get("lastseen").Time()
+			require.Nil(t, err)
+			require.True(t, lastSeen.Equal(restoredLastSeen))
+		}
+
+		if nodes, err := ops.FetchNodes(tx.Nodes().Filter(query.Equals(query.NodeProperty("name"), "ws01"))); err != nil {
+			return err
+		} else {
+			require.Len(t, nodes, 1)
+			require.Equal(t, int64(5), nodes[0].Properties.Get("rank").Any())
+			require.Equal(t, 1.5, nodes[0].Properties.Get("score").Any())
+		}
+
+		if relationships, err := ops.FetchRelationships(tx.Relationships()); err != nil {
+			return err
+		} else {
+			require.Len(t, relationships, 1)
+			require.Equal(t, AdminTo, relationships[0].Kind)
+			require.Equal(t, int64(3), relationships[0].Properties.Get("weight").Any())
+
+			if start, end, err := ops.FetchRelationshipNodes(tx, relationships[0]); err != nil {
+				return err
+			} else {
+				require.True(t, start.Kinds.ContainsOneOf(User))
+				require.True(t, end.Kinds.ContainsOneOf(Computer))
+			}
+		}
+
+		return nil
+	}))
+}
+
+func TestRestoreTruncated(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		buffer    = &bytes.Buffer{}
+		truncated = &bytes.Buffer{}
+	)
+
+	_, err := dump.Dump(ctx, openDatabase(t), buffer)
+	require.Nil(t, err)
+
+	// Re-compress the dump without its trailer record
+	reader, err := gzip.NewReader(buffer)
+	require.Nil(t, err)
+
+	content := &bytes.Buffer{}
+	_, err = content.ReadFrom(reader)
+	require.Nil(t, err)
+
+	lines := bytes.SplitAfter(bytes.TrimSpace(content.Bytes()), []byte("\n"))
+	writer := gzip.NewWriter(truncated)
+
+	for _, line := range lines[:len(lines)-1] {
+		_, err = writer.Write(line)
+		require.Nil(t, err)
+	}
+
+	require.Nil(t, writer.Close())
+
+	_, err = dump.Restore(ctx, openDatabase(t), truncated)
+	require.ErrorIs(t, err, dump.ErrTruncated)
+}
+
+func TestRestoreUnsupportedVersion(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	writer := gzip.NewWriter(buffer)
+
+	_, err := writer.Write([]byte(`{"type":"header","version":99}` + "\n"))
+	require.Nil(t, err)
+	require.Nil(t, writer.Close())
+
+	_, err = dump.Restore(context.Background(), openDatabase(t), buffer)
+	require.ErrorIs(t, err, dump.ErrUnsupportedVersion)
+}
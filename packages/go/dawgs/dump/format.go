@@ -0,0 +1,174 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package dump implements a driver-neutral, streaming file format for backing up and moving graph contents between
+// dawgs databases. A dump is a gzip compressed stream of newline delimited JSON records: a header, the kinds known to
+// the source database, every node, every relationship and finally a trailer that records how many nodes and
+// relationships were written so that a truncated dump can be detected on restore.
+package dump
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// FormatVersion is the version of the dump format written by Dump. Restore accepts any version up to and including
+// this value.
+const FormatVersion = 1
+
+const (
+	FileExtension = ".ndjson.gz"
+	MediaType     = "application/gzip"
+)
+
+var (
+	// ErrInvalidDump is wrapped by every error caused by malformed or incomplete dump content, as opposed to errors
+	// raised by the database being restored into.
+	ErrInvalidDump = errors.New("invalid dump")
+
+	ErrMissingHeader       = fmt.Errorf("%w: missing header record", ErrInvalidDump)
+	ErrUnsupportedVersion  = fmt.Errorf("%w: unsupported format version", ErrInvalidDump)
+	ErrTruncated           = fmt.Errorf("%w: dump is truncated", ErrInvalidDump)
+	ErrUnexpectedRecord    = fmt.Errorf("%w: unexpected record", ErrInvalidDump)
+	ErrUnknownNodeID       = fmt.Errorf("%w: relationship references a node that is not present in the dump", ErrInvalidDump)
+	ErrRecordCountMismatch = fmt.Errorf("%w: trailer does not match the number of records read", ErrInvalidDump)
+)
+
+type RecordType string
+
+const (
+	RecordTypeHeader       RecordType = "header"
+	RecordTypeKinds        RecordType = "kinds"
+	RecordTypeNode         RecordType = "node"
+	RecordTypeRelationship RecordType = "relationship"
+	RecordTypeTrailer      RecordType = "trailer"
+)
+
+// Record is a single line of a dump. Only the fields relevant to the record's Type are populated.
+type Record struct {
+	Type          RecordType     `json:"type"`
+	Version       int            `json:"version,omitempty"`
+	CreatedAt     *time.Time     `json:"created_at,omitempty"`
+	ID            uint64         `json:"id,omitempty"`
+	StartID       uint64         `json:"start_id,omitempty"`
+	EndID         uint64         `json:"end_id,omitempty"`
+	Kind          string         `json:"kind,omitempty"`
+	Kinds         []string       `json:"kinds,omitempty"`
+	Properties    map[string]any `json:"properties,omitempty"`
+	Nodes         int64          `json:"nodes,omitempty"`
+	Relationships int64          `json:"relationships,omitempty"`
+}
+
+// Stats describes the number of nodes and relationships written to or read from a dump.
+type Stats struct {
+	Nodes         int64 `json:"nodes"`
+	Relationships int64 `json:"relationships"`
+}
+
+// timeValue is satisfied by driver specific temporal types (for example the neo4j dbtype package) that can be
+// represented as a time.Time.
+type timeValue interface {
+	Time() time.Time
+}
+
+// encodeProperties converts driver specific property values into values that survive a JSON round trip.
+func encodeProperties(properties map[string]any) map[string]any {
+	if len(properties) == 0 {
+		return nil
+	}
+
+	encoded := make(map[string]any, len(properties))
+
+	for key, value := range properties {
+		encoded[key] = encodeValue(value)
+	}
+
+	return encoded
+}
+
+func encodeValue(value any) any {
+	switch typedValue := value.(type) {
+	case time.Time:
+		return typedValue.UTC()
+
+	case timeValue:
+		return typedValue.Time().UTC()
+
+	case []any:
+		encoded := make([]any, len(typedValue))
+
+		for idx, element := range typedValue {
+			encoded[idx] = encodeValue(element)
+		}
+
+		return encoded
+
+	case map[string]any:
+		return encodeProperties(typedValue)
+
+	default:
+		return value
+	}
+}
+
+// decodeProperties converts the json.Number values produced by a decoder with UseNumber enabled back into int64
+// values where they are integral and float64 values otherwise.
+func decodeProperties(properties map[string]any) (map[string]any, error) {
+	if properties == nil {
+		return map[string]any{}, nil
+	}
+
+	for key, value := range properties {
+		if decoded, err := decodeValue(value); err != nil {
+			return nil, fmt.Errorf("property %s: %w", key, err)
+		} else {
+			properties[key] = decoded
+		}
+	}
+
+	return properties, nil
+}
+
+func decodeValue(value any) (any, error) {
+	switch typedValue := value.(type) {
+	case json.Number:
+		if intValue, err := strconv.ParseInt(typedValue.String(), 10, 64); err == nil {
+			return intValue, nil
+		}
+
+		return typedValue.Float64()
+
+	case []any:
+		for idx, element := range typedValue {
+			if decoded, err := decodeValue(element); err != nil {
+				return nil, err
+			} else {
+				typedValue[idx] = decoded
+			}
+		}
+
+		return typedValue, nil
+
+	case map[string]any:
+		return decodeProperties(typedValue)
+
+	default:
+		return value, nil
+	}
+}
@@ -0,0 +1,201 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dump
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/specterops/bloodhound/dawgs/graph"
+)
+
+// restoreChunkSize is the number of nodes or relationships committed per transaction during a restore.
+const restoreChunkSize = 2000
+
+type restorer struct {
+	db             graph.Database
+	nodeIDMappings map[graph.ID]graph.ID
+	nodes          []Record
+	relationships  []Record
+	stats          Stats
+}
+
+func (s *restorer) flushNodes(ctx context.Context) error {
+	if len(s.nodes) == 0 {
+		return nil
+	}
+
+	if err := s.db.WriteTransaction(ctx, func(tx graph.Transaction) error {
+		for _, record := range s.nodes {
+			if newNode, err := tx.CreateNode(graph.AsProperties(record.Properties), graph.StringsToKinds(record.Kinds)...); err != nil {
+				return err
+			} else {
+				s.nodeIDMappings[graph.ID(record.ID)] = newNode.ID
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed restoring nodes: %w", err)
+	}
+
+	s.stats.Nodes += int64(len(s.nodes))
+	s.nodes = s.nodes[:0]
+
+	return nil
+}
+
+func (s *restorer) flushRelationships(ctx context.Context) error {
+	if len(s.relationships) == 0 {
+		return nil
+	}
+
+	if err := s.db.BatchOperation(ctx, func(batch graph.Batch) error {
+		for _, record := range s.relationships {
+			if dstStartID, found := s.nodeIDMappings[graph.ID(record.StartID)]; !found {
+				return fmt.Errorf("%w: relationship %d start node %d", ErrUnknownNodeID, record.ID, record.StartID)
+			} else if dstEndID, found := s.nodeIDMappings[graph.ID(record.EndID)]; !found {
+				return fmt.Errorf("%w: relationship %d end node %d", ErrUnknownNodeID, record.ID, record.EndID)
+			} else if err := batch.CreateRelationship(&graph.Relationship{
+				StartID:    dstStartID,
+				EndID:      dstEndID,
+				Kind:       graph.StringKind(record.Kind),
+				Properties: graph.AsProperties(record.Properties),
+			}); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed restoring relationships: %w", err)
+	}
+
+	s.stats.Relationships += int64(len(s.relationships))
+	s.relationships = s.relationships[:0]
+
+	return nil
+}
+
+func (s *restorer) checkTrailer(trailer Record) error {
+	if trailer.Nodes != s.stats.Nodes || trailer.Relationships != s.stats.Relationships {
+		return fmt.Errorf("%w: expected %d nodes and %d relationships but read %d nodes and %d relationships",
+			ErrRecordCountMismatch, trailer.Nodes, trailer.Relationships, s.stats.Nodes, s.stats.Relationships)
+	}
+
+	return nil
+}
+
+// Restore reads a dump produced by Dump and writes its contents into the given database. Node identifiers are
+// assigned by the destination database; relationship endpoints are remapped from the dumped identifiers to the newly
+// created ones. Restore does not clear the destination database first.
+//
+// Nodes and relationships are committed in chunks as they are read. If an error is returned, the destination database
+// may contain a partial restore.
+func Restore(ctx context.Context, db graph.Database, reader io.Reader) (Stats, error) {
+	decompressor, err := gzip.NewReader(reader)
+	if err != nil {
+		return Stats{}, fmt.Errorf("%w: %w", ErrInvalidDump, err)
+	}
+
+	defer decompressor.Close()
+
+	var (
+		decoder = json.NewDecoder(decompressor)
+		state   = &restorer{
+			db:             db,
+			nodeIDMappings: map[graph.ID]graph.ID{},
+		}
+		readHeader = false
+	)
+
+	decoder.UseNumber()
+
+	for {
+		var record Record
+
+		if err := ctx.Err(); err != nil {
+			return state.stats, err
+		} else if err := decoder.Decode(&record); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return state.stats, ErrTruncated
+			}
+
+			return state.stats, fmt.Errorf("%w: failed reading record: %w", ErrInvalidDump, err)
+		} else if properties, err := decodeProperties(record.Properties); err != nil {
+			return state.stats, fmt.Errorf("%w: failed decoding %s %d: %w", ErrInvalidDump, record.Type, record.ID, err)
+		} else {
+			record.Properties = properties
+		}
+
+		if !readHeader && record.Type != RecordTypeHeader {
+			return state.stats, ErrMissingHeader
+		}
+
+		switch record.Type {
+		case RecordTypeHeader:
+			if readHeader {
+				return state.stats, fmt.Errorf("%w: duplicate header", ErrUnexpectedRecord)
+			} else if record.Version < 1 || record.Version > FormatVersion {
+				return state.stats, fmt.Errorf("%w: %d", ErrUnsupportedVersion, record.Version)
+			}
+
+			readHeader = true
+
+		case RecordTypeKinds:
+			// Kinds are recorded for inspection only; drivers assert kinds as nodes and relationships are written
+
+		case RecordTypeNode:
+			if len(state.relationships) > 0 || state.stats.Relationships > 0 {
+				return state.stats, fmt.Errorf("%w: node %d follows relationships", ErrUnexpectedRecord, record.ID)
+			}
+
+			if state.nodes = append(state.nodes, record); len(state.nodes) >= restoreChunkSize {
+				if err := state.flushNodes(ctx); err != nil {
+					return state.stats, err
+				}
+			}
+
+		case RecordTypeRelationship:
+			if err := state.flushNodes(ctx); err != nil {
+				return state.stats, err
+			}
+
+			if state.relationships = append(state.relationships, record); len(state.relationships) >= restoreChunkSize {
+				if err := state.flushRelationships(ctx); err != nil {
+					return state.stats, err
+				}
+			}
+
+		case RecordTypeTrailer:
+			if err := state.flushNodes(ctx); err != nil {
+				return state.stats, err
+			} else if err := state.flushRelationships(ctx); err != nil {
+				return state.stats, err
+			}
+
+			return state.stats, state.checkTrailer(record)
+
+		default:
+			return state.stats, fmt.Errorf("%w: %q", ErrUnexpectedRecord, record.Type)
+		}
+	}
+}
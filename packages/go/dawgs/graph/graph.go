@@ -193,6 +193,9 @@ type NodeUpdate struct {
 	Node               *Node
 	IdentityKind       Kind
 	IdentityProperties []string
+
+	// CreateOnlyProperties names the properties of the node that are only written if the update creates the node
+	CreateOnlyProperties []string
 }
 
 func (s NodeUpdate) Key() (string, error) {
@@ -220,6 +223,10 @@ type RelationshipUpdate struct {
 	End                     *Node
 	EndIdentityKind         Kind
 	EndIdentityProperties   []string
+
+	// CreateOnlyProperties names the properties of the relationship and of its start and end nodes that are only
+	// written if the update creates them
+	CreateOnlyProperties []string
 }
 
 func (s RelationshipUpdate) Key() (string, error) {
@@ -262,6 +269,49 @@ func (s RelationshipUpdate) Key() (string, error) {
 	}
 }
 
+// StartNodeUpdate returns the update of the start node of the relationship update
+func (s RelationshipUpdate) StartNodeUpdate() NodeUpdate {
+	return NodeUpdate{
+		Node:                 s.Start,
+		IdentityKind:         s.StartIdentityKind,
+		IdentityProperties:   s.StartIdentityProperties,
+		CreateOnlyProperties: s.CreateOnlyProperties,
+	}
+}
+
+// EndNodeUpdate returns the update of the end node of the relationship update
+func (s RelationshipUpdate) EndNodeUpdate() NodeUpdate {
+	return NodeUpdate{
+		Node:                 s.End,
+		IdentityKind:         s.EndIdentityKind,
+		IdentityProperties:   s.EndIdentityProperties,
+		CreateOnlyProperties: s.CreateOnlyProperties,
+	}
+}
+
+// SplitCreateOnlyProperties returns the given properties without the given create-only properties along with the
+// create-only properties that are set
+func SplitCreateOnlyProperties(properties *Properties, createOnlyProperties []string) (map[string]any, map[string]any) {
+	var (
+		updated = map[string]any{}
+		created = map[string]any{}
+	)
+
+	if properties == nil {
+		return updated, created
+	}
+
+	for key, value := range properties.Map {
+		if slices.Contains(createOnlyProperties, key) {
+			created[key] = value
+		} else {
+			updated[key] = value
+		}
+	}
+
+	return updated, created
+}
+
 func (s RelationshipUpdate) IdentityPropertiesMap() map[string]any {
 	identityPropertiesMap := make(map[string]any, len(s.IdentityProperties))
 
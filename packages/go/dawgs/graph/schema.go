@@ -45,14 +45,21 @@ type Index struct {
 
 type Constraint Index
 
+// FullTextIndex is a relevance ranked text search index that spans one or more node properties.
+type FullTextIndex struct {
+	Name   string
+	Fields []string
+}
+
 type Graph struct {
-	Name            string
-	Nodes           Kinds
-	Edges           Kinds
-	NodeConstraints []Constraint
-	EdgeConstraints []Constraint
-	NodeIndexes     []Index
-	EdgeIndexes     []Index
+	Name                string
+	Nodes               Kinds
+	Edges               Kinds
+	NodeConstraints     []Constraint
+	EdgeConstraints     []Constraint
+	NodeIndexes         []Index
+	EdgeIndexes         []Index
+	NodeFullTextIndexes []FullTextIndex
 }
 
 type Schema struct {
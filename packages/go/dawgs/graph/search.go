@@ -0,0 +1,94 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package graph
+
+import (
+	"context"
+	"strings"
+)
+
+// TextSearch describes a relevance ranked search of a full-text index.
+type TextSearch struct {
+	// Index is the full-text index to search. Drivers use its name or its fields depending on how they implement the
+	// index.
+	Index FullTextIndex
+
+	// Query is the free-form search text. Each whitespace separated term in the query must match at least one of the
+	// index's fields for a node to be returned.
+	Query string
+
+	// Kinds, if set, restricts results to nodes that have at least one of the given kinds.
+	Kinds Kinds
+
+	// ExcludedKinds, if set, omits nodes that have at least one of the given kinds unless they also have at least one
+	// of the ExemptKinds.
+	ExcludedKinds Kinds
+	ExemptKinds   Kinds
+
+	Skip  int
+	Limit int
+}
+
+// Terms returns the lower-cased search terms of the query.
+func (s TextSearch) Terms() []string {
+	return strings.Fields(strings.ToLower(s.Query))
+}
+
+// Matches returns true if the given node kinds satisfy the kind filters of the search.
+func (s TextSearch) Matches(kinds Kinds) bool {
+	if len(s.Kinds) > 0 && !kinds.ContainsOneOf(s.Kinds...) {
+		return false
+	}
+
+	return !kinds.ContainsOneOf(s.ExcludedKinds...) || kinds.ContainsOneOf(s.ExemptKinds...)
+}
+
+// TextSearchResult is a node matched by a TextSearch along with its relevance score. Scores are driver specific and
+// are only comparable to other scores from the same search.
+type TextSearchResult struct {
+	Node  *Node
+	Score float64
+}
+
+// TextSearcher is implemented by transactions that support searching full-text indexes. Results are ordered by
+// descending score and paginated using the search's skip and limit. The total number of matching nodes is returned
+// alongside the page of results.
+type TextSearcher interface {
+	SearchText(search TextSearch) ([]TextSearchResult, int, error)
+}
+
+// SearchText runs the given search in a read transaction. If the database's transactions do not implement
+// TextSearcher then ErrUnsupportedDatabaseOperation is returned.
+func SearchText(ctx context.Context, db Database, search TextSearch) ([]TextSearchResult, int, error) {
+	var (
+		results []TextSearchResult
+		total   int
+	)
+
+	err := db.ReadTransaction(ctx, func(tx Transaction) error {
+		if searcher, isSearcher := tx.(TextSearcher); !isSearcher {
+			return ErrUnsupportedDatabaseOperation
+		} else {
+			var err error
+
+			results, total, err = searcher.SearchText(search)
+			return err
+		}
+	})
+
+	return results, total, err
+}
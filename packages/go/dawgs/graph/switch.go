@@ -20,12 +20,48 @@ import (
 	"context"
 	"errors"
 	"sync"
+
+	"github.com/specterops/bloodhound/dawgs/tracing"
 )
 
 var (
 	ErrAuthoritativeDatabaseSwitching = errors.New("switching authoritative database")
 )
 
+type graphTargetKey struct{}
+
+// WithGraphTarget returns a copy of the given context that directs transactions and batch operations opened through a
+// DatabaseSwitch at the given graph instead of the driver's default graph.
+func WithGraphTarget(ctx context.Context, target Graph) context.Context {
+	return context.WithValue(ctx, graphTargetKey{}, target)
+}
+
+// GraphTargetFromContext returns the graph target set by WithGraphTarget, if any.
+func GraphTargetFromContext(ctx context.Context) (Graph, bool) {
+	target, hasTarget := ctx.Value(graphTargetKey{}).(Graph)
+	return target, hasTarget
+}
+
+func targetTransactionDelegate(ctx context.Context, txDelegate TransactionDelegate) TransactionDelegate {
+	if target, hasTarget := GraphTargetFromContext(ctx); hasTarget {
+		return func(tx Transaction) error {
+			return txDelegate(tx.WithGraph(target))
+		}
+	}
+
+	return txDelegate
+}
+
+func targetBatchDelegate(ctx context.Context, batchDelegate BatchDelegate) BatchDelegate {
+	if target, hasTarget := GraphTargetFromContext(ctx); hasTarget {
+		return func(batch Batch) error {
+			return batchDelegate(batch.WithGraph(target))
+		}
+	}
+
+	return batchDelegate
+}
+
 func IsDriver[T any](db Database) bool {
 	switch typedDB := db.(type) {
 	case *DatabaseSwitch:
@@ -47,6 +83,19 @@ func AsDriver[T any](db Database) (T, bool) {
 	return driver, matchesDriverType
 }
 
+// TransactionOperation names the kind of operation reported to a TransactionObserver.
+type TransactionOperation string
+
+const (
+	TransactionOperationRead  TransactionOperation = "read"
+	TransactionOperationWrite TransactionOperation = "write"
+	TransactionOperationBatch TransactionOperation = "batch"
+)
+
+// TransactionObserver is notified of each transaction and batch operation that completes through a DatabaseSwitch. The
+// given database is the driver that ran the operation and err is the operation's result.
+type TransactionObserver func(db Database, operation TransactionOperation, err error)
+
 type DatabaseSwitch struct {
 	activeContexts map[any]func()
 	currentDB      Database
@@ -55,6 +104,7 @@ type DatabaseSwitch struct {
 	currentDBLock  *sync.RWMutex
 	writeFlushSize int
 	batchWriteSize int
+	observer       TransactionObserver
 }
 
 func NewDatabaseSwitch(ctx context.Context, initialDB Database) *DatabaseSwitch {
@@ -89,6 +139,20 @@ func (s *DatabaseSwitch) Switch(db Database) {
 	s.currentDB = db
 }
 
+// SetTransactionObserver sets the observer notified of completed transactions and batch operations. The observer must
+// be set before the switch is shared.
+func (s *DatabaseSwitch) SetTransactionObserver(observer TransactionObserver) {
+	s.observer = observer
+}
+
+func (s *DatabaseSwitch) observe(operation TransactionOperation, err error) error {
+	if s.observer != nil {
+		s.observer(s.currentDB, operation, err)
+	}
+
+	return err
+}
+
 func (s *DatabaseSwitch) SetWriteFlushSize(interval int) {
 	s.writeFlushSize = interval
 }
@@ -132,41 +196,47 @@ func (s *DatabaseSwitch) retireInternalContext(ctx context.Context) {
 }
 
 func (s *DatabaseSwitch) ReadTransaction(ctx context.Context, txDelegate TransactionDelegate, options ...TransactionOption) error {
+	ctx, span := tracing.StartTransaction(ctx, string(TransactionOperationRead))
+
 	if internalCtx, err := s.newInternalContext(ctx); err != nil {
-		return err
+		return tracing.End(span, err)
 	} else {
 		defer s.retireInternalContext(internalCtx)
 
 		s.currentDBLock.RLock()
 		defer s.currentDBLock.RUnlock()
 
-		return s.currentDB.ReadTransaction(internalCtx, txDelegate, options...)
+		return tracing.End(span, s.observe(TransactionOperationRead, s.currentDB.ReadTransaction(internalCtx, targetTransactionDelegate(ctx, txDelegate), options...)))
 	}
 }
 
 func (s *DatabaseSwitch) WriteTransaction(ctx context.Context, txDelegate TransactionDelegate, options ...TransactionOption) error {
+	ctx, span := tracing.StartTransaction(ctx, string(TransactionOperationWrite))
+
 	if internalCtx, err := s.newInternalContext(ctx); err != nil {
-		return err
+		return tracing.End(span, err)
 	} else {
 		defer s.retireInternalContext(internalCtx)
 
 		s.currentDBLock.RLock()
 		defer s.currentDBLock.RUnlock()
 
-		return s.currentDB.WriteTransaction(internalCtx, txDelegate, options...)
+		return tracing.End(span, s.observe(TransactionOperationWrite, s.currentDB.WriteTransaction(internalCtx, targetTransactionDelegate(ctx, txDelegate), options...)))
 	}
 }
 
 func (s *DatabaseSwitch) BatchOperation(ctx context.Context, batchDelegate BatchDelegate) error {
+	ctx, span := tracing.StartTransaction(ctx, string(TransactionOperationBatch))
+
 	if internalCtx, err := s.newInternalContext(ctx); err != nil {
-		return err
+		return tracing.End(span, err)
 	} else {
 		defer s.retireInternalContext(internalCtx)
 
 		s.currentDBLock.RLock()
 		defer s.currentDBLock.RUnlock()
 
-		return s.currentDB.BatchOperation(internalCtx, batchDelegate)
+		return tracing.End(span, s.observe(TransactionOperationBatch, s.currentDB.BatchOperation(internalCtx, targetBatchDelegate(ctx, batchDelegate))))
 	}
 }
 
@@ -447,6 +447,25 @@ func FetchLargestNodeID(ctx context.Context, db graph.Database) (graph.ID, error
 	return largestNodeID, err
 }
 
+// FetchLargestRelationshipID will fetch the current relationship database identifier ceiling.
+func FetchLargestRelationshipID(ctx context.Context, db graph.Database) (graph.ID, error) {
+	var (
+		largestRelationshipID graph.ID
+
+		err = db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+			if relationship, err := tx.Relationships().OrderBy(query.Order(query.RelationshipID(), query.Descending())).Limit(1).First(); err != nil {
+				return err
+			} else {
+				largestRelationshipID = relationship.ID
+			}
+
+			return nil
+		})
+	)
+
+	return largestRelationshipID, err
+}
+
 func parallelFetchNodes(ctx context.Context, db graph.Database, maxID graph.ID, criteria graph.Criteria, numWorkers int) (graph.NodeSet, error) {
 	const stride = 20_000
 
@@ -0,0 +1,72 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package tracing holds the OpenTelemetry instrumentation shared by the graph database switch and the dawgs drivers.
+// Spans are started from the global tracer provider and are no-ops until the application installs one.
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	InstrumentationName = "github.com/specterops/bloodhound/dawgs"
+
+	// AttributeDBSystem and AttributeDBQueryText follow the OpenTelemetry database semantic conventions
+	AttributeDBSystem    = attribute.Key("db.system")
+	AttributeDBQueryText = attribute.Key("db.query.text")
+
+	AttributeTransactionOperation = attribute.Key("dawgs.transaction.operation")
+)
+
+func tracer() trace.Tracer {
+	return otel.Tracer(InstrumentationName)
+}
+
+// StartTransaction starts a span for a graph transaction or batch operation.
+func StartTransaction(ctx context.Context, operation string) (context.Context, trace.Span) {
+	return tracer().Start(ctx, "dawgs."+operation, trace.WithAttributes(AttributeTransactionOperation.String(operation)))
+}
+
+// StartQuery starts a client span for a single statement sent to the named database system. Transactions created
+// without a context start their query spans as new traces.
+func StartQuery(ctx context.Context, system, statement string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	return tracer().Start(ctx, system+".query",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(AttributeDBSystem.String(system), AttributeDBQueryText.String(statement)),
+		trace.WithAttributes(attributes...),
+	)
+}
+
+// End records the given error, if any, on the span and ends it. The error is returned unchanged.
+func End(span trace.Span, err error) error {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+	return err
+}
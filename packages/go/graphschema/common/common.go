@@ -29,16 +29,17 @@ import (
 
 var (
 	MigrationData = graph.StringKind("MigrationData")
+	GenericEntity = graph.StringKind("GenericEntity")
 )
 
 func Nodes() []graph.Kind {
-	return []graph.Kind{MigrationData}
+	return []graph.Kind{MigrationData, GenericEntity}
 }
 func Relationships() []graph.Kind {
 	return []graph.Kind{}
 }
 func NodeKinds() []graph.Kind {
-	return []graph.Kind{MigrationData}
+	return []graph.Kind{MigrationData, GenericEntity}
 }
 func InboundRelationshipKinds() []graph.Kind {
 	return []graph.Kind{ad.Owns, ad.GenericAll, ad.GenericWrite, ad.WriteOwner, ad.WriteDACL, ad.MemberOf, ad.ForceChangePassword, ad.AllExtendedRights, ad.AddMember, ad.HasSession, ad.GPLink, ad.AllowedToDelegate, ad.CoerceToTGT, ad.AllowedToAct, ad.AdminTo, ad.CanPSRemote, ad.CanRDP, ad.ExecuteDCOM, ad.HasSIDHistory, ad.AddSelf, ad.DCSync, ad.ReadLAPSPassword, ad.ReadGMSAPassword, ad.DumpSMSAPassword, ad.SQLAdmin, ad.AddAllowedToAct, ad.WriteSPN, ad.AddKeyCredentialLink, ad.SyncLAPSPassword, ad.WriteAccountRestrictions, ad.WriteGPLink, ad.GoldenCert, ad.ADCSESC1, ad.ADCSESC3, ad.ADCSESC4, ad.ADCSESC6a, ad.ADCSESC6b, ad.ADCSESC9a, ad.ADCSESC9b, ad.ADCSESC10a, ad.ADCSESC10b, ad.ADCSESC13, ad.SyncedToEntraUser, ad.CoerceAndRelayNTLMToSMB, ad.CoerceAndRelayNTLMToADCS, ad.WriteOwnerLimitedRights, ad.OwnsLimitedRights, ad.CoerceAndRelayNTLMToLDAP, ad.CoerceAndRelayNTLMToLDAPS, ad.Contains, azure.AvereContributor, azure.Contributor, azure.GetCertificates, azure.GetKeys, azure.GetSecrets, azure.HasRole, azure.MemberOf, azure.Owner, azure.RunsAs, azure.VMContributor, azure.AutomationContributor, azure.KeyVaultContributor, azure.VMAdminLogin, azure.AddMembers, azure.AddSecret, azure.ExecuteCommand, azure.GlobalAdmin, azure.PrivilegedAuthAdmin, azure.Grant, azure.GrantSelf, azure.PrivilegedRoleAdmin, azure.ResetPassword, azure.UserAccessAdministrator, azure.Owns, azure.CloudAppAdmin, azure.AppAdmin, azure.AddOwner, azure.ManagedIdentity, azure.AKSContributor, azure.NodeResourceGroup, azure.WebsiteContributor, azure.LogicAppContributor, azure.AZMGAddMember, azure.AZMGAddOwner, azure.AZMGAddSecret, azure.AZMGGrantAppRoles, azure.AZMGGrantRole, azure.SyncedToADUser}
@@ -50,27 +51,32 @@ func OutboundRelationshipKinds() []graph.Kind {
 type Property string
 
 const (
-	ObjectID        Property = "objectid"
-	Name            Property = "name"
-	DisplayName     Property = "displayname"
-	Description     Property = "description"
-	OwnerObjectID   Property = "owner_objectid"
-	Collected       Property = "collected"
-	OperatingSystem Property = "operatingsystem"
-	SystemTags      Property = "system_tags"
-	UserTags        Property = "user_tags"
-	LastSeen        Property = "lastseen"
-	WhenCreated     Property = "whencreated"
-	Enabled         Property = "enabled"
-	PasswordLastSet Property = "pwdlastset"
-	Title           Property = "title"
-	Email           Property = "email"
-	IsInherited     Property = "isinherited"
-	CompositionID   Property = "compositionid"
+	ObjectID            Property = "objectid"
+	Name                Property = "name"
+	DisplayName         Property = "displayname"
+	Description         Property = "description"
+	OwnerObjectID       Property = "owner_objectid"
+	Collected           Property = "collected"
+	OperatingSystem     Property = "operatingsystem"
+	SystemTags          Property = "system_tags"
+	UserTags            Property = "user_tags"
+	LastSeen            Property = "lastseen"
+	Stale               Property = "stale"
+	WhenCreated         Property = "whencreated"
+	Enabled             Property = "enabled"
+	PasswordLastSet     Property = "pwdlastset"
+	Title               Property = "title"
+	Email               Property = "email"
+	IsInherited         Property = "isinherited"
+	CompositionID       Property = "compositionid"
+	FirstIngestJob      Property = "firstingestjob"
+	LastIngestJob       Property = "lastingestjob"
+	IngestSourceType    Property = "ingestsourcetype"
+	IngestSourceVersion Property = "ingestsourceversion"
 )
 
 func AllProperties() []Property {
-	return []Property{ObjectID, Name, DisplayName, Description, OwnerObjectID, Collected, OperatingSystem, SystemTags, UserTags, LastSeen, WhenCreated, Enabled, PasswordLastSet, Title, Email, IsInherited, CompositionID}
+	return []Property{ObjectID, Name, DisplayName, Description, OwnerObjectID, Collected, OperatingSystem, SystemTags, UserTags, LastSeen, Stale, WhenCreated, Enabled, PasswordLastSet, Title, Email, IsInherited, CompositionID, FirstIngestJob, LastIngestJob, IngestSourceType, IngestSourceVersion}
 }
 func ParseProperty(source string) (Property, error) {
 	switch source {
@@ -94,6 +100,8 @@ func ParseProperty(source string) (Property, error) {
 		return UserTags, nil
 	case "lastseen":
 		return LastSeen, nil
+	case "stale":
+		return Stale, nil
 	case "whencreated":
 		return WhenCreated, nil
 	case "enabled":
@@ -108,6 +116,14 @@ func ParseProperty(source string) (Property, error) {
 		return IsInherited, nil
 	case "compositionid":
 		return CompositionID, nil
+	case "firstingestjob":
+		return FirstIngestJob, nil
+	case "lastingestjob":
+		return LastIngestJob, nil
+	case "ingestsourcetype":
+		return IngestSourceType, nil
+	case "ingestsourceversion":
+		return IngestSourceVersion, nil
 	default:
 		return "", errors.New("Invalid enumeration value: " + source)
 	}
@@ -134,6 +150,8 @@ func (s Property) String() string {
 		return string(UserTags)
 	case LastSeen:
 		return string(LastSeen)
+	case Stale:
+		return string(Stale)
 	case WhenCreated:
 		return string(WhenCreated)
 	case Enabled:
@@ -148,6 +166,14 @@ func (s Property) String() string {
 		return string(IsInherited)
 	case CompositionID:
 		return string(CompositionID)
+	case FirstIngestJob:
+		return string(FirstIngestJob)
+	case LastIngestJob:
+		return string(LastIngestJob)
+	case IngestSourceType:
+		return string(IngestSourceType)
+	case IngestSourceVersion:
+		return string(IngestSourceVersion)
 	default:
 		return "Invalid enumeration case: " + string(s)
 	}
@@ -174,6 +200,8 @@ func (s Property) Name() string {
 		return "Node User Tags"
 	case LastSeen:
 		return "Last Collected by BloodHound"
+	case Stale:
+		return "Not Seen Within Retention Period"
 	case WhenCreated:
 		return "Created"
 	case Enabled:
@@ -188,6 +216,14 @@ func (s Property) Name() string {
 		return "Is Inherited"
 	case CompositionID:
 		return "Composition ID"
+	case FirstIngestJob:
+		return "First Ingest Job"
+	case LastIngestJob:
+		return "Last Ingest Job"
+	case IngestSourceType:
+		return "Ingest Source Type"
+	case IngestSourceVersion:
+		return "Ingest Source Version"
 	default:
 		return "Invalid enumeration case: " + string(s)
 	}
@@ -37,6 +37,32 @@ func AzureGraphName(suffix string) string {
 	return AzureGraphPrefix + "_" + suffix
 }
 
+const (
+	NodeSearchIndexName = "node_search"
+
+	// servicePrincipalNames is the AD service principal name property as submitted by collectors
+	servicePrincipalNames = "serviceprincipalnames"
+)
+
+// NodeSearchIndex returns the full-text index that backs node search.
+func NodeSearchIndex() graph.FullTextIndex {
+	return graph.FullTextIndex{
+		Name: NodeSearchIndexName,
+		Fields: []string{
+			common.Name.String(),
+			common.ObjectID.String(),
+			common.DisplayName.String(),
+			common.Description.String(),
+			common.Email.String(),
+			ad.SamAccountName.String(),
+			ad.DistinguishedName.String(),
+			azure.UserPrincipalName.String(),
+			azure.ServicePrincipalNames.String(),
+			servicePrincipalNames,
+		},
+	}
+}
+
 func CombinedGraphSchema(name string) graph.Graph {
 	return graph.Graph{
 		Name:  name,
@@ -68,6 +94,9 @@ func CombinedGraphSchema(name string) graph.Graph {
 				Type:  graph.BTreeIndex,
 			},
 		},
+		NodeFullTextIndexes: []graph.FullTextIndex{
+			NodeSearchIndex(),
+		},
 	}
 }
 
@@ -98,6 +127,9 @@ func AzureGraphSchema(name string) graph.Graph {
 				Type:  graph.BTreeIndex,
 			},
 		},
+		NodeFullTextIndexes: []graph.FullTextIndex{
+			NodeSearchIndex(),
+		},
 	}
 }
 
@@ -140,6 +172,9 @@ func ActiveDirectoryGraphSchema(name string) graph.Graph {
 				Type:  graph.BTreeIndex,
 			},
 		},
+		NodeFullTextIndexes: []graph.FullTextIndex{
+			NodeSearchIndex(),
+		},
 	}
 }
 
@@ -26,7 +26,10 @@ func (s Header) String() string {
 
 // Non-standard headers
 const (
-	RequestDate Header = "RequestDate"
-	RequestID   Header = "RequestID"
-	Signature   Header = "Signature" // https://www.ietf.org/archive/id/draft-ietf-httpbis-message-signatures-04.html#name-the-signature-http-header
+	RequestDate    Header = "RequestDate"
+	RequestID      Header = "RequestID"
+	Signature      Header = "Signature"       // https://www.ietf.org/archive/id/draft-ietf-httpbis-message-signatures-04.html#name-the-signature-http-header
+	UploadChecksum Header = "Upload-Checksum" // https://tus.io/protocols/resumable-upload#checksum
+	UploadOffset   Header = "Upload-Offset"   // https://tus.io/protocols/resumable-upload#upload-offset
+	Workspace      Header = "Workspace"
 )
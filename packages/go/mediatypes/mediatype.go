@@ -20,6 +20,12 @@ import "fmt"
 
 //go:generate go run cmd/generate.go
 
+// Media types in common use that are not registered with IANA and are therefore absent from the generated constants
+const (
+	ApplicationGexfXml    MediaType = "application/gexf+xml"
+	ApplicationGraphmlXml MediaType = "application/graphml+xml"
+)
+
 type MediaType string
 
 func (s MediaType) String() string {
@@ -0,0 +1,360 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// NoContentResponse is the name of the response component for routes that respond without content.
+const NoContentResponse = "no-content"
+
+// Document is the subset of an OpenAPI 3.0 document that is used to generate API clients and to validate API
+// responses against the spec.
+type Document struct {
+	OpenAPI    string               `json:"openapi"`
+	Paths      map[string]*PathItem `json:"paths"`
+	Components Components           `json:"components"`
+}
+
+type Components struct {
+	Schemas       map[string]*Schema      `json:"schemas"`
+	Parameters    map[string]*Parameter   `json:"parameters"`
+	Responses     map[string]*Response    `json:"responses"`
+	RequestBodies map[string]*RequestBody `json:"requestBodies"`
+}
+
+type PathItem struct {
+	Parameters []*Parameter `json:"parameters"`
+	Get        *Operation   `json:"get"`
+	Put        *Operation   `json:"put"`
+	Post       *Operation   `json:"post"`
+	Delete     *Operation   `json:"delete"`
+	Patch      *Operation   `json:"patch"`
+}
+
+type Operation struct {
+	OperationID string               `json:"operationId"`
+	Summary     string               `json:"summary"`
+	Description string               `json:"description"`
+	Deprecated  bool                 `json:"deprecated"`
+	Tags        []string             `json:"tags"`
+	Parameters  []*Parameter         `json:"parameters"`
+	RequestBody *RequestBody         `json:"requestBody"`
+	Responses   map[string]*Response `json:"responses"`
+}
+
+type Parameter struct {
+	Ref         string  `json:"$ref"`
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description"`
+	Required    bool    `json:"required"`
+	Deprecated  bool    `json:"deprecated"`
+	Schema      *Schema `json:"schema"`
+}
+
+type RequestBody struct {
+	Ref         string                `json:"$ref"`
+	Description string                `json:"description"`
+	Required    bool                  `json:"required"`
+	Content     map[string]*MediaType `json:"content"`
+}
+
+type Response struct {
+	Ref         string                `json:"$ref"`
+	Description string                `json:"description"`
+	Content     map[string]*MediaType `json:"content"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+type Schema struct {
+	Ref                  string                `json:"$ref"`
+	Type                 string                `json:"type"`
+	Format               string                `json:"format"`
+	Title                string                `json:"title"`
+	Description          string                `json:"description"`
+	Properties           map[string]*Schema    `json:"properties"`
+	AdditionalProperties *AdditionalProperties `json:"additionalProperties"`
+	Items                *Schema               `json:"items"`
+	AllOf                []*Schema             `json:"allOf"`
+	OneOf                []*Schema             `json:"oneOf"`
+	AnyOf                []*Schema             `json:"anyOf"`
+	Enum                 []any                 `json:"enum"`
+	Required             []string              `json:"required"`
+	Nullable             bool                  `json:"nullable"`
+	ReadOnly             bool                  `json:"readOnly"`
+	WriteOnly            bool                  `json:"writeOnly"`
+	Deprecated           bool                  `json:"deprecated"`
+}
+
+// AdditionalProperties is either a boolean or a schema that all additional property values must match.
+type AdditionalProperties struct {
+	Allowed bool
+	Schema  *Schema
+}
+
+func (s *AdditionalProperties) UnmarshalJSON(content []byte) error {
+	if err := json.Unmarshal(content, &s.Allowed); err == nil {
+		return nil
+	}
+
+	s.Allowed = true
+	return json.Unmarshal(content, &s.Schema)
+}
+
+// NullableValue returns the value schema of a schema that describes one of the nullable database types. These types
+// are described as an object with a value property and a valid property. Depending on the implementation they are
+// marshalled either as that object, with capitalized property names, or as their value or null.
+func (s *Schema) NullableValue() (*Schema, bool) {
+	if s.Type != "object" || len(s.Properties) != 2 {
+		return nil, false
+	} else if valid, hasValid := s.Properties["valid"]; !hasValid || valid.Type != "boolean" {
+		return nil, false
+	}
+
+	for name, property := range s.Properties {
+		if name != "valid" {
+			return property, true
+		}
+	}
+
+	return nil, false
+}
+
+// Route is an operation of the document along with the path and method it is served at. The parameters of a route are
+// the resolved path item parameters followed by the resolved operation parameters.
+type Route struct {
+	Method     string
+	Path       string
+	Parameters []*Parameter
+	Operation  *Operation
+}
+
+// PathParameters returns the route's path parameters in the order they appear in the path.
+func (s Route) PathParameters() []*Parameter {
+	var parameters []*Parameter
+
+	for _, segment := range strings.Split(s.Path, "/") {
+		if name, isParameter := pathParameterName(segment); isParameter {
+			for _, parameter := range s.Parameters {
+				if parameter.In == "path" && parameter.Name == name {
+					parameters = append(parameters, parameter)
+					break
+				}
+			}
+		}
+	}
+
+	return parameters
+}
+
+// SuccessResponse returns the lowest documented 2XX status code of the route and its resolved response.
+func (s Route) SuccessResponse(document *Document) (int, *Response, bool) {
+	var codes []int
+
+	for code := range s.Operation.Responses {
+		var statusCode int
+
+		if _, err := fmt.Sscanf(code, "%d", &statusCode); err == nil && statusCode >= 200 && statusCode < 300 {
+			codes = append(codes, statusCode)
+		}
+	}
+
+	if len(codes) == 0 {
+		return 0, nil, false
+	}
+
+	sort.Ints(codes)
+	return codes[0], document.ResolveResponse(s.Operation.Responses[fmt.Sprint(codes[0])]), true
+}
+
+// IsNoContent returns true if the given response references the no-content response component. The component documents
+// a placeholder text body for routes that respond without content.
+func IsNoContent(response *Response) bool {
+	return response != nil && response.Ref != "" && componentName(response.Ref) == NoContentResponse
+}
+
+func pathParameterName(segment string) (string, bool) {
+	if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+		return segment[1 : len(segment)-1], true
+	}
+
+	return "", false
+}
+
+var (
+	parseSpecOnce = sync.OnceValues(func() (*Document, error) {
+		return ParseDocument(openApiJson)
+	})
+)
+
+// Spec returns the parsed BloodHound OpenAPI document.
+func Spec() (*Document, error) {
+	return parseSpecOnce()
+}
+
+func ParseDocument(content []byte) (*Document, error) {
+	var document Document
+
+	if err := json.Unmarshal(content, &document); err != nil {
+		return nil, fmt.Errorf("failed parsing OpenAPI document: %w", err)
+	}
+
+	return &document, nil
+}
+
+// componentName returns the name of the component that the given reference points to.
+func componentName(ref string) string {
+	return ref[strings.LastIndex(ref, "/")+1:]
+}
+
+// ResolveSchema follows the given schema's references and returns the referenced schema along with the name of the
+// last component referenced. The name is empty if the given schema is not a reference.
+func (s *Document) ResolveSchema(schema *Schema) (*Schema, string) {
+	var name string
+
+	for schema != nil && schema.Ref != "" {
+		name = componentName(schema.Ref)
+		schema = s.Components.Schemas[name]
+	}
+
+	return schema, name
+}
+
+func (s *Document) ResolveParameter(parameter *Parameter) *Parameter {
+	for parameter != nil && parameter.Ref != "" {
+		parameter = s.Components.Parameters[componentName(parameter.Ref)]
+	}
+
+	return parameter
+}
+
+func (s *Document) ResolveResponse(response *Response) *Response {
+	for response != nil && response.Ref != "" {
+		response = s.Components.Responses[componentName(response.Ref)]
+	}
+
+	return response
+}
+
+func (s *Document) ResolveRequestBody(requestBody *RequestBody) *RequestBody {
+	for requestBody != nil && requestBody.Ref != "" {
+		requestBody = s.Components.RequestBodies[componentName(requestBody.Ref)]
+	}
+
+	return requestBody
+}
+
+// Routes returns every operation of the document ordered by path and then by method.
+func (s *Document) Routes() []Route {
+	var (
+		routes []Route
+		paths  = make([]string, 0, len(s.Paths))
+	)
+
+	for path := range s.Paths {
+		paths = append(paths, path)
+	}
+
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		item := s.Paths[path]
+
+		for _, method := range []struct {
+			name      string
+			operation *Operation
+		}{
+			{http.MethodGet, item.Get},
+			{http.MethodPut, item.Put},
+			{http.MethodPost, item.Post},
+			{http.MethodDelete, item.Delete},
+			{http.MethodPatch, item.Patch},
+		} {
+			if method.operation == nil {
+				continue
+			}
+
+			route := Route{
+				Method:    method.name,
+				Path:      path,
+				Operation: method.operation,
+			}
+
+			for _, parameter := range append(item.Parameters, method.operation.Parameters...) {
+				route.Parameters = append(route.Parameters, s.ResolveParameter(parameter))
+			}
+
+			routes = append(routes, route)
+		}
+	}
+
+	return routes
+}
+
+// Route returns the route that serves the given method and request path. Routes with more literal path segments take
+// precedence over routes that match the same segments with path parameters.
+func (s *Document) Route(method, requestPath string) (Route, bool) {
+	var (
+		segments  = strings.Split(strings.Trim(requestPath, "/"), "/")
+		matched   Route
+		bestScore = -1
+	)
+
+	for _, route := range s.Routes() {
+		if route.Method != method {
+			continue
+		}
+
+		if score, matches := matchPath(strings.Split(strings.Trim(route.Path, "/"), "/"), segments); matches && score > bestScore {
+			matched = route
+			bestScore = score
+		}
+	}
+
+	return matched, bestScore >= 0
+}
+
+// matchPath returns the number of literal segments matched if the given path segments match the route template.
+func matchPath(templateSegments, segments []string) (int, bool) {
+	if len(templateSegments) != len(segments) {
+		return 0, false
+	}
+
+	literalMatches := 0
+
+	for idx, templateSegment := range templateSegments {
+		if _, isParameter := pathParameterName(templateSegment); isParameter {
+			continue
+		} else if templateSegment != segments[idx] {
+			return 0, false
+		}
+
+		literalMatches++
+	}
+
+	return literalMatches, true
+}
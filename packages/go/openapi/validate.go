@@ -0,0 +1,349 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"sort"
+	"strings"
+	"time"
+)
+
+var (
+	ErrUndocumentedRoute    = errors.New("route is not documented")
+	ErrUndocumentedResponse = errors.New("response is not documented")
+)
+
+// ValidateResponse validates a response served for the given method and request path against the spec. Only JSON
+// response bodies are validated against their schema.
+//
+// Validation is stricter than JSON Schema so that drift between a handler and the spec is caught: object properties
+// that the schema does not declare are rejected unless the schema allows additional properties or declares no
+// properties at all. Null is accepted for any value as nil slices, maps and pointers marshal as null.
+func (s *Document) ValidateResponse(method, requestPath string, statusCode int, contentType string, body []byte) error {
+	route, found := s.Route(method, requestPath)
+	if !found {
+		return fmt.Errorf("%w: %s %s", ErrUndocumentedRoute, method, requestPath)
+	}
+
+	response, documented := route.Operation.Responses[fmt.Sprint(statusCode)]
+	if !documented {
+		if response, documented = route.Operation.Responses["default"]; !documented {
+			return fmt.Errorf("%w: %s %s returned status %d", ErrUndocumentedResponse, method, route.Path, statusCode)
+		}
+	}
+
+	if IsNoContent(response) {
+		return nil
+	}
+
+	response = s.ResolveResponse(response)
+
+	if len(response.Content) == 0 {
+		if len(bytes.TrimSpace(body)) > 0 {
+			return fmt.Errorf("%s %s returned a body for status %d which documents no content", method, route.Path, statusCode)
+		}
+
+		return nil
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return fmt.Errorf("%s %s returned an invalid content type %q: %w", method, route.Path, contentType, err)
+	}
+
+	content, documented := response.Content[mediaType]
+	if !documented {
+		return fmt.Errorf("%w: %s %s returned content type %s for status %d", ErrUndocumentedResponse, method, route.Path, mediaType, statusCode)
+	} else if !isJSONMediaType(mediaType) || content.Schema == nil {
+		return nil
+	}
+
+	var (
+		value   any
+		decoder = json.NewDecoder(bytes.NewReader(body))
+	)
+
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&value); err != nil {
+		return fmt.Errorf("%s %s returned an invalid JSON body: %w", method, route.Path, err)
+	}
+
+	validator := schemaValidator{
+		document: s,
+	}
+
+	validator.validate(content.Schema, value, "$", true)
+
+	if len(validator.errors) > 0 {
+		return fmt.Errorf("%s %s response for status %d does not match the spec: %w", method, route.Path, statusCode, errors.Join(validator.errors...))
+	}
+
+	return nil
+}
+
+func isJSONMediaType(mediaType string) bool {
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+type schemaValidator struct {
+	document *Document
+	errors   []error
+}
+
+func (s *schemaValidator) fail(path, format string, args ...any) {
+	s.errors = append(s.errors, fmt.Errorf("%s: %s", path, fmt.Sprintf(format, args...)))
+}
+
+// matches validates the value against the schema without recording any errors.
+func (s *schemaValidator) matches(schema *Schema, value any, path string, strict bool) bool {
+	probe := schemaValidator{
+		document: s.document,
+	}
+
+	probe.validate(schema, value, path, strict)
+	return len(probe.errors) == 0
+}
+
+// validate validates the value against the schema. If strict is set then object properties that are not declared by
+// the schema are rejected. Members of an allOf composition are validated without strictness as each member only
+// declares a subset of the properties of the composed object.
+func (s *schemaValidator) validate(schema *Schema, value any, path string, strict bool) {
+	if schema, _ = s.document.ResolveSchema(schema); schema == nil || value == nil {
+		return
+	}
+
+	if valueSchema, isNullable := schema.NullableValue(); isNullable {
+		s.validateNullable(schema, valueSchema, value, path)
+		return
+	}
+
+	switch {
+	case len(schema.AllOf) == 1 && len(schema.Properties) == 0:
+		// A single member composition only decorates the member schema
+		s.validate(schema.AllOf[0], value, path, strict)
+
+	case len(schema.AllOf) > 0:
+		for _, member := range schema.AllOf {
+			s.validate(member, value, path, false)
+		}
+
+		s.validateValue(schema, value, path, false)
+
+		if strict {
+			s.validateKnownProperties(schema, value, path)
+		}
+
+	case len(schema.OneOf) > 0 || len(schema.AnyOf) > 0:
+		for _, member := range append(schema.OneOf, schema.AnyOf...) {
+			if s.matches(member, value, path, strict) {
+				return
+			}
+		}
+
+		s.fail(path, "value does not match any of the documented schemas")
+
+	default:
+		s.validateValue(schema, value, path, strict)
+	}
+}
+
+// validateNullable validates a value of one of the nullable database types. Property names of the object form are
+// matched case-insensitively as the sql null types marshal with capitalized property names.
+func (s *schemaValidator) validateNullable(schema, valueSchema *Schema, value any, path string) {
+	object, isObject := value.(map[string]any)
+	if !isObject {
+		s.validate(valueSchema, value, path, true)
+		return
+	}
+
+	for name, propertyValue := range object {
+		var matched bool
+
+		for propertyName, property := range schema.Properties {
+			if strings.EqualFold(name, propertyName) {
+				s.validate(property, propertyValue, path+"."+name, true)
+				matched = true
+			}
+		}
+
+		if !matched {
+			s.fail(path+"."+name, "property is not documented")
+		}
+	}
+}
+
+// validateValue validates the value against the type, format, enumeration, properties and items of the schema
+// ignoring any composition keywords.
+func (s *schemaValidator) validateValue(schema *Schema, value any, path string, strict bool) {
+	schemaType := schema.Type
+	if schemaType == "" && len(schema.Properties) > 0 {
+		schemaType = "object"
+	}
+
+	switch schemaType {
+	case "object":
+		object, isObject := value.(map[string]any)
+		if !isObject {
+			s.fail(path, "expected an object but found %s", describeValue(value))
+			return
+		}
+
+		for name, property := range schema.Properties {
+			if propertyValue, present := object[name]; present {
+				s.validate(property, propertyValue, path+"."+name, true)
+			}
+		}
+
+		if schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil {
+			for name, propertyValue := range object {
+				if _, declared := schema.Properties[name]; !declared {
+					s.validate(schema.AdditionalProperties.Schema, propertyValue, path+"."+name, true)
+				}
+			}
+		}
+
+		if strict {
+			s.validateKnownProperties(schema, value, path)
+		}
+
+	case "array":
+		array, isArray := value.([]any)
+		if !isArray {
+			s.fail(path, "expected an array but found %s", describeValue(value))
+			return
+		}
+
+		for idx, item := range array {
+			s.validate(schema.Items, item, fmt.Sprintf("%s[%d]", path, idx), true)
+		}
+
+	case "string":
+		if text, isString := value.(string); !isString {
+			s.fail(path, "expected a string but found %s", describeValue(value))
+		} else if schema.Format == "date-time" {
+			if _, err := time.Parse(time.RFC3339Nano, text); err != nil {
+				s.fail(path, "expected an RFC-3339 timestamp but found %q", text)
+			}
+		}
+
+	case "integer":
+		if number, isNumber := value.(json.Number); !isNumber {
+			s.fail(path, "expected an integer but found %s", describeValue(value))
+		} else if _, err := number.Int64(); err != nil {
+			s.fail(path, "expected an integer but found %s", number)
+		}
+
+	case "number":
+		if _, isNumber := value.(json.Number); !isNumber {
+			s.fail(path, "expected a number but found %s", describeValue(value))
+		}
+
+	case "boolean":
+		if _, isBoolean := value.(bool); !isBoolean {
+			s.fail(path, "expected a boolean but found %s", describeValue(value))
+		}
+	}
+
+	if len(schema.Enum) > 0 {
+		for _, member := range schema.Enum {
+			if fmt.Sprint(member) == fmt.Sprint(value) {
+				return
+			}
+		}
+
+		s.fail(path, "value %v is not one of the documented values %v", value, schema.Enum)
+	}
+}
+
+// validateKnownProperties rejects object properties that are not declared by the schema or any of its members.
+func (s *schemaValidator) validateKnownProperties(schema *Schema, value any, path string) {
+	object, isObject := value.(map[string]any)
+	if !isObject {
+		return
+	}
+
+	declared := map[string]struct{}{}
+
+	if open := s.collectProperties(schema, declared); open {
+		return
+	}
+
+	var undeclared []string
+
+	for name := range object {
+		if _, isDeclared := declared[name]; !isDeclared {
+			undeclared = append(undeclared, name)
+		}
+	}
+
+	sort.Strings(undeclared)
+
+	for _, name := range undeclared {
+		s.fail(path+"."+name, "property is not documented")
+	}
+}
+
+// collectProperties adds the names of all properties declared by the schema and its allOf members to the given set.
+// It returns true if the schema permits properties that it does not declare.
+func (s *schemaValidator) collectProperties(schema *Schema, declared map[string]struct{}) bool {
+	if schema, _ = s.document.ResolveSchema(schema); schema == nil {
+		return true
+	} else if schema.AdditionalProperties != nil && (schema.AdditionalProperties.Allowed || schema.AdditionalProperties.Schema != nil) {
+		return true
+	} else if len(schema.OneOf) > 0 || len(schema.AnyOf) > 0 {
+		return true
+	}
+
+	for name := range schema.Properties {
+		declared[name] = struct{}{}
+	}
+
+	if len(schema.AllOf) > 0 {
+		for _, member := range schema.AllOf {
+			if s.collectProperties(member, declared) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return len(schema.Properties) == 0
+}
+
+func describeValue(value any) string {
+	switch value.(type) {
+	case map[string]any:
+		return "an object"
+	case []any:
+		return "an array"
+	case string:
+		return "a string"
+	case json.Number:
+		return "a number"
+	case bool:
+		return "a boolean"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
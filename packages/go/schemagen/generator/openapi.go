@@ -0,0 +1,933 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/specterops/bloodhound/openapi"
+)
+
+const (
+	OpenAPISourceName    = "github.com/specterops/bloodhound/-/tree/main/packages/go/openapi/src"
+	predicateFilterRef   = "api.params.predicate.filter."
+	contentTypeJSON      = "application/json"
+	paginationSkipParam  = "skip"
+	paginationLimitParam = "limit"
+)
+
+var (
+	golangInitialisms = map[string]struct{}{
+		"ACL": {}, "AD": {}, "API": {}, "CA": {}, "CSV": {}, "DN": {}, "DNS": {}, "HTTP": {}, "HTTPS": {}, "ID": {},
+		"IP": {}, "JSON": {}, "JWT": {}, "MFA": {}, "OIDC": {}, "OU": {}, "SAML": {}, "SID": {}, "SQL": {}, "SSO": {},
+		"TLS": {}, "TTL": {}, "UI": {}, "URI": {}, "URL": {}, "UUID": {}, "XML": {},
+	}
+
+	// Component name prefixes that are dropped from generated type names
+	componentNamePrefixes = []string{"model.", "enum.", "api."}
+)
+
+// GolangSymbol converts an identifier from the OpenAPI spec into an exported Go identifier.
+func GolangSymbol(raw string) string {
+	var builder strings.Builder
+
+	for _, word := range splitWords(raw) {
+		if upper := strings.ToUpper(word); isInitialism(upper) {
+			builder.WriteString(upper)
+		} else {
+			builder.WriteString(strings.ToUpper(word[:1]) + word[1:])
+		}
+	}
+
+	symbol := builder.String()
+
+	if symbol == "" {
+		return "Value"
+	} else if unicode.IsDigit(rune(symbol[0])) {
+		return "N" + symbol
+	}
+
+	return symbol
+}
+
+// splitWords splits an identifier into words at non-alphanumeric characters and at lower to upper case transitions.
+func splitWords(raw string) []string {
+	var (
+		words    []string
+		current  []rune
+		previous rune
+	)
+
+	for _, r := range raw {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if len(current) > 0 {
+				words = append(words, string(current))
+				current = nil
+			}
+		} else {
+			if len(current) > 0 && unicode.IsUpper(r) && unicode.IsLower(previous) {
+				words = append(words, string(current))
+				current = nil
+			}
+
+			current = append(current, r)
+		}
+
+		previous = r
+	}
+
+	if len(current) > 0 {
+		words = append(words, string(current))
+	}
+
+	return words
+}
+
+func isInitialism(word string) bool {
+	_, found := golangInitialisms[word]
+	return found
+}
+
+func golangArgumentSymbol(raw string) string {
+	symbol := GolangSymbol(raw)
+
+	for initialism := range golangInitialisms {
+		if strings.HasPrefix(symbol, initialism) && (len(symbol) == len(initialism) || unicode.IsUpper(rune(symbol[len(initialism)]))) {
+			return strings.ToLower(initialism) + symbol[len(initialism):]
+		}
+	}
+
+	return strings.ToLower(symbol[:1]) + symbol[1:]
+}
+
+// golangClientGenerator generates a typed Go client from an OpenAPI document. Types are declared as they are first
+// referenced so that only the components in use by a route are generated.
+type golangClientGenerator struct {
+	document       *openapi.Document
+	types          *jen.File
+	operations     *jen.File
+	typeNames      map[string]struct{}
+	componentTypes map[string]jen.Code
+	schemaTypes    map[*openapi.Schema]string
+	titledTypes    map[string]string
+}
+
+func newGolangClientGenerator(pkgName string, document *openapi.Document) *golangClientGenerator {
+	generator := &golangClientGenerator{
+		document:       document,
+		types:          jen.NewFile(pkgName),
+		operations:     jen.NewFile(pkgName),
+		typeNames:      map[string]struct{}{},
+		componentTypes: map[string]jen.Code{},
+		schemaTypes:    map[*openapi.Schema]string{},
+		titledTypes:    map[string]string{},
+	}
+
+	generator.operations.ImportName("iter", "iter")
+
+	for _, file := range []*jen.File{generator.types, generator.operations} {
+		file.HeaderComment(fmt.Sprintf("// Code generated by schemagen. DO NOT EDIT!\n// OpenAPI source: %s/", OpenAPISourceName))
+	}
+
+	return generator
+}
+
+func (s *golangClientGenerator) reserveTypeName(name string) string {
+	candidate := name
+
+	for suffix := 2; ; suffix++ {
+		if _, taken := s.typeNames[candidate]; !taken {
+			s.typeNames[candidate] = struct{}{}
+			return candidate
+		}
+
+		candidate = fmt.Sprintf("%s%d", name, suffix)
+	}
+}
+
+func componentTypeName(name string) string {
+	for _, prefix := range componentNamePrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return GolangSymbol(strings.TrimPrefix(name, prefix))
+		}
+	}
+
+	return GolangSymbol(name)
+}
+
+const commentWidth = 116
+
+// commentLines wraps the given text into comment lines. Paragraphs separated by blank lines are kept apart.
+func commentLines(text string) []jen.Code {
+	var lines []jen.Code
+
+	for idx, paragraph := range strings.Split(strings.TrimSpace(text), "\n\n") {
+		words := strings.Fields(paragraph)
+
+		if len(words) == 0 {
+			continue
+		} else if idx > 0 {
+			lines = append(lines, jen.Comment(""))
+		}
+
+		line := words[0]
+
+		for _, word := range words[1:] {
+			if len(line)+1+len(word) > commentWidth {
+				lines = append(lines, jen.Comment(line))
+				line = word
+			} else {
+				line += " " + word
+			}
+		}
+
+		lines = append(lines, jen.Comment(line))
+	}
+
+	return lines
+}
+
+func writeComment(file *jen.File, text string) {
+	for _, line := range commentLines(text) {
+		file.Add(line)
+	}
+}
+
+// typeOf returns the Go type of the given schema, declaring any named types that it requires. The hint is used to
+// name inline object schemas.
+func (s *golangClientGenerator) typeOf(schema *openapi.Schema, hint string) jen.Code {
+	if schema == nil {
+		return jen.Any()
+	}
+
+	if schema.Ref != "" {
+		resolved, name := s.document.ResolveSchema(schema)
+		return s.componentType(name, resolved)
+	}
+
+	if valueSchema, isNullable := schema.NullableValue(); isNullable {
+		return jen.Id("Nullable").Types(s.typeOf(valueSchema, hint))
+	}
+
+	// The zero value of a scalar can not express null so nullable scalars are wrapped as well
+	if schema.Nullable && isScalarSchema(schema) {
+		valueSchema := *schema
+		valueSchema.Nullable = false
+
+		return jen.Id("Nullable").Types(s.typeOf(&valueSchema, hint))
+	}
+
+	switch {
+	case len(schema.AllOf) == 1 && len(schema.Properties) == 0:
+		return s.typeOf(schema.AllOf[0], hint)
+
+	case len(schema.AllOf) > 0:
+		return s.declareStruct(schema, hint)
+
+	case len(schema.OneOf) > 0 || len(schema.AnyOf) > 0:
+		return jen.Qual("encoding/json", "RawMessage")
+	}
+
+	switch schema.Type {
+	case "string":
+		switch schema.Format {
+		case "date-time":
+			return jen.Qual("time", "Time")
+		case "binary":
+			return jen.Index().Byte()
+		default:
+			return jen.String()
+		}
+
+	case "integer":
+		switch schema.Format {
+		case "int32":
+			return jen.Int32()
+		case "int64":
+			return jen.Int64()
+		default:
+			return jen.Int()
+		}
+
+	case "number":
+		if schema.Format == "float" {
+			return jen.Float32()
+		}
+
+		return jen.Float64()
+
+	case "boolean":
+		return jen.Bool()
+
+	case "array":
+		return jen.Index().Add(s.typeOf(schema.Items, hint+"Item"))
+
+	default:
+		if len(schema.Properties) > 0 {
+			return s.declareStruct(schema, hint)
+		} else if schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil {
+			return jen.Map(jen.String()).Add(s.typeOf(schema.AdditionalProperties.Schema, hint+"Value"))
+		} else if schema.Type == "object" {
+			return jen.Map(jen.String()).Any()
+		}
+
+		return jen.Any()
+	}
+}
+
+// componentType returns the Go type of a schema component. Object components are declared as named structs and string
+// enumerations as named string types with a constant for each value. Other components are inlined as their type.
+func (s *golangClientGenerator) componentType(name string, schema *openapi.Schema) jen.Code {
+	if componentType, declared := s.componentTypes[name]; declared {
+		return componentType
+	}
+
+	if schema == nil {
+		return jen.Any()
+	}
+
+	if _, isNullable := schema.NullableValue(); isNullable || !isObjectSchema(schema) {
+		if schema.Type == "string" && len(schema.Enum) > 0 {
+			typeName := s.reserveTypeName(componentTypeName(name))
+			s.componentTypes[name] = jen.Id(typeName)
+			s.declareStringEnumeration(typeName, schema)
+
+			return jen.Id(typeName)
+		}
+
+		componentType := s.typeOf(schema, componentTypeName(name))
+		s.componentTypes[name] = componentType
+
+		return componentType
+	}
+
+	typeName := s.reserveTypeName(componentTypeName(name))
+
+	// Record the component before declaring it to allow for self-referencing schemas
+	s.componentTypes[name] = jen.Id(typeName)
+	s.schemaTypes[schema] = typeName
+	s.writeStruct(typeName, schema)
+
+	return jen.Id(typeName)
+}
+
+func isObjectSchema(schema *openapi.Schema) bool {
+	return len(schema.Properties) > 0 || (len(schema.AllOf) > 1) || (len(schema.AllOf) == 1 && schema.Type == "object")
+}
+
+func (s *golangClientGenerator) declareStringEnumeration(typeName string, schema *openapi.Schema) {
+	writeComment(s.types, schema.Description)
+
+	s.types.Type().Id(typeName).String()
+	s.types.Line()
+
+	s.types.Const().DefsFunc(func(group *jen.Group) {
+		for _, value := range schema.Enum {
+			if text, isString := value.(string); isString && text != "" {
+				group.Id(typeName + GolangSymbol(text)).Id(typeName).Op("=").Lit(text)
+			}
+		}
+	})
+	s.types.Line()
+}
+
+// declareStruct declares a named struct for an inline object schema. Inline schemas with a descriptive title are named
+// after it and shared between all routes that declare an identical schema.
+func (s *golangClientGenerator) declareStruct(schema *openapi.Schema, hint string) jen.Code {
+	if typeName, declared := s.schemaTypes[schema]; declared {
+		return jen.Id(typeName)
+	}
+
+	var (
+		titleKey string
+		name     = hint
+	)
+
+	if strings.ContainsAny(schema.Title, "- ") {
+		name = GolangSymbol(schema.Title)
+
+		if content, err := json.Marshal(schema); err == nil {
+			titleKey = string(content)
+
+			if typeName, declared := s.titledTypes[titleKey]; declared {
+				s.schemaTypes[schema] = typeName
+				return jen.Id(typeName)
+			}
+		}
+	}
+
+	typeName := s.reserveTypeName(name)
+
+	s.schemaTypes[schema] = typeName
+
+	if titleKey != "" {
+		s.titledTypes[titleKey] = typeName
+	}
+
+	s.writeStruct(typeName, schema)
+	return jen.Id(typeName)
+}
+
+type structField struct {
+	name     string
+	property string
+	schema   *openapi.Schema
+}
+
+// writeStruct writes the struct declaration of an object schema. Object components of an allOf composition are
+// embedded and the properties of inline members are flattened into the struct.
+func (s *golangClientGenerator) writeStruct(typeName string, schema *openapi.Schema) {
+	var (
+		embedded []jen.Code
+		fields   []structField
+		names    = map[string]struct{}{}
+	)
+
+	addProperties := func(properties map[string]*openapi.Schema) {
+		propertyNames := make([]string, 0, len(properties))
+
+		for property := range properties {
+			propertyNames = append(propertyNames, property)
+		}
+
+		sort.Strings(propertyNames)
+
+		for _, property := range propertyNames {
+			name := GolangSymbol(property)
+
+			for suffix := 2; ; suffix++ {
+				if _, taken := names[name]; !taken {
+					break
+				}
+
+				name = fmt.Sprintf("%s%d", GolangSymbol(property), suffix)
+			}
+
+			names[name] = struct{}{}
+			fields = append(fields, structField{
+				name:     name,
+				property: property,
+				schema:   properties[property],
+			})
+		}
+	}
+
+	for _, member := range schema.AllOf {
+		if resolved, name := s.document.ResolveSchema(member); resolved == nil {
+			continue
+		} else if member.Ref != "" && isObjectSchema(resolved) {
+			if _, isNullable := resolved.NullableValue(); !isNullable {
+				embedded = append(embedded, s.componentType(name, resolved))
+			}
+		} else {
+			addProperties(resolved.Properties)
+		}
+	}
+
+	addProperties(schema.Properties)
+
+	var fieldCode []jen.Code
+
+	for _, field := range fields {
+		var (
+			fieldType = s.typeOf(field.schema, typeName+field.name)
+			tag       = field.property
+		)
+
+		if isNillableSchema(s.document, field.schema) {
+			tag += ",omitempty"
+		}
+
+		fieldCode = append(fieldCode, jen.Id(field.name).Add(fieldType).Tag(map[string]string{"json": tag}))
+	}
+
+	writeComment(s.types, schema.Description)
+
+	s.types.Type().Id(typeName).StructFunc(func(group *jen.Group) {
+		for _, embeddedType := range embedded {
+			group.Add(embeddedType)
+		}
+
+		if len(embedded) > 0 && len(fieldCode) > 0 {
+			group.Line()
+		}
+
+		for _, code := range fieldCode {
+			group.Add(code)
+		}
+	})
+	s.types.Line()
+}
+
+// isNillableSchema returns true if the Go type of the schema is a pointer, slice, map or interface.
+func isNillableSchema(document *openapi.Document, schema *openapi.Schema) bool {
+	resolved, _ := document.ResolveSchema(schema)
+
+	if resolved == nil {
+		return true
+	} else if _, isNullable := resolved.NullableValue(); isNullable {
+		return false
+	} else if len(resolved.AllOf) == 1 && len(resolved.Properties) == 0 {
+		return isNillableSchema(document, resolved.AllOf[0])
+	} else if len(resolved.OneOf) > 0 || len(resolved.AnyOf) > 0 {
+		return true
+	}
+
+	switch resolved.Type {
+	case "array":
+		return true
+	case "string":
+		return resolved.Format == "binary"
+	case "integer", "number", "boolean":
+		return false
+	default:
+		return !isObjectSchema(resolved)
+	}
+}
+
+// isScalarSchema returns true if the Go type of the schema is a string, number or boolean.
+func isScalarSchema(schema *openapi.Schema) bool {
+	switch schema.Type {
+	case "string":
+		return schema.Format != "binary"
+	case "integer", "number", "boolean":
+		return true
+	default:
+		return false
+	}
+}
+
+// routeParameter is a query or header parameter of a route.
+type routeParameter struct {
+	field     string
+	parameter *openapi.Parameter
+}
+
+// routeBody describes how the request body of a route is passed to its generated method.
+type routeBody struct {
+	present bool
+	json    bool
+	goType  jen.Code
+}
+
+// routeResult describes what the generated method of a route returns.
+type routeResult struct {
+	json   bool
+	stream bool
+	goType jen.Code
+	schema *openapi.Schema
+}
+
+func (s *golangClientGenerator) requestBody(route openapi.Route, name string) routeBody {
+	requestBody := s.document.ResolveRequestBody(route.Operation.RequestBody)
+
+	if requestBody == nil || len(requestBody.Content) == 0 {
+		return routeBody{}
+	} else if content, hasJSON := requestBody.Content[contentTypeJSON]; hasJSON {
+		var goType jen.Code = jen.Any()
+
+		if content.Schema != nil {
+			goType = s.typeOf(content.Schema, name+"Request")
+		}
+
+		return routeBody{
+			present: true,
+			json:    true,
+			goType:  goType,
+		}
+	}
+
+	return routeBody{
+		present: true,
+	}
+}
+
+func (s *golangClientGenerator) result(route openapi.Route, name string) routeResult {
+	statusCode, response, found := route.SuccessResponse(s.document)
+	hint := name + "Response"
+
+	// Inline schemas of response components are shared by every route that references the component
+	if reference := route.Operation.Responses[fmt.Sprint(statusCode)]; found && reference.Ref != "" {
+		hint = GolangSymbol(reference.Ref[strings.LastIndex(reference.Ref, "/")+1:])
+	}
+
+	if !found || response == nil || len(response.Content) == 0 || openapi.IsNoContent(route.Operation.Responses[fmt.Sprint(statusCode)]) {
+		return routeResult{}
+	} else if content, hasJSON := response.Content[contentTypeJSON]; hasJSON {
+		if content.Schema == nil {
+			return routeResult{
+				json:   true,
+				goType: jen.Qual("encoding/json", "RawMessage"),
+			}
+		}
+
+		return routeResult{
+			json:   true,
+			goType: s.typeOf(content.Schema, hint),
+			schema: content.Schema,
+		}
+	}
+
+	return routeResult{
+		stream: true,
+		goType: jen.Qual("io", "ReadCloser"),
+	}
+}
+
+// parameterType returns the Go type of a query or header parameter. Predicate filters are passed as strings carrying
+// both the predicate and the value, for example "gt:2024-01-01T00:00:00Z".
+func (s *golangClientGenerator) parameterType(parameter *openapi.Parameter, hint string) jen.Code {
+	if parameter.Schema != nil && strings.Contains(parameter.Schema.Ref, predicateFilterRef) {
+		return jen.String()
+	} else if resolved, _ := s.document.ResolveSchema(parameter.Schema); resolved != nil && resolved.Type == "array" {
+		return s.typeOf(parameter.Schema, hint)
+	} else if parameter.Name == "sort_by" {
+		return jen.Index().String()
+	} else if parameter.Required {
+		return s.typeOf(parameter.Schema, hint)
+	}
+
+	return jen.Op("*").Add(s.typeOf(parameter.Schema, hint))
+}
+
+func (s *golangClientGenerator) writeParameters(name string, route openapi.Route) []routeParameter {
+	var (
+		parameters []routeParameter
+		fields     []jen.Code
+		names      = map[string]struct{}{}
+	)
+
+	for _, parameter := range route.Parameters {
+		if parameter == nil || (parameter.In != "query" && parameter.In != "header") {
+			continue
+		}
+
+		field := GolangSymbol(parameter.Name)
+
+		if _, taken := names[field]; taken {
+			continue
+		}
+
+		names[field] = struct{}{}
+		parameters = append(parameters, routeParameter{
+			field:     field,
+			parameter: parameter,
+		})
+
+		code := jen.Id(field).Add(s.parameterType(parameter, name+field)).Tag(map[string]string{parameter.In: parameter.Name})
+
+		fields = append(fields, commentLines(parameter.Description)...)
+
+		fields = append(fields, code)
+	}
+
+	if len(parameters) > 0 {
+		writeComment(s.operations, fmt.Sprintf("%sParams holds the query and header parameters of %s. Nil and empty fields are omitted.", name, name))
+		s.operations.Type().Id(name + "Params").Struct(fields...)
+		s.operations.Line()
+	}
+
+	return parameters
+}
+
+// pathExpression returns an expression that builds the request path of a route from its path parameter arguments.
+func pathExpression(route openapi.Route) *jen.Statement {
+	var (
+		expression *jen.Statement
+		literal    strings.Builder
+	)
+
+	appendCode := func(code jen.Code) {
+		if expression == nil {
+			expression = jen.Add(code)
+		} else {
+			expression = expression.Op("+").Add(code)
+		}
+	}
+
+	for idx, segment := range strings.Split(route.Path, "/") {
+		if idx > 0 {
+			literal.WriteString("/")
+		}
+
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			appendCode(jen.Lit(literal.String()))
+			literal.Reset()
+
+			appendCode(jen.Id("pathParameter").Call(jen.Id(golangArgumentSymbol(segment[1 : len(segment)-1]))))
+		} else {
+			literal.WriteString(segment)
+		}
+	}
+
+	if literal.Len() > 0 || expression == nil {
+		appendCode(jen.Lit(literal.String()))
+	}
+
+	return expression
+}
+
+func operationComment(name string, route openapi.Route) string {
+	comment := fmt.Sprintf("%s sends %s %s.", name, route.Method, route.Path)
+
+	if summary := strings.TrimSpace(route.Operation.Summary); summary != "" {
+		comment += " " + strings.TrimSuffix(summary, ".") + "."
+	}
+
+	if description := strings.TrimSpace(route.Operation.Description); description != "" {
+		comment += "\n\n" + description
+	}
+
+	if route.Operation.Deprecated {
+		comment += "\n\nDeprecated: the route is deprecated."
+	}
+
+	return comment
+}
+
+func (s *golangClientGenerator) writeRoute(route openapi.Route) {
+	var (
+		name           = operationSymbol(route.Operation.OperationID)
+		pathParameters = route.PathParameters()
+		body           = s.requestBody(route, name)
+		result         = s.result(route, name)
+		parameters     = s.writeParameters(name, route)
+
+		arguments     = []jen.Code{jen.Id("ctx").Qual("context", "Context")}
+		pathArguments []jen.Code
+		requestFields = jen.Dict{
+			jen.Id("method"): jen.Qual("net/http", methodConstant(route.Method)),
+			jen.Id("path"):   pathExpression(route),
+		}
+	)
+
+	for _, parameter := range pathParameters {
+		argument := golangArgumentSymbol(parameter.Name)
+
+		arguments = append(arguments, jen.Id(argument).Add(s.typeOf(parameter.Schema, name+GolangSymbol(parameter.Name))))
+		pathArguments = append(pathArguments, jen.Id(argument))
+	}
+
+	if body.present {
+		if body.json {
+			arguments = append(arguments, jen.Id("body").Add(body.goType))
+			requestFields[jen.Id("body")] = jen.Id("body")
+		} else {
+			arguments = append(arguments, jen.Id("body").Qual("io", "Reader"), jen.Id("contentType").String())
+			requestFields[jen.Id("rawBody")] = jen.Id("body")
+			requestFields[jen.Id("contentType")] = jen.Id("contentType")
+		}
+	}
+
+	if len(parameters) > 0 {
+		arguments = append(arguments, jen.Id("params").Op("*").Id(name+"Params"))
+		requestFields[jen.Id("parameters")] = jen.Id("params")
+	}
+
+	request := jen.Id("request").Values(requestFields)
+
+	writeComment(s.operations, operationComment(name, route))
+
+	method := s.operations.Func().Params(jen.Id("s").Op("*").Id("Client")).Id(name).Params(arguments...)
+
+	defer s.operations.Line()
+
+	switch {
+	case result.json:
+		method.Params(result.goType, jen.Error()).Block(
+			jen.Var().Id("response").Add(result.goType),
+			jen.Return(jen.Id("response"), jen.Id("s").Dot("do").Call(jen.Id("ctx"), request, jen.Op("&").Id("response"))),
+		)
+
+	case result.stream:
+		method.Params(result.goType, jen.Error()).Block(
+			jen.Return(jen.Id("s").Dot("stream").Call(jen.Id("ctx"), request)),
+		)
+
+	default:
+		method.Error().Block(
+			jen.Return(jen.Id("s").Dot("do").Call(jen.Id("ctx"), request, jen.Nil())),
+		)
+	}
+
+	if result.json && !body.present {
+		s.writePaginationIterator(route, name, pathArguments, arguments, parameters, result)
+	}
+}
+
+// operationSymbol returns the method name of an operation. Operation IDs are kept as they are written in the spec if
+// they are already exported Go identifiers.
+func operationSymbol(operationID string) string {
+	for idx, r := range operationID {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' || idx == 0 && !unicode.IsUpper(r) {
+			return GolangSymbol(operationID)
+		}
+	}
+
+	return operationID
+}
+
+func methodConstant(method string) string {
+	switch method {
+	case http.MethodGet:
+		return "MethodGet"
+	case http.MethodPut:
+		return "MethodPut"
+	case http.MethodPost:
+		return "MethodPost"
+	case http.MethodDelete:
+		return "MethodDelete"
+	default:
+		return "MethodPatch"
+	}
+}
+
+// pageItems returns the accessor of the items of a paginated response along with the item schema. Items are either the
+// data array of the response or the single array property of the response data object.
+func (s *golangClientGenerator) pageItems(schema *openapi.Schema) ([]string, *openapi.Schema, bool) {
+	data, found := s.findProperty(schema, "data")
+	if !found {
+		return nil, nil, false
+	}
+
+	resolved, _ := s.document.ResolveSchema(data)
+	if resolved == nil {
+		return nil, nil, false
+	} else if resolved.Type == "array" {
+		return []string{"Data"}, data, true
+	}
+
+	properties := map[string]*openapi.Schema{}
+	s.collectProperties(resolved, properties)
+
+	if len(properties) != 1 {
+		return nil, nil, false
+	}
+
+	for property, propertySchema := range properties {
+		if items, _ := s.document.ResolveSchema(propertySchema); items != nil && items.Type == "array" {
+			return []string{"Data", GolangSymbol(property)}, propertySchema, true
+		}
+	}
+
+	return nil, nil, false
+}
+
+func (s *golangClientGenerator) collectProperties(schema *openapi.Schema, properties map[string]*openapi.Schema) {
+	if schema, _ = s.document.ResolveSchema(schema); schema == nil {
+		return
+	}
+
+	for name, property := range schema.Properties {
+		properties[name] = property
+	}
+
+	for _, member := range schema.AllOf {
+		s.collectProperties(member, properties)
+	}
+}
+
+func (s *golangClientGenerator) findProperty(schema *openapi.Schema, name string) (*openapi.Schema, bool) {
+	properties := map[string]*openapi.Schema{}
+	s.collectProperties(schema, properties)
+
+	property, found := properties[name]
+	return property, found
+}
+
+func isPageParameter(document *openapi.Document, parameters []routeParameter, name string) bool {
+	for _, parameter := range parameters {
+		if parameter.parameter.Name != name || parameter.parameter.In != "query" || parameter.parameter.Required {
+			continue
+		}
+
+		resolved, _ := document.ResolveSchema(parameter.parameter.Schema)
+		return resolved != nil && resolved.Type == "integer" && resolved.Format == ""
+	}
+
+	return false
+}
+
+// writePaginationIterator writes an iterator over every item of a route that is paginated with skip and limit.
+func (s *golangClientGenerator) writePaginationIterator(route openapi.Route, name string, pathArguments, arguments []jen.Code, parameters []routeParameter, result routeResult) {
+	if !isPageParameter(s.document, parameters, paginationSkipParam) || !isPageParameter(s.document, parameters, paginationLimitParam) {
+		return
+	}
+
+	accessor, itemsSchema, found := s.pageItems(result.schema)
+	if !found {
+		return
+	}
+
+	var (
+		arraySchema, _ = s.document.ResolveSchema(itemsSchema)
+		itemType       = s.typeOf(arraySchema.Items, name+"Item")
+		items          = jen.Id("response")
+		iteratorArgs   = append(arguments[:len(arguments)-1:len(arguments)-1], jen.Id("params").Id(name+"Params"))
+		callArgs       = append(append([]jen.Code{jen.Id("ctx")}, pathArguments...), jen.Op("&").Id("params"))
+	)
+
+	for _, field := range accessor {
+		items = items.Dot(field)
+	}
+
+	writeComment(s.operations, fmt.Sprintf("%sAll returns an iterator over every item returned by %s, requesting each page in turn until a page shorter than the limit is returned.", name, name))
+	defer s.operations.Line()
+
+	s.operations.Func().Params(jen.Id("s").Op("*").Id("Client")).Id(name+"All").Params(iteratorArgs...).
+		Qual("iter", "Seq2").Types(itemType, jen.Error()).
+		Block(
+			jen.Return(jen.Id("paginate").Call(
+				jen.Id("params").Dot("Skip"),
+				jen.Id("params").Dot("Limit"),
+				jen.Func().Params(jen.List(jen.Id("skip"), jen.Id("limit")).Int()).Params(jen.Index().Add(itemType), jen.Error()).Block(
+					jen.List(jen.Id("params").Dot("Skip"), jen.Id("params").Dot("Limit")).Op("=").List(jen.Op("&").Id("skip"), jen.Op("&").Id("limit")),
+					jen.Line(),
+					jen.List(jen.Id("response"), jen.Err()).Op(":=").Id("s").Dot(name).Call(callArgs...),
+					jen.Return(items, jen.Err()),
+				),
+			)),
+		)
+}
+
+// GenerateGolangAPIClient generates a typed client for every route of the given OpenAPI document. Component types are
+// written to types.go and the routes along with their parameter and inline types are written to operations.go.
+func GenerateGolangAPIClient(pkgName, dir string, document *openapi.Document) error {
+	generator := newGolangClientGenerator(pkgName, document)
+
+	// Reserve the names of the hand-written declarations of the package
+	for _, name := range []string{"Client", "request", "DefaultPageSize", "Nullable"} {
+		generator.reserveTypeName(name)
+	}
+
+	for _, route := range document.Routes() {
+		generator.writeRoute(route)
+	}
+
+	if err := WriteSourceFile(generator.types, filepath.Join(dir, "types.go")); err != nil {
+		return err
+	}
+
+	return WriteSourceFile(generator.operations, filepath.Join(dir, "operations.go"))
+}
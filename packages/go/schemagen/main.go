@@ -23,6 +23,7 @@ import (
 	"path/filepath"
 
 	"cuelang.org/go/cue/errors"
+	"github.com/specterops/bloodhound/openapi"
 	"github.com/specterops/bloodhound/schemagen/generator"
 	"github.com/specterops/bloodhound/schemagen/model"
 	"github.com/specterops/bloodhound/schemagen/tsgen"
@@ -58,6 +59,14 @@ func GenerateGolang(projectRoot string, rootSchema Schema) error {
 	return nil
 }
 
+func GenerateGolangAPIClient(projectRoot string) error {
+	if document, err := openapi.Spec(); err != nil {
+		return err
+	} else {
+		return generator.GenerateGolangAPIClient("sdk", filepath.Join(projectRoot, "cmd/api/src/api/v2/sdk"), document)
+	}
+}
+
 func GenerateSharedTypeScript(projectRoot string, rootSchema Schema) error {
 	root := tsgen.NewFile("graph_schema", filepath.Join(projectRoot, "packages/javascript/bh-shared-ui/src/graphSchema.ts"))
 
@@ -104,6 +113,11 @@ func main() {
 				slog.Error(fmt.Sprintf("Error %v", err))
 				os.Exit(1)
 			}
+
+			if err := GenerateGolangAPIClient(projectRoot); err != nil {
+				slog.Error(fmt.Sprintf("Error %v", err))
+				os.Exit(1)
+			}
 		}
 	}
 }
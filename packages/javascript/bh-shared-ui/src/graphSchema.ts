@@ -1139,11 +1139,14 @@ export function AzurePathfindingEdges(): AzureRelationshipKind[] {
 }
 export enum CommonNodeKind {
     MigrationData = 'MigrationData',
+    GenericEntity = 'GenericEntity',
 }
 export function CommonNodeKindToDisplay(value: CommonNodeKind): string | undefined {
     switch (value) {
         case CommonNodeKind.MigrationData:
             return 'MigrationData';
+        case CommonNodeKind.GenericEntity:
+            return 'GenericEntity';
         default:
             return undefined;
     }
@@ -1159,6 +1162,7 @@ export enum CommonKindProperties {
     SystemTags = 'system_tags',
     UserTags = 'user_tags',
     LastSeen = 'lastseen',
+    Stale = 'stale',
     WhenCreated = 'whencreated',
     Enabled = 'enabled',
     PasswordLastSet = 'pwdlastset',
@@ -1166,6 +1170,10 @@ export enum CommonKindProperties {
     Email = 'email',
     IsInherited = 'isinherited',
     CompositionID = 'compositionid',
+    FirstIngestJob = 'firstingestjob',
+    LastIngestJob = 'lastingestjob',
+    IngestSourceType = 'ingestsourcetype',
+    IngestSourceVersion = 'ingestsourceversion',
 }
 export function CommonKindPropertiesToDisplay(value: CommonKindProperties): string | undefined {
     switch (value) {
@@ -1189,6 +1197,8 @@ export function CommonKindPropertiesToDisplay(value: CommonKindProperties): stri
             return 'Node User Tags';
         case CommonKindProperties.LastSeen:
             return 'Last Collected by BloodHound';
+        case CommonKindProperties.Stale:
+            return 'Not Seen Within Retention Period';
         case CommonKindProperties.WhenCreated:
             return 'Created';
         case CommonKindProperties.Enabled:
@@ -1203,6 +1213,14 @@ export function CommonKindPropertiesToDisplay(value: CommonKindProperties): stri
             return 'Is Inherited';
         case CommonKindProperties.CompositionID:
             return 'Composition ID';
+        case CommonKindProperties.FirstIngestJob:
+            return 'First Ingest Job';
+        case CommonKindProperties.LastIngestJob:
+            return 'Last Ingest Job';
+        case CommonKindProperties.IngestSourceType:
+            return 'Ingest Source Type';
+        case CommonKindProperties.IngestSourceVersion:
+            return 'Ingest Source Version';
         default:
             return undefined;
     }
@@ -30,6 +30,8 @@ import {
     DatapipeStatusResponse,
     EndFileIngestResponse,
     Environment,
+    FileIngestJobObjectsResponse,
+    FileIngestUploadResponse,
     GetCollectorsResponse,
     GetCommunityCollectorsResponse,
     GetConfigurationResponse,
@@ -66,6 +68,16 @@ class BHEAPIClient {
     getDatapipeStatus = (options?: types.RequestOptions) =>
         this.baseClient.get<DatapipeStatusResponse>('/api/v2/datapipe/status', options);
 
+    getClusterStatus = (options?: types.RequestOptions) => this.baseClient.get('/api/v2/cluster/status', options);
+
+    cancelAnalysis = (options?: types.RequestOptions) => this.baseClient.post('/api/v2/analysis/cancel', {}, options);
+
+    listAnalysisRuns = (skip?: number, limit?: number, sortBy?: string, options?: types.RequestOptions) =>
+        this.baseClient.get(
+            '/api/v2/analysis/runs',
+            Object.assign({ params: { skip, limit, sort_by: sortBy } }, options)
+        );
+
     /* search */
     searchHandler = (keyword: string, type?: string, options?: types.RequestOptions) => {
         return this.baseClient.get(
@@ -553,6 +565,47 @@ class BHEAPIClient {
     endFileIngest = (ingestId: string) =>
         this.baseClient.post<EndFileIngestResponse>(`/api/v2/file-upload/${ingestId}/end`);
 
+    cancelFileIngest = (ingestId: string) => this.baseClient.delete(`/api/v2/file-upload/${ingestId}`);
+
+    listFileIngestJobObjects = (ingestId: string, skip?: number, limit?: number) =>
+        this.baseClient.get<FileIngestJobObjectsResponse>(`/api/v2/file-upload/${ingestId}/objects`, {
+            params: {
+                skip,
+                limit,
+            },
+        });
+
+    rollbackFileIngest = (ingestId: string) => this.baseClient.post(`/api/v2/file-upload/${ingestId}/rollback`);
+
+    startFileIngestUpload = (ingestId: string, contentType: string, totalSize: number) =>
+        this.baseClient.post<FileIngestUploadResponse>(`/api/v2/file-upload/${ingestId}/uploads`, {
+            content_type: contentType,
+            total_size: totalSize,
+        });
+
+    getFileIngestUpload = (ingestId: string, uploadId: number) =>
+        this.baseClient.get<FileIngestUploadResponse>(`/api/v2/file-upload/${ingestId}/uploads/${uploadId}`);
+
+    uploadFileIngestChunk = (ingestId: string, uploadId: number, offset: number, chunk: Blob, checksum?: string) => {
+        const headers: Record<string, string> = {
+            'Content-Type': 'application/offset+octet-stream',
+            'Upload-Offset': offset.toString(),
+        };
+
+        if (checksum) {
+            headers['Upload-Checksum'] = `sha256 ${checksum}`;
+        }
+
+        return this.baseClient.patch<FileIngestUploadResponse>(
+            `/api/v2/file-upload/${ingestId}/uploads/${uploadId}`,
+            chunk,
+            { headers }
+        );
+    };
+
+    deleteFileIngestUpload = (ingestId: string, uploadId: number) =>
+        this.baseClient.delete(`/api/v2/file-upload/${ingestId}/uploads/${uploadId}`);
+
     /* jobs */
     getJobs = (hydrateDomains?: boolean, hydrateOUs?: boolean, options?: types.RequestOptions) =>
         this.baseClient.get(
@@ -243,6 +243,24 @@ export type UploadFileToIngestResponse = null;
 
 export type EndFileIngestResponse = null;
 
+export type FileIngestUpload = TimestampFields & {
+    ingest_job_id: number;
+    file_type: number;
+    total_size: number;
+    received_size: number;
+    completed: boolean;
+    id: number;
+};
+
+export type FileIngestUploadResponse = BasicResponse<FileIngestUpload>;
+
+export type FileIngestJobObjects = GraphData & {
+    node_count: number;
+    edge_count: number;
+};
+
+export type FileIngestJobObjectsResponse = BasicResponse<FileIngestJobObjects>;
+
 export type ConfigurationWithMetadata<T> = TimestampFields &
     T & {
         name: string;
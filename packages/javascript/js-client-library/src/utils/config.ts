@@ -36,6 +36,8 @@ export enum ConfigurationKey {
     Citrix = 'analysis.citrix_rdp_support',
     Reconciliation = 'analysis.reconciliation',
     PruneTTL = 'prune.ttl',
+    PruneSoftDelete = 'prune.soft_delete',
+    PruneEnabled = 'prune.enabled',
 }
 
 export type PasswordExpirationConfiguration = {
@@ -75,12 +77,28 @@ export type PruneTTLConfiguration = {
     };
 };
 
+export type PruneSoftDeleteConfiguration = {
+    key: ConfigurationKey.PruneSoftDelete;
+    value: {
+        enabled: boolean;
+    };
+};
+
+export type PruneEnabledConfiguration = {
+    key: ConfigurationKey.PruneEnabled;
+    value: {
+        enabled: boolean;
+    };
+};
+
 export type ConfigurationPayload =
     | PasswordExpirationConfiguration
     | Neo4jConfiguration
     | CitrixConfiguration
     | ReconciliationConfiguration
-    | PruneTTLConfiguration;
+    | PruneTTLConfiguration
+    | PruneSoftDeleteConfiguration
+    | PruneEnabledConfiguration;
 
 export const getConfigurationFromKey = (config: GetConfigurationResponse | undefined, key: ConfigurationKey) => {
     return config?.data.find((c) => c.key === key);
@@ -130,3 +148,21 @@ export const parsePruneTTLConfiguration = (
 
     return config?.key === key ? config : undefined;
 };
+
+export const parsePruneSoftDeleteConfiguration = (
+    response: GetConfigurationResponse | undefined
+): ConfigurationWithMetadata<PruneSoftDeleteConfiguration> | undefined => {
+    const key = ConfigurationKey.PruneSoftDelete;
+    const config = getConfigurationFromKey(response, key);
+
+    return config?.key === key ? config : undefined;
+};
+
+export const parsePruneEnabledConfiguration = (
+    response: GetConfigurationResponse | undefined
+): ConfigurationWithMetadata<PruneEnabledConfiguration> | undefined => {
+    const key = ConfigurationKey.PruneEnabled;
+    const config = getConfigurationFromKey(response, key);
+
+    return config?.key === key ? config : undefined;
+};